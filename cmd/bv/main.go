@@ -4,10 +4,12 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"crypto/rand"
 	"errors"
 	"flag"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"os/exec"
 	"os/signal"
@@ -21,14 +23,17 @@ import (
 	json "github.com/goccy/go-json"
 
 	"golang.org/x/term"
-	"gopkg.in/yaml.v3"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/baseline"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/beadsview"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/correlation"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/debug"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/drift"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/export"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/hooks"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/metrics"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
@@ -38,12 +43,37 @@ import (
 	"github.com/Dicklesworthstone/beads_viewer/pkg/updater"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/version"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/watcher"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/web"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/workspace"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/ssh"
+	"github.com/charmbracelet/wish"
+	"github.com/charmbracelet/wish/activeterm"
+	wishtea "github.com/charmbracelet/wish/bubbletea"
+	"github.com/charmbracelet/wish/logging"
+	"github.com/muesli/termenv"
 )
 
 func main() {
+	started := time.Now()
+
+	// Subcommands have their own flags, or stand for bv's own (see subcommands).
+	if len(os.Args) > 1 {
+		if cmd := lookupSubcommand(os.Args[1]); cmd != nil {
+			args, err := cmd.run(os.Args[2:])
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			if cmd.mainArgs == nil {
+				return
+			}
+			os.Args = append(os.Args[:1], args...)
+		}
+	}
+
 	help := flag.Bool("help", false, "Show help")
 	versionFlag := flag.Bool("version", false, "Show version")
 	// Update flags (bv-182)
@@ -73,6 +103,7 @@ func main() {
 	suggestType := flag.String("suggest-type", "", "Filter suggestions by type: duplicate, dependency, label, cycle")
 	suggestConfidence := flag.Float64("suggest-confidence", 0.0, "Minimum confidence for suggestions (0.0-1.0)")
 	suggestBead := flag.String("suggest-bead", "", "Filter suggestions for specific bead ID")
+	suggestBuild := flag.String("suggest-build", "", "Also suggest dependencies from build graph files: go, bazel (comma-separated)")
 	// Graph export (bv-136)
 	robotGraph := flag.Bool("robot-graph", false, "Output dependency graph as JSON/DOT/Mermaid for AI agents")
 	graphFormat := flag.String("graph-format", "json", "Graph output format: json, dot, mermaid")
@@ -106,6 +137,9 @@ func main() {
 	profileStartup := flag.Bool("profile-startup", false, "Output detailed startup timing profile for diagnostics")
 	profileJSON := flag.Bool("profile-json", false, "Output profile in JSON format (use with --profile-startup)")
 	noHooks := flag.Bool("no-hooks", false, "Skip running hooks during export")
+	trustHooks := flag.Bool("trust-hooks", false, "Run the project's hooks without asking whether to trust them (for CI)")
+	control := flag.Bool("control", false, "Let bv remote focus issues, set the filter and refresh this TUI")
+	readOnly := flag.Bool("read-only", false, "Disable everything that changes the project: bd writes, hooks, user commands and files under .beads and .bv (also ui.read_only)")
 	workspaceConfig := flag.String("workspace", "", "Load issues from workspace config file (.bv/workspace.yaml)")
 	repoFilter := flag.String("repo", "", "Filter issues by repository prefix (e.g., 'api-' or 'api')")
 	saveBaseline := flag.String("save-baseline", "", "Save current metrics as baseline with optional description")
@@ -192,7 +226,41 @@ func main() {
 	// Experimental background snapshot worker (bv-o11l)
 	backgroundMode := flag.Bool("background-mode", false, "Enable experimental background snapshot loading (TUI only)")
 	noBackgroundMode := flag.Bool("no-background-mode", false, "Disable experimental background snapshot loading (TUI only)")
+	// Polling live reload for mounts without reliable file events (NFS, WSL)
+	poll := flag.Bool("poll", false, "Detect beads file changes by polling instead of file system events (also BV_FORCE_POLL=1)")
+	pollInterval := flag.Duration("poll-interval", 0, "Polling interval for live reload, e.g. 5s (default 2s; also BV_POLL_INTERVAL_MS)")
+	pollJitter := flag.Duration("poll-jitter", 0, "Randomize each poll by up to this much, e.g. 1s (also BV_POLL_JITTER_MS)")
+	// Session review on quit
+	sessionReview := flag.Bool("session-review", false, "Show a session summary (viewed/edited/closed, time per view) before quitting")
+	fresh := flag.Bool("fresh", false, "Start in the default view instead of restoring the last session's view, filter, sort and selection")
+	setup := flag.Bool("setup", false, "Run the first-run setup again: theme, default view, update checks and project hooks")
+	// Linear plain-text output for screen readers and braille displays
+	accessible := flag.Bool("accessible", false, "Screen-reader friendly output: one issue per line, labeled fields, no box drawing or color (also BV_ACCESSIBLE=1)")
+	reducedMotion := flag.Bool("reduced-motion", false, "No spinners, animations or sub-second refresh ticks; repaint only on keys and data changes (also BV_REDUCED_MOTION=1)")
+	verbose := flag.Bool("verbose", false, "Log debug output, including startup timings, to stderr (also BV_DEBUG=1)")
+	// Privacy screen for shared terminals
+	idleLockMinutes := flag.Int("idle-lock", -1, "Blank the screen after N minutes of inactivity (0 disables; default from config)")
+	// Scriptable startup (e.g. --cmd "filter ready" --cmd "view board")
+	var startupCmds stringListFlag
+	flag.Var(&startupCmds, "cmd", "TUI command to run after load; repeatable (e.g. \"filter ready\", \"sort -created\", \"view board\", or an alias)")
+	// Headless list output using the TUI's filter and sort
+	jsonList := flag.Bool("json", false, "Print the issues the TUI list would show as JSON and exit (see --filter, --sort)")
+	listFilter := flag.String("filter", "all", "Filter for --json, in TUI filter terms (e.g. \"open label:api\", \"status:open AND (label:api OR priority<=1)\")")
+	listSort := flag.String("sort", "default", "Sort for --json: default, created, -created, priority, updated, votes, or a score expression; comma-separated terms break ties (e.g. \"priority,-created\", \"priority*10 - age_days\")")
+	// Shell completion (see bv completion) offers the flags above.
+	if len(os.Args) > 1 && os.Args[1] == completeCommand {
+		for _, candidate := range completeWords(flag.CommandLine, os.Args[2:], &completer{}) {
+			fmt.Println(candidate)
+		}
+		return
+	}
 	flag.Parse()
+	if *readOnly {
+		*noHooks = true
+	}
+	if *verbose {
+		debug.SetEnabled(true)
+	}
 
 	// Ensure static export flags are retained even when build tags strip features in some environments.
 	_ = exportPages
@@ -271,6 +339,7 @@ func main() {
 
 	if *help {
 		fmt.Println("Usage: bv [options]")
+		printSubcommandUsage()
 		fmt.Println("\nA TUI viewer for beads issue tracker.")
 		flag.PrintDefaults()
 		os.Exit(0)
@@ -502,6 +571,10 @@ func main() {
 		fmt.Println("  --no-hooks")
 		fmt.Println("      Skip running hooks during export. Useful for CI or quick exports.")
 		fmt.Println("")
+		fmt.Println("  --trust-hooks")
+		fmt.Println("      Run the project's hooks without asking first. Otherwise a hooks file")
+		fmt.Println("      you have not trusted is asked about, or skipped without a terminal.")
+		fmt.Println("")
 		fmt.Println("  Hook Configuration (.bv/hooks.yaml)")
 		fmt.Println("      Configure hooks to automate export workflows:")
 		fmt.Println("      - pre-export: Validation, notifications (failure cancels export)")
@@ -727,7 +800,7 @@ func main() {
 
 	// Handle --check-update (bv-182)
 	if *checkUpdateFlag {
-		available, newVersion, releaseURL, err := updater.CheckUpdateAvailable()
+		available, newVersion, releaseURL, err := updater.CheckUpdateAvailable(context.Background(), updater.Preferences{Pin: updatePin()})
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error checking for updates: %v\n", err)
 			os.Exit(1)
@@ -735,7 +808,11 @@ func main() {
 		if available {
 			fmt.Printf("New version available: %s (current: %s)\n", newVersion, version.Version)
 			fmt.Printf("Download: %s\n", releaseURL)
-			fmt.Println("\nRun 'bv --update' to update automatically")
+			if origin := updater.DetectInstallOrigin(); origin.Managed() {
+				fmt.Printf("\nbv was installed with %s; update it with: %s\n", origin.Name(), origin.UpgradeCommand())
+			} else {
+				fmt.Println("\nRun 'bv --update' to update automatically")
+			}
 		} else {
 			fmt.Printf("bv is up to date (version %s)\n", version.Version)
 		}
@@ -744,19 +821,26 @@ func main() {
 
 	// Handle --update (bv-182)
 	if *updateFlag {
-		release, err := updater.GetLatestRelease()
+		prefs := updater.Preferences{Pin: updatePin()}
+		release, err := updater.GetLatestRelease(context.Background(), prefs)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error fetching release info: %v\n", err)
 			os.Exit(1)
 		}
 
 		// Check if update is needed
-		available, newVersion, _, _ := updater.CheckUpdateAvailable()
+		available, newVersion, _, _ := updater.CheckUpdateAvailable(context.Background(), prefs)
 		if !available {
 			fmt.Printf("bv is already up to date (version %s)\n", version.Version)
 			os.Exit(0)
 		}
 
+		// Package managers update their own binaries
+		if origin := updater.DetectInstallOrigin(); origin.Managed() {
+			fmt.Printf("bv %s is available. bv was installed with %s; update it with:\n  %s\n", newVersion, origin.Name(), origin.UpgradeCommand())
+			os.Exit(0)
+		}
+
 		// Confirm unless --yes is provided
 		if !*yesFlag {
 			fmt.Printf("Update bv from %s to %s? [Y/n]: ", version.Version, newVersion)
@@ -769,7 +853,10 @@ func main() {
 			}
 		}
 
-		result, err := updater.PerformUpdate(release, *yesFlag)
+		// Ctrl+C aborts the download and cleans up after it
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		result, err := updater.PerformUpdate(ctx, release, *yesFlag)
+		stop()
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Update failed: %v\n", err)
 			if result != nil && result.BackupPath != "" {
@@ -951,6 +1038,12 @@ func main() {
 			}
 			os.Exit(1)
 		}
+		if activeRecipe.Sort.Expr != "" {
+			if _, err := beadsview.ParseExpr(activeRecipe.Sort.Expr); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: invalid sort expr in recipe %s: %v\n", activeRecipe.Name, err)
+				os.Exit(2)
+			}
+		}
 	}
 
 	// Load issues from current directory or workspace (with timing for profile)
@@ -1024,9 +1117,10 @@ func main() {
 			fmt.Fprintln(os.Stderr, "Make sure you are in a project initialized with 'bd init'.")
 			os.Exit(1)
 		}
-		// Get beads file path for live reload (respects BEADS_DIR env var)
+		// Get the path of the backend LoadIssues read, for live reload
+		// (respects BEADS_DIR and BV_BEADS_BACKEND)
 		beadsDir, _ := loader.GetBeadsDir("")
-		beadsPath, _ = loader.FindJSONLPath(beadsDir)
+		_, beadsPath, _ = loader.SelectBackend(beadsDir)
 
 		// Automatically ensure .bv/ is in .gitignore to prevent polluting git
 		// with search indexes, baselines, and other bv-specific files.
@@ -1035,6 +1129,7 @@ func main() {
 		_ = loader.EnsureBVInGitignore(projectDir)
 	}
 	loadDuration := time.Since(loadStart)
+	debug.LogTiming("startup: load issues", loadDuration)
 
 	// Apply --repo filter if specified
 	if *repoFilter != "" {
@@ -1126,7 +1221,7 @@ func main() {
 			fmt.Fprintf(os.Stderr, "Error building semantic index: %v\n", err)
 			os.Exit(1)
 		}
-		if !loaded || syncStats.Changed() {
+		if !*readOnly && (!loaded || syncStats.Changed()) {
 			if err := idx.Save(indexPath); err != nil {
 				fmt.Fprintf(os.Stderr, "Error saving semantic index: %v\n", err)
 				os.Exit(1)
@@ -1318,8 +1413,8 @@ func main() {
 			cwd, _ := os.Getwd()
 			var pagesExecutor *hooks.Executor
 			if !*noHooks {
-				hookLoader := hooks.NewLoader(hooks.WithProjectDir(cwd))
-				if err := hookLoader.Load(); err != nil {
+				hookLoader, err := loadExportHooks(cwd, *trustHooks)
+				if err != nil {
 					fmt.Printf("  → Warning: failed to load hooks: %v\n", err)
 				} else if hookLoader.HasHooks() {
 					fmt.Println("  → Running pre-export hooks...")
@@ -1334,7 +1429,7 @@ func main() {
 						fmt.Printf("  → %s\n", msg)
 					})
 
-					if err := pagesExecutor.RunPreExport(); err != nil {
+					if err := withInterrupt(pagesExecutor.RunPreExport); err != nil {
 						return fmt.Errorf("pre-export hook failed: %w", err)
 					}
 				}
@@ -1414,7 +1509,7 @@ func main() {
 			// Run post-export hooks (bv-qjc.3)
 			if pagesExecutor != nil {
 				fmt.Println("  → Running post-export hooks...")
-				if err := pagesExecutor.RunPostExport(); err != nil {
+				if err := withInterrupt(pagesExecutor.RunPostExport); err != nil {
 					fmt.Printf("  → Warning: post-export hook failed: %v\n", err)
 				}
 
@@ -1920,6 +2015,21 @@ func main() {
 			os.Exit(1)
 		}
 
+		if *suggestBuild != "" {
+			cwd, err := os.Getwd()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error getting working directory: %v\n", err)
+				os.Exit(1)
+			}
+			graph, err := analysis.LoadBuildGraph(cwd, strings.Split(*suggestBuild, ","))
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			config.BuildDependencies.Graph = graph
+			config.BuildDependencies.LinkedFiles = linkedCommitFiles(cwd, beadsPath, issues)
+		}
+
 		output := analysis.GenerateRobotSuggestOutput(issues, config, dataHash)
 
 		encoder := newRobotEncoder(os.Stdout)
@@ -4330,7 +4440,7 @@ func main() {
 		// Launch TUI with historical issues (already loaded, no live reload)
 		m := ui.NewModel(issues, activeRecipe, "")
 		defer m.Stop()
-		if err := runTUIProgram(m); err != nil {
+		if err := runTUIProgram(m, ui.DetectTerminal(), false); err != nil {
 			fmt.Printf("Error running beads viewer: %v\n", err)
 			os.Exit(1)
 		}
@@ -4344,8 +4454,8 @@ func main() {
 		cwd, _ := os.Getwd()
 		var executor *hooks.Executor
 		if !*noHooks {
-			hookLoader := hooks.NewLoader(hooks.WithProjectDir(cwd))
-			if err := hookLoader.Load(); err != nil {
+			hookLoader, err := loadExportHooks(cwd, *trustHooks)
+			if err != nil {
 				fmt.Printf("Warning: failed to load hooks: %v\n", err)
 			} else if hookLoader.HasHooks() {
 				ctx := hooks.ExportContext{
@@ -4357,7 +4467,7 @@ func main() {
 				executor = hooks.NewExecutor(hookLoader.Config(), ctx)
 
 				// Run pre-export hooks
-				if err := executor.RunPreExport(); err != nil {
+				if err := withInterrupt(executor.RunPreExport); err != nil {
 					fmt.Printf("Error: pre-export hook failed: %v\n", err)
 					os.Exit(1)
 				}
@@ -4372,7 +4482,7 @@ func main() {
 
 		// Run post-export hooks
 		if executor != nil {
-			if err := executor.RunPostExport(); err != nil {
+			if err := withInterrupt(executor.RunPostExport); err != nil {
 				fmt.Printf("Warning: post-export hook failed: %v\n", err)
 				// Don't exit, just warn
 			}
@@ -4387,6 +4497,53 @@ func main() {
 		os.Exit(0)
 	}
 
+	// Headless list: the TUI's filter/sort pipeline, printed as JSON
+	if *jsonList {
+		if err := beadsview.ValidateFilter(*listFilter); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid --filter: %v\n", err)
+			os.Exit(2)
+		}
+		order, err := beadsview.ParseSort(*listSort)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid --sort: %v\n", err)
+			os.Exit(2)
+		}
+		if activeRecipe != nil {
+			issues = applyRecipeFilters(issues, activeRecipe)
+			issues = applyRecipeSort(issues, activeRecipe)
+		}
+		filtered := beadsview.FilterIssues(issues, *listFilter, beadsview.SortDefault)
+		matched := make([]model.Issue, len(filtered))
+		for newIdx, oldIdx := range order.Order(filtered, time.Now()) {
+			matched[newIdx] = filtered[oldIdx]
+		}
+		output := struct {
+			GeneratedAt string        `json:"generated_at"`
+			DataHash    string        `json:"data_hash"`
+			Filter      string        `json:"filter"`
+			Sort        string        `json:"sort"`
+			Recipe      string        `json:"recipe,omitempty"`
+			Count       int           `json:"count"`
+			Issues      []model.Issue `json:"issues"`
+		}{
+			GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+			DataHash:    dataHash,
+			Filter:      *listFilter,
+			Sort:        *listSort,
+			Count:       len(matched),
+			Issues:      matched,
+		}
+		if activeRecipe != nil {
+			output.Recipe = activeRecipe.Name
+		}
+		encoder := newRobotEncoder(os.Stdout)
+		if err := encoder.Encode(output); err != nil {
+			fmt.Fprintf(os.Stderr, "Error encoding issues: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	if len(issues) == 0 {
 		fmt.Println("No issues found. Create some with 'bd create'!")
 		os.Exit(0)
@@ -4398,6 +4555,110 @@ func main() {
 		issues = applyRecipeSort(issues, activeRecipe)
 	}
 
+	// First-run setup, also on --setup: its answers go to the user config,
+	// which is loaded next. Automated runs (BV_TUI_AUTOCLOSE_MS) skip it.
+	openTutorial := false
+	if configPath := config.DefaultPath(); configPath != "" && stdoutIsTTY && term.IsTerminal(int(os.Stdin.Fd())) && os.Getenv("BV_TUI_AUTOCLOSE_MS") == "" {
+		if _, err := os.Stat(configPath); *setup || os.IsNotExist(err) {
+			openTutorial = runOnboarding(configPath, beadsPath, len(issues), projectDir)
+		}
+	}
+
+	userConfig, settings, err := config.LoadLayered(projectDir, os.Environ())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+
+	if err := ui.ValidateAliases(userConfig.UI.Aliases); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid alias in %s: %v\n", settings.Source("ui.aliases"), err)
+		os.Exit(2)
+	}
+	if err := ui.ValidateKeyBindings(userConfig.UI.Keys, userConfig.UI.Aliases); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid key binding in %s: %v\n", settings.Source("ui.keys"), err)
+		os.Exit(2)
+	}
+	if err := ui.ValidateUserCommands(userConfig.UI.Commands); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid user command in %s: %v\n", settings.Source("ui.commands"), err)
+		os.Exit(2)
+	}
+	if err := ui.ValidateStatusBar(userConfig.UI.StatusBar); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid ui.status_bar in %s: %v\n\nAvailable segments:\n%s", settings.Source("ui.status_bar"), err, ui.StatusSegmentUsage())
+		os.Exit(2)
+	}
+	hookSandbox, err := hooks.ParseSandboxMode(userConfig.UI.HookSandbox)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid ui.hook_sandbox in %s: %v\n", settings.Source("ui.hook_sandbox"), err)
+		os.Exit(2)
+	}
+	if err := updater.ValidatePin(userConfig.UI.UpdatePin); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid ui.update_pin in %s: %v\n", settings.Source("ui.update_pin"), err)
+		os.Exit(2)
+	}
+	if err := ui.ValidateWIPLimits(userConfig.UI.WIPLimits); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid ui.wip_limits in %s: %v\n", settings.Source("ui.wip_limits"), err)
+		os.Exit(2)
+	}
+	if err := ui.ValidateEscalationRules(userConfig.UI.Escalation); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid ui.escalation in %s: %v\n", settings.Source("ui.escalation"), err)
+		os.Exit(2)
+	}
+	if err := ui.ValidateLayout(userConfig.UI.Layout); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid ui.layout in %s: %v\n", settings.Source("ui.layout"), err)
+		os.Exit(2)
+	}
+	if err := ui.ValidateGraphics(userConfig.UI.Graphics); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid ui.graphics in %s: %v\n", settings.Source("ui.graphics"), err)
+		os.Exit(2)
+	}
+	if err := ui.ValidatePager(userConfig.UI.Pager); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid ui.pager in %s: %v\n", settings.Source("ui.pager"), err)
+		os.Exit(2)
+	}
+	if err := ui.ValidateEditorTemplates(userConfig.UI.EditorTemplates); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid ui.editor_templates in %s: %v\n", settings.Source("ui.editor_templates"), err)
+		os.Exit(2)
+	}
+	if err := ui.ValidateTheme(userConfig.UI.Theme); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid ui.theme in %s: %v\n", settings.Source("ui.theme"), err)
+		os.Exit(2)
+	}
+	if err := i18n.ValidateLocale(userConfig.UI.Locale); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid ui.locale in %s: %v\n", settings.Source("ui.locale"), err)
+		os.Exit(2)
+	}
+	if err := i18n.SetLocale(i18n.Detect(userConfig.UI.Locale, os.Getenv)); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+	if level := userConfig.UI.LogLevel; level != "" {
+		logLevel, err := debug.ParseLevel(level)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid ui.log_level in %s: %v\n", settings.Source("ui.log_level"), err)
+			os.Exit(2)
+		}
+		logFile := userConfig.UI.LogFile
+		if logFile == "" {
+			if cacheDir, err := os.UserCacheDir(); err == nil {
+				logFile = filepath.Join(cacheDir, "bv", "bv.log")
+			}
+		}
+		if err := debug.SetupLog(debug.LogOptions{Level: logLevel, File: logFile}); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: log file: %v\n", err)
+		}
+		defer debug.CloseLog()
+	}
+	if theme := userConfig.UI.Theme; theme == "dark" || theme == "light" {
+		lipgloss.SetHasDarkBackground(theme == "dark")
+	}
+	if len(startupCmds) == 0 {
+		startupCmds = userConfig.UI.StartupCommands
+	}
+	for _, line := range startupCmds {
+		if err := ui.ValidateCommand(line, userConfig.UI.Aliases); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid startup command: %v\n\nAvailable commands:\n%s", err, ui.CommandUsage())
+			os.Exit(2)
+		}
+	}
+
 	// Background mode rollout (bv-o11l):
 	// - CLI flags override env var
 	// - env var overrides user config file
@@ -4411,18 +4672,132 @@ func main() {
 		_ = os.Setenv("BV_BACKGROUND_MODE", "0")
 	} else if v, ok := os.LookupEnv("BV_BACKGROUND_MODE"); ok && strings.TrimSpace(v) != "" {
 		// Respect explicit user env var.
-	} else if enabled, ok := loadBackgroundModeFromUserConfig(); ok {
-		if enabled {
+	} else if userConfig.Experimental.BackgroundMode != nil {
+		if *userConfig.Experimental.BackgroundMode {
 			_ = os.Setenv("BV_BACKGROUND_MODE", "1")
 		} else {
 			_ = os.Setenv("BV_BACKGROUND_MODE", "0")
 		}
 	}
 
+	// Polling: flags override env vars, which override the config file.
+	if *poll || (os.Getenv("BV_FORCE_POLL") == "" && os.Getenv("BV_FORCE_POLLING") == "" && userConfig.UI.Poll) {
+		_ = os.Setenv("BV_FORCE_POLL", "1")
+	}
+	for _, d := range []struct {
+		env        string
+		flag, conf time.Duration
+	}{
+		{"BV_POLL_INTERVAL_MS", *pollInterval, userConfig.UI.PollInterval},
+		{"BV_POLL_JITTER_MS", *pollJitter, userConfig.UI.PollJitter},
+	} {
+		value := d.flag
+		if value == 0 && os.Getenv(d.env) == "" {
+			value = d.conf
+		}
+		if value > 0 {
+			_ = os.Setenv(d.env, strconv.FormatInt(value.Milliseconds(), 10))
+		}
+	}
+
 	// Initial Model with live reload support
+	modelStart := time.Now()
 	m := ui.NewModel(issues, activeRecipe, beadsPath)
+	debug.LogTiming("startup: model", time.Since(modelStart))
 	defer m.Stop() // Clean up file watcher
 
+	m.EnableSessionReview(*sessionReview || userConfig.UI.SessionReview)
+	if userConfig.UI.UsageStats {
+		if path, err := ui.UsageStatsPath(); err == nil {
+			if err := m.EnableUsageStats(path); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: usage stats: %v\n", err)
+			}
+		}
+	}
+	if *idleLockMinutes < 0 {
+		*idleLockMinutes = userConfig.UI.IdleLockMinutes
+	}
+	m.EnableIdleLock(time.Duration(*idleLockMinutes) * time.Minute)
+	terminal := ui.DetectTerminal()
+	hyperlinks := terminal.Hyperlinks
+	if userConfig.UI.Hyperlinks != nil {
+		hyperlinks = *userConfig.UI.Hyperlinks
+	}
+	if hyperlinks {
+		m.EnableHyperlinks(userConfig.UI.IssueURL)
+	}
+	m.EnableGraphics(terminal.GraphicsFor(userConfig.UI.Graphics))
+	m.SetPager(userConfig.UI.Pager)
+	m.SetEditorTemplates(userConfig.UI.EditorTemplates)
+	m.SetAliases(userConfig.UI.Aliases)
+	m.SetKeyBindings(userConfig.UI.Keys)
+	m.SetUserCommands(userConfig.UI.Commands)
+	m.SetStatusBar(userConfig.UI.StatusBar)
+	if wd, err := os.Getwd(); err == nil {
+		if path, err := ui.WatchListPath(wd); err == nil {
+			if err := m.EnableWatches(path); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: watched issues: %v\n", err)
+			}
+		}
+		if path, err := ui.DraftsPath(wd); err == nil {
+			if err := m.EnableDrafts(path); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: drafts: %v\n", err)
+			}
+		}
+		if path, err := ui.SessionStatePath(wd); err == nil {
+			if err := m.EnableSessionState(path, !*fresh); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: last session: %v\n", err)
+			}
+		}
+	}
+	m.EnableWatchNotifications(userConfig.UI.WatchNotifications)
+	m.SetStaleDays(userConfig.UI.StaleDays)
+	m.SetWIPLimits(userConfig.UI.WIPLimits)
+	m.SetLayout(userConfig.UI.Layout)
+	// Issues can depend on issues of other repos of their workspace; look
+	// those up when this repo is loaded on its own.
+	if workspaceInfo == nil && *asOf == "" {
+		if beadsDir, err := loader.GetBeadsDir(""); err == nil {
+			projectDir := filepath.Dir(beadsDir)
+			startup := true
+			m.SetExternalResolver(func(issues []model.Issue) map[string]model.Issue {
+				found, err := workspace.FindExternal(projectDir, issues)
+				if err != nil && startup {
+					fmt.Fprintf(os.Stderr, "Warning: cross-repo dependencies: %v\n", err)
+				} else if err != nil {
+					debug.Log("cross-repo dependencies: %v", err)
+				}
+				startup = false
+				return found
+			})
+		}
+	}
+	m.SetEscalationRules(userConfig.UI.Escalation)
+	m.SetConfigSettings(settings)
+	m.EnableUpdateCheck(userConfig.UI.CheckUpdates == nil || *userConfig.UI.CheckUpdates)
+	m.SetUpdatePreferences(updater.Preferences{SkipRelease: userConfig.UI.SkipRelease, Pin: userConfig.UI.UpdatePin})
+	m.EnableHooks(userConfig.UI.RunHooks == nil || *userConfig.UI.RunHooks)
+	if *readOnly || userConfig.UI.ReadOnly {
+		m.EnableReadOnly()
+	}
+	m.SetHookSandbox(hookSandbox)
+	if !*trustHooks {
+		if trust, err := loadHookTrust(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		} else {
+			m.EnableHookTrust(trust)
+		}
+	}
+	if terminal.Kitty && terminal.AltScreen && (userConfig.UI.KittyKeyboard == nil || *userConfig.UI.KittyKeyboard) {
+		m.EnableKittyKeyboard(os.Stdout)
+	}
+	m.EnableAccessible(*accessible || os.Getenv("BV_ACCESSIBLE") == "1" || userConfig.UI.Accessible || terminal.Plain)
+	m.EnableReducedMotion(*reducedMotion || os.Getenv("BV_REDUCED_MOTION") == "1" || userConfig.UI.ReducedMotion || !terminal.Motion)
+	m.SetStartupCommands(startupCmds)
+	if openTutorial {
+		m.TriggerFullTutorial()
+	}
+
 	// Enable workspace mode if loading from workspace config
 	if workspaceInfo != nil {
 		m.EnableWorkspaceMode(ui.WorkspaceInfo{
@@ -4442,19 +4817,124 @@ func main() {
 	}
 
 	// Run Program
-	if err := runTUIProgram(m); err != nil {
+	debug.LogTiming("startup: before the TUI", time.Since(started))
+	m.TraceStartup(started)
+	if err := runTUIProgram(m, terminal, *control); err != nil {
 		fmt.Printf("Error running beads viewer: %v\n", err)
 		os.Exit(1)
 	}
 }
 
-func runTUIProgram(m ui.Model) error {
-	p := tea.NewProgram(
-		m,
-		tea.WithAltScreen(),
-		tea.WithMouseCellMotion(),
-		tea.WithoutSignalHandler(),
-	)
+// loadHookTrust reads the hooks files the user has trusted.
+func loadHookTrust() (*hooks.Trust, error) {
+	path := ""
+	if dir := config.Dir(); dir != "" {
+		path = filepath.Join(dir, hooks.TrustFileName)
+	}
+	return hooks.LoadTrust(path)
+}
+
+// updatePin returns ui.update_pin for --check-update and --update. They
+// ignore ui.skip_release, which only quiets the reminders.
+func updatePin() string {
+	cwd, _ := os.Getwd()
+	userConfig, settings, err := config.LoadLayered(cwd, os.Environ())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+	if err := updater.ValidatePin(userConfig.UI.UpdatePin); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid ui.update_pin in %s: %v\n", settings.Source("ui.update_pin"), err)
+		os.Exit(2)
+	}
+	return userConfig.UI.UpdatePin
+}
+
+// loadExportHooks loads the hooks of the project in dir for an export,
+// confined by ui.hook_sandbox. A hooks file the user has not trusted yet is
+// asked about on a terminal and skipped otherwise, unless trustAll
+// (--trust-hooks).
+func loadExportHooks(dir string, trustAll bool) (*hooks.Loader, error) {
+	userConfig, settings, err := config.LoadLayered(dir, os.Environ())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+	mode, err := hooks.ParseSandboxMode(userConfig.UI.HookSandbox)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ui.hook_sandbox in %s: %w", settings.Source("ui.hook_sandbox"), err)
+	}
+	opts := []hooks.LoaderOption{hooks.WithProjectDir(dir), hooks.WithSandbox(mode)}
+	if !trustAll {
+		trust, err := loadHookTrust()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, hooks.WithTrust(trust))
+	}
+	loader := hooks.NewLoader(opts...)
+	var untrusted *hooks.UntrustedError
+	if err := loader.Load(); !errors.As(err, &untrusted) {
+		for _, warning := range loader.Warnings() {
+			fmt.Fprintf(os.Stderr, "Warning: hooks: %s\n", warning)
+		}
+		return loader, err
+	}
+
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		fmt.Fprintf(os.Stderr, "Warning: skipping the hooks in %s, which are not trusted yet (trust them in bv or pass --trust-hooks)\n", untrusted.Path)
+		return loader, nil
+	}
+	fmt.Printf("%s would run:\n", untrusted.Path)
+	for _, phase := range hooks.Phases {
+		for _, hook := range untrusted.Hooks.ForPhase(phase) {
+			fmt.Printf("  %s %s: %s\n", phase, hook.Name, hook.Command)
+		}
+	}
+	fmt.Print("Trust these hooks? [y/N]: ")
+	var response string
+	fmt.Scanln(&response)
+	response = strings.ToLower(strings.TrimSpace(response))
+	if response != "y" && response != "yes" {
+		fmt.Println("Skipping the project's hooks")
+		return loader, nil
+	}
+	return loader, loader.TrustFile()
+}
+
+// runOnboarding runs the first-run setup and saves the answers to
+// configPath. It reports whether the tutorial should open next.
+func runOnboarding(configPath, beadsPath string, issueCount int, projectDir string) bool {
+	current, _ := config.LoadFrom(configPath)
+	hookLoader := hooks.NewLoader(hooks.WithProjectDir(projectDir))
+	hasHooks := hookLoader.Load() == nil && hookLoader.HasHooks()
+
+	final, err := tea.NewProgram(ui.NewOnboarding(beadsPath, issueCount, hasHooks, configPath, current.UI), tea.WithAltScreen()).Run()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: setup: %v\n", err)
+		return false
+	}
+	onboarding, ok := final.(ui.Onboarding)
+	if !ok || onboarding.Values() == nil {
+		return false
+	}
+	if err := config.SetValues(configPath, onboarding.Values()); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: saving setup: %v\n", err)
+		return false
+	}
+	return onboarding.Tutorial()
+}
+
+func runTUIProgram(m ui.Model, terminal ui.Terminal, control bool) error {
+	lipgloss.SetColorProfile(terminal.LimitColors(lipgloss.ColorProfile()))
+	p := tea.NewProgram(m, append(terminal.ProgramOptions(), tea.WithoutSignalHandler())...)
+
+	// Remote control for editors and scripts (bv remote).
+	if control {
+		server, err := startControlServer(p)
+		if err != nil {
+			return err
+		}
+		defer server.Close()
+	}
 
 	runDone := make(chan struct{})
 	defer close(runDone)
@@ -4508,7 +4988,17 @@ func runTUIProgram(m ui.Model) error {
 		}
 	}
 
-	_, err := p.Run()
+	final, err := p.Run()
+	if fm, ok := final.(ui.Model); ok {
+		fm.StopCommands()
+		if err := fm.SaveSessionState(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: saving session: %v\n", err)
+		}
+		if err := fm.SaveUsageStats(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: saving usage stats: %v\n", err)
+		}
+		fm.LogStartup()
+	}
 	if err != nil && errors.Is(err, tea.ErrProgramKilled) {
 		if err == tea.ErrProgramKilled || errors.Is(err, tea.ErrInterrupted) {
 			return nil
@@ -4517,6 +5007,26 @@ func runTUIProgram(m ui.Model) error {
 	return err
 }
 
+// withInterrupt runs fn with a context that Ctrl+C or SIGTERM cancels, so
+// hook processes it starts are stopped rather than left running after bv.
+func withInterrupt(fn func(context.Context) error) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	return fn(ctx)
+}
+
+// stringListFlag collects the values of a repeatable string flag.
+type stringListFlag []string
+
+func (s *stringListFlag) String() string {
+	return strings.Join(*s, ", ")
+}
+
+func (s *stringListFlag) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
+
 // countEdges counts blocking dependencies for config sizing
 func countEdges(issues []model.Issue) int {
 	count := 0
@@ -4530,32 +5040,6 @@ func countEdges(issues []model.Issue) int {
 	return count
 }
 
-func loadBackgroundModeFromUserConfig() (bool, bool) {
-	homeDir, err := os.UserHomeDir()
-	if err != nil || homeDir == "" {
-		return false, false
-	}
-	configPath := filepath.Join(homeDir, ".config", "bv", "config.yaml")
-
-	data, err := os.ReadFile(configPath)
-	if err != nil {
-		return false, false
-	}
-
-	var cfg struct {
-		Experimental struct {
-			BackgroundMode *bool `yaml:"background_mode"`
-		} `yaml:"experimental"`
-	}
-	if err := yaml.Unmarshal(data, &cfg); err != nil {
-		return false, false
-	}
-	if cfg.Experimental.BackgroundMode == nil {
-		return false, false
-	}
-	return *cfg.Experimental.BackgroundMode, true
-}
-
 // printDiffSummary prints a human-readable diff summary
 func printDiffSummary(diff *analysis.SnapshotDiff, since string) {
 	fmt.Printf("Changes since %s\n", since)
@@ -4896,11 +5380,38 @@ func applyRecipeFilters(issues []model.Issue, r *recipe.Recipe) []model.Issue {
 
 // applyRecipeSort sorts issues based on recipe configuration
 func applyRecipeSort(issues []model.Issue, r *recipe.Recipe) []model.Issue {
-	if r == nil || r.Sort.Field == "" {
+	if r == nil || r.Sort.Field == "" && r.Sort.Expr == "" {
 		return issues
 	}
 
 	s := r.Sort
+	if s.Expr != "" {
+		// Score expressions rank the highest first unless the direction is asc
+		expr, err := beadsview.ParseExpr(s.Expr)
+		if err != nil {
+			return issues
+		}
+		now := time.Now()
+		scores := make([]float64, len(issues))
+		for i, issue := range issues {
+			scores[i] = expr.Eval(issue, now)
+		}
+		order := make([]int, len(issues))
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(i, j int) bool {
+			if s.Direction == "asc" {
+				return scores[order[i]] < scores[order[j]]
+			}
+			return scores[order[i]] > scores[order[j]]
+		})
+		sorted := make([]model.Issue, len(issues))
+		for newIdx, oldIdx := range order {
+			sorted[newIdx] = issues[oldIdx]
+		}
+		return sorted
+	}
 	ascending := s.Direction != "desc"
 
 	// For priority, default to ascending (P0 first)
@@ -4942,6 +5453,33 @@ func applyRecipeSort(issues []model.Issue, r *recipe.Recipe) []model.Issue {
 	return issues
 }
 
+// linkedCommitFiles maps bead IDs to the files changed by commits whose
+// message names the bead (how merged PRs are linked). Outside a git
+// repository it returns nil with a warning.
+func linkedCommitFiles(repoPath, beadsPath string, issues []model.Issue) map[string][]string {
+	beadInfos := make([]correlation.BeadInfo, len(issues))
+	for i, issue := range issues {
+		beadInfos[i] = correlation.BeadInfo{ID: issue.ID, Title: issue.Title, Status: string(issue.Status)}
+	}
+	report, err := correlation.NewCorrelator(repoPath, beadsPath).GenerateReport(beadInfos, correlation.CorrelatorOptions{})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: skipping linked commits: %v\n", err)
+		return nil
+	}
+	files := make(map[string][]string)
+	for id, history := range report.Histories {
+		for _, commit := range history.Commits {
+			if commit.Method != correlation.MethodExplicitID {
+				continue
+			}
+			for _, f := range commit.Files {
+				files[id] = append(files[id], f.Path)
+			}
+		}
+	}
+	return files
+}
+
 // runProfileStartup runs profiled startup analysis and outputs results
 func runProfileStartup(issues []model.Issue, loadDuration time.Duration, jsonOutput bool, forceFullAnalysis bool) {
 	// Get actual beads path (respects BEADS_DIR)
@@ -5740,6 +6278,424 @@ func runPreviewServer(dir string) error {
 	return export.StartPreviewWithConfig(cfg)
 }
 
+// serveCommand defines bv serve, the read-only web UI.
+func serveCommand(fs *flag.FlagSet) func(args []string) error {
+	addr := fs.String("addr", "127.0.0.1:8080", "Address to listen on (use 0.0.0.0:PORT to share on the network)")
+	title := fs.String("title", "", "Page title (default: project directory name)")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: bv serve [options]")
+		fmt.Fprintln(fs.Output(), "\nServe the issue list, issue details, dependency graph and a JSON API over HTTP (read-only).")
+		fs.PrintDefaults()
+	}
+	return func(args []string) error {
+
+		// Fail early if there is nothing to serve. The issues loaded here
+		// become the server's first snapshot.
+		initial, err := loader.LoadIssues("")
+		if err != nil {
+			return err
+		}
+		if *title == "" {
+			if cwd, err := os.Getwd(); err == nil {
+				*title = filepath.Base(cwd)
+			}
+		}
+
+		server, err := web.NewServer(func() ([]model.Issue, error) {
+			if initial != nil {
+				issues := initial
+				initial = nil
+				return issues, nil
+			}
+			return loader.LoadIssues("")
+		}, web.Options{Title: *title})
+		if err != nil {
+			return err
+		}
+		httpServer := &http.Server{
+			Addr:              *addr,
+			Handler:           server.Handler(),
+			ReadHeaderTimeout: 5 * time.Second,
+			ReadTimeout:       30 * time.Second,
+		}
+		fmt.Fprintf(os.Stderr, "Serving %s at http://%s (read-only, Ctrl+C to stop)\n", *title, *addr)
+		return httpServer.ListenAndServe()
+	}
+}
+
+// exportCommand defines bv export, which writes the issues as JSONL, like
+// the beads file; with --anonymize their text is replaced first, so they can
+// be attached to a bug report (see export.Anonymize).
+func exportCommand(fs *flag.FlagSet) func(args []string) error {
+	output := fs.String("o", "", "Write to this file instead of stdout")
+	anonymize := fs.Bool("anonymize", false, "Replace IDs, titles, text, names and labels with fake words of the same size")
+	seed := fs.String("seed", "", "With --anonymize, derive the fake words from this secret to get the same output every time (default: random)")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: bv export [options]")
+		fmt.Fprintln(fs.Output(), "\nWrite the issues as JSONL. With --anonymize, dependencies, counts and sizes are kept,")
+		fmt.Fprintln(fs.Output(), "so the file reproduces performance problems without revealing the project.")
+		fs.PrintDefaults()
+	}
+	return func(args []string) error {
+		if *seed != "" && !*anonymize {
+			return fmt.Errorf("--seed needs --anonymize")
+		}
+
+		issues, err := loader.LoadIssues("")
+		if err != nil {
+			return err
+		}
+		if *anonymize {
+			key := []byte(*seed)
+			if len(key) == 0 {
+				key = make([]byte, 32)
+				if _, err := rand.Read(key); err != nil {
+					return fmt.Errorf("generating key: %w", err)
+				}
+			}
+			issues = export.Anonymize(issues, key)
+		}
+
+		w := io.Writer(os.Stdout)
+		var f *os.File
+		if *output != "" {
+			if f, err = os.Create(*output); err != nil {
+				return fmt.Errorf("creating %s: %w", *output, err)
+			}
+			defer f.Close()
+			w = f
+		}
+		bw := bufio.NewWriter(w)
+		enc := json.NewEncoder(bw)
+		for i := range issues {
+			if err := enc.Encode(issues[i]); err != nil {
+				return fmt.Errorf("encoding %s: %w", issues[i].ID, err)
+			}
+		}
+		if err := bw.Flush(); err != nil {
+			return fmt.Errorf("writing issues: %w", err)
+		}
+		if f != nil {
+			if err := f.Close(); err != nil {
+				return fmt.Errorf("writing %s: %w", *output, err)
+			}
+			fmt.Fprintf(os.Stderr, "Wrote %d issues to %s\n", len(issues), *output)
+		}
+		return nil
+	}
+}
+
+// startControlServer lets `bv remote` in the current directory reach p.
+func startControlServer(p *tea.Program) (*ui.ControlServer, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	path, err := ui.ControlPath(wd)
+	if err != nil {
+		return nil, err
+	}
+	return ui.StartControlServer(path, p.Send)
+}
+
+// remoteCommand defines bv remote, which sends a command to the TUI running
+// in a project with --control, e.g. to jump to an issue from an editor.
+func remoteCommand(fs *flag.FlagSet) func(args []string) error {
+	dir := fs.String("dir", "", "Project directory the TUI was started in (default: the current directory)")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: bv remote [options] <command> [args]")
+		fmt.Fprintln(fs.Output(), "\nControl the bv running in this project with --control. Commands:")
+		fmt.Fprintln(fs.Output(), "  focus ID        select an issue")
+		fmt.Fprintln(fs.Output(), "  filter TERMS    set the list filter, e.g. filter open label:api")
+		fmt.Fprintln(fs.Output(), "  refresh         reload the issues")
+		fs.PrintDefaults()
+	}
+	return func(args []string) error {
+		if len(args) == 0 {
+			fs.Usage()
+			os.Exit(2)
+		}
+		req := ui.ControlRequest{Command: args[0], Args: args[1:]}
+		if err := ui.ValidateControlRequest(req); err != nil {
+			return err
+		}
+		if *dir == "" {
+			wd, err := os.Getwd()
+			if err != nil {
+				return err
+			}
+			*dir = wd
+		}
+		path, err := ui.ControlPath(*dir)
+		if err != nil {
+			return err
+		}
+		return ui.SendControl(path, req)
+	}
+}
+
+// statusCounts is what `bv status` reports about the issues in scope.
+type statusCounts struct {
+	Open    int `json:"open"`
+	Ready   int `json:"ready"`
+	Blocked int `json:"blocked"`
+	P0      int `json:"p0"` // Open P0 issues
+}
+
+// countStatus counts the issues matching scope, a filter in TUI terms, with
+// the filter engine of the list view.
+func countStatus(issues []model.Issue, scope string) (statusCounts, error) {
+	var counts statusCounts
+	terms := []struct {
+		filter string
+		count  *int
+	}{
+		{"open", &counts.Open},
+		{"ready", &counts.Ready},
+		{"blocked:true", &counts.Blocked},
+		{"open priority:0", &counts.P0},
+	}
+	issueMap := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+	for _, term := range terms {
+		f, err := beadsview.ParseFilter("("+scope+") AND "+term.filter, nil)
+		if err != nil {
+			return counts, err
+		}
+		for _, issue := range issues {
+			if f.Match(issue, issueMap) {
+				*term.count++
+			}
+		}
+	}
+	return counts, nil
+}
+
+// formatStatusLine renders counts for a status bar. The tmux format uses
+// #[...] styles, and shows open P0 issues as an alert.
+func formatStatusLine(counts statusCounts, format string) (string, error) {
+	switch format {
+	case "plain":
+		line := fmt.Sprintf("%d open · %d ready · %d blocked", counts.Open, counts.Ready, counts.Blocked)
+		if counts.P0 > 0 {
+			line += fmt.Sprintf(" · %d P0", counts.P0)
+		}
+		return line, nil
+	case "tmux":
+		line := fmt.Sprintf("%d open #[fg=green]%d ready#[default]", counts.Open, counts.Ready)
+		if counts.Blocked > 0 {
+			line += fmt.Sprintf(" #[fg=yellow]%d blocked#[default]", counts.Blocked)
+		} else {
+			line += " 0 blocked"
+		}
+		if counts.P0 > 0 {
+			line += fmt.Sprintf(" #[fg=white,bg=red,bold] %d P0 #[default]", counts.P0)
+		}
+		return line, nil
+	case "json":
+		data, err := json.Marshal(counts)
+		return string(data), err
+	}
+	return "", fmt.Errorf("unknown format %q (want plain, tmux or json)", format)
+}
+
+// statusCommand defines bv status, a one-line summary of the issues for tmux
+// or zellij status bars, which run it every few seconds.
+func statusCommand(fs *flag.FlagSet) func(args []string) error {
+	format := fs.String("format", "plain", "Output format: plain, tmux or json")
+	scope := fs.String("filter", "all", "Only count issues matching this filter, in TUI filter terms (e.g. \"label:api\")")
+	dir := fs.String("dir", ".", "Project directory")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: bv status [options]")
+		fmt.Fprintln(fs.Output(), "\nPrint the open, ready and blocked issue counts on one line, with open P0")
+		fmt.Fprintln(fs.Output(), "issues as an alert. In ~/.tmux.conf:")
+		fmt.Fprintln(fs.Output(), "  set -g status-right '#(cd #{pane_current_path} && bv status --format tmux)'")
+		fs.PrintDefaults()
+	}
+	return func(args []string) error {
+		if len(args) != 0 {
+			fs.Usage()
+			os.Exit(2)
+		}
+		if err := beadsview.ValidateFilter(*scope); err != nil {
+			return fmt.Errorf("invalid --filter: %w", err)
+		}
+		if _, err := formatStatusLine(statusCounts{}, *format); err != nil {
+			return err
+		}
+
+		issues, err := beadsview.Load(*dir)
+		if err != nil {
+			return err
+		}
+		counts, err := countStatus(issues, *scope)
+		if err != nil {
+			return err
+		}
+		line, err := formatStatusLine(counts, *format)
+		if err != nil {
+			return err
+		}
+		fmt.Println(line)
+		return nil
+	}
+}
+
+// reportCommand defines bv report, a self-contained HTML page on the issues
+// to print or attach to an email.
+func reportCommand(fs *flag.FlagSet) func(args []string) error {
+	output := fs.String("o", "", "Write to this file instead of stdout")
+	title := fs.String("title", "", "Report heading (default: the project directory's name)")
+	scope := fs.String("filter", "all", "Only report issues matching this filter, in TUI filter terms (e.g. \"label:api\")")
+	dir := fs.String("dir", ".", "Project directory")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: bv report [options]")
+		fmt.Fprintln(fs.Output(), "\nWrite an HTML page with issue counts, charts, the dependency graph and a table")
+		fmt.Fprintln(fs.Output(), "per status. It needs no other files or network and prints cleanly.")
+		fs.PrintDefaults()
+	}
+	return func(args []string) error {
+		if len(args) != 0 {
+			fs.Usage()
+			os.Exit(2)
+		}
+		if err := beadsview.ValidateFilter(*scope); err != nil {
+			return fmt.Errorf("invalid --filter: %w", err)
+		}
+
+		issues, err := beadsview.Load(*dir)
+		if err != nil {
+			return err
+		}
+		opts := export.HTMLReportOptions{Title: *title, GeneratedAt: time.Now()}
+		if *scope != "all" {
+			issues = beadsview.FilterIssues(issues, *scope, beadsview.SortDefault)
+			opts.Filter = *scope
+		}
+		if opts.Title == "" {
+			if abs, err := filepath.Abs(*dir); err == nil {
+				opts.Title = filepath.Base(abs)
+			}
+		}
+		opts.Issues = issues
+		stats := analysis.NewAnalyzer(issues).Analyze()
+		opts.Stats = &stats
+		opts.DataHash = analysis.ComputeDataHash(issues)
+
+		w := io.Writer(os.Stdout)
+		var f *os.File
+		if *output != "" {
+			if f, err = os.Create(*output); err != nil {
+				return fmt.Errorf("creating %s: %w", *output, err)
+			}
+			defer f.Close()
+			w = f
+		}
+		bw := bufio.NewWriter(w)
+		if err := export.WriteHTMLReport(bw, opts); err != nil {
+			return err
+		}
+		if err := bw.Flush(); err != nil {
+			return fmt.Errorf("writing report: %w", err)
+		}
+		if f != nil {
+			if err := f.Close(); err != nil {
+				return fmt.Errorf("writing %s: %w", *output, err)
+			}
+			fmt.Fprintf(os.Stderr, "Wrote a report on %d issues to %s\n", len(issues), *output)
+		}
+		return nil
+	}
+}
+
+// serveSSHCommand defines bv serve-ssh, the TUI over SSH: every connection
+// gets its own read-only session on the project's issues, following live
+// reload.
+func serveSSHCommand(fs *flag.FlagSet) func(args []string) error {
+	addr := fs.String("addr", "127.0.0.1:2222", "Address to listen on (use :PORT to share on the network)")
+	hostKey := fs.String("host-key", "", "SSH host key file, created if missing (default: .bv/ssh_host_ed25519)")
+	authorizedKeys := fs.String("authorized-keys", "", "Only accept the public keys in this authorized_keys file")
+	reducedMotion := fs.Bool("reduced-motion", false, "Serve sessions without spinners or sub-second refresh ticks (also BV_REDUCED_MOTION=1)")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: bv serve-ssh [options]")
+		fmt.Fprintln(fs.Output(), "\nServe a read-only TUI over SSH; connect with: ssh -p PORT HOST")
+		fs.PrintDefaults()
+	}
+	return func(args []string) error {
+
+		// Fail early if there is nothing to serve.
+		if _, err := loader.LoadIssues(""); err != nil {
+			return err
+		}
+		beadsDir, err := loader.GetBeadsDir("")
+		if err != nil {
+			return err
+		}
+		_, beadsPath, _ := loader.SelectBackend(beadsDir)
+		if *hostKey == "" {
+			*hostKey = filepath.Join(filepath.Dir(beadsDir), ".bv", "ssh_host_ed25519")
+		}
+		if err := os.MkdirAll(filepath.Dir(*hostKey), 0o700); err != nil {
+			return fmt.Errorf("creating host key directory: %w", err)
+		}
+
+		// Sessions render through this process's lipgloss renderer, which may
+		// not have a terminal to detect colors from; assume a typical client.
+		lipgloss.SetColorProfile(termenv.ANSI256)
+		lipgloss.SetHasDarkBackground(true)
+
+		// Sessions see each other's selections (see ui.Presence).
+		presence := ui.NewPresence()
+
+		handler := func(s ssh.Session) (tea.Model, []tea.ProgramOption) {
+			issues, err := loader.LoadIssues("")
+			if err != nil {
+				wish.Fatalln(s, "Error loading beads:", err)
+				return nil, nil
+			}
+			// The client's TERM decides what the session gets, except colors,
+			// which the shared renderer above fixes for every session.
+			pty, _, _ := s.Pty()
+			terminal := ui.TerminalFor(pty.Term)
+			m := ui.NewModel(issues, nil, beadsPath)
+			m.EnableReadOnly()
+			m.EnableAccessible(terminal.Plain)
+			m.EnableReducedMotion(*reducedMotion || os.Getenv("BV_REDUCED_MOTION") == "1" || !terminal.Motion)
+			participant := presence.Join(s.User())
+			m.EnablePresence(participant)
+			go func() {
+				<-s.Context().Done()
+				participant.Leave()
+				m.Stop()
+			}()
+			return m, terminal.ProgramOptions()
+		}
+		opts := []ssh.Option{
+			wish.WithAddress(*addr),
+			wish.WithHostKeyPath(*hostKey),
+			wish.WithMiddleware(
+				wishtea.Middleware(handler),
+				activeterm.Middleware(),
+				logging.Middleware(),
+			),
+		}
+		if *authorizedKeys != "" {
+			opts = append(opts, wish.WithAuthorizedKeys(*authorizedKeys))
+		}
+		server, err := wish.NewServer(opts...)
+		if err != nil {
+			return err
+		}
+		if *authorizedKeys == "" {
+			fmt.Fprintln(os.Stderr, "Warning: no --authorized-keys given; anyone who can reach the port can connect")
+		}
+		fmt.Fprintf(os.Stderr, "Serving a read-only TUI on ssh://%s (Ctrl+C to stop)\n", *addr)
+		return server.ListenAndServe()
+	}
+}
+
 // runPagesWizard runs the interactive deployment wizard (bv-10g).
 func runPagesWizard(issues []model.Issue, beadsPath string) error {
 	wizard := export.NewWizard(beadsPath)
@@ -94,3 +94,44 @@ func buildTestBinary(t *testing.T) string {
 	}
 	return exe
 }
+
+func TestJSONListAppliesFilterAndSort(t *testing.T) {
+	dir := t.TempDir()
+	beadsDir := filepath.Join(dir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0o755); err != nil {
+		t.Fatalf("mkdir beads: %v", err)
+	}
+	beads := `{"id":"L-1","title":"A","status":"open","priority":2,"issue_type":"task","labels":["api"]}
+{"id":"L-2","title":"B","status":"in_progress","priority":1,"issue_type":"task","labels":["api"]}
+{"id":"L-3","title":"C","status":"closed","priority":0,"issue_type":"task","labels":["api"]}
+`
+	if err := os.WriteFile(filepath.Join(beadsDir, "beads.jsonl"), []byte(beads), 0o644); err != nil {
+		t.Fatalf("write beads: %v", err)
+	}
+
+	exe := buildTestBinary(t)
+	cmd := exec.Command(exe, "--json", "--filter", "open label=api", "--sort", "priority")
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("--json failed: %v, out=%s", err, string(out))
+	}
+	var payload struct {
+		Count  int `json:"count"`
+		Issues []struct {
+			ID string `json:"id"`
+		} `json:"issues"`
+	}
+	if err := json.Unmarshal(out, &payload); err != nil {
+		t.Fatalf("json: %v", err)
+	}
+	if payload.Count != 2 || payload.Issues[0].ID != "L-2" || payload.Issues[1].ID != "L-1" {
+		t.Fatalf("unexpected issues: %s", out)
+	}
+
+	cmd = exec.Command(exe, "--json", "--filter", "status=nope=x priority:9")
+	cmd.Dir = dir
+	if err := cmd.Run(); err == nil {
+		t.Fatal("expected an invalid filter to fail")
+	}
+}
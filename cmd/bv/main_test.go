@@ -268,3 +268,41 @@ func repoRoot(t *testing.T) string {
 		dir = parent
 	}
 }
+
+func TestStatusLine(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A-1", Status: model.StatusOpen, Priority: 0, Labels: []string{"api"}},
+		{ID: "A-2", Status: model.StatusOpen, Priority: 1, Dependencies: []*model.Dependency{{IssueID: "A-2", DependsOnID: "A-1", Type: model.DepBlocks}}},
+		{ID: "A-3", Status: model.StatusBlocked, Priority: 0},
+		{ID: "A-4", Status: model.StatusClosed, Priority: 0, Labels: []string{"api"}},
+	}
+	counts, err := countStatus(issues, "all")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (statusCounts{Open: 3, Ready: 1, Blocked: 2, P0: 2}); counts != want {
+		t.Fatalf("countStatus() = %+v, want %+v", counts, want)
+	}
+	if counts, _ := countStatus(issues, "label:api"); counts != (statusCounts{Open: 1, Ready: 1, P0: 1}) {
+		t.Errorf("expected the filter to scope the counts, got %+v", counts)
+	}
+
+	tests := []struct {
+		counts statusCounts
+		format string
+		want   string
+	}{
+		{counts, "plain", "3 open · 1 ready · 2 blocked · 2 P0"},
+		{counts, "tmux", "3 open #[fg=green]1 ready#[default] #[fg=yellow]2 blocked#[default] #[fg=white,bg=red,bold] 2 P0 #[default]"},
+		{statusCounts{Open: 1, Ready: 1}, "tmux", "1 open #[fg=green]1 ready#[default] 0 blocked"},
+		{counts, "json", `{"open":3,"ready":1,"blocked":2,"p0":2}`},
+	}
+	for _, tt := range tests {
+		if got, err := formatStatusLine(tt.counts, tt.format); err != nil || got != tt.want {
+			t.Errorf("%s: got %q, %v; want %q", tt.format, got, err, tt.want)
+		}
+	}
+	if _, err := formatStatusLine(counts, "zsh"); err == nil {
+		t.Error("expected an unknown format to fail")
+	}
+}
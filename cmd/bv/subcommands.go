@@ -0,0 +1,341 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/ui"
+)
+
+// Subcommands
+//
+// "bv NAME" runs one of subcommands. Most have flags of their own; view,
+// diff and update are spellings of bv's own flags (bv diff REF is
+// bv --diff-since REF), so both keep working. The completion scripts
+// printed by bv completion call the hidden "bv __complete" with the words
+// typed so far, and it answers from the flags of the command and the
+// issues of the project in the current directory.
+
+// subcommand is a "bv NAME" command.
+type subcommand struct {
+	name    string
+	usage   string // Arguments, for --help
+	summary string
+	// define adds the command's flags to fs and returns the function that
+	// runs it with the arguments left after them. Completion calls it to
+	// learn the flags without running anything.
+	define func(fs *flag.FlagSet) func(args []string) error
+	// mainArgs, for spellings of bv's own flags, turns the arguments into
+	// those flags.
+	mainArgs func(args []string) ([]string, error)
+	// complete returns the completions of word, the positional argument
+	// after args.
+	complete func(c *completer, args []string, word string) []string
+}
+
+var subcommands = []subcommand{
+	{name: "view", usage: "[options]", summary: "browse the issues in the TUI (the default)", mainArgs: viewArgs},
+	{name: "diff", usage: "REF [options]", summary: "changes since a commit, branch, tag or date", mainArgs: diffArgs},
+	{name: "update", usage: "[check|rollback] [--yes]", summary: "update bv, check for a new version, or undo an update", mainArgs: updateArgs, complete: completeWordList("check", "rollback")},
+	{name: "export", usage: "[--anonymize] [-o FILE]", summary: "issues as JSONL, optionally anonymized", define: exportCommand},
+	{name: "serve", usage: "[--addr HOST:PORT]", summary: "read-only web UI and JSON API", define: serveCommand},
+	{name: "serve-ssh", usage: "[--addr HOST:PORT]", summary: "read-only TUI over SSH", define: serveSSHCommand},
+	{name: "report", usage: "[-o FILE] [--title TEXT] [--filter TERMS]", summary: "HTML report to print or email", define: reportCommand},
+	{name: "status", usage: "[--format plain|tmux|json] [--filter TERMS]", summary: "one-line summary for status bars", define: statusCommand},
+	{name: "remote", usage: "<focus ID|filter TERMS|refresh>", summary: "control a TUI started with --control", define: remoteCommand, complete: completeRemote},
+	{name: "completion", usage: "bash|zsh|fish", summary: "print a shell completion script", define: completionCommand, complete: completeWordList("bash", "fish", "zsh")},
+}
+
+// completeCommand is the hidden subcommand the completion scripts call.
+const completeCommand = "__complete"
+
+func lookupSubcommand(name string) *subcommand {
+	for i := range subcommands {
+		if subcommands[i].name == name {
+			return &subcommands[i]
+		}
+	}
+	return nil
+}
+
+// run runs the command, or for spellings of bv's own flags returns those
+// flags to parse instead.
+func (c *subcommand) run(args []string) ([]string, error) {
+	if c.mainArgs != nil {
+		return c.mainArgs(args)
+	}
+	fs := flag.NewFlagSet(c.name, flag.ExitOnError)
+	run := c.define(fs)
+	_ = fs.Parse(args)
+	return nil, run(fs.Args())
+}
+
+// printSubcommandUsage lists the subcommands for --help.
+func printSubcommandUsage() {
+	for _, c := range subcommands {
+		fmt.Printf("       bv %s %s   %s\n", c.name, c.usage, c.summary)
+	}
+}
+
+func viewArgs(args []string) ([]string, error) {
+	return args, nil
+}
+
+func diffArgs(args []string) ([]string, error) {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return nil, fmt.Errorf("bv diff needs a commit, branch, tag or date, e.g. bv diff HEAD~5 or bv diff 2024-06-01")
+	}
+	return append([]string{"--diff-since", args[0]}, args[1:]...), nil
+}
+
+func updateArgs(args []string) ([]string, error) {
+	action := "--update"
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		switch args[0] {
+		case "check":
+			action = "--check-update"
+		case "rollback":
+			action = "--rollback"
+		default:
+			return nil, fmt.Errorf("unknown update action %q (want check or rollback)", args[0])
+		}
+		args = args[1:]
+	}
+	return append([]string{action}, args...), nil
+}
+
+// completionCommand defines bv completion, which prints a completion script.
+func completionCommand(fs *flag.FlagSet) func(args []string) error {
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: bv completion bash|zsh|fish")
+		fmt.Fprintln(fs.Output(), "\nPrint a completion script for subcommands, flags, issue IDs and labels. Load it with:")
+		fmt.Fprintln(fs.Output(), "  bash: source <(bv completion bash)       (e.g. in ~/.bashrc)")
+		fmt.Fprintln(fs.Output(), "  zsh:  source <(bv completion zsh)        (in ~/.zshrc, after compinit)")
+		fmt.Fprintln(fs.Output(), "  fish: bv completion fish > ~/.config/fish/completions/bv.fish")
+	}
+	return func(args []string) error {
+		if len(args) != 1 {
+			fs.Usage()
+			os.Exit(2)
+		}
+		script, ok := completionScripts[args[0]]
+		if !ok {
+			return fmt.Errorf("unknown shell %q (want bash, zsh or fish)", args[0])
+		}
+		fmt.Print(script)
+		return nil
+	}
+}
+
+// completionScripts pass the command line to bv __complete and offer what
+// it prints, one candidate per line.
+var completionScripts = map[string]string{
+	"bash": `# bash completion for bv; load with: source <(bv completion bash)
+_bv() {
+    local line="${COMP_LINE:0:COMP_POINT}" words cur
+    read -ra words <<< "$line"
+    if [[ -z "$line" || "$line" == *[[:space:]] ]]; then
+        words+=("")
+    fi
+    cur="${words[${#words[@]}-1]}"
+    local IFS=$'\n'
+    COMPREPLY=($(bv __complete "${words[@]:1}" 2>/dev/null))
+    # bash replaces only the part of the word after the last : or =
+    if [[ "$cur" == *[:=]* ]]; then
+        local head="${cur%"${cur##*[:=]}"}"
+        COMPREPLY=("${COMPREPLY[@]#"$head"}")
+    fi
+}
+complete -o default -F _bv bv
+`,
+	"zsh": `#compdef bv
+# zsh completion for bv; load with: source <(bv completion zsh)
+_bv() {
+    local -a candidates
+    candidates=("${(@f)$(bv __complete "${(@)words[2,CURRENT]}" 2>/dev/null)}")
+    candidates=("${(@)candidates:#}")
+    if (( ${#candidates} )); then
+        compadd -- "${(@)candidates}"
+    else
+        _files
+    fi
+}
+compdef _bv bv
+`,
+	"fish": `# fish completion for bv; load with: bv completion fish | source
+function __bv_complete
+    set -l words (commandline -opc)
+    set -l cur (commandline -ct)
+    bv __complete $words[2..-1] "$cur" 2>/dev/null
+end
+complete -c bv -f -a '(__bv_complete)'
+`,
+}
+
+// completer answers bv __complete, loading the project's issues when a
+// candidate needs them.
+type completer struct {
+	data   ui.CompletionData
+	loaded bool
+}
+
+// tui completes word with the candidates of the TUI command prompt after
+// prefix, such as "select " for issue IDs.
+func (c *completer) tui(prefix, word string) []string {
+	if !c.loaded {
+		c.loaded = true
+		c.data.Issues, _ = loader.LoadIssues("")
+	}
+	var out []string
+	for _, line := range ui.CompleteCommand(prefix+word, c.data) {
+		out = append(out, strings.TrimPrefix(line, prefix))
+	}
+	return out
+}
+
+// issueIDFlag matches the usage of flags that take an issue ID.
+var issueIDFlag = regexp.MustCompile(`\b(issue|bead) ID\b`)
+
+// flagValues completes value, the value of f, from what the flag's name and
+// usage say it takes.
+func (c *completer) flagValues(f *flag.Flag, value string) []string {
+	switch {
+	case f == nil:
+		return nil
+	case f.Name == "filter":
+		return c.tui("filter ", value)
+	case f.Name == "sort":
+		return c.tui("sort ", value)
+	case f.Name == "cmd":
+		return c.tui("", value)
+	case strings.Contains(f.Name, "label"):
+		return c.tui("filter label:", value)
+	case issueIDFlag.MatchString(f.Usage):
+		return c.tui("select ", value)
+	}
+	return nil
+}
+
+// completeWords returns the completions of the last of words, the
+// arguments typed after bv; main holds bv's own flags.
+func completeWords(main *flag.FlagSet, words []string, c *completer) []string {
+	if len(words) == 0 {
+		words = []string{""}
+	}
+	word := words[len(words)-1]
+	prev := words[:len(words)-1]
+	if len(prev) == 0 && !strings.HasPrefix(word, "-") {
+		var names []string
+		for _, cmd := range subcommands {
+			names = append(names, cmd.name)
+		}
+		return matchingWords(names, word)
+	}
+
+	flags := main
+	var cmd *subcommand
+	if len(prev) > 0 {
+		if cmd = lookupSubcommand(prev[0]); cmd != nil {
+			prev = prev[1:]
+			if cmd.define != nil {
+				flags = flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+				cmd.define(flags)
+			}
+		}
+	}
+
+	if name, value, ok := strings.Cut(word, "="); ok && strings.HasPrefix(name, "-") {
+		var out []string
+		for _, v := range c.flagValues(flags.Lookup(strings.TrimLeft(name, "-")), value) {
+			out = append(out, name+"="+v)
+		}
+		return out
+	}
+	var args []string
+	for i := 0; i < len(prev); i++ {
+		if !strings.HasPrefix(prev[i], "-") {
+			args = append(args, prev[i])
+			continue
+		}
+		if f := flagTakingValue(flags, prev[i]); f != nil {
+			if i == len(prev)-1 {
+				return c.flagValues(f, word)
+			}
+			i++ // Skip the value
+		}
+	}
+	if strings.HasPrefix(word, "-") {
+		var names []string
+		flags.VisitAll(func(f *flag.Flag) {
+			if len(f.Name) == 1 {
+				names = append(names, "-"+f.Name)
+			} else {
+				names = append(names, "--"+f.Name)
+			}
+		})
+		return matchingWords(names, word)
+	}
+	if cmd != nil && cmd.complete != nil {
+		return cmd.complete(c, args, word)
+	}
+	return nil
+}
+
+// flagTakingValue returns the flag arg names when it takes its value from
+// the next argument.
+func flagTakingValue(flags *flag.FlagSet, arg string) *flag.Flag {
+	if strings.Contains(arg, "=") {
+		return nil
+	}
+	f := flags.Lookup(strings.TrimLeft(arg, "-"))
+	if f == nil {
+		return nil
+	}
+	if b, ok := f.Value.(interface{ IsBoolFlag() bool }); ok && b.IsBoolFlag() {
+		return nil
+	}
+	return f
+}
+
+// completeWordList completes a command's first argument from words.
+func completeWordList(words ...string) func(*completer, []string, string) []string {
+	return func(_ *completer, args []string, word string) []string {
+		if len(args) > 0 {
+			return nil
+		}
+		return matchingWords(words, word)
+	}
+}
+
+// completeRemote completes a command for bv remote and its arguments.
+func completeRemote(c *completer, args []string, word string) []string {
+	if len(args) == 0 {
+		return matchingWords(ui.ControlCommands, word)
+	}
+	switch args[0] {
+	case "focus":
+		if len(args) == 1 {
+			return c.tui("select ", word)
+		}
+	case "filter":
+		return c.tui(strings.Join(args, " ")+" ", word)
+	}
+	return nil
+}
+
+// matchingWords returns the words starting with prefix, sorted.
+func matchingWords(words []string, prefix string) []string {
+	var out []string
+	for _, w := range words {
+		if strings.HasPrefix(w, prefix) {
+			out = append(out, w)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
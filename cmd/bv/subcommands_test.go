@@ -0,0 +1,90 @@
+package main
+
+import (
+	"flag"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/ui"
+)
+
+func TestSubcommandMainArgs(t *testing.T) {
+	tests := []struct {
+		cmd     string
+		args    []string
+		want    []string
+		wantErr bool
+	}{
+		{"view", []string{"--json"}, []string{"--json"}, false},
+		{"diff", []string{"HEAD~5", "--robot-diff"}, []string{"--diff-since", "HEAD~5", "--robot-diff"}, false},
+		{"diff", []string{"--robot-diff"}, nil, true},
+		{"update", nil, []string{"--update"}, false},
+		{"update", []string{"--yes"}, []string{"--update", "--yes"}, false},
+		{"update", []string{"check"}, []string{"--check-update"}, false},
+		{"update", []string{"rollback", "--yes"}, []string{"--rollback", "--yes"}, false},
+		{"update", []string{"later"}, nil, true},
+	}
+	for _, tt := range tests {
+		got, err := lookupSubcommand(tt.cmd).run(tt.args)
+		if (err != nil) != tt.wantErr || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s %q: got %q, %v; want %q", tt.cmd, tt.args, got, err, tt.want)
+		}
+	}
+}
+
+func TestCompleteWords(t *testing.T) {
+	main := flag.NewFlagSet("bv", flag.ContinueOnError)
+	main.Bool("json", false, "Print JSON")
+	main.String("filter", "all", "Filter")
+	main.String("label", "", "Scope to a label")
+	main.String("robot-blocker-chain", "", "Blocker chain for issue ID as JSON")
+	main.String("r", "", "Shorthand for --recipe")
+	c := &completer{loaded: true, data: ui.CompletionData{Issues: []model.Issue{
+		{ID: "A-1", Status: model.StatusOpen, Labels: []string{"api"}},
+		{ID: "A-2", Status: model.StatusOpen, Labels: []string{"auth"}},
+	}}}
+
+	tests := []struct {
+		words []string
+		want  []string
+	}{
+		{[]string{"s"}, []string{"serve", "serve-ssh", "status"}},
+		{[]string{"--"}, []string{"--filter", "--json", "--label", "--robot-blocker-chain"}},
+		{[]string{"-"}, []string{"--filter", "--json", "--label", "--robot-blocker-chain", "-r"}},
+		{[]string{"--filter", "label:a"}, []string{"label:api", "label:auth"}},
+		{[]string{"--filter=label:au"}, []string{"--filter=label:auth"}},
+		{[]string{"--label", ""}, []string{"api", "auth"}},
+		{[]string{"--robot-blocker-chain", "A-"}, []string{"A-1", "A-2"}},
+		{[]string{"--json", ""}, nil},
+		{[]string{"--json", "--label", "au"}, []string{"auth"}},
+		{[]string{"status", "--f"}, []string{"--filter", "--format"}},
+		{[]string{"status", "--filter", "label:ap"}, []string{"label:api"}},
+		{[]string{"remote", ""}, []string{"filter", "focus", "refresh"}},
+		{[]string{"remote", "--dir", "/tmp", "focus", ""}, []string{"A-1", "A-2"}},
+		{[]string{"remote", "focus", "A-1", ""}, nil},
+		{[]string{"update", ""}, []string{"check", "rollback"}},
+		{[]string{"completion", "f"}, []string{"fish"}},
+		{[]string{"diff", "--fi"}, []string{"--filter"}},
+	}
+	for _, tt := range tests {
+		if got := completeWords(main, tt.words, c); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%q: got %q, want %q", tt.words, got, tt.want)
+		}
+	}
+	if got := completeWords(main, nil, c); len(got) != len(subcommands) {
+		t.Errorf("expected every subcommand for an empty line, got %q", got)
+	}
+	if got := completeWords(main, []string{"remote", "filter", "open", "label:a"}, c); !reflect.DeepEqual(got, []string{"label:api", "label:auth"}) {
+		t.Errorf("expected remote filter to complete filter terms, got %q", got)
+	}
+}
+
+func TestCompletionScripts(t *testing.T) {
+	for _, shell := range []string{"bash", "zsh", "fish"} {
+		if !strings.Contains(completionScripts[shell], "bv "+completeCommand) {
+			t.Errorf("%s: expected the script to call bv %s", shell, completeCommand)
+		}
+	}
+}
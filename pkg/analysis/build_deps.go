@@ -0,0 +1,530 @@
+package analysis
+
+import (
+	"bufio"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// Build unit kinds understood by LoadBuildGraph.
+const (
+	BuildKindGo    = "go"
+	BuildKindBazel = "bazel"
+)
+
+// BuildUnit is one node of a repository's build graph: a Go package or a
+// Bazel target.
+type BuildUnit struct {
+	Name string   `json:"name"` // Import path or Bazel label
+	Kind string   `json:"kind"`
+	Dir  string   `json:"dir"`  // Slash-separated, relative to the repository root
+	Deps []string `json:"deps"` // Names of units in the same graph
+}
+
+// BuildGraph holds the build units of a repository and the edges between
+// them. Dependencies outside the repository are not included.
+type BuildGraph struct {
+	Units map[string]*BuildUnit
+	byDir map[string][]*BuildUnit
+}
+
+// NewBuildGraph indexes units, dropping dependencies on units that are not
+// part of the graph.
+func NewBuildGraph(units []*BuildUnit) *BuildGraph {
+	g := &BuildGraph{
+		Units: make(map[string]*BuildUnit, len(units)),
+		byDir: make(map[string][]*BuildUnit),
+	}
+	for _, u := range units {
+		g.Units[u.Name] = u
+		g.byDir[u.Dir] = append(g.byDir[u.Dir], u)
+	}
+	for _, u := range units {
+		deps := u.Deps[:0]
+		for _, d := range u.Deps {
+			if _, ok := g.Units[d]; ok && d != u.Name {
+				deps = append(deps, d)
+			}
+		}
+		u.Deps = deps
+	}
+	return g
+}
+
+// LoadBuildGraph reads the build files under root for the given kinds
+// ("go" reads go.mod modules and the imports of their packages, "bazel"
+// reads BUILD and BUILD.bazel files).
+func LoadBuildGraph(root string, kinds []string) (*BuildGraph, error) {
+	var units []*BuildUnit
+	for _, kind := range kinds {
+		var (
+			found []*BuildUnit
+			err   error
+		)
+		switch kind = strings.TrimSpace(kind); kind {
+		case BuildKindGo:
+			found, err = loadGoUnits(root)
+		case BuildKindBazel:
+			found, err = loadBazelUnits(root)
+		default:
+			return nil, fmt.Errorf("unknown build graph kind %q (use: go, bazel)", kind)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading %s build graph: %w", kind, err)
+		}
+		units = append(units, found...)
+	}
+	return NewBuildGraph(units), nil
+}
+
+// walkBuildTree visits the directories under root that can hold sources,
+// skipping VCS metadata, vendored code, test fixtures and Bazel output links.
+func walkBuildTree(root string, visit func(dir, rel string) error) error {
+	return filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		name := d.Name()
+		if p != root && (strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") ||
+			strings.HasPrefix(name, "bazel-") || name == "vendor" || name == "node_modules" || name == "testdata") {
+			return filepath.SkipDir
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		return visit(p, filepath.ToSlash(rel))
+	})
+}
+
+var goModuleLine = regexp.MustCompile(`^module\s+"?([^"\s]+)"?`)
+
+// loadGoUnits returns a unit per Go package of every module under root.
+// Each package depends on the packages it imports (tests excluded).
+func loadGoUnits(root string) ([]*BuildUnit, error) {
+	modules := make(map[string]string) // Module root (rel) -> module path
+	pkgDirs := make(map[string]string) // Package dir (rel) -> absolute dir
+	err := walkBuildTree(root, func(dir, rel string) error {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			switch name := e.Name(); {
+			case name == "go.mod":
+				modPath, err := readGoModulePath(filepath.Join(dir, name))
+				if err != nil {
+					return err
+				}
+				if modPath != "" {
+					modules[rel] = modPath
+				}
+			case strings.HasSuffix(name, ".go") && !strings.HasSuffix(name, "_test.go"):
+				pkgDirs[rel] = dir
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var units []*BuildUnit
+	fset := token.NewFileSet()
+	for rel, dir := range pkgDirs {
+		importPath := goImportPath(modules, rel)
+		if importPath == "" {
+			continue // Not inside a module
+		}
+		unit := &BuildUnit{Name: importPath, Kind: BuildKindGo, Dir: rel}
+		files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+		if err != nil {
+			return nil, err
+		}
+		seen := make(map[string]bool)
+		for _, file := range files {
+			if strings.HasSuffix(file, "_test.go") {
+				continue
+			}
+			f, err := parser.ParseFile(fset, file, nil, parser.ImportsOnly)
+			if err != nil {
+				continue // Unparseable files don't contribute edges
+			}
+			for _, imp := range f.Imports {
+				p := strings.Trim(imp.Path.Value, `"`)
+				if !seen[p] {
+					seen[p] = true
+					unit.Deps = append(unit.Deps, p)
+				}
+			}
+		}
+		sort.Strings(unit.Deps)
+		units = append(units, unit)
+	}
+	return units, nil
+}
+
+func readGoModulePath(file string) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if m := goModuleLine.FindStringSubmatch(strings.TrimSpace(scanner.Text())); m != nil {
+			return m[1], nil
+		}
+	}
+	return "", scanner.Err()
+}
+
+// goImportPath returns the import path of the package in dir, using the
+// nearest enclosing module.
+func goImportPath(modules map[string]string, dir string) string {
+	for d := dir; ; d = path.Dir(d) {
+		if modPath, ok := modules[d]; ok {
+			if d == dir {
+				return modPath
+			}
+			suffix := strings.TrimPrefix(dir, d+"/")
+			if d == "." {
+				suffix = dir
+			}
+			return modPath + "/" + suffix
+		}
+		if d == "." || d == "/" {
+			return ""
+		}
+	}
+}
+
+var (
+	bazelRuleStart = regexp.MustCompile(`(?m)^[A-Za-z_][A-Za-z0-9_.]*\s*\(`)
+	bazelNameAttr  = regexp.MustCompile(`\bname\s*=\s*"([^"]+)"`)
+	bazelDepsAttr  = regexp.MustCompile(`\b(?:deps|runtime_deps)\s*=\s*\[([^\]]*)\]`)
+	bazelString    = regexp.MustCompile(`"([^"]+)"`)
+)
+
+// loadBazelUnits returns a unit per named rule in the BUILD files under root.
+// Only literal deps lists are read; macros and select() are not evaluated.
+func loadBazelUnits(root string) ([]*BuildUnit, error) {
+	var units []*BuildUnit
+	err := walkBuildTree(root, func(dir, rel string) error {
+		for _, name := range []string{"BUILD.bazel", "BUILD"} {
+			data, err := os.ReadFile(filepath.Join(dir, name))
+			if os.IsNotExist(err) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			pkg := rel
+			if pkg == "." {
+				pkg = ""
+			}
+			units = append(units, parseBazelBuild(string(data), pkg)...)
+			return nil // BUILD.bazel takes precedence over BUILD
+		}
+		return nil
+	})
+	return units, err
+}
+
+func parseBazelBuild(src, pkg string) []*BuildUnit {
+	src = stripBazelComments(src)
+	starts := bazelRuleStart.FindAllStringIndex(src, -1)
+	dir := pkg
+	if dir == "" {
+		dir = "."
+	}
+	var units []*BuildUnit
+	for i, loc := range starts {
+		end := len(src)
+		if i+1 < len(starts) {
+			end = starts[i+1][0]
+		}
+		body := src[loc[1]:end]
+		name := bazelNameAttr.FindStringSubmatch(body)
+		if name == nil {
+			continue
+		}
+		unit := &BuildUnit{Name: "//" + pkg + ":" + name[1], Kind: BuildKindBazel, Dir: dir}
+		for _, deps := range bazelDepsAttr.FindAllStringSubmatch(body, -1) {
+			for _, s := range bazelString.FindAllStringSubmatch(deps[1], -1) {
+				if label := normalizeBazelLabel(s[1], pkg); label != "" {
+					unit.Deps = append(unit.Deps, label)
+				}
+			}
+		}
+		units = append(units, unit)
+	}
+	return units
+}
+
+// stripBazelComments removes "#" comments outside string literals.
+func stripBazelComments(src string) string {
+	var sb strings.Builder
+	for _, line := range strings.Split(src, "\n") {
+		inString := false
+		cut := len(line)
+		for i := 0; i < len(line); i++ {
+			if line[i] == '"' {
+				inString = !inString
+			} else if line[i] == '#' && !inString {
+				cut = i
+				break
+			}
+		}
+		sb.WriteString(line[:cut])
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// normalizeBazelLabel expands label to the "//pkg:name" form, relative to
+// pkg. Labels in external repositories and wildcards return "".
+func normalizeBazelLabel(label, pkg string) string {
+	label = strings.TrimPrefix(label, "@//")
+	switch {
+	case strings.HasPrefix(label, "@"), strings.HasSuffix(label, "/..."), strings.HasSuffix(label, ":all"):
+		return ""
+	case strings.HasPrefix(label, "//"):
+		if !strings.Contains(label, ":") {
+			return label + ":" + path.Base(label)
+		}
+		return label
+	case strings.HasPrefix(label, ":"):
+		return "//" + pkg + label
+	case !strings.Contains(label, "/") && label != "":
+		return "//" + pkg + ":" + label
+	}
+	return ""
+}
+
+// BuildDependencyConfig configures build graph dependency suggestions.
+type BuildDependencyConfig struct {
+	// Graph is the repository build graph; nil disables the detector.
+	Graph *BuildGraph
+
+	// LinkedFiles maps issue IDs to files changed by commits or PRs linked
+	// to the issue.
+	LinkedFiles map[string][]string
+
+	// MinConfidence is the minimum confidence to report
+	// Default: 0.5
+	MinConfidence float64
+
+	// MaxSuggestions limits the number of suggestions
+	// Default: 20
+	MaxSuggestions int
+}
+
+// DefaultBuildDependencyConfig returns sensible defaults without a graph.
+func DefaultBuildDependencyConfig() BuildDependencyConfig {
+	return BuildDependencyConfig{
+		MinConfidence:  0.5,
+		MaxSuggestions: 20,
+	}
+}
+
+// buildRef is a build unit an issue refers to and how the reference was found.
+type buildRef struct {
+	unit      *BuildUnit
+	mentioned bool // Named in the issue text, not only in linked changes
+}
+
+var buildTokenTrim = "`'\"()[]{}<>,;.!?*"
+
+// issueBuildRefs returns the units an issue names in its text (import paths,
+// directories, file paths, Bazel labels) or touches through linked files.
+func issueBuildRefs(g *BuildGraph, issue *model.Issue, linked []string) map[string]buildRef {
+	refs := make(map[string]buildRef)
+	add := func(units []*BuildUnit, mentioned bool) {
+		for _, u := range units {
+			if prev, ok := refs[u.Name]; !ok || (mentioned && !prev.mentioned) {
+				refs[u.Name] = buildRef{unit: u, mentioned: mentioned}
+			}
+		}
+	}
+
+	text := strings.Join([]string{issue.Title, issue.Description, issue.Design, issue.AcceptanceCriteria, issue.Notes}, "\n")
+	for _, tok := range strings.Fields(text) {
+		tok = strings.TrimRight(strings.Trim(tok, buildTokenTrim), ":")
+		if !strings.Contains(tok, "/") {
+			continue
+		}
+		if strings.HasPrefix(tok, "//") || strings.HasPrefix(tok, "@//") {
+			if u, ok := g.Units[normalizeBazelLabel(tok, "")]; ok {
+				add([]*BuildUnit{u}, true)
+			}
+			continue
+		}
+		if u, ok := g.Units[tok]; ok {
+			add([]*BuildUnit{u}, true)
+			continue
+		}
+		p := strings.TrimPrefix(tok, "./")
+		if units := g.byDir[p]; len(units) > 0 {
+			add(units, true)
+		} else if path.Ext(p) != "" {
+			add(g.unitsForFile(p), true)
+		}
+	}
+	for _, file := range linked {
+		add(g.unitsForFile(file), false)
+	}
+	return refs
+}
+
+// unitsForFile returns the units of the nearest directory at or above the
+// file's directory that has any. The repository root only matches files
+// directly inside it.
+func (g *BuildGraph) unitsForFile(file string) []*BuildUnit {
+	dir := path.Dir(filepath.ToSlash(file))
+	if dir == "." {
+		return g.byDir["."]
+	}
+	for ; dir != "." && dir != "/"; dir = path.Dir(dir) {
+		if units := g.byDir[dir]; len(units) > 0 {
+			return units
+		}
+	}
+	return nil
+}
+
+// buildEvidence collects the build edges behind one suggested dependency.
+type buildEvidence struct {
+	from, to  string
+	edges     []string
+	mentioned bool // Both ends were named in issue text
+}
+
+// DetectBuildDependencies suggests issue dependencies that mirror the build
+// graph: when issue A refers to a unit that depends on a unit issue B refers
+// to, A probably depends on B. Suggestions carry the "bd dep add" command to
+// create the edge once reviewed.
+func DetectBuildDependencies(issues []model.Issue, config BuildDependencyConfig) []Suggestion {
+	g := config.Graph
+	if g == nil || len(issues) < 2 {
+		return nil
+	}
+
+	existing := make(map[[2]string]bool)
+	unitIssues := make(map[string][]int) // Unit name -> indices of issues referring to it
+	refs := make([]map[string]buildRef, len(issues))
+	for i := range issues {
+		issue := &issues[i]
+		for _, dep := range issue.Dependencies {
+			if dep != nil {
+				existing[[2]string{issue.ID, dep.DependsOnID}] = true
+				existing[[2]string{dep.DependsOnID, issue.ID}] = true
+			}
+		}
+		if isClosedLikeStatus(issue.Status) {
+			continue
+		}
+		refs[i] = issueBuildRefs(g, issue, config.LinkedFiles[issue.ID])
+		for name := range refs[i] {
+			unitIssues[name] = append(unitIssues[name], i)
+		}
+	}
+
+	found := make(map[[2]string]*buildEvidence)
+	for i := range issues {
+		for name, ref := range refs[i] {
+			for _, depName := range ref.unit.Deps {
+				for _, j := range unitIssues[depName] {
+					if i == j || existing[[2]string{issues[i].ID, issues[j].ID}] {
+						continue
+					}
+					if _, shared := refs[j][name]; shared {
+						continue // Both issues cover the dependent unit
+					}
+					key := [2]string{issues[i].ID, issues[j].ID}
+					ev := found[key]
+					if ev == nil {
+						ev = &buildEvidence{from: issues[i].ID, to: issues[j].ID, mentioned: true}
+						found[key] = ev
+					}
+					ev.edges = append(ev.edges, buildEdgeText(ref.unit, depName))
+					ev.mentioned = ev.mentioned && ref.mentioned && refs[j][depName].mentioned
+				}
+			}
+		}
+	}
+
+	var matches []*buildEvidence
+	for key, ev := range found {
+		// Edges in both directions: keep the better supported one, or
+		// neither when it's a tie.
+		if rev, ok := found[[2]string{key[1], key[0]}]; ok && len(rev.edges) >= len(ev.edges) {
+			continue
+		}
+		sort.Strings(ev.edges)
+		matches = append(matches, ev)
+	}
+
+	suggestions := make([]Suggestion, 0, len(matches))
+	for _, ev := range matches {
+		conf := 0.5 + 0.1*float64(len(ev.edges))
+		if ev.mentioned {
+			conf += 0.1
+		}
+		if conf > 0.9 {
+			conf = 0.9
+		}
+		if conf < config.MinConfidence {
+			continue
+		}
+		source := "linked changes"
+		if ev.mentioned {
+			source = "issue text"
+		}
+		reason := ev.edges[0]
+		if len(ev.edges) > 1 {
+			reason += fmt.Sprintf(" (+%d more build edges)", len(ev.edges)-1)
+		}
+		suggestions = append(suggestions, NewSuggestion(
+			SuggestionMissingDependency,
+			ev.from,
+			fmt.Sprintf("May depend on %s (build graph)", ev.to),
+			reason+"; units found via "+source,
+			conf,
+		).WithRelatedBead(ev.to).
+			WithAction(fmt.Sprintf("bd dep add %s %s", ev.from, ev.to)).
+			WithMetadata("source", "build_graph").
+			WithMetadata("build_edges", ev.edges))
+	}
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].Confidence != suggestions[j].Confidence {
+			return suggestions[i].Confidence > suggestions[j].Confidence
+		}
+		return suggestions[i].TargetBead+suggestions[i].RelatedBead < suggestions[j].TargetBead+suggestions[j].RelatedBead
+	})
+	if config.MaxSuggestions > 0 && len(suggestions) > config.MaxSuggestions {
+		suggestions = suggestions[:config.MaxSuggestions]
+	}
+	return suggestions
+}
+
+func buildEdgeText(unit *BuildUnit, dep string) string {
+	if unit.Kind == BuildKindGo {
+		return unit.Name + " imports " + dep
+	}
+	return unit.Name + " depends on " + dep
+}
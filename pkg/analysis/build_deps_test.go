@@ -0,0 +1,132 @@
+package analysis
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func writeTree(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		p := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestLoadBuildGraph(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"go.mod":                  "module example.com/app\n\ngo 1.22\n",
+		"main.go":                 "package main\n\nimport _ \"example.com/app/pkg/api\"\n",
+		"pkg/api/api.go":          "package api\n\nimport (\n\t\"fmt\"\n\t\"example.com/app/pkg/store\"\n)\n",
+		"pkg/api/api_test.go":     "package api\n\nimport _ \"example.com/app/pkg/testonly\"\n",
+		"pkg/store/store.go":      "package store\n",
+		"pkg/testonly/t.go":       "package testonly\n",
+		"vendor/x/y/y.go":         "package y\n",
+		"tools/go.mod":            "module example.com/tools\n",
+		"tools/gen/gen.go":        "package gen\n\nimport _ \"example.com/app/pkg/store\"\n",
+		"services/web/BUILD":      "go_library(\n    name = \"web\",\n    deps = [\n        \"//services/db\",  # the database\n        \":util\",\n        \"@com_github_x//:y\",\n    ],\n)\n\ngo_library(name = \"util\")\n",
+		"services/db/BUILD.bazel": "go_library(name = \"db\")\n",
+	})
+
+	g, err := LoadBuildGraph(root, []string{BuildKindGo, BuildKindBazel})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantDeps := map[string][]string{
+		"example.com/app":              {"example.com/app/pkg/api"},
+		"example.com/app/pkg/api":      {"example.com/app/pkg/store"},
+		"example.com/app/pkg/store":    {},
+		"example.com/tools/gen":        {"example.com/app/pkg/store"},
+		"//services/web:web":           {"//services/db:db", "//services/web:util"},
+		"//services/web:util":          {},
+		"//services/db:db":             {},
+		"example.com/app/pkg/testonly": {},
+	}
+	got := make(map[string][]string)
+	for name, u := range g.Units {
+		got[name] = append([]string{}, u.Deps...)
+	}
+	if !reflect.DeepEqual(got, wantDeps) {
+		t.Fatalf("units:\n got %v\nwant %v", got, wantDeps)
+	}
+	if g.Units["example.com/tools/gen"].Dir != "tools/gen" {
+		t.Errorf("unexpected dir %q", g.Units["example.com/tools/gen"].Dir)
+	}
+
+	if _, err := LoadBuildGraph(root, []string{"make"}); err == nil {
+		t.Error("expected an error for an unknown kind")
+	}
+}
+
+func TestDetectBuildDependencies(t *testing.T) {
+	g := NewBuildGraph([]*BuildUnit{
+		{Name: "example.com/app/pkg/api", Kind: BuildKindGo, Dir: "pkg/api", Deps: []string{"example.com/app/pkg/store", "fmt"}},
+		{Name: "example.com/app/pkg/store", Kind: BuildKindGo, Dir: "pkg/store"},
+		{Name: "//services/web:web", Kind: BuildKindBazel, Dir: "services/web", Deps: []string{"//services/db:db"}},
+		{Name: "//services/db:db", Kind: BuildKindBazel, Dir: "services/db"},
+	})
+	issues := []model.Issue{
+		{ID: "A", Title: "Paginate results in pkg/api", Status: model.StatusOpen},
+		{ID: "B", Title: "Add cursor support", Description: "Touches `pkg/store/cursor.go`.", Status: model.StatusOpen},
+		{ID: "C", Title: "Web frontend for //services/web", Status: model.StatusOpen},
+		{ID: "D", Title: "Schema migration", Status: model.StatusOpen}, // Linked via commits only
+		{ID: "E", Title: "Store tweak in pkg/store", Status: model.StatusClosed},
+		{ID: "F", Title: "Existing edge pkg/api", Status: model.StatusOpen,
+			Dependencies: []*model.Dependency{{IssueID: "F", DependsOnID: "B", Type: model.DepBlocks}}},
+	}
+	config := DefaultBuildDependencyConfig()
+	config.Graph = g
+	config.LinkedFiles = map[string][]string{"D": {"services/db/migrations/001.sql"}}
+
+	got := make(map[string]Suggestion)
+	for _, s := range DetectBuildDependencies(issues, config) {
+		got[s.TargetBead+"->"+s.RelatedBead] = s
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected A->B and C->D, got %v", got)
+	}
+	ab, ok := got["A->B"]
+	if !ok || ab.ActionCommand != "bd dep add A B" || ab.Confidence != 0.7 {
+		t.Fatalf("unexpected A->B suggestion: %+v", ab)
+	}
+	if edges := ab.Metadata["build_edges"].([]string); len(edges) != 1 || edges[0] != "example.com/app/pkg/api imports example.com/app/pkg/store" {
+		t.Errorf("unexpected edges %v", edges)
+	}
+	if cd, ok := got["C->D"]; !ok || cd.Confidence != 0.6 || cd.Type != SuggestionMissingDependency {
+		t.Fatalf("expected a lower-confidence C->D from linked files, got %+v", cd)
+	}
+
+	config.Graph = nil
+	if s := DetectBuildDependencies(issues, config); s != nil {
+		t.Errorf("expected no suggestions without a graph, got %v", s)
+	}
+}
+
+func TestGenerateAllSuggestions_BuildReplacesKeywordMatch(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "Pagination cursor for pkg/api listing", Status: model.StatusOpen},
+		{ID: "B", Title: "Pagination cursor for pkg/store listing", Status: model.StatusOpen},
+	}
+	config := DefaultSuggestAllConfig()
+	config.FilterType = SuggestionMissingDependency
+	config.Dependencies.MinConfidence = 0
+	config.BuildDependencies.Graph = NewBuildGraph([]*BuildUnit{
+		{Name: "app/pkg/api", Kind: BuildKindGo, Dir: "pkg/api", Deps: []string{"app/pkg/store"}},
+		{Name: "app/pkg/store", Kind: BuildKindGo, Dir: "pkg/store"},
+	})
+
+	set := GenerateAllSuggestions(issues, config, "hash")
+	if len(set.Suggestions) != 1 || set.Suggestions[0].Metadata["source"] != "build_graph" {
+		t.Fatalf("expected only the build graph suggestion, got %+v", set.Suggestions)
+	}
+}
@@ -395,6 +395,7 @@ func computeIssueContentHash(issue model.Issue) string {
 	writeIntPtrHash(h, issue.EstimatedMinutes)
 	writeTimeHash(h, issue.CreatedAt)
 	writeTimeHash(h, issue.UpdatedAt)
+	writeTimePtrHash(h, issue.StartDate)
 	writeTimePtrHash(h, issue.DueDate)
 	writeTimePtrHash(h, issue.ClosedAt)
 
@@ -75,8 +75,49 @@ type DuplicatePair struct {
 }
 
 // DetectDuplicates finds potential duplicate issues using keyword-based Jaccard similarity
-// Optimized with an inverted index for performance on large repositories.
 func DetectDuplicates(issues []model.Issue, config DuplicateConfig) []Suggestion {
+	pairs := FindDuplicatePairs(issues, config)
+	if len(pairs) == 0 {
+		return nil
+	}
+
+	// Issue lookup map for constructing suggestions
+	issueMap := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+
+	// Convert to suggestions
+	suggestions := make([]Suggestion, 0, len(pairs))
+	for _, pair := range pairs {
+		issue1 := issueMap[pair.Issue1]
+		issue2 := issueMap[pair.Issue2]
+
+		sug := NewSuggestion(
+			SuggestionPotentialDuplicate,
+			pair.Issue1,
+			fmt.Sprintf("Potential duplicate of %s", pair.Issue2),
+			fmt.Sprintf("%.0f%% keyword similarity; common: %s",
+				pair.Similarity*100,
+				strings.Join(truncateStringSlice(pair.Keywords, 5), ", ")),
+			pair.Similarity,
+		).WithRelatedBead(pair.Issue2).WithMetadata("method", pair.Method)
+
+		// Add action command if both are open
+		if !isClosedLikeDuplicateStatus(issue1.Status) && !isClosedLikeDuplicateStatus(issue2.Status) {
+			sug = sug.WithAction(fmt.Sprintf("bd dep add %s %s --type=related", pair.Issue1, pair.Issue2))
+		}
+
+		suggestions = append(suggestions, sug)
+	}
+
+	return suggestions
+}
+
+// FindDuplicatePairs returns the pairs of issues whose title and description
+// keywords overlap by at least config.JaccardThreshold, most similar first.
+// Optimized with an inverted index for performance on large repositories.
+func FindDuplicatePairs(issues []model.Issue, config DuplicateConfig) []DuplicatePair {
 	if len(issues) < 2 {
 		return nil
 	}
@@ -169,38 +210,7 @@ func DetectDuplicates(issues []model.Issue, config DuplicateConfig) []Suggestion
 	if len(pairs) > config.MaxSuggestions {
 		pairs = pairs[:config.MaxSuggestions]
 	}
-
-	// Issue lookup map for constructing suggestions
-	issueMap := make(map[string]*model.Issue, len(issues))
-	for i := range issues {
-		issueMap[issues[i].ID] = &issues[i]
-	}
-
-	// Convert to suggestions
-	suggestions := make([]Suggestion, 0, len(pairs))
-	for _, pair := range pairs {
-		issue1 := issueMap[pair.Issue1]
-		issue2 := issueMap[pair.Issue2]
-
-		sug := NewSuggestion(
-			SuggestionPotentialDuplicate,
-			pair.Issue1,
-			fmt.Sprintf("Potential duplicate of %s", pair.Issue2),
-			fmt.Sprintf("%.0f%% keyword similarity; common: %s",
-				pair.Similarity*100,
-				strings.Join(truncateStringSlice(pair.Keywords, 5), ", ")),
-			pair.Similarity,
-		).WithRelatedBead(pair.Issue2).WithMetadata("method", pair.Method)
-
-		// Add action command if both are open
-		if !isClosedLikeDuplicateStatus(issue1.Status) && !isClosedLikeDuplicateStatus(issue2.Status) {
-			sug = sug.WithAction(fmt.Sprintf("bd dep add %s %s --type=related", pair.Issue1, pair.Issue2))
-		}
-
-		suggestions = append(suggestions, sug)
-	}
-
-	return suggestions
+	return pairs
 }
 
 // intersectKeywords finds common strings between two sorted/unsorted slices.
@@ -256,11 +266,18 @@ func extractKeywords(title, description string) []string {
 	return keywords
 }
 
-// sortPairsBySimilarity sorts duplicate pairs by similarity (highest first)
+// sortPairsBySimilarity sorts duplicate pairs by similarity (highest first),
+// then by ID so that equal scores keep a stable order.
 // Uses sort.Slice for O(n log n) performance instead of bubble sort O(n²)
 func sortPairsBySimilarity(pairs []DuplicatePair) {
 	sort.Slice(pairs, func(i, j int) bool {
-		return pairs[i].Similarity > pairs[j].Similarity
+		if pairs[i].Similarity != pairs[j].Similarity {
+			return pairs[i].Similarity > pairs[j].Similarity
+		}
+		if pairs[i].Issue1 != pairs[j].Issue1 {
+			return pairs[i].Issue1 < pairs[j].Issue1
+		}
+		return pairs[i].Issue2 < pairs[j].Issue2
 	})
 }
 
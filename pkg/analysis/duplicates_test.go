@@ -636,3 +636,29 @@ func TestDetectDuplicates_LargeIssueSet(t *testing.T) {
 		t.Error("Should find at least one duplicate pair")
 	}
 }
+
+func TestFindDuplicatePairs_OrderedAndLimited(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "C", Title: "Implement user authentication system", Status: model.StatusOpen},
+		{ID: "A", Title: "Implement user authentication system", Status: model.StatusOpen},
+		{ID: "B", Title: "Implement user authentication system", Status: model.StatusOpen},
+		{ID: "D", Title: "Export monthly report", Status: model.StatusOpen},
+	}
+
+	config := DefaultDuplicateConfig()
+	pairs := FindDuplicatePairs(issues, config)
+	if len(pairs) != 3 {
+		t.Fatalf("FindDuplicatePairs() = %d pairs, want 3", len(pairs))
+	}
+	for i, want := range [][2]string{{"A", "B"}, {"A", "C"}, {"B", "C"}} {
+		got := [2]string{min(pairs[i].Issue1, pairs[i].Issue2), max(pairs[i].Issue1, pairs[i].Issue2)}
+		if got != want {
+			t.Errorf("pair %d = %v, want %v", i, got, want)
+		}
+	}
+
+	config.MaxSuggestions = 1
+	if pairs := FindDuplicatePairs(issues, config); len(pairs) != 1 {
+		t.Errorf("FindDuplicatePairs() with MaxSuggestions 1 = %d pairs, want 1", len(pairs))
+	}
+}
@@ -791,7 +791,7 @@ func (a *Analyzer) computeWhatIfDelta(issueID string) *WhatIfDelta {
 	directCount := len(directUnblocks)
 
 	// Compute transitive unblocks (cascade effect)
-	transitiveCount := a.countTransitiveUnblocks(issueID)
+	transitiveCount := len(a.UnblockCascade(issueID))
 
 	// Compute blocked reduction (how many items in blocked status would become unblocked)
 	blockedReduction := 0
@@ -842,15 +842,24 @@ func (a *Analyzer) computeWhatIfDelta(issueID string) *WhatIfDelta {
 	}
 }
 
-// countTransitiveUnblocks counts total issues unblocked by a hypothetical completion of issueID,
-// including cascading effects (diamonds, chains) via simulation.
-func (a *Analyzer) countTransitiveUnblocks(issueID string) int {
+// UnblockEntry is an issue freed in the cascade of UnblockCascade.
+type UnblockEntry struct {
+	ID    string `json:"id"`
+	Depth int    `json:"depth"` // 1 = ready once the issue closes, 2 = once a depth-1 issue closes too, etc.
+	Via   string `json:"via"`   // The issue whose closing frees it
+}
+
+// UnblockCascade returns the open issues that become ready if issueID is
+// closed, then each issue that frees in turn, including cascading effects
+// (diamonds, chains), ordered by depth and ID.
+func (a *Analyzer) UnblockCascade(issueID string) []UnblockEntry {
 	// Set of "conceptually closed" issues: initially just the starting issue
 	simulatedClosed := make(map[string]bool)
 	simulatedClosed[issueID] = true
+	depths := map[string]int{issueID: 0}
 
 	queue := []string{issueID}
-	count := 0
+	var freed []UnblockEntry
 
 	for len(queue) > 0 {
 		curr := queue[0]
@@ -899,13 +908,20 @@ func (a *Analyzer) countTransitiveUnblocks(issueID string) int {
 
 			if !isBlocked {
 				simulatedClosed[depID] = true
+				depths[depID] = depths[curr] + 1
 				queue = append(queue, depID)
-				count++
+				freed = append(freed, UnblockEntry{ID: depID, Depth: depths[depID], Via: curr})
 			}
 		}
 	}
 
-	return count
+	sort.Slice(freed, func(i, j int) bool {
+		if freed[i].Depth != freed[j].Depth {
+			return freed[i].Depth < freed[j].Depth
+		}
+		return freed[i].ID < freed[j].ID
+	})
+	return freed
 }
 
 // estimateDaysSaved estimates work-days saved by unblocking issues
@@ -0,0 +1,200 @@
+package analysis
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// ScheduledIssue is one open issue in the schedule of an epic. Start,
+// Finish and Slack are minutes of work from now, assuming unlimited hands:
+// an issue starts as soon as its last blocker finishes.
+type ScheduledIssue struct {
+	ID        string `json:"id"`
+	Minutes   int    `json:"minutes"`
+	Estimated bool   `json:"estimated"` // false when Minutes is the median estimate
+	Start     int    `json:"start"`
+	Finish    int    `json:"finish"`
+	Slack     int    `json:"slack"` // How far it can slip without delaying the epic
+	Critical  bool   `json:"critical"`
+}
+
+// EpicSchedule is the schedule of the open work under an epic: its leaf
+// descendants through parent-child links, plus every open issue blocking
+// them. Path is the chain of zero-slack issues, first to last, whose
+// estimates add up to the shortest time the epic can take.
+type EpicSchedule struct {
+	EpicID       string           `json:"epic_id"`
+	Path         []string         `json:"path"`
+	TotalMinutes int              `json:"total_minutes"` // Length of the critical path
+	WorkMinutes  int              `json:"work_minutes"`  // Sum of all scheduled estimates
+	Unestimated  int              `json:"unestimated"`
+	Issues       []ScheduledIssue `json:"issues"` // By start, then ID
+}
+
+// ComputeCriticalPath schedules the open work under epicID by the critical
+// path method. Issues without an estimate take the median of the estimated
+// ones. A blocking cycle in that work is an error, since nothing in it can
+// be scheduled.
+func ComputeCriticalPath(issues []model.Issue, epicID string) (*EpicSchedule, error) {
+	byID := make(map[string]*model.Issue, len(issues))
+	children := make(map[string][]string)
+	for i := range issues {
+		iss := &issues[i]
+		byID[iss.ID] = iss
+		for _, dep := range iss.Dependencies {
+			if dep != nil && dep.Type == model.DepParentChild {
+				children[dep.DependsOnID] = append(children[dep.DependsOnID], iss.ID)
+			}
+		}
+	}
+	if _, ok := byID[epicID]; !ok {
+		return nil, fmt.Errorf("issue %q not found", epicID)
+	}
+
+	// Collect the open leaf descendants, then close over their open
+	// blockers.
+	open := func(id string) bool {
+		iss, ok := byID[id]
+		return ok && !isClosedLikeStatus(iss.Status)
+	}
+	inSet := make(map[string]bool)
+	var queue []string
+	seen := map[string]bool{epicID: true}
+	for stack := []string{epicID}; len(stack) > 0; {
+		id := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		// Containers are done when their children are, so only leaves
+		// are work; an epic without children is its own work.
+		if open(id) && len(children[id]) == 0 {
+			inSet[id] = true
+			queue = append(queue, id)
+		}
+		for _, child := range children[id] {
+			if !seen[child] {
+				seen[child] = true
+				stack = append(stack, child)
+			}
+		}
+	}
+	blockers := make(map[string][]string)
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, dep := range byID[id].Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() || !open(dep.DependsOnID) {
+				continue
+			}
+			blockers[id] = append(blockers[id], dep.DependsOnID)
+			if !inSet[dep.DependsOnID] {
+				inSet[dep.DependsOnID] = true
+				queue = append(queue, dep.DependsOnID)
+			}
+		}
+	}
+
+	ids := make([]string, 0, len(inSet))
+	for id := range inSet {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	dependents := make(map[string][]string)
+	for _, id := range ids {
+		for _, b := range blockers[id] {
+			dependents[b] = append(dependents[b], id)
+		}
+	}
+
+	// Topological order by Kahn's algorithm; leftovers are on a cycle.
+	pending := make(map[string]int, len(ids))
+	var order, ready []string
+	for _, id := range ids {
+		pending[id] = len(blockers[id])
+		if pending[id] == 0 {
+			ready = append(ready, id)
+		}
+	}
+	for len(ready) > 0 {
+		id := ready[0]
+		ready = ready[1:]
+		order = append(order, id)
+		for _, d := range dependents[id] {
+			if pending[d]--; pending[d] == 0 {
+				ready = append(ready, d)
+			}
+		}
+	}
+	if len(order) < len(ids) {
+		for _, id := range ids {
+			if pending[id] > 0 {
+				return nil, fmt.Errorf("dependency cycle through %s", id)
+			}
+		}
+	}
+
+	result := &EpicSchedule{EpicID: epicID}
+	median := computeMedianEstimatedMinutes(issues)
+	sched := make(map[string]*ScheduledIssue, len(order))
+	for _, id := range order {
+		s := &ScheduledIssue{ID: id, Minutes: median}
+		if est := byID[id].EstimatedMinutes; est != nil && *est > 0 {
+			s.Minutes, s.Estimated = *est, true
+		} else {
+			result.Unestimated++
+		}
+		for _, b := range blockers[id] {
+			s.Start = max(s.Start, sched[b].Finish)
+		}
+		s.Finish = s.Start + s.Minutes
+		result.TotalMinutes = max(result.TotalMinutes, s.Finish)
+		result.WorkMinutes += s.Minutes
+		sched[id] = s
+	}
+
+	// Backward pass: the latest an issue may finish is the earliest latest
+	// start among the issues it blocks.
+	latestStart := make(map[string]int, len(order))
+	for i := len(order) - 1; i >= 0; i-- {
+		s := sched[order[i]]
+		latestFinish := result.TotalMinutes
+		for _, d := range dependents[s.ID] {
+			latestFinish = min(latestFinish, latestStart[d])
+		}
+		latestStart[s.ID] = latestFinish - s.Minutes
+		s.Slack = latestStart[s.ID] - s.Start
+		s.Critical = s.Slack == 0
+	}
+
+	// Walk back from the last critical finish through critical blockers.
+	var last *ScheduledIssue
+	for _, id := range order {
+		if s := sched[id]; s.Critical && s.Finish == result.TotalMinutes && (last == nil || id < last.ID) {
+			last = s
+		}
+	}
+	for last != nil {
+		result.Path = append(result.Path, last.ID)
+		var prev *ScheduledIssue
+		for _, b := range blockers[last.ID] {
+			if s := sched[b]; s.Critical && s.Finish == last.Start && (prev == nil || b < prev.ID) {
+				prev = s
+			}
+		}
+		last = prev
+	}
+	for i, j := 0, len(result.Path)-1; i < j; i, j = i+1, j-1 {
+		result.Path[i], result.Path[j] = result.Path[j], result.Path[i]
+	}
+
+	for _, id := range order {
+		result.Issues = append(result.Issues, *sched[id])
+	}
+	sort.SliceStable(result.Issues, func(i, j int) bool {
+		if result.Issues[i].Start != result.Issues[j].Start {
+			return result.Issues[i].Start < result.Issues[j].Start
+		}
+		return result.Issues[i].ID < result.Issues[j].ID
+	})
+	return result, nil
+}
@@ -0,0 +1,88 @@
+package analysis
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeCriticalPath(t *testing.T) {
+	minutes := func(n int) *int { return &n }
+	deps := func(parent string, blockers ...string) []*model.Dependency {
+		var out []*model.Dependency
+		if parent != "" {
+			out = append(out, &model.Dependency{DependsOnID: parent, Type: model.DepParentChild})
+		}
+		for _, b := range blockers {
+			out = append(out, &model.Dependency{DependsOnID: b, Type: model.DepBlocks})
+		}
+		return out
+	}
+	issues := []model.Issue{
+		{ID: "E", Status: model.StatusOpen, IssueType: model.TypeEpic},
+		{ID: "A", Status: model.StatusOpen, EstimatedMinutes: minutes(60), Dependencies: deps("E")},
+		{ID: "B", Status: model.StatusOpen, EstimatedMinutes: minutes(120), Dependencies: deps("E", "A")},
+		{ID: "C", Status: model.StatusOpen, Dependencies: deps("E", "X")},
+		{ID: "D", Status: model.StatusClosed, EstimatedMinutes: minutes(600), Dependencies: deps("E")},
+		{ID: "F", Status: model.StatusOpen, IssueType: model.TypeEpic, Dependencies: deps("E")},
+		{ID: "G", Status: model.StatusOpen, EstimatedMinutes: minutes(30), Dependencies: deps("F", "A")},
+		// X is outside the epic but blocks C, so it is scheduled too.
+		{ID: "X", Status: model.StatusOpen, EstimatedMinutes: minutes(240)},
+	}
+
+	s, err := ComputeCriticalPath(issues, "E")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// C takes the median estimate of all issues, 120, after X's 240.
+	if got := strings.Join(s.Path, ","); got != "X,C" {
+		t.Errorf("expected the path X,C, got %s", got)
+	}
+	if s.TotalMinutes != 360 || s.WorkMinutes != 570 || s.Unestimated != 1 {
+		t.Errorf("unexpected totals %+v", s)
+	}
+	want := map[string]ScheduledIssue{
+		"A": {ID: "A", Minutes: 60, Estimated: true, Start: 0, Finish: 60, Slack: 180},
+		"X": {ID: "X", Minutes: 240, Estimated: true, Start: 0, Finish: 240, Critical: true},
+		"B": {ID: "B", Minutes: 120, Estimated: true, Start: 60, Finish: 180, Slack: 180},
+		"G": {ID: "G", Minutes: 30, Estimated: true, Start: 60, Finish: 90, Slack: 270},
+		"C": {ID: "C", Minutes: 120, Start: 240, Finish: 360, Critical: true},
+	}
+	if len(s.Issues) != len(want) {
+		t.Fatalf("expected %d scheduled issues, got %+v", len(want), s.Issues)
+	}
+	for _, got := range s.Issues {
+		if got != want[got.ID] {
+			t.Errorf("%s: got %+v, want %+v", got.ID, got, want[got.ID])
+		}
+	}
+	if s.Issues[0].ID != "A" || s.Issues[len(s.Issues)-1].ID != "C" {
+		t.Errorf("expected the issues ordered by start, got %+v", s.Issues)
+	}
+}
+
+func TestComputeCriticalPath_Edges(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "E", Status: model.StatusOpen},
+		{ID: "P", Status: model.StatusOpen, Dependencies: []*model.Dependency{
+			{DependsOnID: "E", Type: model.DepParentChild}, {DependsOnID: "Q", Type: model.DepBlocks}}},
+		{ID: "Q", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "P", Type: model.DepBlocks}}},
+		{ID: "L", Status: model.StatusOpen},
+	}
+	if _, err := ComputeCriticalPath(issues, "E"); err == nil || !strings.Contains(err.Error(), "cycle") {
+		t.Errorf("expected a cycle error, got %v", err)
+	}
+	if _, err := ComputeCriticalPath(issues, "nope"); err == nil {
+		t.Error("expected an unknown issue to fail")
+	}
+
+	// An issue without children is its own work, at the default estimate.
+	s, err := ComputeCriticalPath(issues, "L")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Path) != 1 || s.Path[0] != "L" || s.TotalMinutes != DefaultEstimatedMinutes {
+		t.Errorf("unexpected schedule %+v", s)
+	}
+}
@@ -15,6 +15,10 @@ type SuggestAllConfig struct {
 	// Dependencies suggestion config
 	Dependencies DependencySuggestionConfig
 
+	// BuildDependencies suggests dependencies from the build graph when
+	// its Graph is set (part of the dependency suggestions)
+	BuildDependencies BuildDependencyConfig
+
 	// Labels suggestion config
 	Labels LabelSuggestionConfig
 
@@ -51,6 +55,7 @@ func DefaultSuggestAllConfig() SuggestAllConfig {
 	return SuggestAllConfig{
 		Duplicates:         DefaultDuplicateConfig(),
 		Dependencies:       DefaultDependencySuggestionConfig(),
+		BuildDependencies:  DefaultBuildDependencyConfig(),
 		Labels:             DefaultLabelSuggestionConfig(),
 		Cycles:             DefaultCycleWarningConfig(),
 		EnableDuplicates:   true,
@@ -73,8 +78,20 @@ func GenerateAllSuggestions(issues []model.Issue, config SuggestAllConfig, dataH
 	}
 
 	if config.EnableDependencies && (config.FilterType == "" || config.FilterType == SuggestionMissingDependency) {
-		dependencies := DetectMissingDependencies(issues, config.Dependencies)
-		allSuggestions = append(allSuggestions, dependencies...)
+		// Build graph evidence is stronger than shared keywords, so it
+		// replaces a keyword suggestion for the same pair.
+		build := DetectBuildDependencies(issues, config.BuildDependencies)
+		covered := make(map[[2]string]bool, len(build))
+		for _, sug := range build {
+			covered[[2]string{sug.TargetBead, sug.RelatedBead}] = true
+			covered[[2]string{sug.RelatedBead, sug.TargetBead}] = true
+		}
+		for _, sug := range DetectMissingDependencies(issues, config.Dependencies) {
+			if !covered[[2]string{sug.TargetBead, sug.RelatedBead}] {
+				allSuggestions = append(allSuggestions, sug)
+			}
+		}
+		allSuggestions = append(allSuggestions, build...)
 	}
 
 	if config.EnableLabels && (config.FilterType == "" || config.FilterType == SuggestionLabelSuggestion) {
@@ -159,6 +176,7 @@ func GenerateRobotSuggestOutput(issues []model.Issue, config SuggestAllConfig, d
 			"--suggest-type=dependency - Filter to dependency suggestions",
 			"--suggest-confidence=0.7 - Minimum confidence threshold",
 			"--suggest-bead=<id> - Suggestions for specific bead",
+			"--suggest-build=go,bazel - Also suggest dependencies from the build graph",
 		},
 	}
 }
@@ -394,7 +394,7 @@ func ComputeTriageFromAnalyzer(analyzer *Analyzer, stats *GraphStats, issues []m
 
 	// Build recommendations using enhanced scores (bv-148)
 	// Pass triageCtx instead of analyzer for cached blocker lookups (bv-k4az)
-	recommendations := buildRecommendationsFromTriageScores(triageScores, triageCtx, opts.TopN)
+	recommendations := buildRecommendationsFromTriageScores(triageScores, triageCtx, opts.TopN, now)
 
 	// Build quick wins
 	quickWins := buildQuickWins(impactScores, unblocksMap, opts.QuickWinN)
@@ -599,7 +599,7 @@ func computeCountsWithContext(issues []model.Issue, ctx *TriageContext) HealthCo
 
 // buildRecommendationsFromTriageScores creates recommendations using enhanced triage scores.
 // Uses TriageContext for cached blocker lookups (bv-k4az optimization).
-func buildRecommendationsFromTriageScores(scores []TriageScore, ctx *TriageContext, limit int) []Recommendation {
+func buildRecommendationsFromTriageScores(scores []TriageScore, ctx *TriageContext, limit int, now time.Time) []Recommendation {
 	if len(scores) > limit {
 		scores = scores[:limit]
 	}
@@ -615,7 +615,7 @@ func buildRecommendationsFromTriageScores(scores []TriageScore, ctx *TriageConte
 		}
 
 		// Generate reasons using the new logic (cached via TriageContext)
-		reasons := GenerateTriageReasonsForScore(score, ctx)
+		reasons := GenerateTriageReasonsForScore(score, ctx, now)
 
 		// Get blocked by (cached via TriageContext)
 		blockedBy := ctx.OpenBlockers(score.IssueID)
@@ -1350,16 +1350,17 @@ func formatUnblockList(ids []string) string {
 	return fmt.Sprintf("%s, %s, +%d more", ids[0], ids[1], len(ids)-2)
 }
 
-// GenerateTriageReasonsForScore generates reasons from a TriageScore and TriageContext.
+// GenerateTriageReasonsForScore generates reasons from a TriageScore and TriageContext,
+// measuring staleness from now.
 // Uses cached blocker lookups for better performance (bv-k4az optimization).
-func GenerateTriageReasonsForScore(score TriageScore, triageCtx *TriageContext) TriageReasons {
+func GenerateTriageReasonsForScore(score TriageScore, triageCtx *TriageContext, now time.Time) TriageReasons {
 	analyzer := triageCtx.Analyzer()
 	unblocksMap := triageCtx.UnblocksMap()
 	issue := analyzer.GetIssue(score.IssueID)
 
 	daysSinceUpdate := 0
 	if issue != nil && !issue.UpdatedAt.IsZero() {
-		daysSinceUpdate = int(time.Since(issue.UpdatedAt).Hours() / 24)
+		daysSinceUpdate = int(now.Sub(issue.UpdatedAt).Hours() / 24)
 	}
 
 	// Determine if this is a quick win based on factors
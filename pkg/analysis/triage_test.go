@@ -976,7 +976,7 @@ func TestGenerateTriageReasonsForScore(t *testing.T) {
 		}
 	}
 
-	reasons := GenerateTriageReasonsForScore(blockerScore, triageCtx)
+	reasons := GenerateTriageReasonsForScore(blockerScore, triageCtx, time.Now())
 
 	// Should have reasons
 	if len(reasons.All) == 0 {
@@ -352,3 +352,31 @@ func TestExplanationStatus_Capped(t *testing.T) {
 		t.Error("expected capped fields to be set")
 	}
 }
+
+func TestUnblockCascade(t *testing.T) {
+	blocks := func(ids ...string) []*model.Dependency {
+		var deps []*model.Dependency
+		for _, id := range ids {
+			deps = append(deps, &model.Dependency{DependsOnID: id, Type: model.DepBlocks})
+		}
+		return deps
+	}
+	// A frees B and C; D needs both B and C; E also waits on the open X.
+	issues := []model.Issue{
+		{ID: "A", Status: model.StatusOpen},
+		{ID: "B", Status: model.StatusOpen, Dependencies: blocks("A")},
+		{ID: "C", Status: model.StatusBlocked, Dependencies: blocks("A")},
+		{ID: "D", Status: model.StatusOpen, Dependencies: blocks("B", "C")},
+		{ID: "E", Status: model.StatusOpen, Dependencies: blocks("D", "X")},
+		{ID: "X", Status: model.StatusOpen},
+		{ID: "F", Status: model.StatusClosed, Dependencies: blocks("A")},
+	}
+	got := NewAnalyzer(issues).UnblockCascade("A")
+	want := []UnblockEntry{{ID: "B", Depth: 1, Via: "A"}, {ID: "C", Depth: 1, Via: "A"}}
+	if len(got) != 3 || got[0] != want[0] || got[1] != want[1] || got[2].ID != "D" || got[2].Depth != 2 {
+		t.Errorf("unexpected cascade %+v", got)
+	}
+	if got := NewAnalyzer(issues).UnblockCascade("nope"); len(got) != 0 {
+		t.Errorf("expected nothing for an unknown issue, got %+v", got)
+	}
+}
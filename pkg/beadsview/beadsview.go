@@ -0,0 +1,174 @@
+package beadsview
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/export"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// The data model. These are aliases, so values pass freely between this
+// package and the rest of beads_viewer.
+type (
+	Issue          = model.Issue
+	Status         = model.Status
+	IssueType      = model.IssueType
+	Dependency     = model.Dependency
+	DependencyType = model.DependencyType
+	Comment        = model.Comment
+)
+
+const (
+	StatusOpen       = model.StatusOpen
+	StatusInProgress = model.StatusInProgress
+	StatusBlocked    = model.StatusBlocked
+	StatusClosed     = model.StatusClosed
+	StatusTombstone  = model.StatusTombstone
+
+	DepBlocks         = model.DepBlocks
+	DepRelated        = model.DepRelated
+	DepParentChild    = model.DepParentChild
+	DepDiscoveredFrom = model.DepDiscoveredFrom
+)
+
+// Load reads the issues of the beads project at repoPath, honoring
+// BEADS_DIR like bv does.
+func Load(repoPath string) ([]Issue, error) {
+	return loader.LoadIssues(repoPath)
+}
+
+// LoadFile reads issues from a beads JSONL file.
+func LoadFile(path string) ([]Issue, error) {
+	return loader.LoadIssuesFromFile(path)
+}
+
+// Parse reads issues in beads JSONL format from r. Malformed lines are
+// skipped with a warning on stderr.
+func Parse(r io.Reader) ([]Issue, error) {
+	return loader.ParseIssues(r)
+}
+
+// WriteJSONL writes issues to w in beads JSONL format, one issue per line,
+// so that Parse reads them back.
+func WriteJSONL(w io.Writer, issues []Issue) error {
+	enc := json.NewEncoder(w)
+	for i := range issues {
+		if err := enc.Encode(&issues[i]); err != nil {
+			return fmt.Errorf("writing %s: %w", issues[i].ID, err)
+		}
+	}
+	return nil
+}
+
+// ExportMarkdown renders issues as the Markdown report of bv --export-md.
+func ExportMarkdown(issues []Issue, title string) (string, error) {
+	return export.GenerateMarkdown(issues, title)
+}
+
+// Graph is the analyzed dependency graph of a set of issues. It is safe for
+// concurrent use.
+type Graph struct {
+	issues   []Issue
+	analyzer *analysis.Analyzer
+	stats    *analysis.GraphStats
+}
+
+// Metrics are the graph metrics of one issue. Higher scores mean more
+// important to the rest of the graph.
+type Metrics struct {
+	PageRank     float64 `json:"pagerank"`
+	Betweenness  float64 `json:"betweenness"`
+	Eigenvector  float64 `json:"eigenvector"`
+	Hub          float64 `json:"hub"`
+	Authority    float64 `json:"authority"`
+	CriticalPath float64 `json:"critical_path"` // Length of the longest chain the issue heads
+}
+
+// Analyze computes the dependency graph metrics of issues. It may take a
+// few seconds on very large projects.
+func Analyze(issues []Issue) *Graph {
+	a := analysis.NewAnalyzer(issues)
+	stats := a.Analyze()
+	return &Graph{issues: issues, analyzer: a, stats: &stats}
+}
+
+// Metrics returns the metrics of the issue with the given ID; unknown IDs
+// have zero metrics.
+func (g *Graph) Metrics(id string) Metrics {
+	return Metrics{
+		PageRank:     g.stats.GetPageRankScore(id),
+		Betweenness:  g.stats.GetBetweennessScore(id),
+		Eigenvector:  g.stats.GetEigenvectorScore(id),
+		Hub:          g.stats.GetHubScore(id),
+		Authority:    g.stats.GetAuthorityScore(id),
+		CriticalPath: g.stats.GetCriticalPathScore(id),
+	}
+}
+
+// Cycles returns the dependency cycles, each as a list of issue IDs.
+func (g *Graph) Cycles() [][]string {
+	return g.stats.Cycles()
+}
+
+// OpenBlockers returns the IDs of the unfinished issues blocking id.
+func (g *Graph) OpenBlockers(id string) []string {
+	return g.analyzer.GetOpenBlockers(id)
+}
+
+// Actionable returns the open issues with no open blockers.
+func (g *Graph) Actionable() []Issue {
+	return g.analyzer.GetActionableIssues()
+}
+
+// DOT renders the graph in Graphviz DOT format.
+func (g *Graph) DOT() (string, error) {
+	return g.export(export.GraphFormatDOT)
+}
+
+// Mermaid renders the graph as a Mermaid flowchart.
+func (g *Graph) Mermaid() (string, error) {
+	return g.export(export.GraphFormatMermaid)
+}
+
+func (g *Graph) export(format export.GraphExportFormat) (string, error) {
+	result, err := export.ExportGraph(g.issues, g.stats, export.GraphExportConfig{Format: format})
+	if err != nil {
+		return "", fmt.Errorf("exporting %s graph: %w", format, err)
+	}
+	return result.Graph, nil
+}
+
+// Recommendation is a suggested next issue to work on.
+type Recommendation struct {
+	ID        string   `json:"id"`
+	Title     string   `json:"title"`
+	Score     float64  `json:"score"`  // 0-1, higher first
+	Action    string   `json:"action"` // Suggested next step
+	Reasons   []string `json:"reasons"`
+	Unblocks  []string `json:"unblocks,omitempty"`   // Issues that completing this frees up
+	BlockedBy []string `json:"blocked_by,omitempty"` // Open issues that must finish first
+}
+
+// Triage ranks the open issues the way bv --robot-triage does, best first.
+// now is the reference time for staleness.
+func Triage(issues []Issue, now time.Time) []Recommendation {
+	result := analysis.ComputeTriageWithOptionsAndTime(issues, analysis.TriageOptions{}, now)
+	recs := make([]Recommendation, 0, len(result.Recommendations))
+	for _, r := range result.Recommendations {
+		recs = append(recs, Recommendation{
+			ID:        r.ID,
+			Title:     r.Title,
+			Score:     r.Score,
+			Action:    r.Action,
+			Reasons:   r.Reasons,
+			Unblocks:  r.UnblocksIDs,
+			BlockedBy: r.BlockedBy,
+		})
+	}
+	return recs
+}
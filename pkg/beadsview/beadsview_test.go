@@ -0,0 +1,473 @@
+package beadsview_test
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/fs"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/beadsview"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/testutil"
+)
+
+var now = time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+
+func fixture() []beadsview.Issue {
+	day := 24 * time.Hour
+	blocks := func(from, to string) []*beadsview.Dependency {
+		return []*beadsview.Dependency{{IssueID: from, DependsOnID: to, Type: beadsview.DepBlocks}}
+	}
+	return []beadsview.Issue{
+		{ID: "A", Title: "Schema", Status: beadsview.StatusClosed, Priority: 1, IssueType: "task",
+			CreatedAt: now.Add(-30 * day), UpdatedAt: now.Add(-20 * day)},
+		{ID: "B", Title: "API", Status: beadsview.StatusOpen, Priority: 0, IssueType: "feature", Labels: []string{"api"},
+			CreatedAt: now.Add(-10 * day), UpdatedAt: now.Add(-1 * day), Dependencies: blocks("B", "A")},
+		{ID: "C", Title: "Client", Status: beadsview.StatusOpen, Priority: 2, IssueType: "feature", Labels: []string{"api", "web"},
+			CreatedAt: now.Add(-5 * day), UpdatedAt: now.Add(-2 * day), Dependencies: blocks("C", "B")},
+		{ID: "D", Title: "Docs", Status: beadsview.StatusInProgress, Priority: 2, IssueType: "chore",
+			CreatedAt: now.Add(-3 * day), UpdatedAt: now.Add(-3 * day)},
+	}
+}
+
+func ids(issues []beadsview.Issue) string {
+	out := make([]string, len(issues))
+	for i, issue := range issues {
+		out[i] = issue.ID
+	}
+	return strings.Join(out, ",")
+}
+
+func TestFilterIssues(t *testing.T) {
+	tests := []struct {
+		filter string
+		sort   string
+		want   string
+	}{
+		{"all", "default", "B,D,C,A"},
+		{"open", "created", "B,C,D"},
+		{"ready", "default", "B,D"},
+		{"label:api", "-created", "C,B"},
+		{"label=api priority:2", "default", "C"},
+		{"status:in_progress", "default", "D"},
+		{"closed", "updated", "A"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter+"/"+tt.sort, func(t *testing.T) {
+			if err := beadsview.ValidateFilter(tt.filter); err != nil {
+				t.Fatal(err)
+			}
+			mode, err := beadsview.ParseSortMode(tt.sort)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := ids(beadsview.FilterIssues(fixture(), tt.filter, mode)); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	for _, bad := range []string{"", "status:", "priority:7", "owner:me"} {
+		if err := beadsview.ValidateFilter(bad); err == nil {
+			t.Errorf("expected %q to be rejected", bad)
+		}
+	}
+	if _, err := beadsview.ParseSortMode("size"); err == nil {
+		t.Error("expected an unknown sort key to be rejected")
+	}
+	if beadsview.SortVotes.Next() != beadsview.SortDefault {
+		t.Error("expected sort modes to wrap around")
+	}
+}
+
+func TestParseFilter(t *testing.T) {
+	issues := fixture()
+	issueMap := make(map[string]*beadsview.Issue, len(issues))
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+	stale := map[string]func(beadsview.Issue) bool{"stale": func(issue beadsview.Issue) bool { return issue.ID == "D" }}
+	matching := func(t *testing.T, filter string) string {
+		t.Helper()
+		f, err := beadsview.ParseFilter(filter, stale)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, issue := range issues {
+			if f.Match(issue, issueMap) {
+				got = append(got, issue.ID)
+			}
+		}
+		return strings.Join(got, ",")
+	}
+	for filter, want := range map[string]string{
+		"status:open AND (label:api OR priority<=1) AND blocked:false": "B",
+		"open blocked:true":           "C",
+		"priority>=2":                 "C,D",
+		"priority!=2 priority<2":      "A,B",
+		"NOT label:api":               "A,D",
+		"label!=api":                  "A,D",
+		"type:FEATURE or type:chore":  "B,C,D",
+		"closed OR ready":             "A,B,D",
+		"open and not (type:feature)": "D",
+		"(label:web)":                 "C",
+		"stale OR closed":             "A,D",
+	} {
+		if got := matching(t, filter); got != want {
+			t.Errorf("%s: got %s, want %s", filter, got, want)
+		}
+	}
+	if got := ids(beadsview.FilterIssues(issues, "label:api OR closed", beadsview.SortDefault)); got != "B,C,A" {
+		t.Errorf("FilterIssues with OR: got %s", got)
+	}
+
+	for filter, want := range map[string]string{
+		"open AND":       "expected a filter term at the end",
+		"(open":          "expected ) at the end",
+		"open )":         `unexpected ")" at column 6`,
+		"OR open":        `unexpected "OR" at column 1`,
+		"open owner:me":  `unknown filter "owner:me" (want assignee, blocked, label, priority, status, type) at column 6`,
+		"label<api":      "only priority takes <",
+		"blocked:maybe":  "blocked takes true or false",
+		"priority<=5":    "priority must be 0-4",
+		"not stale open": "unknown filter \"stale\"",
+	} {
+		_, err := beadsview.ParseFilter(filter, nil)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%s: expected an error containing %q, got %v", filter, want, err)
+		}
+	}
+}
+
+func TestParseFilter_QuotedValues(t *testing.T) {
+	issues := []beadsview.Issue{
+		{ID: "A", Status: beadsview.StatusOpen, Labels: []string{"needs review"}},
+		{ID: "B", Status: beadsview.StatusOpen, Labels: []string{"needs"}},
+		{ID: "C", Status: beadsview.StatusOpen, Labels: []string{`ui (old) "v1"`}, Assignee: "Ana Lima"},
+	}
+	for filter, want := range map[string]string{
+		`label:"needs review"`:                                 "A",
+		`label:needs`:                                          "B",
+		`(label:"needs review" OR label:needs)`:                "A,B",
+		`NOT label="needs review"`:                             "B,C",
+		`assignee:"ana lima"`:                                  "C",
+		"label:" + beadsview.QuoteFilterValue(`ui (old) "v1"`): "C",
+	} {
+		f, err := beadsview.ParseFilter(filter, nil)
+		if err != nil {
+			t.Fatalf("%s: %v", filter, err)
+		}
+		var got []string
+		for _, issue := range issues {
+			if f.Match(issue, nil) {
+				got = append(got, issue.ID)
+			}
+		}
+		if strings.Join(got, ",") != want {
+			t.Errorf("%s: got %v, want %s", filter, got, want)
+		}
+	}
+
+	if got := beadsview.QuoteFilterValue("api"); got != "api" {
+		t.Errorf("expected a plain value unquoted, got %s", got)
+	}
+	if _, err := beadsview.ParseFilter(`label:"needs review`, nil); err == nil || !strings.Contains(err.Error(), "quote") {
+		t.Errorf("expected an unterminated quote to be reported, got %v", err)
+	}
+}
+
+func TestSortByVotes(t *testing.T) {
+	vote := func(author, text string, hours int) *beadsview.Comment {
+		return &beadsview.Comment{Author: author, Text: text, CreatedAt: now.Add(time.Duration(hours) * time.Hour)}
+	}
+	issues := fixture()
+	issues[2].Comments = []*beadsview.Comment{vote("ana", "+1", 1), vote("kim", "+1", 2)}
+	issues[3].Comments = []*beadsview.Comment{vote("ana", "+1", 1), vote("kim", "+1", 2), vote("kim", "-1", 3)}
+	mode, err := beadsview.ParseSortMode("votes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ids(beadsview.FilterIssues(issues, "all", mode)); got != "C,D,B,A" {
+		t.Errorf("expected the most voted first, then the default order, got %s", got)
+	}
+}
+
+func TestParseSort(t *testing.T) {
+	order := func(spec string) string {
+		t.Helper()
+		s, err := beadsview.ParseSort(spec)
+		if err != nil {
+			t.Fatalf("%q: %v", spec, err)
+		}
+		issues := fixture()
+		var out []string
+		for _, i := range s.Order(issues, now) {
+			out = append(out, issues[i].ID)
+		}
+		return strings.Join(out, ",")
+	}
+	tests := []struct{ spec, want string }{
+		{"default", "B,D,C,A"},
+		{"priority,created", "B,A,C,D"},
+		{"priority, -created", "B,A,D,C"},
+		{"priority*10 - age_days", "D,C,B,A"},
+		{"-(priority*10 - age_days)", "A,B,C,D"},
+		{"open, age_days", "B,C,D,A"},
+		{"max(idle_days, 2), -priority", "A,D,B,C"},
+	}
+	for _, tt := range tests {
+		if got := order(tt.spec); got != tt.want {
+			t.Errorf("%q: got %s, want %s", tt.spec, got, tt.want)
+		}
+	}
+
+	s, _ := beadsview.ParseSort("priority,  age_days*2 ")
+	if s.String() != "priority,age_days*2" {
+		t.Errorf("expected the sort written back, got %q", s.String())
+	}
+	if mode, ok := s.Mode(); ok {
+		t.Errorf("expected no sort mode for a composite sort, got %v", mode)
+	}
+	if mode, ok := (beadsview.Sort{}).Mode(); !ok || mode != beadsview.SortDefault {
+		t.Error("expected the zero sort to be the default")
+	}
+
+	for spec, want := range map[string]string{
+		"":                 "empty term",
+		"priority,,votes":  "empty term",
+		"priorty":          `unknown field "priorty"`,
+		"priority*":        "expected a value at the end",
+		"min(priority":     "expected , or )",
+		"sqrt(age_days)":   `unknown function "sqrt"`,
+		"abs(1, 2)":        "abs takes one argument",
+		"age_days 3":       `unexpected "3" at column 10`,
+		"(age_days":        "expected )",
+		"1.2.3":            "invalid number",
+		"age_days # votes": `unexpected "#"`,
+	} {
+		if _, err := beadsview.ParseSort(spec); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%q: expected an error mentioning %q, got %v", spec, want, err)
+		}
+	}
+}
+
+func TestExprEval(t *testing.T) {
+	issue := fixture()[2]
+	minutes := 90
+	due := now.Add(-36 * time.Hour)
+	issue.EstimatedMinutes, issue.DueDate = &minutes, &due
+	for src, want := range map[string]float64{
+		"age_days":                5,
+		"idle_days - 1":           1,
+		"due_days":                -1.5,
+		"estimate_hours * 2":      3,
+		"labels + dependencies":   3,
+		"open / 0":                0,
+		"-priority + abs(-3)":     1,
+		"min(5, labels, 7) * 1.5": 3,
+	} {
+		e, err := beadsview.ParseExpr(src)
+		if err != nil {
+			t.Fatalf("%q: %v", src, err)
+		}
+		if got := e.Eval(issue, now); got != want {
+			t.Errorf("%q = %v, want %v", src, got, want)
+		}
+	}
+	if fields := beadsview.ExprFields(); !sort.StringsAreSorted(fields) || len(fields) != 10 {
+		t.Errorf("expected the 10 fields sorted, got %v", fields)
+	}
+}
+
+func TestJSONLRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	if err := beadsview.WriteJSONL(&buf, fixture()); err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(buf.String(), "\n"); n != 4 {
+		t.Fatalf("expected one line per issue, got %d", n)
+	}
+	got, err := beadsview.Parse(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids(got) != "A,B,C,D" || got[2].Dependencies[0].DependsOnID != "B" || !got[1].UpdatedAt.Equal(fixture()[1].UpdatedAt) {
+		t.Fatalf("issues did not survive the round trip: %+v", got)
+	}
+
+	dir := testutil.TempBeadsDir(t)
+	testutil.WriteBeadsFile(t, dir, fixture())
+	loaded, err := beadsview.Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids(loaded) != "A,B,C,D" {
+		t.Errorf("Load returned %s", ids(loaded))
+	}
+}
+
+func TestAnalyzeAndTriage(t *testing.T) {
+	issues := fixture()
+	g := beadsview.Analyze(issues)
+
+	if got := g.OpenBlockers("C"); !reflect.DeepEqual(got, []string{"B"}) {
+		t.Errorf("OpenBlockers(C) = %v", got)
+	}
+	if got := g.OpenBlockers("B"); len(got) != 0 {
+		t.Errorf("closed blockers should not count, got %v", got)
+	}
+	if ids(g.Actionable()) != "B,D" {
+		t.Errorf("Actionable = %s", ids(g.Actionable()))
+	}
+	if len(g.Cycles()) != 0 {
+		t.Errorf("unexpected cycles %v", g.Cycles())
+	}
+	if b, c := g.Metrics("B"), g.Metrics("C"); b.PageRank <= c.PageRank {
+		t.Errorf("expected the blocker to outrank what it blocks: B=%+v C=%+v", b, c)
+	}
+	if g.Metrics("missing") != (beadsview.Metrics{}) {
+		t.Error("expected zero metrics for an unknown ID")
+	}
+	dot, err := g.DOT()
+	if err != nil || !strings.Contains(dot, "digraph") {
+		t.Errorf("DOT() = %q, %v", dot, err)
+	}
+	mermaid, err := g.Mermaid()
+	if err != nil || !strings.Contains(mermaid, "graph") {
+		t.Errorf("Mermaid() = %q, %v", mermaid, err)
+	}
+
+	recs := beadsview.Triage(issues, now)
+	if len(recs) == 0 || recs[0].ID != "B" {
+		t.Fatalf("expected B, which unblocks C, first: %+v", recs)
+	}
+	if !reflect.DeepEqual(recs[0].Unblocks, []string{"C"}) {
+		t.Errorf("expected B to unblock C, got %v", recs[0].Unblocks)
+	}
+
+	md, err := beadsview.ExportMarkdown(issues, "Report")
+	if err != nil || !strings.Contains(md, "Report") || !strings.Contains(md, "Client") {
+		t.Errorf("ExportMarkdown: %v\n%s", err, md)
+	}
+}
+
+// TestAPIGolden records the exported API. A diff here is an API change:
+// within a major version it may only add to the API (see the package
+// documentation). Regenerate with GENERATE_GOLDEN=1 once that is checked.
+func TestAPIGolden(t *testing.T) {
+	testutil.NewGoldenFile(t, testutil.GoldenDir("../..", "beadsview"), "api.golden").Assert(exportedAPI(t, "."))
+}
+
+// exportedAPI prints the exported declarations of the package in dir,
+// without bodies, comments or unexported struct fields, sorted.
+func exportedAPI(t *testing.T, dir string) string {
+	t.Helper()
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	print := func(node any) string {
+		var buf bytes.Buffer
+		if err := printer.Fprint(&buf, fset, node); err != nil {
+			t.Fatal(err)
+		}
+		return buf.String()
+	}
+
+	var decls []string
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				switch d := decl.(type) {
+				case *ast.FuncDecl:
+					if !d.Name.IsExported() || (d.Recv != nil && !ast.IsExported(receiverName(d.Recv))) {
+						continue
+					}
+					d.Body, d.Doc = nil, nil
+					decls = append(decls, print(d))
+				case *ast.GenDecl:
+					for k, spec := range d.Specs {
+						switch s := spec.(type) {
+						case *ast.TypeSpec:
+							if !s.Name.IsExported() {
+								continue
+							}
+							s.Doc, s.Comment = nil, nil
+							if st, ok := s.Type.(*ast.StructType); ok {
+								st.Fields.List = exportedFields(st.Fields.List)
+							}
+							decls = append(decls, "type "+print(s))
+						case *ast.ValueSpec:
+							for i, name := range s.Names {
+								if name.IsExported() {
+									decl := d.Tok.String() + " " + name.Name
+									if isIotaDecl(d) {
+										// Renumbering a constant changes its meaning
+										decl += fmt.Sprintf(" = %d", k)
+									} else if i < len(s.Values) {
+										decl += " = " + print(s.Values[i])
+									}
+									decls = append(decls, decl)
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	sort.Strings(decls)
+	return strings.Join(decls, "\n") + "\n"
+}
+
+// isIotaDecl reports whether d is a const block enumerated with a bare iota.
+func isIotaDecl(d *ast.GenDecl) bool {
+	if d.Tok != token.CONST || len(d.Specs) == 0 {
+		return false
+	}
+	first := d.Specs[0].(*ast.ValueSpec)
+	if len(first.Values) != 1 {
+		return false
+	}
+	ident, ok := first.Values[0].(*ast.Ident)
+	return ok && ident.Name == "iota"
+}
+
+func receiverName(recv *ast.FieldList) string {
+	typ := recv.List[0].Type
+	if star, ok := typ.(*ast.StarExpr); ok {
+		typ = star.X
+	}
+	if ident, ok := typ.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+func exportedFields(fields []*ast.Field) []*ast.Field {
+	var out []*ast.Field
+	for _, f := range fields {
+		var names []*ast.Ident
+		for _, n := range f.Names {
+			if n.IsExported() {
+				names = append(names, n)
+			}
+		}
+		if len(names) > 0 {
+			f.Names, f.Doc, f.Comment = names, nil, nil
+			out = append(out, f)
+		}
+	}
+	return out
+}
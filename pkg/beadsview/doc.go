@@ -0,0 +1,36 @@
+// Package beadsview is the stable Go API of beads_viewer: loading beads
+// issues, querying them the way the bv list does, analyzing the dependency
+// graph, and exporting the results, without the terminal UI.
+//
+// # Compatibility
+//
+// This package follows semantic versioning with the beads_viewer module:
+// within a major version, exported identifiers are not removed or renamed,
+// function signatures do not change, and struct fields are only added. Filter
+// terms and sort keys accepted today keep their meaning. Scores and
+// recommendation order may improve between minor versions; compare them
+// relative to each other, not against fixed values.
+//
+// The other packages under pkg/ are implementation details of bv and may
+// change in any release. The data model types here are aliases of
+// pkg/model, and are covered by the same guarantee.
+//
+// The exported API is recorded in testdata/golden/beadsview/api.golden; a
+// change to it fails the tests until the golden file is regenerated, so it
+// is always a deliberate decision.
+//
+// # Example
+//
+//	issues, err := beadsview.Load(".")
+//	if err != nil {
+//	    return err
+//	}
+//	for _, issue := range beadsview.FilterIssues(issues, "ready label:api", beadsview.SortPriority) {
+//	    fmt.Println(issue.ID, issue.Title)
+//	}
+//
+//	g := beadsview.Analyze(issues)
+//	for _, rec := range beadsview.Triage(issues, time.Now()) {
+//	    fmt.Println(rec.ID, rec.Score, g.OpenBlockers(rec.ID))
+//	}
+package beadsview
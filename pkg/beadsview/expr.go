@@ -0,0 +1,294 @@
+package beadsview
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Expr is a score computed from an issue's fields, such as
+// "priority*10 - age_days". Expressions use numbers, the fields below,
+// + - * / with the usual precedence, parentheses, and the functions
+// min(a, b, ...), max(a, b, ...) and abs(x). Division by zero is 0.
+//
+//	priority        0 (critical) to 4 (backlog)
+//	age_days        days since the issue was created
+//	idle_days       days since it was last updated
+//	due_days        days until its due date, negative once overdue; 0 without one
+//	estimate_hours  its estimate, 0 without one
+//	votes           number of voters
+//	comments        number of comments
+//	labels          number of labels
+//	dependencies    number of blocking dependencies
+//	open            1 unless closed, else 0
+type Expr struct {
+	src  string
+	eval func(issue *Issue, now time.Time) float64
+}
+
+// exprFields are the fields an expression can use.
+var exprFields = map[string]func(issue *Issue, now time.Time) float64{
+	"priority": func(i *Issue, _ time.Time) float64 { return float64(i.Priority) },
+	"age_days": func(i *Issue, now time.Time) float64 {
+		return now.Sub(i.CreatedAt).Hours() / 24
+	},
+	"idle_days": func(i *Issue, now time.Time) float64 {
+		return now.Sub(i.UpdatedAt).Hours() / 24
+	},
+	"due_days": func(i *Issue, now time.Time) float64 {
+		if i.DueDate == nil {
+			return 0
+		}
+		return i.DueDate.Sub(now).Hours() / 24
+	},
+	"estimate_hours": func(i *Issue, _ time.Time) float64 {
+		if i.EstimatedMinutes == nil {
+			return 0
+		}
+		return float64(*i.EstimatedMinutes) / 60
+	},
+	"votes":    func(i *Issue, _ time.Time) float64 { return float64(len(i.Voters())) },
+	"comments": func(i *Issue, _ time.Time) float64 { return float64(len(i.Comments)) },
+	"labels":   func(i *Issue, _ time.Time) float64 { return float64(len(i.Labels)) },
+	"dependencies": func(i *Issue, _ time.Time) float64 {
+		n := 0
+		for _, dep := range i.Dependencies {
+			if dep != nil && dep.Type.IsBlocking() {
+				n++
+			}
+		}
+		return float64(n)
+	},
+	"open": func(i *Issue, _ time.Time) float64 {
+		if isClosedLike(i.Status) {
+			return 0
+		}
+		return 1
+	},
+}
+
+// ExprFields lists the fields an expression can use, sorted.
+func ExprFields() []string {
+	names := make([]string, 0, len(exprFields))
+	for name := range exprFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ParseExpr parses a score expression.
+func ParseExpr(src string) (*Expr, error) {
+	p := exprParser{src: src}
+	p.next()
+	eval, err := p.sum()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok != "" {
+		return nil, fmt.Errorf("unexpected %q at column %d", p.tok, p.tokPos+1)
+	}
+	return &Expr{src: strings.TrimSpace(src), eval: eval}, nil
+}
+
+// Eval computes the score of issue, with ages counted up to now.
+func (e *Expr) Eval(issue Issue, now time.Time) float64 {
+	return e.eval(&issue, now)
+}
+
+// String returns the expression as written.
+func (e *Expr) String() string {
+	return e.src
+}
+
+type evalFunc = func(issue *Issue, now time.Time) float64
+
+// exprParser is a recursive descent parser over the tokens of src: numbers,
+// names, and single-character operators.
+type exprParser struct {
+	src    string
+	pos    int
+	tok    string // Current token; "" at the end
+	tokPos int
+}
+
+func (p *exprParser) next() {
+	for p.pos < len(p.src) && (p.src[p.pos] == ' ' || p.src[p.pos] == '\t') {
+		p.pos++
+	}
+	p.tokPos = p.pos
+	if p.pos >= len(p.src) {
+		p.tok = ""
+		return
+	}
+	start := p.pos
+	switch c := p.src[p.pos]; {
+	case c >= '0' && c <= '9' || c == '.':
+		for p.pos < len(p.src) && (p.src[p.pos] >= '0' && p.src[p.pos] <= '9' || p.src[p.pos] == '.') {
+			p.pos++
+		}
+	case c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+		for p.pos < len(p.src) && (p.src[p.pos] == '_' || p.src[p.pos] >= 'a' && p.src[p.pos] <= 'z' ||
+			p.src[p.pos] >= 'A' && p.src[p.pos] <= 'Z' || p.src[p.pos] >= '0' && p.src[p.pos] <= '9') {
+			p.pos++
+		}
+	default:
+		p.pos++
+	}
+	p.tok = p.src[start:p.pos]
+}
+
+func (p *exprParser) errorf(format string, args ...any) error {
+	if p.tok == "" {
+		return fmt.Errorf(format+" at the end", args...)
+	}
+	return fmt.Errorf(format+" at column %d", append(args, p.tokPos+1)...)
+}
+
+// sum parses terms joined by + and -.
+func (p *exprParser) sum() (evalFunc, error) {
+	left, err := p.product()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok == "+" || p.tok == "-" {
+		op := p.tok
+		p.next()
+		right, err := p.product()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		if op == "+" {
+			left = func(i *Issue, now time.Time) float64 { return l(i, now) + right(i, now) }
+		} else {
+			left = func(i *Issue, now time.Time) float64 { return l(i, now) - right(i, now) }
+		}
+	}
+	return left, nil
+}
+
+// product parses factors joined by * and /.
+func (p *exprParser) product() (evalFunc, error) {
+	left, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok == "*" || p.tok == "/" {
+		op := p.tok
+		p.next()
+		right, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		if op == "*" {
+			left = func(i *Issue, now time.Time) float64 { return l(i, now) * right(i, now) }
+		} else {
+			left = func(i *Issue, now time.Time) float64 {
+				d := right(i, now)
+				if d == 0 {
+					return 0
+				}
+				return l(i, now) / d
+			}
+		}
+	}
+	return left, nil
+}
+
+func (p *exprParser) unary() (evalFunc, error) {
+	if p.tok == "-" {
+		p.next()
+		operand, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return func(i *Issue, now time.Time) float64 { return -operand(i, now) }, nil
+	}
+	return p.primary()
+}
+
+func (p *exprParser) primary() (evalFunc, error) {
+	tok := p.tok
+	switch {
+	case tok == "":
+		return nil, p.errorf("expected a value")
+	case tok == "(":
+		p.next()
+		inner, err := p.sum()
+		if err != nil {
+			return nil, err
+		}
+		if p.tok != ")" {
+			return nil, p.errorf("expected )")
+		}
+		p.next()
+		return inner, nil
+	case tok[0] >= '0' && tok[0] <= '9' || tok[0] == '.':
+		v, err := strconv.ParseFloat(tok, 64)
+		if err != nil {
+			return nil, p.errorf("invalid number %q", tok)
+		}
+		p.next()
+		return func(*Issue, time.Time) float64 { return v }, nil
+	case tok[0] == '_' || tok[0] >= 'a' && tok[0] <= 'z' || tok[0] >= 'A' && tok[0] <= 'Z':
+		name := strings.ToLower(tok)
+		p.next()
+		if p.tok == "(" {
+			return p.call(name)
+		}
+		field, ok := exprFields[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown field %q (want %s)", tok, strings.Join(ExprFields(), ", "))
+		}
+		return field, nil
+	}
+	return nil, p.errorf("unexpected %q", tok)
+}
+
+// call parses the arguments of function name; the current token is "(".
+func (p *exprParser) call(name string) (evalFunc, error) {
+	if name != "min" && name != "max" && name != "abs" {
+		return nil, fmt.Errorf("unknown function %q (want min, max, abs)", name)
+	}
+	var args []evalFunc
+	p.next()
+	for p.tok != ")" {
+		arg, err := p.sum()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		if p.tok == "," {
+			p.next()
+		} else if p.tok != ")" {
+			return nil, p.errorf("expected , or )")
+		}
+	}
+	p.next()
+
+	if name == "abs" {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("abs takes one argument, got %d", len(args))
+		}
+		return func(i *Issue, now time.Time) float64 { return math.Abs(args[0](i, now)) }, nil
+	}
+	if len(args) == 0 {
+		return nil, fmt.Errorf("%s needs an argument", name)
+	}
+	pick := math.Min
+	if name == "max" {
+		pick = math.Max
+	}
+	return func(i *Issue, now time.Time) float64 {
+		v := args[0](i, now)
+		for _, arg := range args[1:] {
+			v = pick(v, arg(i, now))
+		}
+		return v
+	}, nil
+}
@@ -0,0 +1,347 @@
+package beadsview
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// Filter is a parsed issue filter, such as
+// "status:open AND (label:backend OR priority<=1) AND blocked:false".
+//
+// A filter combines terms with AND, OR and NOT (in any case) and
+// parentheses; AND binds tighter than OR, and terms side by side are joined
+// by AND, so "open label:api" is "open AND label:api". The terms are:
+//
+//	all, open, closed   every issue, those not closed, those closed
+//	ready               open, not blocked, with no open blockers
+//	status:NAME         the named status; closed includes tombstones
+//	label:NAME          issues carrying the label
+//	assignee:NAME       issues assigned to NAME, ignoring case
+//	type:NAME           issues of the type (bug, feature, task, ...)
+//	priority:N          priority N, 0-4; also priority<N, <=, >, >=
+//	blocked:BOOL        issues with status blocked or an open blocker
+//
+// "=" may be used instead of ":", and "!=" negates a term. A value with
+// spaces or parentheses is written in double quotes, as in
+// label:"needs review"; QuoteFilterValue quotes one when needed.
+type Filter struct {
+	src   string
+	match matchFunc
+}
+
+type matchFunc = func(issue *Issue, issueMap map[string]*Issue) bool
+
+// FilterFields lists the keys a filter term can take, sorted.
+func FilterFields() []string {
+	return []string{"assignee", "blocked", "label", "priority", "status", "type"}
+}
+
+// ParseFilter parses a filter. keywords adds bare terms the caller matches
+// itself, such as the TUI's stale; it may be nil.
+func ParseFilter(src string, keywords map[string]func(Issue) bool) (*Filter, error) {
+	p := filterParser{src: src, keywords: keywords}
+	p.next()
+	if p.tok == "" {
+		return nil, fmt.Errorf("expected a filter")
+	}
+	match, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok != "" {
+		return nil, p.errorf("unexpected %q", p.tok)
+	}
+	return &Filter{src: strings.TrimSpace(src), match: match}, nil
+}
+
+// Match reports whether issue passes the filter. issueMap resolves
+// blockers for ready and blocked.
+func (f *Filter) Match(issue Issue, issueMap map[string]*Issue) bool {
+	return f.match(&issue, issueMap)
+}
+
+// String returns the filter as written.
+func (f *Filter) String() string {
+	return f.src
+}
+
+// QuoteFilterValue returns value as a filter term writes it: in double
+// quotes if it has spaces, parentheses or quotes, else as is.
+func QuoteFilterValue(value string) string {
+	if strings.ContainsAny(value, " \t()\"") {
+		return strconv.Quote(value)
+	}
+	return value
+}
+
+// filterParser is a recursive descent parser over the words of src, with
+// parentheses as words of their own. A double-quoted part of a word, with
+// Go escapes, may hold spaces and parentheses.
+type filterParser struct {
+	src      string
+	keywords map[string]func(Issue) bool
+	pos      int
+	tok      string // Current word; "" at the end
+	tokPos   int
+}
+
+func (p *filterParser) next() {
+	for p.pos < len(p.src) && (p.src[p.pos] == ' ' || p.src[p.pos] == '\t') {
+		p.pos++
+	}
+	p.tokPos = p.pos
+	start := p.pos
+	if p.pos < len(p.src) && (p.src[p.pos] == '(' || p.src[p.pos] == ')') {
+		p.pos++
+	} else {
+		for p.pos < len(p.src) && !strings.ContainsRune(" \t()", rune(p.src[p.pos])) {
+			if p.src[p.pos] == '"' {
+				p.skipQuoted()
+				continue
+			}
+			p.pos++
+		}
+	}
+	p.tok = p.src[start:p.pos]
+}
+
+// skipQuoted moves past the quoted string at p.pos, or to the end of src if
+// it is not closed.
+func (p *filterParser) skipQuoted() {
+	for p.pos++; p.pos < len(p.src); p.pos++ {
+		switch p.src[p.pos] {
+		case '\\':
+			p.pos++
+		case '"':
+			p.pos++
+			return
+		}
+	}
+	p.pos = len(p.src)
+}
+
+func (p *filterParser) errorf(format string, args ...any) error {
+	if p.tok == "" {
+		return fmt.Errorf(format+" at the end", args...)
+	}
+	return fmt.Errorf(format+" at column %d", append(args, p.tokPos+1)...)
+}
+
+// is reports whether the current word is the operator op, ignoring case.
+func (p *filterParser) is(op string) bool {
+	return strings.EqualFold(p.tok, op)
+}
+
+// or parses and-groups joined by OR.
+func (p *filterParser) or() (matchFunc, error) {
+	left, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+	for p.is("or") {
+		p.next()
+		right, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(i *Issue, m map[string]*Issue) bool { return l(i, m) || right(i, m) }
+	}
+	return left, nil
+}
+
+// and parses negations joined by AND or by nothing at all.
+func (p *filterParser) and() (matchFunc, error) {
+	left, err := p.not()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok != "" && p.tok != ")" && !p.is("or") {
+		if p.is("and") {
+			p.next()
+		}
+		right, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(i *Issue, m map[string]*Issue) bool { return l(i, m) && right(i, m) }
+	}
+	return left, nil
+}
+
+func (p *filterParser) not() (matchFunc, error) {
+	if p.is("not") {
+		p.next()
+		operand, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		return func(i *Issue, m map[string]*Issue) bool { return !operand(i, m) }, nil
+	}
+	return p.primary()
+}
+
+func (p *filterParser) primary() (matchFunc, error) {
+	switch {
+	case p.tok == "":
+		return nil, p.errorf("expected a filter term")
+	case p.tok == "(":
+		p.next()
+		inner, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if p.tok != ")" {
+			return nil, p.errorf("expected )")
+		}
+		p.next()
+		return inner, nil
+	case p.tok == ")" || p.is("and") || p.is("or"):
+		return nil, p.errorf("unexpected %q", p.tok)
+	}
+	match, err := p.term(p.tok)
+	if err != nil {
+		return nil, fmt.Errorf("%w at column %d", err, p.tokPos+1)
+	}
+	p.next()
+	return match, nil
+}
+
+// term parses a single filter term.
+func (p *filterParser) term(word string) (matchFunc, error) {
+	switch word {
+	case "all":
+		return func(*Issue, map[string]*Issue) bool { return true }, nil
+	case "open":
+		return func(i *Issue, _ map[string]*Issue) bool { return !isClosedLike(i.Status) }, nil
+	case "closed":
+		return func(i *Issue, _ map[string]*Issue) bool { return isClosedLike(i.Status) }, nil
+	case "ready":
+		return func(i *Issue, m map[string]*Issue) bool {
+			return !isClosedLike(i.Status) && i.Status != model.StatusBlocked && !hasOpenBlocker(i, m)
+		}, nil
+	}
+	if keyword, ok := p.keywords[word]; ok {
+		return func(i *Issue, _ map[string]*Issue) bool { return keyword(*i) }, nil
+	}
+
+	key, op, value, ok := splitFilterTerm(word)
+	if !ok {
+		return nil, fmt.Errorf("unknown filter %q", word)
+	}
+	if strings.HasPrefix(value, `"`) {
+		unquoted, err := strconv.Unquote(value)
+		if err != nil {
+			return nil, fmt.Errorf("unterminated or malformed quote in %q", word)
+		}
+		value = unquoted
+	}
+	if key != "priority" && op != "=" && op != "!=" {
+		return nil, fmt.Errorf("only priority takes %s in %q", op, word)
+	}
+	var match func(i *Issue, m map[string]*Issue) bool
+	switch key {
+	case "status":
+		if value == "" {
+			return nil, fmt.Errorf("expected a status in %q", word)
+		}
+		match = func(i *Issue, _ map[string]*Issue) bool { return MatchesStatus(i.Status, value) }
+	case "label":
+		if value == "" {
+			return nil, fmt.Errorf("expected a label in %q", word)
+		}
+		match = func(i *Issue, _ map[string]*Issue) bool {
+			for _, l := range i.Labels {
+				if l == value {
+					return true
+				}
+			}
+			return false
+		}
+	case "assignee":
+		if value == "" {
+			return nil, fmt.Errorf("expected an assignee in %q", word)
+		}
+		match = func(i *Issue, _ map[string]*Issue) bool { return strings.EqualFold(i.Assignee, value) }
+	case "type":
+		if value == "" {
+			return nil, fmt.Errorf("expected a type in %q", word)
+		}
+		match = func(i *Issue, _ map[string]*Issue) bool { return strings.EqualFold(string(i.IssueType), value) }
+	case "blocked":
+		want, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("blocked takes true or false in %q", word)
+		}
+		match = func(i *Issue, m map[string]*Issue) bool {
+			blocked := !isClosedLike(i.Status) && (i.Status == model.StatusBlocked || hasOpenBlocker(i, m))
+			return blocked == want
+		}
+	case "priority":
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 || n > 4 {
+			return nil, fmt.Errorf("priority must be 0-4 in %q", word)
+		}
+		return func(i *Issue, _ map[string]*Issue) bool { return comparePriority(i.Priority, op, n) }, nil
+	default:
+		return nil, fmt.Errorf("unknown filter %q (want %s)", word, strings.Join(FilterFields(), ", "))
+	}
+	if op == "!=" {
+		return func(i *Issue, m map[string]*Issue) bool { return !match(i, m) }, nil
+	}
+	return match, nil
+}
+
+// splitFilterTerm splits a filter term into key, operator and value. The
+// operator is one of = != < <= > >=, with ":" read as "=".
+func splitFilterTerm(term string) (key, op, value string, ok bool) {
+	i := strings.IndexAny(term, ":=!<>")
+	if i <= 0 {
+		return "", "", "", false
+	}
+	key, rest := strings.ToLower(term[:i]), term[i:]
+	for _, op := range []string{"!=", "<=", ">=", ":", "=", "<", ">"} {
+		if strings.HasPrefix(rest, op) {
+			if op == ":" {
+				return key, "=", rest[1:], true
+			}
+			return key, op, rest[len(op):], true
+		}
+	}
+	return "", "", "", false
+}
+
+func comparePriority(priority int, op string, n int) bool {
+	switch op {
+	case "!=":
+		return priority != n
+	case "<":
+		return priority < n
+	case "<=":
+		return priority <= n
+	case ">":
+		return priority > n
+	case ">=":
+		return priority >= n
+	default:
+		return priority == n
+	}
+}
+
+// hasOpenBlocker reports whether a blocking dependency of issue is still
+// open. Blockers missing from issueMap don't count.
+func hasOpenBlocker(issue *Issue, issueMap map[string]*Issue) bool {
+	for _, dep := range issue.Dependencies {
+		if dep == nil || !dep.Type.IsBlocking() {
+			continue
+		}
+		if blocker, exists := issueMap[dep.DependsOnID]; exists && !isClosedLike(blocker.Status) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,38 @@
+package beadsview_test
+
+import (
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/beadsview"
+)
+
+// FuzzFilter checks that no filter or sort key makes the query functions
+// panic, and that a filter ValidateFilter rejects matches nothing.
+//
+// Run with: go test -fuzz=FuzzFilter ./pkg/beadsview/
+func FuzzFilter(f *testing.F) {
+	for _, seed := range []string{
+		"", "all", "open closed", "ready label:api", "priority:0", "priority=4", "priority:9", "priority:-1",
+		"status:in_progress", "label:", ":", "=", "label::x", "status=open=x", "priority:99999999999999999999",
+		"-created", "votes", "\x00", "label:日本", "  open\t ready ",
+		"open AND (label:api OR priority<=1) AND blocked:false", "NOT NOT open", "((open)", "open OR", ")",
+		"priority>", "label!=api", "and", "type:Feature or", "(",
+	} {
+		f.Add(seed)
+	}
+
+	issues := fixture()
+	issueMap := make(map[string]*beadsview.Issue, len(issues))
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+	f.Fuzz(func(t *testing.T, filter string) {
+		valid := beadsview.ValidateFilter(filter) == nil
+		for _, issue := range issues {
+			if beadsview.MatchesFilter(issue, filter, issueMap) && !valid {
+				t.Fatalf("invalid filter %q matched %s", filter, issue.ID)
+			}
+		}
+		_, _ = beadsview.ParseSortMode(filter)
+	})
+}
@@ -0,0 +1,307 @@
+package beadsview
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// SortMode is an issue list order.
+type SortMode int
+
+const (
+	SortDefault     SortMode = iota // Open first, then priority, then newest
+	SortCreatedAsc                  // By creation date, oldest first
+	SortCreatedDesc                 // By creation date, newest first
+	SortPriority                    // By priority only (P0 first)
+	SortUpdated                     // By last update, newest first
+	SortVotes                       // By number of voters, most first
+	numSortModes                    // Keep this last - used for cycling
+)
+
+// String returns a human-readable label for the sort mode.
+func (s SortMode) String() string {
+	switch s {
+	case SortCreatedAsc:
+		return "Created ↑"
+	case SortCreatedDesc:
+		return "Created ↓"
+	case SortPriority:
+		return "Priority"
+	case SortUpdated:
+		return "Updated"
+	case SortVotes:
+		return "Votes"
+	default:
+		return "Default"
+	}
+}
+
+// Next returns the mode after s, wrapping around to SortDefault.
+func (s SortMode) Next() SortMode {
+	return (s + 1) % numSortModes
+}
+
+// sortModeNames maps sort keys to sort modes. A leading "-" selects the
+// descending variant where one exists; priority always lists P0 first.
+var sortModeNames = map[string]SortMode{
+	"default":   SortDefault,
+	"created":   SortCreatedAsc,
+	"-created":  SortCreatedDesc,
+	"priority":  SortPriority,
+	"-priority": SortPriority,
+	"updated":   SortUpdated,
+	"-updated":  SortUpdated,
+	"votes":     SortVotes,
+	"-votes":    SortVotes,
+}
+
+// ParseSortMode resolves a sort key: default, created, -created, priority,
+// updated or votes.
+func ParseSortMode(name string) (SortMode, error) {
+	mode, ok := sortModeNames[name]
+	if !ok {
+		return SortDefault, fmt.Errorf("unknown sort key %q", name)
+	}
+	return mode, nil
+}
+
+// ValidateFilter checks that filter parses (see Filter).
+func ValidateFilter(filter string) error {
+	_, err := ParseFilter(filter, nil)
+	return err
+}
+
+// MatchesFilter reports whether issue passes filter (see Filter). A filter
+// that doesn't parse matches nothing. issueMap resolves blockers for ready
+// and blocked.
+func MatchesFilter(issue Issue, filter string, issueMap map[string]*Issue) bool {
+	f, err := ParseFilter(filter, nil)
+	return err == nil && f.Match(issue, issueMap)
+}
+
+// MatchesStatus reports whether status is the named status, ignoring case.
+// "closed" also matches tombstones.
+func MatchesStatus(status Status, name string) bool {
+	normalized := strings.ToLower(strings.TrimSpace(name))
+	statusKey := strings.ToLower(string(status))
+	switch normalized {
+	case string(model.StatusClosed):
+		return isClosedLike(status)
+	case string(model.StatusTombstone):
+		return status == model.StatusTombstone
+	case string(model.StatusOpen):
+		return status == model.StatusOpen
+	case string(model.StatusInProgress):
+		return status == model.StatusInProgress
+	case string(model.StatusBlocked):
+		return status == model.StatusBlocked
+	default:
+		return statusKey == normalized
+	}
+}
+
+func isClosedLike(status Status) bool {
+	return status == model.StatusClosed || status == model.StatusTombstone
+}
+
+// FilterIssues returns the issues matching filter in the order of mode, as
+// the bv list view shows them. Callers should check filter with
+// ValidateFilter.
+func FilterIssues(issues []Issue, filter string, mode SortMode) []Issue {
+	issueMap := make(map[string]*Issue, len(issues))
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+	f, err := ParseFilter(filter, nil)
+	var matched []Issue
+	for _, issue := range issues {
+		if err == nil && f.Match(issue, issueMap) {
+			matched = append(matched, issue)
+		}
+	}
+	out := make([]Issue, len(matched))
+	for newIdx, oldIdx := range SortOrder(matched, mode) {
+		out[newIdx] = matched[oldIdx]
+	}
+	return out
+}
+
+// SortOrder returns the indices of issues in the order of mode, leaving
+// issues unchanged so that parallel slices can be reordered with it.
+func SortOrder(issues []Issue, mode SortMode) []int {
+	return Sort{terms: []sortTerm{{mode: mode}}}.Order(issues, time.Now())
+}
+
+// Sort is an issue order made of one or more terms, separated by commas:
+// sort keys (see ParseSortMode) and score expressions (see ParseExpr),
+// which list the highest score first; negate one for the lowest first.
+// Later terms break ties of earlier ones, and the default order breaks the
+// rest. "priority,created" lists P0 first, the oldest first within each
+// priority. The zero Sort is the default order.
+type Sort struct {
+	terms []sortTerm
+}
+
+// sortTerm is a sort key, or a score expression when expr is set.
+type sortTerm struct {
+	key  string
+	mode SortMode
+	expr *Expr
+}
+
+// ParseSort parses a sort: comma-separated sort keys and score expressions.
+func ParseSort(spec string) (Sort, error) {
+	var s Sort
+	for _, part := range splitSortTerms(spec) {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			return Sort{}, fmt.Errorf("empty term in sort %q", spec)
+		}
+		if mode, ok := sortModeNames[part]; ok {
+			s.terms = append(s.terms, sortTerm{key: part, mode: mode})
+			continue
+		}
+		expr, err := ParseExpr(part)
+		if err != nil {
+			return Sort{}, fmt.Errorf("sort term %q: %w", part, err)
+		}
+		s.terms = append(s.terms, sortTerm{expr: expr})
+	}
+	if len(s.terms) == 0 {
+		return Sort{}, fmt.Errorf("expected a sort")
+	}
+	return s, nil
+}
+
+// splitSortTerms splits spec at the commas outside parentheses.
+func splitSortTerms(spec string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, c := range spec {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, spec[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, spec[start:])
+}
+
+// String returns the sort in the form ParseSort reads.
+func (s Sort) String() string {
+	if len(s.terms) == 0 {
+		return "default"
+	}
+	parts := make([]string, len(s.terms))
+	for i, t := range s.terms {
+		if t.expr != nil {
+			parts[i] = t.expr.String()
+		} else {
+			parts[i] = t.key
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// Mode returns the sort mode of a sort made of a single sort key.
+func (s Sort) Mode() (SortMode, bool) {
+	switch {
+	case len(s.terms) == 0:
+		return SortDefault, true
+	case len(s.terms) == 1 && s.terms[0].expr == nil:
+		return s.terms[0].mode, true
+	}
+	return SortDefault, false
+}
+
+// Order returns the indices of issues in the order of s, leaving issues
+// unchanged so that parallel slices can be reordered with it. Expressions
+// count ages up to now.
+func (s Sort) Order(issues []Issue, now time.Time) []int {
+	indices := make([]int, len(issues))
+	for i := range indices {
+		indices[i] = i
+	}
+
+	// Scores are computed once per issue; comparing them dominates on large
+	// lists.
+	scores := make([][]float64, len(s.terms))
+	for k, t := range s.terms {
+		if t.expr == nil && t.mode != SortVotes {
+			continue
+		}
+		scores[k] = make([]float64, len(issues))
+		for i := range issues {
+			if t.expr != nil {
+				scores[k][i] = t.expr.eval(&issues[i], now)
+			} else {
+				scores[k][i] = float64(len(issues[i].Voters()))
+			}
+		}
+	}
+
+	sort.SliceStable(indices, func(i, j int) bool {
+		a, b := indices[i], indices[j]
+		for k, t := range s.terms {
+			var c int
+			if scores[k] != nil {
+				// Highest first
+				c = compareFloat(scores[k][b], scores[k][a])
+			} else {
+				c = compareByMode(&issues[a], &issues[b], t.mode)
+			}
+			if c != 0 {
+				return c < 0
+			}
+		}
+		return compareByMode(&issues[a], &issues[b], SortDefault) < 0
+	})
+	return indices
+}
+
+// compareByMode compares two issues by a sort key other than votes.
+func compareByMode(a, b *Issue, mode SortMode) int {
+	switch mode {
+	case SortCreatedAsc:
+		return a.CreatedAt.Compare(b.CreatedAt)
+	case SortCreatedDesc:
+		return b.CreatedAt.Compare(a.CreatedAt)
+	case SortPriority:
+		return a.Priority - b.Priority
+	case SortUpdated:
+		return b.UpdatedAt.Compare(a.UpdatedAt)
+	case SortVotes:
+		return len(b.Voters()) - len(a.Voters())
+	}
+	// Open first, then priority, then newest
+	aClosed, bClosed := isClosedLike(a.Status), isClosedLike(b.Status)
+	switch {
+	case aClosed != bClosed && !aClosed:
+		return -1
+	case aClosed != bClosed:
+		return 1
+	case a.Priority != b.Priority:
+		return a.Priority - b.Priority
+	}
+	return b.CreatedAt.Compare(a.CreatedAt)
+}
+
+func compareFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,620 @@
+// Package config loads the bv configuration.
+//
+// Settings come in three layers, each overriding the one before: the user
+// file ~/.config/bv/config.yaml, the project's .beads_viewer.toml, and
+// BV_<SECTION>_<KEY> environment variables such as BV_UI_STALE_DAYS. All
+// are optional: missing layers yield a zero Config, which callers treat as
+// "use defaults". The user file looks like this:
+//
+//	experimental:
+//	  background_mode: true
+//	ui:
+//	  session_review: true
+//	  usage_stats: true
+//	  log_level: info
+//	  idle_lock_minutes: 10
+//	  theme: dark
+//	  locale: de
+//	  check_updates: false
+//	  skip_release: v1.2.3
+//	  update_pin: v1
+//	  run_hooks: false
+//	  stale_days: 21
+//	  wip_limits:
+//	    in_progress: {limit: 3, block: true}
+//	    blocked: {limit: 5}
+//	  poll_interval: 5s
+//	  layout: {split_width: 120, stack_height: 40}
+//	  status_bar: [filter, counts, freshness, hooks, fill, total, keys]
+//	  hyperlinks: true
+//	  graphics: auto
+//	  pager: less -R
+//	  editor_templates:
+//	    hx: "{file}:{line}:{column}"
+//	  issue_url: https://tracker.example.com/{id}
+//	  startup_commands: ["filter ready", "view board"]
+//	  aliases:
+//	    p0: filter open priority:0
+//	    triage: filter ready; sort priority
+//	  keys:
+//	    g g: p0
+//	    space f s: filter status:in_progress
+//	  commands:
+//	    - name: gh
+//	      run: gh issue view {external_ref} --web
+//	    - name: estimate
+//	      key: E
+//	      run: ./scripts/estimate {id}
+//	      output: update
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the parsed contents of ~/.config/bv/config.yaml.
+type Config struct {
+	Experimental ExperimentalConfig `yaml:"experimental,omitempty"`
+	UI           UIConfig           `yaml:"ui,omitempty"`
+}
+
+// ExperimentalConfig holds opt-in features that are still being rolled out.
+type ExperimentalConfig struct {
+	// BackgroundMode enables the background snapshot worker. Nil means unset,
+	// so the caller can distinguish "explicitly off" from "not configured".
+	BackgroundMode *bool `yaml:"background_mode,omitempty"`
+}
+
+// UIConfig holds TUI presentation preferences.
+type UIConfig struct {
+	// SessionReview shows a summary of the session before quitting.
+	SessionReview bool `yaml:"session_review,omitempty"`
+
+	// UsageStats counts the views, commands and filters used, in a local
+	// file that is never sent anywhere. Off unless set.
+	UsageStats bool `yaml:"usage_stats,omitempty"`
+
+	// LogLevel writes the structured log to LogFile from this level on:
+	// "debug", "info", "warn" or "error". Empty writes no file.
+	LogLevel string `yaml:"log_level,omitempty"`
+
+	// LogFile is where the log goes; empty means bv.log in the user cache
+	// directory. It is rotated by size.
+	LogFile string `yaml:"log_file,omitempty"`
+
+	// IdleLockMinutes blanks the screen after this many minutes without
+	// input. Zero disables the privacy screen.
+	IdleLockMinutes int `yaml:"idle_lock_minutes,omitempty"`
+
+	// Theme picks the light or dark palette: "light", "dark", or "auto"
+	// (the default) to follow the terminal background.
+	Theme string `yaml:"theme,omitempty"`
+
+	// Locale is the language of the TUI, such as "de", or "auto" (the
+	// default) to follow LC_ALL, LC_MESSAGES and LANG.
+	Locale string `yaml:"locale,omitempty"`
+
+	// CheckUpdates looks for a new release on GitHub at startup. Nil means
+	// on.
+	CheckUpdates *bool `yaml:"check_updates,omitempty"`
+
+	// SkipRelease is a release the update check does not remind about, set
+	// by "Skip this version" in the update dialog. Newer releases are
+	// offered again.
+	SkipRelease string `yaml:"skip_release,omitempty"`
+
+	// UpdatePin keeps updates within a major version such as "v1": the
+	// newest release of it is offered instead of the latest.
+	UpdatePin string `yaml:"update_pin,omitempty"`
+
+	// KittyKeyboard turns on the kitty keyboard protocol on terminals that
+	// support it, which lets CapsLock open the tutorial. Nil means on.
+	KittyKeyboard *bool `yaml:"kitty_keyboard,omitempty"`
+
+	// RunHooks runs the issue hooks of the project's .bv/hooks.yaml from the
+	// TUI. Nil means on.
+	RunHooks *bool `yaml:"run_hooks,omitempty"`
+
+	// ReadOnly disables everything that changes the project, like
+	// --read-only: bd writes, hooks, user commands and files under .beads
+	// and .bv.
+	ReadOnly bool `yaml:"read_only,omitempty"`
+
+	// HookSandbox confines the project's hooks: "none" (the default), "env"
+	// to keep environment variables such as tokens from them, or "strict"
+	// to also run them without network in an OS sandbox (bwrap on Linux,
+	// sandbox-exec on macOS). A project file cannot set it.
+	HookSandbox string `yaml:"hook_sandbox,omitempty"`
+
+	// Hyperlinks forces OSC 8 links on or off. Nil means auto-detect.
+	Hyperlinks *bool `yaml:"hyperlinks,omitempty"`
+
+	// Graphics draws the detail view's priority icon and assignee avatar as
+	// small images: "auto" when the terminal is known to show them, or
+	// "kitty", "iterm2" or "sixel" to force a protocol. Empty or "off"
+	// keeps text.
+	Graphics string `yaml:"graphics,omitempty"`
+
+	// Pager shows long text, such as an issue, a commit diff or the export,
+	// with a command that reads a file named as its last argument, such as
+	// "less -R". "internal" uses bv's own pager. Empty uses $PAGER, or the
+	// internal pager when that is unset. A project file cannot set it.
+	Pager string `yaml:"pager,omitempty"`
+
+	// EditorTemplates tell editors, named by the executable in $EDITOR
+	// (such as "code" or "nvim"), how to open a file at a line: the
+	// arguments after the $EDITOR command, with {file}, {line} and
+	// {column} replaced. They add to bv's templates for common editors. A
+	// project file cannot set them.
+	EditorTemplates map[string]string `yaml:"editor_templates,omitempty"`
+
+	// IssueURL is the link target for issue IDs; "{id}" is replaced with the
+	// issue ID. When empty, issues link to their external_ref if it is a URL.
+	IssueURL string `yaml:"issue_url,omitempty"`
+
+	// StartupCommands run after load, like repeated --cmd flags. Flags given
+	// on the command line replace this list.
+	StartupCommands []string `yaml:"startup_commands,omitempty"`
+
+	// Aliases map short names to one or more TUI commands separated by ";".
+	// They can be run from the ":" prompt or via --cmd.
+	Aliases map[string]string `yaml:"aliases,omitempty"`
+
+	// Keys bind sequences of two or more keys, written as space-separated
+	// key names such as "g g" or "space f s", to TUI commands or aliases.
+	Keys map[string]string `yaml:"keys,omitempty"`
+
+	// Commands are external programs offered in the issue action menu and
+	// runnable from the ":" prompt as "run NAME".
+	Commands []UserCommand `yaml:"commands,omitempty"`
+
+	// WatchNotifications sends a desktop notification (notify-send or
+	// osascript) when a watched issue changes, besides the status bar.
+	WatchNotifications bool `yaml:"watch_notifications,omitempty"`
+
+	// StaleDays is how long an open issue can go without an update before
+	// the list flags it as stale. Zero means 14 days.
+	StaleDays int `yaml:"stale_days,omitempty"`
+
+	// WIPLimits caps the board's status columns, keyed open, in_progress,
+	// blocked or closed.
+	WIPLimits map[string]WIPLimit `yaml:"wip_limits,omitempty"`
+
+	// Escalation rules flag open issues that need more attention than
+	// their priority gives them (see EscalationRule).
+	Escalation []EscalationRule `yaml:"escalation,omitempty"`
+
+	// Accessible renders linear, plain-text views for screen readers, like
+	// --accessible.
+	Accessible bool `yaml:"accessible,omitempty"`
+
+	// ReducedMotion turns off spinners, flashes and sub-second refresh
+	// ticks, like --reduced-motion. Useful over high-latency SSH and on
+	// battery.
+	ReducedMotion bool `yaml:"reduced_motion,omitempty"`
+
+	// Poll detects changes to the beads file by polling instead of file
+	// system events, like --poll, for mounts where events are unreliable.
+	// PollInterval ("5s") and PollJitter ("1s") also apply when bv switches
+	// to polling on its own.
+	Poll         bool          `yaml:"poll,omitempty"`
+	PollInterval time.Duration `yaml:"poll_interval,omitempty"`
+	PollJitter   time.Duration `yaml:"poll_jitter,omitempty"`
+
+	// StatusBar lists the footer segments in display order, like the parts
+	// of a shell prompt; "fill" pushes the rest to the right. Empty means
+	// the default layout.
+	StatusBar []string `yaml:"status_bar,omitempty"`
+
+	// Layout sets the terminal sizes the main screen adapts at.
+	Layout LayoutConfig `yaml:"layout,omitempty"`
+}
+
+// UserCommand runs an external program for the selected issue.
+type UserCommand struct {
+	// Name identifies the command; it must be a single word.
+	Name string `yaml:"name"`
+
+	// Key is an optional one-character shortcut in the action menu.
+	Key string `yaml:"key,omitempty"`
+
+	// Run is a shell command. Placeholders such as {id} and {title} are
+	// replaced with the shell-quoted issue fields.
+	Run string `yaml:"run"`
+
+	// Output says what to do with stdout: "discard" (the default), "show"
+	// it in a pager, or "update" the issue from a JSON object of fields.
+	Output string `yaml:"output,omitempty"`
+}
+
+// LayoutConfig holds the breakpoints of the main screen, in columns and
+// rows. Zero means the default of each.
+type LayoutConfig struct {
+	// SplitWidth: wider terminals show the list and details side by side.
+	// 100 by default.
+	SplitWidth int `yaml:"split_width,omitempty"`
+
+	// StackHeight: narrower terminals at least this tall show the details
+	// below the list rather than one at a time. 40 by default; -1 never
+	// stacks.
+	StackHeight int `yaml:"stack_height,omitempty"`
+
+	// CompactWidth: narrower terminals get compact list rows and a
+	// status bar without hints and breadcrumbs. 80 by default.
+	CompactWidth int `yaml:"compact_width,omitempty"`
+
+	// MinWidth and MinHeight: smaller terminals get a screen that says how
+	// big bv needs them instead of a layout that wraps. 40×10 by default.
+	MinWidth  int `yaml:"min_width,omitempty"`
+	MinHeight int `yaml:"min_height,omitempty"`
+}
+
+// EscalationRule suggests a higher priority for the issues it matches, or
+// pins them to the top of the list.
+type EscalationRule struct {
+	// Name shows in the review; the rule's position if empty.
+	Name string `yaml:"name,omitempty"`
+
+	// When is a list filter such as "blocked:true priority:2".
+	When string `yaml:"when"`
+
+	// IdleDays further requires the issues to have gone this many days
+	// without an update.
+	IdleDays int `yaml:"idle_days,omitempty"`
+
+	// Priority, 0-4, is suggested for matching issues of a lower one.
+	Priority *int `yaml:"priority,omitempty"`
+
+	// Pin lists matching issues first, whatever the sort.
+	Pin bool `yaml:"pin,omitempty"`
+}
+
+// WIPLimit is the work-in-progress policy of one board column.
+type WIPLimit struct {
+	// Limit is how many issues the column may hold; the board highlights
+	// the column past it.
+	Limit int `yaml:"limit"`
+
+	// Block refuses status changes that would take the column past Limit,
+	// rather than only warning about them.
+	Block bool `yaml:"block,omitempty"`
+}
+
+// Dir returns the directory of bv's user files (config, recipes, tutorial
+// progress), or "" if the home directory cannot be determined: ~/.config/bv,
+// or %AppData%\bv on Windows.
+func Dir() string {
+	return userDir()
+}
+
+// DefaultPath returns the path to the user config file, or "" if the home
+// directory cannot be determined.
+func DefaultPath() string {
+	dir := Dir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "config.yaml")
+}
+
+// SetValues sets dotted keys such as "ui.theme" in the YAML file at path,
+// keeping its other settings and comments. A nil value removes the key. The
+// file and its directory are created if missing.
+func SetValues(path string, values map[string]any) error {
+	var doc yaml.Node
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("reading config: %w", err)
+	}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("parsing %s: %w", path, err)
+	}
+	if len(doc.Content) == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}}
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return fmt.Errorf("%s: expected sections such as ui:", path)
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		sectionName, field, ok := strings.Cut(key, ".")
+		if !ok {
+			return fmt.Errorf("%s: expected section.key", key)
+		}
+		section := mappingValue(root, sectionName)
+		if values[key] == nil {
+			if section != nil {
+				deleteKey(section, field)
+			}
+			continue
+		}
+		if section == nil {
+			section = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+			root.Content = append(root.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: sectionName}, section)
+		} else if section.Kind != yaml.MappingNode {
+			return fmt.Errorf("%s: %s must be a mapping", path, sectionName)
+		}
+		var value yaml.Node
+		if err := value.Encode(values[key]); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+		if existing := mappingValue(section, field); existing != nil {
+			value.HeadComment, value.LineComment = existing.HeadComment, existing.LineComment
+			*existing = value
+		} else {
+			section.Content = append(section.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: field}, &value)
+		}
+	}
+
+	var out bytes.Buffer
+	enc := yaml.NewEncoder(&out)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, out.Bytes(), 0o644)
+}
+
+// mappingValue returns the value of key in the mapping node m, or nil.
+func mappingValue(m *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			return m.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func deleteKey(m *yaml.Node, key string) {
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			m.Content = append(m.Content[:i], m.Content[i+2:]...)
+			return
+		}
+	}
+}
+
+// LoadFrom reads a config file from path. A missing file (or empty path)
+// is not an error and returns an empty Config.
+func LoadFrom(path string) (*Config, error) {
+	cfg := &Config{}
+	if path == "" {
+		return cfg, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cfg, nil
+		}
+		return cfg, fmt.Errorf("reading config: %w", err)
+	}
+
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return &Config{}, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// ProjectFileName is the per-project overlay of the user config, in TOML,
+// read from the project root:
+//
+//	[ui]
+//	stale_days = 30
+//	startup_commands = ["view board"]
+//
+//	[ui.wip_limits]
+//	in_progress = { limit = 3, block = true }
+const ProjectFileName = ".beads_viewer.toml"
+
+// DefaultSource is the source of keys that no layer sets.
+const DefaultSource = "default"
+
+// Setting is the effective value of one config key and where it came from.
+type Setting struct {
+	Key    string // Dotted key, e.g. ui.stale_days
+	Value  string // Empty when unset
+	Source string // DefaultSource, a file path or $BV_... variable
+}
+
+// Settings lists every known key, in the order of the Config fields.
+type Settings []Setting
+
+// Source returns where key was set, or DefaultSource.
+func (s Settings) Source(key string) string {
+	for _, setting := range s {
+		if setting.Key == key {
+			return setting.Source
+		}
+	}
+	return DefaultSource
+}
+
+// userOnlyKeys protect the user from the projects they open: a project
+// file cannot set them. Each runs commands, decides what may run, names
+// a file bv writes to, decides which release the user runs, or records
+// what the user does.
+var userOnlyKeys = []string{
+	"ui.hook_sandbox", "ui.pager", "ui.editor_templates",
+	"ui.commands", "ui.startup_commands", "ui.run_hooks",
+	"ui.log_file", "ui.log_level",
+	"ui.check_updates", "ui.skip_release", "ui.update_pin",
+	"ui.usage_stats",
+}
+
+// configLayer is one layer's keys by section, and where they came from.
+type configLayer struct {
+	source string
+	values map[string]any
+}
+
+// LoadLayered merges the user config at DefaultPath, the ProjectFileName
+// in projectDir and the BV_<SECTION>_<KEY> variables in environ, later
+// layers first. A key set in a layer replaces the whole value below it,
+// lists and maps included. Layers or keys that cannot be read are
+// reported in the error and skipped; the rest still apply.
+func LoadLayered(projectDir string, environ []string) (*Config, Settings, error) {
+	var layers []configLayer
+	var errs []error
+
+	if path := DefaultPath(); path != "" {
+		if data, err := os.ReadFile(path); err == nil {
+			var values map[string]any
+			if err := yaml.Unmarshal(data, &values); err != nil {
+				errs = append(errs, fmt.Errorf("parsing %s: %w", path, err))
+			} else {
+				layers = append(layers, configLayer{path, values})
+			}
+		} else if !os.IsNotExist(err) {
+			errs = append(errs, fmt.Errorf("reading config: %w", err))
+		}
+	}
+
+	if projectDir != "" {
+		path := filepath.Join(projectDir, ProjectFileName)
+		if data, err := os.ReadFile(path); err == nil {
+			if values, err := parseTOML(string(data)); err != nil {
+				errs = append(errs, fmt.Errorf("parsing %s: %w", path, err))
+			} else {
+				for _, key := range userOnlyKeys {
+					section, field, _ := strings.Cut(key, ".")
+					if fields, ok := values[section].(map[string]any); ok {
+						if _, ok := fields[field]; ok {
+							delete(fields, field)
+							errs = append(errs, fmt.Errorf("%s: %s can only be set in your own config", path, key))
+						}
+					}
+				}
+				// A project can make itself read-only, but not writable.
+				if fields, ok := values["ui"].(map[string]any); ok {
+					if readOnly, ok := fields["read_only"]; ok && readOnly != true {
+						delete(fields, "read_only")
+						errs = append(errs, fmt.Errorf("%s: ui.read_only can only be turned on in a project", path))
+					}
+				}
+				layers = append(layers, configLayer{path, values})
+			}
+		} else if !os.IsNotExist(err) {
+			errs = append(errs, fmt.Errorf("reading %s: %w", path, err))
+		}
+	}
+
+	keys := configKeys()
+	env := make(map[string]string, len(environ))
+	for _, kv := range environ {
+		if name, value, ok := strings.Cut(kv, "="); ok {
+			env[name] = value
+		}
+	}
+	for _, key := range keys {
+		name := EnvName(key)
+		raw := env[name]
+		if raw == "" {
+			continue
+		}
+		var value any
+		if err := yaml.Unmarshal([]byte(raw), &value); err != nil {
+			errs = append(errs, fmt.Errorf("parsing $%s: %w", name, err))
+			continue
+		}
+		section, field, _ := strings.Cut(key, ".")
+		layers = append(layers, configLayer{"$" + name, map[string]any{section: map[string]any{field: value}}})
+	}
+
+	// Merge key by key, remembering the layer that won.
+	merged := map[string]any{}
+	sources := map[string]string{}
+	for _, layer := range layers {
+		for section, fields := range layer.values {
+			fieldMap, ok := fields.(map[string]any)
+			if !ok {
+				if fields != nil {
+					errs = append(errs, fmt.Errorf("%s: %s must be a table of settings", layer.source, section))
+				}
+				continue
+			}
+			for field, value := range fieldMap {
+				merged[section+"."+field] = value
+				sources[section+"."+field] = layer.source
+			}
+		}
+	}
+
+	// Decode each key on its own, so a bad value names its source.
+	cfg := &Config{}
+	settings := make(Settings, 0, len(keys))
+	for _, key := range keys {
+		setting := Setting{Key: key, Source: DefaultSource}
+		if value, ok := merged[key]; ok {
+			section, field, _ := strings.Cut(key, ".")
+			data, err := yaml.Marshal(map[string]any{section: map[string]any{field: value}})
+			if err == nil {
+				err = yaml.Unmarshal(data, cfg)
+			}
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s from %s: %w", key, sources[key], err))
+			} else {
+				setting.Value, setting.Source = formatSetting(value), sources[key]
+			}
+		}
+		settings = append(settings, setting)
+	}
+	return cfg, settings, errors.Join(errs...)
+}
+
+// EnvName is the environment variable that overrides key, e.g.
+// BV_UI_STALE_DAYS for ui.stale_days.
+func EnvName(key string) string {
+	return "BV_" + strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+}
+
+// configKeys lists the dotted keys of Config from its yaml tags.
+func configKeys() []string {
+	var keys []string
+	sections := reflect.TypeOf(Config{})
+	for i := 0; i < sections.NumField(); i++ {
+		section := sections.Field(i)
+		for j := 0; j < section.Type.NumField(); j++ {
+			keys = append(keys, yamlName(section)+"."+yamlName(section.Type.Field(j)))
+		}
+	}
+	return keys
+}
+
+func yamlName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("yaml"), ",")
+	return name
+}
+
+// formatSetting renders a value for display: strings as they are, the
+// rest as JSON.
+func formatSetting(value any) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
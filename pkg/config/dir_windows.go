@@ -0,0 +1,32 @@
+//go:build windows
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// userDir is %AppData%\bv. Earlier versions used ~\.config\bv on every
+// platform, so that directory stays in use while it exists and %AppData%\bv
+// does not.
+func userDir() string {
+	var legacy string
+	if home, err := os.UserHomeDir(); err == nil && home != "" {
+		legacy = filepath.Join(home, ".config", "bv")
+	}
+	appData, err := os.UserConfigDir()
+	if err != nil || appData == "" {
+		return legacy
+	}
+	dir := filepath.Join(appData, "bv")
+	if legacy != "" && !isDir(dir) && isDir(legacy) {
+		return legacy
+	}
+	return dir
+}
+
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
@@ -0,0 +1,36 @@
+//go:build windows
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDir_Windows(t *testing.T) {
+	home, appData := t.TempDir(), t.TempDir()
+	t.Setenv("USERPROFILE", home)
+	t.Setenv("APPDATA", appData)
+
+	if got, want := Dir(), filepath.Join(appData, "bv"); got != want {
+		t.Fatalf("Dir() = %q, want %q", got, want)
+	}
+
+	// A config directory from an earlier version keeps being used
+	legacy := filepath.Join(home, ".config", "bv")
+	if err := os.MkdirAll(legacy, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if got := Dir(); got != legacy {
+		t.Fatalf("Dir() = %q, want the existing %q", got, legacy)
+	}
+
+	// until %AppData%\bv exists
+	if err := os.MkdirAll(filepath.Join(appData, "bv"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := Dir(), filepath.Join(appData, "bv"); got != want {
+		t.Errorf("Dir() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,32 @@
+package config
+
+import (
+	"testing"
+)
+
+// FuzzParseTOML checks that no .beads_viewer.toml makes the parser panic or
+// return both a table and an error.
+//
+// Run with: go test -fuzz=FuzzParseTOML ./pkg/config/
+func FuzzParseTOML(f *testing.F) {
+	for _, seed := range []string{
+		"",
+		"[ui]\nstale_days = 30\nstartup_commands = [\"filter ready\", \"view board\"]\n",
+		"[ui.wip_limits]\nin_progress = { limit = 3, block = true }\n",
+		"[[ui.commands]]\nname = \"gh\"\nrun = 'gh issue view {id}'\n[[ui.commands]]\nname = \"x\"\n",
+		"a.b.\"c d\" = 1_000 # comment\n",
+		"x = \"\\u00e9\\U0001F600\\n\"\n",
+		"x = [\n  1,\n  2,\n]\n",
+		"x = [", "x = {", "x = \"", "[", "[[", "[a", "= 1", "x = 1 2", "x = \"\\u12\"", "a = 1\na.b = 2", "[[a]]\n[a.b]\nc = 1",
+		"x = '''multi'''", "x = 0x1F", "x = 1e400", "x = -inf",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, src string) {
+		table, err := parseTOML(src)
+		if err != nil && table != nil {
+			t.Fatalf("got both a table and %v", err)
+		}
+	})
+}
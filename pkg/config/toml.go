@@ -0,0 +1,362 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// parseTOML reads the part of TOML a config overlay needs into nested maps:
+// comments, [tables], [[arrays of tables]], dotted keys, basic and literal
+// strings, integers, floats, booleans, arrays and inline tables. Multi-line
+// strings and dates are not supported.
+func parseTOML(src string) (map[string]any, error) {
+	p := &tomlParser{src: src, line: 1}
+	root := map[string]any{}
+	current := root
+	for {
+		p.skipBlank()
+		if p.eof() {
+			return root, nil
+		}
+		var err error
+		if p.peek() == '[' {
+			current, err = p.header(root)
+		} else {
+			err = p.keyValue(current)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", p.line, err)
+		}
+		p.skipSpace()
+		if !p.eof() && p.peek() != '\n' && p.peek() != '#' && p.peek() != '\r' {
+			return nil, fmt.Errorf("line %d: unexpected %q after value", p.line, p.peek())
+		}
+	}
+}
+
+type tomlParser struct {
+	src  string
+	pos  int
+	line int
+}
+
+func (p *tomlParser) eof() bool  { return p.pos >= len(p.src) }
+func (p *tomlParser) peek() byte { return p.src[p.pos] }
+
+// skipSpace skips spaces and tabs.
+func (p *tomlParser) skipSpace() {
+	for !p.eof() && (p.peek() == ' ' || p.peek() == '\t') {
+		p.pos++
+	}
+}
+
+// skipBlank skips whitespace, newlines and comments.
+func (p *tomlParser) skipBlank() {
+	for !p.eof() {
+		switch p.peek() {
+		case '\n':
+			p.line++
+			p.pos++
+		case ' ', '\t', '\r':
+			p.pos++
+		case '#':
+			for !p.eof() && p.peek() != '\n' {
+				p.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+// header reads [a.b] or [[a.b]] and returns the table it opens.
+func (p *tomlParser) header(root map[string]any) (map[string]any, error) {
+	p.pos++
+	array := !p.eof() && p.peek() == '['
+	if array {
+		p.pos++
+	}
+	p.skipSpace()
+	keys, err := p.keyPath()
+	if err != nil {
+		return nil, err
+	}
+	closing := "]"
+	if array {
+		closing = "]]"
+	}
+	if !strings.HasPrefix(p.src[p.pos:], closing) {
+		return nil, fmt.Errorf("expected %s after table name", closing)
+	}
+	p.pos += len(closing)
+
+	parent, err := subTable(root, keys[:len(keys)-1])
+	if err != nil {
+		return nil, err
+	}
+	last := keys[len(keys)-1]
+	if !array {
+		return subTable(parent, []string{last})
+	}
+	table := map[string]any{}
+	switch existing := parent[last].(type) {
+	case nil:
+		parent[last] = []any{table}
+	case []any:
+		parent[last] = append(existing, table)
+	default:
+		return nil, fmt.Errorf("%s is not an array of tables", strings.Join(keys, "."))
+	}
+	return table, nil
+}
+
+// subTable walks keys down from t, creating tables as needed. A key naming
+// an array of tables continues in its last table.
+func subTable(t map[string]any, keys []string) (map[string]any, error) {
+	for _, key := range keys {
+		switch next := t[key].(type) {
+		case nil:
+			table := map[string]any{}
+			t[key] = table
+			t = table
+		case map[string]any:
+			t = next
+		case []any:
+			last, ok := next[len(next)-1].(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("%s is not a table", key)
+			}
+			t = last
+		default:
+			return nil, fmt.Errorf("%s is not a table", key)
+		}
+	}
+	return t, nil
+}
+
+// keyValue reads key = value into t.
+func (p *tomlParser) keyValue(t map[string]any) error {
+	keys, err := p.keyPath()
+	if err != nil {
+		return err
+	}
+	if p.eof() || p.peek() != '=' {
+		return fmt.Errorf("expected = after %s", strings.Join(keys, "."))
+	}
+	p.pos++
+	p.skipSpace()
+	value, err := p.value()
+	if err != nil {
+		return err
+	}
+	table, err := subTable(t, keys[:len(keys)-1])
+	if err != nil {
+		return err
+	}
+	last := keys[len(keys)-1]
+	if _, ok := table[last]; ok {
+		return fmt.Errorf("%s is set twice", strings.Join(keys, "."))
+	}
+	table[last] = value
+	return nil
+}
+
+// keyPath reads a dotted key and the spaces after it.
+func (p *tomlParser) keyPath() ([]string, error) {
+	var keys []string
+	for {
+		p.skipSpace()
+		if p.eof() {
+			return nil, fmt.Errorf("expected a key")
+		}
+		var key string
+		switch c := p.peek(); {
+		case c == '"' || c == '\'':
+			s, err := p.str()
+			if err != nil {
+				return nil, err
+			}
+			key = s
+		default:
+			start := p.pos
+			for !p.eof() && isBareKeyByte(p.peek()) {
+				p.pos++
+			}
+			if p.pos == start {
+				return nil, fmt.Errorf("expected a key, got %q", c)
+			}
+			key = p.src[start:p.pos]
+		}
+		keys = append(keys, key)
+		p.skipSpace()
+		if p.eof() || p.peek() != '.' {
+			return keys, nil
+		}
+		p.pos++
+	}
+}
+
+func isBareKeyByte(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-'
+}
+
+func (p *tomlParser) value() (any, error) {
+	if p.eof() {
+		return nil, fmt.Errorf("expected a value")
+	}
+	switch c := p.peek(); {
+	case c == '"' || c == '\'':
+		if strings.HasPrefix(p.src[p.pos:], `"""`) || strings.HasPrefix(p.src[p.pos:], "'''") {
+			return nil, fmt.Errorf("multi-line strings are not supported")
+		}
+		return p.str()
+	case c == '[':
+		return p.array()
+	case c == '{':
+		return p.inlineTable()
+	default:
+		start := p.pos
+		for !p.eof() && strings.IndexByte(" \t\r\n,]}#", p.peek()) < 0 {
+			p.pos++
+		}
+		return tomlScalar(p.src[start:p.pos])
+	}
+}
+
+// tomlScalar parses a boolean or number.
+func tomlScalar(s string) (any, error) {
+	switch s {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	digits := strings.ReplaceAll(s, "_", "")
+	if n, err := strconv.ParseInt(digits, 0, 64); err == nil {
+		return int(n), nil
+	}
+	if f, err := strconv.ParseFloat(digits, 64); err == nil {
+		return f, nil
+	}
+	return nil, fmt.Errorf("invalid value %q (quote strings)", s)
+}
+
+// str reads a basic "..." or literal '...' string.
+func (p *tomlParser) str() (string, error) {
+	quote := p.peek()
+	p.pos++
+	var sb strings.Builder
+	for {
+		if p.eof() || p.peek() == '\n' {
+			return "", fmt.Errorf("unterminated string")
+		}
+		c := p.peek()
+		p.pos++
+		switch {
+		case c == quote:
+			return sb.String(), nil
+		case c == '\\' && quote == '"':
+			if err := p.escape(&sb); err != nil {
+				return "", err
+			}
+		default:
+			sb.WriteByte(c)
+		}
+	}
+}
+
+func (p *tomlParser) escape(sb *strings.Builder) error {
+	if p.eof() {
+		return fmt.Errorf("unterminated string")
+	}
+	c := p.peek()
+	p.pos++
+	switch c {
+	case '"', '\\':
+		sb.WriteByte(c)
+	case 'n':
+		sb.WriteByte('\n')
+	case 't':
+		sb.WriteByte('\t')
+	case 'r':
+		sb.WriteByte('\r')
+	case 'u', 'U':
+		n := 4
+		if c == 'U' {
+			n = 8
+		}
+		if p.pos+n > len(p.src) {
+			return fmt.Errorf("short \\%c escape", c)
+		}
+		code, err := strconv.ParseUint(p.src[p.pos:p.pos+n], 16, 32)
+		if err != nil || !utf8.ValidRune(rune(code)) {
+			return fmt.Errorf("invalid \\%c escape", c)
+		}
+		p.pos += n
+		sb.WriteRune(rune(code))
+	default:
+		return fmt.Errorf("invalid escape \\%c", c)
+	}
+	return nil
+}
+
+// array reads [v, ...], which may span lines.
+func (p *tomlParser) array() ([]any, error) {
+	p.pos++
+	values := []any{}
+	for {
+		p.skipBlank()
+		if p.eof() {
+			return nil, fmt.Errorf("unterminated array")
+		}
+		if p.peek() == ']' {
+			p.pos++
+			return values, nil
+		}
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+		p.skipBlank()
+		if p.eof() {
+			return nil, fmt.Errorf("unterminated array")
+		}
+		if p.peek() == ',' {
+			p.pos++
+		} else if p.peek() != ']' {
+			return nil, fmt.Errorf("expected , or ] in array")
+		}
+	}
+}
+
+// inlineTable reads {k = v, ...} on one line.
+func (p *tomlParser) inlineTable() (map[string]any, error) {
+	p.pos++
+	t := map[string]any{}
+	p.skipSpace()
+	if !p.eof() && p.peek() == '}' {
+		p.pos++
+		return t, nil
+	}
+	for {
+		if err := p.keyValue(t); err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if p.eof() {
+			return nil, fmt.Errorf("unterminated inline table")
+		}
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case '}':
+			p.pos++
+			return t, nil
+		default:
+			return nil, fmt.Errorf("expected , or } in inline table")
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseTOML(t *testing.T) {
+	src := `# project overrides
+title = "bv \"viewer\"\u00e9" # trailing comment
+path = 'C:\beads'
+
+[ui]
+stale_days = 1_000
+ratio = 0.5
+hyperlinks = false
+startup_commands = [
+  "filter ready",  # first
+  "view board",
+]
+"quoted key".nested = 1
+
+[ui.wip_limits]
+in_progress = { limit = 3, block = true }
+
+[[ui.commands]]
+name = "gh"
+[[ui.commands]]
+name = "estimate"
+`
+	got, err := parseTOML(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"title": "bv \"viewer\"é",
+		"path":  `C:\beads`,
+		"ui": map[string]any{
+			"stale_days":       1000,
+			"ratio":            0.5,
+			"hyperlinks":       false,
+			"startup_commands": []any{"filter ready", "view board"},
+			"quoted key":       map[string]any{"nested": 1},
+			"wip_limits": map[string]any{
+				"in_progress": map[string]any{"limit": 3, "block": true},
+			},
+			"commands": []any{
+				map[string]any{"name": "gh"},
+				map[string]any{"name": "estimate"},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v\nwant %#v", got, want)
+	}
+}
+
+func TestParseTOML_Errors(t *testing.T) {
+	for src, want := range map[string]string{
+		"a = 1\na = 2":        "line 2: a is set twice",
+		"a = bare":            "line 1: invalid value",
+		"a = \"open":          "unterminated string",
+		"a = \"\"\"x\"\"\"":   "multi-line strings",
+		"[ui\nx = 1":          "expected ]",
+		"a = 1\n[a]":          "a is not a table",
+		"a = [1, 2":           "unterminated array",
+		"a = 1 b = 2":         "unexpected",
+		"a = { b = 1, c = 2 ": "unterminated inline table",
+		"a = \"\\q\"":         "invalid escape",
+		"x = 1\n[[x]]\n":      "x is not an array of tables",
+	} {
+		if _, err := parseTOML(src); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("parseTOML(%q) = %v, want an error containing %q", src, err, want)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package correlation
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ActivityFlagKind names a pattern in an actor's changes worth a second look
+type ActivityFlagKind string
+
+const (
+	// FlagMassClosure indicates many beads closed in a short burst
+	FlagMassClosure ActivityFlagKind = "mass_closure"
+	// FlagPriorityInflation indicates many beads raised in priority
+	FlagPriorityInflation ActivityFlagKind = "priority_inflation"
+)
+
+// ActivityFlag is a suspicious pattern found in an actor's changes
+type ActivityFlag struct {
+	Kind   ActivityFlagKind `json:"kind"`
+	Reason string           `json:"reason"` // Human-readable explanation
+}
+
+// ActorActivity summarizes what one actor (a commit author) changed in the
+// beads file
+type ActorActivity struct {
+	Actor          string            `json:"actor"`
+	Events         []BeadEvent       `json:"events"`  // Most recent first
+	Touched        []string          `json:"touched"` // Bead IDs, most recently touched first
+	Counts         map[EventType]int `json:"counts"`
+	PriorityRaises int               `json:"priority_raises"`
+	Flags          []ActivityFlag    `json:"flags,omitempty"`
+	LastSeen       time.Time         `json:"last_seen"`
+}
+
+// ActivityOptions configures SummarizeActivity
+type ActivityOptions struct {
+	Since          time.Time     // Only events at or after this time
+	MassClosures   int           // Closures within ClosureBurst that flag an actor
+	ClosureBurst   time.Duration // Window for MassClosures
+	PriorityRaises int           // Priority raises since Since that flag an actor
+}
+
+// DefaultActivityOptions returns sensible thresholds for changes since since
+func DefaultActivityOptions(since time.Time) ActivityOptions {
+	return ActivityOptions{
+		Since:          since,
+		MassClosures:   10,
+		ClosureBurst:   time.Hour,
+		PriorityRaises: 5,
+	}
+}
+
+// SummarizeActivity groups the events of report since opts.Since by actor,
+// most active first, and flags mass closures and priority inflation.
+func SummarizeActivity(report *HistoryReport, opts ActivityOptions) []ActorActivity {
+	if report == nil {
+		return nil
+	}
+
+	byActor := make(map[string]*ActorActivity)
+	for _, history := range report.Histories {
+		for _, event := range history.Events {
+			if event.Timestamp.Before(opts.Since) {
+				continue
+			}
+			actor := event.Author
+			if actor == "" {
+				actor = event.AuthorEmail
+			}
+			if actor == "" {
+				actor = "unknown"
+			}
+			a, ok := byActor[actor]
+			if !ok {
+				a = &ActorActivity{Actor: actor, Counts: make(map[EventType]int)}
+				byActor[actor] = a
+			}
+			a.Events = append(a.Events, event)
+		}
+	}
+
+	activity := make([]ActorActivity, 0, len(byActor))
+	for _, a := range byActor {
+		sort.SliceStable(a.Events, func(i, j int) bool {
+			if !a.Events[i].Timestamp.Equal(a.Events[j].Timestamp) {
+				return a.Events[i].Timestamp.After(a.Events[j].Timestamp)
+			}
+			return a.Events[i].BeadID < a.Events[j].BeadID
+		})
+		seen := make(map[string]bool)
+		var closures []time.Time
+		lowered := 0
+		for _, event := range a.Events {
+			a.Counts[event.EventType]++
+			if !seen[event.BeadID] {
+				seen[event.BeadID] = true
+				a.Touched = append(a.Touched, event.BeadID)
+			}
+			if event.EventType == EventClosed {
+				closures = append(closures, event.Timestamp)
+			}
+			if event.OldPriority != nil && event.NewPriority != nil {
+				// Lower numbers are more urgent
+				if *event.NewPriority < *event.OldPriority {
+					a.PriorityRaises++
+				} else {
+					lowered++
+				}
+			}
+		}
+		a.LastSeen = a.Events[0].Timestamp
+
+		if burst := largestBurst(closures, opts.ClosureBurst); opts.MassClosures > 0 && burst >= opts.MassClosures {
+			a.Flags = append(a.Flags, ActivityFlag{
+				Kind:   FlagMassClosure,
+				Reason: fmt.Sprintf("closed %d beads within %s", burst, formatBurst(opts.ClosureBurst)),
+			})
+		}
+		if opts.PriorityRaises > 0 && a.PriorityRaises >= opts.PriorityRaises && a.PriorityRaises > lowered {
+			a.Flags = append(a.Flags, ActivityFlag{
+				Kind:   FlagPriorityInflation,
+				Reason: fmt.Sprintf("raised priority %d times, lowered it %d times", a.PriorityRaises, lowered),
+			})
+		}
+		activity = append(activity, *a)
+	}
+
+	sort.Slice(activity, func(i, j int) bool {
+		if len(activity[i].Events) != len(activity[j].Events) {
+			return len(activity[i].Events) > len(activity[j].Events)
+		}
+		return activity[i].Actor < activity[j].Actor
+	})
+	return activity
+}
+
+// largestBurst returns the most timestamps (newest first) that fall within
+// any span of length window.
+func largestBurst(times []time.Time, window time.Duration) int {
+	best := 0
+	start := 0
+	for end := range times {
+		for times[start].Sub(times[end]) > window {
+			start++
+		}
+		best = max(best, end-start+1)
+	}
+	return best
+}
+
+// formatBurst formats a burst window in hours or minutes
+func formatBurst(d time.Duration) string {
+	if d >= time.Hour && d%time.Hour == 0 {
+		if d == time.Hour {
+			return "an hour"
+		}
+		return fmt.Sprintf("%d hours", d/time.Hour)
+	}
+	return fmt.Sprintf("%d minutes", d/time.Minute)
+}
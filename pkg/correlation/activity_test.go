@@ -0,0 +1,75 @@
+package correlation
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func intPtr(n int) *int { return &n }
+
+func TestSummarizeActivity_GroupsAndFlags(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	histories := make(map[string]BeadHistory)
+	add := func(id string, events ...BeadEvent) {
+		h := histories[id]
+		h.BeadID = id
+		for _, e := range events {
+			e.BeadID = id
+			h.Events = append(h.Events, e)
+		}
+		histories[id] = h
+	}
+	// The agent closes 12 beads within 40 minutes and raises 5 priorities.
+	for i := 0; i < 12; i++ {
+		add(fmt.Sprintf("bv-%02d", i), BeadEvent{EventType: EventClosed, Author: "agent", Timestamp: now.Add(-time.Duration(i*3) * time.Minute)})
+	}
+	for i := 0; i < 5; i++ {
+		add(fmt.Sprintf("bv-p%d", i), BeadEvent{EventType: EventModified, Author: "agent", Timestamp: now.Add(-2 * time.Hour), OldPriority: intPtr(3), NewPriority: intPtr(0)})
+	}
+	// A person spreads closures over the day, and an old event is ignored.
+	for i := 0; i < 10; i++ {
+		add("bv-h", BeadEvent{EventType: EventClosed, AuthorEmail: "dev@example.com", Timestamp: now.Add(-time.Duration(i*2) * time.Hour)})
+	}
+	add("bv-old", BeadEvent{EventType: EventCreated, Author: "agent", Timestamp: now.Add(-48 * time.Hour)})
+
+	report := &HistoryReport{Histories: histories}
+	activity := SummarizeActivity(report, DefaultActivityOptions(now.Add(-24*time.Hour)))
+	if len(activity) != 2 {
+		t.Fatalf("expected 2 actors, got %d", len(activity))
+	}
+
+	agent := activity[0]
+	if agent.Actor != "agent" || len(agent.Events) != 17 || agent.Counts[EventClosed] != 12 || agent.PriorityRaises != 5 {
+		t.Fatalf("unexpected agent summary: %+v", agent)
+	}
+	if agent.Touched[0] != "bv-00" || !agent.LastSeen.Equal(now) {
+		t.Errorf("expected the most recent bead first, got %v at %v", agent.Touched[0], agent.LastSeen)
+	}
+	if len(agent.Flags) != 2 || agent.Flags[0].Kind != FlagMassClosure || agent.Flags[1].Kind != FlagPriorityInflation {
+		t.Fatalf("expected mass closure and priority inflation flags, got %+v", agent.Flags)
+	}
+	if agent.Flags[0].Reason != "closed 12 beads within an hour" {
+		t.Errorf("unexpected reason %q", agent.Flags[0].Reason)
+	}
+
+	person := activity[1]
+	if person.Actor != "dev@example.com" || len(person.Touched) != 1 || len(person.Flags) != 0 {
+		t.Errorf("expected the email as actor and no flags, got %+v", person)
+	}
+
+	if SummarizeActivity(nil, DefaultActivityOptions(now)) != nil {
+		t.Error("expected nil for a nil report")
+	}
+}
+
+func TestLargestBurst(t *testing.T) {
+	now := time.Now()
+	times := []time.Time{now, now.Add(-10 * time.Minute), now.Add(-50 * time.Minute), now.Add(-3 * time.Hour), now.Add(-3*time.Hour - time.Minute)}
+	if got := largestBurst(times, time.Hour); got != 3 {
+		t.Errorf("largestBurst() = %d, want 3", got)
+	}
+	if got := largestBurst(nil, time.Hour); got != 0 {
+		t.Errorf("largestBurst(nil) = %d, want 0", got)
+	}
+}
@@ -75,9 +75,10 @@ type commitInfo struct {
 
 // beadSnapshot represents a bead's state at a point in time
 type beadSnapshot struct {
-	ID     string
-	Status string
-	Title  string
+	ID       string
+	Status   string
+	Title    string
+	Priority *int
 }
 
 // Extract extracts bead lifecycle events from git history
@@ -365,6 +366,7 @@ func (e *Extractor) parseDiff(diffData []byte, info commitInfo, filterBeadID str
 			event.EventType = EventCreated
 			events = append(events, event)
 		} else if hadOld && hasNew {
+			notePriorityChange(&event, oldSnap, newSnap)
 			// Check for status change
 			if oldSnap.Status != newSnap.Status {
 				event.EventType = determineStatusEvent(oldSnap.Status, newSnap.Status)
@@ -384,9 +386,10 @@ func (e *Extractor) parseDiff(diffData []byte, info commitInfo, filterBeadID str
 // parseBeadJSON extracts minimal bead info from a JSON line
 func parseBeadJSON(jsonStr string) (beadSnapshot, bool) {
 	var partial struct {
-		ID     string `json:"id"`
-		Status string `json:"status"`
-		Title  string `json:"title"`
+		ID       string `json:"id"`
+		Status   string `json:"status"`
+		Title    string `json:"title"`
+		Priority *int   `json:"priority"`
 	}
 
 	if err := json.Unmarshal([]byte(jsonStr), &partial); err != nil {
@@ -398,12 +401,23 @@ func parseBeadJSON(jsonStr string) (beadSnapshot, bool) {
 	}
 
 	return beadSnapshot{
-		ID:     partial.ID,
-		Status: partial.Status,
-		Title:  partial.Title,
+		ID:       partial.ID,
+		Status:   partial.Status,
+		Title:    partial.Title,
+		Priority: partial.Priority,
 	}, true
 }
 
+// notePriorityChange records on event a priority change between two
+// snapshots of its bead.
+func notePriorityChange(event *BeadEvent, oldSnap, newSnap beadSnapshot) {
+	if oldSnap.Priority == nil || newSnap.Priority == nil || *oldSnap.Priority == *newSnap.Priority {
+		return
+	}
+	event.OldPriority = oldSnap.Priority
+	event.NewPriority = newSnap.Priority
+}
+
 // determineStatusEvent determines the appropriate event type for a status transition
 func determineStatusEvent(oldStatus, newStatus string) EventType {
 	switch newStatus {
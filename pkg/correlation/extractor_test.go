@@ -410,6 +410,24 @@ func TestParseDiff(t *testing.T) {
 		}
 	})
 
+	t.Run("priority change", func(t *testing.T) {
+		diffData := []byte(`diff --git a/.beads/beads.jsonl b/.beads/beads.jsonl
+--- a/.beads/beads.jsonl
++++ b/.beads/beads.jsonl
+-{"id":"bv-123","title":"Test","status":"open","priority":2}
++{"id":"bv-123","title":"Test","status":"open","priority":0}
+`)
+
+		events := e.parseDiff(diffData, info, "")
+
+		if len(events) != 1 {
+			t.Fatalf("Expected 1 event, got %d", len(events))
+		}
+		if events[0].OldPriority == nil || *events[0].OldPriority != 2 || events[0].NewPriority == nil || *events[0].NewPriority != 0 {
+			t.Errorf("Expected priority 2 -> 0, got %v -> %v", events[0].OldPriority, events[0].NewPriority)
+		}
+	})
+
 	t.Run("status change to closed", func(t *testing.T) {
 		diffData := []byte(`diff --git a/.beads/beads.jsonl b/.beads/beads.jsonl
 --- a/.beads/beads.jsonl
@@ -315,6 +315,7 @@ func (s *StreamExtractor) parseBufferedDiff(lines []string, info commitInfo, fil
 			event.EventType = EventCreated
 			events = append(events, event)
 		} else if hadOld && hasNew {
+			notePriorityChange(&event, oldSnap, newSnap)
 			if oldSnap.Status != newSnap.Status {
 				event.EventType = determineStatusEvent(oldSnap.Status, newSnap.Status)
 
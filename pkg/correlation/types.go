@@ -45,6 +45,10 @@ type BeadEvent struct {
 	CommitMsg   string    `json:"commit_message"`
 	Author      string    `json:"author"`
 	AuthorEmail string    `json:"author_email"`
+	// OldPriority and NewPriority are set when the commit changed the
+	// bead's priority.
+	OldPriority *int `json:"old_priority,omitempty"`
+	NewPriority *int `json:"new_priority,omitempty"`
 }
 
 // CorrelationMethod describes how a commit was linked to a bead
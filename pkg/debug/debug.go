@@ -7,6 +7,9 @@
 // When enabled, debug messages are written to stderr with timestamps.
 // When disabled (default), all debug functions are no-ops with zero overhead.
 //
+// The structured log (Logger, in log.go) is separate: it is always on, keeps
+// recent lines in memory and can be written to a rotating file.
+//
 // Usage:
 //
 //	import "github.com/Dicklesworthstone/beads_viewer/pkg/debug"
@@ -19,8 +22,10 @@
 package debug
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"log/slog"
 	"os"
 	"time"
 )
@@ -54,22 +59,33 @@ func SetEnabled(e bool) {
 }
 
 // Log writes a debug message if debug logging is enabled.
-// Uses printf-style formatting.
+// Uses printf-style formatting. The message also goes to the structured
+// log at debug level (see Logger).
 func Log(format string, args ...any) {
+	logDebug(format, args...)
 	if !enabled {
 		return
 	}
 	logger.Printf(format, args...)
 }
 
-// LogTiming writes a timing message if debug logging is enabled.
+// LogTiming writes a timing message if debug logging is enabled, and to
+// the structured log at debug level.
 func LogTiming(name string, d time.Duration) {
+	logDebug("%s took %v", name, d)
 	if !enabled {
 		return
 	}
 	logger.Printf("%s took %v", name, d)
 }
 
+// logDebug formats a message for the structured log only if it is kept.
+func logDebug(format string, args ...any) {
+	if l := Logger(); l.Enabled(context.Background(), slog.LevelDebug) {
+		l.Debug(fmt.Sprintf(format, args...))
+	}
+}
+
 // LogIf writes a debug message only if the condition is true.
 func LogIf(cond bool, format string, args ...any) {
 	if !enabled || !cond {
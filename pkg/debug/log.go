@@ -0,0 +1,307 @@
+package debug
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Structured log
+//
+// Besides the BV_DEBUG stderr output above, bv keeps a structured log
+// (log/slog). Its recent lines are held in memory for the TUI's debug
+// console; with a level configured they are also written to a file that is
+// rotated by size, since the TUI owns stderr.
+
+// Defaults for the log file.
+const (
+	DefaultLogMaxBytes = 5 << 20 // Rotate after 5 MiB
+	DefaultLogKeep     = 3       // bv.log.1 .. bv.log.3
+	recentLogLines     = 500
+)
+
+// LogOptions configures the structured log.
+type LogOptions struct {
+	Level    slog.Level // Lowest level written to File
+	File     string     // Empty keeps the log in memory only
+	MaxBytes int64      // Rotate File beyond this size; 0 means DefaultLogMaxBytes
+	Keep     int        // Rotated files kept; 0 means DefaultLogKeep
+}
+
+var (
+	logMu     sync.Mutex
+	recent    = &ringWriter{max: recentLogLines}
+	tailLevel = new(slog.LevelVar) // Lowest level kept in memory
+	logFile   *rotatingWriter
+	slogger   = newLogger(nil, 0)
+)
+
+func init() {
+	tailLevel.Set(slog.LevelInfo)
+}
+
+// Logger returns the structured logger.
+func Logger() *slog.Logger {
+	logMu.Lock()
+	defer logMu.Unlock()
+	return slogger
+}
+
+// SetupLog starts writing the structured log to opts.File, closing any file
+// opened before.
+func SetupLog(opts LogOptions) error {
+	var file *rotatingWriter
+	if opts.File != "" {
+		if opts.MaxBytes <= 0 {
+			opts.MaxBytes = DefaultLogMaxBytes
+		}
+		if opts.Keep <= 0 {
+			opts.Keep = DefaultLogKeep
+		}
+		var err error
+		if file, err = openRotating(opts.File, opts.MaxBytes, opts.Keep); err != nil {
+			return err
+		}
+	}
+	logMu.Lock()
+	defer logMu.Unlock()
+	if logFile != nil {
+		_ = logFile.Close()
+	}
+	logFile = file
+	slogger = newLogger(file, opts.Level)
+	return nil
+}
+
+// CloseLog closes the log file, if any. The in-memory log keeps working.
+func CloseLog() error {
+	logMu.Lock()
+	defer logMu.Unlock()
+	if logFile == nil {
+		return nil
+	}
+	err := logFile.Close()
+	logFile = nil
+	slogger = newLogger(nil, 0)
+	return err
+}
+
+// LogPath returns the file the log is written to; empty when there is none.
+func LogPath() string {
+	logMu.Lock()
+	defer logMu.Unlock()
+	if logFile == nil {
+		return ""
+	}
+	return logFile.path
+}
+
+// SetTailDebug keeps debug records in memory too, for the debug console.
+func SetTailDebug(on bool) {
+	if on {
+		tailLevel.Set(slog.LevelDebug)
+	} else {
+		tailLevel.Set(slog.LevelInfo)
+	}
+}
+
+// RecentLog returns up to n of the latest log lines, oldest first.
+func RecentLog(n int) []string {
+	return recent.last(n)
+}
+
+// ClearRecentLog forgets the lines kept in memory.
+func ClearRecentLog() {
+	recent.clear()
+}
+
+// ParseLevel reads a level name: debug, info, warn or error.
+func ParseLevel(name string) (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(name)); err != nil {
+		return 0, fmt.Errorf("unknown log level %q (want debug, info, warn or error)", name)
+	}
+	return level, nil
+}
+
+func newLogger(file io.Writer, level slog.Level) *slog.Logger {
+	handlers := []slog.Handler{slog.NewTextHandler(recent, &slog.HandlerOptions{Level: tailLevel, ReplaceAttr: shortTime})}
+	if file != nil {
+		handlers = append(handlers, slog.NewTextHandler(file, &slog.HandlerOptions{Level: level}))
+	}
+	return slog.New(fanout(handlers))
+}
+
+// shortTime keeps the time of day only: the console shows recent lines.
+func shortTime(groups []string, a slog.Attr) slog.Attr {
+	if len(groups) == 0 && a.Key == slog.TimeKey {
+		return slog.String(slog.TimeKey, a.Value.Time().Format("15:04:05.000"))
+	}
+	return a
+}
+
+// fanout sends records to every handler that takes their level.
+type fanout []slog.Handler
+
+func (f fanout) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, h := range f {
+		if h.Enabled(ctx, level) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f fanout) Handle(ctx context.Context, r slog.Record) error {
+	var errs []error
+	for _, h := range f {
+		if h.Enabled(ctx, r.Level) {
+			if err := h.Handle(ctx, r.Clone()); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+func (f fanout) WithAttrs(attrs []slog.Attr) slog.Handler {
+	out := make(fanout, len(f))
+	for i, h := range f {
+		out[i] = h.WithAttrs(attrs)
+	}
+	return out
+}
+
+func (f fanout) WithGroup(name string) slog.Handler {
+	out := make(fanout, len(f))
+	for i, h := range f {
+		out[i] = h.WithGroup(name)
+	}
+	return out
+}
+
+// ringWriter keeps the last max lines written to it. slog handlers write
+// one record per call.
+type ringWriter struct {
+	mu    sync.Mutex
+	max   int
+	lines []string
+	next  int // Where the next line goes once the ring is full
+}
+
+func (r *ringWriter) Write(p []byte) (int, error) {
+	line := strings.TrimRight(string(p), "\n")
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.lines) < r.max {
+		r.lines = append(r.lines, line)
+	} else {
+		r.lines[r.next] = line
+		r.next = (r.next + 1) % r.max
+	}
+	return len(p), nil
+}
+
+func (r *ringWriter) last(n int) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ordered := append(append([]string(nil), r.lines[r.next:]...), r.lines[:r.next]...)
+	if n >= 0 && len(ordered) > n {
+		ordered = ordered[len(ordered)-n:]
+	}
+	return ordered
+}
+
+func (r *ringWriter) clear() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lines, r.next = nil, 0
+}
+
+// rotatingWriter appends to a file and, before it would grow past max
+// bytes, renames it to path.1 (shifting older ones up to path.keep).
+type rotatingWriter struct {
+	mu   sync.Mutex
+	path string
+	max  int64
+	keep int
+	f    *os.File
+	size int64
+}
+
+func openRotating(path string, max int64, keep int) (*rotatingWriter, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("creating log directory: %w", err)
+	}
+	w := &rotatingWriter{path: path, max: max, keep: keep}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *rotatingWriter) open() error {
+	f, err := os.OpenFile(w.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("opening log: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("opening log: %w", err)
+	}
+	w.f, w.size = f, info.Size()
+	return nil
+}
+
+func (w *rotatingWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.f == nil {
+		return 0, os.ErrClosed
+	}
+	if w.size > 0 && w.size+int64(len(p)) > w.max {
+		if err := w.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := w.f.Write(p)
+	w.size += int64(n)
+	return n, err
+}
+
+func (w *rotatingWriter) rotate() error {
+	if err := w.f.Close(); err != nil {
+		return err
+	}
+	for i := w.keep - 1; i >= 1; i-- {
+		_ = os.Rename(fmt.Sprintf("%s.%d", w.path, i), fmt.Sprintf("%s.%d", w.path, i+1))
+	}
+	renameErr := os.Rename(w.path, w.path+".1")
+	if err := w.open(); err != nil {
+		return err
+	}
+	if renameErr != nil {
+		return fmt.Errorf("rotating log: %w", renameErr)
+	}
+	return nil
+}
+
+func (w *rotatingWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.f == nil {
+		return nil
+	}
+	err := w.f.Close()
+	w.f = nil
+	return err
+}
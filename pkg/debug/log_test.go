@@ -0,0 +1,91 @@
+package debug
+
+import (
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestStructuredLog_TailsAndWritesFile(t *testing.T) {
+	defer CloseLog()
+	defer SetTailDebug(false)
+	ClearRecentLog()
+
+	path := filepath.Join(t.TempDir(), "logs", "bv.log")
+	if err := SetupLog(LogOptions{Level: slog.LevelWarn, File: path}); err != nil {
+		t.Fatal(err)
+	}
+	Logger().Debug("hidden")
+	Logger().Info("loaded", "issues", 3)
+	Logger().Warn("reload failed", "err", "boom")
+	SetTailDebug(true)
+	Log("key %s", "ctrl+c")
+
+	lines := RecentLog(10)
+	if len(lines) != 3 || !strings.Contains(lines[0], "msg=loaded issues=3") || !strings.Contains(lines[2], `msg="key ctrl+c"`) {
+		t.Fatalf("unexpected recent lines %q", lines)
+	}
+	if got := RecentLog(1); len(got) != 1 || got[0] != lines[2] {
+		t.Errorf("expected the latest line, got %q", got)
+	}
+
+	if err := CloseLog(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text := string(data); !strings.Contains(text, "reload failed") || strings.Contains(text, "loaded") || strings.Contains(text, "ctrl+c") {
+		t.Errorf("expected only warnings in the file, got %q", text)
+	}
+}
+
+func TestRingWriter_KeepsLatest(t *testing.T) {
+	r := &ringWriter{max: 3}
+	for _, line := range []string{"a\n", "b\n", "c\n", "d\n", "e\n"} {
+		_, _ = r.Write([]byte(line))
+	}
+	if got := strings.Join(r.last(-1), ","); got != "c,d,e" {
+		t.Errorf("got %s, want c,d,e", got)
+	}
+	if got := strings.Join(r.last(2), ","); got != "d,e" {
+		t.Errorf("got %s, want d,e", got)
+	}
+}
+
+func TestRotatingWriter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bv.log")
+	w, err := openRotating(path, 10, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		if _, err := w.Write([]byte(line)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]string{"bv.log": "fourth\n", "bv.log.1": "third\n", "bv.log.2": "second\n"} {
+		data, err := os.ReadFile(filepath.Join(filepath.Dir(path), name))
+		if err != nil || string(data) != want {
+			t.Errorf("%s: got %q, %v; want %q", name, data, err, want)
+		}
+	}
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Errorf("expected only 2 rotated files, got %v", err)
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	if level, err := ParseLevel("warn"); err != nil || level != slog.LevelWarn {
+		t.Errorf("warn: got %v, %v", level, err)
+	}
+	if _, err := ParseLevel("loud"); err == nil {
+		t.Error("expected an unknown level to fail")
+	}
+}
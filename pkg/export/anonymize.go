@@ -0,0 +1,175 @@
+package export
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"strings"
+	"unicode"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// Anonymize returns copies of issues that can be shared to reproduce a bug
+// without revealing what the project is about. Every word (a run of letters
+// or digits) of the IDs, text fields, names, labels and references is
+// replaced by a fake word of the same length and letter case, derived from
+// key; punctuation, whitespace and everything else is kept. The same word
+// becomes the same fake word everywhere and different words stay different,
+// so dependencies, mentions of other issues, label counts and the sizes of
+// all fields survive. Votes ("+1" comments) are kept as they are.
+//
+// Anyone who knows key can check guesses of the original words, so keep it
+// secret or random.
+func Anonymize(issues []model.Issue, key []byte) []model.Issue {
+	a := &anonymizer{key: key, words: map[string]string{}, used: map[string]bool{}}
+	out := make([]model.Issue, len(issues))
+	for i, issue := range issues {
+		c := issue.Clone()
+		c.ID = a.text(c.ID)
+		c.ContentHash = ""
+		c.Title = a.text(c.Title)
+		c.Description = a.text(c.Description)
+		c.Design = a.text(c.Design)
+		c.AcceptanceCriteria = a.text(c.AcceptanceCriteria)
+		c.Notes = a.text(c.Notes)
+		c.Assignee = a.text(c.Assignee)
+		c.SourceRepo = a.text(c.SourceRepo)
+		if c.ExternalRef != nil {
+			ref := a.text(*c.ExternalRef)
+			c.ExternalRef = &ref
+		}
+		if c.CompactedAtCommit != nil {
+			commit := a.text(*c.CompactedAtCommit)
+			c.CompactedAtCommit = &commit
+		}
+		for j := range c.Labels {
+			c.Labels[j] = a.text(c.Labels[j])
+		}
+		for _, dep := range c.Dependencies {
+			if dep == nil {
+				continue
+			}
+			dep.IssueID = a.text(dep.IssueID)
+			dep.DependsOnID = a.text(dep.DependsOnID)
+			dep.CreatedBy = a.text(dep.CreatedBy)
+		}
+		for _, comment := range c.Comments {
+			if comment == nil {
+				continue
+			}
+			comment.IssueID = a.text(comment.IssueID)
+			comment.Author = a.text(comment.Author)
+			if comment.Text != model.VoteText && comment.Text != model.UnvoteText {
+				comment.Text = a.text(comment.Text)
+			}
+		}
+		out[i] = c
+	}
+	return out
+}
+
+// anonymizer remembers the fake word of every word seen, and which fake
+// words are taken, so that no two words share one.
+type anonymizer struct {
+	key   []byte
+	words map[string]string
+	used  map[string]bool
+}
+
+// text replaces each word of s.
+func (a *anonymizer) text(s string) string {
+	var sb, word strings.Builder
+	flush := func() {
+		if word.Len() > 0 {
+			sb.WriteString(a.word(word.String()))
+			word.Reset()
+		}
+	}
+	for _, r := range s {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			word.WriteRune(r)
+			continue
+		}
+		flush()
+		sb.WriteRune(r)
+	}
+	flush()
+	return sb.String()
+}
+
+// Fake words are drawn at random up to maxDraws times; when all of those
+// are taken, nearly every word of that shape is, and word steps through
+// them in order for up to maxSteps instead. Only words of non-ASCII letters
+// or digits, which can outnumber the ASCII words of their shape, may end
+// up sharing a fake word.
+const (
+	maxDraws = 16
+	maxSteps = 1 << 16
+)
+
+// word returns the fake word for w: digits become digits and letters
+// ASCII letters of the same case.
+func (a *anonymizer) word(w string) string {
+	if fake, ok := a.words[w]; ok {
+		return fake
+	}
+	runes := []rune(w)
+	fake := make([]rune, len(runes))
+	for attempt := uint32(0); attempt < maxDraws; attempt++ {
+		stream := a.stream(w, attempt, len(runes))
+		for i, r := range runes {
+			switch {
+			case unicode.IsDigit(r):
+				fake[i] = '0' + rune(stream[i]%10)
+			case unicode.IsUpper(r):
+				fake[i] = 'A' + rune(stream[i]%26)
+			default:
+				fake[i] = 'a' + rune(stream[i]%26)
+			}
+		}
+		if !a.used[string(fake)] {
+			break
+		}
+	}
+	for step := 0; step < maxSteps && a.used[string(fake)]; step++ {
+		nextWord(fake)
+	}
+	s := string(fake)
+	a.words[w] = s
+	a.used[s] = true
+	return s
+}
+
+// nextWord advances fake to the next word of the same shape, like an
+// odometer.
+func nextWord(fake []rune) {
+	for i := len(fake) - 1; i >= 0; i-- {
+		switch fake[i] {
+		case '9':
+			fake[i] = '0'
+		case 'Z':
+			fake[i] = 'A'
+		case 'z':
+			fake[i] = 'a'
+		default:
+			fake[i]++
+			return
+		}
+	}
+}
+
+// stream returns n pseudo-random bytes for the given attempt at w.
+func (a *anonymizer) stream(w string, attempt uint32, n int) []byte {
+	out := make([]byte, 0, n+sha256.Size)
+	var header [8]byte
+	binary.BigEndian.PutUint32(header[:4], attempt)
+	for block := uint32(0); len(out) < n; block++ {
+		binary.BigEndian.PutUint32(header[4:], block)
+		mac := hmac.New(sha256.New, a.key)
+		mac.Write(header[:])
+		mac.Write([]byte(w))
+		out = mac.Sum(out)
+	}
+	return out[:n]
+}
@@ -0,0 +1,90 @@
+package export
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestAnonymize(t *testing.T) {
+	ref := "https://github.com/acme/payroll/issues/7"
+	issues := []model.Issue{
+		{ID: "pay-1", Title: "Payroll export crashes", Description: "Stack trace:\n  at Export (payroll.go:42)", Status: model.StatusOpen, Priority: 1,
+			Assignee: "alice", Labels: []string{"payroll", "backend"}, ExternalRef: &ref,
+			Comments: []*model.Comment{{IssueID: "pay-1", Author: "bob", Text: "Blocked by pay-2"}, {IssueID: "pay-1", Author: "carol", Text: model.VoteText}}},
+		{ID: "pay-2", Title: "Fix Payroll schema", Status: model.StatusBlocked, Assignee: "bob", Labels: []string{"backend"},
+			Dependencies: []*model.Dependency{{IssueID: "pay-2", DependsOnID: "pay-1", Type: model.DepBlocks, CreatedBy: "alice"}}},
+	}
+	orig := issues[0].Clone()
+	key := []byte("seed")
+	out := Anonymize(issues, key)
+
+	if !reflect.DeepEqual(issues[0], orig) {
+		t.Fatal("expected the input left alone")
+	}
+	if !reflect.DeepEqual(out, Anonymize(issues, key)) {
+		t.Fatal("expected the same key to give the same output")
+	}
+	a, b := out[0], out[1]
+	for _, field := range []struct{ got, was string }{
+		{a.ID, "pay-1"}, {a.Title, issues[0].Title}, {a.Description, issues[0].Description}, {a.Assignee, "alice"}, {*a.ExternalRef, ref},
+	} {
+		if field.got == field.was || utf8.RuneCountInString(field.got) != utf8.RuneCountInString(field.was) {
+			t.Errorf("expected %q replaced by text of the same length, got %q", field.was, field.got)
+		}
+	}
+	if shape(a.Description) != shape(issues[0].Description) || shape(*a.ExternalRef) != shape(ref) {
+		t.Errorf("expected case, punctuation and whitespace kept, got %q and %q", a.Description, *a.ExternalRef)
+	}
+	if a.Status != model.StatusOpen || a.Priority != 1 || b.Status != model.StatusBlocked {
+		t.Error("expected status and priority kept")
+	}
+
+	// The same words map to the same fake words across fields and issues.
+	if b.Dependencies[0].IssueID != b.ID || b.Dependencies[0].DependsOnID != a.ID || b.Dependencies[0].CreatedBy != a.Assignee {
+		t.Errorf("expected the dependency to follow the IDs, got %+v", *b.Dependencies[0])
+	}
+	if a.Labels[1] != b.Labels[0] || b.Assignee != a.Comments[0].Author || a.Comments[0].Text[len("Blocked by "):] != b.ID {
+		t.Errorf("expected consistent replacements, got %v %v %q", a.Labels, b.Labels, a.Comments[0].Text)
+	}
+	if strings.Fields(b.Title)[1] != strings.Fields(a.Title)[0] {
+		t.Errorf("expected Payroll replaced alike in both titles, got %q and %q", a.Title, b.Title)
+	}
+	if a.Comments[1].Text != model.VoteText {
+		t.Errorf("expected the vote kept, got %q", a.Comments[1].Text)
+	}
+}
+
+// shape masks the letters and digits of s by class.
+func shape(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsDigit(r):
+			return '9'
+		case unicode.IsUpper(r):
+			return 'A'
+		case unicode.IsLetter(r):
+			return 'a'
+		}
+		return r
+	}, s)
+}
+
+func TestAnonymize_KeepsWordsDistinct(t *testing.T) {
+	var issues []model.Issue
+	for i := 0; i < 10; i++ {
+		issues = append(issues, model.Issue{ID: fmt.Sprintf("x-%d", i)})
+	}
+	seen := map[string]bool{}
+	for _, issue := range Anonymize(issues, []byte("k")) {
+		if seen[issue.ID] {
+			t.Fatalf("expected distinct IDs, got %q twice", issue.ID)
+		}
+		seen[issue.ID] = true
+	}
+}
@@ -115,6 +115,44 @@ func GenerateInteractiveGraphFilename(projectName string) string {
 
 // GenerateInteractiveGraphHTML creates a self-contained HTML file with force-graph visualization
 func GenerateInteractiveGraphHTML(opts InteractiveGraphOptions) (string, error) {
+	html, err := RenderInteractiveGraphHTML(opts)
+	if err != nil {
+		return "", err
+	}
+
+	// Generate filename if not provided
+	outputPath := opts.Path
+	if outputPath == "" {
+		projectName := opts.ProjectName
+		if projectName == "" {
+			projectName = "graph"
+		}
+		outputPath = GenerateInteractiveGraphFilename(projectName)
+	}
+
+	// Ensure .html extension
+	if !strings.HasSuffix(strings.ToLower(outputPath), ".html") {
+		outputPath = strings.TrimSuffix(outputPath, filepath.Ext(outputPath)) + ".html"
+	}
+
+	// Ensure directory exists
+	dir := filepath.Dir(outputPath)
+	if dir != "" && dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return "", fmt.Errorf("create dir: %w", err)
+		}
+	}
+
+	if err := os.WriteFile(outputPath, []byte(html), 0644); err != nil {
+		return "", err
+	}
+
+	return outputPath, nil
+}
+
+// RenderInteractiveGraphHTML returns the self-contained force-graph page
+// without writing it; opts.Path is ignored.
+func RenderInteractiveGraphHTML(opts InteractiveGraphOptions) (string, error) {
 	if len(opts.Issues) == 0 {
 		return "", fmt.Errorf("no issues to export")
 	}
@@ -306,34 +344,5 @@ func GenerateInteractiveGraphHTML(opts InteractiveGraphOptions) (string, error)
 		title = "Dependency Graph"
 	}
 
-	// Generate filename if not provided
-	outputPath := opts.Path
-	if outputPath == "" {
-		projectName := opts.ProjectName
-		if projectName == "" {
-			projectName = "graph"
-		}
-		outputPath = GenerateInteractiveGraphFilename(projectName)
-	}
-
-	// Ensure .html extension
-	if !strings.HasSuffix(strings.ToLower(outputPath), ".html") {
-		outputPath = strings.TrimSuffix(outputPath, filepath.Ext(outputPath)) + ".html"
-	}
-
-	html := generateUltimateHTML(title, opts.DataHash, string(dataJSON), len(nodes), len(links), opts.ProjectName, forceGraphJS, markedJS)
-
-	// Ensure directory exists
-	dir := filepath.Dir(outputPath)
-	if dir != "" && dir != "." {
-		if err := os.MkdirAll(dir, 0o755); err != nil {
-			return "", fmt.Errorf("create dir: %w", err)
-		}
-	}
-
-	if err := os.WriteFile(outputPath, []byte(html), 0644); err != nil {
-		return "", err
-	}
-
-	return outputPath, nil
+	return generateUltimateHTML(title, opts.DataHash, string(dataJSON), len(nodes), len(links), opts.ProjectName, forceGraphJS, markedJS), nil
 }
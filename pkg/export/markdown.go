@@ -313,6 +313,16 @@ func getPriorityLabel(priority int) string {
 
 // SaveMarkdownToFile writes the generated markdown to a file
 func SaveMarkdownToFile(issues []model.Issue, filename string) error {
+	content, err := GenerateReport(issues)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, []byte(content), 0644)
+}
+
+// GenerateReport renders the export report: open issues first, then by
+// priority, then newest first.
+func GenerateReport(issues []model.Issue) (string, error) {
 	// Make a copy to avoid mutating the caller's slice
 	issuesCopy := make([]model.Issue, len(issues))
 	copy(issuesCopy, issues)
@@ -330,11 +340,7 @@ func SaveMarkdownToFile(issues []model.Issue, filename string) error {
 		return issuesCopy[i].CreatedAt.After(issuesCopy[j].CreatedAt)
 	})
 
-	content, err := GenerateMarkdown(issuesCopy, "Beads Export")
-	if err != nil {
-		return err
-	}
-	return os.WriteFile(filename, []byte(content), 0644)
+	return GenerateMarkdown(issuesCopy, "Beads Export")
 }
 
 // generateQuickActions creates a Quick Actions section with bulk commands
@@ -0,0 +1,517 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// HTMLReportOptions controls the static HTML report.
+type HTMLReportOptions struct {
+	Title       string               // Heading; "Project report" when empty
+	Filter      string               // Filter the issues were chosen by, shown under the heading; "" for all
+	Issues      []model.Issue        // Issues to report on
+	Stats       *analysis.GraphStats // Graph analysis of Issues, for the dependency graph
+	DataHash    string               // Hash of Issues for provenance
+	GeneratedAt time.Time
+}
+
+// reportGraphMax bounds the open issues drawn in the report's dependency
+// graph, highest PageRank first; a page can't show more legibly.
+const reportGraphMax = 60
+
+// reportTrendWeeks is how many weeks the created/closed chart covers.
+const reportTrendWeeks = 12
+
+// WriteHTMLReport writes a self-contained HTML page on the issues: counts,
+// charts by status, priority, type and week, the dependency graph of the
+// open issues as inline SVG, and a table per status. It needs no network
+// and prints on A4 or Letter, so it can be attached to an email.
+func WriteHTMLReport(w io.Writer, opts HTMLReportOptions) error {
+	if opts.Stats == nil {
+		return fmt.Errorf("graph stats are required for the report")
+	}
+	if strings.TrimSpace(opts.Title) == "" {
+		opts.Title = "Project report"
+	}
+	if opts.GeneratedAt.IsZero() {
+		opts.GeneratedAt = time.Now()
+	}
+
+	graph, graphNote, err := reportGraph(opts)
+	if err != nil {
+		return fmt.Errorf("rendering dependency graph: %w", err)
+	}
+	data := reportData{
+		Title:     opts.Title,
+		Filter:    opts.Filter,
+		Generated: opts.GeneratedAt.Format("2006-01-02 15:04 MST"),
+		DataHash:  opts.DataHash,
+		Counts:    reportCounts(opts.Issues),
+		Charts: []reportChart{
+			statusChart(opts.Issues),
+			priorityChart(opts.Issues),
+			typeChart(opts.Issues),
+		},
+		Trend:     trendChart(opts.Issues, opts.GeneratedAt),
+		Graph:     graph,
+		GraphNote: graphNote,
+		Sections:  reportSections(opts.Issues),
+	}
+	if err := reportTemplate.Execute(w, data); err != nil {
+		return fmt.Errorf("writing report: %w", err)
+	}
+	return nil
+}
+
+type reportData struct {
+	Title, Filter, Generated, DataHash string
+	Counts                             []reportCount
+	Charts                             []reportChart
+	Trend                              reportTrend
+	Graph                              template.HTML
+	GraphNote                          string
+	Sections                           []reportSection
+}
+
+type reportCount struct {
+	Label string
+	Value int
+	Color string
+}
+
+// reportChart is a horizontal bar chart, laid out for the template.
+type reportChart struct {
+	Title  string
+	Height int
+	Bars   []reportBar
+}
+
+type reportBar struct {
+	Label        string
+	Count        int
+	Y, Width     int
+	Color        string
+	TextX, TextY int
+}
+
+// reportTrend is the weekly created/closed column chart.
+type reportTrend struct {
+	Weeks                     []reportWeek
+	Width, Height             int
+	Baseline, LabelY          int
+	CreatedColor, ClosedColor string
+}
+
+type reportWeek struct {
+	Label                     string
+	Created, Closed           int
+	X, CreatedY, CreatedH     int
+	ClosedX, ClosedY, ClosedH int
+	LabelX                    int
+}
+
+type reportSection struct {
+	Status string
+	Color  string
+	Rows   []reportRow
+}
+
+type reportRow struct {
+	ID, Title, Priority, Type, Assignee, Labels, Updated string
+}
+
+// isReady reports whether issue is open with no open blocker among byID.
+func isReady(issue *model.Issue, byID map[string]*model.Issue) bool {
+	if isClosedLikeStatus(issue.Status) || issue.Status == model.StatusBlocked {
+		return false
+	}
+	for _, dep := range issue.Dependencies {
+		if dep == nil || !dep.Type.IsBlocking() {
+			continue
+		}
+		if blocker, ok := byID[dep.DependsOnID]; ok && !isClosedLikeStatus(blocker.Status) {
+			return false
+		}
+	}
+	return true
+}
+
+func reportCounts(issues []model.Issue) []reportCount {
+	byID := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		byID[issues[i].ID] = &issues[i]
+	}
+	var open, inProgress, blocked, ready, closed int
+	for i := range issues {
+		issue := &issues[i]
+		switch {
+		case isClosedLikeStatus(issue.Status):
+			closed++
+			continue
+		case issue.Status == model.StatusInProgress:
+			inProgress++
+		case issue.Status == model.StatusBlocked:
+			blocked++
+		}
+		open++
+		if isReady(issue, byID) {
+			ready++
+		}
+	}
+	return []reportCount{
+		{"Issues", len(issues), css(colorText)},
+		{"Open", open, css(colorOpen)},
+		{"In progress", inProgress, css(colorInProg)},
+		{"Blocked", blocked, css(colorBlocked)},
+		{"Ready", ready, css(colorOpen)},
+		{"Closed", closed, css(colorClosed)},
+	}
+}
+
+// reportBarChart lays out bars of at most 300 pixels, labels on the left.
+func reportBarChart(title string, labels []string, counts []int, colors []string) reportChart {
+	const rowHeight, barMax, labelWidth = 24, 300, 110
+	most := 1
+	for _, c := range counts {
+		most = max(most, c)
+	}
+	chart := reportChart{Title: title, Height: max(len(labels)*rowHeight, rowHeight)}
+	for i, label := range labels {
+		width := counts[i] * barMax / most
+		if counts[i] > 0 {
+			width = max(width, 2)
+		}
+		chart.Bars = append(chart.Bars, reportBar{
+			Label: label,
+			Count: counts[i],
+			Y:     i*rowHeight + 4,
+			Width: width,
+			Color: colors[i],
+			TextX: labelWidth + width + 6,
+			TextY: i*rowHeight + 17,
+		})
+	}
+	return chart
+}
+
+func statusChart(issues []model.Issue) reportChart {
+	counts := make(map[model.Status]int)
+	for _, issue := range issues {
+		counts[issue.Status]++
+	}
+	var labels []string
+	var values []int
+	var colors []string
+	for _, status := range reportStatusOrder(counts) {
+		labels = append(labels, string(status))
+		values = append(values, counts[status])
+		colors = append(colors, css(statusColor(status)))
+	}
+	return reportBarChart("By status", labels, values, colors)
+}
+
+// priorityColors shade the priorities from urgent to backlog.
+var priorityColors = [5]string{"#dc2626", "#f97316", "#eab308", "#3b82f6", "#9ca3af"}
+
+func priorityChart(issues []model.Issue) reportChart {
+	var counts [5]int
+	for _, issue := range issues {
+		if !isClosedLikeStatus(issue.Status) && issue.Priority >= 0 && issue.Priority < len(counts) {
+			counts[issue.Priority]++
+		}
+	}
+	labels := make([]string, len(counts))
+	for p := range counts {
+		labels[p] = fmt.Sprintf("P%d", p)
+	}
+	return reportBarChart("Open by priority", labels, counts[:], priorityColors[:])
+}
+
+func typeChart(issues []model.Issue) reportChart {
+	counts := make(map[string]int)
+	for _, issue := range issues {
+		if !isClosedLikeStatus(issue.Status) {
+			counts[string(issue.IssueType)]++
+		}
+	}
+	types := make([]string, 0, len(counts))
+	for t := range counts {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		if counts[types[i]] != counts[types[j]] {
+			return counts[types[i]] > counts[types[j]]
+		}
+		return types[i] < types[j]
+	})
+	values := make([]int, len(types))
+	colors := make([]string, len(types))
+	for i, t := range types {
+		values[i] = counts[t]
+		colors[i] = css(colorEdge)
+		if t == "" {
+			types[i] = "(none)"
+		}
+	}
+	return reportBarChart("Open by type", types, values, colors)
+}
+
+// trendChart counts the issues created and closed in each of the last
+// reportTrendWeeks weeks up to now.
+func trendChart(issues []model.Issue, now time.Time) reportTrend {
+	const colWidth, barWidth, plotHeight, top = 44, 16, 120, 10
+	var created, closed [reportTrendWeeks]int
+	week := func(t time.Time) int {
+		if t.IsZero() || t.After(now) {
+			return -1
+		}
+		return reportTrendWeeks - 1 - int(now.Sub(t)/(7*24*time.Hour))
+	}
+	for _, issue := range issues {
+		if w := week(issue.CreatedAt); w >= 0 {
+			created[w]++
+		}
+		if issue.ClosedAt != nil {
+			if w := week(*issue.ClosedAt); w >= 0 {
+				closed[w]++
+			}
+		}
+	}
+	most := 1
+	for w := range created {
+		most = max(most, created[w], closed[w])
+	}
+	trend := reportTrend{
+		Width:        reportTrendWeeks * colWidth,
+		Height:       top + plotHeight + 24,
+		Baseline:     top + plotHeight,
+		LabelY:       top + plotHeight + 16,
+		CreatedColor: css(colorEdge),
+		ClosedColor:  css(colorSubtle),
+	}
+	for w := range created {
+		x := w*colWidth + 4
+		ch := created[w] * plotHeight / most
+		dh := closed[w] * plotHeight / most
+		start := now.Add(-time.Duration(reportTrendWeeks-w) * 7 * 24 * time.Hour).Add(24 * time.Hour)
+		trend.Weeks = append(trend.Weeks, reportWeek{
+			Label:    start.Format("Jan 2"),
+			Created:  created[w],
+			Closed:   closed[w],
+			X:        x,
+			CreatedY: trend.Baseline - ch,
+			CreatedH: ch,
+			ClosedX:  x + barWidth + 2,
+			ClosedY:  trend.Baseline - dh,
+			ClosedH:  dh,
+			LabelX:   x + barWidth,
+		})
+	}
+	return trend
+}
+
+// reportGraph renders the dependency graph of the open issues with the
+// highest PageRank as inline SVG, and a note when some were left out.
+func reportGraph(opts HTMLReportOptions) (template.HTML, string, error) {
+	var open []model.Issue
+	for _, issue := range opts.Issues {
+		if !isClosedLikeStatus(issue.Status) {
+			open = append(open, issue)
+		}
+	}
+	if len(open) == 0 {
+		return "", "No open issues.", nil
+	}
+	note := ""
+	if len(open) > reportGraphMax {
+		rank := opts.Stats.PageRank()
+		sort.SliceStable(open, func(i, j int) bool { return rank[open[i].ID] > rank[open[j].ID] })
+		note = fmt.Sprintf("The %d open issues with the highest PageRank of %d.", reportGraphMax, len(open))
+		open = open[:reportGraphMax]
+	}
+	layout := buildLayout(GraphSnapshotOptions{Title: opts.Title, Issues: open, Stats: opts.Stats, DataHash: opts.DataHash})
+	var buf bytes.Buffer
+	if err := renderSVGToWriter(&buf, layout); err != nil {
+		return "", "", err
+	}
+	// Inline SVG takes no XML prolog, and scales to the page with a viewBox.
+	svg := buf.String()
+	svg = svg[strings.Index(svg, "<svg"):]
+	svg = strings.Replace(svg, "<svg", fmt.Sprintf(`<svg viewBox="0 0 %d %d"`, layout.Width, layout.Height), 1)
+	return template.HTML(svg), note, nil
+}
+
+// reportStatusOrder lists the statuses in counts, active work first and
+// closed last.
+func reportStatusOrder(counts map[model.Status]int) []model.Status {
+	rank := func(s model.Status) int {
+		switch s {
+		case model.StatusInProgress:
+			return 0
+		case model.StatusBlocked:
+			return 1
+		case model.StatusOpen:
+			return 2
+		case model.StatusClosed:
+			return 4
+		case model.StatusTombstone:
+			return 5
+		}
+		return 3
+	}
+	statuses := make([]model.Status, 0, len(counts))
+	for s := range counts {
+		statuses = append(statuses, s)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		if rank(statuses[i]) != rank(statuses[j]) {
+			return rank(statuses[i]) < rank(statuses[j])
+		}
+		return statuses[i] < statuses[j]
+	})
+	return statuses
+}
+
+// reportSections tables the issues by status, each by priority and then
+// most recently updated.
+func reportSections(issues []model.Issue) []reportSection {
+	byStatus := make(map[model.Status][]model.Issue)
+	counts := make(map[model.Status]int)
+	for _, issue := range issues {
+		byStatus[issue.Status] = append(byStatus[issue.Status], issue)
+		counts[issue.Status]++
+	}
+	var sections []reportSection
+	for _, status := range reportStatusOrder(counts) {
+		group := byStatus[status]
+		sort.SliceStable(group, func(i, j int) bool {
+			if group[i].Priority != group[j].Priority {
+				return group[i].Priority < group[j].Priority
+			}
+			return group[i].UpdatedAt.After(group[j].UpdatedAt)
+		})
+		section := reportSection{Status: string(status), Color: css(statusColor(status))}
+		for _, issue := range group {
+			updated := ""
+			if !issue.UpdatedAt.IsZero() {
+				updated = issue.UpdatedAt.Format("2006-01-02")
+			}
+			section.Rows = append(section.Rows, reportRow{
+				ID:       issue.ID,
+				Title:    issue.Title,
+				Priority: fmt.Sprintf("P%d", issue.Priority),
+				Type:     string(issue.IssueType),
+				Assignee: issue.Assignee,
+				Labels:   strings.Join(issue.Labels, ", "),
+				Updated:  updated,
+			})
+		}
+		sections = append(sections, section)
+	}
+	return sections
+}
+
+var reportTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+<style>
+  body { font: 14px/1.45 -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; color: #111; margin: 2rem auto; max-width: 960px; padding: 0 1rem; }
+  h1 { margin: 0 0 .25rem; font-size: 1.8rem; }
+  h2 { margin: 2rem 0 .75rem; font-size: 1.25rem; border-bottom: 1px solid #e5e7eb; padding-bottom: .25rem; }
+  .meta { color: #666; margin: 0; }
+  .counts { display: flex; flex-wrap: wrap; gap: .75rem; margin-top: 1.25rem; }
+  .count { border: 1px solid #e5e7eb; border-radius: 8px; padding: .5rem 1rem; min-width: 6rem; }
+  .count b { display: block; font-size: 1.6rem; }
+  .charts { display: flex; flex-wrap: wrap; gap: 1.5rem; }
+  .chart h3 { font-size: .95rem; margin: 0 0 .5rem; }
+  svg { max-width: 100%; height: auto; }
+  svg text { font: 12px -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; fill: #333; }
+  .legend span { display: inline-block; width: .8em; height: .8em; margin: 0 .3em 0 1em; vertical-align: middle; }
+  table { border-collapse: collapse; width: 100%; font-size: 13px; }
+  th, td { text-align: left; padding: .3rem .5rem; border-bottom: 1px solid #eee; vertical-align: top; }
+  th { background: #f3f4f6; }
+  td.id { font-family: ui-monospace, Menlo, monospace; white-space: nowrap; }
+  .status { display: inline-block; width: .7em; height: .7em; border-radius: 50%; margin-right: .4em; }
+  .note { color: #666; font-size: 13px; }
+  @media print {
+    body { margin: 0; max-width: none; font-size: 11px; }
+    * { -webkit-print-color-adjust: exact; print-color-adjust: exact; }
+    h2 { break-after: avoid; }
+    .chart, .count, tr { break-inside: avoid; }
+    .graph { break-before: page; }
+    thead { display: table-header-group; }
+  }
+  @page { margin: 15mm; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<p class="meta">Generated {{.Generated}}{{if .Filter}} · issues matching <code>{{.Filter}}</code>{{end}}{{if .DataHash}} · data {{.DataHash}}{{end}}</p>
+
+<div class="counts">
+{{- range .Counts}}
+  <div class="count" style="border-top: 4px solid {{.Color}}"><b>{{.Value}}</b>{{.Label}}</div>
+{{- end}}
+</div>
+
+<h2>Statistics</h2>
+<div class="charts">
+{{- range .Charts}}
+  <div class="chart">
+    <h3>{{.Title}}</h3>
+    <svg width="460" height="{{.Height}}" viewBox="0 0 460 {{.Height}}" role="img" aria-label="{{.Title}}">
+    {{- range .Bars}}
+      <text x="0" y="{{.TextY}}">{{.Label}}</text>
+      <rect x="110" y="{{.Y}}" width="{{.Width}}" height="16" rx="3" fill="{{.Color}}" stroke="#888" stroke-width="0.5"></rect>
+      <text x="{{.TextX}}" y="{{.TextY}}">{{.Count}}</text>
+    {{- end}}
+    </svg>
+  </div>
+{{- end}}
+  <div class="chart">
+    <h3>Created and closed by week<span class="legend"><span style="background: {{.Trend.CreatedColor}}"></span>created<span style="background: {{.Trend.ClosedColor}}"></span>closed</span></h3>
+    {{- with .Trend}}
+    <svg width="{{.Width}}" height="{{.Height}}" viewBox="0 0 {{.Width}} {{.Height}}" role="img" aria-label="Created and closed by week">
+    {{- $trend := .}}
+    {{- range .Weeks}}
+      <rect x="{{.X}}" y="{{.CreatedY}}" width="16" height="{{.CreatedH}}" fill="{{$trend.CreatedColor}}"><title>{{.Label}}: {{.Created}} created</title></rect>
+      <rect x="{{.ClosedX}}" y="{{.ClosedY}}" width="16" height="{{.ClosedH}}" fill="{{$trend.ClosedColor}}"><title>{{.Label}}: {{.Closed}} closed</title></rect>
+      <text x="{{.LabelX}}" y="{{$trend.LabelY}}" text-anchor="middle" style="font-size: 10px">{{.Label}}</text>
+    {{- end}}
+      <line x1="0" y1="{{.Baseline}}" x2="{{.Width}}" y2="{{.Baseline}}" stroke="#ccc"></line>
+    {{- end}}
+    </svg>
+  </div>
+</div>
+
+<div class="graph">
+<h2>Dependency graph</h2>
+{{if .GraphNote}}<p class="note">{{.GraphNote}}</p>{{end}}
+{{.Graph}}
+</div>
+
+{{- range .Sections}}
+<h2><span class="status" style="background: {{.Color}}"></span>{{.Status}} ({{len .Rows}})</h2>
+<table>
+  <thead><tr><th>ID</th><th>Title</th><th>Priority</th><th>Type</th><th>Assignee</th><th>Labels</th><th>Updated</th></tr></thead>
+  <tbody>
+  {{- range .Rows}}
+    <tr><td class="id">{{.ID}}</td><td>{{.Title}}</td><td>{{.Priority}}</td><td>{{.Type}}</td><td>{{.Assignee}}</td><td>{{.Labels}}</td><td>{{.Updated}}</td></tr>
+  {{- end}}
+  </tbody>
+</table>
+{{- end}}
+</body>
+</html>
+`))
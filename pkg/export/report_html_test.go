@@ -0,0 +1,91 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestWriteHTMLReport(t *testing.T) {
+	now := time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)
+	closedAt := now.Add(-3 * 24 * time.Hour)
+	issues := []model.Issue{
+		{ID: "A", Title: "Root <task>", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask, CreatedAt: now.Add(-20 * 24 * time.Hour)},
+		{ID: "B", Title: "Depends on A", Status: model.StatusBlocked, Priority: 0, IssueType: model.TypeBug, CreatedAt: now.Add(-2 * 24 * time.Hour),
+			Dependencies: []*model.Dependency{{DependsOnID: "A", Type: model.DepBlocks}}},
+		{ID: "C", Title: "Waits on A", Status: model.StatusOpen, Priority: 2, IssueType: model.TypeTask, CreatedAt: now.Add(-24 * time.Hour),
+			Dependencies: []*model.Dependency{{DependsOnID: "A", Type: model.DepBlocks}}},
+		{ID: "D", Title: "Shipped", Status: model.StatusClosed, Priority: 2, IssueType: model.TypeFeature, CreatedAt: now.Add(-30 * 24 * time.Hour), ClosedAt: &closedAt},
+	}
+	stats := analysis.NewAnalyzer(issues).Analyze()
+
+	var buf bytes.Buffer
+	err := WriteHTMLReport(&buf, HTMLReportOptions{Title: "Q1 & beyond", Filter: "label:api", Issues: issues, Stats: &stats, GeneratedAt: now})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		"<title>Q1 &amp; beyond</title>",
+		"<code>label:api</code>",
+		"<b>4</b>Issues",
+		"<b>1</b>Ready", // Only A: C waits on it, B is blocked
+		"<b>1</b>Closed",
+		"Open by priority",
+		"Created and closed by week",
+		"Mar 14: 1 closed", // The week of Mar 14-20
+		`<svg viewBox="0 0 `,
+		"Root &lt;task&gt;",
+		"blocked (1)",
+		"open (2)",
+		"closed (1)",
+		"@media print",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected the report to contain %q", want)
+		}
+	}
+	if strings.Contains(out, "<?xml") {
+		t.Error("expected the graph inlined without an XML prolog")
+	}
+	if strings.Contains(out, "Root <task>") {
+		t.Error("expected titles escaped")
+	}
+	for _, external := range []string{`src="http`, `href="http`, "<script", "<link"} {
+		if strings.Contains(out, external) {
+			t.Errorf("expected a self-contained page, found %q", external)
+		}
+	}
+	if strings.Index(out, "blocked (1)") > strings.Index(out, "open (2)") || strings.Index(out, "open (2)") > strings.Index(out, "closed (1)") {
+		t.Error("expected blocked, then open, then closed tables")
+	}
+}
+
+func TestWriteHTMLReport_LimitsGraph(t *testing.T) {
+	var issues []model.Issue
+	for i := 0; i < reportGraphMax+5; i++ {
+		issues = append(issues, model.Issue{ID: fmt.Sprintf("N-%d", i), Title: "Node", Status: model.StatusOpen})
+	}
+	stats := analysis.NewAnalyzer(issues).Analyze()
+
+	var buf bytes.Buffer
+	if err := WriteHTMLReport(&buf, HTMLReportOptions{Issues: issues, Stats: &stats}); err != nil {
+		t.Fatal(err)
+	}
+	if want := fmt.Sprintf("The %d open issues with the highest PageRank of %d.", reportGraphMax, len(issues)); !strings.Contains(buf.String(), want) {
+		t.Errorf("expected the graph note %q", want)
+	}
+	if !strings.Contains(buf.String(), "<h1>Project report</h1>") {
+		t.Error("expected the default title")
+	}
+
+	if err := WriteHTMLReport(&buf, HTMLReportOptions{Issues: issues}); err == nil {
+		t.Error("expected an error without stats")
+	}
+}
@@ -13,6 +13,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+
 	"github.com/charmbracelet/huh"
 	"golang.org/x/term"
 )
@@ -848,11 +850,11 @@ func (w *Wizard) PrintSuccess(result *WizardResult) {
 
 // WizardConfigPath returns the path to the wizard config file.
 func WizardConfigPath() string {
-	home, err := os.UserHomeDir()
-	if err != nil {
+	dir := config.Dir()
+	if dir == "" {
 		return ""
 	}
-	return filepath.Join(home, ".config", "bv", "pages-wizard.json")
+	return filepath.Join(dir, "pages-wizard.json")
 }
 
 // LoadWizardConfig loads previously saved wizard configuration.
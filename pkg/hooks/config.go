@@ -1,6 +1,10 @@
 // Package hooks provides a hook system for bv export automation.
 // Hooks are configured via .bv/hooks.yaml and run at specific points
-// in the export pipeline (pre-export, post-export).
+// in the export pipeline (pre-export, post-export), on demand for a
+// single issue from the TUI action menu (issue-action), after the TUI
+// creates an issue (issue-created), when the TUI sees an open issue go
+// untouched for too long (issue-stale), or when a reload changes an issue
+// the user watches (issue-watched).
 package hooks
 
 import (
@@ -21,6 +25,19 @@ const (
 	PreExport HookPhase = "pre-export"
 	// PostExport runs after export is written. Failure is logged but doesn't break export.
 	PostExport HookPhase = "post-export"
+	// IssueAction hooks are listed in the TUI action menu and run for the
+	// selected issue. Failures are reported but never fatal.
+	IssueAction HookPhase = "issue-action"
+	// IssueCreated hooks run after the TUI creates an issue with bd, from
+	// quick capture or by publishing a draft. Failures are reported but
+	// never fatal.
+	IssueCreated HookPhase = "issue-created"
+	// IssueStale hooks run when the TUI sees an open issue cross the stale
+	// threshold. Failures are reported but never fatal.
+	IssueStale HookPhase = "issue-stale"
+	// IssueWatched hooks run when the TUI reloads a change to an issue the
+	// user watches. Failures are reported but never fatal.
+	IssueWatched HookPhase = "issue-watched"
 )
 
 // Hook defines a single hook configuration
@@ -30,6 +47,21 @@ type Hook struct {
 	Timeout time.Duration     `yaml:"timeout,omitempty" json:"timeout,omitempty"`   // Execution timeout (default: 30s)
 	Env     map[string]string `yaml:"env,omitempty" json:"env,omitempty"`           // Additional environment variables
 	OnError string            `yaml:"on_error,omitempty" json:"on_error,omitempty"` // "fail" (default for pre) or "continue" (default for post)
+	Needs   []string          `yaml:"needs,omitempty" json:"needs,omitempty"`       // Hooks of the same phase that must succeed first (see RunPipeline)
+	Shell   string            `yaml:"shell,omitempty" json:"shell,omitempty"`       // One of Shells; default sh, or cmd on Windows
+
+	// Set by the loader, never by the file
+	sandbox Sandbox // See WithSandbox
+	dir     string  // Project directory, where the hook runs
+}
+
+// RunTimeout returns how long the hook may run: its Timeout, or
+// DefaultTimeout.
+func (h Hook) RunTimeout() time.Duration {
+	if h.Timeout == 0 {
+		return DefaultTimeout
+	}
+	return h.Timeout
 }
 
 // Config holds all hook configurations
@@ -37,10 +69,37 @@ type Config struct {
 	Hooks HooksByPhase `yaml:"hooks" json:"hooks"`
 }
 
+// ForPhase returns the hooks of phase.
+func (c *Config) ForPhase(phase HookPhase) []Hook {
+	switch phase {
+	case PreExport:
+		return c.Hooks.PreExport
+	case PostExport:
+		return c.Hooks.PostExport
+	case IssueAction:
+		return c.Hooks.IssueAction
+	case IssueCreated:
+		return c.Hooks.IssueCreated
+	case IssueStale:
+		return c.Hooks.IssueStale
+	case IssueWatched:
+		return c.Hooks.IssueWatched
+	default:
+		return nil
+	}
+}
+
+// Phases lists the hook phases in the order of hooks.yaml.
+var Phases = []HookPhase{PreExport, PostExport, IssueAction, IssueCreated, IssueStale, IssueWatched}
+
 // HooksByPhase organizes hooks by their execution phase
 type HooksByPhase struct {
-	PreExport  []Hook `yaml:"pre-export,omitempty" json:"pre-export,omitempty"`
-	PostExport []Hook `yaml:"post-export,omitempty" json:"post-export,omitempty"`
+	PreExport    []Hook `yaml:"pre-export,omitempty" json:"pre-export,omitempty"`
+	PostExport   []Hook `yaml:"post-export,omitempty" json:"post-export,omitempty"`
+	IssueAction  []Hook `yaml:"issue-action,omitempty" json:"issue-action,omitempty"`
+	IssueCreated []Hook `yaml:"issue-created,omitempty" json:"issue-created,omitempty"`
+	IssueStale   []Hook `yaml:"issue-stale,omitempty" json:"issue-stale,omitempty"`
+	IssueWatched []Hook `yaml:"issue-watched,omitempty" json:"issue-watched,omitempty"`
 }
 
 // ExportContext contains information passed to hooks via environment variables
@@ -61,6 +120,26 @@ func (c ExportContext) ToEnv() []string {
 	}
 }
 
+// IssueContext describes the issue an issue-action hook runs for
+type IssueContext struct {
+	ID       string // BV_ISSUE_ID
+	Title    string // BV_ISSUE_TITLE
+	Status   string // BV_ISSUE_STATUS
+	Priority int    // BV_ISSUE_PRIORITY
+	Assignee string // BV_ISSUE_ASSIGNEE
+}
+
+// ToEnv converts issue context to environment variables
+func (c IssueContext) ToEnv() []string {
+	return []string{
+		fmt.Sprintf("BV_ISSUE_ID=%s", c.ID),
+		fmt.Sprintf("BV_ISSUE_TITLE=%s", c.Title),
+		fmt.Sprintf("BV_ISSUE_STATUS=%s", c.Status),
+		fmt.Sprintf("BV_ISSUE_PRIORITY=%d", c.Priority),
+		fmt.Sprintf("BV_ISSUE_ASSIGNEE=%s", c.Assignee),
+	}
+}
+
 // DefaultTimeout is the default hook execution timeout
 const DefaultTimeout = 30 * time.Second
 
@@ -69,6 +148,12 @@ type Loader struct {
 	projectDir string
 	config     *Config
 	warnings   []string
+
+	trust   *Trust
+	sandbox SandboxMode
+	path    string  // Absolute path of the hooks file read
+	data    []byte  // Its content, for trusting it
+	pending *Config // Hooks of an untrusted file, loaded by TrustFile
 }
 
 // LoaderOption configures the loader
@@ -81,6 +166,21 @@ func WithProjectDir(dir string) LoaderOption {
 	}
 }
 
+// WithTrust only loads hooks files that trust holds; Load returns an
+// *UntrustedError for any other.
+func WithTrust(trust *Trust) LoaderOption {
+	return func(l *Loader) {
+		l.trust = trust
+	}
+}
+
+// WithSandbox runs the loaded hooks under the sandbox of mode.
+func WithSandbox(mode SandboxMode) LoaderOption {
+	return func(l *Loader) {
+		l.sandbox = mode
+	}
+}
+
 // NewLoader creates a new hook loader with options
 func NewLoader(opts ...LoaderOption) *Loader {
 	l := &Loader{}
@@ -109,15 +209,58 @@ func (l *Loader) Load() error {
 		}
 		return fmt.Errorf("reading hooks config: %w", err)
 	}
+	if err := l.parse(data, configPath); err != nil {
+		return err
+	}
+
+	if l.trust != nil {
+		if abs, err := filepath.Abs(configPath); err == nil {
+			configPath = abs
+		}
+		l.path, l.data = configPath, data
+		if !l.trust.trusts(configPath, data) {
+			l.pending, l.config = l.config, &Config{}
+			return &UntrustedError{Path: configPath, Hooks: l.pending}
+		}
+	}
+	return nil
+}
 
+// TrustFile trusts the hooks file that Load found untrusted, from now on
+// and until it changes, and loads its hooks.
+func (l *Loader) TrustFile() error {
+	if l.pending == nil {
+		return nil
+	}
+	if err := l.trust.allow(l.path, l.data); err != nil {
+		return err
+	}
+	l.config, l.pending = l.pending, nil
+	return nil
+}
+
+// parse reads a hooks.yaml read from path.
+func (l *Loader) parse(data []byte, path string) error {
 	var config Config
 	if err := yaml.Unmarshal(data, &config); err != nil {
-		return fmt.Errorf("parsing %s: %w", configPath, err)
+		return fmt.Errorf("parsing %s: %w", path, err)
 	}
 
 	// Apply defaults and validate
 	l.normalizeConfig(&config)
 
+	sandbox := Sandbox{Mode: l.sandbox, ProjectDir: l.projectDir}
+	if abs, err := filepath.Abs(l.projectDir); err == nil {
+		sandbox.ProjectDir = abs
+	}
+	for _, phase := range Phases {
+		hooks := config.ForPhase(phase)
+		for i := range hooks {
+			hooks[i].sandbox = sandbox
+			hooks[i].dir = sandbox.ProjectDir
+		}
+	}
+
 	l.config = &config
 	return nil
 }
@@ -126,6 +269,10 @@ func (l *Loader) Load() error {
 func (l *Loader) normalizeConfig(config *Config) {
 	config.Hooks.PreExport, l.warnings = normalizeHooks(config.Hooks.PreExport, PreExport, l.warnings)
 	config.Hooks.PostExport, l.warnings = normalizeHooks(config.Hooks.PostExport, PostExport, l.warnings)
+	config.Hooks.IssueAction, l.warnings = normalizeHooks(config.Hooks.IssueAction, IssueAction, l.warnings)
+	config.Hooks.IssueCreated, l.warnings = normalizeHooks(config.Hooks.IssueCreated, IssueCreated, l.warnings)
+	config.Hooks.IssueStale, l.warnings = normalizeHooks(config.Hooks.IssueStale, IssueStale, l.warnings)
+	config.Hooks.IssueWatched, l.warnings = normalizeHooks(config.Hooks.IssueWatched, IssueWatched, l.warnings)
 }
 
 // normalizeHooks applies defaults, drops empty commands, and accumulates warnings.
@@ -137,6 +284,14 @@ func normalizeHooks(hooks []Hook, phase HookPhase, warnings []string) ([]Hook, [
 			warnings = append(warnings, fmt.Sprintf("%s hook %d has empty command; skipping", phase, i+1))
 			continue
 		}
+		if !validShell(hook.Shell) {
+			warnings = append(warnings, fmt.Sprintf("%s hook %d has unknown shell %q (want %s); skipping", phase, i+1, hook.Shell, strings.Join(Shells, ", ")))
+			continue
+		}
+		if hook.Timeout < 0 {
+			warnings = append(warnings, fmt.Sprintf("%s hook %d has a negative timeout; using %s", phase, i+1, DefaultTimeout))
+			hook.Timeout = 0
+		}
 		if hook.Timeout == 0 {
 			hook.Timeout = DefaultTimeout
 		}
@@ -144,15 +299,20 @@ func normalizeHooks(hooks []Hook, phase HookPhase, warnings []string) ([]Hook, [
 			if phase == PreExport {
 				hook.OnError = "fail" // pre-export failures cancel export by default
 			} else {
-				hook.OnError = "continue" // post-export failures don't break export by default
+				hook.OnError = "continue" // other failures are only reported
 			}
 		}
 		if hook.Name == "" {
 			hook.Name = fmt.Sprintf("%s-%d", phase, i+1)
 		}
+		if phase == IssueAction && len(hook.Needs) > 0 {
+			// Issue-action hooks run one at a time, from the action menu
+			warnings = append(warnings, fmt.Sprintf("%s hook %q has needs, but %s hooks run on their own; ignoring them", phase, hook.Name, phase))
+			hook.Needs = nil
+		}
 		out = append(out, hook)
 	}
-	return out, warnings
+	return validateNeeds(out, phase, warnings)
 }
 
 // Config returns the loaded configuration (or empty if not loaded)
@@ -168,7 +328,9 @@ func (l *Loader) HasHooks() bool {
 	if l.config == nil {
 		return false
 	}
-	return len(l.config.Hooks.PreExport) > 0 || len(l.config.Hooks.PostExport) > 0
+	return len(l.config.Hooks.PreExport) > 0 || len(l.config.Hooks.PostExport) > 0 ||
+		len(l.config.Hooks.IssueAction) > 0 || len(l.config.Hooks.IssueCreated) > 0 ||
+		len(l.config.Hooks.IssueStale) > 0 || len(l.config.Hooks.IssueWatched) > 0
 }
 
 // GetHooks returns hooks for a specific phase
@@ -176,15 +338,7 @@ func (l *Loader) GetHooks(phase HookPhase) []Hook {
 	if l.config == nil {
 		return nil
 	}
-
-	switch phase {
-	case PreExport:
-		return l.config.Hooks.PreExport
-	case PostExport:
-		return l.config.Hooks.PostExport
-	default:
-		return nil
-	}
+	return l.config.ForPhase(phase)
 }
 
 // Warnings returns any warnings from loading
@@ -211,6 +365,8 @@ func (h *Hook) UnmarshalYAML(node *yaml.Node) error {
 		Timeout string            `yaml:"timeout,omitempty"`
 		Env     map[string]string `yaml:"env,omitempty"`
 		OnError string            `yaml:"on_error,omitempty"`
+		Needs   []string          `yaml:"needs,omitempty"`
+		Shell   string            `yaml:"shell,omitempty"`
 	}
 
 	var dto hookDTO
@@ -222,6 +378,8 @@ func (h *Hook) UnmarshalYAML(node *yaml.Node) error {
 	h.Command = dto.Command
 	h.Env = dto.Env
 	h.OnError = dto.OnError
+	h.Needs = dto.Needs
+	h.Shell = dto.Shell
 
 	// Parse timeout
 	if dto.Timeout != "" {
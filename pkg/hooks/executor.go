@@ -9,6 +9,7 @@ import (
 	"runtime"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -21,6 +22,7 @@ type HookResult struct {
 	Stderr   string
 	Duration time.Duration
 	Error    error
+	Skipped  bool // Never started in a pipeline; Error says why
 }
 
 // Executor runs hooks with proper environment and timeout handling
@@ -52,19 +54,26 @@ func (e *Executor) SetLogger(logger func(string)) {
 
 // RunPreExport executes all pre-export hooks
 // Returns error if any hook fails with on_error="fail"
-func (e *Executor) RunPreExport() error {
+// Canceling ctx kills the running hook and skips the rest.
+func (e *Executor) RunPreExport(ctx context.Context) error {
 	if e.config == nil {
 		return nil
 	}
+	if hasNeeds(e.config.Hooks.PreExport) {
+		return e.runPipeline(ctx, e.config.Hooks.PreExport, PreExport)
+	}
 
 	for _, hook := range e.config.Hooks.PreExport {
 		e.logger(fmt.Sprintf("Running pre-export hook %q: %s", hook.Name, hook.Command))
-		result := e.runHook(hook, PreExport)
+		result := e.runHook(ctx, hook, PreExport)
 		e.results = append(e.results, result)
 
 		if !result.Success && hook.OnError == "fail" {
 			return fmt.Errorf("pre-export hook %q failed: %w", hook.Name, result.Error)
 		}
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("pre-export hooks: %w", err)
+		}
 	}
 
 	return nil
@@ -72,25 +81,58 @@ func (e *Executor) RunPreExport() error {
 
 // RunPostExport executes all post-export hooks
 // Errors are logged but don't fail (unless on_error="fail")
-func (e *Executor) RunPostExport() error {
+// Canceling ctx kills the running hook and skips the rest.
+func (e *Executor) RunPostExport(ctx context.Context) error {
 	if e.config == nil {
 		return nil
 	}
+	if hasNeeds(e.config.Hooks.PostExport) {
+		return e.runPipeline(ctx, e.config.Hooks.PostExport, PostExport)
+	}
 
 	var firstError error
 	for _, hook := range e.config.Hooks.PostExport {
 		e.logger(fmt.Sprintf("Running post-export hook %q: %s", hook.Name, hook.Command))
-		result := e.runHook(hook, PostExport)
+		result := e.runHook(ctx, hook, PostExport)
 		e.results = append(e.results, result)
 
 		if !result.Success && hook.OnError == "fail" && firstError == nil {
 			firstError = fmt.Errorf("post-export hook %q failed: %w", hook.Name, result.Error)
 		}
+		if err := ctx.Err(); err != nil {
+			if firstError == nil {
+				firstError = fmt.Errorf("post-export hooks: %w", err)
+			}
+			break
+		}
 	}
 
 	return firstError
 }
 
+// runPipeline runs hooks of phase as a pipeline (see RunPipeline). It
+// returns the error of the first failed hook with on_error="fail".
+func (e *Executor) runPipeline(ctx context.Context, hooks []Hook, phase HookPhase) error {
+	var mu sync.Mutex
+	results := RunPipeline(ctx, phase, hooks, func(ctx context.Context, hook Hook) HookResult {
+		mu.Lock()
+		e.logger(fmt.Sprintf("Running %s hook %q: %s", phase, hook.Name, hook.Command))
+		mu.Unlock()
+		return e.runHook(ctx, hook, phase)
+	})
+	e.results = append(e.results, results...)
+
+	for _, result := range results {
+		if !result.Success && !result.Skipped && result.Hook.OnError == "fail" {
+			return fmt.Errorf("%s hook %q failed: %w", phase, result.Hook.Name, result.Error)
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("%s hooks: %w", phase, err)
+	}
+	return nil
+}
+
 // getShellCommand returns the shell and flag to use for executing commands
 func getShellCommand() (string, string) {
 	if runtime.GOOS == "windows" {
@@ -99,32 +141,61 @@ func getShellCommand() (string, string) {
 	return "sh", "-c"
 }
 
-// runHook executes a single hook with timeout and environment
-func (e *Executor) runHook(hook Hook, phase HookPhase) HookResult {
-	result := HookResult{
-		Hook:  hook,
-		Phase: phase,
-	}
+// ShellCommand returns a command that runs command through the platform
+// shell, the way hooks without a shell are run. Canceling ctx kills the
+// shell and the processes it started.
+func ShellCommand(ctx context.Context, command string) *exec.Cmd {
+	return shellCommand(ctx, "", command, "")
+}
 
-	start := time.Now()
+// runHook executes a single hook with the export context
+func (e *Executor) runHook(ctx context.Context, hook Hook, phase HookPhase) HookResult {
+	return runHookWithEnv(ctx, hook, phase, e.context.ToEnv())
+}
 
-	// Create context with timeout
-	timeout := hook.Timeout
-	if timeout == 0 {
-		timeout = DefaultTimeout
-	}
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
-	defer cancel()
+// RunIssueHook executes an issue-action hook for a single issue
+func RunIssueHook(ctx context.Context, hook Hook, issue IssueContext) HookResult {
+	return runHookWithEnv(ctx, hook, IssueAction, issue.ToEnv())
+}
+
+// RunIssueCreatedHook executes an issue-created hook for a new issue
+func RunIssueCreatedHook(ctx context.Context, hook Hook, issue IssueContext) HookResult {
+	return runHookWithEnv(ctx, hook, IssueCreated, issue.ToEnv())
+}
+
+// RunIssueStaleHook executes an issue-stale hook for an issue that went stale
+func RunIssueStaleHook(ctx context.Context, hook Hook, issue IssueContext) HookResult {
+	return runHookWithEnv(ctx, hook, IssueStale, issue.ToEnv())
+}
+
+// RunIssueWatchedHook executes an issue-watched hook for a watched issue
+// that changed, with the changes in BV_WATCH_CHANGES, separated by ", ".
+func RunIssueWatchedHook(ctx context.Context, hook Hook, issue IssueContext, changes []string) HookResult {
+	env := append(issue.ToEnv(), "BV_WATCH_CHANGES="+strings.Join(changes, ", "))
+	return runHookWithEnv(ctx, hook, IssueWatched, env)
+}
+
+// IssueHookCommand returns the command RunIssueHook runs for hook and
+// issue, for callers that stream its output themselves. ctx should end
+// after hook.RunTimeout().
+func IssueHookCommand(ctx context.Context, hook Hook, issue IssueContext) (*exec.Cmd, error) {
+	return hookCommand(ctx, hook, issue.ToEnv())
+}
 
+// hookCommand returns the command of hook, in its sandbox and directory.
+// contextEnv is added on top of the process environment.
+func hookCommand(ctx context.Context, hook Hook, contextEnv []string) (*exec.Cmd, error) {
 	// Create command - use shell to interpret the command
-	shell, flag := getShellCommand()
-	cmd := exec.CommandContext(ctx, shell, flag, hook.Command)
+	cmd, err := hook.sandbox.command(ctx, hook.Shell, hook.Command, hook.dir)
+	if err != nil {
+		return nil, err
+	}
 
-	// Build environment
-	cmd.Env = os.Environ()
+	// Build environment, confined by the sandbox
+	cmd.Env = hook.sandbox.environ(os.Environ())
 
-	// Add export context variables
-	cmd.Env = append(cmd.Env, e.context.ToEnv()...)
+	// Add export or issue context variables
+	cmd.Env = append(cmd.Env, contextEnv...)
 
 	// Add hook-specific env vars (with ${VAR} expansion from current env)
 	// Sort keys for deterministic environment order
@@ -140,6 +211,31 @@ func (e *Executor) runHook(hook Hook, phase HookPhase) HookResult {
 		expandedValue := expandEnv(value, cmd.Env)
 		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", key, expandedValue))
 	}
+	return cmd, nil
+}
+
+// runHookWithEnv executes a single hook with timeout and environment.
+// contextEnv is added on top of the process environment. Canceling parent
+// kills the hook.
+func runHookWithEnv(parent context.Context, hook Hook, phase HookPhase, contextEnv []string) HookResult {
+	result := HookResult{
+		Hook:  hook,
+		Phase: phase,
+	}
+
+	start := time.Now()
+
+	// Create context with timeout
+	timeout := hook.RunTimeout()
+	ctx, cancel := context.WithTimeout(parent, timeout)
+	defer cancel()
+
+	cmd, err := hookCommand(ctx, hook, contextEnv)
+	if err != nil {
+		result.Error = err
+		result.Duration = time.Since(start)
+		return result
+	}
 
 	// Capture output
 	var stdout, stderr bytes.Buffer
@@ -147,13 +243,15 @@ func (e *Executor) runHook(hook Hook, phase HookPhase) HookResult {
 	cmd.Stderr = &stderr
 
 	// Run the command
-	err := cmd.Run()
+	err = cmd.Run()
 	result.Duration = time.Since(start)
 	result.Stdout = strings.TrimSpace(stdout.String())
 	result.Stderr = strings.TrimSpace(stderr.String())
 
 	if err != nil {
-		if ctx.Err() == context.DeadlineExceeded {
+		if parent.Err() != nil {
+			result.Error = fmt.Errorf("canceled: %w", parent.Err())
+		} else if ctx.Err() == context.DeadlineExceeded {
 			result.Error = fmt.Errorf("timeout after %v", timeout)
 		} else {
 			result.Error = err
@@ -194,14 +292,13 @@ func (e *Executor) Summary() string {
 	}
 
 	var sb strings.Builder
-	var succeeded, failed int
-
 	for _, r := range e.results {
-		if r.Success {
-			succeeded++
+		switch {
+		case r.Success:
 			sb.WriteString(fmt.Sprintf("  [OK] %s (%v)\n", r.Hook.Name, r.Duration.Round(time.Millisecond)))
-		} else {
-			failed++
+		case r.Skipped:
+			sb.WriteString(fmt.Sprintf("  [SKIP] %s: %v\n", r.Hook.Name, r.Error))
+		default:
 			sb.WriteString(fmt.Sprintf("  [FAIL] %s: %v\n", r.Hook.Name, r.Error))
 			if r.Stderr != "" {
 				sb.WriteString(fmt.Sprintf("         stderr: %s\n", truncate(r.Stderr, 200)))
@@ -209,7 +306,7 @@ func (e *Executor) Summary() string {
 		}
 	}
 
-	header := fmt.Sprintf("Hook execution: %d succeeded, %d failed\n", succeeded, failed)
+	header := fmt.Sprintf("Hook execution: %s\n", PipelineStatus(e.results))
 	return header + sb.String()
 }
 
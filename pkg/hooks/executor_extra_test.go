@@ -1,6 +1,7 @@
 package hooks
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
@@ -22,7 +23,7 @@ func TestRunPreExportTimeout(t *testing.T) {
 		},
 	}
 	ex := NewExecutor(cfg, ExportContext{})
-	err := ex.RunPreExport()
+	err := ex.RunPreExport(context.Background())
 	if err == nil {
 		t.Fatalf("expected timeout error")
 	}
@@ -47,7 +48,7 @@ func TestRunPostExportContinue(t *testing.T) {
 		},
 	}
 	ex := NewExecutor(cfg, ExportContext{})
-	if err := ex.RunPostExport(); err != nil {
+	if err := ex.RunPostExport(context.Background()); err != nil {
 		t.Fatalf("post-export should not surface error, got %v", err)
 	}
 	res := ex.Results()
@@ -1,6 +1,8 @@
 package hooks
 
 import (
+	"context"
+	"errors"
 	"os"
 	"path/filepath"
 	"strings"
@@ -148,7 +150,7 @@ func TestExecutorRunSimpleHook(t *testing.T) {
 	}
 
 	executor := NewExecutor(config, ctx)
-	err := executor.RunPreExport()
+	err := executor.RunPreExport(context.Background())
 	if err != nil {
 		t.Fatalf("expected hook to succeed, got: %v", err)
 	}
@@ -189,7 +191,7 @@ func TestExecutorHookFailure(t *testing.T) {
 	}
 
 	executor := NewExecutor(config, ctx)
-	err := executor.RunPreExport()
+	err := executor.RunPreExport(context.Background())
 	if err == nil {
 		t.Error("expected error for failing pre-export hook")
 	}
@@ -223,7 +225,7 @@ func TestExecutorHookFailureContinue(t *testing.T) {
 	}
 
 	executor := NewExecutor(config, ctx)
-	err := executor.RunPostExport()
+	err := executor.RunPostExport(context.Background())
 	if err != nil {
 		t.Errorf("expected no error with on_error=continue, got: %v", err)
 	}
@@ -269,7 +271,7 @@ func TestExecutorHookTimeout(t *testing.T) {
 	}
 
 	executor := NewExecutor(config, ctx)
-	err := executor.RunPreExport()
+	err := executor.RunPreExport(context.Background())
 	if err == nil {
 		t.Error("expected timeout error")
 	}
@@ -310,7 +312,7 @@ func TestExecutorEnvironmentVariables(t *testing.T) {
 	}
 
 	executor := NewExecutor(config, ctx)
-	err := executor.RunPreExport()
+	err := executor.RunPreExport(context.Background())
 	if err != nil {
 		t.Fatalf("expected success, got: %v", err)
 	}
@@ -321,6 +323,67 @@ func TestExecutorEnvironmentVariables(t *testing.T) {
 	}
 }
 
+func TestRunIssueHook(t *testing.T) {
+	hook := Hook{
+		Name:    "issue-env",
+		Command: "echo $BV_ISSUE_ID $BV_ISSUE_STATUS $BV_ISSUE_PRIORITY",
+		Timeout: 5 * time.Second,
+	}
+
+	result := RunIssueHook(context.Background(), hook, IssueContext{ID: "bv-42", Title: "Fix it", Status: "open", Priority: 1})
+	if !result.Success {
+		t.Fatalf("expected success, got: %v", result.Error)
+	}
+	if result.Phase != IssueAction {
+		t.Errorf("expected issue-action phase, got %s", result.Phase)
+	}
+	if result.Stdout != "bv-42 open 1" {
+		t.Errorf("expected issue env vars in output, got %q", result.Stdout)
+	}
+}
+
+func TestRunIssueCreatedHook(t *testing.T) {
+	hook := Hook{Name: "announce", Command: "echo created $BV_ISSUE_ID", Timeout: 5 * time.Second}
+
+	result := RunIssueCreatedHook(context.Background(), hook, IssueContext{ID: "bv-7", Title: "New", Status: "open"})
+	if !result.Success || result.Phase != IssueCreated {
+		t.Fatalf("expected a successful issue-created hook, got %+v", result)
+	}
+	if result.Stdout != "created bv-7" {
+		t.Errorf("expected issue env vars in output, got %q", result.Stdout)
+	}
+}
+
+func TestRunIssueWatchedHook(t *testing.T) {
+	hook := Hook{Name: "ping", Command: `echo "$BV_ISSUE_ID: $BV_WATCH_CHANGES"`, Timeout: 5 * time.Second}
+
+	result := RunIssueWatchedHook(context.Background(), hook, IssueContext{ID: "bv-3", Status: "closed"}, []string{"status open → closed", "new comment"})
+	if !result.Success || result.Phase != IssueWatched {
+		t.Fatalf("expected a successful issue-watched hook, got %+v", result)
+	}
+	if result.Stdout != "bv-3: status open → closed, new comment" {
+		t.Errorf("expected the changes in BV_WATCH_CHANGES, got %q", result.Stdout)
+	}
+}
+
+func TestRunIssueHook_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	result := RunIssueHook(ctx, Hook{Name: "slow", Command: "sleep 5", Timeout: 10 * time.Second}, IssueContext{ID: "bv-1"})
+	if result.Success || !errors.Is(result.Error, context.Canceled) {
+		t.Fatalf("expected a canceled hook, got %v", result.Error)
+	}
+	if result.Duration > 3*time.Second {
+		t.Errorf("expected the hook to be killed promptly, took %v", result.Duration)
+	}
+
+	executor := NewExecutor(&Config{Hooks: HooksByPhase{PreExport: []Hook{{Name: "a", Command: "true"}}}}, ExportContext{})
+	if err := executor.RunPreExport(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected pre-export hooks to stop once canceled, got %v", err)
+	}
+}
+
 func TestExecutorCustomEnvExpansion(t *testing.T) {
 	// Set an env var to be expanded
 	os.Setenv("TEST_HOOK_VAR", "expanded_value")
@@ -350,7 +413,7 @@ func TestExecutorCustomEnvExpansion(t *testing.T) {
 	}
 
 	executor := NewExecutor(config, ctx)
-	err := executor.RunPreExport()
+	err := executor.RunPreExport(context.Background())
 	if err != nil {
 		t.Fatalf("expected success, got: %v", err)
 	}
@@ -391,8 +454,8 @@ func TestExecutorSummary(t *testing.T) {
 	}
 
 	executor := NewExecutor(config, ctx)
-	_ = executor.RunPreExport()
-	_ = executor.RunPostExport()
+	_ = executor.RunPreExport(context.Background())
+	_ = executor.RunPostExport(context.Background())
 
 	summary := executor.Summary()
 	if summary == "" {
@@ -489,7 +552,7 @@ func TestRunPreExportStopsOnFail(t *testing.T) {
 	}
 
 	executor := NewExecutor(config, ExportContext{})
-	err := executor.RunPreExport()
+	err := executor.RunPreExport(context.Background())
 	if err == nil {
 		t.Fatal("expected error from failing pre-export hook")
 	}
@@ -514,7 +577,7 @@ func TestRunPostExportFailOnErrorStillRunsAll(t *testing.T) {
 	}
 
 	executor := NewExecutor(config, ExportContext{})
-	err := executor.RunPostExport()
+	err := executor.RunPostExport(context.Background())
 	if err == nil {
 		t.Fatal("expected error for post-export hook with on_error=fail")
 	}
@@ -595,7 +658,7 @@ func TestExecutorCommandNotFound(t *testing.T) {
 	}
 
 	exec := NewExecutor(config, ExportContext{})
-	err := exec.RunPreExport()
+	err := exec.RunPreExport(context.Background())
 	if err == nil {
 		t.Fatalf("expected error for missing command")
 	}
@@ -624,7 +687,7 @@ func TestExecutorPermissionDenied(t *testing.T) {
 	}
 
 	exec := NewExecutor(config, ExportContext{})
-	err := exec.RunPreExport()
+	err := exec.RunPreExport(context.Background())
 	if err == nil {
 		t.Fatalf("expected permission error")
 	}
@@ -649,7 +712,7 @@ func TestExecutorLargeStderrTruncatedInSummary(t *testing.T) {
 	}
 
 	exec := NewExecutor(config, ExportContext{})
-	_ = exec.RunPostExport()
+	_ = exec.RunPostExport(context.Background())
 
 	summary := exec.Summary()
 	if summary == "" {
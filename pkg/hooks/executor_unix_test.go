@@ -2,7 +2,13 @@
 
 package hooks
 
-import "testing"
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
 
 func TestGetShellCommand_Unix(t *testing.T) {
 	shell, flag := getShellCommand()
@@ -10,3 +16,68 @@ func TestGetShellCommand_Unix(t *testing.T) {
 		t.Fatalf("getShellCommand() = (%q, %q); want (\"sh\", \"-c\")", shell, flag)
 	}
 }
+
+func TestShellQuote_Unix(t *testing.T) {
+	for _, s := range []string{"", "plain", "it's", `"$HOME" $(rm -rf ~) ; |`, "50% off"} {
+		out, err := ShellCommand(context.Background(), "printf %s "+ShellQuote(s)).Output()
+		if err != nil {
+			t.Fatalf("%q: %v", s, err)
+		}
+		if string(out) != s {
+			t.Errorf("ShellQuote(%q) reached the shell as %q", s, out)
+		}
+	}
+}
+
+func TestSandboxedHooks_Unix(t *testing.T) {
+	t.Setenv("BV_TEST_SECRET", "hunter2")
+	hook := Hook{Name: "leak", Command: `echo "[$BV_TEST_SECRET] [$BV_ISSUE_ID] [$FROM_HOOK]"`, Env: map[string]string{"FROM_HOOK": "${BV_TEST_SECRET}"}}
+
+	result := RunIssueHook(context.Background(), hook, IssueContext{ID: "A-1"})
+	if result.Stdout != "[hunter2] [A-1] [hunter2]" {
+		t.Errorf("expected an unconfined hook to see the environment, got %q", result.Stdout)
+	}
+
+	hook.sandbox = Sandbox{Mode: SandboxEnv}
+	result = RunIssueHook(context.Background(), hook, IssueContext{ID: "A-1"})
+	if !result.Success || result.Stdout != "[] [A-1] []" {
+		t.Errorf("expected the secret kept from a confined hook, got %q (%v)", result.Stdout, result.Error)
+	}
+
+	// Strict mode fails instead of running unconfined without its tool.
+	t.Setenv("PATH", t.TempDir())
+	hook.sandbox = Sandbox{Mode: SandboxStrict, ProjectDir: t.TempDir()}
+	result = RunIssueHook(context.Background(), hook, IssueContext{ID: "A-1"})
+	if result.Success || result.Error == nil || !strings.Contains(result.Error.Error(), "strict hook sandbox needs") {
+		t.Errorf("expected strict mode to need its sandbox tool, got %v", result.Error)
+	}
+}
+
+func TestHooksRunInProjectDir_Unix(t *testing.T) {
+	dir := t.TempDir()
+	hook := Hook{Name: "where", Command: "pwd -P", dir: dir}
+	result := RunIssueHook(context.Background(), hook, IssueContext{})
+	want, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Error != nil || result.Stdout != want {
+		t.Errorf("expected the hook to run in %s, got %q (%v)", want, result.Stdout, result.Error)
+	}
+}
+
+func TestPowerShellHook_Unix(t *testing.T) {
+	if _, err := exec.LookPath(ShellPwsh); err != nil {
+		t.Skip("pwsh not installed")
+	}
+	hook := Hook{Name: "ps", Shell: ShellPwsh, Command: `Write-Output "it's $env:BV_ISSUE_ID"`}
+	result := RunIssueHook(context.Background(), hook, IssueContext{ID: "A-1"})
+	if result.Error != nil || result.Stdout != "it's A-1" {
+		t.Errorf("expected pwsh to run the command as written, got %q (%v)", result.Stdout, result.Error)
+	}
+
+	hook.Command = "Get-Item ./does-not-exist; Write-Output unreachable"
+	if result := RunIssueHook(context.Background(), hook, IssueContext{}); result.Success || strings.Contains(result.Stdout, "unreachable") {
+		t.Errorf("expected an error to stop the script and fail the hook, got %+v", result)
+	}
+}
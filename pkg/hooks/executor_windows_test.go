@@ -2,7 +2,13 @@
 
 package hooks
 
-import "testing"
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
 
 func TestGetShellCommand_Windows(t *testing.T) {
 	shell, flag := getShellCommand()
@@ -10,3 +16,83 @@ func TestGetShellCommand_Windows(t *testing.T) {
 		t.Fatalf("getShellCommand() = (%q, %q); want (\"cmd\", \"/C\")", shell, flag)
 	}
 }
+
+func TestShellQuote_Windows(t *testing.T) {
+	tests := map[string]string{
+		"":              `""`,
+		"plain":         `"plain"`,
+		`say "hi"`:      `"say ""hi"""`,
+		"50% off":       `"50"^%" off"`,
+		"%PATH%":        `""^%"PATH"^%""`,
+		"a & b | c > d": `"a & b | c > d"`,
+	}
+	for in, want := range tests {
+		if got := ShellQuote(in); got != want {
+			t.Errorf("ShellQuote(%q) = %s, want %s", in, got, want)
+		}
+	}
+}
+
+func TestShellCommand_WindowsPassesQuotesVerbatim(t *testing.T) {
+	cmd := ShellCommand(context.Background(), `if "a b"=="a b" echo same`)
+	if got := cmd.SysProcAttr.CmdLine; got != `cmd /S /C "if "a b"=="a b" echo same"` {
+		t.Fatalf("unexpected command line %s", got)
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.TrimSpace(string(out)) != "same" {
+		t.Errorf("expected cmd to see the quotes, got %q", out)
+	}
+}
+
+func TestShellCommand_WindowsUNCDir(t *testing.T) {
+	cmd := shellCommand(context.Background(), ShellCmd, "dir", `\\server\share\proj`)
+	if got := cmd.SysProcAttr.CmdLine; got != `cmd /S /C "pushd "\\server\share\proj" && dir"` {
+		t.Errorf("expected cmd to pushd into the UNC directory, got %s", got)
+	}
+	if cmd.Dir != "" {
+		t.Errorf("expected cmd not to start in the UNC directory, got %q", cmd.Dir)
+	}
+
+	// PowerShell starts in UNC directories itself
+	cmd = shellCommand(context.Background(), ShellPowerShell, "Get-Location", `\\server\share\proj`)
+	if cmd.Dir != `\\server\share\proj` {
+		t.Errorf("expected powershell to start in the UNC directory, got %q", cmd.Dir)
+	}
+}
+
+func TestWorkDir_WindowsLongPath(t *testing.T) {
+	if got := workDir(`C:\proj`); got != `C:\proj` {
+		t.Errorf("expected a short directory unchanged, got %q", got)
+	}
+
+	dir := t.TempDir()
+	for len(dir) < maxDirPath {
+		dir = filepath.Join(dir, "a-rather-long-directory-name")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	short := workDir(dir)
+	if short == dir {
+		t.Skip("the volume has no short names")
+	}
+	cmd := shellCommand(context.Background(), ShellCmd, "cd", dir)
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.TrimSpace(string(out)) != short {
+		t.Errorf("expected cmd to start in %s, got %q", short, out)
+	}
+}
+
+func TestPowerShellHook_Windows(t *testing.T) {
+	hook := Hook{Name: "ps", Shell: ShellPowerShell, Command: `Write-Output "it's ""quoted"" $env:BV_ISSUE_ID"`}
+	result := RunIssueHook(context.Background(), hook, IssueContext{ID: "A-1"})
+	if result.Error != nil || result.Stdout != `it's "quoted" A-1` {
+		t.Errorf("expected powershell to run the command as written, got %q (%v: %s)", result.Stdout, result.Error, result.Stderr)
+	}
+}
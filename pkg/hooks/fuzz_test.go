@@ -0,0 +1,42 @@
+package hooks
+
+import (
+	"testing"
+)
+
+// FuzzParseHooks checks that no hooks.yaml makes the loader panic, and that
+// every hook it accepts can be run.
+//
+// Run with: go test -fuzz=FuzzParseHooks ./pkg/hooks/
+func FuzzParseHooks(f *testing.F) {
+	seeds := []string{
+		"",
+		"hooks:\n  pre-export:\n    - name: check\n      command: ./check.sh\n      timeout: 10s\n",
+		"hooks:\n  post-export:\n    - command: echo done\n      timeout: 30\n      on_error: fail\n      env: {A: b}\n",
+		"hooks:\n  issue-created:\n    - command: notify\n      timeout: -5s\n",
+		"hooks:\n  issue-stale:\n    - command: x\n      timeout: NaN\n",
+		"hooks:\n  issue-action:\n    - command: \"\"\n    - {}\n",
+		"hooks: [1, 2]\n",
+		"hooks:\n  pre-export: {command: x}\n",
+		"hooks:\n  pre-export:\n    - command: x\n      timeout: 1e300\n",
+		"hooks: &a\n  pre-export: *a\n",
+		"\t- ]",
+	}
+	for _, seed := range seeds {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		l := NewLoader(WithProjectDir("."))
+		if err := l.parse(data, "hooks.yaml"); err != nil {
+			return
+		}
+		for _, phase := range Phases {
+			for _, h := range l.GetHooks(phase) {
+				if h.Command == "" || h.Name == "" || h.OnError == "" || h.Timeout <= 0 {
+					t.Fatalf("%s hook not normalized: %+v", phase, h)
+				}
+			}
+		}
+	})
+}
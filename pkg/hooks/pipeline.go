@@ -0,0 +1,207 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Pipelines
+//
+// A hook can name the hooks of its phase that must succeed before it
+// starts (needs). The hooks of a phase then run as a pipeline: each one
+// starts as soon as its needs have succeeded, so hooks that don't depend on
+// each other run at the same time, and a hook whose needs failed or were
+// skipped is skipped too.
+
+// hasNeeds reports whether any of hooks declares needs, which makes their
+// phase a pipeline.
+func hasNeeds(hooks []Hook) bool {
+	for _, hook := range hooks {
+		if len(hook.Needs) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// RunPipeline runs hooks, the hooks of one event in phase, with run. Each
+// hook starts once every hook named in its needs has succeeded; hooks
+// whose needs are met run concurrently. A failed hook with on_error "fail",
+// or canceling ctx, starts nothing more. The results are in the order of
+// hooks, with Skipped set for the hooks that never started.
+func RunPipeline(ctx context.Context, phase HookPhase, hooks []Hook, run func(context.Context, Hook) HookResult) []HookResult {
+	type finished struct {
+		i      int
+		result HookResult
+	}
+	const (
+		pending = iota
+		running
+		done
+	)
+	results := make([]HookResult, len(hooks))
+	state := make([]int, len(hooks))
+	finishedCh := make(chan finished)
+	active := 0
+	var stop error
+
+	skip := func(i int, reason error) {
+		results[i] = HookResult{Hook: hooks[i], Phase: phase, Skipped: true, Error: reason}
+		state[i] = done
+	}
+
+	// blocker returns the unmet need that stops hook i for good, and
+	// whether all of its needs have succeeded.
+	blocker := func(i int) (string, bool) {
+		met := true
+		for _, need := range hooks[i].Needs {
+			for j, other := range hooks {
+				if other.Name != need {
+					continue
+				}
+				if state[j] != done {
+					met = false
+				} else if !results[j].Success {
+					return need, false
+				}
+			}
+		}
+		return "", met
+	}
+
+	for {
+		if err := ctx.Err(); err != nil && stop == nil {
+			stop = fmt.Errorf("skipped: %w", err)
+		}
+		// Skipping a hook can skip the hooks that need it, so look again
+		// until nothing changes.
+		for changed := stop == nil; changed; {
+			changed = false
+			for i := range hooks {
+				if state[i] != pending {
+					continue
+				}
+				need, ready := blocker(i)
+				switch {
+				case need != "":
+					skip(i, fmt.Errorf("skipped: needs %q, which did not succeed", need))
+					changed = true
+				case ready:
+					state[i] = running
+					active++
+					go func(i int) {
+						finishedCh <- finished{i, run(ctx, hooks[i])}
+					}(i)
+				}
+			}
+		}
+		if active == 0 {
+			break
+		}
+		f := <-finishedCh
+		active--
+		results[f.i], state[f.i] = f.result, done
+		if !f.result.Success && hooks[f.i].OnError == "fail" && stop == nil {
+			stop = fmt.Errorf("skipped: %q failed", hooks[f.i].Name)
+		}
+	}
+
+	for i := range hooks {
+		if state[i] == pending {
+			reason := stop
+			if reason == nil {
+				reason = fmt.Errorf("skipped: its needs never finished")
+			}
+			skip(i, reason)
+		}
+	}
+	return results
+}
+
+// PipelineStatus counts results by outcome, e.g. "2 succeeded, 1 failed,
+// 1 skipped".
+func PipelineStatus(results []HookResult) string {
+	var succeeded, failed, skipped int
+	for _, r := range results {
+		switch {
+		case r.Success:
+			succeeded++
+		case r.Skipped:
+			skipped++
+		default:
+			failed++
+		}
+	}
+	status := fmt.Sprintf("%d succeeded, %d failed", succeeded, failed)
+	if skipped > 0 {
+		status += fmt.Sprintf(", %d skipped", skipped)
+	}
+	return status
+}
+
+// validateNeeds drops the needs of hooks that name no hook of phase, and
+// the hooks whose needs can never be met because they form a cycle or wait
+// on one.
+func validateNeeds(hooks []Hook, phase HookPhase, warnings []string) ([]Hook, []string) {
+	if !hasNeeds(hooks) {
+		return hooks, warnings
+	}
+	names := make(map[string]bool, len(hooks))
+	for _, hook := range hooks {
+		names[hook.Name] = true
+	}
+	for i := range hooks {
+		var needs []string
+		for _, need := range hooks[i].Needs {
+			if need == hooks[i].Name || !names[need] {
+				warnings = append(warnings, fmt.Sprintf("%s hook %q needs %q, which is not a %s hook; ignoring it", phase, hooks[i].Name, need, phase))
+				continue
+			}
+			needs = append(needs, need)
+		}
+		hooks[i].Needs = needs
+	}
+
+	// Whatever a topological sort cannot reach is on or behind a cycle.
+	resolved := make(map[string]bool, len(hooks))
+	for progress := true; progress; {
+		progress = false
+		unresolved := make(map[string]bool)
+		for _, hook := range hooks {
+			if !resolved[hook.Name] {
+				unresolved[hook.Name] = true
+			}
+		}
+		for _, hook := range hooks {
+			if resolved[hook.Name] {
+				continue
+			}
+			ok := true
+			for _, need := range hook.Needs {
+				if unresolved[need] {
+					ok = false
+				}
+			}
+			if ok {
+				resolved[hook.Name] = true
+				progress = true
+			}
+		}
+	}
+	var out []Hook
+	var cyclic []string
+	for _, hook := range hooks {
+		if resolved[hook.Name] {
+			out = append(out, hook)
+		} else {
+			cyclic = append(cyclic, hook.Name)
+		}
+	}
+	if len(cyclic) > 0 {
+		sort.Strings(cyclic)
+		warnings = append(warnings, fmt.Sprintf("%s hooks %s wait on a cycle of needs; skipping them", phase, strings.Join(cyclic, ", ")))
+	}
+	return out, warnings
+}
@@ -0,0 +1,175 @@
+package hooks
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRunPipeline_OrdersByNeedsAndRunsTheRestConcurrently(t *testing.T) {
+	hooks := []Hook{
+		{Name: "lint", OnError: "continue"},
+		{Name: "test", OnError: "continue"},
+		{Name: "build", OnError: "continue", Needs: []string{"lint", "test"}},
+		{Name: "deploy", OnError: "continue", Needs: []string{"build"}},
+		{Name: "notify", OnError: "continue", Needs: []string{"deploy"}},
+		{Name: "docs", OnError: "continue"},
+	}
+	var mu sync.Mutex
+	var order []string
+	started := make(chan string, len(hooks))
+	release := make(chan struct{})
+	run := func(ctx context.Context, hook Hook) HookResult {
+		started <- hook.Name
+		if hook.Name == "lint" {
+			<-release // Holds build back until test and docs are known to run
+		}
+		mu.Lock()
+		order = append(order, hook.Name)
+		mu.Unlock()
+		return HookResult{Hook: hook, Success: hook.Name != "deploy", Error: errorIf(hook.Name == "deploy")}
+	}
+
+	go func() {
+		seen := map[string]bool{}
+		for len(seen) < 3 {
+			seen[<-started] = true
+		}
+		close(release)
+	}()
+	results := RunPipeline(context.Background(), PostExport, hooks, run)
+
+	if got := strings.Join(order, ","); !strings.HasSuffix(got, "build,deploy") || strings.Index(got, "lint") > strings.Index(got, "build") {
+		t.Errorf("expected build after lint and test, and deploy after build, got %s", got)
+	}
+	if len(results) != len(hooks) {
+		t.Fatalf("expected a result per hook, got %d", len(results))
+	}
+	for i, r := range results {
+		if r.Hook.Name != hooks[i].Name {
+			t.Errorf("expected results in hook order, got %s at %d", r.Hook.Name, i)
+		}
+	}
+	if notify := results[4]; !notify.Skipped || !strings.Contains(notify.Error.Error(), `needs "deploy"`) {
+		t.Errorf("expected notify skipped after deploy failed, got %+v", notify)
+	}
+	if got := PipelineStatus(results); got != "4 succeeded, 1 failed, 1 skipped" {
+		t.Errorf("unexpected status %q", got)
+	}
+}
+
+func TestRunPipeline_FailingHookStopsNewStarts(t *testing.T) {
+	hooks := []Hook{
+		{Name: "check", OnError: "fail"},
+		{Name: "slow", OnError: "fail"},
+		{Name: "after", OnError: "fail", Needs: []string{"slow"}},
+	}
+	run := func(ctx context.Context, hook Hook) HookResult {
+		if hook.Name == "slow" {
+			time.Sleep(20 * time.Millisecond)
+		}
+		return HookResult{Hook: hook, Success: hook.Name != "check", Error: errorIf(hook.Name == "check")}
+	}
+	results := RunPipeline(context.Background(), PreExport, hooks, run)
+	if !results[1].Success {
+		t.Error("expected the hook already running to finish")
+	}
+	if after := results[2]; !after.Skipped || !strings.Contains(after.Error.Error(), `"check" failed`) {
+		t.Errorf("expected nothing to start after check failed, got %+v", after)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results = RunPipeline(ctx, PreExport, hooks, run)
+	for _, r := range results {
+		if !r.Skipped || !errors.Is(r.Error, context.Canceled) {
+			t.Errorf("expected a canceled pipeline to start nothing, got %+v", r)
+		}
+	}
+}
+
+func TestLoader_ValidatesNeeds(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, ".bv"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	hooksYAML := `hooks:
+  pre-export:
+    - name: a
+      command: echo a
+      needs: [missing, a]
+    - name: b
+      command: echo b
+      needs: [c]
+    - name: c
+      command: echo c
+      needs: [b]
+    - name: d
+      command: echo d
+      needs: [c]
+    - name: e
+      command: echo e
+      needs: [a]
+  issue-action:
+    - name: menu
+      command: echo menu
+      needs: [other]
+`
+	if err := os.WriteFile(filepath.Join(dir, ".bv", "hooks.yaml"), []byte(hooksYAML), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	loader := NewLoader(WithProjectDir(dir))
+	if err := loader.Load(); err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, hook := range loader.GetHooks(PreExport) {
+		names = append(names, hook.Name)
+	}
+	if got := strings.Join(names, ","); got != "a,e" {
+		t.Errorf("expected the hooks on a cycle dropped, got %s", got)
+	}
+	if a := loader.GetHooks(PreExport)[0]; len(a.Needs) != 0 {
+		t.Errorf("expected unknown and self needs dropped, got %v", a.Needs)
+	}
+	if menu := loader.GetHooks(IssueAction)[0]; len(menu.Needs) != 0 {
+		t.Errorf("expected issue-action needs ignored, got %v", menu.Needs)
+	}
+	warnings := strings.Join(loader.Warnings(), "\n")
+	for _, want := range []string{`needs "missing"`, `needs "a"`, "b, c, d wait on a cycle", `issue-action hook "menu" has needs`} {
+		if !strings.Contains(warnings, want) {
+			t.Errorf("expected a warning containing %q, got:\n%s", want, warnings)
+		}
+	}
+}
+
+func TestExecutor_RunsPipelinePhase(t *testing.T) {
+	config := &Config{Hooks: HooksByPhase{PostExport: []Hook{
+		{Name: "upload", Command: "exit 1", OnError: "continue", Timeout: DefaultTimeout},
+		{Name: "announce", Command: "echo announced", OnError: "continue", Timeout: DefaultTimeout, Needs: []string{"upload"}},
+		{Name: "log", Command: "echo logged", OnError: "continue", Timeout: DefaultTimeout},
+	}}}
+	executor := NewExecutor(config, ExportContext{})
+	if err := executor.RunPostExport(context.Background()); err != nil {
+		t.Fatalf("expected continue hooks not to fail the export, got %v", err)
+	}
+	summary := executor.Summary()
+	for _, want := range []string{"1 succeeded, 1 failed, 1 skipped", "[SKIP] announce", "[OK] log", "[FAIL] upload"} {
+		if !strings.Contains(summary, want) {
+			t.Errorf("expected %q in the summary:\n%s", want, summary)
+		}
+	}
+}
+
+func errorIf(failed bool) error {
+	if failed {
+		return errors.New("exit status 1")
+	}
+	return nil
+}
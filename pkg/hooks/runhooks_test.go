@@ -1,6 +1,7 @@
 package hooks
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
@@ -84,7 +85,7 @@ func TestRunPostExportFailStopsOnFail(t *testing.T) {
 	}}}
 
 	exec := NewExecutor(config, ExportContext{})
-	err := exec.RunPostExport()
+	err := exec.RunPostExport(context.Background())
 	if err == nil {
 		t.Fatalf("expected error when post-export hook fails with on_error=fail")
 	}
@@ -0,0 +1,157 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// SandboxMode says how far hooks are confined. Hooks come with the
+// repository, so a checkout of someone else's project brings commands
+// that run as you; the sandbox limits what they can reach.
+type SandboxMode string
+
+const (
+	// SandboxNone runs hooks as the user, with the full environment.
+	SandboxNone SandboxMode = "none"
+	// SandboxEnv runs hooks with a minimal environment, so that tokens
+	// and credentials in variables do not reach them.
+	SandboxEnv SandboxMode = "env"
+	// SandboxStrict adds an OS sandbox to SandboxEnv: no network, the home
+	// directory hidden, and only the project and a private /tmp writable.
+	// It uses bwrap (bubblewrap) on Linux and sandbox-exec on macOS, and
+	// hooks fail rather than run unconfined where neither is available.
+	SandboxStrict SandboxMode = "strict"
+)
+
+// SandboxModes lists the modes, weakest first.
+var SandboxModes = []SandboxMode{SandboxNone, SandboxEnv, SandboxStrict}
+
+// ParseSandboxMode parses a mode name; "" is SandboxNone.
+func ParseSandboxMode(s string) (SandboxMode, error) {
+	if s == "" {
+		return SandboxNone, nil
+	}
+	for _, mode := range SandboxModes {
+		if SandboxMode(s) == mode {
+			return mode, nil
+		}
+	}
+	return "", fmt.Errorf("unknown hook sandbox %q (want none, env or strict)", s)
+}
+
+// Sandbox confines the hooks of the project in ProjectDir. The zero value
+// runs them unconfined.
+type Sandbox struct {
+	Mode       SandboxMode
+	ProjectDir string
+}
+
+// sandboxEnvKeys are the variables a confined hook keeps. Everything
+// else, API tokens included, is dropped.
+var sandboxEnvKeys = map[string]bool{
+	"PATH": true, "HOME": true, "USER": true, "LOGNAME": true, "SHELL": true,
+	"LANG": true, "TERM": true, "TZ": true, "TMPDIR": true,
+	// Windows needs these to start processes at all
+	"SYSTEMROOT": true, "COMSPEC": true, "PATHEXT": true, "TEMP": true, "TMP": true,
+}
+
+// confined reports whether s limits the environment.
+func (s Sandbox) confined() bool {
+	return s.Mode == SandboxEnv || s.Mode == SandboxStrict
+}
+
+// environ returns the part of base a hook under s sees.
+func (s Sandbox) environ(base []string) []string {
+	if !s.confined() {
+		return base
+	}
+	var env []string
+	for _, kv := range base {
+		key, _, _ := strings.Cut(kv, "=")
+		if sandboxEnvKeys[strings.ToUpper(key)] || strings.HasPrefix(key, "LC_") {
+			env = append(env, kv)
+		}
+	}
+	return env
+}
+
+// command returns the command that runs command in shell (see shellArgs)
+// under s, in dir. Canceling ctx kills it and the processes it started.
+func (s Sandbox) command(ctx context.Context, shell, command, dir string) (*exec.Cmd, error) {
+	if s.Mode != SandboxStrict {
+		return shellCommand(ctx, shell, command, dir), nil
+	}
+	home, _ := os.UserHomeDir()
+	args, err := strictSandboxArgs(runtime.GOOS, s.ProjectDir, home, shellArgs(shell, command))
+	if err != nil {
+		return nil, err
+	}
+	path, err := exec.LookPath(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("strict hook sandbox needs %s: %w", args[0], err)
+	}
+	cmd := exec.CommandContext(ctx, path, args[1:]...)
+	cmd.Dir = dir
+	killGroupOnCancel(cmd)
+	cmd.WaitDelay = time.Second
+	return cmd, nil
+}
+
+// strictSandboxArgs returns the command line that runs shell, a shell
+// command line, under the OS sandbox of goos, with projectDir writable and
+// home hidden. The project's .git stays read-only: a hook that could write
+// .git/hooks or .git/config would have git run its code unconfined.
+func strictSandboxArgs(goos, projectDir, home string, shell []string) ([]string, error) {
+	if projectDir == "" {
+		return nil, fmt.Errorf("strict hook sandbox needs the project directory")
+	}
+	switch goos {
+	case "linux":
+		args := []string{"bwrap", "--die-with-parent", "--unshare-all",
+			"--ro-bind", "/", "/", "--dev", "/dev", "--proc", "/proc", "--tmpfs", "/tmp"}
+		if home != "" && home != "/" {
+			args = append(args, "--tmpfs", home)
+		}
+		args = append(args, "--bind", projectDir, projectDir)
+		// bwrap cannot bind a path that does not exist.
+		if gitDir := filepath.Join(projectDir, ".git"); pathExists(gitDir) {
+			args = append(args, "--ro-bind", gitDir, gitDir)
+		}
+		args = append(args, "--chdir", projectDir)
+		return append(args, shell...), nil
+	case "darwin":
+		return append([]string{"sandbox-exec", "-p", darwinSandboxProfile(projectDir, home)}, shell...), nil
+	default:
+		return nil, fmt.Errorf("strict hook sandbox is not supported on %s", goos)
+	}
+}
+
+// pathExists reports whether path exists.
+func pathExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// darwinSandboxProfile is the sandbox-exec profile of strict mode. Later
+// rules win, so the project stays readable inside a hidden home and its
+// .git read-only inside the writable project.
+func darwinSandboxProfile(projectDir, home string) string {
+	quote := func(s string) string {
+		return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+	}
+	var sb strings.Builder
+	sb.WriteString("(version 1)\n(allow default)\n(deny network*)\n(deny file-write*)\n")
+	if home != "" && home != "/" {
+		fmt.Fprintf(&sb, "(deny file-read* (subpath %s))\n", quote(home))
+	}
+	fmt.Fprintf(&sb, "(allow file-read* file-write* (subpath %s))\n", quote(projectDir))
+	fmt.Fprintf(&sb, "(deny file-write* (subpath %s))\n", quote(filepath.Join(projectDir, ".git")))
+	sb.WriteString(`(allow file-write* (subpath "/private/tmp") (subpath "/private/var/folders") (literal "/dev/null"))` + "\n")
+	return sb.String()
+}
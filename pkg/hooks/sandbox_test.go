@@ -0,0 +1,84 @@
+package hooks
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseSandboxMode(t *testing.T) {
+	for s, want := range map[string]SandboxMode{"": SandboxNone, "none": SandboxNone, "env": SandboxEnv, "strict": SandboxStrict} {
+		if got, err := ParseSandboxMode(s); err != nil || got != want {
+			t.Errorf("ParseSandboxMode(%q) = %q, %v; want %q", s, got, err, want)
+		}
+	}
+	if _, err := ParseSandboxMode("jail"); err == nil {
+		t.Error("expected an unknown mode to fail")
+	}
+}
+
+func TestSandboxEnviron(t *testing.T) {
+	base := []string{"PATH=/bin", "HOME=/home/u", "LC_ALL=C", "GITHUB_TOKEN=secret", "AWS_SECRET_ACCESS_KEY=x", "BV_DEBUG=1"}
+	if got := (Sandbox{}).environ(base); len(got) != len(base) {
+		t.Errorf("expected no sandbox to keep the environment, got %v", got)
+	}
+	for _, mode := range []SandboxMode{SandboxEnv, SandboxStrict} {
+		got := strings.Join(Sandbox{Mode: mode}.environ(base), " ")
+		if got != "PATH=/bin HOME=/home/u LC_ALL=C" {
+			t.Errorf("%s: unexpected environment %q", mode, got)
+		}
+	}
+}
+
+func TestStrictSandboxArgs(t *testing.T) {
+	args, err := strictSandboxArgs("linux", "/work/proj", "/home/u", []string{"sh", "-c", "make lint"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := strings.Join(args, " ")
+	for _, want := range []string{"bwrap ", "--unshare-all", "--ro-bind / /", "--tmpfs /home/u --bind /work/proj /work/proj", "--chdir /work/proj", "sh -c make lint"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected %q in %q", want, got)
+		}
+	}
+
+	// A project's .git is bound read-only over the writable project.
+	proj := t.TempDir()
+	args, _ = strictSandboxArgs("linux", proj, "/home/u", []string{"sh", "-c", "true"})
+	if strings.Contains(strings.Join(args, " "), ".git") {
+		t.Errorf("expected no .git bind without a .git, got %q", args)
+	}
+	if err := os.Mkdir(filepath.Join(proj, ".git"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	args, _ = strictSandboxArgs("linux", proj, "/home/u", []string{"sh", "-c", "true"})
+	gitDir := filepath.Join(proj, ".git")
+	if want := "--bind " + proj + " " + proj + " --ro-bind " + gitDir + " " + gitDir; !strings.Contains(strings.Join(args, " "), want) {
+		t.Errorf("expected %q in %q", want, args)
+	}
+
+	args, err = strictSandboxArgs("darwin", `/Users/u/my "proj"`, "/Users/u", []string{"sh", "-c", "make lint"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if args[0] != "sandbox-exec" || args[len(args)-1] != "make lint" {
+		t.Errorf("unexpected command line %q", args)
+	}
+	profile := args[2]
+	for _, want := range []string{"(deny network*)", `(deny file-read* (subpath "/Users/u"))`, `(allow file-read* file-write* (subpath "/Users/u/my \"proj\""))`, `(deny file-write* (subpath "/Users/u/my \"proj\"/.git"))`} {
+		if !strings.Contains(profile, want) {
+			t.Errorf("expected %q in the profile:\n%s", want, profile)
+		}
+	}
+	if strings.Index(profile, "/.git") < strings.Index(profile, "(allow file-read* file-write*") {
+		t.Errorf("expected the .git rule after the project's, as later rules win:\n%s", profile)
+	}
+
+	if _, err := strictSandboxArgs("windows", `C:\proj`, `C:\Users\u`, []string{"cmd", "/C", "dir"}); err == nil {
+		t.Error("expected strict mode to be unsupported on Windows")
+	}
+	if _, err := strictSandboxArgs("linux", "", "/home/u", []string{"sh", "-c", "true"}); err == nil {
+		t.Error("expected strict mode to need the project directory")
+	}
+}
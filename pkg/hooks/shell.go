@@ -0,0 +1,82 @@
+package hooks
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"os/exec"
+	"time"
+	"unicode/utf16"
+)
+
+// Shells a hook can run in (Hook.Shell). Without one, hooks run in sh, or
+// in cmd on Windows.
+const (
+	ShellSh         = "sh"
+	ShellCmd        = "cmd"
+	ShellPwsh       = "pwsh"       // PowerShell 7 and later, on any OS
+	ShellPowerShell = "powershell" // Windows PowerShell 5.1
+)
+
+// Shells lists the shells a hook can name.
+var Shells = []string{ShellSh, ShellCmd, ShellPwsh, ShellPowerShell}
+
+// validShell reports whether shell is "" or one of Shells.
+func validShell(shell string) bool {
+	if shell == "" {
+		return true
+	}
+	for _, s := range Shells {
+		if shell == s {
+			return true
+		}
+	}
+	return false
+}
+
+// shellArgs returns the command line that runs command in shell, or in the
+// platform shell for "".
+func shellArgs(shell, command string) []string {
+	if shell == "" {
+		shell, _ = getShellCommand()
+	}
+	switch shell {
+	case ShellPwsh, ShellPowerShell:
+		return []string{shell, "-NoProfile", "-NonInteractive", "-EncodedCommand", encodePowerShell(command)}
+	case ShellCmd:
+		return []string{ShellCmd, "/C", command}
+	default:
+		return []string{ShellSh, "-c", command}
+	}
+}
+
+// encodePowerShell encodes command for -EncodedCommand: base64 of its
+// UTF-16LE, which reaches PowerShell intact whatever quotes it contains.
+// Errors stop the script and fail the hook, as a failing command does in
+// sh -e, and progress bars stay out of stderr.
+func encodePowerShell(command string) string {
+	script := "$ErrorActionPreference = 'Stop'\n$ProgressPreference = 'SilentlyContinue'\n" + command
+	units := utf16.Encode([]rune(script))
+	buf := make([]byte, 2*len(units))
+	for i, u := range units {
+		binary.LittleEndian.PutUint16(buf[2*i:], u)
+	}
+	return base64.StdEncoding.EncodeToString(buf)
+}
+
+// shellCommand returns a command that runs command in shell (see
+// shellArgs) in dir, or in the current directory for "". Canceling ctx
+// kills the shell and the processes it started.
+func shellCommand(ctx context.Context, shell, command, dir string) *exec.Cmd {
+	args := shellArgs(shell, command)
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	if args[0] == ShellCmd {
+		setShellCommandLine(cmd, command, dir)
+	} else {
+		cmd.Dir = workDir(dir)
+	}
+	killGroupOnCancel(cmd)
+	// Don't wait on output pipes held open by orphaned grandchildren.
+	cmd.WaitDelay = time.Second
+	return cmd
+}
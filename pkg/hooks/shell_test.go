@@ -0,0 +1,79 @@
+package hooks
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode/utf16"
+)
+
+func TestShellArgs(t *testing.T) {
+	defaultShell, _ := getShellCommand()
+	if got := shellArgs("", "echo hi"); got[0] != defaultShell {
+		t.Errorf("expected the platform shell by default, got %v", got)
+	}
+	if got := strings.Join(shellArgs(ShellSh, "echo hi"), " "); got != "sh -c echo hi" {
+		t.Errorf("unexpected sh command line %q", got)
+	}
+	if got := strings.Join(shellArgs(ShellCmd, "echo hi"), " "); got != "cmd /C echo hi" {
+		t.Errorf("unexpected cmd command line %q", got)
+	}
+	for _, shell := range []string{ShellPwsh, ShellPowerShell} {
+		args := shellArgs(shell, `Write-Output "it's $env:BV_ISSUE_ID"`)
+		if args[0] != shell || strings.Join(args[1:4], " ") != "-NoProfile -NonInteractive -EncodedCommand" {
+			t.Errorf("unexpected %s command line %v", shell, args)
+		}
+	}
+}
+
+func TestEncodePowerShell(t *testing.T) {
+	command := `& "C:\Program Files\tool.exe" --name 'a "b"' | Out-File ü.txt`
+	raw, err := base64.StdEncoding.DecodeString(encodePowerShell(command))
+	if err != nil {
+		t.Fatal(err)
+	}
+	units := make([]uint16, len(raw)/2)
+	for i := range units {
+		units[i] = binary.LittleEndian.Uint16(raw[2*i:])
+	}
+	script := string(utf16.Decode(units))
+	if !strings.HasSuffix(script, "\n"+command) || !strings.HasPrefix(script, "$ErrorActionPreference = 'Stop'") {
+		t.Errorf("expected the command intact after the preamble, got %q", script)
+	}
+}
+
+func TestLoader_SkipsHooksWithUnknownShell(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, ".bv"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	hooksYAML := `hooks:
+  post-export:
+    - name: upload
+      command: ./upload.ps1
+      shell: pwsh
+    - name: legacy
+      command: echo hi
+      shell: fish
+`
+	if err := os.WriteFile(filepath.Join(dir, ".bv", "hooks.yaml"), []byte(hooksYAML), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	loader := NewLoader(WithProjectDir(dir))
+	if err := loader.Load(); err != nil {
+		t.Fatal(err)
+	}
+	hooks := loader.GetHooks(PostExport)
+	if len(hooks) != 1 || hooks[0].Shell != ShellPwsh {
+		t.Fatalf("expected only the pwsh hook, got %+v", hooks)
+	}
+	if abs, _ := filepath.Abs(dir); hooks[0].dir != abs {
+		t.Errorf("expected the hook to run in %s, got %q", abs, hooks[0].dir)
+	}
+	if warnings := strings.Join(loader.Warnings(), "\n"); !strings.Contains(warnings, `unknown shell "fish"`) {
+		t.Errorf("expected a warning about the shell, got %q", warnings)
+	}
+}
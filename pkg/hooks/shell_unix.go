@@ -0,0 +1,34 @@
+//go:build !windows
+
+package hooks
+
+import (
+	"os/exec"
+	"strings"
+	"syscall"
+)
+
+// killGroupOnCancel runs cmd in its own process group and kills the whole
+// group on cancel, so children of the shell do not outlive the hook.
+func killGroupOnCancel(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+}
+
+// setShellCommandLine runs cmd in dir; cmd.exe only exists on Windows, so
+// the command fails to start.
+func setShellCommandLine(cmd *exec.Cmd, command, dir string) {
+	cmd.Dir = dir
+}
+
+// workDir returns dir, which Unix shells take as it is.
+func workDir(dir string) string {
+	return dir
+}
+
+// ShellQuote quotes s as a single word for the shell hooks run in.
+func ShellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
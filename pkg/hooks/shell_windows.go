@@ -0,0 +1,79 @@
+//go:build windows
+
+package hooks
+
+import (
+	"os/exec"
+	"strings"
+	"syscall"
+)
+
+// killGroupOnCancel is a no-op on Windows, where canceling kills only the
+// shell.
+func killGroupOnCancel(cmd *exec.Cmd) {}
+
+// setShellCommandLine passes command to cmd.exe verbatim, to run in dir.
+// Go quotes arguments with the argv rules of CommandLineToArgvW, which cmd
+// does not follow, so a command containing quotes would reach it mangled.
+// /S makes cmd strip exactly the outer pair of quotes.
+//
+// cmd cannot start in a UNC directory (\\server\share): it warns and falls
+// back to the Windows directory. pushd maps such a directory to a drive
+// letter for the length of the command instead.
+func setShellCommandLine(cmd *exec.Cmd, command, dir string) {
+	if isUNC(dir) {
+		command = "pushd " + ShellQuote(dir) + " && " + command
+	} else {
+		cmd.Dir = workDir(dir)
+	}
+	cmd.SysProcAttr = &syscall.SysProcAttr{CmdLine: `cmd /S /C "` + command + `"`}
+}
+
+// isUNC reports whether dir is a network path, \\server\share\...
+func isUNC(dir string) bool {
+	return strings.HasPrefix(dir, `\\`) && !strings.HasPrefix(dir, `\\?\`)
+}
+
+// maxDirPath is the longest working directory CreateProcess accepts:
+// MAX_PATH less room for an 8.3 file name.
+const maxDirPath = 248
+
+// workDir returns dir in a form a process can start in. Directories past
+// maxDirPath are only reachable by their short (8.3) name, where the
+// volume has short names; otherwise dir is returned as it is and starting
+// the hook fails with the reason.
+func workDir(dir string) string {
+	if len(dir) < maxDirPath {
+		return dir
+	}
+	// The \\?\ prefix lifts MAX_PATH for the lookup itself; the result
+	// must not keep it, since it is not a valid working directory.
+	query := dir
+	if len(dir) > 2 && dir[1] == ':' {
+		query = `\\?\` + dir
+	}
+	long, err := syscall.UTF16PtrFromString(query)
+	if err != nil {
+		return dir
+	}
+	buf := make([]uint16, syscall.MAX_LONG_PATH)
+	n, err := syscall.GetShortPathName(long, &buf[0], uint32(len(buf)))
+	if err != nil || n == 0 || int(n) > len(buf) {
+		return dir
+	}
+	short := strings.TrimPrefix(syscall.UTF16ToString(buf[:n]), `\\?\`)
+	if len(short) >= maxDirPath {
+		return dir
+	}
+	return short
+}
+
+// ShellQuote quotes s as a single word for cmd.exe: inside double quotes
+// only '"' and '%' are special. Quotes are doubled, and each '%' is
+// escaped with '^' outside the quotes, since cmd expands %VAR% even inside
+// them.
+func ShellQuote(s string) string {
+	s = strings.ReplaceAll(s, `"`, `""`)
+	s = strings.ReplaceAll(s, "%", `"^%"`)
+	return `"` + s + `"`
+}
@@ -0,0 +1,82 @@
+package hooks
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// TrustFileName is the file, in the user's bv directory, that records the
+// hooks files the user agreed to run.
+const TrustFileName = "trusted-hooks.json"
+
+// Trust remembers the hooks files the user agreed to run, by path and
+// content: editing a trusted file makes it untrusted again, so a pull that
+// changes the hooks asks anew.
+type Trust struct {
+	path   string
+	hashes map[string]string // Absolute hooks file path to SHA-256 of its content
+}
+
+// LoadTrust reads the trust records at path; a missing file trusts
+// nothing. With an empty path, trust is only kept in memory.
+func LoadTrust(path string) (*Trust, error) {
+	t := &Trust{path: path, hashes: make(map[string]string)}
+	if path == "" {
+		return t, nil
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return t, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading hook trust: %w", err)
+	}
+	if err := json.Unmarshal(data, &t.hashes); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return t, nil
+}
+
+func contentHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// trusts reports whether the hooks file at file, holding data, is trusted.
+func (t *Trust) trusts(file string, data []byte) bool {
+	return t.hashes[file] == contentHash(data)
+}
+
+// allow trusts the hooks file at file with its current content data.
+func (t *Trust) allow(file string, data []byte) error {
+	t.hashes[file] = contentHash(data)
+	if t.path == "" {
+		return nil
+	}
+	out, err := json.MarshalIndent(t.hashes, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(t.path), 0o755); err != nil {
+		return fmt.Errorf("saving hook trust: %w", err)
+	}
+	if err := os.WriteFile(t.path, out, 0o600); err != nil {
+		return fmt.Errorf("saving hook trust: %w", err)
+	}
+	return nil
+}
+
+// UntrustedError is returned by Loader.Load for a hooks file that is not
+// trusted yet. Nothing is loaded until Loader.TrustFile.
+type UntrustedError struct {
+	Path  string
+	Hooks *Config // What the file would run, to show before asking
+}
+
+func (e *UntrustedError) Error() string {
+	return fmt.Sprintf("hooks in %s are not trusted yet", e.Path)
+}
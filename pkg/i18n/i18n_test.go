@@ -0,0 +1,434 @@
+package i18n
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+	"unicode"
+)
+
+func TestDetect(t *testing.T) {
+	env := func(vars map[string]string) func(string) string {
+		return func(name string) string { return vars[name] }
+	}
+	cases := []struct {
+		name       string
+		configured string
+		vars       map[string]string
+		want       string
+	}{
+		{"nothing set", "", nil, "en"},
+		{"configured wins", "de", map[string]string{"LANG": "en_US.UTF-8"}, "de"},
+		{"auto reads LANG", "auto", map[string]string{"LANG": "de_DE.UTF-8"}, "de"},
+		{"LC_ALL before LANG", "", map[string]string{"LC_ALL": "de_AT", "LANG": "en_US"}, "de"},
+		{"LC_MESSAGES before LANG", "", map[string]string{"LC_MESSAGES": "en_GB", "LANG": "de_DE"}, "en"},
+		{"modifier", "", map[string]string{"LANG": "de_DE.UTF-8@euro"}, "de"},
+		{"C locale", "", map[string]string{"LC_ALL": "C", "LANG": "de_DE"}, "en"},
+		{"unsupported language", "", map[string]string{"LANG": "xx_YY.UTF-8"}, "en"},
+	}
+	for _, tc := range cases {
+		if got := Detect(tc.configured, env(tc.vars)); got != tc.want {
+			t.Errorf("%s: Detect = %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestValidateLocale(t *testing.T) {
+	for _, name := range []string{"", "auto", "en", "de"} {
+		if err := ValidateLocale(name); err != nil {
+			t.Errorf("ValidateLocale(%q): %v", name, err)
+		}
+	}
+	if err := ValidateLocale("klingon"); err == nil || !strings.Contains(err.Error(), "auto, en, de") {
+		t.Errorf("expected an error listing the locales, got %v", err)
+	}
+}
+
+func TestSetLocale(t *testing.T) {
+	t.Cleanup(func() { _ = SetLocale(DefaultLocale) })
+
+	if err := SetLocale("de"); err != nil {
+		t.Fatalf("SetLocale(de): %v", err)
+	}
+	if Locale() != "de" {
+		t.Errorf("Locale = %q, want de", Locale())
+	}
+	if got := T("Move down"); got != "Nach unten" {
+		t.Errorf("T(Move down) = %q", got)
+	}
+	if got := Tf("Cannot %s", "x"); got != "x nicht möglich" {
+		t.Errorf("Tf(Cannot %%s) = %q", got)
+	}
+	if got := T("a string without a translation"); got != "a string without a translation" {
+		t.Errorf("expected missing strings to stay English, got %q", got)
+	}
+
+	if err := SetLocale("xx"); err == nil {
+		t.Error("expected an error for a locale without a catalog")
+	}
+	if err := SetLocale(DefaultLocale); err != nil || T("Move down") != "Move down" {
+		t.Errorf("expected English after SetLocale(en), got %q (%v)", T("Move down"), err)
+	}
+}
+
+func TestPluralForm(t *testing.T) {
+	cases := map[string][]struct{ n, want int }{
+		"en": {{0, 1}, {1, 0}, {2, 1}, {21, 1}},
+		"de": {{0, 1}, {1, 0}, {5, 1}},
+		"fr": {{0, 0}, {1, 0}, {2, 1}},
+		"uk": {{1, 0}, {3, 1}, {5, 2}, {11, 2}, {12, 2}, {21, 0}, {22, 1}, {111, 2}, {-3, 1}},
+		"ru": {{1, 0}, {4, 1}, {14, 2}, {101, 0}},
+		"pl": {{1, 0}, {3, 1}, {5, 2}, {13, 2}, {21, 2}, {22, 1}},
+		"xx": {{1, 0}, {2, 1}},
+	}
+	for lang, counts := range cases {
+		for _, tc := range counts {
+			if got := pluralForm(lang, tc.n); got != tc.want {
+				t.Errorf("%s: pluralForm(%d) = %d, want %d", lang, tc.n, got, tc.want)
+			}
+		}
+	}
+}
+
+func TestN(t *testing.T) {
+	t.Cleanup(func() { current.Store(nil) })
+
+	if got := Nf("%d issue|%d issues", 1); got != "1 issue" {
+		t.Errorf("Nf(1) = %q", got)
+	}
+	if got := Nf("%d issue|%d issues in %s", 3, "bv"); got != "3 issues in bv" {
+		t.Errorf("Nf(3) = %q", got)
+	}
+
+	current.Store(&catalog{lang: "uk", messages: map[string]string{
+		"%dd ago": "%d день тому|%d дні тому|%d днів тому",
+		"%dh ago": "%d год тому", // One form serves every count
+	}})
+	for n, want := range map[int]string{1: "1 день тому", 3: "3 дні тому", 5: "5 днів тому", 21: "21 день тому"} {
+		if got := Nf("%dd ago", n); got != want {
+			t.Errorf("uk: Nf(%%dd ago, %d) = %q, want %q", n, got, want)
+		}
+	}
+	if got := Nf("%dh ago", 3); got != "3 год тому" {
+		t.Errorf("uk: expected the only form, got %q", got)
+	}
+	if got := Nf("%d issue|%d issues", 2); got != "2 issues" {
+		t.Errorf("expected untranslated text to keep English forms, got %q", got)
+	}
+}
+
+func TestRelativeTime(t *testing.T) {
+	t.Cleanup(func() { _ = SetLocale(DefaultLocale) })
+
+	const day = 24 * time.Hour
+	cases := map[string][]struct {
+		d    time.Duration
+		want string
+	}{
+		"en": {
+			{30 * time.Second, "now"},
+			{10 * time.Minute, "10m ago"},
+			{2 * time.Hour, "2h ago"},
+			{25 * time.Hour, "1d ago"},
+			{8 * day, "1w ago"},
+			{60 * day, "2mo ago"},
+			{400 * day, "13mo ago"},
+		},
+		"de": {
+			{30 * time.Second, "jetzt"},
+			{10 * time.Minute, "vor 10 Min."},
+			{2 * time.Hour, "vor 2 Std."},
+			{25 * time.Hour, "vor 1 Tag"},
+			{3 * day, "vor 3 Tagen"},
+			{8 * day, "vor 1 Woche"},
+			{60 * day, "vor 2 Monaten"},
+			{400 * day, "vor 13 Monaten"},
+		},
+	}
+	for lang, durations := range cases {
+		if err := SetLocale(lang); err != nil {
+			t.Fatal(err)
+		}
+		for _, tc := range durations {
+			if got := RelativeTime(tc.d); got != tc.want {
+				t.Errorf("%s: RelativeTime(%v) = %q, want %q", lang, tc.d, got, tc.want)
+			}
+		}
+	}
+}
+
+// sourceDirs are the packages whose strings go through T, Tf, N and Nf.
+var sourceDirs = []string{".", "../ui"}
+
+// translators are the functions whose first argument is translated.
+var translators = map[string]bool{"T": true, "Tf": true, "N": true, "Nf": true}
+
+// translatedArg returns which argument of a call to fun is translated: the
+// first of the translators, called as i18n.T or from this package, the
+// action of the footer's hint(key, action), or -1.
+func translatedArg(fun ast.Expr) int {
+	switch fun := fun.(type) {
+	case *ast.SelectorExpr:
+		if pkg, ok := fun.X.(*ast.Ident); ok && pkg.Name == "i18n" && translators[fun.Sel.Name] {
+			return 0
+		}
+	case *ast.Ident:
+		if translators[fun.Name] {
+			return 0
+		}
+		if fun.Name == "hint" {
+			return 1
+		}
+	}
+	return -1
+}
+
+// stringConst folds a constant string expression, such as a literal or
+// literals joined with +, and reports whether expr was one.
+func stringConst(expr ast.Expr) (string, bool) {
+	switch expr := expr.(type) {
+	case *ast.BasicLit:
+		if expr.Kind != token.STRING {
+			return "", false
+		}
+		s, err := strconv.Unquote(expr.Value)
+		return s, err == nil
+	case *ast.BinaryExpr:
+		if expr.Op != token.ADD {
+			return "", false
+		}
+		x, ok := stringConst(expr.X)
+		if !ok {
+			return "", false
+		}
+		y, ok := stringConst(expr.Y)
+		return x + y, ok
+	case *ast.ParenExpr:
+		return stringConst(expr.X)
+	}
+	return "", false
+}
+
+// counted reports whether fun is N or Nf, whose message holds plural forms.
+func counted(fun ast.Expr) bool {
+	switch fun := fun.(type) {
+	case *ast.SelectorExpr:
+		return fun.Sel.Name == "N" || fun.Sel.Name == "Nf"
+	case *ast.Ident:
+		return fun.Name == "N" || fun.Name == "Nf"
+	}
+	return false
+}
+
+// translatedMaps are the package-level maps whose values are translated
+// when shown.
+var translatedMaps = map[string]bool{"ContextHelpContent": true}
+
+// sourceMessages returns the translated strings in dirs, per translatedArg,
+// the Section names of tutorial pages and the values of translatedMaps,
+// each mapped to whether it is counted and so split into plural forms. A
+// translated string is a literal or a package-level string constant, as the
+// tutorial's pages are.
+func sourceMessages(t *testing.T, dirs []string) map[string]bool {
+	t.Helper()
+	messages := map[string]bool{}
+	fset := token.NewFileSet()
+	for _, dir := range dirs {
+		paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var files []*ast.File
+		var mapValues []ast.Expr
+		consts := map[string]string{}
+		for _, path := range paths {
+			if strings.HasSuffix(path, "_test.go") {
+				continue
+			}
+			f, err := parser.ParseFile(fset, path, nil, 0)
+			if err != nil {
+				t.Fatalf("parse %s: %v", path, err)
+			}
+			files = append(files, f)
+			for _, decl := range f.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || (gen.Tok != token.CONST && gen.Tok != token.VAR) {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					vs := spec.(*ast.ValueSpec)
+					for i, name := range vs.Names {
+						if i >= len(vs.Values) {
+							continue
+						}
+						if gen.Tok == token.CONST {
+							if s, ok := stringConst(vs.Values[i]); ok {
+								consts[name.Name] = s
+							}
+						} else if lit, ok := vs.Values[i].(*ast.CompositeLit); ok && translatedMaps[name.Name] {
+							for _, elt := range lit.Elts {
+								if kv, ok := elt.(*ast.KeyValueExpr); ok {
+									mapValues = append(mapValues, kv.Value)
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+		// resolve adds the string expr stands for, if any, to messages.
+		resolve := func(expr ast.Expr, plural bool) {
+			s, ok := stringConst(expr)
+			if ident, isIdent := expr.(*ast.Ident); isIdent {
+				s, ok = consts[ident.Name]
+			}
+			if ok && s != "" {
+				messages[s] = messages[s] || plural
+			}
+		}
+		for _, v := range mapValues {
+			resolve(v, false)
+		}
+		for _, f := range files {
+			ast.Inspect(f, func(n ast.Node) bool {
+				var lit ast.Expr
+				plural := false
+				switch n := n.(type) {
+				case *ast.CallExpr:
+					arg := translatedArg(n.Fun)
+					if arg < 0 || arg >= len(n.Args) {
+						return true
+					}
+					lit, plural = n.Args[arg], counted(n.Fun)
+				case *ast.KeyValueExpr:
+					if key, ok := n.Key.(*ast.Ident); !ok || key.Name != "Section" {
+						return true
+					}
+					lit = n.Value
+				default:
+					return true
+				}
+				resolve(lit, plural)
+				return true
+			})
+		}
+	}
+	return messages
+}
+
+// untranslatedKeys are the key names the views print as they are, as
+// hint(key, action) does.
+var untranslatedKeys = map[string]bool{
+	"e": true, "q": true, "t": true, "Y": true, "esc": true, "Esc": true, "Enter": true, "Tab": true,
+	"j/k": true, "ctrl+s": true, "Ctrl+d/u": true, "←/→/Space": true,
+}
+
+// untranslatedLiterals returns the string literals in dir's views that are
+// passed straight to Render or WriteString without going through T: text
+// with letters in it that is neither a key name nor markup.
+func untranslatedLiterals(t *testing.T, dir string) []string {
+	t.Helper()
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var found []string
+	fset := token.NewFileSet()
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			t.Fatalf("parse %s: %v", path, err)
+		}
+		ast.Inspect(f, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			if sel, ok := call.Fun.(*ast.SelectorExpr); !ok || (sel.Sel.Name != "Render" && sel.Sel.Name != "WriteString") {
+				return true
+			}
+			for _, arg := range call.Args {
+				lit, ok := arg.(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					continue
+				}
+				s, err := strconv.Unquote(lit.Value)
+				if err != nil || untranslatedKeys[s] || strings.HasPrefix(s, "\x1b") || !strings.ContainsFunc(s, unicode.IsLetter) {
+					continue
+				}
+				found = append(found, fmt.Sprintf("%s: %q", fset.Position(lit.Pos()), s))
+			}
+			return true
+		})
+	}
+	return found
+}
+
+// TestCatalogsCoverSource keeps every catalog in step with the code: each
+// string passed to T or Tf needs a translation, and each translation a
+// string that is still used. Text the views render must go through T.
+func TestCatalogsCoverSource(t *testing.T) {
+	messages := sourceMessages(t, sourceDirs)
+	if len(messages) == 0 {
+		t.Fatal("found no translatable strings")
+	}
+	if found := untranslatedLiterals(t, "../ui"); len(found) > 0 {
+		t.Errorf("%d strings are rendered without T:\n%s", len(found), strings.Join(found, "\n"))
+	}
+
+	for _, lang := range Available()[1:] {
+		data, err := locales.ReadFile("locales/" + lang + ".json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		catalog := map[string]string{}
+		if err := json.Unmarshal(data, &catalog); err != nil {
+			t.Fatalf("%s: %v", lang, err)
+		}
+
+		var missing, stale []string
+		for msg := range messages {
+			if catalog[msg] == "" {
+				missing = append(missing, strconv.Quote(msg))
+			}
+		}
+		for msg, translated := range catalog {
+			plural, used := messages[msg]
+			if !used {
+				stale = append(stale, strconv.Quote(msg))
+			}
+			// Only counted messages hold plural forms; the "|" in the rest,
+			// such as the tutorial's tables, is text.
+			verbs, forms := strings.Count(msg, "%"), []string{translated}
+			if plural {
+				verbs = strings.Count(strings.Split(msg, "|")[0], "%")
+				forms = strings.Split(translated, "|")
+			}
+			for _, form := range forms {
+				if strings.Count(form, "%") != verbs {
+					t.Errorf("%s: %q and its translation %q have different verbs", lang, msg, translated)
+					break
+				}
+			}
+		}
+		sort.Strings(missing)
+		sort.Strings(stale)
+		if len(missing) > 0 {
+			t.Errorf("%s is missing %d strings:\n%s", lang, len(missing), strings.Join(missing, "\n"))
+		}
+		if len(stale) > 0 {
+			t.Errorf("%s has %d strings the code no longer uses:\n%s", lang, len(stale), strings.Join(stale, "\n"))
+		}
+	}
+}
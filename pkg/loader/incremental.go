@@ -0,0 +1,326 @@
+package loader
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// IncrementalLoader keeps a beads JSONL file parsed in memory and, on
+// Refresh, parses only the records appended since the previous read. If the
+// bytes already consumed have changed (the file was rewritten or truncated)
+// it falls back to a full reparse. A SQLite database has no appended
+// records to follow, so every Refresh of one reads it whole.
+//
+// Unlike ParseIssues, a record whose ID was seen earlier replaces the
+// earlier one, so append-only update logs resolve to the latest state.
+// An IncrementalLoader is not safe for concurrent use.
+type IncrementalLoader struct {
+	path string
+	opts ParseOptions
+
+	offset    int64  // Bytes consumed; always ends on a record boundary
+	prefixCRC uint32 // Checksum of the consumed bytes
+	lineNum   int
+
+	issues []model.Issue
+	byID   map[string]int
+	index  *IssueIndex
+}
+
+// IncrementalUpdate describes what a Refresh changed.
+type IncrementalUpdate struct {
+	// Reloaded is set when the whole file was reparsed.
+	Reloaded bool
+	// Added and Updated list issue IDs in file order.
+	Added   []string
+	Updated []string
+	// Warnings collects skipped-record messages. They are also passed to
+	// opts.WarningHandler when one is set.
+	Warnings []string
+}
+
+// Changed reports whether the update added or replaced any issue.
+func (u IncrementalUpdate) Changed() bool {
+	return u.Reloaded || len(u.Added) > 0 || len(u.Updated) > 0
+}
+
+// NewIncrementalLoader returns a loader for path. Nothing is read until the
+// first Refresh.
+func NewIncrementalLoader(path string, opts ParseOptions) *IncrementalLoader {
+	l := &IncrementalLoader{path: path, opts: opts}
+	l.reset()
+	return l
+}
+
+func (l *IncrementalLoader) reset() {
+	l.offset = 0
+	l.prefixCRC = 0
+	l.lineNum = 0
+	l.issues = nil
+	l.byID = make(map[string]int)
+	l.index = NewIssueIndex(nil)
+}
+
+// Refresh brings the in-memory issues up to date with the file.
+func (l *IncrementalLoader) Refresh() (IncrementalUpdate, error) {
+	if IsSQLitePath(l.path) {
+		return l.reloadSQLite()
+	}
+
+	file, err := os.Open(l.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return IncrementalUpdate{}, fmt.Errorf("no beads issues found at %s", l.path)
+		}
+		return IncrementalUpdate{}, fmt.Errorf("failed to open issues file: %w", err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return IncrementalUpdate{}, fmt.Errorf("failed to stat issues file: %w", err)
+	}
+
+	reloaded := true
+	if l.offset > 0 && info.Size() >= l.offset {
+		crc := crc32.New(crcTable)
+		if _, err := io.CopyN(crc, file, l.offset); err == nil && crc.Sum32() == l.prefixCRC {
+			reloaded = false
+		}
+	}
+	if reloaded {
+		l.reset()
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return IncrementalUpdate{}, fmt.Errorf("failed to rewind issues file: %w", err)
+		}
+	}
+
+	update := IncrementalUpdate{Reloaded: reloaded}
+	if err := l.readRecords(file, &update); err != nil {
+		return IncrementalUpdate{}, err
+	}
+	return update, nil
+}
+
+func (l *IncrementalLoader) reloadSQLite() (IncrementalUpdate, error) {
+	update := IncrementalUpdate{Reloaded: true}
+	opts := l.opts
+	opts.WarningHandler = func(msg string) {
+		update.Warnings = append(update.Warnings, msg)
+		if l.opts.WarningHandler != nil {
+			l.opts.WarningHandler(msg)
+		}
+	}
+	issues, err := LoadIssuesFromSQLiteWithOptions(l.path, opts)
+	if err != nil {
+		return IncrementalUpdate{}, err
+	}
+	l.reset()
+	for _, issue := range issues {
+		l.apply(issue, &update)
+	}
+	return update, nil
+}
+
+// readRecords parses records from r, which is positioned at l.offset. A final
+// line without a newline is only consumed if it decodes: it may be a record
+// the writer has not finished appending.
+func (l *IncrementalLoader) readRecords(r io.Reader, update *IncrementalUpdate) error {
+	maxCapacity := l.opts.BufferSize
+	if maxCapacity <= 0 {
+		maxCapacity = DefaultMaxBufferSize
+	}
+	warn := func(msg string) {
+		update.Warnings = append(update.Warnings, msg)
+		if l.opts.WarningHandler != nil {
+			l.opts.WarningHandler(msg)
+		}
+	}
+
+	reader := bufio.NewReader(r)
+	for {
+		raw, err := reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return fmt.Errorf("error reading issues stream at line %d: %w", l.lineNum+1, err)
+		}
+		if len(raw) == 0 {
+			return nil
+		}
+		complete := raw[len(raw)-1] == '\n'
+
+		line := bytes.TrimRight(raw, "\r\n")
+		if l.offset == 0 {
+			line = stripBOM(line)
+		}
+
+		var issue model.Issue
+		switch {
+		case len(line) == 0:
+		case len(line) > maxCapacity:
+			warn(fmt.Sprintf("skipping line %d: line too long (exceeds %d bytes)", l.lineNum+1, maxCapacity))
+		case !complete:
+			// Decode quietly; a partial record is retried on the next Refresh.
+			if !decodeIssueLine(line, l.lineNum+1, &issue, func(string) {}) {
+				return nil
+			}
+			l.apply(issue, update)
+		default:
+			if decodeIssueLine(line, l.lineNum+1, &issue, warn) {
+				l.apply(issue, update)
+			}
+		}
+
+		l.lineNum++
+		l.offset += int64(len(raw))
+		l.prefixCRC = crc32.Update(l.prefixCRC, crcTable, raw)
+		if !complete {
+			return nil
+		}
+	}
+}
+
+func (l *IncrementalLoader) apply(issue model.Issue, update *IncrementalUpdate) {
+	if l.opts.IssueFilter != nil && !l.opts.IssueFilter(&issue) {
+		return
+	}
+	if i, ok := l.byID[issue.ID]; ok {
+		l.index.remove(&l.issues[i])
+		l.issues[i] = issue
+		l.index.add(&l.issues[i])
+		update.Updated = append(update.Updated, issue.ID)
+		return
+	}
+	l.byID[issue.ID] = len(l.issues)
+	l.issues = append(l.issues, issue)
+	l.index.add(&issue)
+	update.Added = append(update.Added, issue.ID)
+}
+
+// Issues returns a copy of the current issues in file order.
+func (l *IncrementalLoader) Issues() []model.Issue {
+	out := make([]model.Issue, len(l.issues))
+	copy(out, l.issues)
+	return out
+}
+
+// Issue returns the current version of the issue with the given ID.
+func (l *IncrementalLoader) Issue(id string) (model.Issue, bool) {
+	i, ok := l.byID[id]
+	if !ok {
+		return model.Issue{}, false
+	}
+	return l.issues[i], true
+}
+
+// Index returns the secondary indexes over the current issues. It is kept up
+// to date by Refresh and must not be modified.
+func (l *IncrementalLoader) Index() *IssueIndex {
+	return l.index
+}
+
+// IssueIndex maps issue attributes to the IDs of the issues that have them.
+type IssueIndex struct {
+	byStatus   map[model.Status]idSet
+	byLabel    map[string]idSet
+	byAssignee map[string]idSet
+	dependents map[string]idSet // depends_on_id -> issues that depend on it
+}
+
+type idSet map[string]struct{}
+
+func (s idSet) sorted() []string {
+	ids := make([]string, 0, len(s))
+	for id := range s {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// NewIssueIndex indexes issues.
+func NewIssueIndex(issues []model.Issue) *IssueIndex {
+	x := &IssueIndex{
+		byStatus:   make(map[model.Status]idSet),
+		byLabel:    make(map[string]idSet),
+		byAssignee: make(map[string]idSet),
+		dependents: make(map[string]idSet),
+	}
+	for i := range issues {
+		x.add(&issues[i])
+	}
+	return x
+}
+
+func addID[K comparable](m map[K]idSet, key K, id string) {
+	set, ok := m[key]
+	if !ok {
+		set = make(idSet)
+		m[key] = set
+	}
+	set[id] = struct{}{}
+}
+
+func removeID[K comparable](m map[K]idSet, key K, id string) {
+	if set, ok := m[key]; ok {
+		delete(set, id)
+		if len(set) == 0 {
+			delete(m, key)
+		}
+	}
+}
+
+func (x *IssueIndex) add(issue *model.Issue) {
+	addID(x.byStatus, issue.Status, issue.ID)
+	addID(x.byAssignee, issue.Assignee, issue.ID)
+	for _, label := range issue.Labels {
+		addID(x.byLabel, label, issue.ID)
+	}
+	for _, dep := range issue.Dependencies {
+		if dep != nil {
+			addID(x.dependents, dep.DependsOnID, issue.ID)
+		}
+	}
+}
+
+func (x *IssueIndex) remove(issue *model.Issue) {
+	removeID(x.byStatus, issue.Status, issue.ID)
+	removeID(x.byAssignee, issue.Assignee, issue.ID)
+	for _, label := range issue.Labels {
+		removeID(x.byLabel, label, issue.ID)
+	}
+	for _, dep := range issue.Dependencies {
+		if dep != nil {
+			removeID(x.dependents, dep.DependsOnID, issue.ID)
+		}
+	}
+}
+
+// ByStatus returns the sorted IDs of issues with the given status.
+func (x *IssueIndex) ByStatus(status model.Status) []string {
+	return x.byStatus[status].sorted()
+}
+
+// ByLabel returns the sorted IDs of issues carrying label.
+func (x *IssueIndex) ByLabel(label string) []string {
+	return x.byLabel[label].sorted()
+}
+
+// ByAssignee returns the sorted IDs of issues assigned to assignee; ""
+// selects unassigned issues.
+func (x *IssueIndex) ByAssignee(assignee string) []string {
+	return x.byAssignee[assignee].sorted()
+}
+
+// Dependents returns the sorted IDs of issues with any dependency on id.
+func (x *IssueIndex) Dependents(id string) []string {
+	return x.dependents[id].sorted()
+}
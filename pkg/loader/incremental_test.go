@@ -0,0 +1,173 @@
+package loader_test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+const (
+	incA = `{"id":"A","title":"First","status":"open","issue_type":"task","assignee":"sam","labels":["api"]}` + "\n"
+	incB = `{"id":"B","title":"Second","status":"open","issue_type":"bug","dependencies":[{"issue_id":"B","depends_on_id":"A","type":"blocks"}]}` + "\n"
+	incC = `{"id":"C","title":"Third","status":"closed","issue_type":"task","labels":["api","ui"]}` + "\n"
+)
+
+func appendFile(t *testing.T, path, data string) {
+	t.Helper()
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIncrementalLoader_AppliesAppendedRecords(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "issues.jsonl")
+	appendFile(t, path, incA+incB)
+
+	l := loader.NewIncrementalLoader(path, loader.ParseOptions{})
+	update, err := l.Refresh()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !update.Reloaded || !reflect.DeepEqual(update.Added, []string{"A", "B"}) {
+		t.Fatalf("unexpected initial update: %+v", update)
+	}
+
+	// A newer version of A plus a new issue.
+	appendFile(t, path, `{"id":"A","title":"First","status":"closed","issue_type":"task","assignee":"kim","labels":["api"]}`+"\n"+incC)
+	update, err = l.Refresh()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if update.Reloaded {
+		t.Fatal("expected an incremental update")
+	}
+	if !reflect.DeepEqual(update.Updated, []string{"A"}) || !reflect.DeepEqual(update.Added, []string{"C"}) {
+		t.Fatalf("unexpected update: %+v", update)
+	}
+	if got := len(l.Issues()); got != 3 {
+		t.Fatalf("expected 3 issues, got %d", got)
+	}
+	if a, _ := l.Issue("A"); a.Status != model.StatusClosed {
+		t.Fatalf("expected A closed, got %s", a.Status)
+	}
+
+	idx := l.Index()
+	if got := idx.ByStatus(model.StatusClosed); !reflect.DeepEqual(got, []string{"A", "C"}) {
+		t.Errorf("ByStatus(closed) = %v", got)
+	}
+	if got := idx.ByStatus(model.StatusOpen); !reflect.DeepEqual(got, []string{"B"}) {
+		t.Errorf("ByStatus(open) = %v", got)
+	}
+	if got := idx.ByAssignee("sam"); len(got) != 0 {
+		t.Errorf("stale assignee entry: %v", got)
+	}
+	if got := idx.ByAssignee("kim"); !reflect.DeepEqual(got, []string{"A"}) {
+		t.Errorf("ByAssignee(kim) = %v", got)
+	}
+	if got := idx.ByLabel("api"); !reflect.DeepEqual(got, []string{"A", "C"}) {
+		t.Errorf("ByLabel(api) = %v", got)
+	}
+	if got := idx.Dependents("A"); !reflect.DeepEqual(got, []string{"B"}) {
+		t.Errorf("Dependents(A) = %v", got)
+	}
+}
+
+func TestIncrementalLoader_WaitsForPartialRecord(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "issues.jsonl")
+	appendFile(t, path, incA)
+
+	l := loader.NewIncrementalLoader(path, loader.ParseOptions{})
+	if _, err := l.Refresh(); err != nil {
+		t.Fatal(err)
+	}
+
+	appendFile(t, path, incB[:20])
+	update, err := l.Refresh()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if update.Changed() || len(update.Warnings) != 0 {
+		t.Fatalf("expected a half-written record to be left for later, got %+v", update)
+	}
+
+	appendFile(t, path, incB[20:])
+	update, err = l.Refresh()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if update.Reloaded || !reflect.DeepEqual(update.Added, []string{"B"}) {
+		t.Fatalf("expected B once complete, got %+v", update)
+	}
+}
+
+func TestIncrementalLoader_ReloadsRewrittenFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "issues.jsonl")
+	appendFile(t, path, incA+incB)
+
+	l := loader.NewIncrementalLoader(path, loader.ParseOptions{})
+	if _, err := l.Refresh(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Same length or longer, but the existing bytes changed.
+	if err := os.WriteFile(path, []byte(incC+incB), 0644); err != nil {
+		t.Fatal(err)
+	}
+	update, err := l.Refresh()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !update.Reloaded {
+		t.Fatal("expected a full reload after a rewrite")
+	}
+	if _, ok := l.Issue("A"); ok {
+		t.Fatal("expected A to be gone after the rewrite")
+	}
+	if got := l.Index().ByLabel("api"); !reflect.DeepEqual(got, []string{"C"}) {
+		t.Fatalf("expected index rebuilt, got %v", got)
+	}
+
+	// Truncation also forces a reload.
+	if err := os.WriteFile(path, []byte(incB), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if update, err = l.Refresh(); err != nil || !update.Reloaded || len(l.Issues()) != 1 {
+		t.Fatalf("expected reload after truncation, got %+v (err %v)", update, err)
+	}
+}
+
+func TestIncrementalLoader_WarnsOnBadRecords(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "issues.jsonl")
+	appendFile(t, path, "\xEF\xBB\xBF"+incA+"{not json}\n"+`{"id":"X","title":""}`+"\n")
+
+	var handled []string
+	l := loader.NewIncrementalLoader(path, loader.ParseOptions{
+		WarningHandler: func(msg string) { handled = append(handled, msg) },
+	})
+	update, err := l.Refresh()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(update.Added, []string{"A"}) {
+		t.Fatalf("expected only A after BOM strip, got %v", update.Added)
+	}
+	if len(update.Warnings) != 2 || !reflect.DeepEqual(handled, update.Warnings) {
+		t.Fatalf("expected 2 warnings passed to the handler, got %v / %v", update.Warnings, handled)
+	}
+}
+
+func TestIncrementalLoader_MissingFile(t *testing.T) {
+	l := loader.NewIncrementalLoader(filepath.Join(t.TempDir(), "missing.jsonl"), loader.ParseOptions{})
+	if _, err := l.Refresh(); err == nil {
+		t.Fatal("expected error for missing file")
+	}
+}
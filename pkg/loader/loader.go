@@ -130,19 +130,15 @@ func FindJSONLPathWithWarnings(beadsDir string, warnFunc func(msg string)) (stri
 
 // LoadIssues reads issues from the beads directory.
 // Respects BEADS_DIR environment variable, otherwise uses .beads in repoPath.
-// Automatically finds the correct JSONL file (issues.jsonl preferred, beads.jsonl fallback).
+// Automatically finds the correct JSONL file (issues.jsonl preferred, beads.jsonl fallback),
+// or reads bd's SQLite database when there is no JSONL (see SelectBackend).
 func LoadIssues(repoPath string) ([]model.Issue, error) {
 	beadsDir, err := GetBeadsDir(repoPath)
 	if err != nil {
 		return nil, err
 	}
 
-	jsonlPath, err := FindJSONLPath(beadsDir)
-	if err != nil {
-		return nil, err
-	}
-
-	return LoadIssuesFromFile(jsonlPath)
+	return LoadIssuesFromDir(beadsDir)
 }
 
 // DefaultMaxBufferSize is the default buffer size for the scanner (10MB).
@@ -322,20 +318,8 @@ func parseIssuesWithOptions(r io.Reader, opts ParseOptions, usePool bool) ([]mod
 
 		if usePool {
 			issue := GetIssue()
-			if err := json.Unmarshal(line, issue); err != nil {
-				PutIssue(issue)
-				// Skip malformed lines but warn
-				warn(fmt.Sprintf("skipping malformed JSON on line %d: %v", lineNum, err))
-				continue
-			}
-
-			issue.Status = normalizeIssueStatus(issue.Status)
-
-			// Validate issue
-			if err := issue.Validate(); err != nil {
+			if !decodeIssueLine(line, lineNum, issue, warn) {
 				PutIssue(issue)
-				// Skip invalid issues
-				warn(fmt.Sprintf("skipping invalid issue on line %d: %v", lineNum, err))
 				continue
 			}
 
@@ -348,18 +332,7 @@ func parseIssuesWithOptions(r io.Reader, opts ParseOptions, usePool bool) ([]mod
 			poolRefs = append(poolRefs, issue)
 		} else {
 			var issue model.Issue
-			if err := json.Unmarshal(line, &issue); err != nil {
-				// Skip malformed lines but warn
-				warn(fmt.Sprintf("skipping malformed JSON on line %d: %v", lineNum, err))
-				continue
-			}
-
-			issue.Status = normalizeIssueStatus(issue.Status)
-
-			// Validate issue
-			if err := issue.Validate(); err != nil {
-				// Skip invalid issues
-				warn(fmt.Sprintf("skipping invalid issue on line %d: %v", lineNum, err))
+			if !decodeIssueLine(line, lineNum, &issue, warn) {
 				continue
 			}
 
@@ -374,6 +347,26 @@ func parseIssuesWithOptions(r io.Reader, opts ParseOptions, usePool bool) ([]mod
 	return issues, poolRefs, nil
 }
 
+// decodeIssueLine unmarshals and validates one JSONL record into issue.
+// Malformed or invalid records are reported via warn and yield false.
+func decodeIssueLine(line []byte, lineNum int, issue *model.Issue, warn func(string)) bool {
+	if err := json.Unmarshal(line, issue); err != nil {
+		// Skip malformed lines but warn
+		warn(fmt.Sprintf("skipping malformed JSON on line %d: %v", lineNum, err))
+		return false
+	}
+
+	issue.Status = normalizeIssueStatus(issue.Status)
+
+	// Validate issue
+	if err := issue.Validate(); err != nil {
+		// Skip invalid issues
+		warn(fmt.Sprintf("skipping invalid issue on line %d: %v", lineNum, err))
+		return false
+	}
+	return true
+}
+
 // stripBOM removes the UTF-8 Byte Order Mark if present
 func stripBOM(b []byte) []byte {
 	if bytes.HasPrefix(b, []byte{0xEF, 0xBB, 0xBF}) {
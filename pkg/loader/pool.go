@@ -121,6 +121,7 @@ func clearIssueReferences(issue *model.Issue) {
 	issue.Comments = issue.Comments[:0]
 	issue.Labels = issue.Labels[:0]
 
+	issue.StartDate = nil
 	issue.DueDate = nil
 	issue.ClosedAt = nil
 	issue.EstimatedMinutes = nil
@@ -0,0 +1,404 @@
+package loader
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	_ "modernc.org/sqlite"
+)
+
+// BackendEnvVar forces the storage backend read from .beads/: "jsonl" or
+// "sqlite". When unset the backend is chosen by what exists on disk.
+const BackendEnvVar = "BV_BEADS_BACKEND"
+
+// Backend identifies where issues are read from.
+type Backend string
+
+const (
+	BackendJSONL  Backend = "jsonl"
+	BackendSQLite Backend = "sqlite"
+)
+
+// PreferredSQLiteNames lists database names bd uses, in priority order.
+var PreferredSQLiteNames = []string{"beads.db"}
+
+// FindSQLitePath locates the bd SQLite database in beadsDir. It prefers
+// beads.db and otherwise takes the first *.db that is not a backup.
+func FindSQLitePath(beadsDir string) (string, error) {
+	entries, err := os.ReadDir(beadsDir)
+	if err != nil {
+		return "", fmt.Errorf("failed to read beads directory: %w", err)
+	}
+	var candidates []string
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".db") || strings.Contains(name, ".backup") {
+			continue
+		}
+		candidates = append(candidates, name)
+	}
+	if len(candidates) == 0 {
+		return "", fmt.Errorf("no beads SQLite database found in %s", beadsDir)
+	}
+	for _, preferred := range PreferredSQLiteNames {
+		for _, name := range candidates {
+			if name == preferred {
+				return filepath.Join(beadsDir, name), nil
+			}
+		}
+	}
+	sort.Strings(candidates)
+	return filepath.Join(beadsDir, candidates[0]), nil
+}
+
+// IsSQLitePath reports whether path, as returned by SelectBackend, is a
+// SQLite database rather than a JSONL file.
+func IsSQLitePath(path string) bool {
+	return strings.HasSuffix(path, ".db")
+}
+
+// SelectBackend picks the data source in beadsDir and returns its path.
+// The JSONL file is the git-synced source of truth and is preferred; the
+// SQLite database is used when there is no non-empty JSONL, or when
+// BV_BEADS_BACKEND asks for it.
+func SelectBackend(beadsDir string) (Backend, string, error) {
+	switch forced := Backend(strings.ToLower(strings.TrimSpace(os.Getenv(BackendEnvVar)))); forced {
+	case BackendSQLite:
+		path, err := FindSQLitePath(beadsDir)
+		return BackendSQLite, path, err
+	case BackendJSONL:
+		path, err := FindJSONLPath(beadsDir)
+		return BackendJSONL, path, err
+	case "":
+	default:
+		return "", "", fmt.Errorf("invalid %s %q (want jsonl or sqlite)", BackendEnvVar, forced)
+	}
+
+	jsonlPath, jsonlErr := FindJSONLPath(beadsDir)
+	if jsonlErr == nil {
+		if info, err := os.Stat(jsonlPath); err == nil && info.Size() > 0 {
+			return BackendJSONL, jsonlPath, nil
+		}
+	}
+	if dbPath, err := FindSQLitePath(beadsDir); err == nil {
+		return BackendSQLite, dbPath, nil
+	}
+	return BackendJSONL, jsonlPath, jsonlErr
+}
+
+// LoadIssuesFromDir reads issues from a beads directory using the backend
+// chosen by SelectBackend.
+func LoadIssuesFromDir(beadsDir string) ([]model.Issue, error) {
+	backend, path, err := SelectBackend(beadsDir)
+	if err != nil {
+		return nil, err
+	}
+	if backend == BackendSQLite {
+		return LoadIssuesFromSQLite(path)
+	}
+	return LoadIssuesFromFile(path)
+}
+
+// LoadIssuesFromSQLite reads issues directly from a bd SQLite database.
+func LoadIssuesFromSQLite(path string) ([]model.Issue, error) {
+	return LoadIssuesFromSQLiteWithOptions(path, ParseOptions{})
+}
+
+// LoadIssuesFromSQLiteWithOptions reads issues from a bd SQLite database.
+// The database is opened read-only. Columns are matched by name, so schemas
+// from older and newer bd versions load as long as the core columns exist;
+// BufferSize is not used.
+func LoadIssuesFromSQLiteWithOptions(path string, opts ParseOptions) ([]model.Issue, error) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil, fmt.Errorf("no beads issues found at %s", path)
+	}
+
+	// busy_timeout lets reads wait out a concurrent bd write.
+	db, err := sql.Open("sqlite", "file:"+filepath.ToSlash(path)+"?mode=ro&_pragma=busy_timeout(5000)")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open beads database: %w", err)
+	}
+	defer db.Close()
+
+	warn := opts.WarningHandler
+	if warn == nil {
+		if os.Getenv("BV_ROBOT") == "1" {
+			warn = func(string) {}
+		} else {
+			warn = func(msg string) {
+				fmt.Fprintf(os.Stderr, "Warning: %s\n", msg)
+			}
+		}
+	}
+
+	r := sqliteReader{db: db}
+	issues, err := r.readIssues(warn)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		byID[issues[i].ID] = &issues[i]
+	}
+	if err := r.readLabels(byID); err != nil {
+		return nil, err
+	}
+	if err := r.readDependencies(byID); err != nil {
+		return nil, err
+	}
+	if err := r.readComments(byID); err != nil {
+		return nil, err
+	}
+
+	if opts.IssueFilter == nil {
+		return issues, nil
+	}
+	filtered := issues[:0]
+	for i := range issues {
+		if opts.IssueFilter(&issues[i]) {
+			filtered = append(filtered, issues[i])
+		}
+	}
+	return filtered, nil
+}
+
+type sqliteReader struct {
+	db *sql.DB
+}
+
+// columns returns the column names of table, or nil if it does not exist.
+func (r sqliteReader) columns(table string) (map[string]bool, error) {
+	rows, err := r.db.Query("SELECT name FROM pragma_table_info(?)", table)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s schema: %w", table, err)
+	}
+	defer rows.Close()
+	cols := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("reading %s schema: %w", table, err)
+		}
+		cols[name] = true
+	}
+	if len(cols) == 0 {
+		return nil, rows.Err()
+	}
+	return cols, rows.Err()
+}
+
+// query prepares and runs a statement over the given columns of table. Columns
+// missing from the schema are selected as NULL.
+func (r sqliteReader) query(table string, want []string, suffix string) (*sql.Rows, error) {
+	have, err := r.columns(table)
+	if err != nil || have == nil {
+		return nil, err
+	}
+	exprs := make([]string, len(want))
+	for i, col := range want {
+		if have[col] {
+			exprs[i] = col
+		} else {
+			exprs[i] = "NULL"
+		}
+	}
+	stmt, err := r.db.Prepare("SELECT " + strings.Join(exprs, ", ") + " FROM " + table + " " + suffix)
+	if err != nil {
+		return nil, fmt.Errorf("preparing %s query: %w", table, err)
+	}
+	defer stmt.Close()
+	rows, err := stmt.Query()
+	if err != nil {
+		return nil, fmt.Errorf("querying %s: %w", table, err)
+	}
+	return rows, nil
+}
+
+var issueColumns = []string{
+	"id", "title", "description", "design", "acceptance_criteria", "notes",
+	"status", "priority", "issue_type", "assignee", "estimated_minutes",
+	"created_at", "updated_at", "closed_at", "external_ref", "source_repo",
+}
+
+func (r sqliteReader) readIssues(warn func(string)) ([]model.Issue, error) {
+	rows, err := r.query("issues", issueColumns, "ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	if rows == nil {
+		return nil, fmt.Errorf("beads database has no issues table")
+	}
+	defer rows.Close()
+
+	var issues []model.Issue
+	for rows.Next() {
+		var (
+			id, title, description, design, acceptance, notes sql.NullString
+			status, issueType, assignee, externalRef, repo    sql.NullString
+			priority, estimate                                sql.NullInt64
+			createdAt, updatedAt, closedAt                    any
+		)
+		if err := rows.Scan(&id, &title, &description, &design, &acceptance, &notes,
+			&status, &priority, &issueType, &assignee, &estimate,
+			&createdAt, &updatedAt, &closedAt, &externalRef, &repo); err != nil {
+			return nil, fmt.Errorf("reading issues: %w", err)
+		}
+
+		issue := model.Issue{
+			ID:                 id.String,
+			Title:              title.String,
+			Description:        description.String,
+			Design:             design.String,
+			AcceptanceCriteria: acceptance.String,
+			Notes:              notes.String,
+			Status:             normalizeIssueStatus(model.Status(status.String)),
+			Priority:           int(priority.Int64),
+			IssueType:          model.IssueType(issueType.String),
+			Assignee:           assignee.String,
+			CreatedAt:          sqliteTime(createdAt),
+			UpdatedAt:          sqliteTime(updatedAt),
+		}
+		if repo.String != "." {
+			issue.SourceRepo = repo.String
+		}
+		if estimate.Valid {
+			v := int(estimate.Int64)
+			issue.EstimatedMinutes = &v
+		}
+		if t := sqliteTime(closedAt); !t.IsZero() {
+			issue.ClosedAt = &t
+		}
+		if externalRef.Valid && externalRef.String != "" {
+			ref := externalRef.String
+			issue.ExternalRef = &ref
+		}
+
+		if err := issue.Validate(); err != nil {
+			warn(fmt.Sprintf("skipping invalid issue %s: %v", issue.ID, err))
+			continue
+		}
+		issues = append(issues, issue)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("reading issues: %w", err)
+	}
+	return issues, nil
+}
+
+func (r sqliteReader) readLabels(byID map[string]*model.Issue) error {
+	rows, err := r.query("labels", []string{"issue_id", "label"}, "ORDER BY issue_id, label")
+	if err != nil || rows == nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var issueID, label string
+		if err := rows.Scan(&issueID, &label); err != nil {
+			return fmt.Errorf("reading labels: %w", err)
+		}
+		if issue, ok := byID[issueID]; ok {
+			issue.Labels = append(issue.Labels, label)
+		}
+	}
+	return rows.Err()
+}
+
+func (r sqliteReader) readDependencies(byID map[string]*model.Issue) error {
+	rows, err := r.query("dependencies", []string{"issue_id", "depends_on_id", "type", "created_at", "created_by"}, "ORDER BY issue_id, depends_on_id")
+	if err != nil || rows == nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var issueID, dependsOn string
+		var depType, createdBy sql.NullString
+		var createdAt any
+		if err := rows.Scan(&issueID, &dependsOn, &depType, &createdAt, &createdBy); err != nil {
+			return fmt.Errorf("reading dependencies: %w", err)
+		}
+		issue, ok := byID[issueID]
+		if !ok {
+			continue
+		}
+		issue.Dependencies = append(issue.Dependencies, &model.Dependency{
+			IssueID:     issueID,
+			DependsOnID: dependsOn,
+			Type:        model.DependencyType(depType.String),
+			CreatedAt:   sqliteTime(createdAt),
+			CreatedBy:   createdBy.String,
+		})
+	}
+	return rows.Err()
+}
+
+func (r sqliteReader) readComments(byID map[string]*model.Issue) error {
+	rows, err := r.query("comments", []string{"id", "issue_id", "author", "text", "created_at"}, "ORDER BY id")
+	if err != nil || rows == nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id sql.NullInt64
+		var issueID string
+		var author, text sql.NullString
+		var createdAt any
+		if err := rows.Scan(&id, &issueID, &author, &text, &createdAt); err != nil {
+			return fmt.Errorf("reading comments: %w", err)
+		}
+		issue, ok := byID[issueID]
+		if !ok {
+			continue
+		}
+		issue.Comments = append(issue.Comments, &model.Comment{
+			ID:        id.Int64,
+			IssueID:   issueID,
+			Author:    author.String,
+			Text:      text.String,
+			CreatedAt: sqliteTime(createdAt),
+		})
+	}
+	return rows.Err()
+}
+
+// sqliteTimeLayouts are the text encodings Go SQLite drivers use for
+// DATETIME columns.
+var sqliteTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999 -0700 MST", // time.Time.String()
+	"2006-01-02",
+}
+
+// sqliteTime converts a scanned DATETIME value; unparseable values are zero.
+func sqliteTime(v any) time.Time {
+	switch t := v.(type) {
+	case time.Time:
+		return t
+	case int64:
+		return time.Unix(t, 0).UTC()
+	case []byte:
+		return sqliteTime(string(t))
+	case string:
+		s := strings.TrimSpace(t)
+		for _, layout := range sqliteTimeLayouts {
+			if parsed, err := time.Parse(layout, s); err == nil {
+				return parsed
+			}
+		}
+		if secs, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return time.Unix(secs, 0).UTC()
+		}
+	}
+	return time.Time{}
+}
@@ -0,0 +1,192 @@
+package loader_test
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	_ "modernc.org/sqlite"
+)
+
+// bdSchema mirrors the tables bd creates in .beads/beads.db.
+const bdSchema = `
+CREATE TABLE issues (
+	id TEXT PRIMARY KEY,
+	content_hash TEXT,
+	title TEXT NOT NULL,
+	description TEXT NOT NULL DEFAULT '',
+	design TEXT NOT NULL DEFAULT '',
+	acceptance_criteria TEXT NOT NULL DEFAULT '',
+	notes TEXT NOT NULL DEFAULT '',
+	status TEXT NOT NULL DEFAULT 'open',
+	priority INTEGER NOT NULL DEFAULT 2,
+	issue_type TEXT NOT NULL DEFAULT 'task',
+	assignee TEXT,
+	estimated_minutes INTEGER,
+	created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	closed_at DATETIME,
+	external_ref TEXT,
+	source_repo TEXT DEFAULT '.'
+);
+CREATE TABLE dependencies (
+	issue_id TEXT NOT NULL,
+	depends_on_id TEXT NOT NULL,
+	type TEXT NOT NULL DEFAULT 'blocks',
+	created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	created_by TEXT NOT NULL DEFAULT '',
+	PRIMARY KEY (issue_id, depends_on_id)
+);
+CREATE TABLE labels (issue_id TEXT NOT NULL, label TEXT NOT NULL, PRIMARY KEY (issue_id, label));
+CREATE TABLE comments (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	issue_id TEXT NOT NULL,
+	author TEXT NOT NULL,
+	text TEXT NOT NULL,
+	created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);`
+
+func createBeadsDB(t *testing.T, path, schema string, stmts ...string) {
+	t.Helper()
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	for _, s := range append([]string{schema}, stmts...) {
+		if _, err := db.Exec(s); err != nil {
+			t.Fatalf("%s: %v", s, err)
+		}
+	}
+}
+
+func TestLoadIssuesFromSQLite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "beads.db")
+	createBeadsDB(t, path, bdSchema,
+		`INSERT INTO issues (id, title, description, status, priority, issue_type, assignee, estimated_minutes, created_at, updated_at, closed_at, external_ref)
+		 VALUES ('bv-1', 'Root', 'desc', 'open', 1, 'feature', 'sam', 90, '2025-01-02 10:00:00', '2025-01-03T11:00:00Z', NULL, 'https://example.com/1')`,
+		`INSERT INTO issues (id, title, status, priority, issue_type, created_at, updated_at, closed_at)
+		 VALUES ('bv-2', 'Child', 'CLOSED', 2, 'task', '2025-01-04 09:00:00.5+00:00', '2025-01-05 09:00:00+00:00', '2025-01-05 09:00:00+00:00')`,
+		`INSERT INTO issues (id, title, status, issue_type) VALUES ('bv-3', '', 'open', 'task')`,
+		`INSERT INTO labels VALUES ('bv-1', 'ui'), ('bv-1', 'api'), ('ghost', 'x')`,
+		`INSERT INTO dependencies (issue_id, depends_on_id, type, created_by) VALUES ('bv-2', 'bv-1', 'parent-child', 'sam')`,
+		`INSERT INTO comments (issue_id, author, text) VALUES ('bv-1', 'kim', 'first'), ('bv-1', 'sam', 'second')`,
+	)
+
+	var warnings []string
+	issues, err := loader.LoadIssuesFromSQLiteWithOptions(path, loader.ParseOptions{
+		WarningHandler: func(msg string) { warnings = append(warnings, msg) },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 2 || len(warnings) != 1 {
+		t.Fatalf("expected 2 issues and 1 warning for the untitled row, got %d / %v", len(issues), warnings)
+	}
+
+	root, child := issues[0], issues[1]
+	if root.ID != "bv-1" || root.Assignee != "sam" || root.EstimatedMinutes == nil || *root.EstimatedMinutes != 90 {
+		t.Errorf("unexpected root: %+v", root)
+	}
+	if root.ExternalRef == nil || *root.ExternalRef != "https://example.com/1" {
+		t.Errorf("expected external ref, got %v", root.ExternalRef)
+	}
+	if want := time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC); !root.CreatedAt.Equal(want) {
+		t.Errorf("created_at = %v, want %v", root.CreatedAt, want)
+	}
+	if len(root.Labels) != 2 || root.Labels[0] != "api" {
+		t.Errorf("labels = %v", root.Labels)
+	}
+	if len(root.Comments) != 2 || root.Comments[0].Text != "first" || root.Comments[1].Author != "sam" {
+		t.Errorf("comments = %+v", root.Comments)
+	}
+	if child.Status != model.StatusClosed || child.ClosedAt == nil {
+		t.Errorf("expected normalized closed status with closed_at, got %s %v", child.Status, child.ClosedAt)
+	}
+	if len(child.Dependencies) != 1 || child.Dependencies[0].DependsOnID != "bv-1" || child.Dependencies[0].Type != model.DepParentChild {
+		t.Errorf("dependencies = %+v", child.Dependencies)
+	}
+}
+
+func TestLoadIssuesFromSQLite_OlderSchema(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "beads.db")
+	createBeadsDB(t, path,
+		`CREATE TABLE issues (id TEXT PRIMARY KEY, title TEXT, status TEXT, priority INTEGER, issue_type TEXT, created_at DATETIME)`,
+		`INSERT INTO issues VALUES ('old-1', 'Legacy', 'open', 0, 'bug', '2024-06-01 00:00:00')`,
+	)
+
+	issues, err := loader.LoadIssuesFromSQLite(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 1 || issues[0].Title != "Legacy" || len(issues[0].Labels) != 0 {
+		t.Fatalf("unexpected issues: %+v", issues)
+	}
+}
+
+func TestIncrementalLoader_RereadsSQLite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "beads.db")
+	createBeadsDB(t, path,
+		`CREATE TABLE issues (id TEXT PRIMARY KEY, title TEXT, status TEXT, issue_type TEXT)`,
+		`INSERT INTO issues VALUES ('db-1', 'First', 'open', 'task')`,
+	)
+
+	l := loader.NewIncrementalLoader(path, loader.ParseOptions{})
+	if _, err := l.Refresh(); err != nil {
+		t.Fatal(err)
+	}
+	createBeadsDB(t, path, `UPDATE issues SET status = 'closed' WHERE id = 'db-1'`,
+		`INSERT INTO issues VALUES ('db-2', 'Second', 'open', 'task')`)
+
+	update, err := l.Refresh()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !update.Reloaded || len(update.Added) != 2 {
+		t.Errorf("expected a full reload of both issues, got %+v", update)
+	}
+	issues := l.Issues()
+	if len(issues) != 2 || issues[0].Status != model.StatusClosed {
+		t.Errorf("unexpected issues after refresh: %+v", issues)
+	}
+}
+
+func TestSelectBackend(t *testing.T) {
+	dir := t.TempDir()
+	createBeadsDB(t, filepath.Join(dir, "beads.db"), bdSchema,
+		`INSERT INTO issues (id, title) VALUES ('db-1', 'From database')`)
+
+	// Only a database: read it directly.
+	backend, path, err := loader.SelectBackend(dir)
+	if err != nil || backend != loader.BackendSQLite || filepath.Base(path) != "beads.db" {
+		t.Fatalf("got %s %s %v, want sqlite", backend, path, err)
+	}
+	issues, err := loader.LoadIssuesFromDir(dir)
+	if err != nil || len(issues) != 1 || issues[0].ID != "db-1" {
+		t.Fatalf("LoadIssuesFromDir = %v, %v", issues, err)
+	}
+
+	// A non-empty JSONL takes precedence.
+	jsonl := `{"id":"j-1","title":"From JSONL","status":"open","issue_type":"task"}` + "\n"
+	if err := os.WriteFile(filepath.Join(dir, "issues.jsonl"), []byte(jsonl), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if backend, _, _ := loader.SelectBackend(dir); backend != loader.BackendJSONL {
+		t.Fatalf("expected jsonl preferred, got %s", backend)
+	}
+
+	// The environment can force either backend.
+	t.Setenv(loader.BackendEnvVar, "sqlite")
+	if backend, _, _ := loader.SelectBackend(dir); backend != loader.BackendSQLite {
+		t.Fatalf("expected forced sqlite, got %s", backend)
+	}
+	t.Setenv(loader.BackendEnvVar, "postgres")
+	if _, _, err := loader.SelectBackend(dir); err == nil {
+		t.Fatal("expected error for unknown backend")
+	}
+}
@@ -2,6 +2,9 @@ package model
 
 import (
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
 	"time"
 )
 
@@ -21,6 +24,7 @@ type Issue struct {
 	EstimatedMinutes   *int          `json:"estimated_minutes,omitempty"`
 	CreatedAt          time.Time     `json:"created_at"`
 	UpdatedAt          time.Time     `json:"updated_at"`
+	StartDate          *time.Time    `json:"start_date,omitempty"`
 	DueDate            *time.Time    `json:"due_date,omitempty"`
 	ClosedAt           *time.Time    `json:"closed_at,omitempty"`
 	ExternalRef        *string       `json:"external_ref,omitempty"`
@@ -46,6 +50,10 @@ func (i Issue) Clone() Issue {
 		v := *i.ClosedAt
 		clone.ClosedAt = &v
 	}
+	if i.StartDate != nil {
+		v := *i.StartDate
+		clone.StartDate = &v
+	}
 	if i.DueDate != nil {
 		v := *i.DueDate
 		clone.DueDate = &v
@@ -234,16 +242,53 @@ type Comment struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// Vote comments. A comment whose whole text is VoteText casts its author's
+// vote for the issue and one that is UnvoteText withdraws it, so votes live
+// in the issue's comments and need nothing beyond bd comments add.
+const (
+	VoteText   = "+1"
+	UnvoteText = "-1"
+)
+
+// IsVote reports whether the comment casts or withdraws a vote.
+func (c *Comment) IsVote() bool {
+	text := strings.TrimSpace(c.Text)
+	return text == VoteText || text == UnvoteText
+}
+
+// Voters returns the authors whose latest vote comment is a vote, in the
+// order they voted.
+func (i Issue) Voters() []string {
+	var votes []*Comment
+	for _, c := range i.Comments {
+		if c != nil && c.IsVote() {
+			votes = append(votes, c)
+		}
+	}
+	sort.SliceStable(votes, func(a, b int) bool {
+		return votes[a].CreatedAt.Before(votes[b].CreatedAt)
+	})
+	var voters []string
+	for _, c := range votes {
+		voters = slices.DeleteFunc(voters, func(v string) bool { return v == c.Author })
+		if strings.TrimSpace(c.Text) == VoteText {
+			voters = append(voters, c.Author)
+		}
+	}
+	return voters
+}
+
 // Sprint represents a time-boxed period of work
 type Sprint struct {
-	ID             string    `json:"id"`
-	Name           string    `json:"name"`
-	StartDate      time.Time `json:"start_date,omitzero"`
-	EndDate        time.Time `json:"end_date,omitzero"`
-	BeadIDs        []string  `json:"bead_ids,omitempty"`
-	VelocityTarget float64   `json:"velocity_target,omitempty"`
-	CreatedAt      time.Time `json:"created_at,omitzero"`
-	UpdatedAt      time.Time `json:"updated_at,omitzero"`
+	ID              string    `json:"id"`
+	Name            string    `json:"name"`
+	StartDate       time.Time `json:"start_date,omitzero"`
+	EndDate         time.Time `json:"end_date,omitzero"`
+	BeadIDs         []string  `json:"bead_ids,omitempty"`
+	VelocityTarget  float64   `json:"velocity_target,omitempty"`
+	CapacityMinutes int       `json:"capacity_minutes,omitempty"` // Work the sprint can take, like Issue.EstimatedMinutes
+	CreatedAt       time.Time `json:"created_at,omitzero"`
+	UpdatedAt       time.Time `json:"updated_at,omitzero"`
 }
 
 // Validate checks if the sprint data is logically valid
@@ -709,3 +709,27 @@ func TestIssue_Clone_NilFields(t *testing.T) {
 		t.Errorf("Comments should be nil")
 	}
 }
+
+func TestIssue_Voters(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	comment := func(author, text string, minutes int) *Comment {
+		return &Comment{Author: author, Text: text, CreatedAt: base.Add(time.Duration(minutes) * time.Minute)}
+	}
+	issue := Issue{Comments: []*Comment{
+		comment("kim", "+1", 5),
+		comment("ana", " +1\n", 1),
+		nil,
+		comment("lee", "+1 from me too", 2),
+		comment("kim", "-1", 6),
+		comment("ana", "+1", 7),
+		comment("kim", "+1", 8),
+	}}
+
+	got := issue.Voters()
+	if len(got) != 2 || got[0] != "ana" || got[1] != "kim" {
+		t.Errorf("Voters() = %v, want [ana kim]", got)
+	}
+	if issue.Comments[3].IsVote() {
+		t.Error("expected a comment with more than +1 not to be a vote")
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"path/filepath"
 	"sort"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+
 	"gopkg.in/yaml.v3"
 )
 
@@ -64,9 +66,8 @@ func NewLoader(opts ...LoaderOption) *Loader {
 
 	// Set defaults
 	if l.userPath == "" {
-		home, err := os.UserHomeDir()
-		if err == nil {
-			l.userPath = filepath.Join(home, ".config", "bv", "recipes.yaml")
+		if dir := config.Dir(); dir != "" {
+			l.userPath = filepath.Join(dir, "recipes.yaml")
 		}
 	}
 
@@ -39,6 +39,7 @@ type SortConfig struct {
 	Field     string      `yaml:"field" json:"field"`                             // priority, created, updated, title, id, pagerank, betweenness
 	Direction string      `yaml:"direction,omitempty" json:"direction,omitempty"` // asc, desc (default: asc for priority, desc for dates)
 	Secondary *SortConfig `yaml:"secondary,omitempty" json:"secondary,omitempty"` // Tie-breaker
+	Expr      string      `yaml:"expr,omitempty" json:"expr,omitempty"`           // Score expression (see beadsview.ParseExpr) in place of field; highest first unless direction is asc
 }
 
 // ViewConfig controls display options
@@ -0,0 +1,177 @@
+package testutil
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Render harness
+//
+// A Harness drives a Bubble Tea model synchronously: messages go straight
+// through Update and the commands it returns are dropped, so nothing runs in
+// the background and View depends only on the messages sent. Views are
+// compared as plain text (escape sequences stripped, trailing blanks
+// trimmed), which keeps snapshots independent of the terminal's colors.
+
+// TermSize is a terminal size to render at.
+type TermSize struct {
+	Width, Height int
+}
+
+func (s TermSize) String() string {
+	return fmt.Sprintf("%dx%d", s.Width, s.Height)
+}
+
+// StandardSizes are the terminal sizes views are checked at: a narrow
+// terminal, the classic 80x24, and a wide one that enables split layouts.
+var StandardSizes = []TermSize{{60, 20}, {80, 24}, {120, 40}, {180, 50}}
+
+// Harness renders a model under test.
+type Harness struct {
+	t     *testing.T
+	model tea.Model
+}
+
+// NewHarness wraps m, sending it a tea.WindowSizeMsg for size first.
+func NewHarness(t *testing.T, m tea.Model, size TermSize) *Harness {
+	t.Helper()
+	h := &Harness{t: t, model: m}
+	return h.Resize(size)
+}
+
+// Resize sends a tea.WindowSizeMsg.
+func (h *Harness) Resize(size TermSize) *Harness {
+	h.t.Helper()
+	return h.Send(tea.WindowSizeMsg{Width: size.Width, Height: size.Height})
+}
+
+// Send passes msgs to Update in order.
+func (h *Harness) Send(msgs ...tea.Msg) *Harness {
+	h.t.Helper()
+	for _, msg := range msgs {
+		h.model, _ = h.model.Update(msg)
+	}
+	return h
+}
+
+// Keys sends a key press per name. Names follow tea.KeyMsg.String ("enter",
+// "esc", "tab", "ctrl+c", "up", ...); anything else is typed as runes, so
+// Keys("j", "j", "enter") moves down twice and opens the selection.
+func (h *Harness) Keys(names ...string) *Harness {
+	h.t.Helper()
+	for _, name := range names {
+		h.Send(KeyMsg(name))
+	}
+	return h
+}
+
+// Model returns the model after the messages sent so far.
+func (h *Harness) Model() tea.Model {
+	return h.model
+}
+
+// RawView returns the model's View unchanged.
+func (h *Harness) RawView() string {
+	return h.model.View()
+}
+
+// View returns the model's View as plain text.
+func (h *Harness) View() string {
+	return PlainView(h.model.View())
+}
+
+// AssertContains fails the test unless the plain view contains every want.
+func (h *Harness) AssertContains(want ...string) {
+	h.t.Helper()
+	view := h.View()
+	for _, w := range want {
+		if !strings.Contains(view, w) {
+			h.t.Errorf("view does not contain %q:\n%s", w, view)
+		}
+	}
+}
+
+// AssertGolden compares the plain view with dir/name (see GoldenFile).
+func (h *Harness) AssertGolden(dir, name string) {
+	h.t.Helper()
+	NewGoldenFile(h.t, dir, name).Assert(h.View())
+}
+
+// AssertGoldenSizes renders a fresh model at each size, after the given
+// keys, and compares it with dir/<prefix>_<WxH>.golden.
+func AssertGoldenSizes(t *testing.T, newModel func() tea.Model, sizes []TermSize, keys []string, dir, prefix string) {
+	t.Helper()
+	for _, size := range sizes {
+		t.Run(size.String(), func(t *testing.T) {
+			h := NewHarness(t, newModel(), size).Keys(keys...)
+			h.AssertGolden(dir, prefix+"_"+size.String()+".golden")
+			for i, line := range strings.Split(h.View(), "\n") {
+				if w := ansi.StringWidth(line); w > size.Width {
+					t.Errorf("line %d is %d cells wide, wider than the %d-cell terminal: %q", i+1, w, size.Width, line)
+				}
+			}
+		})
+	}
+}
+
+// GoldenDir returns dir under the repository's testdata/golden, given the
+// test's path from the repository root (for example "../..").
+func GoldenDir(rootRel string, dir ...string) string {
+	return filepath.Join(append([]string{rootRel, "testdata", "golden"}, dir...)...)
+}
+
+// PlainView strips escape sequences and trailing blanks from a rendered
+// view, leaving what a reader would see.
+func PlainView(view string) string {
+	lines := strings.Split(ansi.Strip(view), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n") + "\n"
+}
+
+var keyTypes = map[string]tea.KeyType{
+	"enter":     tea.KeyEnter,
+	"esc":       tea.KeyEsc,
+	"tab":       tea.KeyTab,
+	"shift+tab": tea.KeyShiftTab,
+	"backspace": tea.KeyBackspace,
+	"delete":    tea.KeyDelete,
+	" ":         tea.KeySpace,
+	"space":     tea.KeySpace,
+	"up":        tea.KeyUp,
+	"down":      tea.KeyDown,
+	"left":      tea.KeyLeft,
+	"right":     tea.KeyRight,
+	"home":      tea.KeyHome,
+	"end":       tea.KeyEnd,
+	"pgup":      tea.KeyPgUp,
+	"pgdown":    tea.KeyPgDown,
+	"ctrl+a":    tea.KeyCtrlA,
+	"ctrl+c":    tea.KeyCtrlC,
+	"ctrl+d":    tea.KeyCtrlD,
+	"ctrl+e":    tea.KeyCtrlE,
+	"ctrl+k":    tea.KeyCtrlK,
+	"ctrl+l":    tea.KeyCtrlL,
+	"ctrl+n":    tea.KeyCtrlN,
+	"ctrl+p":    tea.KeyCtrlP,
+	"ctrl+r":    tea.KeyCtrlR,
+	"ctrl+s":    tea.KeyCtrlS,
+	"ctrl+u":    tea.KeyCtrlU,
+	"ctrl+x":    tea.KeyCtrlX,
+	"f1":        tea.KeyF1,
+	"f5":        tea.KeyF5,
+}
+
+// KeyMsg returns the key press for name (see Harness.Keys).
+func KeyMsg(name string) tea.KeyMsg {
+	if kt, ok := keyTypes[name]; ok {
+		return tea.KeyMsg{Type: kt}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(name)}
+}
@@ -0,0 +1,327 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Accessibility mode
+//
+// Screen readers and braille displays read the terminal line by line, so in
+// accessibility mode every view is plain text: no colors, no box drawing, no
+// side-by-side panels. The list shows one issue per line with each field
+// named, the detail view is a sequence of "Field: value" lines, and the last
+// line announces what the previous key press changed.
+
+// EnableAccessible switches the TUI to linear, screen-reader friendly output.
+func (m *Model) EnableAccessible(enabled bool) {
+	m.accessible = enabled
+	if enabled {
+		m.isSplitView = false
+		m.updateViewportContent()
+	}
+}
+
+// accessibleState is what announcements compare before and after a message.
+type accessibleState struct {
+	context  Context
+	selected string
+	shown    int
+	filter   string
+	status   string
+}
+
+func (m Model) accessibleState() accessibleState {
+	s := accessibleState{
+		context: m.CurrentContext(),
+		shown:   len(m.list.VisibleItems()),
+		filter:  m.currentFilter,
+		status:  m.statusMsg,
+	}
+	if item, ok := m.list.SelectedItem().(IssueItem); ok {
+		s.selected = item.Issue.ID
+	}
+	return s
+}
+
+// announce describes the difference between before and the current state.
+// The previous announcement stays up when nothing changed.
+func (m *Model) announce(before accessibleState) {
+	after := m.accessibleState()
+	var parts []string
+	if after.context != before.context {
+		parts = append(parts, after.context.Description()+".")
+	}
+	if after.filter != before.filter || after.shown != before.shown {
+		parts = append(parts, fmt.Sprintf("%d issues shown, filter %s.", after.shown, after.filter))
+	}
+	if after.selected != before.selected {
+		if after.selected == "" {
+			parts = append(parts, "No issue selected.")
+		} else if item, ok := m.list.SelectedItem().(IssueItem); ok {
+			parts = append(parts, fmt.Sprintf("Selected %s, %d of %d: %s.",
+				item.Issue.ID, m.list.Index()+1, after.shown, item.Issue.Title))
+		}
+	}
+	if after.status != before.status && after.status != "" {
+		parts = append(parts, after.status)
+	}
+	if len(parts) > 0 {
+		m.announcement = strings.Join(parts, " ")
+	}
+}
+
+// accessibleUpdate runs update and records an announcement for the change.
+func (m Model) accessibleUpdate(msg tea.Msg) (tea.Model, tea.Cmd) {
+	before := m.accessibleState()
+	next, cmd := m.update(msg)
+	if nm, ok := next.(Model); ok {
+		nm.announce(before)
+		return nm, cmd
+	}
+	return next, cmd
+}
+
+// renderAccessibleList renders the issue list one issue per line, with
+// continuation lines indented, keeping the selected issue in view.
+func (m Model) renderAccessibleList() string {
+	items := m.list.VisibleItems()
+	header := []string{fmt.Sprintf("Issues: %d shown of %d. Filter: %s. Sort: %s.",
+		len(items), len(m.issues), m.currentFilter, sortLabel(m.sorts["list"]))}
+	if m.list.FilterState() != list.Unfiltered {
+		header = append(header, "Search: "+m.list.FilterValue())
+	}
+	if len(items) == 0 {
+		return strings.Join(append(header, "No issues."), "\n")
+	}
+
+	// Long lines wrap rather than truncate, so nothing is cut off for a
+	// braille display; the window is sized in lines, not issues.
+	var lines []string
+	first := 0
+	cursor := m.list.Index()
+	for i, it := range items {
+		item, ok := it.(IssueItem)
+		if !ok {
+			continue
+		}
+		marker := "  "
+		if i == cursor {
+			marker = "> "
+			first = len(lines)
+		}
+		text := marker + m.accessibleIssueLine(item.Issue)
+		if m.width > 4 {
+			text = ansi.Wrap(text, m.width-2, "")
+			text = strings.ReplaceAll(text, "\n", "\n    ")
+			text = ansi.Wrap(text, m.width, "")
+		}
+		lines = append(lines, strings.Split(text, "\n")...)
+	}
+
+	rows := m.height - 1 - len(header)
+	if rows < 1 {
+		rows = 1
+	}
+	start := first - rows/2
+	if start > len(lines)-rows {
+		start = len(lines) - rows
+	}
+	if start < 0 {
+		start = 0
+	}
+	end := start + rows
+	if end > len(lines) {
+		end = len(lines)
+	}
+	return strings.Join(append(header, lines[start:end]...), "\n")
+}
+
+func (m Model) accessibleIssueLine(issue model.Issue) string {
+	assignee := issue.Assignee
+	if assignee == "" {
+		assignee = "none"
+	}
+	return fmt.Sprintf("%s | Title: %s | Status: %s | Priority: P%d | Type: %s | Assignee: %s | Updated: %s",
+		issue.ID, issue.Title, issue.Status, issue.Priority, issue.IssueType, assignee,
+		FormatTimeRel(issue.UpdatedAt, clockNow(m.now)))
+}
+
+// accessibleDetail renders an issue as "Field: value" lines for the detail
+// viewport.
+func (m Model) accessibleDetail(item IssueItem) string {
+	issue := item.Issue
+	now := clockNow(m.now)
+	var lines []string
+	field := func(name, value string) {
+		if value != "" {
+			lines = append(lines, name+": "+value)
+		}
+	}
+	section := func(name, text string) {
+		if text != "" {
+			lines = append(lines, "", name+":", text)
+		}
+	}
+
+	field("ID", issue.ID)
+	field("Title", issue.Title)
+	field("Type", string(issue.IssueType))
+	field("Status", string(issue.Status))
+	field("Priority", fmt.Sprintf("P%d", issue.Priority))
+	assignee := issue.Assignee
+	if assignee == "" {
+		assignee = "none"
+	}
+	field("Assignee", assignee)
+	field("Labels", strings.Join(issue.Labels, ", "))
+	if voters := issue.Voters(); len(voters) > 0 {
+		field("Votes", fmt.Sprintf("%d (%s)", len(voters), strings.Join(voters, ", ")))
+	}
+	field("Created", FormatTimeRel(issue.CreatedAt, now))
+	field("Updated", FormatTimeRel(issue.UpdatedAt, now))
+	if issue.StartDate != nil {
+		field("Starts", issue.StartDate.Format("2006-01-02"))
+	}
+	if issue.DueDate != nil {
+		field("Due", issue.DueDate.Format("2006-01-02"))
+	}
+	if issue.ClosedAt != nil {
+		field("Closed", FormatTimeRel(*issue.ClosedAt, now))
+	}
+	field("Blocked by", m.accessibleIssueRefs(blockerIDs(issue)))
+	field("Blocks", m.accessibleIssueRefs(m.blockedIDs(issue.ID)))
+	if item.TriageReason != "" {
+		field("Triage", fmt.Sprintf("score %.2f, %s", item.TriageScore, item.TriageReason))
+	}
+
+	section("Description", issue.Description)
+	section("Design notes", issue.Design)
+	section("Acceptance criteria", issue.AcceptanceCriteria)
+	section("Notes", issue.Notes)
+	var comments []*model.Comment
+	for _, c := range issue.Comments {
+		if c != nil && !c.IsVote() {
+			comments = append(comments, c)
+		}
+	}
+	if len(comments) > 0 {
+		lines = append(lines, "", fmt.Sprintf("Comments: %d", len(comments)))
+		for _, c := range comments {
+			lines = append(lines, fmt.Sprintf("Comment by %s, %s: %s", c.Author, FormatTimeRel(c.CreatedAt, now), c.Text))
+		}
+	}
+
+	width := m.viewport.Width
+	if width <= 0 {
+		width = m.width
+	}
+	text := strings.Join(lines, "\n")
+	if width > 0 {
+		text = ansi.Wrap(text, width, "")
+	}
+	return text
+}
+
+func blockerIDs(issue model.Issue) []string {
+	var ids []string
+	for _, dep := range issue.Dependencies {
+		if dep != nil && dep.Type.IsBlocking() {
+			ids = append(ids, dep.DependsOnID)
+		}
+	}
+	return ids
+}
+
+// blockedIDs lists the issues that have a blocking dependency on id.
+func (m Model) blockedIDs(id string) []string {
+	var ids []string
+	for _, other := range m.issueMap {
+		for _, dep := range other.Dependencies {
+			if dep != nil && dep.Type.IsBlocking() && dep.DependsOnID == id {
+				ids = append(ids, other.ID)
+				break
+			}
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// accessibleIssueRefs names each issue with its title and status.
+func (m Model) accessibleIssueRefs(ids []string) string {
+	refs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if issue, ok := m.issueMap[id]; ok {
+			refs = append(refs, fmt.Sprintf("%s (%s, %s)", id, issue.Title, issue.Status))
+		} else {
+			refs = append(refs, id)
+		}
+	}
+	return strings.Join(refs, "; ")
+}
+
+// renderAccessible lays out body and a plain status line, reducing views
+// that have no linear rendering of their own to plain text.
+func (m Model) renderAccessible(body string) string {
+	status := m.announcement
+	if m.statusMsg != "" && status == "" {
+		status = m.statusMsg
+	}
+	footer := "Status: " + status
+	if status == "" {
+		footer = "Status: ready. Press ? for help."
+	}
+	if m.showCommandPrompt {
+		footer = "Command: " + plainText(m.commandInput.View())
+	}
+
+	lines := strings.Split(plainText(body), "\n")
+	if max := m.height - 1; max > 0 && len(lines) > max {
+		lines = lines[:max]
+	}
+	for i, line := range lines {
+		lines[i] = truncateAccessible(line, m.width)
+	}
+	return strings.Join(append(lines, truncateAccessible(footer, m.width)), "\n")
+}
+
+// plainText strips escape sequences and replaces box-drawing and block
+// characters with spaces, dropping the blank lines left behind.
+func plainText(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if r >= 0x2500 && r <= 0x259F {
+			return ' '
+		}
+		return r
+	}, ansi.Strip(s))
+	var lines []string
+	blank := false
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimRight(line, " ")
+		if strings.TrimSpace(line) == "" {
+			if blank || len(lines) == 0 {
+				continue
+			}
+			blank = true
+			lines = append(lines, "")
+			continue
+		}
+		blank = false
+		lines = append(lines, line)
+	}
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n")
+}
+
+func truncateAccessible(line string, width int) string {
+	if width <= 0 {
+		return line
+	}
+	return ansi.Truncate(line, width, "…")
+}
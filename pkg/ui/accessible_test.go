@@ -0,0 +1,99 @@
+package ui_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/testutil"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/ui"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func accessibleFixtureModel() tea.Model {
+	m := renderFixtureModel().(ui.Model)
+	m.EnableAccessible(true)
+	return m
+}
+
+func assertLinear(t *testing.T, h *testutil.Harness) {
+	t.Helper()
+	raw := h.RawView()
+	if strings.Contains(raw, "\x1b[") {
+		t.Errorf("accessible view contains escape sequences: %q", raw)
+	}
+	for _, r := range raw {
+		if r >= 0x2500 && r <= 0x259F {
+			t.Fatalf("accessible view contains box drawing %q:\n%s", r, raw)
+		}
+	}
+}
+
+// selectedID reads the selected issue from the accessible list.
+func selectedID(h *testutil.Harness) string {
+	for _, line := range strings.Split(h.View(), "\n") {
+		if rest, ok := strings.CutPrefix(line, "> "); ok {
+			id, _, _ := strings.Cut(rest, " ")
+			return id
+		}
+	}
+	return ""
+}
+
+func TestAccessible_ListIsOneIssuePerLine(t *testing.T) {
+	// Wide enough that the normal UI would split the screen
+	h := testutil.NewHarness(t, accessibleFixtureModel(), testutil.TermSize{Width: 180, Height: 20})
+	assertLinear(t, h)
+	h.AssertContains(
+		"Issues: 4 shown of 4. Filter: all.",
+		"bv-3 | Title: Crash on empty file | Status: open | Priority: P1 | Type: bug | Assignee: none | Updated: 45m ago",
+		"Assignee: ana",
+		"Status: ready. Press ? for help.",
+	)
+	selected := 0
+	for _, line := range strings.Split(h.View(), "\n") {
+		if strings.HasPrefix(line, "> ") {
+			selected++
+		}
+	}
+	if selected != 1 {
+		t.Errorf("expected exactly one selected line, got %d:\n%s", selected, h.View())
+	}
+}
+
+func TestAccessible_DetailUsesFieldLabels(t *testing.T) {
+	h := testutil.NewHarness(t, accessibleFixtureModel(), testutil.TermSize{Width: 80, Height: 30})
+	// Select bv-1, which has a blocker and a comment
+	for i := 0; i < 4 && selectedID(h) != "bv-1"; i++ {
+		h.Keys("j")
+	}
+	if selectedID(h) != "bv-1" {
+		t.Fatalf("could not select bv-1:\n%s", h.View())
+	}
+	h.Keys("enter")
+	assertLinear(t, h)
+	h.AssertContains(
+		"ID: bv-1",
+		"Title: Ship the importer",
+		"Status: in_progress",
+		"Priority: P0",
+		"Assignee: ana",
+		"Updated: 2h ago",
+		"Blocked by: bv-2 (Map legacy fields, open)",
+		"Description:",
+		"Comment by kim, 3d ago: Schema is settled.",
+	)
+}
+
+func TestAccessible_AnnouncesChanges(t *testing.T) {
+	h := testutil.NewHarness(t, accessibleFixtureModel(), testutil.TermSize{Width: 100, Height: 20})
+	h.Keys("j")
+	id := selectedID(h)
+	h.AssertContains("Status: Selected " + id + ", 2 of 4:")
+
+	h.Keys("enter")
+	h.AssertContains("Status: Issue detail.")
+
+	h.Keys("esc", "b")
+	assertLinear(t, h)
+	h.AssertContains("Status: Kanban board.")
+}
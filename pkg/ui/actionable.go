@@ -6,6 +6,7 @@ import (
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -185,7 +186,7 @@ func (m *ActionableModel) Render() string {
 			Padding(2, 4).
 			Width(m.width - 4).
 			Align(lipgloss.Center)
-		lines = append(lines, emptyStyle.Render("✓ No actionable items. All tasks are either blocked or completed."))
+		lines = append(lines, emptyStyle.Render(i18n.T("✓ No actionable items. All tasks are either blocked or completed.")))
 		return strings.Join(lines, "\n")
 	}
 
@@ -0,0 +1,739 @@
+package ui
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/export"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/hooks"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// issueAction is one entry of the per-issue action menu (".").
+type issueAction struct {
+	key   string // Shortcut inside the menu
+	label string
+	// local actions affect this machine (files, processes, the clipboard)
+	// and are left out of read-only sessions.
+	local bool
+	// available reports whether the action applies to issue in the current
+	// state; unavailable actions are left out of the menu.
+	available func(m *Model, issue model.Issue) bool
+	run       func(m Model, issue model.Issue) (Model, tea.Cmd)
+}
+
+// bdAvailable reports whether the beads CLI is on PATH. Status changes are
+// delegated to bd so bv never writes the JSONL itself.
+var bdAvailable = func() bool {
+	_, err := exec.LookPath("bd")
+	return err == nil
+}
+
+// issueActions is the registry of built-in actions, in menu order. Hooks from
+// the issue-action phase of .bv/hooks.yaml are appended per issue.
+var issueActions = []issueAction{
+	{
+		key:   "e",
+		label: "Open beads file in editor",
+		local: true,
+		available: func(m *Model, _ model.Issue) bool {
+			return m.beadsPath != ""
+		},
+		run: func(m Model, _ model.Issue) (Model, tea.Cmd) {
+			m.openInEditor()
+			return m, nil
+		},
+	},
+	{
+		key:   "s",
+		label: "Claim (bd update --status=in_progress)",
+		local: true,
+		available: func(m *Model, issue model.Issue) bool {
+			return !isClosedLikeStatus(issue.Status) && issue.Status != model.StatusInProgress && bdAvailable()
+		},
+		run: func(m Model, issue model.Issue) (Model, tea.Cmd) {
+			return m.moveIssue(issue, model.StatusInProgress, i18n.Tf("Claimed %s", issue.ID), "update", issue.ID, "--status=in_progress")
+		},
+	},
+	{
+		key:   "c",
+		label: "Close (bd close)",
+		local: true,
+		available: func(m *Model, issue model.Issue) bool {
+			return !isClosedLikeStatus(issue.Status) && bdAvailable()
+		},
+		run: func(m Model, issue model.Issue) (Model, tea.Cmd) {
+			return m.moveIssue(issue, model.StatusClosed, i18n.Tf("Closed %s", issue.ID), "close", issue.ID)
+		},
+	},
+	{
+		key:   "o",
+		label: "Reopen (bd reopen)",
+		local: true,
+		available: func(m *Model, issue model.Issue) bool {
+			return issue.Status == model.StatusClosed && bdAvailable()
+		},
+		run: func(m Model, issue model.Issue) (Model, tea.Cmd) {
+			return m.moveIssue(issue, model.StatusOpen, i18n.Tf("Reopened %s", issue.ID), "reopen", issue.ID)
+		},
+	},
+	{
+		key:   "m",
+		label: "Comment (bd comments add)",
+		local: true,
+		available: func(*Model, model.Issue) bool {
+			return bdAvailable()
+		},
+		run: func(m Model, issue model.Issue) (Model, tea.Cmd) {
+			m.openComposer(&issue)
+			return m, nil
+		},
+	},
+	{
+		key:   "v",
+		label: "Vote (+1 comment)",
+		local: true,
+		available: func(_ *Model, issue model.Issue) bool {
+			return bdAvailable() && !slices.Contains(issue.Voters(), voterName(os.Getenv))
+		},
+		run: func(m Model, issue model.Issue) (Model, tea.Cmd) {
+			return m.toggleVote(&issue)
+		},
+	},
+	{
+		key:   "v",
+		label: "Withdraw vote",
+		local: true,
+		available: func(_ *Model, issue model.Issue) bool {
+			return bdAvailable() && slices.Contains(issue.Voters(), voterName(os.Getenv))
+		},
+		run: func(m Model, issue model.Issue) (Model, tea.Cmd) {
+			return m.toggleVote(&issue)
+		},
+	},
+	{
+		key:   "w",
+		label: "Watch for changes",
+		available: func(m *Model, issue model.Issue) bool {
+			return !m.watches.Watching(issue.ID)
+		},
+		run: func(m Model, issue model.Issue) (Model, tea.Cmd) {
+			m.setWatched(issue.ID, true)
+			return m, nil
+		},
+	},
+	{
+		key:   "w",
+		label: "Stop watching",
+		available: func(m *Model, issue model.Issue) bool {
+			return m.watches.Watching(issue.ID)
+		},
+		run: func(m Model, issue model.Issue) (Model, tea.Cmd) {
+			m.setWatched(issue.ID, false)
+			return m, nil
+		},
+	},
+	{
+		key:   "f",
+		label: "Open a referenced file in $EDITOR",
+		local: true,
+		available: func(m *Model, issue model.Issue) bool {
+			return len(m.fileRefs(issue)) > 0
+		},
+		run: func(m Model, issue model.Issue) (Model, tea.Cmd) {
+			return m.openFileRefs(issue)
+		},
+	},
+	{
+		key:   "i",
+		label: "Impact: what closing it unblocks, what blocks it",
+		available: func(m *Model, _ model.Issue) bool {
+			return m.analyzer != nil
+		},
+		run: func(m Model, issue model.Issue) (Model, tea.Cmd) {
+			if err := m.openImpact(issue.ID); err != nil {
+				m.statusMsg = err.Error()
+				m.statusIsError = true
+			}
+			return m, nil
+		},
+	},
+	{
+		key:   "y",
+		label: "Copy as Markdown",
+		local: true,
+		available: func(*Model, model.Issue) bool {
+			return true
+		},
+		run: func(m Model, issue model.Issue) (Model, tea.Cmd) {
+			m.copyIssueMarkdown(issue)
+			return m, nil
+		},
+	},
+	{
+		key:   "l",
+		label: "Copy link",
+		local: true,
+		available: func(m *Model, issue model.Issue) bool {
+			return m.issueLinkTarget(issue.ID) != ""
+		},
+		run: func(m Model, issue model.Issue) (Model, tea.Cmd) {
+			m.copyToClipboard(m.issueLinkTarget(issue.ID), "link for "+issue.ID)
+			return m, nil
+		},
+	},
+	{
+		key:   "b",
+		label: "Copy branch name",
+		local: true,
+		available: func(*Model, model.Issue) bool {
+			return true
+		},
+		run: func(m Model, issue model.Issue) (Model, tea.Cmd) {
+			name := issueBranchName(issue)
+			m.copyToClipboard(name, "branch name "+name)
+			return m, nil
+		},
+	},
+	{
+		key:   "x",
+		label: "Export to Markdown file",
+		local: true,
+		available: func(*Model, model.Issue) bool {
+			return true
+		},
+		run: func(m Model, issue model.Issue) (Model, tea.Cmd) {
+			filename := fmt.Sprintf("issue_%s_%s.md", sanitizeFilenamePart(issue.ID), time.Now().Format("2006-01-02"))
+			if err := export.SaveMarkdownToFile([]model.Issue{issue}, filename); err != nil {
+				m.reportError(newError(errData, "export "+issue.ID, err))
+				return m, nil
+			}
+			m.statusMsg = i18n.Tf("✅ Exported %s to %s", issue.ID, filename)
+			m.statusIsError = false
+			return m, nil
+		},
+	},
+}
+
+// actionsFor returns the menu entries that apply to issue: the available
+// built-ins, then the user commands, then the configured issue-action hooks.
+// Read-only sessions only get the built-ins that don't act on this machine.
+func (m *Model) actionsFor(issue model.Issue) []issueAction {
+	var actions []issueAction
+	for _, a := range issueActions {
+		if a.available(m, issue) && !(a.local && m.readOnly) {
+			actions = append(actions, a)
+		}
+	}
+	if m.readOnly {
+		return actions
+	}
+	for _, uc := range m.userCommands {
+		if uc.Output == "update" && !bdAvailable() {
+			continue
+		}
+		uc := uc
+		actions = append(actions, issueAction{
+			key:   uc.Key,
+			label: "Run " + uc.Name,
+			run: func(m Model, issue model.Issue) (Model, tea.Cmd) {
+				return m.runUserCommand(uc, issue)
+			},
+		})
+	}
+
+	issueHooks, err := m.projectHooks(hooks.IssueAction)
+	if err != nil {
+		return actions
+	}
+	for i, hook := range issueHooks {
+		key := ""
+		if i < 9 {
+			key = strconv.Itoa(i + 1)
+		}
+		hook := hook
+		actions = append(actions, issueAction{
+			key:   key,
+			label: "Run hook " + hook.Name,
+			run: func(m Model, issue model.Issue) (Model, tea.Cmd) {
+				return m, m.startHookOutput(hook, issue)
+			},
+		})
+	}
+	return actions
+}
+
+// actionTarget returns the issue the action menu applies to: the selection
+// of the active view.
+func (m *Model) actionTarget() *model.Issue {
+	switch {
+	case m.showSprintPlan:
+		return m.sprintPlanSelection()
+	case m.isBoardView:
+		return m.board.SelectedIssue()
+	case m.isGraphView:
+		return m.graphView.SelectedIssue()
+	case m.focused == focusList || m.focused == focusDetail:
+		if item, ok := m.list.SelectedItem().(IssueItem); ok {
+			issue := item.Issue
+			return &issue
+		}
+	}
+	return nil
+}
+
+// openActionMenu shows the action menu for the current selection.
+func (m *Model) openActionMenu() {
+	target := m.actionTarget()
+	if target == nil {
+		m.statusMsg = i18n.T("No issue selected")
+		m.statusIsError = true
+		return
+	}
+	m.actionMenuIssue = *target
+	m.actionMenu = m.actionsFor(*target)
+	m.actionMenuCursor = 0
+	m.showActionMenu = true
+	m.focusBeforeActionMenu = m.focused
+	m.focused = focusActionMenu
+}
+
+func (m *Model) closeActionMenu() {
+	m.showActionMenu = false
+	m.actionMenu = nil
+	m.focused = m.focusBeforeActionMenu
+}
+
+// handleActionMenuKeys handles navigation and selection in the action menu.
+func (m Model) handleActionMenuKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q", ".":
+		m.closeActionMenu()
+		return m, nil
+	case "j", "down":
+		if m.actionMenuCursor < len(m.actionMenu)-1 {
+			m.actionMenuCursor++
+		}
+		return m, nil
+	case "k", "up":
+		if m.actionMenuCursor > 0 {
+			m.actionMenuCursor--
+		}
+		return m, nil
+	case "enter":
+		if m.actionMenuCursor < len(m.actionMenu) {
+			return m.runAction(m.actionMenu[m.actionMenuCursor])
+		}
+		return m, nil
+	}
+	for _, a := range m.actionMenu {
+		if a.key != "" && a.key == msg.String() {
+			return m.runAction(a)
+		}
+	}
+	return m, nil
+}
+
+func (m Model) runAction(a issueAction) (Model, tea.Cmd) {
+	issue := m.actionMenuIssue
+	m.closeActionMenu()
+	return a.run(m, issue)
+}
+
+func (m Model) renderActionMenu() string {
+	t := m.theme
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 3)
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	keyStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Bold(true)
+	textStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+	selectedStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(i18n.Tf("Actions: %s", m.actionMenuIssue.ID)) + "\n")
+	sb.WriteString(mutedStyle.Render(truncateRunesHelper(m.actionMenuIssue.Title, 48, "…")) + "\n\n")
+	if len(m.actionMenu) == 0 {
+		sb.WriteString(mutedStyle.Render(i18n.T("No actions available")) + "\n")
+	}
+	for i, a := range m.actionMenu {
+		cursor := "  "
+		label := textStyle.Render(a.label)
+		if i == m.actionMenuCursor {
+			cursor = selectedStyle.Render("▸ ")
+			label = selectedStyle.Render(a.label)
+		}
+		sb.WriteString(cursor + keyStyle.Render(fmt.Sprintf("%-2s", a.key)) + label + "\n")
+	}
+	sb.WriteString("\n" + mutedStyle.Render(i18n.T("j/k: navigate • enter: run • esc: close")))
+
+	return lipgloss.Place(m.width, m.height-1, lipgloss.Center, lipgloss.Center, boxStyle.Render(sb.String()))
+}
+
+// issueActionResultMsg reports the outcome of an asynchronous action.
+type issueActionResultMsg struct {
+	summary string
+	err     error
+}
+
+// runBDCmd runs the beads CLI in the project directory. The resulting file
+// change reaches the view through live reload.
+func (m Model) runBDCmd(summary string, args ...string) tea.Cmd {
+	dir, life := m.workDir, m.life
+	return func() tea.Msg {
+		if _, err := life.runWrite(dir, args...); err != nil {
+			return issueActionResultMsg{err: newError(errData, "update "+args[1], err)}
+		}
+		return issueActionResultMsg{summary: summary}
+	}
+}
+
+// runBD runs bd and returns what it printed on stdout.
+func runBD(ctx context.Context, dir string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "bd", args...)
+	cmd.Dir = dir
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			err = fmt.Errorf("%w: %s", err, msg)
+		}
+		return "", fmt.Errorf("bd %s: %w", args[0], err)
+	}
+	return stdout.String(), nil
+}
+
+// EnableHooks allows the TUI to run the issue hooks of the project's
+// .bv/hooks.yaml. It is on by default.
+func (m *Model) EnableHooks(enabled bool) {
+	m.skipHooks = !enabled
+}
+
+// projectHooks reads the project's hooks of phase; none when they are
+// disabled or not trusted (see EnableHookTrust).
+func (m *Model) projectHooks(phase hooks.HookPhase) ([]hooks.Hook, error) {
+	if m.skipHooks {
+		return nil, nil
+	}
+	loader := m.hookLoader()
+	if err := loader.Load(); err != nil {
+		var untrusted *hooks.UntrustedError
+		if errors.As(err, &untrusted) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return loader.GetHooks(phase), nil
+}
+
+// issueHookContext returns the BV_ISSUE_* variables of issue for its hooks.
+func issueHookContext(issue model.Issue) hooks.IssueContext {
+	return hooks.IssueContext{
+		ID:       issue.ID,
+		Title:    issue.Title,
+		Status:   string(issue.Status),
+		Priority: issue.Priority,
+		Assignee: issue.Assignee,
+	}
+}
+
+// runIssueHookCmd runs hook for issue with run, which sets its phase. The
+// hook counts as running from now until it finishes.
+func runIssueHookCmd(life *lifecycle, hook hooks.Hook, issue model.Issue, run func(context.Context, hooks.Hook, hooks.IssueContext) hooks.HookResult) tea.Cmd {
+	ic := issueHookContext(issue)
+	done := life.startHook()
+	return func() tea.Msg {
+		defer done()
+		result := run(life.context(), hook, ic)
+		if result.Error != nil {
+			return issueActionResultMsg{err: newError(errHook, "run hook "+hook.Name, hookFailure(result))}
+		}
+		summary := i18n.Tf("Hook %s finished for %s", hook.Name, issue.ID)
+		if line, _, _ := strings.Cut(result.Stdout, "\n"); line != "" {
+			summary += ": " + line
+		}
+		return issueActionResultMsg{summary: summary}
+	}
+}
+
+// runIssueHooksCmd runs the hooks of one event for issue as a pipeline
+// (see hooks.RunPipeline), with run, which sets their phase, and reports
+// them in one status: a single hook as runIssueHookCmd does, several by
+// count, naming the first that failed.
+func runIssueHooksCmd(life *lifecycle, phase hooks.HookPhase, eventHooks []hooks.Hook, issue model.Issue, run func(context.Context, hooks.Hook, hooks.IssueContext) hooks.HookResult) tea.Cmd {
+	if len(eventHooks) == 0 {
+		return nil
+	}
+	if len(eventHooks) == 1 {
+		return runIssueHookCmd(life, eventHooks[0], issue, run)
+	}
+	ic := issueHookContext(issue)
+	done := life.startHook()
+	return func() tea.Msg {
+		defer done()
+		results := hooks.RunPipeline(life.context(), phase, eventHooks, func(ctx context.Context, hook hooks.Hook) hooks.HookResult {
+			return run(ctx, hook, ic)
+		})
+		status := hooks.PipelineStatus(results)
+		for _, result := range results {
+			if !result.Success && !result.Skipped {
+				err := fmt.Errorf("%s (%s)", hookFailure(result), status)
+				return issueActionResultMsg{err: newError(errHook, "run hook "+result.Hook.Name, err)}
+			}
+		}
+		return issueActionResultMsg{summary: i18n.Tf("Hooks finished for %s: %s", issue.ID, status)}
+	}
+}
+
+// hookFailure returns the error of a failed hook run, with its stderr.
+func hookFailure(result hooks.HookResult) error {
+	if result.Stderr != "" {
+		return fmt.Errorf("%w: %s", result.Error, result.Stderr)
+	}
+	return result.Error
+}
+
+// userCommandPlaceholder matches the issue fields in a user command template.
+var userCommandPlaceholder = regexp.MustCompile(`\{([a-z_]+)\}`)
+
+// userCommandFields returns the values substituted into user command
+// templates.
+func userCommandFields(issue model.Issue) map[string]string {
+	fields := map[string]string{
+		"id":           issue.ID,
+		"title":        issue.Title,
+		"status":       string(issue.Status),
+		"priority":     strconv.Itoa(issue.Priority),
+		"assignee":     issue.Assignee,
+		"type":         string(issue.IssueType),
+		"labels":       strings.Join(issue.Labels, ","),
+		"external_ref": "",
+	}
+	if issue.ExternalRef != nil {
+		fields["external_ref"] = *issue.ExternalRef
+	}
+	return fields
+}
+
+// expandUserCommand fills the placeholders of template with the shell-quoted
+// fields of issue, so titles cannot inject shell syntax.
+func expandUserCommand(template string, issue model.Issue) string {
+	fields := userCommandFields(issue)
+	return userCommandPlaceholder.ReplaceAllStringFunc(template, func(p string) string {
+		return hooks.ShellQuote(fields[p[1:len(p)-1]])
+	})
+}
+
+// ValidateUserCommands checks the ui.commands config: names must be unique
+// single words, keys must not clash with the menu's own shortcuts, and
+// templates may only use known placeholders.
+func ValidateUserCommands(cmds []config.UserCommand) error {
+	builtinKeys := make(map[string]bool)
+	for _, a := range issueActions {
+		builtinKeys[a.key] = true
+	}
+	known := userCommandFields(model.Issue{})
+	names := make(map[string]bool)
+	keys := make(map[string]string)
+	for _, uc := range cmds {
+		if uc.Name == "" || strings.ContainsAny(uc.Name, " \t;:") {
+			return fmt.Errorf("command %q: name must be a single word", uc.Name)
+		}
+		if names[uc.Name] {
+			return fmt.Errorf("command %q: defined twice", uc.Name)
+		}
+		names[uc.Name] = true
+		if strings.TrimSpace(uc.Run) == "" {
+			return fmt.Errorf("command %q: run is empty", uc.Name)
+		}
+		for _, p := range userCommandPlaceholder.FindAllStringSubmatch(uc.Run, -1) {
+			if _, ok := known[p[1]]; !ok {
+				return fmt.Errorf("command %q: unknown placeholder {%s}", uc.Name, p[1])
+			}
+		}
+		switch uc.Output {
+		case "", "discard", "show", "update":
+		default:
+			return fmt.Errorf("command %q: output must be discard, show, or update", uc.Name)
+		}
+		if uc.Key == "" {
+			continue
+		}
+		if len([]rune(uc.Key)) != 1 || builtinKeys[uc.Key] || (uc.Key >= "0" && uc.Key <= "9") ||
+			uc.Key == "j" || uc.Key == "k" || uc.Key == "q" || uc.Key == "." {
+			return fmt.Errorf("command %q: key %q is reserved by the action menu", uc.Name, uc.Key)
+		}
+		if other, ok := keys[uc.Key]; ok {
+			return fmt.Errorf("command %q: key %q is already used by %q", uc.Name, uc.Key, other)
+		}
+		keys[uc.Key] = uc.Name
+	}
+	return nil
+}
+
+// SetUserCommands installs the external commands from ui.commands. Callers
+// should check them with ValidateUserCommands first.
+func (m *Model) SetUserCommands(cmds []config.UserCommand) {
+	m.userCommands = cmds
+}
+
+func (m *Model) userCommand(name string) (config.UserCommand, bool) {
+	for _, uc := range m.userCommands {
+		if uc.Name == name {
+			return uc, true
+		}
+	}
+	return config.UserCommand{}, false
+}
+
+func (m *Model) userCommandNames() []string {
+	names := make([]string, 0, len(m.userCommands))
+	for _, uc := range m.userCommands {
+		names = append(names, uc.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// runUserCommand runs uc for issue and handles its output as configured:
+// "show" streams it into the output pane, the other modes run in the
+// background and report in the status bar.
+func (m Model) runUserCommand(uc config.UserCommand, issue model.Issue) (Model, tea.Cmd) {
+	if m.refuseReadOnly(i18n.T("Running commands")) {
+		return m, nil
+	}
+	dir, life, wip, issues := m.workDir, m.life, m.wip, m.issues
+	hook := hooks.Hook{Name: uc.Name, Command: expandUserCommand(uc.Run, issue)}
+	ic := issueHookContext(issue)
+	if uc.Output == "show" {
+		cmd := m.startOutput(uc.Name+" "+issue.ID, hook.Command, dir, ic.ToEnv())
+		return m, cmd
+	}
+	done := life.startHook()
+	return m, func() tea.Msg {
+		defer done()
+		result := hooks.RunIssueHook(life.context(), hook, ic)
+		if result.Error != nil {
+			return issueActionResultMsg{err: newError(errHook, "run "+uc.Name, hookFailure(result))}
+		}
+		if uc.Output == "update" {
+			args, err := userCommandUpdateArgs(issue.ID, result.Stdout)
+			if err != nil {
+				return issueActionResultMsg{err: newError(errHook, "run "+uc.Name, err)}
+			}
+			if len(args) == 2 {
+				return issueActionResultMsg{summary: i18n.Tf("%s: no changes for %s", uc.Name, issue.ID)}
+			}
+			summary := i18n.Tf("%s updated %s", uc.Name, issue.ID)
+			for _, arg := range args[2:] {
+				if status, ok := strings.CutPrefix(arg, "--status="); ok {
+					warning, err := wip.check(issues, map[string]model.Status{issue.ID: model.Status(status)})
+					if err != nil {
+						return issueActionResultMsg{err: newError(errHook, "run "+uc.Name, err)}
+					}
+					if warning != "" {
+						summary += " (" + warning + ")"
+					}
+				}
+			}
+			if _, err := life.runWrite(dir, args...); err != nil {
+				return issueActionResultMsg{err: newError(errData, "update "+issue.ID, err)}
+			}
+			return issueActionResultMsg{summary: summary}
+		}
+		return issueActionResultMsg{summary: i18n.Tf("Ran %s for %s", uc.Name, issue.ID)}
+	}
+}
+
+// userCommandUpdateArgs turns the JSON object printed by an output: update
+// command into "bd update" arguments. Only fields bd can set are accepted.
+func userCommandUpdateArgs(id, stdout string) ([]string, error) {
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(stdout), &fields); err != nil {
+		return nil, fmt.Errorf("expected a JSON object of fields: %w", err)
+	}
+	args := []string{"update", id}
+	for _, name := range []string{"status", "priority", "assignee", "title"} {
+		v, ok := fields[name]
+		if !ok {
+			continue
+		}
+		delete(fields, name)
+		switch v := v.(type) {
+		case string:
+			args = append(args, fmt.Sprintf("--%s=%s", name, v))
+		case float64:
+			args = append(args, fmt.Sprintf("--%s=%d", name, int(v)))
+		default:
+			return nil, fmt.Errorf("field %q: unsupported value %v", name, v)
+		}
+	}
+	if len(fields) > 0 {
+		rest := make([]string, 0, len(fields))
+		for name := range fields {
+			rest = append(rest, name)
+		}
+		sort.Strings(rest)
+		return nil, fmt.Errorf("cannot update %s", strings.Join(rest, ", "))
+	}
+	return args, nil
+}
+
+// issueBranchName suggests a git branch for issue: its ID followed by a
+// slug of the title.
+func issueBranchName(issue model.Issue) string {
+	var slug strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(issue.Title) {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			slug.WriteRune(r)
+			dash = false
+		} else if !dash && slug.Len() > 0 {
+			slug.WriteByte('-')
+			dash = true
+		}
+	}
+	name := strings.TrimRight(slug.String(), "-")
+	const maxSlug = 40
+	if len(name) > maxSlug {
+		// Cut at a word boundary.
+		name = name[:maxSlug]
+		if i := strings.LastIndexByte(name, '-'); i > 0 {
+			name = name[:i]
+		}
+	}
+	if name == "" {
+		return issue.ID
+	}
+	return issue.ID + "-" + name
+}
+
+// sanitizeFilenamePart keeps letters, digits, '-' and '_'.
+func sanitizeFilenamePart(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' {
+			return r
+		}
+		return '_'
+	}, s)
+}
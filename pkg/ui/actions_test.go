@@ -0,0 +1,313 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/hooks"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func actionLabels(actions []issueAction) string {
+	var labels []string
+	for _, a := range actions {
+		labels = append(labels, a.key+":"+a.label)
+	}
+	return strings.Join(labels, "|")
+}
+
+func withBD(t *testing.T, available bool) {
+	t.Helper()
+	orig := bdAvailable
+	bdAvailable = func() bool { return available }
+	t.Cleanup(func() { bdAvailable = orig })
+}
+
+func TestActionsFor_FilteredByState(t *testing.T) {
+	withBD(t, true)
+	m := commandTestModel(t)
+	m.workDir = t.TempDir()
+
+	open := actionLabels(m.actionsFor(model.Issue{ID: "A-1", Status: model.StatusOpen}))
+	if !strings.Contains(open, "s:Claim") || !strings.Contains(open, "c:Close") || strings.Contains(open, "Reopen") {
+		t.Fatalf("unexpected actions for open issue: %s", open)
+	}
+
+	closed := actionLabels(m.actionsFor(model.Issue{ID: "A-3", Status: model.StatusClosed}))
+	if strings.Contains(closed, "Close") || strings.Contains(closed, "Claim") || !strings.Contains(closed, "o:Reopen") {
+		t.Fatalf("unexpected actions for closed issue: %s", closed)
+	}
+
+	if strings.Contains(open, "Copy link") {
+		t.Fatal("copy link should need a link target")
+	}
+	m.EnableHyperlinks("https://tracker.example/{id}")
+	if got := actionLabels(m.actionsFor(model.Issue{ID: "A-1", Status: model.StatusOpen})); !strings.Contains(got, "l:Copy link") {
+		t.Fatalf("expected copy link with a URL template: %s", got)
+	}
+
+	withBD(t, false)
+	if got := actionLabels(m.actionsFor(model.Issue{ID: "A-1", Status: model.StatusOpen})); strings.Contains(got, "Close") {
+		t.Fatalf("bd actions need bd on PATH: %s", got)
+	}
+}
+
+func TestActionMenu_RunsIssueHook(t *testing.T) {
+	withBD(t, false)
+	m := commandTestModel(t)
+	m.workDir = t.TempDir()
+	if err := os.MkdirAll(filepath.Join(m.workDir, ".bv"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	hooksYAML := "hooks:\n  issue-action:\n    - name: notify\n      command: echo notified $BV_ISSUE_ID\n"
+	if err := os.WriteFile(filepath.Join(m.workDir, ".bv", "hooks.yaml"), []byte(hooksYAML), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	newM, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(".")})
+	m = newM.(Model)
+	if m.CurrentContext() != ContextActionMenu {
+		t.Fatalf("expected action menu, got %s", m.CurrentContext())
+	}
+	if got := actionLabels(m.actionMenu); !strings.Contains(got, "1:Run hook notify") {
+		t.Fatalf("expected hook in menu: %s", got)
+	}
+	if !strings.Contains(m.View(), "Actions: "+m.actionMenuIssue.ID) {
+		t.Fatal("expected menu rendered")
+	}
+
+	newM, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	m = newM.(Model)
+	if m.showActionMenu || m.focused != focusList {
+		t.Fatal("expected menu closed after running an action")
+	}
+	if cmd == nil || m.CurrentContext() != ContextCommandOutput {
+		t.Fatalf("expected the hook to stream into the output pane, got %s", m.CurrentContext())
+	}
+	m, _ = pumpOutput(t, m, cmd, func(m Model) bool { return !m.commandOutput.running })
+	if got := strings.Join(m.commandOutput.lines, "\n"); got != "notified "+m.actionMenuIssue.ID || m.commandOutput.status() != "exited 0" {
+		t.Fatalf("expected hook output in the pane, got %q (%s)", got, m.commandOutput.status())
+	}
+
+	m.EnableHooks(false)
+	if got := actionLabels(m.actionsFor(m.actionMenuIssue)); strings.Contains(got, "Run hook") {
+		t.Fatalf("expected no hooks with ui.run_hooks off: %s", got)
+	}
+}
+
+func TestRunIssueHooksCmd_ReportsPipelineAsOne(t *testing.T) {
+	eventHooks := []hooks.Hook{
+		{Name: "post", OnError: "continue"},
+		{Name: "label", OnError: "continue"},
+		{Name: "ping", OnError: "continue", Needs: []string{"post"}},
+	}
+	var ran []string
+	run := func(failing string) func(context.Context, hooks.Hook, hooks.IssueContext) hooks.HookResult {
+		return func(_ context.Context, hook hooks.Hook, ic hooks.IssueContext) hooks.HookResult {
+			if hook.Name == "ping" {
+				ran = append(ran, ic.ID) // Only runs once post is done, so never concurrently
+			}
+			if hook.Name == failing {
+				return hooks.HookResult{Hook: hook, Error: errors.New("exit status 1"), Stderr: "no network"}
+			}
+			return hooks.HookResult{Hook: hook, Success: true}
+		}
+	}
+	issue := model.Issue{ID: "A-1"}
+
+	msg := runIssueHooksCmd(nil, hooks.IssueCreated, eventHooks, issue, run(""))().(issueActionResultMsg)
+	if msg.err != nil || msg.summary != "Hooks finished for A-1: 3 succeeded, 0 failed" {
+		t.Errorf("unexpected result %+v", msg)
+	}
+	if len(ran) != 1 {
+		t.Errorf("expected ping to run after post, got %v", ran)
+	}
+
+	msg = runIssueHooksCmd(nil, hooks.IssueCreated, eventHooks, issue, run("post"))().(issueActionResultMsg)
+	if msg.err == nil || !strings.Contains(msg.err.Error(), "no network (1 succeeded, 1 failed, 1 skipped)") {
+		t.Errorf("expected the failure and the counts, got %+v", msg)
+	}
+	if len(ran) != 1 {
+		t.Error("expected ping skipped once post failed")
+	}
+}
+
+func TestActionMenu_EscRestoresFocus(t *testing.T) {
+	m := commandTestModel(t)
+	m.workDir = t.TempDir()
+
+	newM, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")})
+	m = newM.(Model)
+	newM, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(".")})
+	m = newM.(Model)
+	if !m.showActionMenu {
+		t.Fatal("expected action menu on the board")
+	}
+	newM, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = newM.(Model)
+	if m.showActionMenu || m.focused != focusBoard {
+		t.Fatalf("expected board focus after esc, got %v", m.focused)
+	}
+}
+
+func TestIssueBranchName(t *testing.T) {
+	tests := map[string]model.Issue{
+		"bv-12-fix-login-crash-on-safari": {ID: "bv-12", Title: "Fix login crash on Safari!"},
+		"bv-13":                           {ID: "bv-13", Title: "!!!"},
+		"bv-14-a-very-long-title-that-keeps-going-and": {ID: "bv-14", Title: "A very long title that keeps going and going"},
+	}
+	for want, issue := range tests {
+		if got := issueBranchName(issue); got != want {
+			t.Errorf("issueBranchName(%q) = %q, want %q", issue.Title, got, want)
+		}
+	}
+}
+
+func TestExpandUserCommand_QuotesFields(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("cmd quoting is covered by the hooks tests")
+	}
+	issue := model.Issue{ID: "bv-1", Title: "it's $(rm -rf ~)", Priority: 2, Labels: []string{"api", "ui"}}
+	got := expandUserCommand("notify {id} {title} p{priority} {labels} {assignee}", issue)
+	want := `notify 'bv-1' 'it'\''s $(rm -rf ~)' p'2' 'api,ui' ''`
+	if got != want {
+		t.Fatalf("got %s\nwant %s", got, want)
+	}
+}
+
+func TestValidateUserCommands(t *testing.T) {
+	valid := []config.UserCommand{
+		{Name: "gh", Run: "gh issue view {external_ref}"},
+		{Name: "estimate", Key: "E", Run: "estimate {id}", Output: "update"},
+	}
+	if err := ValidateUserCommands(valid); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	bad := map[string][]config.UserCommand{
+		"single word":   {{Name: "two words", Run: "x"}},
+		"defined twice": {{Name: "a", Run: "x"}, {Name: "a", Run: "y"}},
+		"run is empty":  {{Name: "a"}},
+		"placeholder":   {{Name: "a", Run: "x {nope}"}},
+		"output":        {{Name: "a", Run: "x", Output: "print"}},
+		"reserved":      {{Name: "a", Run: "x", Key: "c"}},
+		"already used":  {{Name: "a", Run: "x", Key: "Z"}, {Name: "b", Run: "y", Key: "Z"}},
+	}
+	for want, cmds := range bad {
+		if err := ValidateUserCommands(cmds); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error containing %q, got %v", want, err)
+		}
+	}
+}
+
+func TestUserCommand_ShowsOutput(t *testing.T) {
+	withBD(t, false)
+	m := commandTestModel(t)
+	m.workDir = t.TempDir()
+	m.SetUserCommands([]config.UserCommand{
+		{Name: "describe", Key: "D", Run: "echo about {id}", Output: "show"},
+		{Name: "estimate", Run: "echo '{}'", Output: "update"},
+	})
+
+	newM, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(".")})
+	m = newM.(Model)
+	labels := actionLabels(m.actionMenu)
+	if !strings.Contains(labels, "D:Run describe") || strings.Contains(labels, "estimate") {
+		t.Fatalf("expected describe but not the bd-backed estimate: %s", labels)
+	}
+
+	newM, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("D")})
+	m = newM.(Model)
+	if cmd == nil {
+		t.Fatal("expected command")
+	}
+	newM, _ = m.Update(cmd())
+	m = newM.(Model)
+	if m.CurrentContext() != ContextCommandOutput || !strings.Contains(m.View(), "about "+m.actionMenuIssue.ID) {
+		t.Fatalf("expected output pager, got context %s", m.CurrentContext())
+	}
+	newM, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = newM.(Model)
+	if m.showCommandOutput {
+		t.Fatal("expected esc to close the pager")
+	}
+
+	// The same command from the prompt.
+	m, cmd, err := m.ExecCommand("run describe")
+	if err != nil || cmd == nil {
+		t.Fatalf("run describe: %v", err)
+	}
+	if _, _, err := m.ExecCommand("run nope"); err == nil || !strings.Contains(err.Error(), "describe, estimate") {
+		t.Fatalf("expected unknown command error listing commands, got %v", err)
+	}
+}
+
+func TestUserCommandUpdateArgs(t *testing.T) {
+	args, err := userCommandUpdateArgs("bv-1", `{"status":"in_progress","priority":1}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(args, " "); got != "update bv-1 --status=in_progress --priority=1" {
+		t.Fatalf("got %q", got)
+	}
+	if _, err := userCommandUpdateArgs("bv-1", `{"status":"open","labels":["x"]}`); err == nil || !strings.Contains(err.Error(), "labels") {
+		t.Fatalf("expected error for labels, got %v", err)
+	}
+	if _, err := userCommandUpdateArgs("bv-1", "not json"); err == nil {
+		t.Fatal("expected error for non-JSON output")
+	}
+}
+
+func TestReadOnly_RefusesLocalActions(t *testing.T) {
+	withBD(t, true)
+	m := commandTestModel(t)
+	m.workDir = t.TempDir()
+	m.SetUserCommands([]config.UserCommand{{Name: "describe", Key: "D", Run: "echo {id}", Output: "show"}})
+	m.EnableReadOnly()
+
+	got := actionLabels(m.actionsFor(model.Issue{ID: "A-1", Status: model.StatusOpen}))
+	if got != "w:Watch for changes|i:Impact: what closing it unblocks, what blocks it" {
+		t.Fatalf("expected only local-free actions, got %s", got)
+	}
+
+	m, cmd, err := m.ExecCommand("run describe")
+	if err != nil || cmd != nil || m.showCommandOutput || !strings.Contains(m.statusMsg, "read-only") {
+		t.Fatalf("expected run to be refused, got err=%v status=%q", err, m.statusMsg)
+	}
+
+	newM, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	m = newM.(Model)
+	if !strings.Contains(m.statusMsg, "Export is not available") {
+		t.Fatalf("expected export to be refused, got %q", m.statusMsg)
+	}
+	m, cmd = m.handleSessionReviewKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+	if cmd != nil || !strings.Contains(m.statusMsg, "Exporting the worklog is not available") {
+		t.Fatalf("expected the worklog export to be refused, got %q", m.statusMsg)
+	}
+	if _, err := os.Stat(filepath.Join(m.workDir, ".bv", WorklogFileName)); !os.IsNotExist(err) {
+		t.Errorf("expected no worklog in a read-only session, got %v", err)
+	}
+	m.statusMsg = ""
+	if view := m.View(); !strings.Contains(view, "🔒 read-only") {
+		t.Errorf("expected the status bar to show the read-only session:\n%s", view)
+	}
+
+	// Expanding and collapsing the tree is not saved to .beads.
+	beadsDir := filepath.Join(t.TempDir(), ".beads")
+	m.tree.SetBeadsDir(beadsDir)
+	m.tree.Build([]model.Issue{
+		{ID: "E-1", Status: model.StatusOpen, IssueType: model.TypeEpic},
+		{ID: "E-2", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "E-1", Type: model.DepParentChild}}},
+	})
+	m.tree.ToggleExpand()
+	if _, err := os.Stat(TreeStatePath(beadsDir)); !os.IsNotExist(err) {
+		t.Errorf("expected no tree state in a read-only session, got %v", err)
+	}
+}
@@ -0,0 +1,194 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/correlation"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Agent activity
+//
+// ":activity [hours]" groups the changes made to the beads file in the last
+// hours (default 24) by actor, the author of the commit, so it shows what
+// each agent or person touched. Actors who closed many issues within an
+// hour or raised many priorities are flagged (see
+// correlation.SummarizeActivity). It reads the git history bv loads in the
+// background at startup, so it needs the beads file to be in a git
+// repository.
+
+// defaultActivityHours is the window :activity looks back over by default.
+const defaultActivityHours = 24
+
+// activityRow is an issue listed under an actor in the activity view.
+type activityRow struct {
+	actor int // Index into Model.activity
+	event correlation.BeadEvent
+}
+
+// openActivity opens the activity view over the last hours.
+func (m *Model) openActivity(hours int) {
+	m.showActivity = true
+	m.activityHours = hours
+	m.activityCursor = 0
+	m.refreshActivity()
+}
+
+// refreshActivity summarizes the loaded history for the activity view.
+func (m *Model) refreshActivity() {
+	m.activity, m.activityRows = nil, nil
+	if m.historyView.report == nil {
+		return
+	}
+	since := clockNow(m.now).Add(-time.Duration(m.activityHours) * time.Hour)
+	m.activity = correlation.SummarizeActivity(m.historyView.report, correlation.DefaultActivityOptions(since))
+	for i, a := range m.activity {
+		seen := make(map[string]bool)
+		// Events are most recent first, so each issue shows its latest change.
+		for _, event := range a.Events {
+			if !seen[event.BeadID] {
+				seen[event.BeadID] = true
+				m.activityRows = append(m.activityRows, activityRow{actor: i, event: event})
+			}
+		}
+	}
+	m.activityCursor = min(m.activityCursor, max(len(m.activityRows)-1, 0))
+}
+
+// handleActivityKeys moves through the issues of the activity view and
+// jumps to one.
+func (m Model) handleActivityKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	rows := m.activityRows
+	switch msg.String() {
+	case "esc", "q":
+		m.showActivity = false
+	case "j", "down":
+		if m.activityCursor < len(rows)-1 {
+			m.activityCursor++
+		}
+	case "k", "up":
+		if m.activityCursor > 0 {
+			m.activityCursor--
+		}
+	case "enter":
+		if m.activityCursor < len(rows) {
+			id := rows[m.activityCursor].event.BeadID
+			if _, ok := m.issueMap[id]; !ok {
+				m.statusMsg = i18n.Tf("Issue %s is no longer in the beads file", id)
+				m.statusIsError = true
+				return m, nil
+			}
+			m.showActivity = false
+			return m.gotoIssue(id), nil
+		}
+	}
+	return m, nil
+}
+
+// activityEventLabel describes the change an event made.
+// eventTypeName names an event type in the user's language.
+func eventTypeName(kind correlation.EventType) string {
+	switch kind {
+	case correlation.EventCreated:
+		return i18n.T("created")
+	case correlation.EventClaimed:
+		return i18n.T("claimed")
+	case correlation.EventClosed:
+		return i18n.T("closed")
+	case correlation.EventReopened:
+		return i18n.T("reopened")
+	case correlation.EventModified:
+		return i18n.T("modified")
+	}
+	return string(kind)
+}
+
+func activityEventLabel(event correlation.BeadEvent) string {
+	label := eventTypeName(event.EventType)
+	if event.OldPriority != nil && event.NewPriority != nil {
+		label += fmt.Sprintf(" P%d→P%d", *event.OldPriority, *event.NewPriority)
+	}
+	return label
+}
+
+func (m Model) renderActivity() string {
+	t := m.theme
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 3)
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	actorStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Bold(true)
+	flagStyle := t.Renderer.NewStyle().Foreground(t.Blocked).Bold(true)
+	idStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+	textStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+	selectedStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+
+	width := max(min(m.width-12, 90), 40)
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(i18n.Tf("Activity in the last %dh", m.activityHours)) + "\n\n")
+
+	switch {
+	case m.historyView.report == nil && m.historyLoading:
+		sb.WriteString(mutedStyle.Render(i18n.T("Loading history…")) + "\n")
+	case m.historyView.report == nil:
+		sb.WriteString(mutedStyle.Render(i18n.T("No history: the beads file needs to be in a git repository")) + "\n")
+	case len(m.activity) == 0:
+		sb.WriteString(mutedStyle.Render(i18n.Tf("No changes in the last %dh", m.activityHours)) + "\n")
+	}
+
+	// Lay out every line, then show a window around the cursor.
+	var lines []string
+	cursorLine := 0
+	now := clockNow(m.now)
+	for i, a := range m.activity {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		var counts []string
+		for _, kind := range []correlation.EventType{correlation.EventCreated, correlation.EventClaimed, correlation.EventClosed, correlation.EventReopened, correlation.EventModified} {
+			if n := a.Counts[kind]; n > 0 {
+				counts = append(counts, fmt.Sprintf("%d %s", n, eventTypeName(kind)))
+			}
+		}
+		lines = append(lines, actorStyle.Render(a.Actor)+"  "+mutedStyle.Render(strings.Join(counts, " · ")))
+		for _, flag := range a.Flags {
+			lines = append(lines, "  "+flagStyle.Render("⚠ "+flag.Reason))
+		}
+		for r, row := range m.activityRows {
+			if row.actor != i {
+				continue
+			}
+			cursor, style := "  ", textStyle
+			if r == m.activityCursor {
+				cursor, style = selectedStyle.Render("▸ "), selectedStyle
+				cursorLine = len(lines)
+			}
+			title := ""
+			if issue, ok := m.issueMap[row.event.BeadID]; ok {
+				title = issue.Title
+			}
+			meta := activityEventLabel(row.event) + " " + FormatTimeRel(row.event.Timestamp, now)
+			room := width - lipgloss.Width(row.event.BeadID) - lipgloss.Width(meta) - 6
+			lines = append(lines, cursor+idStyle.Render(row.event.BeadID)+" "+
+				style.Render(truncateRunesHelper(title, max(room, 0), "…"))+" "+mutedStyle.Render(meta))
+		}
+	}
+	visible := max(m.height-12, 1)
+	start := 0
+	if cursorLine >= visible {
+		start = cursorLine - visible + 1
+	}
+	for i := start; i < len(lines) && i < start+visible; i++ {
+		sb.WriteString(lines[i] + "\n")
+	}
+	sb.WriteString("\n" + mutedStyle.Render(i18n.T("j/k: navigate • enter: go to issue • esc: close")))
+
+	return lipgloss.Place(m.width, m.height-1, lipgloss.Center, lipgloss.Center, boxStyle.Render(sb.String()))
+}
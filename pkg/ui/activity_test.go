@@ -0,0 +1,75 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/correlation"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestActivity_GroupsByActorAndFlags(t *testing.T) {
+	m := commandTestModel(t)
+	now := time.Now()
+	m.SetClock(func() time.Time { return now })
+
+	m, _, err := m.ExecCommand("activity")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.CurrentContext() != ContextActivity || !strings.Contains(ansi.Strip(m.View()), "Loading history") {
+		t.Fatalf("expected the view to wait for history, got %s", m.CurrentContext())
+	}
+
+	histories := map[string]correlation.BeadHistory{
+		"A-1":  {BeadID: "A-1", Events: []correlation.BeadEvent{{BeadID: "A-1", EventType: correlation.EventClaimed, Author: "alice", Timestamp: now.Add(-2 * time.Hour)}}},
+		"A-3":  {BeadID: "A-3", Events: []correlation.BeadEvent{{BeadID: "A-3", EventType: correlation.EventClosed, Author: "agent", Timestamp: now.Add(-time.Hour)}}},
+		"A-2":  {BeadID: "A-2", Events: []correlation.BeadEvent{{BeadID: "A-2", EventType: correlation.EventCreated, Author: "alice", Timestamp: now.Add(-30 * time.Hour)}}},
+		"GONE": {BeadID: "GONE"},
+	}
+	for i := 0; i < 10; i++ {
+		id := fmt.Sprintf("X-%d", i)
+		histories[id] = correlation.BeadHistory{BeadID: id, Events: []correlation.BeadEvent{{BeadID: id, EventType: correlation.EventClosed, Author: "agent", Timestamp: now.Add(-time.Duration(i) * time.Minute)}}}
+	}
+	newM, _ := m.Update(HistoryLoadedMsg{Report: &correlation.HistoryReport{Histories: histories}})
+	m = newM.(Model)
+
+	view := ansi.Strip(m.View())
+	for _, want := range []string{"Activity in the last 24h", "agent  11 closed", "⚠ closed 11 beads within an hour", "alice  1 claimed", "A-1 Old open"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected %q in:\n%s", want, view)
+		}
+	}
+	if strings.Contains(view, "A-2") {
+		t.Error("expected changes older than the window to be left out")
+	}
+
+	// The agent's most recent change comes first and is no longer loaded.
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "X-0 is no longer in the beads file") {
+		t.Fatalf("expected a missing issue to be reported, got %q", m.statusMsg)
+	}
+	for range 11 {
+		m = pressKey(m, runeKey("j"))
+	}
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if m.showActivity {
+		t.Fatal("expected enter to close the view")
+	}
+	if sel, ok := m.list.SelectedItem().(IssueItem); !ok || sel.Issue.ID != "A-1" {
+		t.Errorf("expected A-1 selected, got %v", m.list.SelectedItem())
+	}
+
+	if m, _, err = m.ExecCommand("activity 48"); err != nil {
+		t.Fatal(err)
+	}
+	if view := ansi.Strip(m.View()); !strings.Contains(view, "A-2") {
+		t.Errorf("expected a 48 hour window to include A-2:\n%s", view)
+	}
+	if _, _, err := m.ExecCommand("activity 0"); err == nil {
+		t.Error("expected 0 hours to be rejected")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/agents"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -133,21 +134,17 @@ func (m AgentPromptModal) View() string {
 	var b strings.Builder
 
 	// Title
-	b.WriteString(titleStyle.Render("📝 Enhance AI Agent Integration?"))
+	b.WriteString(titleStyle.Render(i18n.T("📝 Enhance AI Agent Integration?")))
 	b.WriteString("\n\n")
 
 	// Body
-	b.WriteString(bodyStyle.Render("We found " + m.fileType + " in this project but it"))
-	b.WriteString("\n")
-	b.WriteString(bodyStyle.Render("doesn't include beads_viewer instructions."))
+	b.WriteString(bodyStyle.Render(i18n.Tf("We found %s in this project but it\ndoesn't include beads_viewer instructions.", m.fileType)))
 	b.WriteString("\n\n")
-	b.WriteString(bodyStyle.Render("Adding these helps AI coding agents understand"))
-	b.WriteString("\n")
-	b.WriteString(bodyStyle.Render("how to use your issue tracking workflow."))
+	b.WriteString(bodyStyle.Render(i18n.T("Adding these helps AI coding agents understand\nhow to use your issue tracking workflow.")))
 	b.WriteString("\n\n")
 
 	// Preview
-	b.WriteString(previewHeaderStyle.Render("Preview of content to add:"))
+	b.WriteString(previewHeaderStyle.Render(i18n.T("Preview of content to add:")))
 	b.WriteString("\n")
 
 	preview := getBlurbPreview()
@@ -158,7 +155,7 @@ func (m AgentPromptModal) View() string {
 	var buttons []string
 
 	// Yes button
-	yesLabel := "Yes, add it"
+	yesLabel := i18n.T("Yes, add it")
 	if m.selection == 0 {
 		buttons = append(buttons, selectedButton.Render(yesLabel))
 	} else {
@@ -166,7 +163,7 @@ func (m AgentPromptModal) View() string {
 	}
 
 	// No button
-	noLabel := "No thanks"
+	noLabel := i18n.T("No thanks")
 	if m.selection == 1 {
 		buttons = append(buttons, selectedButton.Render(noLabel))
 	} else {
@@ -174,7 +171,7 @@ func (m AgentPromptModal) View() string {
 	}
 
 	// Never button
-	neverLabel := "Don't ask again"
+	neverLabel := i18n.T("Don't ask again")
 	if m.selection == 2 {
 		buttons = append(buttons, selectedButton.Render(neverLabel))
 	} else {
@@ -189,7 +186,7 @@ func (m AgentPromptModal) View() string {
 		Italic(true).
 		MarginTop(1)
 	b.WriteString("\n")
-	b.WriteString(hintStyle.Render("← → to select • Enter to confirm • Esc to cancel"))
+	b.WriteString(hintStyle.Render(i18n.T("← → to select • Enter to confirm • Esc to cancel")))
 
 	return modalStyle.Render(b.String())
 }
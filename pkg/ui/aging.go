@@ -0,0 +1,157 @@
+package ui
+
+import (
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/beadsview"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/hooks"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Aging
+//
+// An open issue nobody has touched in weeks is easy to lose in a long list.
+// Once an open issue is halfway to the stale threshold the list shows the
+// days since its last update, yellow and then red past the threshold;
+// "filter stale" keeps just those past it, and the issue-stale hooks run
+// when bv sees an issue cross it. The threshold is ui.stale_days, 14 days
+// unless configured.
+
+// defaultStaleAfter is the stale threshold without ui.stale_days.
+const defaultStaleAfter = 14 * 24 * time.Hour
+
+// staleCheckInterval is how often issues are checked for crossing the
+// threshold between reloads; they age without anyone touching the file.
+const staleCheckInterval = time.Hour
+
+// staleFilterTerm is the list filter term handled here rather than by
+// beadsview, since it depends on the clock and the configured threshold.
+const staleFilterTerm = "stale"
+
+// Aging levels of an open issue.
+const (
+	ageFresh = iota
+	ageAging // Halfway to stale
+	ageStale
+)
+
+// staleCheckMsg asks for the open issues to be checked for going stale.
+type staleCheckMsg struct{}
+
+func staleCheckCmd() tea.Cmd {
+	return tea.Tick(staleCheckInterval, func(time.Time) tea.Msg { return staleCheckMsg{} })
+}
+
+// agingLevel returns the whole days since issue was last updated and how
+// close that is to staleAfter. Closed issues are always fresh.
+func agingLevel(issue *model.Issue, now time.Time, staleAfter time.Duration) (days, level int) {
+	if isClosedLikeStatus(issue.Status) {
+		return 0, ageFresh
+	}
+	touched := lastTouched(issue)
+	if touched.IsZero() {
+		return 0, ageFresh
+	}
+	age := now.Sub(touched)
+	days = int(age / (24 * time.Hour))
+	switch {
+	case age >= staleAfter:
+		return days, ageStale
+	case age >= staleAfter/2:
+		return days, ageAging
+	default:
+		return days, ageFresh
+	}
+}
+
+// lastTouched returns when issue was last updated, or created if it never
+// was; zero if neither is known.
+func lastTouched(issue *model.Issue) time.Time {
+	if issue.UpdatedAt.IsZero() {
+		return issue.CreatedAt
+	}
+	return issue.UpdatedAt
+}
+
+// SetStaleDays sets how many days an open issue can go without an update
+// before it counts as stale; zero or less keeps the default of 14.
+func (m *Model) SetStaleDays(days int) {
+	m.staleAfter = defaultStaleAfter
+	if days > 0 {
+		m.staleAfter = time.Duration(days) * 24 * time.Hour
+	}
+	m.updateListDelegate()
+}
+
+func (m Model) isStale(issue *model.Issue) bool {
+	_, level := agingLevel(issue, clockNow(m.now), m.staleAfter)
+	return level == ageStale
+}
+
+// parseListFilter parses a list filter: the beadsview terms plus stale and
+// watched.
+func (m Model) parseListFilter(filter string) (*beadsview.Filter, error) {
+	return beadsview.ParseFilter(filter, map[string]func(model.Issue) bool{
+		staleFilterTerm:   func(issue model.Issue) bool { return m.isStale(&issue) },
+		watchedFilterTerm: func(issue model.Issue) bool { return m.watches.Watching(issue.ID) },
+	})
+}
+
+// validateListFilter checks a list filter.
+func validateListFilter(filter string) error {
+	_, err := Model{}.parseListFilter(filter)
+	return err
+}
+
+// listFilter returns the test of the current list filter, parsed once for
+// a pass over the issues. A filter that doesn't parse matches nothing.
+func (m Model) listFilter() func(issue model.Issue) bool {
+	f, err := m.parseListFilter(m.currentFilter)
+	if err != nil {
+		return func(model.Issue) bool { return false }
+	}
+	blockers := m.blockerMap()
+	return func(issue model.Issue) bool { return f.Match(issue, blockers) }
+}
+
+// checkStale notes which open issues are stale and runs the issue-stale
+// hooks for those that were fresh at the last check. The first check only
+// takes stock, so issues that were already stale when bv started don't
+// fire, and neither do issues that arrive stale in a reload.
+func (m *Model) checkStale() tea.Cmd {
+	if m.readOnly {
+		return nil
+	}
+	now := clockNow(m.now)
+	seen := make(map[string]bool, len(m.issues))
+	var crossed []model.Issue
+	for i := range m.issues {
+		issue := &m.issues[i]
+		if isClosedLikeStatus(issue.Status) {
+			continue
+		}
+		_, level := agingLevel(issue, now, m.staleAfter)
+		stale := level == ageStale
+		if wasStale, known := m.staleSeen[issue.ID]; known && !wasStale && stale {
+			crossed = append(crossed, *issue)
+		}
+		seen[issue.ID] = stale
+	}
+	m.staleSeen = seen
+	if len(crossed) == 0 {
+		return nil
+	}
+
+	staleHooks, err := m.projectHooks(hooks.IssueStale)
+	if err != nil {
+		m.reportError(newError(errHook, "load hooks", err))
+		return nil
+	}
+	var cmds []tea.Cmd
+	for _, issue := range crossed {
+		cmds = append(cmds, runIssueHooksCmd(m.life, hooks.IssueStale, staleHooks, issue, hooks.RunIssueStaleHook))
+	}
+	return tea.Batch(cmds...)
+}
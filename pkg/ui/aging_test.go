@@ -0,0 +1,93 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestAgingLevel(t *testing.T) {
+	now := time.Date(2025, 3, 20, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(n int) time.Time { return now.Add(-time.Duration(n) * 24 * time.Hour) }
+	cases := []struct {
+		issue     model.Issue
+		days, lvl int
+	}{
+		{model.Issue{Status: model.StatusOpen, UpdatedAt: daysAgo(3)}, 3, ageFresh},
+		{model.Issue{Status: model.StatusOpen, UpdatedAt: daysAgo(7)}, 7, ageAging},
+		{model.Issue{Status: model.StatusInProgress, CreatedAt: daysAgo(20)}, 20, ageStale},
+		{model.Issue{Status: model.StatusOpen, CreatedAt: daysAgo(20), UpdatedAt: daysAgo(1)}, 1, ageFresh},
+		{model.Issue{Status: model.StatusClosed, UpdatedAt: daysAgo(90)}, 0, ageFresh},
+		{model.Issue{Status: model.StatusOpen}, 0, ageFresh},
+	}
+	for i, tc := range cases {
+		if days, lvl := agingLevel(&tc.issue, now, defaultStaleAfter); days != tc.days || lvl != tc.lvl {
+			t.Errorf("case %d: got %d days at level %d, want %d at %d", i, days, lvl, tc.days, tc.lvl)
+		}
+	}
+
+	d := IssueDelegate{Theme: DefaultTheme(lipgloss.NewRenderer(nil)), Now: func() time.Time { return now }, StaleAfter: defaultStaleAfter}
+	if got := d.agingBadge(&cases[2].issue); !strings.Contains(got, "⏳20d") {
+		t.Errorf("expected a stale badge, got %q", got)
+	}
+	if got := d.agingBadge(&cases[0].issue); got != "" {
+		t.Errorf("expected no badge on a fresh issue, got %q", got)
+	}
+}
+
+func TestAging_StaleFilterAndHooks(t *testing.T) {
+	m := commandTestModel(t)
+	m.workDir = t.TempDir()
+	if err := os.MkdirAll(filepath.Join(m.workDir, ".bv"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	hooksYAML := "hooks:\n  issue-stale:\n    - name: nag\n      command: echo stale $BV_ISSUE_ID\n"
+	if err := os.WriteFile(filepath.Join(m.workDir, ".bv", "hooks.yaml"), []byte(hooksYAML), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if cmd := m.checkStale(); cmd != nil {
+		t.Fatal("expected the first check only to take stock")
+	}
+
+	// A-1 was created two days before A-2
+	later := time.Now().Add(15 * 24 * time.Hour)
+	m.SetClock(func() time.Time { return later })
+	m.SetStaleDays(16)
+	m, _, err := m.ExecCommand("filter stale open")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(listIDs(m), " "); got != "A-1" {
+		t.Fatalf("expected only A-1 stale, got %q", got)
+	}
+	if err := ValidateCommand("filter stale label:api", nil); err != nil {
+		t.Errorf("expected stale to combine with other terms: %v", err)
+	}
+	if m, _, err = m.ExecCommand("filter (stale AND open) OR priority:0"); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(listIDs(m), " "); got != "A-2 A-1" {
+		t.Errorf("expected stale inside an OR, got %q", got)
+	}
+	if m, _, err = m.ExecCommand("filter stale open"); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := m.checkStale()
+	if cmd == nil {
+		t.Fatal("expected the issue-stale hook for A-1")
+	}
+	newM, _ := m.Update(cmd())
+	m = newM.(Model)
+	if !strings.Contains(m.statusMsg, "Hook nag finished for A-1: stale A-1") {
+		t.Errorf("expected the hook result, got %q", m.statusMsg)
+	}
+	if cmd := m.checkStale(); cmd != nil {
+		t.Error("expected the hook to run once per crossing")
+	}
+}
@@ -259,6 +259,11 @@ type WorkerConfig struct {
 	HeartbeatTimeout  time.Duration // default: 30s
 	ProcessingTimeout time.Duration // default: 30s
 	MaxRecoveries     int           // default: 3
+
+	// Polling live reload (bv --poll-interval, --poll-jitter). Zero values
+	// use BV_POLL_INTERVAL_MS and BV_POLL_JITTER_MS.
+	PollInterval time.Duration // default: 2s
+	PollJitter   time.Duration // default: none
 }
 
 // NewBackgroundWorker creates a new background worker.
@@ -283,6 +288,12 @@ func NewBackgroundWorker(cfg WorkerConfig) (*BackgroundWorker, error) {
 	if cfg.WatchdogInterval == 0 {
 		cfg.WatchdogInterval = envDurationSeconds("BV_WATCHDOG_INTERVAL_S", 10*time.Second)
 	}
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = envDurationMilliseconds("BV_POLL_INTERVAL_MS", watcher.DefaultPollInterval)
+	}
+	if cfg.PollJitter == 0 {
+		cfg.PollJitter = envDurationMilliseconds("BV_POLL_JITTER_MS", 0)
+	}
 	if cfg.HeartbeatTimeout == 0 {
 		cfg.HeartbeatTimeout = 30 * time.Second
 	}
@@ -352,6 +363,8 @@ func NewBackgroundWorker(cfg WorkerConfig) (*BackgroundWorker, error) {
 	if cfg.BeadsPath != "" {
 		fw, err := watcher.NewWatcher(cfg.BeadsPath,
 			watcher.WithDebounceDuration(cfg.DebounceDelay),
+			watcher.WithPollInterval(cfg.PollInterval),
+			watcher.WithPollJitter(cfg.PollJitter),
 		)
 		if err != nil {
 			return nil, err
@@ -1141,11 +1154,14 @@ func (w *BackgroundWorker) LastError() *WorkerError {
 	return w.lastError
 }
 
-func (w *BackgroundWorker) WatcherInfo() (polling bool, fsType watcher.FilesystemType, pollInterval time.Duration) {
-	if w == nil || w.watcher == nil {
-		return false, watcher.FSTypeUnknown, 0
+// Watcher returns the file watcher driving live reload, or nil.
+func (w *BackgroundWorker) Watcher() *watcher.Watcher {
+	if w == nil {
+		return nil
 	}
-	return w.watcher.IsPolling(), w.watcher.FilesystemType(), w.watcher.PollInterval()
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.watcher
 }
 
 func (w *BackgroundWorker) Health() WorkerHealth {
@@ -1288,6 +1304,9 @@ func (w *BackgroundWorker) buildSnapshot() *DataSnapshot {
 	sourceLineCount := 0
 	tier := datasetTierUnknown
 	countErr := w.safeCompute("count_lines", func() error {
+		if loader.IsSQLitePath(w.beadsPath) {
+			return nil // No lines to count; the tier stays unknown
+		}
 		n, err := countJSONLLines(w.beadsPath)
 		if err != nil {
 			return err
@@ -1324,7 +1343,11 @@ func (w *BackgroundWorker) buildSnapshot() *DataSnapshot {
 				return i.Status != model.StatusClosed && i.Status != model.StatusTombstone
 			}
 		}
-		loaded, err = loader.LoadIssuesFromFileWithOptionsPooled(w.beadsPath, opts)
+		if loader.IsSQLitePath(w.beadsPath) {
+			loaded.Issues, err = loader.LoadIssuesFromSQLiteWithOptions(w.beadsPath, opts)
+		} else {
+			loaded, err = loader.LoadIssuesFromFileWithOptionsPooled(w.beadsPath, opts)
+		}
 		if err == nil {
 			issues = loaded.Issues
 			pooledRefs = loaded.PoolRefs
@@ -1439,7 +1462,7 @@ func (w *BackgroundWorker) buildSnapshot() *DataSnapshot {
 	// Store hash in snapshot for external access
 	if snapshot != nil {
 		snapshot.DataHash = hash
-		snapshot.LoadWarningCount = len(loadWarnings)
+		snapshot.LoadWarnings = loadWarnings
 		snapshot.RecipeName = recipeID
 		snapshot.RecipeHash = recipeHash
 		snapshot.pooledIssues = pooledRefs
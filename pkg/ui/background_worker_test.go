@@ -1311,8 +1311,8 @@ not json
 	if got, want := len(ready.Snapshot.Issues), 2; got != want {
 		t.Fatalf("Expected %d issues, got %d", want, got)
 	}
-	if ready.Snapshot.LoadWarningCount == 0 {
-		t.Error("Expected LoadWarningCount > 0 for malformed/invalid lines")
+	if len(ready.Snapshot.LoadWarnings) == 0 {
+		t.Error("Expected LoadWarnings for malformed/invalid lines")
 	}
 	if worker.LastError() != nil {
 		t.Errorf("Expected LastError to be nil for parse warnings, got: %v", worker.LastError())
@@ -6,6 +6,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/beadsview"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 
 	"github.com/charmbracelet/bubbles/viewport"
@@ -20,6 +22,9 @@ type BoardModel struct {
 	focusedCol   int    // Index into activeColIdx
 	selectedRow  [4]int // Store selection for each column
 	theme        Theme
+	now          func() time.Time // Clock for relative ages
+	wip          wipPolicy        // Limits of the status columns
+	order        beadsview.Sort   // Card order in each column; the zero Sort is priority, then newest
 
 	// Swimlane grouping mode (bv-wjs0)
 	swimLaneMode SwimLaneMode
@@ -73,6 +78,24 @@ const (
 	ColClosed     = 3
 )
 
+// statusColumnTitles are the column headers of the status swimlanes.
+var statusColumnTitles = [4]string{"OPEN", "IN PROGRESS", "BLOCKED", "CLOSED"}
+
+// statusColumn returns the status column of the board an issue with
+// status belongs in.
+func statusColumn(status model.Status) int {
+	switch {
+	case isClosedLikeStatus(status):
+		return ColClosed
+	case status == model.StatusInProgress:
+		return ColInProgress
+	case status == model.StatusBlocked:
+		return ColBlocked
+	default:
+		return ColOpen
+	}
+}
+
 // SwimLaneMode determines how cards are grouped into columns (bv-wjs0)
 type SwimLaneMode int
 
@@ -95,7 +118,7 @@ type ColumnStats struct {
 }
 
 // computeColumnStats calculates statistics for issues in a column (bv-nl8a)
-func computeColumnStats(issues []model.Issue, issueMap map[string]*model.Issue) ColumnStats {
+func computeColumnStats(issues []model.Issue, issueMap map[string]*model.Issue, now time.Time) ColumnStats {
 	stats := ColumnStats{Total: len(issues)}
 
 	var oldest time.Time
@@ -134,7 +157,7 @@ func computeColumnStats(issues []model.Issue, issueMap map[string]*model.Issue)
 	}
 
 	if !oldest.IsZero() {
-		stats.OldestAge = time.Since(oldest)
+		stats.OldestAge = now.Sub(oldest)
 	}
 
 	return stats
@@ -275,18 +298,7 @@ func groupIssuesByMode(issues []model.Issue, mode SwimLaneMode) [4][]model.Issue
 		switch mode {
 		case SwimByStatus:
 			// Default: Open | In Progress | Blocked | Closed
-			switch {
-			case isClosedLikeStatus(issue.Status):
-				colIdx = 3
-			case issue.Status == model.StatusOpen:
-				colIdx = 0
-			case issue.Status == model.StatusInProgress:
-				colIdx = 1
-			case issue.Status == model.StatusBlocked:
-				colIdx = 2
-			default:
-				colIdx = 0
-			}
+			colIdx = statusColumn(issue.Status)
 		case SwimByPriority:
 			// P0 Critical | P1 High | P2 Medium | P3+ Other
 			switch {
@@ -350,6 +362,29 @@ func (b *BoardModel) CycleSwimLaneMode() {
 	b.regroupIssues()
 }
 
+// SetSort orders the cards of each column by s.
+func (b *BoardModel) SetSort(s beadsview.Sort) {
+	b.order = s
+	b.regroupIssues()
+}
+
+// sortColumns orders the cards of each column by the board's sort. The
+// columns may be shared with a snapshot, so they are copied, not sorted in
+// place.
+func (b *BoardModel) sortColumns() {
+	if mode, ok := b.order.Mode(); ok && mode == beadsview.SortDefault {
+		return
+	}
+	now := clockNow(b.now)
+	for i, col := range b.columns {
+		sorted := make([]model.Issue, len(col))
+		for newIdx, oldIdx := range b.order.Order(col, now) {
+			sorted[newIdx] = col[oldIdx]
+		}
+		b.columns[i] = sorted
+	}
+}
+
 // regroupIssues rebuilds columns based on current swimlane mode (bv-wjs0)
 func (b *BoardModel) regroupIssues() {
 	if b.boardState != nil {
@@ -357,6 +392,7 @@ func (b *BoardModel) regroupIssues() {
 	} else {
 		b.columns = groupIssuesByMode(b.allIssues, b.swimLaneMode)
 	}
+	b.sortColumns()
 
 	// Reset selection to avoid out-of-bounds
 	for i := 0; i < 4; i++ {
@@ -384,7 +420,7 @@ func (b *BoardModel) getColumnHeaders() ([]string, []string) {
 		return []string{"BUG", "FEATURE", "TASK", "EPIC"},
 			[]string{"🐛", "✨", "📋", "🎯"}
 	default: // SwimByStatus
-		return []string{"OPEN", "IN PROGRESS", "BLOCKED", "CLOSED"},
+		return statusColumnTitles[:],
 			[]string{"📋", "🔄", "🚫", "✅"}
 	}
 }
@@ -411,6 +447,7 @@ func NewBoardModel(issues []model.Issue, theme Theme) BoardModel {
 		columns:      cols,
 		focusedCol:   0,
 		theme:        theme,
+		now:          time.Now,
 		swimLaneMode: SwimByStatus, // Default mode (bv-wjs0)
 		allIssues:    issues,       // Store for regrouping (bv-wjs0)
 		blocksIndex:  buildBlocksIndex(issues),
@@ -430,6 +467,7 @@ func (b *BoardModel) SetIssues(issues []model.Issue) {
 
 	// Group by current swimlane mode (bv-wjs0)
 	b.columns = groupIssuesByMode(issues, b.swimLaneMode)
+	b.sortColumns()
 
 	b.blocksIndex = buildBlocksIndex(issues) // Rebuild reverse dependency index (bv-1daf)
 
@@ -475,6 +513,7 @@ func (b *BoardModel) SetSnapshot(s *DataSnapshot) {
 	} else {
 		b.columns = groupIssuesByMode(s.Issues, b.swimLaneMode)
 	}
+	b.sortColumns()
 
 	// Prefer snapshot-precomputed reverse-dependency index when available.
 	if s.GraphLayout != nil && s.GraphLayout.Dependents != nil {
@@ -916,7 +955,7 @@ func (b BoardModel) View(width, height int) string {
 			Height(height).
 			Align(lipgloss.Center, lipgloss.Center).
 			Foreground(t.Secondary).
-			Render("No issues to display")
+			Render(i18n.T("No issues to display"))
 	}
 
 	// Calculate board width vs detail panel width (bv-r6kh)
@@ -988,7 +1027,7 @@ func (b BoardModel) View(width, height int) string {
 		issueCount := len(issues)
 
 		// Compute column statistics (bv-nl8a)
-		stats := computeColumnStats(issues, b.issueMap)
+		stats := computeColumnStats(issues, b.issueMap, clockNow(b.now))
 
 		// Build header text with adaptive stats based on terminal width (bv-nl8a)
 		// - Narrow (<100): Just count
@@ -996,6 +1035,12 @@ func (b BoardModel) View(width, height int) string {
 		// - Wide (>140): Full stats including oldest age
 		var headerText string
 		baseHeader := fmt.Sprintf("%s %s (%d)", columnEmoji[colIdx], columnTitles[colIdx], issueCount)
+		overLimit := false
+		if b.swimLaneMode == SwimByStatus && b.wip.limits[colIdx] > 0 {
+			// WIP limit: count/limit, highlighted past the limit
+			baseHeader = fmt.Sprintf("%s %s (%d/%d)", columnEmoji[colIdx], columnTitles[colIdx], issueCount, b.wip.limits[colIdx])
+			overLimit = b.wip.over(colIdx, issueCount)
+		}
 
 		if width < 100 {
 			// Narrow: just the base header
@@ -1045,7 +1090,11 @@ func (b BoardModel) View(width, height int) string {
 			Bold(true).
 			Padding(0, 1)
 
-		if isFocused {
+		if overLimit {
+			headerStyle = headerStyle.
+				Background(ColorDanger).
+				Foreground(lipgloss.AdaptiveColor{Light: "#FFFFFF", Dark: "#1a1a1a"})
+		} else if isFocused {
 			headerStyle = headerStyle.
 				Background(columnColors[colIdx]).
 				Foreground(lipgloss.AdaptiveColor{Light: "#FFFFFF", Dark: "#1a1a1a"})
@@ -1109,7 +1158,7 @@ func (b BoardModel) View(width, height int) string {
 				Align(lipgloss.Center, lipgloss.Center).
 				Foreground(t.Secondary).
 				Italic(true)
-			cards = append(cards, emptyStyle.Render("(empty)"))
+			cards = append(cards, emptyStyle.Render(i18n.T("(empty)")))
 		}
 
 		// Scroll indicator
@@ -1133,7 +1182,9 @@ func (b BoardModel) View(width, height int) string {
 			Padding(0, 1).
 			Border(lipgloss.RoundedBorder())
 
-		if isFocused {
+		if overLimit {
+			colStyle = colStyle.BorderForeground(ColorDanger)
+		} else if isFocused {
 			colStyle = colStyle.BorderForeground(columnColors[colIdx])
 		} else {
 			colStyle = colStyle.BorderForeground(t.Secondary)
@@ -1186,11 +1237,11 @@ func (b BoardModel) renderTitleBar(width int, t Theme) string {
 
 // getAgeColor returns a color based on issue age (bv-1daf)
 // green (<7d), yellow (7-30d), red (>30d stale)
-func getAgeColor(t time.Time) lipgloss.TerminalColor {
+func getAgeColor(t, now time.Time) lipgloss.TerminalColor {
 	if t.IsZero() {
 		return ColorMuted
 	}
-	days := int(time.Since(t).Hours() / 24)
+	days := int(now.Sub(t).Hours() / 24)
 	switch {
 	case days < 7:
 		return lipgloss.AdaptiveColor{Light: "#2e7d32", Dark: "#81c784"} // green
@@ -1304,11 +1355,11 @@ func (b BoardModel) renderCard(issue model.Issue, width int, selected bool, colI
 	displayID := truncateRunesHelper(issue.ID, maxIDLen, "…")
 
 	// Age indicator with color coding: green(<7d), yellow(7-30d), red(>30d)
-	ageText := FormatTimeRel(issue.UpdatedAt)
+	ageText := FormatTimeRel(issue.UpdatedAt, clockNow(b.now))
 	if len(ageText) > 6 {
 		ageText = truncateRunesHelper(ageText, 6, "")
 	}
-	ageColor := getAgeColor(issue.UpdatedAt)
+	ageColor := getAgeColor(issue.UpdatedAt, clockNow(b.now))
 	ageStyled := t.Renderer.NewStyle().Foreground(ageColor).Render(ageText)
 
 	line1 := fmt.Sprintf("%s %s %s %s",
@@ -1496,7 +1547,7 @@ func (b BoardModel) renderExpandedCard(issue model.Issue, width int, _, _ int) s
 		}
 	}
 	if len(blockingDeps) > 0 {
-		depLines = append(depLines, t.Renderer.NewStyle().Bold(true).Foreground(t.Blocked).Render("Blocked by:"))
+		depLines = append(depLines, t.Renderer.NewStyle().Bold(true).Foreground(t.Blocked).Render(i18n.T("Blocked by:")))
 		for _, dep := range blockingDeps {
 			blockerText := fmt.Sprintf("  • %s", dep.DependsOnID)
 			if blocker, ok := b.issueMap[dep.DependsOnID]; ok && blocker != nil {
@@ -1508,7 +1559,7 @@ func (b BoardModel) renderExpandedCard(issue model.Issue, width int, _, _ int) s
 
 	// Show what this blocks
 	if blockedIDs, ok := b.blocksIndex[issue.ID]; ok && len(blockedIDs) > 0 {
-		depLines = append(depLines, t.Renderer.NewStyle().Bold(true).Foreground(t.Feature).Render("Blocks:"))
+		depLines = append(depLines, t.Renderer.NewStyle().Bold(true).Foreground(t.Feature).Render(i18n.T("Blocks:")))
 		for _, blockedID := range blockedIDs {
 			blockedText := fmt.Sprintf("  • %s", blockedID)
 			if blocked, ok := b.issueMap[blockedID]; ok && blocked != nil {
@@ -1532,7 +1583,7 @@ func (b BoardModel) renderExpandedCard(issue model.Issue, width int, _, _ int) s
 	// ══════════════════════════════════════════════════════════════════════════
 	timeStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Italic(true)
 	timestamps := timeStyle.Render(fmt.Sprintf("Created: %s | Updated: %s",
-		FormatTimeRel(issue.CreatedAt), FormatTimeRel(issue.UpdatedAt)))
+		FormatTimeRel(issue.CreatedAt, clockNow(b.now)), FormatTimeRel(issue.UpdatedAt, clockNow(b.now))))
 
 	// ══════════════════════════════════════════════════════════════════════════
 	// ASSEMBLE CARD
@@ -1625,7 +1676,7 @@ func (b *BoardModel) renderDetailPanel(width, height int) string {
 				}
 			}
 			if len(blockingDeps) > 0 {
-				content.WriteString("**Blocked by:**\n")
+				content.WriteString("**" + i18n.T("Blocked by:") + "**\n")
 				for _, dep := range blockingDeps {
 					// Look up blocker info for richer display
 					if blocker, ok := b.issueMap[dep.DependsOnID]; ok && blocker != nil {
@@ -1640,7 +1691,7 @@ func (b *BoardModel) renderDetailPanel(width, height int) string {
 
 			// Show what this issue blocks (bv-kklp)
 			if blockedIDs, ok := b.blocksIndex[issue.ID]; ok && len(blockedIDs) > 0 {
-				content.WriteString("**Blocks:**\n")
+				content.WriteString("**" + i18n.T("Blocks:") + "**\n")
 				for _, blockedID := range blockedIDs {
 					if blocked, ok := b.issueMap[blockedID]; ok && blocked != nil {
 						content.WriteString(fmt.Sprintf("- %s: %s\n", blockedID, blocked.Title))
@@ -1660,8 +1711,8 @@ func (b *BoardModel) renderDetailPanel(width, height int) string {
 
 			// Timestamps
 			content.WriteString("\n---\n\n")
-			content.WriteString(fmt.Sprintf("*Created: %s*\n", FormatTimeRel(issue.CreatedAt)))
-			content.WriteString(fmt.Sprintf("*Updated: %s*\n", FormatTimeRel(issue.UpdatedAt)))
+			content.WriteString(fmt.Sprintf("*Created: %s*\n", FormatTimeRel(issue.CreatedAt, clockNow(b.now))))
+			content.WriteString(fmt.Sprintf("*Updated: %s*\n", FormatTimeRel(issue.UpdatedAt, clockNow(b.now))))
 
 			// Render with markdown
 			rendered := content.String()
@@ -1703,7 +1754,7 @@ func (b *BoardModel) renderDetailPanel(width, height int) string {
 		Foreground(t.Primary).
 		Width(width - 4).
 		Align(lipgloss.Center).
-		Render("DETAILS")
+		Render(i18n.T("DETAILS"))
 
 	return panelStyle.Render(lipgloss.JoinVertical(lipgloss.Left, titleBar, sb.String()))
 }
@@ -0,0 +1,152 @@
+package ui
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/beadsview"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/testutil"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// benchSizes are the database sizes of the render path benchmarks.
+var benchSizes = []int{1000, 10000, 100000}
+
+var (
+	benchIssuesMu    sync.Mutex
+	benchIssuesCache = map[int][]model.Issue{}
+)
+
+// benchIssues returns a synthetic database of n issues in chains of five,
+// so some are blocked and some ready. Generating 100k issues takes a while,
+// so each size is built once.
+func benchIssues(n int) []model.Issue {
+	benchIssuesMu.Lock()
+	defer benchIssuesMu.Unlock()
+	if issues, ok := benchIssuesCache[n]; ok {
+		return issues
+	}
+	issues := testutil.QuickDisconnected(n/5, 5)
+	benchIssuesCache[n] = issues
+	return issues
+}
+
+// benchModel returns a list view of n issues in a 120x40 terminal.
+func benchModel(n int) Model {
+	m := NewModel(copyIssues(benchIssues(n)), nil, "")
+	tm, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	return tm.(Model)
+}
+
+// benchLoad parses a beads file of n issues.
+func benchLoad(n int) func(b *testing.B) {
+	return func(b *testing.B) {
+		data := []byte(testutil.ToJSONL(benchIssues(n)))
+		b.SetBytes(int64(len(data)))
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			issues, err := loader.ParseIssuesWithOptions(bytes.NewReader(data), loader.ParseOptions{WarningHandler: func(string) {}})
+			if err != nil || len(issues) != n {
+				b.Fatalf("parsed %d issues, %v", len(issues), err)
+			}
+		}
+	}
+}
+
+// benchFilter rebuilds the list of n issues for a filter.
+func benchFilter(n int) func(b *testing.B) {
+	return func(b *testing.B) {
+		m := benchModel(n)
+		m.currentFilter = "ready"
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			m.applyFilter()
+		}
+	}
+}
+
+// benchSort re-sorts the list of n issues.
+func benchSort(n int) func(b *testing.B) {
+	return func(b *testing.B) {
+		m := benchModel(n)
+		orders := []string{"priority", "-created"}
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			order, _ := beadsview.ParseSort(orders[i%len(orders)])
+			m.setSort("list", order)
+		}
+	}
+}
+
+// benchFrame moves the selection in the list of n issues and renders the
+// frame, as a keypress does.
+func benchFrame(n int) func(b *testing.B) {
+	return func(b *testing.B) {
+		m := benchModel(n)
+		keys := []tea.KeyMsg{runeKey("j"), runeKey("k")}
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			tm, _ := m.Update(keys[i%len(keys)])
+			m = tm.(Model)
+			_ = m.View()
+		}
+	}
+}
+
+func BenchmarkRenderPath(b *testing.B) {
+	for _, n := range benchSizes {
+		b.Run(fmt.Sprintf("load/issues=%d", n), benchLoad(n))
+		b.Run(fmt.Sprintf("filter/issues=%d", n), benchFilter(n))
+		b.Run(fmt.Sprintf("sort/issues=%d", n), benchSort(n))
+		b.Run(fmt.Sprintf("frame/issues=%d", n), benchFrame(n))
+	}
+}
+
+// TestPerformanceBudgets fails when the render path on 10,000 issues takes
+// longer than its budget (see frame_stats.go). BV_PERF_BUDGET_SCALE
+// multiplies the budgets, for slow machines or -race.
+func TestPerformanceBudgets(t *testing.T) {
+	if testing.Short() {
+		t.Skip("performance budgets are measured without -short")
+	}
+	scale := 1.0
+	if v := os.Getenv("BV_PERF_BUDGET_SCALE"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil || f <= 0 {
+			t.Fatalf("BV_PERF_BUDGET_SCALE=%q: want a positive number", v)
+		}
+		scale = f
+	}
+
+	const n = 10000
+	budgets := []struct {
+		name   string
+		bench  func(n int) func(b *testing.B)
+		budget time.Duration
+	}{
+		{"load", benchLoad, loadBudget},
+		{"filter", benchFilter, filterBudget},
+		{"sort", benchSort, sortBudget},
+		{"frame", benchFrame, frameBudget},
+	}
+	for _, tt := range budgets {
+		t.Run(tt.name, func(t *testing.T) {
+			result := testing.Benchmark(tt.bench(n))
+			took := time.Duration(result.NsPerOp())
+			if limit := time.Duration(float64(tt.budget) * scale); took > limit {
+				t.Errorf("%s of %d issues took %s, over its budget of %s", tt.name, n, took, limit)
+			}
+		})
+	}
+}
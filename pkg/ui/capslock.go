@@ -3,6 +3,7 @@ package ui
 import (
 	"time"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -16,15 +17,18 @@ import (
 // Our Strategy:
 // 1. Primary path: ? (help) → Space → Tutorial (always works)
 // 2. Direct shortcut: ` (backtick) → Tutorial (reliable alternative)
-// 3. CapsLock detection: Best-effort for terminals that do pass it through
+// 3. CapsLock detection: on terminals speaking the kitty keyboard protocol
+//    (see kitty_keyboard.go), where a single tap opens the tutorial and a
+//    double tap the help for the current view
 //
 // Terminal Compatibility:
 // - macOS Terminal.app: CapsLock intercepted by OS
 // - iTerm2: CapsLock intercepted by OS (can be remapped in settings)
 // - Linux xterm/rxvt: Usually intercepted by X11
 // - Windows Terminal: Usually intercepted by OS
-// - Kitty: Can be configured to send CapsLock
-// - Alacritty: Can be configured to send CapsLock
+// - kitty, foot, WezTerm, Ghostty, Alacritty 0.13+: report CapsLock under
+//   the kitty keyboard protocol, which bv turns on when the terminal
+//   answers its probe
 
 // TutorialTriggerKey defines the key used to trigger the tutorial directly.
 // Default is backtick (`) since CapsLock is unreliable.
@@ -34,6 +38,10 @@ const TutorialTriggerKey = "`"
 // Default is tilde (~) as it's Shift+backtick, easy to remember.
 const ContextHelpTriggerKey = "~"
 
+// KeyCapsLock is the key type of a CapsLock press. Bubble Tea has none, so
+// it lies outside the range of its key types.
+const KeyCapsLock tea.KeyType = -1 << 10
+
 // TutorialTrigger represents what action to take when tutorial key is pressed.
 type TutorialTrigger int
 
@@ -135,28 +143,11 @@ func (c *CapsLockTracker) IsPending() bool {
 	return c.pending
 }
 
-// IsCapsLock attempts to detect if a key message is CapsLock.
-// This is best-effort and may not work on all terminals.
-//
-// Returns false if uncertain - users should use the alternative trigger key.
+// IsCapsLock reports whether a key message is CapsLock. Only terminals
+// speaking the kitty keyboard protocol report it; elsewhere users have the
+// backtick instead.
 func IsCapsLock(msg tea.KeyMsg) bool {
-	// Most terminals don't pass CapsLock through, so this is conservative.
-	// We check for patterns that some terminals might use.
-
-	// Some terminals might send CapsLock as a specific escape sequence
-	// or with a particular key type. In practice, this rarely works.
-
-	// Check if it looks like a potential CapsLock event
-	// Note: msg.String() returns "" for unknown keys
-	if msg.Type == tea.KeyRunes && len(msg.Runes) == 0 {
-		// Empty runes could indicate a modifier-only key
-		return false // Still too ambiguous
-	}
-
-	// Kitty terminal protocol might send CapsLock as a specific sequence
-	// but BubbleTea doesn't expose this directly
-
-	return false // Conservative: assume CapsLock not detected
+	return msg.Type == KeyCapsLock
 }
 
 // IsTutorialTrigger checks if the key message is the tutorial trigger key.
@@ -173,7 +164,7 @@ func IsContextHelpTrigger(msg tea.KeyMsg) bool {
 
 // GetTriggerKeyHint returns a user-friendly hint for the trigger keys.
 func GetTriggerKeyHint() string {
-	return TutorialTriggerKey + " tutorial | " + ContextHelpTriggerKey + " context help"
+	return TutorialTriggerKey + " " + i18n.T("tutorial") + " | " + ContextHelpTriggerKey + " " + i18n.T("context help")
 }
 
 // TutorialKeyBindings holds configurable key bindings for tutorial access.
@@ -171,7 +171,6 @@ func TestTutorialTrigger_String(t *testing.T) {
 }
 
 func TestIsCapsLock(t *testing.T) {
-	// CapsLock is not reliably detectable, so function should be conservative
 	msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}}
 	if IsCapsLock(msg) {
 		t.Error("Regular key should not be detected as CapsLock")
@@ -182,6 +181,11 @@ func TestIsCapsLock(t *testing.T) {
 	if IsCapsLock(msg) {
 		t.Error("Empty runes should not be detected as CapsLock (too ambiguous)")
 	}
+
+	// What the kitty keyboard protocol reports
+	if !IsCapsLock(tea.KeyMsg{Type: KeyCapsLock}) {
+		t.Error("KeyCapsLock should be detected as CapsLock")
+	}
 }
 
 func TestIsTutorialTrigger(t *testing.T) {
@@ -0,0 +1,264 @@
+package ui
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/hooks"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Quick capture
+//
+// "+" (or :new) opens a small form for a new issue from any view. The issue
+// is created with bd create, like the other writes, and the issue-created
+// hooks run for it; once live reload brings it in, it is selected in the
+// list. ctrl+s keeps it as a local draft instead (see drafts.go).
+
+// Capture form fields, in tab order.
+const (
+	captureTitle = iota
+	capturePriority
+	captureParent
+	captureBlocker
+	captureFieldCount
+)
+
+// captureForm is the state of the quick capture form.
+type captureForm struct {
+	fields [captureFieldCount]textinput.Model
+	focus  int
+	err    string // Why the last submit was refused
+}
+
+// issueCreatedMsg reports the outcome of bd create for draft.
+type issueCreatedMsg struct {
+	id    string
+	draft Draft // ID is 0 unless it was a saved draft
+	err   error
+}
+
+func newCaptureForm(theme Theme, title, parent string) captureForm {
+	var f captureForm
+	for i := range f.fields {
+		ti := textinput.New()
+		ti.Prompt = ""
+		ti.TextStyle = lipgloss.NewStyle().Foreground(theme.Base.GetForeground())
+		ti.CharLimit = 40
+		f.fields[i] = ti
+	}
+	f.fields[captureTitle].CharLimit = 200
+	f.fields[captureTitle].Placeholder = "What needs doing?"
+	f.fields[captureTitle].SetValue(title)
+	f.fields[capturePriority].Placeholder = "0-4"
+	f.fields[capturePriority].SetValue("2")
+	f.fields[captureParent].Placeholder = "none"
+	f.fields[captureParent].SetValue(parent)
+	f.fields[captureBlocker].Placeholder = "none"
+	f.fields[captureTitle].Focus()
+	return f
+}
+
+// captureParentFor is the parent a new issue gets by default: the selected
+// epic, or the selected issue's own parent.
+func captureParentFor(issue *model.Issue) string {
+	if issue == nil {
+		return ""
+	}
+	if issue.IssueType == model.TypeEpic {
+		return issue.ID
+	}
+	for _, dep := range issue.Dependencies {
+		if dep != nil && dep.Type == model.DepParentChild {
+			return dep.DependsOnID
+		}
+	}
+	return ""
+}
+
+// openCapture opens the quick capture form, with title pre-filled.
+func (m *Model) openCapture(title string) {
+	if m.readOnly {
+		m.statusMsg = i18n.T("Read-only session: cannot create issues")
+		m.statusIsError = true
+		return
+	}
+	if !bdAvailable() {
+		m.reportError(newError(errConfig, "create issue", errors.New("bd not found on PATH")).
+			withHint("install the beads CLI to create issues from bv"))
+		return
+	}
+	m.capture = newCaptureForm(m.theme, title, captureParentFor(m.actionTarget()))
+	m.showCapture = true
+}
+
+// handleCaptureKeys edits the form. Enter creates the issue from any field
+// and ctrl+s saves it as a draft.
+func (m Model) handleCaptureKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	f := &m.capture
+	switch msg.String() {
+	case "esc":
+		m.showCapture = false
+		return m, nil
+	case "tab", "down":
+		f.setFocus((f.focus + 1) % captureFieldCount)
+		return m, nil
+	case "shift+tab", "up":
+		f.setFocus((f.focus + captureFieldCount - 1) % captureFieldCount)
+		return m, nil
+	case "enter":
+		d, err := m.captureDraft()
+		if err != nil {
+			f.err = err.Error()
+			return m, nil
+		}
+		m.showCapture = false
+		m.statusMsg = i18n.T("Creating issue…")
+		m.statusIsError = false
+		return m, m.createIssueCmd(d)
+	case "ctrl+s":
+		d, err := m.captureDraft()
+		if err != nil {
+			f.err = err.Error()
+			return m, nil
+		}
+		m.showCapture = false
+		m.saveDraft(d)
+		return m, nil
+	}
+	var cmd tea.Cmd
+	f.fields[f.focus], cmd = f.fields[f.focus].Update(msg)
+	return m, cmd
+}
+
+func (f *captureForm) setFocus(i int) {
+	f.fields[f.focus].Blur()
+	f.focus = i
+	f.fields[i].Focus()
+}
+
+// captureDraft checks the form and returns the issue it describes.
+func (m Model) captureDraft() (Draft, error) {
+	value := func(i int) string { return strings.TrimSpace(m.capture.fields[i].Value()) }
+
+	d := Draft{Title: value(captureTitle), Parent: value(captureParent), Blocker: value(captureBlocker)}
+	if d.Title == "" {
+		return d, errors.New("a title is required")
+	}
+	priority, err := strconv.Atoi(strings.TrimPrefix(strings.ToUpper(value(capturePriority)), "P"))
+	if err != nil || priority < 0 || priority > 4 {
+		return d, fmt.Errorf("priority must be 0-4, got %q", value(capturePriority))
+	}
+	d.Priority = priority
+	return d, m.checkDraft(d)
+}
+
+// checkDraft refuses a parent or blocker that is not a known issue.
+func (m Model) checkDraft(d Draft) error {
+	if _, ok := m.issueMap[d.Parent]; d.Parent != "" && !ok {
+		return fmt.Errorf("unknown parent %q", d.Parent)
+	}
+	if _, ok := m.issueMap[d.Blocker]; d.Blocker != "" && !ok {
+		return fmt.Errorf("unknown blocker %q", d.Blocker)
+	}
+	return nil
+}
+
+// createIssueCmd runs bd create for d and reads the new ID from its JSON
+// output.
+func (m Model) createIssueCmd(d Draft) tea.Cmd {
+	dir, life := m.workDir, m.life
+	return func() tea.Msg {
+		out, err := life.runWrite(dir, d.createArgs()...)
+		if err != nil {
+			return issueCreatedMsg{draft: d, err: newError(errData, "create issue", err)}
+		}
+		var created struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal([]byte(out), &created); err != nil || created.ID == "" {
+			return issueCreatedMsg{draft: d, err: newError(errData, "create issue", fmt.Errorf("unexpected bd create output %q", out))}
+		}
+		return issueCreatedMsg{id: created.ID, draft: d}
+	}
+}
+
+// issueCreated records a successful bd create: a published draft is
+// dropped, and the issue-created hooks run for the new issue.
+func (m *Model) issueCreated(msg issueCreatedMsg) tea.Cmd {
+	m.statusMsg = i18n.Tf("✅ Created %s, waiting for reload", msg.id)
+	m.statusIsError = false
+	if msg.draft.ID != 0 {
+		if err := m.drafts.Remove(msg.draft.ID); err != nil {
+			m.reportError(newError(errData, "delete published draft", err))
+		}
+	}
+	m.capturedID = msg.id
+	m.focusCaptured()
+
+	createdHooks, err := m.projectHooks(hooks.IssueCreated)
+	if err != nil {
+		m.reportError(newError(errHook, "load hooks", err))
+		return nil
+	}
+	issue := model.Issue{ID: msg.id, Title: msg.draft.Title, Status: model.StatusOpen, Priority: msg.draft.Priority}
+	return runIssueHooksCmd(m.life, hooks.IssueCreated, createdHooks, issue, hooks.RunIssueCreatedHook)
+}
+
+// focusCaptured selects the issue created last, once it has been loaded.
+func (m *Model) focusCaptured() {
+	id := m.capturedID
+	if id == "" {
+		return
+	}
+	if _, ok := m.issueMap[id]; !ok {
+		return
+	}
+	m.capturedID = ""
+	*m = m.gotoIssue(id)
+	if !m.statusIsError {
+		m.statusMsg = i18n.Tf("✅ Created %s", id)
+	}
+}
+
+func (m Model) renderCapture() string {
+	t := m.theme
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 3)
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	labelStyle := t.Renderer.NewStyle().Foreground(t.Subtext).Width(12)
+	focusStyle := labelStyle.Foreground(t.Primary).Bold(true)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	errStyle := t.Renderer.NewStyle().Foreground(t.Blocked)
+
+	width := max(min(m.width-20, 60), 20)
+	labels := [captureFieldCount]string{i18n.T("Title"), i18n.T("Priority"), i18n.T("Parent"), i18n.T("Blocked by")}
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(i18n.T("New issue")) + "\n\n")
+	for i := range m.capture.fields {
+		field := m.capture.fields[i]
+		field.Width = width
+		label := labelStyle.Render(labels[i])
+		if i == m.capture.focus {
+			label = focusStyle.Render(labels[i])
+		}
+		sb.WriteString(label + field.View() + "\n")
+	}
+	if m.capture.err != "" {
+		sb.WriteString("\n" + errStyle.Render(m.capture.err) + "\n")
+	}
+	sb.WriteString("\n" + mutedStyle.Render(i18n.T("tab: next field • enter: create with bd • ctrl+s: save as local draft • esc: cancel")))
+
+	return lipgloss.Place(m.width, m.height-1, lipgloss.Center, lipgloss.Center, boxStyle.Render(sb.String()))
+}
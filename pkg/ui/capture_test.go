@@ -0,0 +1,118 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func typeText(m Model, s string) Model {
+	for _, r := range s {
+		m = pressKey(m, runeKey(string(r)))
+	}
+	return m
+}
+
+func TestCapture_CreatesAndFocusesIssue(t *testing.T) {
+	fakeBD(t, `echo "$@" > args; echo '{"id":"A-4","title":"Fix login"}'`)
+	m := commandTestModel(t)
+	m.workDir = t.TempDir()
+
+	m = pressKey(m, runeKey("+"))
+	if m.CurrentContext() != ContextCapture {
+		t.Fatalf("expected the capture form, got %s", m.CurrentContext())
+	}
+	m = typeText(m, "Fix login")
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyTab})
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyBackspace})
+	m = typeText(m, "1")
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyTab})
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyTab})
+	m = typeText(m, "A-9")
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.showCapture || !strings.Contains(m.View(), `unknown blocker "A-9"`) {
+		t.Fatal("expected an unknown blocker to be refused in the form")
+	}
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyBackspace})
+	m = typeText(m, "2")
+
+	newM, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = newM.(Model)
+	if m.showCapture || cmd == nil {
+		t.Fatal("expected enter to close the form and run bd")
+	}
+	newM, _ = m.Update(cmd())
+	m = newM.(Model)
+	if m.capturedID != "A-4" {
+		t.Fatalf("expected A-4 to wait for the reload, got %q (status %q)", m.capturedID, m.statusMsg)
+	}
+	args, err := os.ReadFile(filepath.Join(m.workDir, "args"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.TrimSpace(string(args)); got != "create Fix login --priority 1 --json --deps blocks:A-2" {
+		t.Errorf("unexpected bd arguments %q", got)
+	}
+
+	// A filter hiding the new issue is cleared to show it
+	m.currentFilter = "closed"
+	m.applyFilter()
+	issues := append([]model.Issue(nil), m.issues...)
+	issues = append(issues, model.Issue{ID: "A-4", Title: "Fix login", Status: model.StatusOpen, Priority: 1})
+	newM, _ = m.Update(SnapshotReadyMsg{Snapshot: NewSnapshotBuilder(issues).Build()})
+	m = newM.(Model)
+	if item, ok := m.list.SelectedItem().(IssueItem); !ok || item.Issue.ID != "A-4" {
+		t.Fatalf("expected the new issue selected, got %v", m.list.SelectedItem())
+	}
+	if m.capturedID != "" || !strings.Contains(m.statusMsg, "Created A-4") {
+		t.Errorf("expected the capture finished, got %q", m.statusMsg)
+	}
+}
+
+func TestCapture_PrefillsParentFromContext(t *testing.T) {
+	epic := model.Issue{ID: "E-1", IssueType: model.TypeEpic}
+	child := model.Issue{ID: "T-1", Dependencies: []*model.Dependency{
+		{IssueID: "T-1", DependsOnID: "A-1", Type: model.DepBlocks},
+		{IssueID: "T-1", DependsOnID: "E-1", Type: model.DepParentChild},
+	}}
+	for _, tc := range []struct {
+		issue *model.Issue
+		want  string
+	}{
+		{nil, ""},
+		{&epic, "E-1"},
+		{&child, "E-1"},
+		{&model.Issue{ID: "T-2"}, ""},
+	} {
+		if got := captureParentFor(tc.issue); got != tc.want {
+			t.Errorf("captureParentFor(%v) = %q, want %q", tc.issue, got, tc.want)
+		}
+	}
+}
+
+func TestCapture_ReadOnlyAndEsc(t *testing.T) {
+	fakeBD(t, "exit 0")
+	m := commandTestModel(t)
+	m, _, err := m.ExecCommand("new Write docs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := m.capture.fields[captureTitle].Value(); got != "Write docs" {
+		t.Errorf("expected the title from :new, got %q", got)
+	}
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.showCapture {
+		t.Error("expected esc to cancel")
+	}
+
+	m.readOnly = true
+	m = pressKey(m, runeKey("+"))
+	if m.showCapture || !m.statusIsError {
+		t.Error("expected read-only sessions to refuse to create issues")
+	}
+}
@@ -2,13 +2,13 @@ package ui
 
 import (
 	"fmt"
-	"os/exec"
-	"runtime"
 	"strings"
 	"time"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/cass"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+	"github.com/atotto/clipboard"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -72,7 +72,7 @@ func (m CassSessionModal) Update(msg tea.Msg) (CassSessionModal, tea.Cmd) {
 			}
 		case "y":
 			// Copy search command to clipboard
-			if err := copyToClipboard(m.searchCmd); err == nil {
+			if err := clipboard.WriteAll(m.searchCmd); err == nil {
 				m.copied = true
 				m.copiedAt = time.Now()
 			}
@@ -130,14 +130,14 @@ func (m CassSessionModal) View() string {
 	var b strings.Builder
 
 	// Header
-	b.WriteString(headerStyle.Render("📎 Related Coding Sessions"))
+	b.WriteString(headerStyle.Render(i18n.T("📎 Related Coding Sessions")))
 	b.WriteString("  ")
 	b.WriteString(beadIDStyle.Render(m.beadID))
 	b.WriteString("\n\n")
 
 	// Sessions
 	if len(m.sessions) == 0 {
-		b.WriteString(matchReasonStyle.Render("No correlated sessions found."))
+		b.WriteString(matchReasonStyle.Render(i18n.T("No correlated sessions found.")))
 		b.WriteString("\n\n")
 	} else {
 		displayCount := len(m.sessions)
@@ -241,9 +241,7 @@ func (m CassSessionModal) formatSnippet(snippet string) string {
 		}
 		// Truncate long lines
 		maxLineLen := m.width - 14 // Account for box padding
-		if len(line) > maxLineLen {
-			line = line[:maxLineLen-3] + "..."
-		}
+		line = truncateRunesHelper(line, maxLineLen, "...")
 		cleaned = append(cleaned, line)
 		if len(cleaned) >= 3 {
 			break
@@ -344,43 +342,3 @@ func (m CassSessionModal) CenterModal(termWidth, termHeight int) string {
 
 	return centered
 }
-
-// copyToClipboard copies text to the system clipboard.
-// It uses platform-specific commands and fails silently if unavailable.
-func copyToClipboard(text string) error {
-	var cmd *exec.Cmd
-
-	switch runtime.GOOS {
-	case "darwin":
-		cmd = exec.Command("pbcopy")
-	case "linux":
-		// Try xclip first, then xsel
-		if _, err := exec.LookPath("xclip"); err == nil {
-			cmd = exec.Command("xclip", "-selection", "clipboard")
-		} else if _, err := exec.LookPath("xsel"); err == nil {
-			cmd = exec.Command("xsel", "--clipboard", "--input")
-		} else {
-			return fmt.Errorf("no clipboard utility found")
-		}
-	case "windows":
-		cmd = exec.Command("clip")
-	default:
-		return fmt.Errorf("unsupported platform: %s", runtime.GOOS)
-	}
-
-	stdin, err := cmd.StdinPipe()
-	if err != nil {
-		return err
-	}
-
-	if err := cmd.Start(); err != nil {
-		return err
-	}
-
-	if _, err := stdin.Write([]byte(text)); err != nil {
-		return err
-	}
-	stdin.Close()
-
-	return cmd.Wait()
-}
@@ -0,0 +1,833 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/beadsview"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TUI commands are small textual actions ("filter ready", "view board") that
+// drive the same state changes as the keybindings. They let users script the
+// initial state of a session (--cmd / ui.startup_commands) and are typed at
+// the ":" prompt. User aliases (ui.aliases) name a sequence of commands.
+
+// tuiCommand describes one named command.
+type tuiCommand struct {
+	name    string
+	usage   string
+	summary string
+	// validate checks arguments without needing a Model, so bad scripts can
+	// be rejected before the TUI starts. Nil means any arguments are accepted.
+	validate func(args []string) error
+	// complete offers candidates for the argument being typed, given the
+	// ones before it (see CompleteCommand). Nil offers nothing.
+	complete func(d CompletionData, prev []string) []string
+	run      func(m Model, args []string) (Model, tea.Cmd, error)
+}
+
+// commandViewKeys maps view names to the key that opens them.
+var commandViewKeys = map[string]string{
+	"board":      "b",
+	"graph":      "g",
+	"tree":       "E",
+	"insights":   "i",
+	"actionable": "a",
+	"history":    "h",
+	"labels":     "[",
+	"attention":  "]",
+	"flow":       "f",
+	"treemap":    "#",
+	"timeline":   "@",
+}
+
+// tuiCommands is populated in init: the view command re-enters Update, which
+// would otherwise form an initialization cycle.
+var tuiCommands []tuiCommand
+
+func init() {
+	tuiCommands = []tuiCommand{
+		{
+			name:    "filter",
+			usage:   "filter <all|open|closed|ready|stale|watched|label:NAME|priority<=N|status:NAME|assignee:NAME|type:NAME|blocked:BOOL> [AND|OR|NOT|(...)]...",
+			summary: "Filter the issue list; terms side by side must all match",
+			validate: func(args []string) error {
+				return validateListFilter(strings.Join(args, " "))
+			},
+			complete: completeFilter,
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				m.setActiveRecipe(nil)
+				m.currentFilter = strings.Join(args, " ")
+				m.applyFilter()
+				return m, nil, nil
+			},
+		},
+		{
+			name:    "sort",
+			usage:   "sort <default|created|-created|priority|updated|votes|expression>[,...]",
+			summary: "Set the sort order of the current view; later terms break ties",
+			validate: func(args []string) error {
+				if len(args) == 0 {
+					return fmt.Errorf("expected a sort key or expression")
+				}
+				_, err := beadsview.ParseSort(strings.Join(args, " "))
+				return err
+			},
+			complete: completeOne(commandSortKeys),
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				order, _ := beadsview.ParseSort(strings.Join(args, " "))
+				m.setSort(m.sortView(), order)
+				return m, nil, nil
+			},
+		},
+		{
+			name:    "view",
+			usage:   "view <list|board|graph|tree|insights|actionable|history|labels|attention|flow|treemap|timeline>",
+			summary: "Switch to a view",
+			validate: func(args []string) error {
+				if len(args) != 1 {
+					return fmt.Errorf("expected one view")
+				}
+				if _, ok := commandViewKeys[args[0]]; !ok && args[0] != "list" {
+					return fmt.Errorf("unknown view %q", args[0])
+				}
+				return nil
+			},
+			complete: completeOne(commandViews),
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				m.clearAttentionOverlay()
+				m.isBoardView = false
+				m.isGraphView = false
+				m.isActionableView = false
+				m.isHistoryView = false
+				m.focused = focusList
+				key, ok := commandViewKeys[args[0]]
+				if !ok {
+					return m, nil, nil
+				}
+				// The view keys toggle, so open the view from the list state.
+				newM, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+				return newM.(Model), cmd, nil
+			},
+		},
+		{
+			name:    "search",
+			usage:   "search <text>",
+			summary: "Fuzzy-search the issue list",
+			validate: func(args []string) error {
+				if len(args) == 0 {
+					return fmt.Errorf("expected search text")
+				}
+				return nil
+			},
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				m.list.SetFilterText(strings.Join(args, " "))
+				return m, nil, nil
+			},
+		},
+		{
+			name:    "recipe",
+			usage:   "recipe <name>",
+			summary: "Apply a recipe",
+			validate: func(args []string) error {
+				if len(args) != 1 {
+					return fmt.Errorf("expected one recipe name")
+				}
+				return nil
+			},
+			complete: completeOne(CompletionData.recipes),
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				r := m.recipeLoader.Get(args[0])
+				if r == nil {
+					return m, nil, fmt.Errorf("unknown recipe %q", args[0])
+				}
+				m.setActiveRecipe(r)
+				m.applyRecipe(r)
+				return m, nil, nil
+			},
+		},
+		{
+			name:    "select",
+			usage:   "select <issue-id>",
+			summary: "Select an issue in the list",
+			validate: func(args []string) error {
+				if len(args) != 1 {
+					return fmt.Errorf("expected one issue ID")
+				}
+				return nil
+			},
+			complete: completeOne(CompletionData.issueIDs),
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				for i, it := range m.list.Items() {
+					if item, ok := it.(IssueItem); ok && item.Issue.ID == args[0] {
+						m.list.Select(i)
+						m.updateViewportContent()
+						return m, nil, nil
+					}
+				}
+				return m, nil, fmt.Errorf("issue %q not in the current list", args[0])
+			},
+		},
+		{
+			name:     "watch",
+			usage:    "watch [issue-id]",
+			summary:  "Watch an issue (default: the selection) for changes",
+			validate: optionalIssueArg,
+			complete: completeOne(CompletionData.issueIDs),
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				return m.watchCommand(args, true)
+			},
+		},
+		{
+			name:     "unwatch",
+			usage:    "unwatch [issue-id]",
+			summary:  "Stop watching an issue (default: the selection)",
+			validate: optionalIssueArg,
+			complete: completeOne(CompletionData.issueIDs),
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				return m.watchCommand(args, false)
+			},
+		},
+		{
+			name:     "impact",
+			usage:    "impact [issue-id]",
+			summary:  "Show what closing an issue (default: the selection) unblocks, and what blocks it",
+			validate: optionalIssueArg,
+			complete: completeOne(CompletionData.issueIDs),
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				return m.impactCommand(args)
+			},
+		},
+		{
+			name:    "duplicates",
+			usage:   "duplicates",
+			summary: "Review open issues that look alike and close duplicates",
+			validate: func(args []string) error {
+				if len(args) != 0 {
+					return fmt.Errorf("takes no arguments")
+				}
+				return nil
+			},
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				return m, m.openDuplicates(), nil
+			},
+		},
+		{
+			name:    "escalations",
+			usage:   "escalations",
+			summary: "Review the priorities the ui.escalation rules suggest, and accept or dismiss them",
+			validate: func(args []string) error {
+				if len(args) != 0 {
+					return fmt.Errorf("takes no arguments")
+				}
+				return nil
+			},
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				return m, nil, m.openEscalations()
+			},
+		},
+		{
+			name:    "activity",
+			usage:   "activity [hours]",
+			summary: "Show what each agent or person changed in the last hours (default 24), flagging mass closures and priority inflation",
+			validate: func(args []string) error {
+				if len(args) > 1 {
+					return fmt.Errorf("expected at most one number of hours")
+				}
+				if len(args) == 1 {
+					if n, err := strconv.Atoi(args[0]); err != nil || n < 1 || n > 24*365 {
+						return fmt.Errorf("hours must be 1 to 8760, got %q", args[0])
+					}
+				}
+				return nil
+			},
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				hours := defaultActivityHours
+				if len(args) == 1 {
+					hours, _ = strconv.Atoi(args[0])
+				}
+				m.openActivity(hours)
+				return m, nil, nil
+			},
+		},
+		{
+			name:     "critical",
+			usage:    "critical [issue-id|off]",
+			summary:  "Mark the critical path to finishing an epic (default: the selection) in the list and graph",
+			validate: optionalIssueArg,
+			complete: completeOne(CompletionData.criticalTargets),
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				return m.criticalPathCommand(args)
+			},
+		},
+		{
+			name:    "watched",
+			usage:   "watched",
+			summary: "Show recent changes to watched issues",
+			validate: func(args []string) error {
+				if len(args) != 0 {
+					return fmt.Errorf("takes no arguments")
+				}
+				return nil
+			},
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				m.openWatchFeed()
+				return m, nil, nil
+			},
+		},
+		{
+			name:    "drafts",
+			usage:   "drafts",
+			summary: "List local draft issues to publish or delete",
+			validate: func(args []string) error {
+				if len(args) != 0 {
+					return fmt.Errorf("takes no arguments")
+				}
+				return nil
+			},
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				m.openDrafts()
+				return m, nil, nil
+			},
+		},
+		{
+			name:    "pause",
+			usage:   "pause",
+			summary: "Pause auto-refresh; changes load on resume",
+			validate: func(args []string) error {
+				if len(args) != 0 {
+					return fmt.Errorf("takes no arguments")
+				}
+				return nil
+			},
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				err := m.setAutoRefreshPaused(true)
+				return m, nil, err
+			},
+		},
+		{
+			name:    "resume",
+			usage:   "resume",
+			summary: "Resume auto-refresh",
+			validate: func(args []string) error {
+				if len(args) != 0 {
+					return fmt.Errorf("takes no arguments")
+				}
+				return nil
+			},
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				err := m.setAutoRefreshPaused(false)
+				return m, nil, err
+			},
+		},
+		{
+			name:    "run",
+			usage:   "run <command>",
+			summary: "Run a user command (ui.commands) on the selected issue",
+			validate: func(args []string) error {
+				if len(args) != 1 {
+					return fmt.Errorf("expected one command name")
+				}
+				return nil
+			},
+			complete: completeOne(CompletionData.userCommands),
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				uc, ok := m.userCommand(args[0])
+				if !ok && len(m.userCommands) == 0 {
+					return m, nil, fmt.Errorf("unknown user command %q: none configured in ui.commands", args[0])
+				}
+				if !ok {
+					return m, nil, fmt.Errorf("unknown user command %q (configured: %s)", args[0], strings.Join(m.userCommandNames(), ", "))
+				}
+				target := m.actionTarget()
+				if target == nil {
+					return m, nil, fmt.Errorf("no issue selected")
+				}
+				m, cmd := m.runUserCommand(uc, *target)
+				return m, cmd, nil
+			},
+		},
+		{
+			name:    "errors",
+			usage:   "errors",
+			summary: "Show the errors reported since bv started",
+			validate: func(args []string) error {
+				if len(args) != 0 {
+					return fmt.Errorf("takes no arguments")
+				}
+				return nil
+			},
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				m.openDiagnostics()
+				return m, nil, nil
+			},
+		},
+		{
+			name:    "perf",
+			usage:   "perf",
+			summary: "Show or hide frame and update timings",
+			validate: func(args []string) error {
+				if len(args) != 0 {
+					return fmt.Errorf("takes no arguments")
+				}
+				return nil
+			},
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				m.togglePerf()
+				return m, nil, nil
+			},
+		},
+		{
+			name:    "config",
+			usage:   "config",
+			summary: "Show the effective configuration and where each value comes from",
+			validate: func(args []string) error {
+				if len(args) != 0 {
+					return fmt.Errorf("takes no arguments")
+				}
+				return nil
+			},
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				m.showConfig = true
+				m.configCursor = 0
+				return m, nil, nil
+			},
+		},
+		{
+			name:    "stats",
+			usage:   "stats",
+			summary: "Show your usage stats (ui.usage_stats)",
+			validate: func(args []string) error {
+				if len(args) != 0 {
+					return fmt.Errorf("takes no arguments")
+				}
+				return nil
+			},
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				m.showUsage = true
+				return m, nil, nil
+			},
+		},
+		{
+			name:    "log",
+			usage:   "log",
+			summary: "Show the debug console with recent log lines (F12)",
+			validate: func(args []string) error {
+				if len(args) != 0 {
+					return fmt.Errorf("takes no arguments")
+				}
+				return nil
+			},
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				m.openDebugConsole()
+				return m, nil, nil
+			},
+		},
+		{
+			name:    "record",
+			usage:   "record [register]",
+			summary: "Record the commands that follow into a register (a-z); alone, stop",
+			validate: func(args []string) error {
+				if len(args) > 1 || (len(args) == 1 && !validRegister(args[0])) {
+					return fmt.Errorf("expected a register a-z, or nothing to stop")
+				}
+				return nil
+			},
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				var err error
+				if len(args) == 0 {
+					m, err = m.stopRecording()
+				} else {
+					m, err = m.startRecording(args[0])
+				}
+				return m, nil, err
+			},
+		},
+		{
+			name:    "play",
+			usage:   "play <register> [count]",
+			summary: "Run the commands recorded in a register, count times",
+			validate: func(args []string) error {
+				if err := registerArg(args); err != nil {
+					return err
+				}
+				if len(args) > 2 {
+					return fmt.Errorf("expected a register and a count")
+				}
+				if len(args) == 2 {
+					if n, err := strconv.Atoi(args[1]); err != nil || n < 1 || n > 1000 {
+						return fmt.Errorf("count must be 1 to 1000, got %q", args[1])
+					}
+				}
+				return nil
+			},
+			complete: completeOne(CompletionData.macros),
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				count := 1
+				if len(args) == 2 {
+					count, _ = strconv.Atoi(args[1])
+				}
+				return m.playMacro(args[0], count)
+			},
+		},
+		{
+			name:    "each",
+			usage:   "each <register>",
+			summary: "Run a recorded macro on every issue in the list",
+			validate: func(args []string) error {
+				if len(args) != 1 {
+					return fmt.Errorf("expected one register")
+				}
+				return registerArg(args)
+			},
+			complete: completeOne(CompletionData.macros),
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				return m.eachMacro(args[0])
+			},
+		},
+		{
+			name:     "estimate",
+			usage:    "estimate <duration> [issue-id]",
+			summary:  "Set an issue's estimate (default: the selection), e.g. 90m, 3h or 2d",
+			validate: validateEstimateArgs,
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				return m.estimateCommand(args)
+			},
+		},
+		{
+			name:     "sprint",
+			usage:    "sprint [sprint-id | new <start> <end> <capacity> [name] | capacity <duration>]",
+			summary:  "Plan a sprint (default: the current one), or define one",
+			validate: validateSprintArgs,
+			complete: completeOne(CompletionData.sprints),
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				return m.sprintCommand(args)
+			},
+		},
+		{
+			name:     "page",
+			usage:    "page [issue|diff|export]",
+			summary:  "Show the selected issue, the history view's selected commit diff, or the export in a pager",
+			validate: validatePageArgs,
+			complete: completeOne(commandPageSources),
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				return m.pageCommand(args)
+			},
+		},
+		{
+			name:    "new",
+			usage:   "new [title]",
+			summary: "Create an issue with bd, starting from the quick capture form",
+			validate: func([]string) error {
+				return nil
+			},
+			run: func(m Model, args []string) (Model, tea.Cmd, error) {
+				m.openCapture(strings.Join(args, " "))
+				return m, nil, nil
+			},
+		},
+	}
+}
+
+func optionalIssueArg(args []string) error {
+	if len(args) > 1 {
+		return fmt.Errorf("expected at most one issue ID")
+	}
+	return nil
+}
+
+// watchCommand runs watch and unwatch: args name the issue, or the selection
+// is used.
+func (m Model) watchCommand(args []string, watch bool) (Model, tea.Cmd, error) {
+	var id string
+	if len(args) == 1 {
+		if _, ok := m.issueMap[args[0]]; !ok && watch {
+			return m, nil, fmt.Errorf("unknown issue %q", args[0])
+		}
+		id = args[0]
+	} else if target := m.actionTarget(); target != nil {
+		id = target.ID
+	} else {
+		return m, nil, fmt.Errorf("no issue selected")
+	}
+	m.setWatched(id, watch)
+	return m, nil, nil
+}
+
+func lookupCommand(name string) *tuiCommand {
+	for i := range tuiCommands {
+		if tuiCommands[i].name == name {
+			return &tuiCommands[i]
+		}
+	}
+	return nil
+}
+
+// parseCommand splits a command line into its command and arguments.
+func parseCommand(line string) (*tuiCommand, []string, error) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return nil, nil, fmt.Errorf("empty command")
+	}
+	cmd := lookupCommand(strings.ToLower(fields[0]))
+	if cmd == nil {
+		return nil, nil, fmt.Errorf("unknown command %q", fields[0])
+	}
+	args := fields[1:]
+	if cmd.validate != nil {
+		if err := cmd.validate(args); err != nil {
+			return nil, nil, fmt.Errorf("%s: %w (usage: %s)", cmd.name, err, cmd.usage)
+		}
+	}
+	return cmd, args, nil
+}
+
+// splitCommandLine normalizes a command line: a leading ":" (as typed at the
+// prompt) is dropped.
+func splitCommandLine(line string) []string {
+	return strings.Fields(strings.TrimPrefix(strings.TrimSpace(line), ":"))
+}
+
+// expandCommand resolves line to the builtin commands it stands for: itself,
+// or the body of the alias it names. Aliases take no arguments.
+func expandCommand(line string, aliases map[string]string) ([]string, error) {
+	fields := splitCommandLine(line)
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("empty command")
+	}
+	body, ok := aliases[fields[0]]
+	if !ok || lookupCommand(strings.ToLower(fields[0])) != nil {
+		return []string{strings.Join(fields, " ")}, nil
+	}
+	if len(fields) > 1 {
+		return nil, fmt.Errorf("alias %q takes no arguments", fields[0])
+	}
+	return splitAliasBody(body), nil
+}
+
+// splitAliasBody splits an alias body into its ";"-separated commands.
+func splitAliasBody(body string) []string {
+	var lines []string
+	for _, part := range strings.Split(body, ";") {
+		if part = strings.TrimSpace(part); part != "" {
+			lines = append(lines, part)
+		}
+	}
+	return lines
+}
+
+// ValidateAliases checks user-defined aliases: names must be single words that
+// do not shadow a builtin command, and bodies must be builtin commands (aliases
+// do not nest).
+func ValidateAliases(aliases map[string]string) error {
+	names := make([]string, 0, len(aliases))
+	for name := range aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if name == "" || strings.ContainsAny(name, " \t;:") {
+			return fmt.Errorf("alias %q: name must be a single word", name)
+		}
+		if lookupCommand(strings.ToLower(name)) != nil {
+			return fmt.Errorf("alias %q: shadows a builtin command", name)
+		}
+		lines := splitAliasBody(aliases[name])
+		if len(lines) == 0 {
+			return fmt.Errorf("alias %q: empty body", name)
+		}
+		for _, line := range lines {
+			if _, _, err := parseCommand(line); err != nil {
+				return fmt.Errorf("alias %q: %w", name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateCommand reports whether line is a well-formed TUI command or the
+// name of one of aliases.
+func ValidateCommand(line string, aliases map[string]string) error {
+	lines, err := expandCommand(line, aliases)
+	if err != nil {
+		return err
+	}
+	for _, l := range lines {
+		if _, _, err := parseCommand(l); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CommandUsage lists the available TUI commands, one per line.
+func CommandUsage() string {
+	var sb strings.Builder
+	for _, c := range tuiCommands {
+		sb.WriteString(fmt.Sprintf("  %-40s %s\n", c.usage, c.summary))
+	}
+	return sb.String()
+}
+
+// ExecCommand runs a TUI command, or every command of an alias, against the
+// model. An alias stops at its first failing command.
+func (m Model) ExecCommand(line string) (Model, tea.Cmd, error) {
+	lines, err := expandCommand(line, m.aliases)
+	if err != nil {
+		return m, nil, err
+	}
+	var cmds []tea.Cmd
+	for _, l := range lines {
+		c, args, err := parseCommand(l)
+		if err != nil {
+			return m, tea.Batch(cmds...), err
+		}
+		var cmd tea.Cmd
+		m, cmd, err = c.run(m, args)
+		cmds = append(cmds, cmd)
+		if err != nil {
+			return m, tea.Batch(cmds...), err
+		}
+	}
+	return m, tea.Batch(cmds...), nil
+}
+
+// SetAliases installs user-defined command aliases (name -> ";"-separated
+// commands). Callers should check them with ValidateAliases first.
+func (m *Model) SetAliases(aliases map[string]string) {
+	m.aliases = aliases
+}
+
+// startupCommandsMsg runs the startup script once the program is running.
+type startupCommandsMsg struct{}
+
+// SetStartupCommands queues commands to run after the TUI starts.
+func (m *Model) SetStartupCommands(lines []string) {
+	m.startupCommands = lines
+}
+
+// runStartupCommands restores the last session, if asked to, and executes
+// the queued startup commands in order. Failing commands are reported
+// together as a config error; the remaining ones still run.
+func (m Model) runStartupCommands() (Model, tea.Cmd) {
+	var cmds []tea.Cmd
+	var errs []error
+	if m.restoreState != nil {
+		var cmd tea.Cmd
+		m, cmd = m.restoreSession(*m.restoreState)
+		m.restoreState = nil
+		cmds = append(cmds, cmd)
+	}
+	for _, line := range m.startupCommands {
+		var cmd tea.Cmd
+		var err error
+		m, cmd, err = m.ExecCommand(line)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		cmds = append(cmds, cmd)
+	}
+	m.startupCommands = nil
+	if len(errs) > 0 {
+		m.reportError(newError(errConfig, "run startup commands", errors.Join(errs...)).
+			withHint("check --cmd and ui.startup_commands"))
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// openCommandPrompt shows the ":" prompt in place of the footer.
+func (m *Model) openCommandPrompt() {
+	m.showCommandPrompt = true
+	m.commandInput.SetValue("")
+	m.commandInput.SetSuggestions(nil)
+	m.commandInput.Width = m.width - 2
+	m.commandInput.Focus()
+	m.focusBeforeCommand = m.focused
+	m.focused = focusCommandPrompt
+}
+
+func (m *Model) closeCommandPrompt() {
+	m.showCommandPrompt = false
+	m.commandInput.Blur()
+	m.focused = m.focusBeforeCommand
+}
+
+// handleCommandPromptKeys handles input for the ":" prompt. Enter runs the
+// line as a command or alias; a bare issue ID jumps to that issue. Tab
+// accepts the suggested completion.
+func (m Model) handleCommandPromptKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		line := strings.TrimSpace(m.commandInput.Value())
+		m.closeCommandPrompt()
+		if line == "" {
+			return m, nil
+		}
+		return m.runPromptCommand(line)
+	case "esc":
+		m.closeCommandPrompt()
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.commandInput, cmd = m.commandInput.Update(msg)
+	m.commandInput.SetSuggestions(CompleteCommand(m.commandInput.Value(), m.completionData()))
+	return m, cmd
+}
+
+func (m Model) runPromptCommand(line string) (Model, tea.Cmd) {
+	m, cmd, err := m.execPromptLine(line)
+	if err != nil {
+		m.statusMsg = err.Error()
+		m.statusIsError = true
+		return m, cmd
+	}
+	m.macros.record(line)
+	return m, cmd
+}
+
+// execPromptLine runs line as typed at the prompt, where a bare issue ID
+// jumps to that issue.
+func (m Model) execPromptLine(line string) (Model, tea.Cmd, error) {
+	fields := splitCommandLine(line)
+	if len(fields) == 1 {
+		if _, isIssue := m.issueMap[fields[0]]; isIssue {
+			m.usage.recordCommand("goto")
+			return m.gotoIssue(fields[0]), nil, nil
+		}
+	}
+	m, cmd, err := m.ExecCommand(line)
+	if err != nil {
+		return m, cmd, err
+	}
+	m.usage.recordCommand(fields[0])
+	return m, cmd, nil
+}
+
+// gotoIssue selects id in the list, clearing the filter if it hides the issue.
+func (m Model) gotoIssue(id string) Model {
+	m.nav.jump = true
+	if next, _, err := m.ExecCommand("select " + id); err == nil {
+		return next
+	}
+	m.setActiveRecipe(nil)
+	m.currentFilter = "all"
+	m.applyFilter()
+	if next, _, err := m.ExecCommand("select " + id); err == nil {
+		return next
+	}
+	m.statusMsg = i18n.Tf("Issue %s is hidden by the repo filter", id)
+	m.statusIsError = true
+	return m
+}
+
+func (m Model) renderCommandPrompt() string {
+	return lipgloss.NewStyle().Width(m.width).Render(m.commandInput.View())
+}
@@ -0,0 +1,324 @@
+package ui
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/beadsview"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// commandTestModel is the 120x40 model most tests start from: A-1 "Old
+// open" (P2, labelled api), A-2 "New open" (P0) and A-3 "Done". Each edit
+// adds what a test needs to the issues, or more issues, before the model
+// is built.
+func commandTestModel(t testing.TB, edits ...func([]model.Issue) []model.Issue) Model {
+	t.Helper()
+	now := time.Now()
+	issues := []model.Issue{
+		{ID: "A-1", Title: "Old open", Status: model.StatusOpen, Priority: 2, CreatedAt: now.Add(-48 * time.Hour), Labels: []string{"api"}},
+		{ID: "A-2", Title: "New open", Status: model.StatusOpen, Priority: 0, CreatedAt: now.Add(-1 * time.Hour)},
+		{ID: "A-3", Title: "Done", Status: model.StatusClosed, Priority: 1, CreatedAt: now.Add(-24 * time.Hour)},
+	}
+	for _, edit := range edits {
+		issues = edit(issues)
+	}
+	m := NewModel(issues, nil, "")
+	newM, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	return newM.(Model)
+}
+
+func listIDs(m Model) []string {
+	var ids []string
+	for _, it := range m.list.Items() {
+		ids = append(ids, it.(IssueItem).Issue.ID)
+	}
+	return ids
+}
+
+func TestValidateCommand(t *testing.T) {
+	valid := []string{"filter ready", "filter label:api", "filter open priority:0", ":filter all", "sort -created", "view board", "view list", "search login bug", "recipe triage", "select A-1", "  FILTER open  "}
+	for _, line := range valid {
+		if err := ValidateCommand(line, nil); err != nil {
+			t.Errorf("%q: unexpected error %v", line, err)
+		}
+	}
+	invalid := []string{"", "explode", "filter", "filter blocked", "filter label:", "filter priority:9", "filter open priority:x", "sort sideways", "view nowhere", "search", "select"}
+	for _, line := range invalid {
+		if err := ValidateCommand(line, nil); err == nil {
+			t.Errorf("%q: expected error", line)
+		}
+	}
+}
+
+func TestExecCommand_FilterAndSort(t *testing.T) {
+	m := commandTestModel(t)
+
+	m, _, err := m.ExecCommand("filter open")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, _, err = m.ExecCommand("sort -created")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(listIDs(m), ","); got != "A-2,A-1" {
+		t.Fatalf("expected open issues newest first, got %s", got)
+	}
+
+	m, _, _ = m.ExecCommand("filter label:api")
+	if got := strings.Join(listIDs(m), ","); got != "A-1" {
+		t.Fatalf("expected label filter, got %s", got)
+	}
+}
+
+func TestExecCommand_ViewIsIdempotent(t *testing.T) {
+	m := commandTestModel(t)
+
+	m, _, _ = m.ExecCommand("view board")
+	m, _, _ = m.ExecCommand("view board")
+	if !m.isBoardView || m.focused != focusBoard {
+		t.Fatal("expected repeated view board to stay on the board")
+	}
+
+	m, _, _ = m.ExecCommand("view graph")
+	if m.isBoardView || !m.isGraphView {
+		t.Fatal("expected switch from board to graph")
+	}
+
+	m, _, _ = m.ExecCommand("view list")
+	if m.isGraphView || m.focused != focusList {
+		t.Fatal("expected view list to return to the list")
+	}
+}
+
+func TestExecCommand_SelectAndErrors(t *testing.T) {
+	m := commandTestModel(t)
+
+	m, _, err := m.ExecCommand("select A-3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sel := m.list.SelectedItem().(IssueItem); sel.Issue.ID != "A-3" {
+		t.Fatalf("expected A-3 selected, got %s", sel.Issue.ID)
+	}
+
+	if _, _, err := m.ExecCommand("select NOPE"); err == nil {
+		t.Fatal("expected error selecting unknown issue")
+	}
+	if _, _, err := m.ExecCommand("recipe no-such-recipe"); err == nil {
+		t.Fatal("expected error for unknown recipe")
+	}
+}
+
+func TestStartupCommands_RunFromInitMessage(t *testing.T) {
+	m := commandTestModel(t)
+	m.SetStartupCommands([]string{"filter closed", "recipe no-such-recipe", "view board"})
+
+	newM, _ := m.Update(startupCommandsMsg{})
+	m = newM.(Model)
+
+	if got := strings.Join(listIDs(m), ","); got != "A-3" {
+		t.Fatalf("expected closed filter applied, got %s", got)
+	}
+	if !m.isBoardView {
+		t.Fatal("expected commands after a failure to still run")
+	}
+	if m.errorModal == nil || m.errorModal.kind != errConfig || !strings.Contains(m.errorModal.Error(), "no-such-recipe") {
+		t.Fatalf("expected failure reported as a config error, got %v", m.errorModal)
+	}
+	if len(m.startupCommands) != 0 {
+		t.Fatal("expected startup commands to be consumed")
+	}
+}
+
+func TestExecCommand_CombinedFilterTerms(t *testing.T) {
+	m := commandTestModel(t)
+
+	m, _, err := m.ExecCommand("filter open priority:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(listIDs(m), ","); got != "A-2" {
+		t.Fatalf("expected open P0 issues only, got %s", got)
+	}
+}
+
+func TestFilterIssues_MatchesListView(t *testing.T) {
+	m := commandTestModel(t)
+	m, _, err := m.ExecCommand("filter status=open")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m, _, err = m.ExecCommand("sort created"); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, issue := range beadsview.FilterIssues(m.issues, "status=open", beadsview.SortCreatedAsc) {
+		got = append(got, issue.ID)
+	}
+	if strings.Join(got, ",") != "A-1,A-2" || strings.Join(got, ",") != strings.Join(listIDs(m), ",") {
+		t.Fatalf("headless %v differs from list %v", got, listIDs(m))
+	}
+}
+
+func TestFilterCommand_Expressions(t *testing.T) {
+	m := commandTestModel(t)
+	m, _, err := m.ExecCommand("filter closed OR (open AND label:api)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(listIDs(m), ","); got != "A-1,A-3" {
+		t.Fatalf("expected A-1 and A-3, got %s", got)
+	}
+	_, _, err = m.ExecCommand("filter open AND (label:api")
+	if err == nil || !strings.Contains(err.Error(), "expected ) at the end") {
+		t.Errorf("expected an unclosed group to be reported, got %v", err)
+	}
+
+	d := m.completionData()
+	if got := CompleteCommand("filter (la", d); len(got) != 1 || got[0] != "filter (label:api" {
+		t.Errorf("expected a label after the parenthesis, got %q", got)
+	}
+	if got := CompleteCommand("filter open O", d); !slices.Contains(got, "filter open OR") {
+		t.Errorf("expected OR after a term, got %q", got)
+	}
+	if got := CompleteCommand("filter O", d); slices.Contains(got, "filter OR") {
+		t.Errorf("expected no OR before a term, got %q", got)
+	}
+}
+
+func TestSortCommand_ExpressionsPerView(t *testing.T) {
+	m := commandTestModel(t)
+	m, _, err := m.ExecCommand("sort open, age_days")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(listIDs(m), ","); got != "A-1,A-2,A-3" {
+		t.Fatalf("expected open issues oldest first, got %s", got)
+	}
+	if _, _, err := m.ExecCommand("sort priority*"); err == nil {
+		t.Error("expected a bad expression to be rejected")
+	}
+
+	if m, _, err = m.ExecCommand("view board"); err != nil {
+		t.Fatal(err)
+	}
+	if !isDefaultSort(m.currentSort()) {
+		t.Fatalf("expected the board to keep its own sort, got %s", m.currentSort())
+	}
+	boardIDs := func() string {
+		var ids []string
+		for _, issue := range m.board.columns[0] {
+			ids = append(ids, issue.ID)
+		}
+		return strings.Join(ids, ",")
+	}
+	if got := boardIDs(); got != "A-2,A-1" {
+		t.Fatalf("expected the open column by priority, got %s", got)
+	}
+	if m, _, err = m.ExecCommand("sort age_days"); err != nil {
+		t.Fatal(err)
+	}
+	if got := boardIDs(); got != "A-1,A-2" {
+		t.Errorf("expected the open column oldest first, got %s", got)
+	}
+	if got := m.sorts["list"].String(); got != "open,age_days" {
+		t.Errorf("expected the list sort unchanged, got %s", got)
+	}
+}
+
+func TestApplyRecipe_SortExpr(t *testing.T) {
+	m := commandTestModel(t)
+	m.applyRecipe(&recipe.Recipe{Name: "oldest", Sort: recipe.SortConfig{Expr: "age_days"}})
+	if got := strings.Join(listIDs(m), ","); got != "A-1,A-3,A-2" {
+		t.Errorf("expected the oldest first, got %s", got)
+	}
+	m.applyRecipe(&recipe.Recipe{Name: "bad", Sort: recipe.SortConfig{Expr: "age_days +"}})
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "invalid sort expr") {
+		t.Errorf("expected an invalid expression reported, got %q", m.statusMsg)
+	}
+}
+
+func TestValidateAliases(t *testing.T) {
+	if err := ValidateAliases(map[string]string{"p0": "filter open priority:0", "triage": "filter ready; sort priority"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	bad := []map[string]string{
+		{"filter": "filter open"},      // shadows a builtin
+		{"two words": "filter open"},   // not a single word
+		{"p0": " ; "},                  // empty body
+		{"p0": "filter nope"},          // invalid command
+		{"a": "filter open", "b": "a"}, // aliases do not nest
+	}
+	for _, aliases := range bad {
+		if err := ValidateAliases(aliases); err == nil {
+			t.Errorf("%v: expected error", aliases)
+		}
+	}
+}
+
+func TestExecCommand_Alias(t *testing.T) {
+	m := commandTestModel(t)
+	aliases := map[string]string{"oldest": "filter open; sort created"}
+	m.SetAliases(aliases)
+
+	for _, line := range []string{"oldest", ":oldest"} {
+		if err := ValidateCommand(line, aliases); err != nil {
+			t.Fatalf("%q: %v", line, err)
+		}
+		next, _, err := m.ExecCommand(line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := strings.Join(listIDs(next), ","); got != "A-1,A-2" {
+			t.Fatalf("%q: expected open issues oldest first, got %s", line, got)
+		}
+	}
+	if _, _, err := m.ExecCommand("oldest now"); err == nil {
+		t.Fatal("expected error passing arguments to an alias")
+	}
+}
+
+func TestCommandPrompt_RunsAliasAndGoto(t *testing.T) {
+	m := commandTestModel(t)
+	m.SetAliases(map[string]string{"p0": "filter open priority:0"})
+
+	typeLine := func(m Model, line string) Model {
+		newM, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(":")})
+		m = newM.(Model)
+		if m.CurrentContext() != ContextCommandPrompt {
+			t.Fatalf("expected command prompt, got %s", m.CurrentContext())
+		}
+		for _, r := range line {
+			newM, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+			m = newM.(Model)
+		}
+		newM, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		return newM.(Model)
+	}
+
+	m = typeLine(m, "p0")
+	if m.showCommandPrompt || m.focused != focusList {
+		t.Fatal("expected prompt closed after enter")
+	}
+	if got := strings.Join(listIDs(m), ","); got != "A-2" {
+		t.Fatalf("expected alias applied, got %s", got)
+	}
+
+	// A bare issue ID jumps to it, widening the filter if needed.
+	m = typeLine(m, "A-3")
+	if sel := m.list.SelectedItem().(IssueItem); sel.Issue.ID != "A-3" {
+		t.Fatalf("expected A-3 selected, got %s", sel.Issue.ID)
+	}
+
+	m = typeLine(m, "bogus")
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "bogus") {
+		t.Fatalf("expected error in status, got %q", m.statusMsg)
+	}
+}
@@ -0,0 +1,274 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Comments
+//
+// The detail view shows an issue's comments oldest first, as a thread:
+// comments an author posts within commentThreadGap of their previous one
+// are shown as replies under it. "m" opens the composer, which writes the
+// new comment with bd comments add; Ctrl+E hands the draft to $EDITOR.
+//
+// Votes are comments too (see model.Comment.IsVote): "v" posts a +1, or a
+// -1 to take it back, as the user bd records comments for. They are counted
+// in the summary and the list instead of being shown in the thread, and
+// "sort votes" puts the most wanted issues first.
+
+// commentThreadGap is how soon a comment must follow the previous one by the
+// same author to continue it.
+const commentThreadGap = time.Hour
+
+// writeCommentsMD writes the comment thread of an issue's detail markdown.
+func writeCommentsMD(sb *strings.Builder, comments []*model.Comment, now time.Time) {
+	sorted := make([]*model.Comment, 0, len(comments))
+	for _, c := range comments {
+		if c != nil && !c.IsVote() {
+			sorted = append(sorted, c)
+		}
+	}
+	if len(sorted) == 0 {
+		return
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedAt.Before(sorted[j].CreatedAt)
+	})
+
+	fmt.Fprintf(sb, "### Comments (%d)\n", len(sorted))
+	var prev *model.Comment
+	for _, c := range sorted {
+		text := strings.ReplaceAll(strings.TrimSpace(c.Text), "\n", "\n> ")
+		if prev != nil && c.Author == prev.Author && c.CreatedAt.Sub(prev.CreatedAt) <= commentThreadGap {
+			fmt.Fprintf(sb, "> \n> ↳ *%s*\n> \n> %s\n", commentTimestamp(c.CreatedAt, now), text)
+		} else {
+			if prev != nil {
+				sb.WriteString("\n")
+			}
+			fmt.Fprintf(sb, "> **%s** · *%s*\n> \n> %s\n", c.Author, commentTimestamp(c.CreatedAt, now), text)
+		}
+		prev = c
+	}
+	sb.WriteString("\n")
+}
+
+// commentTimestamp shows when a comment was posted, exactly and relative
+// to now.
+func commentTimestamp(t, now time.Time) string {
+	if t.IsZero() {
+		return "unknown time"
+	}
+	layout := "Jan 02 15:04"
+	if t.Year() != now.Year() {
+		layout = "Jan 02 2006 15:04"
+	}
+	return t.Format(layout) + " (" + FormatTimeRel(t, now) + ")"
+}
+
+// voterName is who bd records votes for: BD_ACTOR, like bd, or else the
+// login name. It is passed to bd as --actor so the check for an earlier
+// vote matches.
+func voterName(getenv func(string) string) string {
+	for _, key := range []string{"BD_ACTOR", "USER", "USERNAME"} {
+		if name := getenv(key); name != "" {
+			return name
+		}
+	}
+	return "bv"
+}
+
+// toggleVote votes for target, or withdraws the user's vote if they have
+// already voted for it.
+func (m Model) toggleVote(target *model.Issue) (Model, tea.Cmd) {
+	if m.refuseReadOnly(i18n.T("Voting")) {
+		return m, nil
+	}
+	if target == nil {
+		m.statusMsg = i18n.T("No issue selected")
+		m.statusIsError = true
+		return m, nil
+	}
+	if !bdAvailable() {
+		m.reportError(newError(errConfig, "vote for "+target.ID, errors.New("bd not found on PATH")).
+			withHint("install the beads CLI to vote from bv"))
+		return m, nil
+	}
+	voter := voterName(os.Getenv)
+	text, summary := model.VoteText, i18n.Tf("Voted for %s", target.ID)
+	if slices.Contains(target.Voters(), voter) {
+		text, summary = model.UnvoteText, i18n.Tf("Withdrew vote for %s", target.ID)
+	}
+	m.statusMsg = summary + "…"
+	m.statusIsError = false
+	dir, life, id := m.workDir, m.life, target.ID
+	return m, func() tea.Msg {
+		if _, err := life.runWrite(dir, "comments", "add", "--actor", voter, "--", id, text); err != nil {
+			return issueActionResultMsg{err: newError(errData, "vote for "+id, err)}
+		}
+		return issueActionResultMsg{summary: summary}
+	}
+}
+
+// composerEditedMsg reports that $EDITOR exited with the draft in path.
+type composerEditedMsg struct {
+	path string
+	err  error
+}
+
+// openComposer starts a comment on target.
+func (m *Model) openComposer(target *model.Issue) {
+	if m.refuseReadOnly(i18n.T("Commenting")) {
+		return
+	}
+	if target == nil {
+		m.statusMsg = i18n.T("No issue selected")
+		m.statusIsError = true
+		return
+	}
+	if !bdAvailable() {
+		m.reportError(newError(errConfig, "comment on "+target.ID, errors.New("bd not found on PATH")).
+			withHint("install the beads CLI to comment from bv"))
+		return
+	}
+	ta := textarea.New()
+	ta.Placeholder = "Markdown comment…"
+	ta.ShowLineNumbers = false
+	ta.CharLimit = 0
+	ta.SetWidth(max(min(m.width-12, 80), 20))
+	ta.SetHeight(max(min(m.height-14, 8), 3))
+	ta.Focus()
+	m.composer = ta
+	m.composerIssue = target.ID
+	m.showComposer = true
+}
+
+// handleComposerKeys edits the draft: Ctrl+S posts it, Ctrl+E moves it to
+// $EDITOR, Esc discards it.
+func (m Model) handleComposerKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.showComposer = false
+		return m, nil
+	case "ctrl+s":
+		text := strings.TrimSpace(m.composer.Value())
+		if text == "" {
+			m.statusMsg = i18n.T("Empty comment not posted")
+			m.statusIsError = true
+			return m, nil
+		}
+		m.showComposer = false
+		m.statusMsg = i18n.Tf("Posting comment on %s…", m.composerIssue)
+		m.statusIsError = false
+		return m, m.postCommentCmd(m.composerIssue, text)
+	case "ctrl+e":
+		return m.composeInEditor()
+	}
+	var cmd tea.Cmd
+	m.composer, cmd = m.composer.Update(msg)
+	return m, cmd
+}
+
+// composeInEditor suspends the TUI and edits the draft in $EDITOR. The
+// editor runs in the terminal, so it must not return before the file is
+// saved: GUI editors need their wait flag (e.g. "code --wait").
+func (m Model) composeInEditor() (Model, tea.Cmd) {
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		editor = os.Getenv("VISUAL")
+	}
+	if editor == "" {
+		m.statusMsg = i18n.T("Set $EDITOR to write comments in an editor")
+		m.statusIsError = true
+		return m, nil
+	}
+	args, err := parseCommandLine(editor)
+	if err != nil {
+		m.reportError(newError(errConfig, "open editor", fmt.Errorf("invalid $EDITOR/$VISUAL: %w", err)))
+		return m, nil
+	}
+	switch base, kind := classifyEditorCommand(args); kind {
+	case editorCommandEmpty:
+		m.reportError(newError(errConfig, "open editor", errors.New("invalid $EDITOR/$VISUAL: empty command")))
+		return m, nil
+	case editorCommandForbidden:
+		m.reportError(newError(errConfig, "open editor", fmt.Errorf("refusing to run %s as editor (shell/interpreter)", base)).
+			withHint("set $EDITOR to an editor"))
+		return m, nil
+	}
+
+	f, err := os.CreateTemp("", "bv-comment-*.md")
+	if err == nil {
+		_, err = f.WriteString(m.composer.Value())
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if err != nil {
+		m.reportError(newError(errTerminal, "open editor", err))
+		return m, nil
+	}
+	path := f.Name()
+	c := exec.Command(args[0], append(args[1:], path)...)
+	return m, tea.ExecProcess(c, func(err error) tea.Msg {
+		return composerEditedMsg{path: path, err: err}
+	})
+}
+
+// applyEditedDraft loads the draft back from the editor's file.
+func (m *Model) applyEditedDraft(msg composerEditedMsg) {
+	text, readErr := os.ReadFile(msg.path)
+	_ = os.Remove(msg.path)
+	if msg.err != nil {
+		m.reportError(newError(errTerminal, "open editor", msg.err))
+		return
+	}
+	if readErr != nil {
+		m.reportError(newError(errTerminal, "open editor", readErr))
+		return
+	}
+	m.composer.SetValue(strings.TrimRight(string(text), "\n"))
+}
+
+// postCommentCmd adds a comment with bd. It reaches the detail view through
+// live reload.
+func (m Model) postCommentCmd(id, text string) tea.Cmd {
+	dir, life := m.workDir, m.life
+	return func() tea.Msg {
+		if _, err := life.runWrite(dir, "comments", "add", "--", id, text); err != nil {
+			return issueActionResultMsg{err: newError(errData, "comment on "+id, err)}
+		}
+		return issueActionResultMsg{summary: i18n.Tf("Commented on %s", id)}
+	}
+}
+
+func (m Model) renderComposer() string {
+	t := m.theme
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 3)
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(i18n.Tf("Comment on %s", m.composerIssue)) + "\n\n")
+	sb.WriteString(m.composer.View() + "\n")
+	sb.WriteString("\n" + mutedStyle.Render(i18n.T("ctrl+s: post with bd • ctrl+e: $EDITOR • esc: discard")))
+
+	return lipgloss.Place(m.width, m.height-1, lipgloss.Center, lipgloss.Center, boxStyle.Render(sb.String()))
+}
@@ -0,0 +1,161 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestWriteCommentsMD_Thread(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	comments := []*model.Comment{
+		{Author: "ana", Text: "Looks good", CreatedAt: now.Add(-1 * time.Hour)},
+		{Author: "kim", Text: "Schema is settled.", CreatedAt: now.Add(-3 * time.Hour)},
+		nil,
+		{Author: "kim", Text: "Migration **next**\nthen cleanup", CreatedAt: now.Add(-150 * time.Minute)},
+	}
+	var sb strings.Builder
+	writeCommentsMD(&sb, comments, now)
+	want := "### Comments (3)\n" +
+		"> **kim** · *Mar 10 09:00 (3h ago)*\n> \n> Schema is settled.\n" +
+		"> \n> ↳ *Mar 10 09:30 (2h ago)*\n> \n> Migration **next**\n> then cleanup\n" +
+		"\n" +
+		"> **ana** · *Mar 10 11:00 (1h ago)*\n> \n> Looks good\n\n"
+	if got := sb.String(); got != want {
+		t.Fatalf("got:\n%s\nwant:\n%s", got, want)
+	}
+
+	sb.Reset()
+	writeCommentsMD(&sb, nil, now)
+	if sb.Len() != 0 {
+		t.Errorf("expected no section without comments, got %q", sb.String())
+	}
+	if got := commentTimestamp(now.AddDate(-1, 0, 0), now); got != "Mar 10 2024 12:00 (12mo ago)" {
+		t.Errorf("expected the year on old comments, got %q", got)
+	}
+}
+
+func TestComposer_PostsCommentWithBD(t *testing.T) {
+	fakeBD(t, `printf '%s|' "$@" > args`)
+	m := navTestModel(t)
+	m.workDir = t.TempDir()
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEnter})
+	id := m.actionTarget().ID
+
+	m = pressKey(m, runeKey("m"))
+	if m.CurrentContext() != ContextComposer {
+		t.Fatalf("expected the composer, got %s", m.CurrentContext())
+	}
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyCtrlS})
+	if !m.showComposer || !m.statusIsError {
+		t.Fatal("expected an empty comment to be refused")
+	}
+	m = typeText(m, "-looks good")
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEnter})
+	m = typeText(m, "ship it")
+
+	newM, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	m = newM.(Model)
+	if m.showComposer || cmd == nil {
+		t.Fatal("expected ctrl+s to post")
+	}
+	newM, _ = m.Update(cmd())
+	m = newM.(Model)
+	if m.statusIsError || !strings.Contains(m.statusMsg, "Commented on "+id) {
+		t.Fatalf("expected success, got %q", m.statusMsg)
+	}
+	args, err := os.ReadFile(filepath.Join(m.workDir, "args"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(args), "comments|add|--|"+id+"|-looks good\nship it|"; got != want {
+		t.Errorf("bd arguments = %q, want %q", got, want)
+	}
+}
+
+func TestVotes_ToggleWithBD(t *testing.T) {
+	fakeBD(t, `printf '%s|' "$@" > args`)
+	t.Setenv("BD_ACTOR", "ana")
+	m := commandTestModel(t)
+	m.workDir = t.TempDir()
+	id := m.actionTarget().ID
+	bdArgs := func() string {
+		args, err := os.ReadFile(filepath.Join(m.workDir, "args"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(args)
+	}
+
+	newM, cmd := m.Update(runeKey("v"))
+	m = newM.(Model)
+	if cmd == nil {
+		t.Fatal("expected v to vote")
+	}
+	newM, _ = m.Update(cmd())
+	m = newM.(Model)
+	if m.statusIsError || !strings.Contains(m.statusMsg, "Voted for "+id) {
+		t.Fatalf("expected success, got %q", m.statusMsg)
+	}
+	if got, want := bdArgs(), "comments|add|--actor|ana|--|"+id+"|+1|"; got != want {
+		t.Errorf("bd arguments = %q, want %q", got, want)
+	}
+
+	// Once the vote is loaded, v takes it back
+	voted := model.Issue{ID: id, Comments: []*model.Comment{
+		{Author: "kim", Text: "+1"},
+		{Author: "ana", Text: "+1"},
+		{Author: "kim", Text: "Agreed, this hurts"},
+	}}
+	m, cmd = m.toggleVote(&voted)
+	cmd()
+	if got := bdArgs(); !strings.HasSuffix(got, "|-1|") || !strings.Contains(m.statusMsg, "Withdrew vote") {
+		t.Errorf("expected the vote withdrawn, got %q (%s)", got, m.statusMsg)
+	}
+
+	var sb strings.Builder
+	writeIssueSummaryMD(&sb, voted)
+	writeCommentsMD(&sb, voted.Comments, time.Now())
+	if got := sb.String(); !strings.Contains(got, "**Votes:** ▲2 (kim, ana)") || !strings.Contains(got, "Comments (1)") {
+		t.Errorf("expected votes counted apart from the thread, got %q", got)
+	}
+
+	m, _, err := m.ExecCommand("sort votes")
+	if err != nil || sortLabel(m.sorts["list"]) != "Votes" {
+		t.Errorf("expected sort votes to apply, got %s (%v)", m.sorts["list"], err)
+	}
+}
+
+func TestComposer_Editor(t *testing.T) {
+	fakeBD(t, "exit 0")
+	m := commandTestModel(t)
+	m.openComposer(m.actionTarget())
+
+	t.Setenv("EDITOR", "bash -c")
+	m, _ = m.handleComposerKeys(tea.KeyMsg{Type: tea.KeyCtrlE})
+	if m.errorModal == nil || !strings.Contains(m.errorModal.Error(), "refusing to run bash") {
+		t.Fatalf("expected a shell to be refused as editor, got %v", m.errorModal)
+	}
+	m.errorModal = nil
+
+	draft := filepath.Join(t.TempDir(), "draft.md")
+	if err := os.WriteFile(draft, []byte("Written in vim\n\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	m.applyEditedDraft(composerEditedMsg{path: draft})
+	if got := m.composer.Value(); got != "Written in vim" {
+		t.Errorf("expected the edited draft, got %q", got)
+	}
+	if _, err := os.Stat(draft); !os.IsNotExist(err) {
+		t.Error("expected the draft file to be removed")
+	}
+	if !m.showComposer {
+		t.Error("expected the composer to stay open for review")
+	}
+}
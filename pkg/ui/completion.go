@@ -0,0 +1,215 @@
+package ui
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/beadsview"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// Completion
+//
+// The ":" prompt suggests the rest of the word being typed as ghost text;
+// tab accepts it and up/down cycle through the other matches. Each command
+// names its own candidates (complete in tuiCommand), drawn from
+// CompletionData rather than a Model so that shell completion can offer the
+// same ones.
+
+// CompletionData is what completion candidates are drawn from.
+type CompletionData struct {
+	Issues       []model.Issue
+	Recipes      []string
+	UserCommands []string          // Names of ui.commands entries
+	Aliases      map[string]string // ui.aliases
+	Macros       []string          // Registers holding a recorded macro
+	Sprints      []string          // Sprint IDs
+}
+
+// completionData returns the candidates for the current session.
+func (m Model) completionData() CompletionData {
+	return CompletionData{
+		Issues:       m.issues,
+		Recipes:      m.recipeLoader.Names(),
+		UserCommands: m.userCommandNames(),
+		Aliases:      m.aliases,
+		Macros:       m.macros.names(),
+		Sprints:      m.sprintIDs(),
+	}
+}
+
+// CompleteCommand returns the completions of the last word of line, each as
+// the whole line: command and alias names for the first word, then what
+// the command takes. An issue ID alone jumps to it at the prompt, so IDs
+// complete the first word too. Commands that take arguments complete with
+// a trailing space.
+func CompleteCommand(line string, d CompletionData) []string {
+	fields := strings.Fields(line)
+	word := ""
+	if len(fields) > 0 && !strings.HasSuffix(line, " ") {
+		word = fields[len(fields)-1]
+		fields = fields[:len(fields)-1]
+	}
+	// A word opening a group completes after its parentheses
+	word = strings.TrimLeft(word, "(")
+	prefix := line[:len(line)-len(word)]
+
+	var candidates []string
+	if len(fields) == 0 {
+		for _, c := range tuiCommands {
+			name := c.name
+			if strings.Contains(c.usage, " ") {
+				name += " " // Takes arguments
+			}
+			candidates = append(candidates, name)
+		}
+		candidates = append(candidates, sortedKeys(d.Aliases)...)
+		candidates = append(candidates, d.issueIDs()...)
+	} else if c := lookupCommand(strings.ToLower(fields[0])); c != nil && c.complete != nil {
+		candidates = c.complete(d, fields[1:])
+	}
+
+	var lines []string
+	for _, candidate := range candidates {
+		if strings.HasPrefix(strings.ToLower(candidate), strings.ToLower(word)) && candidate != word {
+			lines = append(lines, prefix+candidate)
+		}
+	}
+	return lines
+}
+
+// completeOne offers values for a command's single argument.
+func completeOne(values func(d CompletionData) []string) func(CompletionData, []string) []string {
+	return func(d CompletionData, prev []string) []string {
+		if len(prev) > 0 {
+			return nil
+		}
+		return values(d)
+	}
+}
+
+// completeFilter offers the filter terms not already given, and the
+// operators that join them.
+func completeFilter(d CompletionData, prev []string) []string {
+	terms := []string{"all", "open", "closed", "ready", "stale", "watched"}
+	for _, label := range d.labels() {
+		terms = append(terms, "label:"+beadsview.QuoteFilterValue(label))
+	}
+	terms = append(terms, "priority:0", "priority:1", "priority:2", "priority:3", "priority:4")
+	for _, status := range d.statuses() {
+		terms = append(terms, "status:"+status)
+	}
+	for _, assignee := range d.assignees() {
+		terms = append(terms, "assignee:"+assignee)
+	}
+	for _, issueType := range d.issueTypes() {
+		terms = append(terms, "type:"+issueType)
+	}
+	terms = append(terms, "blocked:true", "blocked:false")
+	given := make(map[string]bool, len(prev))
+	for _, term := range prev {
+		given[strings.Trim(term, "()")] = true
+	}
+	var out []string
+	for _, term := range terms {
+		if !given[term] {
+			out = append(out, term)
+		}
+	}
+	// AND and OR follow a term; NOT may start one
+	if len(prev) > 0 && !strings.HasSuffix(prev[len(prev)-1], "(") && !isFilterOperator(prev[len(prev)-1]) {
+		out = append(out, "AND", "OR")
+	}
+	return append(out, "NOT")
+}
+
+func isFilterOperator(word string) bool {
+	switch strings.ToUpper(word) {
+	case "AND", "OR", "NOT":
+		return true
+	}
+	return false
+}
+
+// commandViews lists the arguments of the view command.
+func commandViews(CompletionData) []string {
+	return append([]string{"list"}, sortedKeys(commandViewKeys)...)
+}
+
+func commandSortKeys(CompletionData) []string {
+	return sortKeys
+}
+
+func (d CompletionData) issueIDs() []string {
+	ids := make([]string, 0, len(d.Issues))
+	for _, issue := range d.Issues {
+		ids = append(ids, issue.ID)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (d CompletionData) recipes() []string {
+	return d.Recipes
+}
+
+func (d CompletionData) userCommands() []string {
+	return d.UserCommands
+}
+
+func (d CompletionData) macros() []string {
+	return d.Macros
+}
+
+// sprints offers the sprint IDs and the sprint subcommands.
+func (d CompletionData) sprints() []string {
+	return append([]string{"new", "capacity"}, d.Sprints...)
+}
+
+// criticalTargets offers "off" and the issue IDs for ":critical".
+func (d CompletionData) criticalTargets() []string {
+	return append([]string{"off"}, d.issueIDs()...)
+}
+
+func (d CompletionData) labels() []string {
+	seen := make(map[string]bool)
+	for _, issue := range d.Issues {
+		for _, label := range issue.Labels {
+			seen[label] = true
+		}
+	}
+	return sortedKeys(seen)
+}
+
+func (d CompletionData) assignees() []string {
+	seen := make(map[string]bool)
+	for _, issue := range d.Issues {
+		if issue.Assignee != "" {
+			seen[issue.Assignee] = true
+		}
+	}
+	return sortedKeys(seen)
+}
+
+func (d CompletionData) issueTypes() []string {
+	seen := make(map[string]bool)
+	for _, issue := range d.Issues {
+		if issue.IssueType != "" {
+			seen[string(issue.IssueType)] = true
+		}
+	}
+	return sortedKeys(seen)
+}
+
+func (d CompletionData) statuses() []string {
+	seen := make(map[string]bool)
+	for _, issue := range d.Issues {
+		seen[string(issue.Status)] = true
+	}
+	return sortedKeys(seen)
+}
+
+// commandPageSources lists the arguments of the page command.
+func commandPageSources(CompletionData) []string {
+	return pageSources
+}
@@ -0,0 +1,76 @@
+package ui
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestCompleteCommand(t *testing.T) {
+	d := CompletionData{
+		Issues: []model.Issue{
+			{ID: "A-2", Status: model.StatusOpen, Labels: []string{"api", "ui"}},
+			{ID: "A-1", Status: model.StatusBlocked},
+		},
+		Recipes:      []string{"triage", "quick-wins"},
+		UserCommands: []string{"gh"},
+		Aliases:      map[string]string{"p0": "filter priority:0"},
+	}
+	tests := []struct {
+		line string
+		want []string
+	}{
+		{"fil", []string{"filter "}},
+		{"wat", []string{"watch ", "watched"}},
+		{"p", []string{"pause", "perf", "play ", "page ", "p0"}},
+		{"A", []string{"activity ", "A-1", "A-2"}},
+		{"filter l", []string{"filter label:api", "filter label:ui"}},
+		{"filter label:api st", []string{"filter label:api stale", "filter label:api status:blocked", "filter label:api status:open"}},
+		{"FILTER PRI", []string{"FILTER priority:0", "FILTER priority:1", "FILTER priority:2", "FILTER priority:3", "FILTER priority:4"}},
+		{"view b", []string{"view board"}},
+		{"view board ", nil},
+		{"sort -", []string{"sort -created"}},
+		{"recipe t", []string{"recipe triage"}},
+		{"select A-", []string{"select A-1", "select A-2"}},
+		{"unwatch A-2", nil},
+		{"run ", []string{"run gh"}},
+		{"search lo", nil},
+		{"bogus a", nil},
+	}
+	for _, tt := range tests {
+		if got := CompleteCommand(tt.line, d); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%q: got %q, want %q", tt.line, got, tt.want)
+		}
+	}
+
+	got := CompleteCommand("filter open ", d)
+	if !slices.Contains(got, "filter open closed") || slices.Contains(got, "filter open open") {
+		t.Errorf("expected the other filter terms, got %q", got)
+	}
+}
+
+func TestCommandPrompt_TabCompletes(t *testing.T) {
+	m := commandTestModel(t)
+	m = pressKey(m, runeKey(":"))
+	m = pressKey(m, runeKey("v"))
+	if view := m.commandInput.View(); !strings.Contains(view, "iew") {
+		t.Fatalf("expected view as ghost text, got %q", view)
+	}
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyBackspace})
+	for _, key := range []tea.KeyMsg{runeKey("f"), runeKey("i"), {Type: tea.KeyTab}, runeKey("l"), {Type: tea.KeyTab}} {
+		m = pressKey(m, key)
+	}
+	if got := m.commandInput.Value(); got != "filter label:api" {
+		t.Fatalf("expected tab to complete the command and label, got %q", got)
+	}
+
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if m.currentFilter != "label:api" {
+		t.Fatalf("expected the completed filter to run, got %q", m.currentFilter)
+	}
+}
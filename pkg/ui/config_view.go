@@ -0,0 +1,91 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// SetConfigSettings sets the effective configuration that ":config" shows.
+func (m *Model) SetConfigSettings(settings config.Settings) {
+	m.configSettings = settings
+}
+
+// handleConfigKeys scrolls the config inspector.
+func (m Model) handleConfigKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q":
+		m.showConfig = false
+	case "j", "down":
+		if m.configCursor < len(m.configSettings)-1 {
+			m.configCursor++
+		}
+	case "k", "up":
+		if m.configCursor > 0 {
+			m.configCursor--
+		}
+	}
+	return m, nil
+}
+
+// renderConfig lists every config key with its effective value and the
+// layer it came from. Keys set by a layer stand out from the defaults.
+func (m Model) renderConfig() string {
+	t := m.theme
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 3)
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	textStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+	sourceStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+	selectedStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+
+	keyWidth := 0
+	for _, s := range m.configSettings {
+		keyWidth = max(keyWidth, len(s.Key))
+	}
+	width := max(min(m.width-12, 120), 40)
+	valueWidth := max((width-keyWidth-4)/2, 10)
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(i18n.T("Configuration")) + "\n")
+	sb.WriteString(mutedStyle.Render(i18n.Tf("Later layers win: %s, %s, environment", config.DefaultPath(), config.ProjectFileName)) + "\n\n")
+	if len(m.configSettings) == 0 {
+		sb.WriteString(mutedStyle.Render(i18n.T("No configuration loaded")) + "\n")
+	}
+	// Keep the cursor visible in a window of keys that fits the screen.
+	visible := max(m.height-12, 1)
+	start := 0
+	if m.configCursor >= visible {
+		start = m.configCursor - visible + 1
+	}
+	for i := start; i < len(m.configSettings) && i < start+visible; i++ {
+		s := m.configSettings[i]
+		key := fmt.Sprintf("%-*s", keyWidth, s.Key)
+		value := fmt.Sprintf("%-*s", valueWidth, truncateRunesHelper(s.Value, valueWidth, "…"))
+		cursor := "  "
+		if i == m.configCursor {
+			cursor, key = selectedStyle.Render("▸ "), selectedStyle.Render(key)
+		} else {
+			key = textStyle.Render(key)
+		}
+		source := mutedStyle.Render(s.Source)
+		if s.Source != config.DefaultSource {
+			value, source = textStyle.Render(value), sourceStyle.Render(s.Source)
+		} else {
+			value = mutedStyle.Render(value)
+		}
+		sb.WriteString(cursor + key + "  " + value + "  " + source + "\n")
+	}
+	sb.WriteString("\n" + mutedStyle.Render(i18n.T("j/k: navigate • esc: close")))
+
+	return lipgloss.Place(m.width, m.height-1, lipgloss.Center, lipgloss.Center, boxStyle.Render(sb.String()))
+}
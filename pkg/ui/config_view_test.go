@@ -0,0 +1,45 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestConfigCommand_ShowsSources(t *testing.T) {
+	m := commandTestModel(t)
+	m.SetConfigSettings(config.Settings{
+		{Key: "ui.stale_days", Value: "30", Source: "/repo/.beads_viewer.toml"},
+		{Key: "ui.hyperlinks", Value: "false", Source: "$BV_UI_HYPERLINKS"},
+		{Key: "ui.issue_url", Source: config.DefaultSource},
+	})
+	m, _, err := m.ExecCommand("config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.CurrentContext() != ContextConfig {
+		t.Fatalf("expected the config inspector, got %s", m.CurrentContext())
+	}
+	view := m.View()
+	for _, want := range []string{"ui.stale_days", "30", "/repo/.beads_viewer.toml", "$BV_UI_HYPERLINKS", "ui.issue_url", "default"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected %q in the inspector:\n%s", want, view)
+		}
+	}
+
+	newM, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	m = newM.(Model)
+	if m.configCursor != 1 {
+		t.Errorf("expected j to move to the next key, got %d", m.configCursor)
+	}
+	newM, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if newM.(Model).showConfig {
+		t.Error("expected esc to close the inspector")
+	}
+	if ValidateCommand("config extra", nil) == nil {
+		t.Error("expected config to take no arguments")
+	}
+}
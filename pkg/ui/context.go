@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
 	"github.com/charmbracelet/bubbles/list"
 )
 
@@ -21,10 +22,33 @@ const (
 	ContextRepoPicker        Context = "repo-picker"
 	ContextAgentPrompt       Context = "agent-prompt"
 	ContextCassSession       Context = "cass-session"
+	ContextSessionReview     Context = "session-review"
+	ContextIdleLock          Context = "idle-lock"
+	ContextCommandPrompt     Context = "command-prompt"
+	ContextActionMenu        Context = "action-menu"
+	ContextCommandOutput     Context = "command-output"
+	ContextPager             Context = "pager"
+	ContextWatchFeed         Context = "watch-feed"
+	ContextErrorModal        Context = "error-modal"
+	ContextDiagnostics       Context = "diagnostics"
+	ContextConfig            Context = "config"
+	ContextUsage             Context = "usage"
+	ContextSprintPlan        Context = "sprint-plan"
+	ContextImpact            Context = "impact"
+	ContextDuplicates        Context = "duplicates"
+	ContextEscalations       Context = "escalations"
+	ContextActivity          Context = "activity"
+	ContextHookTrust         Context = "hook-trust"
+	ContextDebugConsole      Context = "debug-console"
+	ContextCapture           Context = "capture"
+	ContextDrafts            Context = "drafts"
+	ContextComposer          Context = "composer"
 
 	// Views
 	ContextInsights       Context = "insights"
 	ContextFlowMatrix     Context = "flow-matrix"
+	ContextTreemap        Context = "treemap"
+	ContextTimeline       Context = "timeline"
 	ContextGraph          Context = "graph"
 	ContextBoard          Context = "board"
 	ContextActionable     Context = "actionable"
@@ -51,6 +75,16 @@ const (
 func (m Model) CurrentContext() Context {
 	// === Overlays (most specific - check first) ===
 
+	// Privacy screen covers everything
+	if m.idleLock.Locked() {
+		return ContextIdleLock
+	}
+
+	// Error modal covers the other overlays
+	if m.errorModal != nil {
+		return ContextErrorModal
+	}
+
 	// Cass session modal (bv-qi94)
 	if m.showCassModal {
 		return ContextCassSession
@@ -66,6 +100,11 @@ func (m Model) CurrentContext() Context {
 		return ContextHelp
 	}
 
+	// Session review shown on quit
+	if m.showSessionReview {
+		return ContextSessionReview
+	}
+
 	// Quit confirmation
 	if m.showQuitConfirm {
 		return ContextQuitConfirm
@@ -96,6 +135,96 @@ func (m Model) CurrentContext() Context {
 		return ContextLabelGraphAnalysis
 	}
 
+	// ":" command prompt
+	if m.showCommandPrompt {
+		return ContextCommandPrompt
+	}
+
+	// Per-issue action menu
+	if m.showActionMenu {
+		return ContextActionMenu
+	}
+
+	// Output of a user command
+	if m.showCommandOutput {
+		return ContextCommandOutput
+	}
+
+	// Long text paged with "|"
+	if m.showPager {
+		return ContextPager
+	}
+
+	// Feed of changes to watched issues
+	if m.showWatchFeed {
+		return ContextWatchFeed
+	}
+
+	// Log of reported errors
+	if m.showDiagnostics {
+		return ContextDiagnostics
+	}
+
+	// Effective configuration
+	if m.showConfig {
+		return ContextConfig
+	}
+
+	// Usage stats
+	if m.showUsage {
+		return ContextUsage
+	}
+
+	// Sprint planning
+	if m.showSprintPlan {
+		return ContextSprintPlan
+	}
+
+	// Dependency impact of an issue
+	if m.showImpact {
+		return ContextImpact
+	}
+
+	// Duplicate review
+	if m.showDuplicates {
+		return ContextDuplicates
+	}
+
+	// Escalation review
+	if m.showEscalations {
+		return ContextEscalations
+	}
+
+	// Agent activity
+	if m.showActivity {
+		return ContextActivity
+	}
+
+	// Trust prompt for the project's hooks
+	if m.showHookTrust {
+		return ContextHookTrust
+	}
+
+	// Debug console
+	if m.showDebugConsole {
+		return ContextDebugConsole
+	}
+
+	// Quick capture form
+	if m.showCapture {
+		return ContextCapture
+	}
+
+	// Local draft issues
+	if m.showDrafts {
+		return ContextDrafts
+	}
+
+	// Comment composer
+	if m.showComposer {
+		return ContextComposer
+	}
+
 	// Time-travel input prompt
 	if m.showTimeTravelPrompt {
 		return ContextTimeTravelInput
@@ -127,6 +256,16 @@ func (m Model) CurrentContext() Context {
 		return ContextFlowMatrix
 	}
 
+	// Treemap view
+	if m.focused == focusTreemap {
+		return ContextTreemap
+	}
+
+	// Timeline view
+	if m.focused == focusTimeline {
+		return ContextTimeline
+	}
+
 	// Label dashboard
 	if m.focused == focusLabelDashboard {
 		return ContextLabelDashboard
@@ -189,32 +328,55 @@ func (m Model) CurrentContext() Context {
 // Useful for status messages or debugging.
 func (c Context) Description() string {
 	descriptions := map[Context]string{
-		ContextLabelPicker:        "Label picker",
-		ContextRecipePicker:       "Recipe picker",
-		ContextHelp:               "Help overlay",
-		ContextQuitConfirm:        "Quit confirmation",
-		ContextLabelHealthDetail:  "Label health detail",
-		ContextLabelDrilldown:     "Label drilldown",
-		ContextLabelGraphAnalysis: "Label graph analysis",
-		ContextTimeTravelInput:    "Time-travel input",
-		ContextAlerts:             "Alerts panel",
-		ContextRepoPicker:         "Repo picker",
-		ContextAgentPrompt:        "Agent prompt",
-		ContextCassSession:        "Cass session preview",
-		ContextInsights:           "Insights panel",
-		ContextFlowMatrix:         "Flow matrix",
-		ContextGraph:              "Dependency graph",
-		ContextBoard:              "Kanban board",
-		ContextActionable:         "Actionable view",
-		ContextHistory:            "History view",
-		ContextSprint:             "Sprint view",
-		ContextLabelDashboard:     "Label dashboard",
-		ContextAttention:          "Attention view",
-		ContextSplit:              "Split view",
-		ContextDetail:             "Issue detail",
-		ContextTimeTravel:         "Time-travel mode",
-		ContextFilter:             "Filter/search mode",
-		ContextList:               "Issue list",
+		ContextLabelPicker:        i18n.T("Label picker"),
+		ContextRecipePicker:       i18n.T("Recipe picker"),
+		ContextHelp:               i18n.T("Help overlay"),
+		ContextQuitConfirm:        i18n.T("Quit confirmation"),
+		ContextLabelHealthDetail:  i18n.T("Label health detail"),
+		ContextLabelDrilldown:     i18n.T("Label drilldown"),
+		ContextLabelGraphAnalysis: i18n.T("Label graph analysis"),
+		ContextTimeTravelInput:    i18n.T("Time-travel input"),
+		ContextAlerts:             i18n.T("Alerts panel"),
+		ContextRepoPicker:         i18n.T("Repo picker"),
+		ContextAgentPrompt:        i18n.T("Agent prompt"),
+		ContextCassSession:        i18n.T("Cass session preview"),
+		ContextSessionReview:      i18n.T("Session review"),
+		ContextIdleLock:           i18n.T("Idle lock"),
+		ContextCommandPrompt:      i18n.T("Command prompt"),
+		ContextActionMenu:         i18n.T("Issue actions"),
+		ContextCommandOutput:      i18n.T("Command output"),
+		ContextPager:              i18n.T("Pager"),
+		ContextWatchFeed:          i18n.T("Watched changes"),
+		ContextErrorModal:         i18n.T("Error"),
+		ContextDiagnostics:        i18n.T("Errors"),
+		ContextConfig:             i18n.T("Configuration"),
+		ContextUsage:              i18n.T("Usage stats"),
+		ContextSprintPlan:         i18n.T("Sprint planning"),
+		ContextImpact:             i18n.T("Dependency impact"),
+		ContextDuplicates:         i18n.T("Possible duplicates"),
+		ContextEscalations:        i18n.T("Escalations"),
+		ContextActivity:           i18n.T("Agent activity"),
+		ContextHookTrust:          i18n.T("Trust project hooks"),
+		ContextDebugConsole:       i18n.T("Debug console"),
+		ContextCapture:            i18n.T("New issue"),
+		ContextDrafts:             i18n.T("Drafts"),
+		ContextComposer:           i18n.T("Comment"),
+		ContextInsights:           i18n.T("Insights panel"),
+		ContextFlowMatrix:         i18n.T("Flow matrix"),
+		ContextTreemap:            i18n.T("Treemap"),
+		ContextTimeline:           i18n.T("Timeline"),
+		ContextGraph:              i18n.T("Dependency graph"),
+		ContextBoard:              i18n.T("Kanban board"),
+		ContextActionable:         i18n.T("Actionable view"),
+		ContextHistory:            i18n.T("History view"),
+		ContextSprint:             i18n.T("Sprint view"),
+		ContextLabelDashboard:     i18n.T("Label dashboard"),
+		ContextAttention:          i18n.T("Attention view"),
+		ContextSplit:              i18n.T("Split view"),
+		ContextDetail:             i18n.T("Issue detail"),
+		ContextTimeTravel:         i18n.T("Time-travel mode"),
+		ContextFilter:             i18n.T("Filter/search mode"),
+		ContextList:               i18n.T("Issue list"),
 	}
 	if desc, ok := descriptions[c]; ok {
 		return desc
@@ -228,7 +390,9 @@ func (c Context) IsOverlay() bool {
 	case ContextLabelPicker, ContextRecipePicker, ContextHelp, ContextQuitConfirm,
 		ContextLabelHealthDetail, ContextLabelDrilldown, ContextLabelGraphAnalysis,
 		ContextTimeTravelInput, ContextAlerts, ContextRepoPicker, ContextAgentPrompt,
-		ContextCassSession:
+		ContextCassSession, ContextSessionReview, ContextIdleLock, ContextCommandPrompt,
+		ContextActionMenu, ContextCommandOutput, ContextPager, ContextWatchFeed, ContextErrorModal,
+		ContextDiagnostics, ContextConfig, ContextUsage, ContextSprintPlan, ContextImpact, ContextDuplicates, ContextEscalations, ContextActivity, ContextHookTrust, ContextDebugConsole, ContextCapture, ContextDrafts, ContextComposer:
 		return true
 	}
 	return false
@@ -237,7 +401,7 @@ func (c Context) IsOverlay() bool {
 // IsView returns true if the context is a full view (not overlay or default list)
 func (c Context) IsView() bool {
 	switch c {
-	case ContextInsights, ContextFlowMatrix, ContextGraph, ContextBoard,
+	case ContextInsights, ContextFlowMatrix, ContextTreemap, ContextTimeline, ContextGraph, ContextBoard,
 		ContextActionable, ContextHistory, ContextSprint, ContextLabelDashboard,
 		ContextAttention, ContextSplit, ContextDetail, ContextTimeTravel:
 		return true
@@ -262,6 +426,8 @@ func (c Context) TutorialPages() []int {
 		ContextTimeTravel:         {10},          // Time-Travel
 		ContextLabelDashboard:     {11},          // Labels
 		ContextFlowMatrix:         {11, 12},      // Labels, Advanced
+		ContextTreemap:            {7},           // Insights
+		ContextTimeline:           {7},           // Insights
 		ContextHelp:               {13},          // Keyboard Reference
 		ContextSprint:             {14},          // Sprints
 		ContextAttention:          {7},           // Insights (attention is part of insights)
@@ -276,6 +442,27 @@ func (c Context) TutorialPages() []int {
 		ContextTimeTravelInput:    {10},          // Time-Travel
 		ContextQuitConfirm:        {1},           // Navigation basics
 		ContextCassSession:        {8},           // History (cass integrates with history)
+		ContextSessionReview:      {1},           // Navigation basics
+		ContextIdleLock:           {1},           // Navigation basics
+		ContextCommandPrompt:      {3, 12},       // Filtering, Advanced
+		ContextActionMenu:         {4},           // Detail View
+		ContextCommandOutput:      {4},           // Detail View
+		ContextPager:              {4},           // Detail View
+		ContextWatchFeed:          {4},           // Detail View
+		ContextErrorModal:         {1},           // Navigation basics
+		ContextDiagnostics:        {1},           // Navigation basics
+		ContextConfig:             {1},           // Navigation basics
+		ContextUsage:              {1},           // Navigation basics
+		ContextSprintPlan:         {14},          // Sprints
+		ContextImpact:             {6},           // Graph View
+		ContextDuplicates:         {1},           // Navigation basics
+		ContextEscalations:        {1},           // Navigation basics
+		ContextActivity:           {8},           // History View
+		ContextHookTrust:          {1},           // Navigation basics
+		ContextDebugConsole:       {1},           // Navigation basics
+		ContextCapture:            {1},           // Navigation basics
+		ContextDrafts:             {1},           // Navigation basics
+		ContextComposer:           {4},           // Detail View
 	}
 	if pages, ok := pageMap[c]; ok {
 		return pages
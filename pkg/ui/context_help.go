@@ -3,12 +3,14 @@ package ui
 import (
 	"strings"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
 	"github.com/charmbracelet/lipgloss"
 )
 
 // ContextHelpContent contains compact help content for each context.
 // This is used when user triggers context-specific help (e.g., double-tap backtick).
-// Content should fit on one screen (~20 lines) without scrolling.
+// Content should fit on one screen (~20 lines) without scrolling. It is
+// English; GetContextHelp translates it.
 var ContextHelpContent = map[Context]string{
 	ContextList:           contextHelpList,
 	ContextGraph:          contextHelpGraph,
@@ -28,13 +30,13 @@ var ContextHelpContent = map[Context]string{
 	ContextCassSession:    contextHelpCassSession,
 }
 
-// GetContextHelp returns the help content for a given context.
+// GetContextHelp returns the help content for a given context, translated.
 // Falls back to generic help if the context has no specific content.
 func GetContextHelp(ctx Context) string {
 	if content, ok := ContextHelpContent[ctx]; ok {
-		return content
+		return i18n.T(content)
 	}
-	return contextHelpGeneric
+	return i18n.T(contextHelpGeneric)
 }
 
 // RenderContextHelp renders the context-specific help modal.
@@ -66,13 +68,13 @@ func RenderContextHelp(ctx Context, theme Theme, width, height int) string {
 
 	// Build content
 	var b strings.Builder
-	b.WriteString(titleStyle.Render("Quick Reference"))
+	b.WriteString(titleStyle.Render(i18n.T("Quick Reference")))
 	b.WriteString("\n")
 	b.WriteString(r.NewStyle().Foreground(theme.Border).Render(strings.Repeat("─", modalWidth-4)))
 	b.WriteString("\n\n")
 	b.WriteString(contentStyle.Render(content))
 	b.WriteString("\n\n")
-	b.WriteString(footerStyle.Render("Press ` for full tutorial │ Esc to close"))
+	b.WriteString(footerStyle.Render(i18n.T("Press ` for full tutorial │ Esc to close")))
 
 	// Wrap in modal style
 	modalStyle := r.NewStyle().
@@ -0,0 +1,261 @@
+package ui
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Remote control
+//
+// With --control, the TUI listens on a random localhost port for commands
+// from other tools, so an editor can jump from a code comment to an issue:
+//
+//	POST /v1/command  {"command": "focus", "args": ["bd-123"]}
+//
+// answered with {"ok": true} or {"error": "..."}. The commands are focus ID,
+// filter TERMS and refresh. The address and a token that requests must send
+// as "Authorization: Bearer TOKEN" are written to ControlPath, readable only
+// by the user; `bv remote` reads them from there.
+
+// ControlCommands lists the commands a running viewer accepts remotely.
+var ControlCommands = []string{"focus", "filter", "refresh"}
+
+// controlTimeout bounds how long a request waits for the TUI to handle it.
+const controlTimeout = 5 * time.Second
+
+// ControlRequest asks a running viewer to run one of ControlCommands.
+type ControlRequest struct {
+	Command string   `json:"command"`
+	Args    []string `json:"args,omitempty"`
+}
+
+// controlInfo is what ControlPath holds while a viewer listens.
+type controlInfo struct {
+	PID   int    `json:"pid"`
+	Addr  string `json:"addr"`
+	Token string `json:"token"`
+}
+
+type controlResponse struct {
+	OK    bool   `json:"ok,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// controlMsg delivers a ControlRequest to the TUI, which answers on reply.
+type controlMsg struct {
+	req   ControlRequest
+	reply chan<- error
+}
+
+// ControlPath returns where a viewer of the project in projectDir
+// advertises its control address.
+func ControlPath(projectDir string) (string, error) {
+	return userProjectFile("control", projectDir)
+}
+
+// ControlServer accepts ControlRequests for a running program.
+type ControlServer struct {
+	path     string
+	info     controlInfo
+	server   *http.Server
+	listener net.Listener
+}
+
+// StartControlServer listens on localhost, passes requests to send (such as
+// tea.Program.Send) and advertises itself at path.
+func StartControlServer(path string, send func(tea.Msg)) (*ControlServer, error) {
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return nil, fmt.Errorf("control token: %w", err)
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("control listener: %w", err)
+	}
+	s := &ControlServer{
+		path:     path,
+		info:     controlInfo{PID: os.Getpid(), Addr: listener.Addr().String(), Token: hex.EncodeToString(token)},
+		listener: listener,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/command", func(w http.ResponseWriter, r *http.Request) {
+		s.handle(w, r, send)
+	})
+	s.server = &http.Server{Handler: mux, ReadHeaderTimeout: controlTimeout}
+
+	data, err := json.Marshal(s.info)
+	if err == nil {
+		err = os.MkdirAll(filepath.Dir(path), 0o755)
+	}
+	if err == nil {
+		err = writeControlFile(path, data)
+	}
+	if err != nil {
+		listener.Close()
+		return nil, fmt.Errorf("control file: %w", err)
+	}
+	go func() { _ = s.server.Serve(listener) }()
+	return s, nil
+}
+
+// writeControlFile writes data to a new file at path that only the user can
+// read. A file left there, by a viewer that crashed or by someone else, is
+// replaced rather than written through, as it may be readable by others.
+func writeControlFile(path string, data []byte) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+func (s *ControlServer) handle(w http.ResponseWriter, r *http.Request, send func(tea.Msg)) {
+	respond := func(status int, resp controlResponse) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(resp)
+	}
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte("Bearer "+s.info.Token)) != 1 {
+		respond(http.StatusUnauthorized, controlResponse{Error: "missing or wrong token"})
+		return
+	}
+	var req ControlRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 64<<10)).Decode(&req); err != nil {
+		respond(http.StatusBadRequest, controlResponse{Error: "bad request: " + err.Error()})
+		return
+	}
+	reply := make(chan error, 1)
+	go send(controlMsg{req: req, reply: reply})
+	select {
+	case err := <-reply:
+		if err != nil {
+			respond(http.StatusUnprocessableEntity, controlResponse{Error: err.Error()})
+			return
+		}
+		respond(http.StatusOK, controlResponse{OK: true})
+	case <-time.After(controlTimeout):
+		respond(http.StatusGatewayTimeout, controlResponse{Error: "the viewer did not answer"})
+	case <-r.Context().Done():
+	}
+}
+
+// Close stops listening and removes the control file, unless another
+// viewer has taken it over since.
+func (s *ControlServer) Close() error {
+	if data, err := os.ReadFile(s.path); err == nil {
+		var info controlInfo
+		if json.Unmarshal(data, &info) == nil && info.Token == s.info.Token {
+			_ = os.Remove(s.path)
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	return s.server.Shutdown(ctx)
+}
+
+// SendControl sends req to the viewer advertised at path and returns the
+// error it answered with.
+func SendControl(path string, req ControlRequest) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("no viewer is listening in this project (start bv with --control)")
+	}
+	if err != nil {
+		return fmt.Errorf("control file: %w", err)
+	}
+	var info controlInfo
+	if err := json.Unmarshal(data, &info); err != nil {
+		return fmt.Errorf("control file %s: %w", path, err)
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	httpReq, err := http.NewRequest(http.MethodPost, "http://"+info.Addr+"/v1/command", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+info.Token)
+	httpReq.Header.Set("Content-Type", "application/json")
+	client := &http.Client{Timeout: 2 * controlTimeout}
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		var opErr *net.OpError
+		if errors.As(err, &opErr) && opErr.Op == "dial" {
+			return fmt.Errorf("the viewer that listened in this project (pid %d) is gone", info.PID)
+		}
+		return fmt.Errorf("control request: %w", err)
+	}
+	defer resp.Body.Close()
+	var answer controlResponse
+	if err := json.NewDecoder(resp.Body).Decode(&answer); err != nil {
+		return fmt.Errorf("control response: %s", resp.Status)
+	}
+	if answer.Error != "" {
+		return errors.New(answer.Error)
+	}
+	return nil
+}
+
+// ValidateControlRequest checks a request before it is sent.
+func ValidateControlRequest(req ControlRequest) error {
+	switch req.Command {
+	case "focus":
+		if len(req.Args) != 1 {
+			return fmt.Errorf("focus takes one issue ID")
+		}
+	case "filter":
+		if len(req.Args) == 0 {
+			return fmt.Errorf("filter takes filter terms")
+		}
+		return validateListFilter(strings.Join(req.Args, " "))
+	case "refresh":
+		if len(req.Args) != 0 {
+			return fmt.Errorf("refresh takes no arguments")
+		}
+	default:
+		return fmt.Errorf("unknown command %q (want %s)", req.Command, strings.Join(ControlCommands, ", "))
+	}
+	return nil
+}
+
+// handleControl runs a remote request in the TUI.
+func (m Model) handleControl(req ControlRequest) (Model, tea.Cmd, error) {
+	if err := ValidateControlRequest(req); err != nil {
+		return m, nil, err
+	}
+	switch req.Command {
+	case "focus":
+		id := req.Args[0]
+		if _, ok := m.issueMap[id]; !ok {
+			return m, nil, fmt.Errorf("no issue %s", id)
+		}
+		return m.gotoIssue(id), nil, nil
+	case "filter":
+		return m.ExecCommand("filter " + strings.Join(req.Args, " "))
+	default:
+		m, cmd := m.forceRefresh()
+		return m, cmd, nil
+	}
+}
@@ -0,0 +1,93 @@
+package ui
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestControl_RemoteCommands(t *testing.T) {
+	m := commandTestModel(t)
+	path := filepath.Join(t.TempDir(), "control", "project.json")
+	// A file left behind with looser permissions is replaced, not reused.
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("{}"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	msgs := make(chan tea.Msg)
+	server, err := StartControlServer(path, func(msg tea.Msg) { msgs <- msg })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0o600 {
+		t.Fatalf("expected a control file only the user can read, got %v %v", info, err)
+	}
+
+	// send delivers req to the model as the program would and returns the
+	// answer the client got.
+	send := func(req ControlRequest) error {
+		t.Helper()
+		done := make(chan error, 1)
+		go func() { done <- SendControl(path, req) }()
+		newM, _ := m.Update(<-msgs)
+		m = newM.(Model)
+		return <-done
+	}
+
+	if err := send(ControlRequest{Command: "focus", Args: []string{"A-1"}}); err != nil {
+		t.Fatal(err)
+	}
+	if sel, ok := m.list.SelectedItem().(IssueItem); !ok || sel.Issue.ID != "A-1" {
+		t.Errorf("expected A-1 focused, got %v", m.list.SelectedItem())
+	}
+	if err := send(ControlRequest{Command: "filter", Args: []string{"open", "priority:0"}}); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(listIDs(m), ","); got != "A-2" {
+		t.Errorf("expected the remote filter applied, got %s", got)
+	}
+	if err := send(ControlRequest{Command: "focus", Args: []string{"NOPE"}}); err == nil || err.Error() != "no issue NOPE" {
+		t.Errorf("expected the viewer's error, got %v", err)
+	}
+
+	// Requests without the token never reach the viewer.
+	resp, err := http.Post("http://"+server.info.Addr+"/v1/command", "application/json", strings.NewReader(`{"command":"refresh"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected 401 without the token, got %s", resp.Status)
+	}
+
+	if err := server.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Error("expected Close to remove the control file")
+	}
+	if err := SendControl(path, ControlRequest{Command: "refresh"}); err == nil || !strings.Contains(err.Error(), "--control") {
+		t.Errorf("expected a hint to start bv with --control, got %v", err)
+	}
+}
+
+func TestValidateControlRequest(t *testing.T) {
+	valid := []ControlRequest{{Command: "focus", Args: []string{"A-1"}}, {Command: "filter", Args: []string{"open", "OR", "label:api"}}, {Command: "refresh"}}
+	for _, req := range valid {
+		if err := ValidateControlRequest(req); err != nil {
+			t.Errorf("%+v: unexpected error %v", req, err)
+		}
+	}
+	invalid := []ControlRequest{{Command: "focus"}, {Command: "filter", Args: []string{"priority:9"}}, {Command: "refresh", Args: []string{"now"}}, {Command: "run", Args: []string{"rm"}}}
+	for _, req := range invalid {
+		if err := ValidateControlRequest(req); err == nil {
+			t.Errorf("%+v: expected an error", req)
+		}
+	}
+}
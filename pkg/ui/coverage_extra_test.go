@@ -813,6 +813,47 @@ func TestRenderFooter_CombinedIndicators(t *testing.T) {
 	}
 }
 
+func TestAutoRefreshPause_KeyAndCommands(t *testing.T) {
+	f := filepath.Join(t.TempDir(), "beads.jsonl")
+	if err := os.WriteFile(f, []byte("{}"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	w, err := watcher.NewWatcher(f,
+		watcher.WithForcePoll(true),
+		watcher.WithPollInterval(5*time.Second),
+		watcher.WithPollJitter(time.Second),
+	)
+	if err != nil {
+		t.Fatalf("watcher: %v", err)
+	}
+	if err := w.Start(); err != nil {
+		t.Fatalf("watcher start: %v", err)
+	}
+	t.Cleanup(func() { w.Stop() })
+
+	m := NewModel(nil, nil, "")
+	m.width = 160
+	m.watcher = w
+	if out := m.renderFooter(); !strings.Contains(out, "polling 5s±1s") {
+		t.Fatalf("footer should show the polling interval and jitter: %q", out)
+	}
+
+	modelAny, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Z")})
+	m = modelAny.(Model)
+	if !w.IsPaused() || !strings.Contains(m.renderFooter(), "refresh paused") {
+		t.Fatalf("expected Z to pause auto-refresh, footer: %q", m.renderFooter())
+	}
+
+	m, _, err = m.ExecCommand("resume")
+	if err != nil || w.IsPaused() {
+		t.Fatalf("expected resume to unpause, err=%v", err)
+	}
+
+	if _, _, err := NewModel(nil, nil, "").ExecCommand("pause"); err == nil {
+		t.Fatal("expected pause to fail without live reload")
+	}
+}
+
 func TestRenderSplitAndListViews(t *testing.T) {
 	issues := []model.Issue{
 		{ID: "1", Title: "Alpha", Status: model.StatusOpen},
@@ -915,7 +956,7 @@ func TestOpenInEditorMissingAndGUI(t *testing.T) {
 
 	m := NewModel([]model.Issue{{ID: "1", Title: "x", Status: model.StatusOpen}}, nil, "")
 	m.openInEditor()
-	if !m.statusIsError || !strings.Contains(m.statusMsg, "No .beads") {
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "no .beads") {
 		t.Fatalf("expected missing beads error, got %q", m.statusMsg)
 	}
 
@@ -1305,3 +1346,31 @@ func TestHelpOverlayScroll(t *testing.T) {
 		t.Fatalf("expected helpScroll=0 after Space, got %d", m.helpScroll)
 	}
 }
+
+func TestReducedMotion_StopsTimers(t *testing.T) {
+	m := NewModel(nil, nil, "")
+	m.backgroundWorker = &BackgroundWorker{state: WorkerProcessing}
+	m.EnableReducedMotion(true)
+
+	updated, cmd := m.Update(workerPollTickMsg{})
+	if cmd != nil {
+		t.Fatal("expected no spinner tick in reduced motion")
+	}
+	if updated.(Model).workerSpinnerIdx != 0 {
+		t.Fatal("expected the spinner to stay on its first frame")
+	}
+
+	h := NewHistoryModel(nil, m.theme)
+	h.reducedMotion = true
+	h.ToggleViewMode()
+	if !h.modeChangedAt.IsZero() {
+		t.Fatal("expected no mode transition flash in reduced motion")
+	}
+
+	modal := NewUpdateModal("v9.9.9", "", m.theme)
+	modal.reducedMotion = true
+	modal.startTime = time.Now().Add(-250 * time.Millisecond)
+	if got := modal.renderSpinner(); got != "⠋" {
+		t.Fatalf("expected a still spinner frame, got %q", got)
+	}
+}
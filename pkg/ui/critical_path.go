@@ -0,0 +1,101 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Critical path
+//
+// ":critical [issue-id]" schedules the open work under an epic (see
+// analysis.ComputeCriticalPath) and marks the issues on its critical path
+// with ◆ in the list and the graph, until ":critical off". The schedule is
+// recomputed as the issues reload, so the marks follow closed work.
+
+// criticalPathCommand sets or clears the critical path of the issue args
+// name, or the selection.
+func (m Model) criticalPathCommand(args []string) (Model, tea.Cmd, error) {
+	var id string
+	switch {
+	case len(args) == 1 && args[0] == "off":
+		if m.criticalPath == nil {
+			return m, nil, fmt.Errorf("no critical path is shown")
+		}
+		m.setCriticalPath(nil)
+		m.statusMsg = i18n.T("Critical path cleared")
+		m.statusIsError = false
+		return m, nil, nil
+	case len(args) == 1:
+		if _, ok := m.issueMap[args[0]]; !ok {
+			return m, nil, fmt.Errorf("unknown issue %q", args[0])
+		}
+		id = args[0]
+	default:
+		target := m.actionTarget()
+		if target == nil {
+			return m, nil, fmt.Errorf("no issue selected")
+		}
+		id = target.ID
+	}
+
+	schedule, err := analysis.ComputeCriticalPath(m.issues, id)
+	if err != nil {
+		return m, nil, err
+	}
+	if len(schedule.Path) == 0 {
+		return m, nil, fmt.Errorf("%s has no open work", id)
+	}
+	m.setCriticalPath(schedule)
+	m.statusMsg = criticalPathSummary(schedule)
+	m.statusIsError = false
+	return m, nil, nil
+}
+
+// criticalPathSummary describes a schedule for the status bar.
+func criticalPathSummary(s *analysis.EpicSchedule) string {
+	msg := fmt.Sprintf("Critical path to %s: %s, %s of %s of work",
+		s.EpicID, strings.Join(s.Path, " → "), formatEstimate(s.TotalMinutes), formatEstimate(s.WorkMinutes))
+	if s.Unestimated > 0 {
+		msg += fmt.Sprintf(" (%d unestimated, counted at the median)", s.Unestimated)
+	}
+	return msg
+}
+
+// setCriticalPath shows schedule's critical path in the list and the graph,
+// or clears it when schedule is nil.
+func (m *Model) setCriticalPath(schedule *analysis.EpicSchedule) {
+	m.criticalPath = schedule
+	var onPath map[string]bool
+	if schedule != nil {
+		onPath = make(map[string]bool, len(schedule.Path))
+		for _, id := range schedule.Path {
+			onPath[id] = true
+		}
+	}
+	m.criticalIDs = onPath
+	m.graphView.critical = onPath
+	m.updateListDelegate()
+}
+
+// refreshCriticalPath reschedules the shown critical path after a reload,
+// dropping it once its epic is gone or has no open work left.
+func (m *Model) refreshCriticalPath() {
+	if m.criticalPath == nil {
+		return
+	}
+	schedule, err := analysis.ComputeCriticalPath(m.issues, m.criticalPath.EpicID)
+	if err != nil || len(schedule.Path) == 0 {
+		schedule = nil
+	}
+	m.setCriticalPath(schedule)
+}
+
+// criticalMark is the marker of an issue on the shown critical path.
+func criticalMark(r *lipgloss.Renderer, t Theme) string {
+	return r.NewStyle().Foreground(t.Blocked).Bold(true).Render("◆")
+}
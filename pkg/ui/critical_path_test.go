@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestCriticalPathCommand(t *testing.T) {
+	hour, day := 60, 480
+	issues := []model.Issue{
+		{ID: "E-1", Title: "Launch", Status: model.StatusOpen, IssueType: model.TypeEpic},
+		{ID: "E-2", Title: "Schema", Status: model.StatusOpen, EstimatedMinutes: &day, Dependencies: []*model.Dependency{
+			{DependsOnID: "E-1", Type: model.DepParentChild}}},
+		{ID: "E-3", Title: "API", Status: model.StatusOpen, EstimatedMinutes: &day, Dependencies: []*model.Dependency{
+			{DependsOnID: "E-1", Type: model.DepParentChild}, {DependsOnID: "E-2", Type: model.DepBlocks}}},
+		{ID: "E-4", Title: "Docs", Status: model.StatusOpen, EstimatedMinutes: &hour, Dependencies: []*model.Dependency{
+			{DependsOnID: "E-1", Type: model.DepParentChild}}},
+		{ID: "E-5", Title: "Shipped", Status: model.StatusClosed},
+	}
+	m := NewModel(issues, nil, "")
+	newM, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m = newM.(Model)
+
+	m = typeCommand(m, "critical E-1")
+	if m.statusIsError || !strings.Contains(m.statusMsg, "Critical path to E-1: E-2 → E-3, 2d of 2d of work") {
+		t.Fatalf("unexpected status %q", m.statusMsg)
+	}
+	if !m.criticalIDs["E-2"] || !m.criticalIDs["E-3"] || m.criticalIDs["E-4"] {
+		t.Errorf("expected E-2 and E-3 on the path, got %v", m.criticalIDs)
+	}
+	m.statusMsg = ""
+	if view := m.View(); strings.Count(view, "◆") != 2 {
+		t.Errorf("expected two marked rows in the list:\n%s", view)
+	}
+	if !m.graphView.critical["E-3"] {
+		t.Error("expected the graph to mark the path")
+	}
+
+	m = typeCommand(m, "critical off")
+	if m.criticalPath != nil || m.graphView.critical != nil {
+		t.Error("expected off to clear the path")
+	}
+	m.statusMsg = ""
+	if view := m.View(); strings.Contains(view, "◆") {
+		t.Errorf("expected no marks once cleared:\n%s", view)
+	}
+
+	m = typeCommand(m, "critical E-5")
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "no open work") {
+		t.Errorf("expected a closed issue to have no path, got %q", m.statusMsg)
+	}
+	m = typeCommand(m, "critical E-9")
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "unknown issue") {
+		t.Errorf("expected an unknown issue to fail, got %q", m.statusMsg)
+	}
+}
@@ -0,0 +1,103 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/debug"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Debug console
+//
+// F12 (or ":log") shows the tail of the structured log. While it is open,
+// or when ui.log_level is debug, every key event and message is logged
+// too, so it shows exactly what the terminal sends; that is what to look at
+// when a key, such as CapsLock, is not recognized.
+
+// logMsg logs msg at debug level when debug records are kept.
+func logMsg(msg tea.Msg) {
+	log := debug.Logger()
+	if !log.Enabled(context.Background(), slog.LevelDebug) {
+		return
+	}
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		log.Debug("key", "key", msg.String(), "type", int(msg.Type), "runes", fmt.Sprintf("%q", msg.Runes), "alt", msg.Alt, "paste", msg.Paste)
+	case tea.WindowSizeMsg:
+		log.Debug("resize", "width", msg.Width, "height", msg.Height)
+	default:
+		if seq, ok := csiSequence(msg); ok {
+			log.Debug("csi", "seq", fmt.Sprintf("%q", seq))
+			return
+		}
+		log.Debug("msg", "type", fmt.Sprintf("%T", msg))
+	}
+}
+
+// handleDebugConsoleKeys opens the console on F12 and, while it is open,
+// takes every key.
+func (m Model) handleDebugConsoleKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	if !m.showDebugConsole {
+		m.openDebugConsole()
+		return m, nil
+	}
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "f12", "esc", "q":
+		m.showDebugConsole = false
+		debug.SetTailDebug(false)
+	case "c":
+		debug.ClearRecentLog()
+	}
+	return m, nil
+}
+
+func (m *Model) openDebugConsole() {
+	m.showDebugConsole = true
+	debug.SetTailDebug(true)
+}
+
+// renderDebugConsole shows the latest log lines that fit the screen.
+func (m Model) renderDebugConsole() string {
+	t := m.theme
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(0, 1)
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	textStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+	warnStyle := t.Renderer.NewStyle().Foreground(t.Blocked)
+
+	width := max(m.width-6, 20)
+	where := i18n.T("kept in memory only; set ui.log_level to also write a file")
+	if path := debug.LogPath(); path != "" {
+		where = i18n.Tf("also written to %s", path)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(i18n.T("Debug console")) + mutedStyle.Render("  "+where) + "\n\n")
+	lines := debug.RecentLog(max(m.height-8, 1))
+	if len(lines) == 0 {
+		sb.WriteString(mutedStyle.Render(i18n.T("Nothing logged yet. Press keys to see what the terminal sends.")) + "\n")
+	}
+	for _, line := range lines {
+		line = truncateRunesHelper(line, width, "…")
+		if strings.Contains(line, "level=WARN") || strings.Contains(line, "level=ERROR") {
+			sb.WriteString(warnStyle.Render(line) + "\n")
+		} else {
+			sb.WriteString(textStyle.Render(line) + "\n")
+		}
+	}
+	sb.WriteString("\n" + mutedStyle.Render(i18n.T("c: clear • F12/esc: close")))
+
+	return lipgloss.Place(m.width, m.height-1, lipgloss.Left, lipgloss.Top, boxStyle.Width(m.width-2).Render(sb.String()))
+}
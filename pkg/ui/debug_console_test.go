@@ -0,0 +1,58 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/debug"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestDebugConsole_ShowsKeysAndErrors(t *testing.T) {
+	debug.ClearRecentLog()
+	m := commandTestModel(t)
+
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyF12})
+	if m.CurrentContext() != ContextDebugConsole {
+		t.Fatalf("expected F12 to open the debug console, got %s", m.CurrentContext())
+	}
+	cursor := m.list.Index()
+	m = pressKey(m, runeKey("j"))
+	if m.list.Index() != cursor || !m.showDebugConsole {
+		t.Fatal("expected keys to be logged, not acted on, while the console is open")
+	}
+	m.reportError(newError(errData, "reload issues", errors.New("disk full")))
+
+	view := m.View()
+	for _, want := range []string{"Debug console", `key=j`, "runes=['j']", "level=WARN", "reload issues"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected %q in the console:\n%s", want, view)
+		}
+	}
+
+	m = pressKey(m, runeKey("c"))
+	if got := debug.RecentLog(-1); len(got) != 0 {
+		t.Errorf("expected c to clear the log, got %q", got)
+	}
+
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyF12})
+	if m.showDebugConsole {
+		t.Fatal("expected F12 to close the console")
+	}
+	debug.ClearRecentLog()
+	m = pressKey(m, runeKey("j"))
+	if got := debug.RecentLog(-1); len(got) != 0 {
+		t.Errorf("expected keys not to be kept once closed, got %q", got)
+	}
+
+	m = typeCommand(m, "log")
+	if !m.showDebugConsole {
+		t.Error("expected :log to open the console")
+	}
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.showDebugConsole {
+		t.Error("expected esc to close the console")
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
@@ -17,8 +19,47 @@ type IssueDelegate struct {
 	Theme             Theme
 	ShowPriorityHints bool
 	PriorityHints     map[string]*analysis.PriorityRecommendation
-	WorkspaceMode     bool // When true, shows repo prefix badges
-	ShowSearchScores  bool // Show semantic/hybrid score badge when search is active
+	WorkspaceMode     bool             // When true, shows repo prefix badges
+	ShowSearchScores  bool             // Show semantic/hybrid score badge when search is active
+	Now               func() time.Time // Clock for relative ages; nil means time.Now
+	StaleAfter        time.Duration    // Threshold of the aging badge (see aging.go)
+	Presence          *PresenceSession // Marks issues others have selected; nil outside shared sessions
+	Critical          map[string]bool  // Issues on the shown critical path (see critical_path.go)
+	Watches           *WatchList       // Marks watched issues with 👁; nil marks none (see watch.go)
+	Pinned            map[string]bool  // Issues of pin rules, marked 📌 (see escalation.go)
+	External          map[string]bool  // Issues waiting on another repo, marked ⇱ (see external.go)
+	Compact           bool             // Narrow terminal: status icon, short ID, no meta columns (see layout.go)
+
+	rowCache *rowRenderCache // Memoized unselected rows; nil disables caching
+}
+
+// agingBadge renders the days since the last update of an open issue that
+// is aging or stale, or "" for a fresh one.
+func (d IssueDelegate) agingBadge(issue *model.Issue) string {
+	if d.StaleAfter <= 0 {
+		return ""
+	}
+	days, level := agingLevel(issue, clockNow(d.Now), d.StaleAfter)
+	color := ColorWarning
+	switch level {
+	case ageFresh:
+		return ""
+	case ageStale:
+		color = ColorDanger
+	}
+	return d.Theme.Renderer.NewStyle().Foreground(color).Render(fmt.Sprintf("⏳%dd", days))
+}
+
+// presenceMark is the dot of the first other participant on id, if any.
+func (d IssueDelegate) presenceMark(id string) string {
+	if d.Presence == nil {
+		return ""
+	}
+	on := d.Presence.On(id)
+	if len(on) == 0 {
+		return ""
+	}
+	return d.Theme.Renderer.NewStyle().Foreground(on[0].Color).Bold(true).Render("●")
 }
 
 func (d IssueDelegate) Height() int {
@@ -49,6 +90,16 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 
 	isSelected := index == m.Index()
 
+	// Unselected rows are stable between frames; reuse their rendering.
+	var cacheKey string
+	if !isSelected && d.rowCache != nil {
+		cacheKey = d.rowCacheKey(i, width)
+		if row, ok := d.rowCache.get(cacheKey); ok {
+			fmt.Fprint(w, row)
+			return
+		}
+	}
+
 	// ══════════════════════════════════════════════════════════════════════════
 	// POLISHED ROW LAYOUT - Stripe-level visual hierarchy
 	// Layout: [sel] [type] [prio-badge] [status-badge] [ID] [title...] [meta]
@@ -58,8 +109,14 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 	icon, iconColor := t.GetTypeIcon(string(i.Issue.IssueType))
 	idStr := i.Issue.ID
 	title := i.Issue.Title
-	ageStr := FormatTimeRel(i.Issue.CreatedAt)
-	commentCount := len(i.Issue.Comments)
+	ageStr := FormatTimeRel(i.Issue.CreatedAt, clockNow(d.Now))
+	commentCount := 0
+	for _, c := range i.Issue.Comments {
+		if c != nil && !c.IsVote() {
+			commentCount++
+		}
+	}
+	voteCount := len(i.Issue.Voters())
 
 	// Measure actual icon display width (emojis vary: 1-2 cells)
 	iconDisplayWidth := lipgloss.Width(icon)
@@ -69,7 +126,7 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 	var rightParts []string
 
 	// Show Age and Comments only if we have reasonable width
-	if width > 60 {
+	if width > 60 && !d.Compact {
 		// Age - with subtle styling (using pre-computed style)
 		rightParts = append(rightParts, t.MutedText.Render(fmt.Sprintf("%8s", ageStr)))
 		rightWidth += 9
@@ -83,6 +140,19 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 			rightParts = append(rightParts, "   ")
 			rightWidth += 3
 		}
+
+		// Votes only when there are any (see comments.go)
+		if voteCount > 0 {
+			voteStr := fmt.Sprintf("▲%d", voteCount)
+			rightParts = append(rightParts, t.InfoText.Render(voteStr))
+			rightWidth += lipgloss.Width(voteStr) + 1
+		}
+
+		// Days since the last update, once an open issue is aging
+		if badge := d.agingBadge(&i.Issue); badge != "" {
+			rightParts = append(rightParts, badge)
+			rightWidth += lipgloss.Width(badge) + 1
+		}
 	}
 
 	// Sparkline (Graph Score) - visualization of importance
@@ -144,8 +214,11 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 		leftFixedWidth += lipgloss.Width(fmt.Sprintf("↪%d", i.UnblocksCount)) + 1 // arrow+count + space
 	}
 
-	// Status badge (polished)
+	// Status badge (polished), or just its icon in compact rows
 	statusBadge := RenderStatusBadge(string(i.Issue.Status))
+	if d.Compact {
+		statusBadge = getStatusIcon(i.Issue.Status)
+	}
 	statusBadgeWidth := lipgloss.Width(statusBadge)
 	leftFixedWidth += statusBadgeWidth + 1
 
@@ -158,6 +231,9 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 	}
 
 	// ID width - use actual visual width, but cap reasonably
+	if d.Compact {
+		idStr = smartTruncateID(idStr, 12)
+	}
 	idWidth := lipgloss.Width(idStr)
 	if idWidth > 35 {
 		idWidth = 35
@@ -170,6 +246,20 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 		leftFixedWidth += lipgloss.Width(badge) + 1
 	}
 
+	// Watch mark width
+	watched := d.Watches.Watching(i.Issue.ID)
+	if watched {
+		leftFixedWidth += lipgloss.Width(watchMark) + 1
+	}
+	pinned := d.Pinned[i.Issue.ID]
+	if pinned {
+		leftFixedWidth += lipgloss.Width(pinMark) + 1
+	}
+	external := d.External[i.Issue.ID]
+	if external {
+		leftFixedWidth += lipgloss.Width(externalMark) + 1
+	}
+
 	// Title gets everything in between
 	titleWidth := width - leftFixedWidth - rightWidth - 2
 	if titleWidth < 5 {
@@ -193,6 +283,10 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 	// Selection indicator with accent color (using pre-computed style)
 	if isSelected {
 		leftSide.WriteString(t.PrimaryBold.Render("▸ "))
+	} else if mark := d.presenceMark(i.Issue.ID); mark != "" {
+		leftSide.WriteString(mark + " ")
+	} else if d.Critical[i.Issue.ID] {
+		leftSide.WriteString(criticalMark(t.Renderer, t) + " ")
 	} else {
 		leftSide.WriteString("  ")
 	}
@@ -263,6 +357,20 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 		leftSide.WriteString(" ")
 	}
 
+	// Watch mark
+	if watched {
+		leftSide.WriteString(watchMark)
+		leftSide.WriteString(" ")
+	}
+	if pinned {
+		leftSide.WriteString(pinMark)
+		leftSide.WriteString(" ")
+	}
+	if external {
+		leftSide.WriteString(t.Renderer.NewStyle().Foreground(t.Blocked).Render(externalMark))
+		leftSide.WriteString(" ")
+	}
+
 	// Title with emphasis when selected
 	titleStyle := t.Renderer.NewStyle()
 	if isSelected {
@@ -294,5 +402,8 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 		row = rowStyle.Render(row)
 	}
 
+	if cacheKey != "" {
+		d.rowCache.put(cacheKey, row)
+	}
 	fmt.Fprint(w, row)
 }
@@ -12,6 +12,7 @@ import (
 
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 )
 
 // Build a minimal issue item used across delegate tests.
@@ -139,3 +140,40 @@ func TestIssueDelegate_RenderNarrow(t *testing.T) {
 		t.Fatalf("narrow output should hide comments count: %q", out)
 	}
 }
+
+func TestIssueDelegate_RowsAlignWithWideTitles(t *testing.T) {
+	// A title measured narrower than it draws wraps the row or pushes the
+	// right-hand columns off it.
+	theme := DefaultTheme(lipgloss.NewRenderer(os.Stdout))
+	delegate := IssueDelegate{Theme: theme}
+	titles := []string{
+		"Plain ASCII title that is long enough to need truncating somewhere",
+		"日本語のタイトルはとても長いので途中で切り詰める必要があります",
+		"Emoji 🚀🔥 and a family 👨‍👩‍👧 in a long title that gets cut",
+		strings.Repeat("❤️", 80),
+		"Cafe\u0301 cre\u0300me bru\u0302le\u0301e with combining accents, again and again",
+	}
+
+	l := list.New(nil, delegate, 0, 0)
+	l.SetWidth(160)
+	var rowWidth int
+	for i, title := range titles {
+		item := newTestIssueItem("ALIGN-1")
+		item.Issue.Title = title
+		var buf bytes.Buffer
+		delegate.Render(&buf, l, 0, item)
+		row := ansi.Strip(buf.String())
+		if strings.Contains(row, "\n") {
+			t.Errorf("row for %q wrapped:\n%s", title, row)
+			continue
+		}
+		if i == 0 {
+			rowWidth = lipgloss.Width(row)
+		} else if got := lipgloss.Width(row); got != rowWidth {
+			t.Errorf("row for %q is %d cells, want %d like the ASCII row", title, got, rowWidth)
+		}
+		if !strings.HasSuffix(strings.TrimRight(row, " "), "one,two") {
+			t.Errorf("row for %q lost its right-hand columns: %q", title, row)
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package ui
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+)
+
+// Drafts
+//
+// ctrl+s in the quick capture form keeps the new issue as a local draft
+// instead of creating it. Drafts are personal, like watches: they live under
+// the user config dir and never reach the beads file, so exports and sync
+// don't see them. Publishing one from the drafts list ("D") creates it with
+// bd like the capture form does, issue-created hooks included.
+
+// Draft is an issue that has not been created yet.
+type Draft struct {
+	ID       int       `json:"id"` // Local number, not a beads ID
+	Title    string    `json:"title"`
+	Priority int       `json:"priority"`
+	Parent   string    `json:"parent,omitempty"`
+	Blocker  string    `json:"blocker,omitempty"`
+	Created  time.Time `json:"created"`
+}
+
+// createArgs returns the bd create arguments that publish the draft.
+func (d Draft) createArgs() []string {
+	args := []string{"create", d.Title, "--priority", strconv.Itoa(d.Priority), "--json"}
+	if d.Parent != "" {
+		args = append(args, "--parent", d.Parent)
+	}
+	if d.Blocker != "" {
+		args = append(args, "--deps", "blocks:"+d.Blocker)
+	}
+	return args
+}
+
+// DraftList holds the drafts of one project.
+type DraftList struct {
+	path   string // Empty keeps the drafts in memory only
+	drafts []Draft
+	next   int
+}
+
+type draftsFile struct {
+	Drafts []Draft `json:"drafts"`
+}
+
+// NewDraftList returns an empty draft list that is not saved anywhere.
+func NewDraftList() *DraftList {
+	return &DraftList{next: 1}
+}
+
+// DraftsPath returns where the drafts of the project in projectDir are
+// stored, next to its watch list.
+func DraftsPath(projectDir string) (string, error) {
+	return userProjectFile("drafts", projectDir)
+}
+
+// LoadDrafts reads the drafts at path; a missing file is an empty list.
+// Changes are saved back to path.
+func LoadDrafts(path string) (*DraftList, error) {
+	l := NewDraftList()
+	l.path = path
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return l, nil
+	}
+	if err != nil {
+		return l, err
+	}
+	var f draftsFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return l, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	l.drafts = f.Drafts
+	for _, d := range l.drafts {
+		l.next = max(l.next, d.ID+1)
+	}
+	return l, nil
+}
+
+// All returns the drafts, oldest first.
+func (l *DraftList) All() []Draft {
+	if l == nil {
+		return nil
+	}
+	return l.drafts
+}
+
+// Add numbers d, appends it and saves the list.
+func (l *DraftList) Add(d Draft) (Draft, error) {
+	d.ID = l.next
+	l.next++
+	l.drafts = append(l.drafts, d)
+	return d, l.save()
+}
+
+// Remove deletes the draft numbered id and saves the list.
+func (l *DraftList) Remove(id int) error {
+	i := slices.IndexFunc(l.drafts, func(d Draft) bool { return d.ID == id })
+	if i < 0 {
+		return nil
+	}
+	l.drafts = slices.Delete(l.drafts, i, i+1)
+	return l.save()
+}
+
+func (l *DraftList) save() error {
+	if l.path == "" {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(l.path), 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(draftsFile{Drafts: l.drafts}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(l.path, data, 0o644)
+}
+
+// EnableDrafts loads the drafts stored at path (see DraftsPath) and saves
+// changes there. Without it drafts last for the session only.
+func (m *Model) EnableDrafts(path string) error {
+	l, err := LoadDrafts(path)
+	m.drafts = l
+	return err
+}
+
+// saveDraft keeps the capture form's issue as a draft.
+func (m *Model) saveDraft(d Draft) {
+	d.Created = clockNow(m.now)
+	saved, err := m.drafts.Add(d)
+	if err != nil {
+		m.reportError(newError(errData, "save draft", err))
+		return
+	}
+	m.statusMsg = i18n.Tf("📝 Saved draft %d (D to list drafts)", saved.ID)
+	m.statusIsError = false
+}
+
+func (m *Model) openDrafts() {
+	if m.refuseReadOnly(i18n.T("Drafts")) {
+		return
+	}
+	m.showDrafts = true
+	m.draftsCursor = 0
+}
+
+// handleDraftsKeys moves through the drafts; p or enter publishes the
+// selected one and x deletes it.
+func (m Model) handleDraftsKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	drafts := m.drafts.All()
+	switch msg.String() {
+	case "esc", "q", "D":
+		m.showDrafts = false
+	case "j", "down":
+		if m.draftsCursor < len(drafts)-1 {
+			m.draftsCursor++
+		}
+	case "k", "up":
+		if m.draftsCursor > 0 {
+			m.draftsCursor--
+		}
+	case "x":
+		if m.draftsCursor < len(drafts) {
+			d := drafts[m.draftsCursor]
+			if err := m.drafts.Remove(d.ID); err != nil {
+				m.reportError(newError(errData, "delete draft", err))
+				return m, nil
+			}
+			m.draftsCursor = min(m.draftsCursor, max(len(m.drafts.All())-1, 0))
+			m.statusMsg = i18n.Tf("Deleted draft %d", d.ID)
+			m.statusIsError = false
+		}
+	case "p", "enter":
+		if m.draftsCursor >= len(drafts) {
+			return m, nil
+		}
+		d := drafts[m.draftsCursor]
+		if !bdAvailable() {
+			m.reportError(newError(errConfig, "publish draft", errors.New("bd not found on PATH")).
+				withHint("install the beads CLI to publish drafts from bv"))
+			return m, nil
+		}
+		if err := m.checkDraft(d); err != nil {
+			m.statusMsg = i18n.Tf("Draft %d: %v", d.ID, err)
+			m.statusIsError = true
+			return m, nil
+		}
+		m.showDrafts = false
+		m.statusMsg = i18n.Tf("Publishing draft %d…", d.ID)
+		m.statusIsError = false
+		return m, m.createIssueCmd(d)
+	}
+	return m, nil
+}
+
+func (m Model) renderDrafts() string {
+	t := m.theme
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 3)
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	textStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+	selectedStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+
+	width := max(min(m.width-12, 90), 30)
+	now := clockNow(m.now)
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(i18n.T("Drafts")) + "\n")
+	sb.WriteString(mutedStyle.Render(i18n.T("Kept on this machine only: not in the beads file, exports or sync")) + "\n\n")
+
+	drafts := m.drafts.All()
+	if len(drafts) == 0 {
+		sb.WriteString(mutedStyle.Render(i18n.T("No drafts: use ctrl+s in the new issue form (+)")) + "\n")
+	}
+	visible := max((m.height-12)/2, 1)
+	start := 0
+	if m.draftsCursor >= visible {
+		start = m.draftsCursor - visible + 1
+	}
+	for i := start; i < len(drafts) && i < start+visible; i++ {
+		d := drafts[i]
+		cursor, style := "  ", textStyle
+		if i == m.draftsCursor {
+			cursor, style = selectedStyle.Render("▸ "), selectedStyle
+		}
+		sb.WriteString(cursor + style.Render(truncateRunesHelper(fmt.Sprintf("P%d %s", d.Priority, d.Title), width-4, "…")) + "\n")
+		meta := []string{i18n.Tf("draft %d", d.ID), i18n.Tf("saved %s", FormatTimeRel(d.Created, now))}
+		if d.Parent != "" {
+			meta = append(meta, i18n.Tf("parent %s", d.Parent))
+		}
+		if d.Blocker != "" {
+			meta = append(meta, i18n.Tf("blocked by %s", d.Blocker))
+		}
+		sb.WriteString("    " + mutedStyle.Render(strings.Join(meta, " • ")) + "\n")
+	}
+	sb.WriteString("\n" + mutedStyle.Render(i18n.T("j/k: navigate • p/enter: publish with bd • x: delete • esc: close")))
+
+	return lipgloss.Place(m.width, m.height-1, lipgloss.Center, lipgloss.Center, boxStyle.Render(sb.String()))
+}
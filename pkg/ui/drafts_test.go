@@ -0,0 +1,103 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestDrafts_SaveAndPublish(t *testing.T) {
+	fakeBD(t, `echo "$@" > args; echo '{"id":"A-4","title":"Fix login"}'`)
+	m := commandTestModel(t)
+	m.workDir = t.TempDir()
+	path := filepath.Join(t.TempDir(), "drafts.json")
+	if err := m.EnableDrafts(path); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(m.workDir, ".bv"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	hooksYAML := "hooks:\n  issue-created:\n    - name: announce\n      command: echo announced $BV_ISSUE_ID $BV_ISSUE_PRIORITY\n"
+	if err := os.WriteFile(filepath.Join(m.workDir, ".bv", "hooks.yaml"), []byte(hooksYAML), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	m = pressKey(m, runeKey("+"))
+	m = typeText(m, "Fix login")
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyTab})
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyBackspace})
+	m = typeText(m, "1")
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyCtrlS})
+	if m.showCapture || !strings.Contains(m.statusMsg, "Saved draft 1") {
+		t.Fatalf("expected ctrl+s to save a draft, got %q", m.statusMsg)
+	}
+	if _, err := os.Stat(filepath.Join(m.workDir, "args")); err == nil {
+		t.Fatal("expected a draft not to run bd")
+	}
+	loaded, err := LoadDrafts(path)
+	if err != nil || len(loaded.All()) != 1 || loaded.All()[0].Title != "Fix login" || loaded.next != 2 {
+		t.Fatalf("expected the draft saved to %s, got %+v (%v)", path, loaded, err)
+	}
+
+	m = pressKey(m, runeKey("D"))
+	if m.CurrentContext() != ContextDrafts || !strings.Contains(m.View(), "P1 Fix login") {
+		t.Fatalf("expected the drafts list, got %s", m.CurrentContext())
+	}
+	newM, cmd := m.Update(runeKey("p"))
+	m = newM.(Model)
+	if m.showDrafts || cmd == nil {
+		t.Fatal("expected p to close the list and run bd")
+	}
+	newM, cmd = m.Update(cmd())
+	m = newM.(Model)
+	if m.capturedID != "A-4" || len(m.drafts.All()) != 0 {
+		t.Fatalf("expected the draft published and removed, got %q (%d drafts)", m.capturedID, len(m.drafts.All()))
+	}
+	args, err := os.ReadFile(filepath.Join(m.workDir, "args"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.TrimSpace(string(args)); got != "create Fix login --priority 1 --json" {
+		t.Errorf("unexpected bd arguments %q", got)
+	}
+	if cmd == nil {
+		t.Fatal("expected the issue-created hook to run")
+	}
+	newM, _ = m.Update(cmd())
+	m = newM.(Model)
+	if !strings.Contains(m.statusMsg, "Hook announce finished for A-4: announced A-4 1") {
+		t.Errorf("expected the hook result, got %q", m.statusMsg)
+	}
+}
+
+func TestDrafts_DeleteAndReadOnly(t *testing.T) {
+	m := commandTestModel(t)
+	for _, title := range []string{"One", "Two"} {
+		m.saveDraft(Draft{Title: title, Priority: 2, Blocker: "A-1"})
+	}
+	m, _, err := m.ExecCommand("drafts")
+	if err != nil || m.CurrentContext() != ContextDrafts {
+		t.Fatalf("expected :drafts to open the list, got %s (%v)", m.CurrentContext(), err)
+	}
+	if !strings.Contains(m.View(), "blocked by A-1") {
+		t.Error("expected the blocker shown")
+	}
+	m = pressKey(m, runeKey("j"))
+	m = pressKey(m, runeKey("x"))
+	if drafts := m.drafts.All(); len(drafts) != 1 || drafts[0].Title != "One" || m.draftsCursor != 0 {
+		t.Fatalf("expected draft 2 deleted, got %+v", drafts)
+	}
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.showDrafts {
+		t.Fatal("expected esc to close the list")
+	}
+
+	m.EnableReadOnly()
+	m = pressKey(m, runeKey("D"))
+	if m.showDrafts || !strings.Contains(m.statusMsg, "read-only") {
+		t.Errorf("expected drafts refused in a read-only session, got %q", m.statusMsg)
+	}
+}
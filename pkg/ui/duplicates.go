@@ -0,0 +1,177 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Duplicate review
+//
+// ":duplicates" lists pairs of open issues whose titles and descriptions
+// share most of their keywords (Jaccard similarity, see
+// analysis.FindDuplicatePairs), most similar first. enter jumps to the
+// first issue of a pair; d closes the second as a duplicate of the first,
+// and D the first as a duplicate of the second, through bd. The pairs are
+// found in the background, since a large project takes a moment.
+
+// duplicateThreshold is the keyword similarity of a pair worth reviewing.
+// It is lower than the analysis default: the review is a shortlist for a
+// person, not an automatic suggestion.
+const duplicateThreshold = 0.5
+
+// duplicateReviewMax bounds the pairs listed.
+const duplicateReviewMax = 100
+
+// duplicatesMsg carries the pairs found for the review.
+type duplicatesMsg struct {
+	pairs []analysis.DuplicatePair
+}
+
+// findDuplicatesCmd finds the pairs of open issues worth reviewing.
+func findDuplicatesCmd(issues []model.Issue) tea.Cmd {
+	return func() tea.Msg {
+		open := make([]model.Issue, 0, len(issues))
+		for _, issue := range issues {
+			if !isClosedLikeStatus(issue.Status) {
+				open = append(open, issue)
+			}
+		}
+		config := analysis.DefaultDuplicateConfig()
+		config.JaccardThreshold = duplicateThreshold
+		config.MaxSuggestions = duplicateReviewMax
+		return duplicatesMsg{pairs: analysis.FindDuplicatePairs(open, config)}
+	}
+}
+
+// openDuplicates opens the review and starts looking for pairs.
+func (m *Model) openDuplicates() tea.Cmd {
+	m.showDuplicates = true
+	m.duplicatesLoading = true
+	m.duplicatePairs = nil
+	m.duplicatesCursor = 0
+	return findDuplicatesCmd(m.issues)
+}
+
+// markDuplicate closes dup as a duplicate of keep with bd, and drops the
+// pairs dup was in from the review.
+func (m Model) markDuplicate(dup, keep string) (Model, tea.Cmd) {
+	if m.readOnly {
+		m.statusMsg = i18n.T("Read-only session: cannot close duplicates")
+		m.statusIsError = true
+		return m, nil
+	}
+	if !bdAvailable() {
+		m.statusMsg = i18n.T("bd not found on PATH: duplicates are closed with bd close")
+		m.statusIsError = true
+		return m, nil
+	}
+	issue, ok := m.issueMap[dup]
+	if !ok {
+		m.statusMsg = i18n.Tf("Issue %s no longer exists", dup)
+		m.statusIsError = true
+		return m, nil
+	}
+	kept := m.duplicatePairs[:0:0]
+	for _, pair := range m.duplicatePairs {
+		if pair.Issue1 != dup && pair.Issue2 != dup {
+			kept = append(kept, pair)
+		}
+	}
+	m.duplicatePairs = kept
+	m.duplicatesCursor = min(m.duplicatesCursor, max(len(kept)-1, 0))
+	summary := i18n.Tf("Closed %s as a duplicate of %s", dup, keep)
+	return m.moveIssue(*issue, model.StatusClosed, summary, "close", dup, "--reason=Duplicate of "+keep)
+}
+
+// handleDuplicatesKeys moves through the pairs, jumps to one, or closes
+// one issue of a pair as a duplicate of the other.
+func (m Model) handleDuplicatesKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	pairs := m.duplicatePairs
+	switch msg.String() {
+	case "esc", "q":
+		m.showDuplicates = false
+	case "j", "down":
+		if m.duplicatesCursor < len(pairs)-1 {
+			m.duplicatesCursor++
+		}
+	case "k", "up":
+		if m.duplicatesCursor > 0 {
+			m.duplicatesCursor--
+		}
+	case "enter":
+		if m.duplicatesCursor < len(pairs) {
+			m.showDuplicates = false
+			return m.gotoIssue(pairs[m.duplicatesCursor].Issue1), nil
+		}
+	case "d":
+		if m.duplicatesCursor < len(pairs) {
+			pair := pairs[m.duplicatesCursor]
+			return m.markDuplicate(pair.Issue2, pair.Issue1)
+		}
+	case "D":
+		if m.duplicatesCursor < len(pairs) {
+			pair := pairs[m.duplicatesCursor]
+			return m.markDuplicate(pair.Issue1, pair.Issue2)
+		}
+	}
+	return m, nil
+}
+
+func (m Model) renderDuplicates() string {
+	t := m.theme
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 3)
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	idStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Bold(true)
+	textStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+	selectedStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+
+	width := max(min(m.width-12, 90), 40)
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(i18n.T("Possible duplicates")) + "\n\n")
+
+	pairs := m.duplicatePairs
+	switch {
+	case m.duplicatesLoading:
+		sb.WriteString(mutedStyle.Render(i18n.T("Comparing issues…")) + "\n")
+	case len(pairs) == 0:
+		sb.WriteString(mutedStyle.Render(i18n.T("No open issues look alike")) + "\n")
+	}
+	// Keep the cursor visible in a window of pairs that fits the screen.
+	visible := max((m.height-12)/3, 1)
+	start := 0
+	if m.duplicatesCursor >= visible {
+		start = m.duplicatesCursor - visible + 1
+	}
+	title := func(id string) string {
+		if issue, ok := m.issueMap[id]; ok {
+			return issue.Title
+		}
+		return ""
+	}
+	for i := start; i < len(pairs) && i < start+visible; i++ {
+		pair := pairs[i]
+		cursor, style := "  ", textStyle
+		if i == m.duplicatesCursor {
+			cursor, style = selectedStyle.Render("▸ "), selectedStyle
+		}
+		sb.WriteString(cursor + mutedStyle.Render(fmt.Sprintf("%3.0f%% ", pair.Similarity*100)) + idStyle.Render(pair.Issue1) + " " +
+			style.Render(truncateRunesHelper(title(pair.Issue1), width-lipgloss.Width(pair.Issue1)-8, "…")) + "\n")
+		sb.WriteString("       " + idStyle.Render(pair.Issue2) + " " +
+			style.Render(truncateRunesHelper(title(pair.Issue2), width-lipgloss.Width(pair.Issue2)-8, "…")) + "\n")
+		sb.WriteString("       " + mutedStyle.Render(truncateRunesHelper(i18n.Tf("common: %s", strings.Join(pair.Keywords, ", ")), width-7, "…")) + "\n")
+	}
+	sb.WriteString("\n" + mutedStyle.Render(i18n.T("j/k: navigate • enter: go to issue • d/D: close the second/first as a duplicate • esc: close")))
+
+	return lipgloss.Place(m.width, m.height-1, lipgloss.Center, lipgloss.Center, boxStyle.Render(sb.String()))
+}
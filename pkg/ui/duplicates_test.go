@@ -0,0 +1,89 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestDuplicates_ReviewAndClose(t *testing.T) {
+	withBD(t, true)
+	fakeBD(t, `echo "$@" > args`)
+	issues := []model.Issue{
+		{ID: "A-1", Title: "Login page crashes on expired session token", Status: model.StatusOpen},
+		{ID: "A-2", Title: "Login page crashes when session token expired", Status: model.StatusOpen},
+		{ID: "A-3", Title: "Export report as CSV", Status: model.StatusOpen},
+		{ID: "A-4", Title: "Login page crashes on expired session token", Status: model.StatusClosed},
+	}
+	m := NewModel(issues, nil, "")
+	newM, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m = newM.(Model)
+	m.workDir = t.TempDir()
+
+	m, cmd, err := m.ExecCommand("duplicates")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.CurrentContext() != ContextDuplicates || !strings.Contains(ansi.Strip(m.View()), "Comparing issues") {
+		t.Fatalf("expected the review to open while comparing, got %s", m.CurrentContext())
+	}
+	newM, _ = m.Update(cmd())
+	m = newM.(Model)
+	if len(m.duplicatePairs) != 1 || m.duplicatePairs[0].Issue1 != "A-1" || m.duplicatePairs[0].Issue2 != "A-2" {
+		t.Fatalf("expected only the open pair A-1/A-2, got %+v", m.duplicatePairs)
+	}
+	if view := ansi.Strip(m.View()); !strings.Contains(view, "A-1") || !strings.Contains(view, "A-2") || strings.Contains(view, "A-3") {
+		t.Errorf("expected the pair listed, got:\n%s", view)
+	}
+
+	m.readOnly = true
+	m = pressKey(m, runeKey("d"))
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "Read-only") || len(m.duplicatePairs) != 1 {
+		t.Fatalf("expected read-only sessions to refuse, got %q", m.statusMsg)
+	}
+	m.readOnly = false
+
+	newM, cmd = m.Update(runeKey("D"))
+	m = newM.(Model)
+	if cmd == nil || len(m.duplicatePairs) != 0 {
+		t.Fatalf("expected D to close A-1 and drop the pair, got %+v", m.duplicatePairs)
+	}
+	newM, _ = m.Update(cmd())
+	m = newM.(Model)
+	if !strings.Contains(m.statusMsg, "Closed A-1 as a duplicate of A-2") {
+		t.Errorf("expected the close summary, got %q", m.statusMsg)
+	}
+	args, err := os.ReadFile(filepath.Join(m.workDir, "args"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.TrimSpace(string(args)); got != "close A-1 --reason=Duplicate of A-2" {
+		t.Errorf("unexpected bd arguments %q", got)
+	}
+	if !strings.Contains(ansi.Strip(m.View()), "No open issues look alike") {
+		t.Error("expected the emptied review to say so")
+	}
+	m = pressKey(m, runeKey("q"))
+	if m.CurrentContext() == ContextDuplicates {
+		t.Error("expected q to close the review")
+	}
+}
+
+func TestDuplicates_EnterJumpsToIssue(t *testing.T) {
+	m := commandTestModel(t)
+	m.showDuplicates = true
+	m.duplicatePairs = []analysis.DuplicatePair{{Issue1: "A-2", Issue2: "A-1", Similarity: 0.8}}
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if m.showDuplicates {
+		t.Fatal("expected enter to close the review")
+	}
+	if sel, ok := m.list.SelectedItem().(IssueItem); !ok || sel.Issue.ID != "A-2" {
+		t.Errorf("expected A-2 selected, got %v", m.list.SelectedItem())
+	}
+}
@@ -0,0 +1,249 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	runtimedebug "runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/debug"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Errors
+//
+// Failures the TUI reports are categorized by where they come from, and the
+// category decides how they are shown:
+//
+//   - data, hook, terminal and internal errors appear in the status bar
+//     until the next key press
+//   - config errors open a modal: they recur until the configuration is fixed
+//   - network errors are only logged: update checks are optional and fail
+//     whenever bv runs offline
+//
+// All of them go to the diagnostics log that ":errors" shows.
+
+// errorKind is the category of a uiError.
+type errorKind int
+
+const (
+	errData     errorKind = iota // Reading or writing issues, history and exports
+	errNetwork                   // Update checks
+	errConfig                    // Configuration and environment, such as $EDITOR
+	errHook                      // Hooks and user commands
+	errTerminal                  // Clipboard, browser and editor
+	errInternal                  // A panic while handling a message
+)
+
+func (k errorKind) String() string {
+	switch k {
+	case errData:
+		return "data"
+	case errNetwork:
+		return "network"
+	case errConfig:
+		return "config"
+	case errHook:
+		return "hook"
+	case errTerminal:
+		return "terminal"
+	case errInternal:
+		return "internal"
+	}
+	return "unknown"
+}
+
+// uiError is a failure to report to the user: its category, what bv was
+// doing and the cause.
+type uiError struct {
+	kind errorKind
+	op   string // What failed, e.g. "reload issues"
+	hint string // What the user can do about it; may be empty
+	err  error
+}
+
+func newError(kind errorKind, op string, err error) *uiError {
+	return &uiError{kind: kind, op: op, err: err}
+}
+
+// withHint sets what the user can do about the error.
+func (e *uiError) withHint(hint string) *uiError {
+	e.hint = hint
+	return e
+}
+
+func (e *uiError) Error() string {
+	return e.op + ": " + e.err.Error()
+}
+
+func (e *uiError) Unwrap() error {
+	return e.err
+}
+
+// diagnostic is an entry of the diagnostics log.
+type diagnostic struct {
+	at  time.Time
+	err *uiError
+}
+
+// maxDiagnostics bounds the diagnostics log; older entries are dropped.
+const maxDiagnostics = 100
+
+// updateCheckFailedMsg reports a failed background update check.
+type updateCheckFailedMsg struct {
+	err error
+}
+
+// reportError logs err in the diagnostics log and shows it as its category
+// calls for. Errors without a category are shown in the status bar.
+func (m *Model) reportError(err error) {
+	var ue *uiError
+	if !errors.As(err, &ue) {
+		m.statusMsg = "❌ " + err.Error()
+		m.statusIsError = true
+		return
+	}
+	m.logDiagnostic(ue)
+	debug.Logger().Warn(ue.Error(), "kind", ue.kind.String(), "op", ue.op)
+
+	switch ue.kind {
+	case errNetwork:
+	case errConfig:
+		m.errorModal = ue
+	default:
+		m.statusMsg = "❌ " + err.Error()
+		if ue.hint != "" {
+			m.statusMsg += " (" + ue.hint + ")"
+		}
+		m.statusIsError = true
+	}
+}
+
+// logDiagnostic adds ue to the diagnostics log without showing it.
+func (m *Model) logDiagnostic(ue *uiError) {
+	m.diagnostics = append(m.diagnostics, diagnostic{at: clockNow(m.now), err: ue})
+	if n := len(m.diagnostics); n > maxDiagnostics {
+		m.diagnostics = append([]diagnostic(nil), m.diagnostics[n-maxDiagnostics:]...)
+	}
+}
+
+// noteLoadWarnings logs the records a load skipped, such as malformed
+// lines, in the diagnostics log. The status bar only counts them.
+func (m *Model) noteLoadWarnings(warnings []string) {
+	if len(warnings) > maxDiagnostics {
+		warnings = warnings[len(warnings)-maxDiagnostics:]
+	}
+	for _, w := range warnings {
+		m.logDiagnostic(newError(errData, "load issues", errors.New(w)))
+	}
+}
+
+// recoverUpdate turns a panic while handling msg into an internal error,
+// so that bad input cannot take the TUI down: the model stays as it was
+// before the message. Use it deferred, with Update's named results.
+func (m Model) recoverUpdate(msg tea.Msg, newM *tea.Model, cmd *tea.Cmd) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	debug.Logger().Error("panic", "msg", fmt.Sprintf("%T", msg), "value", fmt.Sprint(r), "stack", string(runtimedebug.Stack()))
+	m.reportError(newError(errInternal, fmt.Sprintf("handle %T", msg), fmt.Errorf("%v", r)).
+		withHint("bv kept running; please report this"))
+	*newM, *cmd = m, nil
+}
+
+func (m Model) renderErrorModal() string {
+	t := m.theme
+	e := m.errorModal
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Blocked).
+		Padding(1, 3)
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Blocked).Bold(true)
+	textStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+
+	width := max(min(m.width-12, 80), 30)
+	wrap := textStyle.Width(width)
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(i18n.Tf("Cannot %s", e.op)) + "\n\n")
+	sb.WriteString(wrap.Render(e.err.Error()) + "\n")
+	if e.hint != "" {
+		sb.WriteString("\n" + wrap.Render(e.hint) + "\n")
+	}
+	sb.WriteString("\n" + mutedStyle.Render(i18n.T("Press any key to close • :errors lists all errors")))
+
+	return lipgloss.Place(m.width, m.height-1, lipgloss.Center, lipgloss.Center, boxStyle.Render(sb.String()))
+}
+
+// handleDiagnosticsKeys scrolls the diagnostics log.
+func (m Model) handleDiagnosticsKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q":
+		m.showDiagnostics = false
+	case "j", "down":
+		if m.diagnosticsCursor < len(m.diagnostics)-1 {
+			m.diagnosticsCursor++
+		}
+	case "k", "up":
+		if m.diagnosticsCursor > 0 {
+			m.diagnosticsCursor--
+		}
+	}
+	return m, nil
+}
+
+// openDiagnostics shows the diagnostics log with the latest entry selected.
+func (m *Model) openDiagnostics() {
+	m.showDiagnostics = true
+	m.diagnosticsCursor = max(len(m.diagnostics)-1, 0)
+}
+
+func (m Model) renderDiagnostics() string {
+	t := m.theme
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 3)
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	kindStyle := t.Renderer.NewStyle().Foreground(t.Blocked).Bold(true)
+	textStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+	selectedStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+
+	width := max(min(m.width-12, 100), 30)
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(i18n.T("Errors")) + "\n\n")
+	if len(m.diagnostics) == 0 {
+		sb.WriteString(mutedStyle.Render(i18n.T("No errors since bv started")) + "\n")
+	}
+	// Keep the cursor visible in a window of entries that fits the screen.
+	visible := max((m.height-12)/2, 1)
+	start := 0
+	if m.diagnosticsCursor >= visible {
+		start = m.diagnosticsCursor - visible + 1
+	}
+	for i := start; i < len(m.diagnostics) && i < start+visible; i++ {
+		d := m.diagnostics[i]
+		cursor, op := "  ", textStyle.Render(d.err.op)
+		if i == m.diagnosticsCursor {
+			cursor, op = selectedStyle.Render("▸ "), selectedStyle.Render(d.err.op)
+		}
+		sb.WriteString(cursor + mutedStyle.Render(d.at.Format("15:04:05")) + " " +
+			kindStyle.Render(fmt.Sprintf("%-8s", d.err.kind)) + " " + op + "\n")
+		detail := d.err.err.Error()
+		if d.err.hint != "" {
+			detail += " (" + d.err.hint + ")"
+		}
+		sb.WriteString("           " + textStyle.Render(truncateRunesHelper(detail, width-11, "…")) + "\n")
+	}
+	sb.WriteString("\n" + mutedStyle.Render(i18n.T("j/k: navigate • esc: close")))
+
+	return lipgloss.Place(m.width, m.height-1, lipgloss.Center, lipgloss.Center, boxStyle.Render(sb.String()))
+}
@@ -0,0 +1,118 @@
+package ui
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestReportError_PresentationByKind(t *testing.T) {
+	m := commandTestModel(t)
+
+	// Network: logged only
+	newM, _ := m.Update(updateCheckFailedMsg{err: errors.New("dial tcp: no route to host")})
+	m = newM.(Model)
+	if m.statusMsg != "" || m.errorModal != nil || len(m.diagnostics) != 1 {
+		t.Fatalf("expected a network error to be logged only, got status %q modal %v", m.statusMsg, m.errorModal)
+	}
+
+	// Hook: status bar, cause kept
+	newM, _ = m.Update(issueActionResultMsg{err: newError(errHook, "run hook notify", fs.ErrPermission)})
+	m = newM.(Model)
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "run hook notify: permission denied") {
+		t.Fatalf("expected the hook error in the status bar, got %q", m.statusMsg)
+	}
+	if !errors.Is(m.diagnostics[1].err, fs.ErrPermission) {
+		t.Error("expected the cause to be wrapped")
+	}
+
+	// Config: modal until a key is pressed
+	m.reportError(newError(errConfig, "open editor", errors.New("no GUI editor found")).withHint("set $EDITOR to a GUI editor"))
+	if m.CurrentContext() != ContextErrorModal {
+		t.Fatalf("expected the error modal, got %s", m.CurrentContext())
+	}
+	view := m.View()
+	for _, want := range []string{"Cannot open editor", "no GUI editor found", "set $EDITOR to a GUI editor"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected %q in the modal:\n%s", want, view)
+		}
+	}
+	newM, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	m = newM.(Model)
+	if m.errorModal != nil {
+		t.Fatal("expected a key to close the modal")
+	}
+
+	// All three are in the diagnostics log
+	m, _, err := m.ExecCommand("errors")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.CurrentContext() != ContextDiagnostics {
+		t.Fatalf("expected the diagnostics log, got %s", m.CurrentContext())
+	}
+	view = m.View()
+	for _, want := range []string{"network", "check for updates", "hook", "config", "open editor"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected %q in the diagnostics log:\n%s", want, view)
+		}
+	}
+	newM, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if newM.(Model).showDiagnostics {
+		t.Error("expected esc to close the diagnostics log")
+	}
+}
+
+func TestReportError_BoundedLog(t *testing.T) {
+	m := commandTestModel(t)
+	for i := 0; i < maxDiagnostics+5; i++ {
+		m.reportError(newError(errNetwork, "check for updates", errors.New("offline")))
+	}
+	if len(m.diagnostics) != maxDiagnostics {
+		t.Fatalf("expected %d entries, got %d", maxDiagnostics, len(m.diagnostics))
+	}
+}
+
+func TestRecoverUpdate_ReportsPanics(t *testing.T) {
+	m := commandTestModel(t)
+	m.currentFilter = "open"
+	newM, cmd := func() (newM tea.Model, cmd tea.Cmd) {
+		defer m.recoverUpdate(runeKey("x"), &newM, &cmd)
+		m.currentFilter = "closed"
+		panic("index out of range")
+	}()
+	got := newM.(Model)
+	if cmd != nil || got.currentFilter != "open" {
+		t.Fatalf("expected the model from before the message, got filter %q", got.currentFilter)
+	}
+	if len(got.diagnostics) != 1 || got.diagnostics[0].err.kind != errInternal || !strings.Contains(got.statusMsg, "index out of range") {
+		t.Fatalf("expected an internal error in the log and status bar, got %q", got.statusMsg)
+	}
+}
+
+func TestReload_LogsSkippedLines(t *testing.T) {
+	beads := filepath.Join(t.TempDir(), "beads.jsonl")
+	if err := os.WriteFile(beads, []byte(`{"id":"ONE","title":"One","status":"open"}`+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	m := NewModel(nil, nil, beads)
+	if m.watcher != nil {
+		m.watcher.Stop()
+	}
+	if err := os.WriteFile(beads, []byte(`{"id":"ONE","title":"One","status":"open","issue_type":"task"}`+"\nnot json\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	newM, _ := m.Update(FileChangedMsg{})
+	m = newM.(Model)
+	if !strings.Contains(m.statusMsg, "1 warnings, see :errors") {
+		t.Errorf("expected the warning counted in the status bar, got %q", m.statusMsg)
+	}
+	if len(m.diagnostics) != 1 || m.diagnostics[0].err.op != "load issues" {
+		t.Fatalf("expected the skipped line in the diagnostics log, got %d entries", len(m.diagnostics))
+	}
+}
@@ -0,0 +1,352 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Escalation rules
+//
+// ui.escalation declares rules for issues that need more attention than
+// their priority gives them. A rule matches the open issues that pass its
+// list filter, and with idle_days only those that went that long without
+// an update. A rule with a priority suggests raising its issues to it; a
+// pin rule lists its issues first, marked 📌, whatever the sort. The rules
+// are evaluated on load and on every reload. ":escalations" reviews the
+// suggestions: a accepts one with bd update, A accepts them all, and d
+// dismisses one for the rest of the session.
+
+// pinMark marks the issues of pin rules in the list.
+const pinMark = "📌"
+
+// escalationRule is a checked ui.escalation rule.
+type escalationRule struct {
+	name     string
+	when     string        // List filter
+	idle     time.Duration // Time without an update; 0 for any
+	priority int           // Priority to suggest; -1 for none
+	pin      bool
+}
+
+// escalation suggests raising an issue's priority.
+type escalation struct {
+	id       string
+	rule     string // Name of the rule that suggests it
+	from, to int
+}
+
+// escalationKey identifies a suggestion across evaluations.
+type escalationKey struct {
+	id string
+	to int
+}
+
+func (e escalation) key() escalationKey {
+	return escalationKey{e.id, e.to}
+}
+
+// ValidateEscalationRules checks ui.escalation: every rule needs a filter
+// that parses and a priority of 0-4 or pin.
+func ValidateEscalationRules(rules []config.EscalationRule) error {
+	for i, rule := range rules {
+		name := escalationRuleName(rule, i)
+		if strings.TrimSpace(rule.When) == "" {
+			return fmt.Errorf("rule %q: when is required", name)
+		}
+		if err := validateListFilter(rule.When); err != nil {
+			return fmt.Errorf("rule %q: when: %w", name, err)
+		}
+		if rule.IdleDays < 0 {
+			return fmt.Errorf("rule %q: idle_days must be positive, got %d", name, rule.IdleDays)
+		}
+		if rule.Priority != nil && (*rule.Priority < 0 || *rule.Priority > 4) {
+			return fmt.Errorf("rule %q: priority must be 0-4, got %d", name, *rule.Priority)
+		}
+		if rule.Priority == nil && !rule.Pin {
+			return fmt.Errorf("rule %q does nothing: set a priority or pin", name)
+		}
+	}
+	return nil
+}
+
+// escalationRuleName names the i-th rule for messages and the review.
+func escalationRuleName(rule config.EscalationRule, i int) string {
+	if rule.Name != "" {
+		return rule.Name
+	}
+	return fmt.Sprintf("rule %d", i+1)
+}
+
+// SetEscalationRules sets the rules and evaluates them against the issues
+// loaded so far. Check them with ValidateEscalationRules first; rules that
+// don't parse are ignored.
+func (m *Model) SetEscalationRules(rules []config.EscalationRule) {
+	m.escalationRules = nil
+	for i, rule := range rules {
+		r := escalationRule{
+			name:     escalationRuleName(rule, i),
+			when:     rule.When,
+			idle:     time.Duration(rule.IdleDays) * 24 * time.Hour,
+			priority: -1,
+			pin:      rule.Pin,
+		}
+		if rule.Priority != nil {
+			r.priority = *rule.Priority
+		}
+		m.escalationRules = append(m.escalationRules, r)
+	}
+	m.evaluateEscalations()
+	if len(m.pinnedIDs) > 0 {
+		m.applyFilter()
+	}
+}
+
+// evaluateEscalations applies the rules to the issues, pinning and
+// suggesting anew, and returns how many suggestions were not there before.
+// Where rules disagree the highest priority wins.
+func (m *Model) evaluateEscalations() int {
+	if len(m.escalationRules) == 0 {
+		m.escalations, m.pinnedIDs = nil, nil
+		return 0
+	}
+	now := clockNow(m.now)
+	pinned := make(map[string]bool)
+	best := make(map[string]escalation)
+	blockers := m.blockerMap()
+	for _, rule := range m.escalationRules {
+		f, err := m.parseListFilter(rule.when)
+		if err != nil {
+			continue
+		}
+		for i := range m.issues {
+			issue := &m.issues[i]
+			if isClosedLikeStatus(issue.Status) || !f.Match(*issue, blockers) {
+				continue
+			}
+			if touched := lastTouched(issue); rule.idle > 0 && (touched.IsZero() || now.Sub(touched) < rule.idle) {
+				continue
+			}
+			if rule.pin {
+				pinned[issue.ID] = true
+			}
+			if rule.priority < 0 || issue.Priority <= rule.priority {
+				continue
+			}
+			e := escalation{id: issue.ID, rule: rule.name, from: issue.Priority, to: rule.priority}
+			if prev, ok := best[issue.ID]; (!ok || e.to < prev.to) && !m.escalationsDismissed[e.key()] {
+				best[issue.ID] = e
+			}
+		}
+	}
+
+	seen := make(map[escalationKey]bool, len(m.escalations))
+	for _, e := range m.escalations {
+		seen[e.key()] = true
+	}
+	added := 0
+	m.escalations = m.escalations[:0:0]
+	for _, e := range best {
+		if !seen[e.key()] {
+			added++
+		}
+		m.escalations = append(m.escalations, e)
+	}
+	sort.Slice(m.escalations, func(i, j int) bool {
+		a, b := m.escalations[i], m.escalations[j]
+		if a.to != b.to {
+			return a.to < b.to
+		}
+		return a.id < b.id
+	})
+	m.escalationsCursor = min(m.escalationsCursor, max(len(m.escalations)-1, 0))
+	m.pinnedIDs = nil
+	if len(pinned) > 0 {
+		m.pinnedIDs = pinned
+	}
+	m.updateListDelegate()
+	return added
+}
+
+// noteEscalations adds new suggestions to the status bar.
+func (m *Model) noteEscalations(added int) {
+	if added == 0 || m.statusIsError {
+		return
+	}
+	note := fmt.Sprintf("%d escalations to review (:escalations)", added)
+	if added == 1 {
+		note = "1 escalation to review (:escalations)"
+	}
+	if m.statusMsg == "" {
+		m.statusMsg = note
+	} else {
+		m.statusMsg += " · " + note
+	}
+}
+
+// pinFirst moves the pinned issues of order, indices into issues, to the
+// front, keeping the order within both.
+func pinFirst(order []int, issues []model.Issue, pinned map[string]bool) []int {
+	if len(pinned) == 0 {
+		return order
+	}
+	out := make([]int, 0, len(order))
+	for _, i := range order {
+		if pinned[issues[i].ID] {
+			out = append(out, i)
+		}
+	}
+	for _, i := range order {
+		if !pinned[issues[i].ID] {
+			out = append(out, i)
+		}
+	}
+	return out
+}
+
+// openEscalations opens the review.
+func (m *Model) openEscalations() error {
+	if len(m.escalationRules) == 0 {
+		return fmt.Errorf("no escalation rules: add them under ui.escalation in the config")
+	}
+	m.showEscalations = true
+	m.escalationsCursor = min(m.escalationsCursor, max(len(m.escalations)-1, 0))
+	return nil
+}
+
+// acceptEscalations raises the priority of the suggestions at indices with
+// bd, one after another, and drops them from the review.
+func (m Model) acceptEscalations(indices ...int) (Model, tea.Cmd) {
+	if m.readOnly {
+		m.statusMsg = i18n.T("Read-only session: cannot change priorities")
+		m.statusIsError = true
+		return m, nil
+	}
+	if !bdAvailable() {
+		m.statusMsg = i18n.T("bd not found on PATH: priorities are changed with bd update")
+		m.statusIsError = true
+		return m, nil
+	}
+	accepted := make(map[int]bool, len(indices))
+	var cmds []tea.Cmd
+	for _, i := range indices {
+		e := m.escalations[i]
+		accepted[i] = true
+		summary := i18n.Tf("Raised %s from P%d to P%d (%s)", e.id, e.from, e.to, e.rule)
+		cmds = append(cmds, m.runBDCmd(summary, "update", e.id, fmt.Sprintf("--priority=%d", e.to)))
+	}
+	kept := m.escalations[:0:0]
+	for i, e := range m.escalations {
+		if !accepted[i] {
+			kept = append(kept, e)
+		}
+	}
+	m.escalations = kept
+	m.escalationsCursor = min(m.escalationsCursor, max(len(kept)-1, 0))
+	if len(cmds) == 1 {
+		return m, cmds[0]
+	}
+	return m, tea.Sequence(cmds...)
+}
+
+// handleEscalationsKeys moves through the suggestions, jumps to an issue,
+// or accepts or dismisses suggestions.
+func (m Model) handleEscalationsKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	suggestions := m.escalations
+	switch msg.String() {
+	case "esc", "q":
+		m.showEscalations = false
+	case "j", "down":
+		if m.escalationsCursor < len(suggestions)-1 {
+			m.escalationsCursor++
+		}
+	case "k", "up":
+		if m.escalationsCursor > 0 {
+			m.escalationsCursor--
+		}
+	case "enter":
+		if m.escalationsCursor < len(suggestions) {
+			m.showEscalations = false
+			return m.gotoIssue(suggestions[m.escalationsCursor].id), nil
+		}
+	case "a":
+		if m.escalationsCursor < len(suggestions) {
+			return m.acceptEscalations(m.escalationsCursor)
+		}
+	case "A":
+		if len(suggestions) > 0 {
+			all := make([]int, len(suggestions))
+			for i := range all {
+				all[i] = i
+			}
+			return m.acceptEscalations(all...)
+		}
+	case "d":
+		if m.escalationsCursor < len(suggestions) {
+			if m.escalationsDismissed == nil {
+				m.escalationsDismissed = make(map[escalationKey]bool)
+			}
+			m.escalationsDismissed[suggestions[m.escalationsCursor].key()] = true
+			m.escalations = append(suggestions[:m.escalationsCursor:m.escalationsCursor], suggestions[m.escalationsCursor+1:]...)
+			m.escalationsCursor = min(m.escalationsCursor, max(len(m.escalations)-1, 0))
+		}
+	}
+	return m, nil
+}
+
+func (m Model) renderEscalations() string {
+	t := m.theme
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 3)
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	idStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Bold(true)
+	textStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+	selectedStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+
+	width := max(min(m.width-12, 90), 40)
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(i18n.T("Escalations")) + "\n\n")
+
+	suggestions := m.escalations
+	if len(suggestions) == 0 {
+		sb.WriteString(mutedStyle.Render(i18n.T("No issues need a higher priority")) + "\n")
+	}
+	// Keep the cursor visible in a window that fits the screen.
+	visible := max((m.height-12)/2, 1)
+	start := 0
+	if m.escalationsCursor >= visible {
+		start = m.escalationsCursor - visible + 1
+	}
+	for i := start; i < len(suggestions) && i < start+visible; i++ {
+		e := suggestions[i]
+		cursor, style := "  ", textStyle
+		if i == m.escalationsCursor {
+			cursor, style = selectedStyle.Render("▸ "), selectedStyle
+		}
+		title := ""
+		if issue, ok := m.issueMap[e.id]; ok {
+			title = issue.Title
+		}
+		change := fmt.Sprintf("P%d → P%d ", e.from, e.to)
+		sb.WriteString(cursor + style.Render(change) + idStyle.Render(e.id) + " " +
+			style.Render(truncateRunesHelper(title, width-lipgloss.Width(change+e.id)-4, "…")) + "\n")
+		sb.WriteString("         " + mutedStyle.Render(truncateRunesHelper(e.rule, width-9, "…")) + "\n")
+	}
+	if n := len(m.pinnedIDs); n > 0 {
+		sb.WriteString("\n" + mutedStyle.Render(i18n.Tf("%s %d pinned at the top of the list", pinMark, n)) + "\n")
+	}
+	sb.WriteString("\n" + mutedStyle.Render(i18n.T("j/k: navigate • enter: go to issue • a: accept • A: accept all • d: dismiss • esc: close")))
+
+	return lipgloss.Place(m.width, m.height-1, lipgloss.Center, lipgloss.Center, boxStyle.Render(sb.String()))
+}
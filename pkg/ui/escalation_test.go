@@ -0,0 +1,165 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func priority(p int) *int {
+	return &p
+}
+
+func TestValidateEscalationRules(t *testing.T) {
+	valid := []config.EscalationRule{
+		{Name: "Long-blocked P2s", When: "blocked:true priority:2", IdleDays: 7, Priority: priority(1)},
+		{When: "label:security", Pin: true},
+		{When: "stale", Priority: priority(0)},
+	}
+	if err := ValidateEscalationRules(valid); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	invalid := map[string]config.EscalationRule{
+		"when is required":      {Name: "x", Pin: true},
+		"unknown filter":        {When: "bogus:1", Pin: true},
+		"priority must be":      {When: "open", Priority: priority(5)},
+		"idle_days must be":     {When: "open", IdleDays: -1, Pin: true},
+		`"rule 1" does nothing`: {When: "open"},
+	}
+	for want, rule := range invalid {
+		if err := ValidateEscalationRules([]config.EscalationRule{rule}); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%+v: expected an error mentioning %q, got %v", rule, want, err)
+		}
+	}
+}
+
+func escalationTestModel(t *testing.T) Model {
+	t.Helper()
+	now := time.Now()
+	security := []string{"security"}
+	m := commandTestModel(t, func(issues []model.Issue) []model.Issue {
+		issues[0].Status, issues[0].UpdatedAt = model.StatusBlocked, now.Add(-10*24*time.Hour)
+		issues[1].Status, issues[1].Priority, issues[1].UpdatedAt = model.StatusBlocked, 2, now.Add(-24*time.Hour)
+		issues[2].Status, issues[2].Priority, issues[2].Labels = model.StatusOpen, 3, security
+		return append(issues,
+			model.Issue{ID: "A-4", Title: "Old leak", Status: model.StatusClosed, Priority: 3, CreatedAt: now.Add(-40 * 24 * time.Hour), Labels: security},
+			model.Issue{ID: "A-5", Title: "Urgent already", Status: model.StatusOpen, Priority: 0, CreatedAt: now.Add(-24 * time.Hour), Labels: security},
+		)
+	})
+	m.SetClock(func() time.Time { return now })
+	m.workDir = t.TempDir()
+	m.SetEscalationRules([]config.EscalationRule{
+		{Name: "Long-blocked P2s", When: "blocked:true priority:2", IdleDays: 7, Priority: priority(1)},
+		{Name: "Security", When: "label:security", Pin: true, Priority: priority(1)},
+	})
+	return m
+}
+
+func TestEscalations_EvaluateAndPin(t *testing.T) {
+	m := escalationTestModel(t)
+
+	var got []string
+	for _, e := range m.escalations {
+		got = append(got, e.id+":"+e.rule)
+	}
+	if want := "A-1:Long-blocked P2s A-3:Security"; strings.Join(got, " ") != want {
+		t.Fatalf("expected suggestions %q, got %q", want, strings.Join(got, " "))
+	}
+	if len(m.pinnedIDs) != 2 || !m.pinnedIDs["A-3"] || !m.pinnedIDs["A-5"] {
+		t.Fatalf("expected the open security issues pinned, got %v", m.pinnedIDs)
+	}
+
+	ids := listIDs(m)
+	if len(ids) < 2 || !m.pinnedIDs[ids[0]] || !m.pinnedIDs[ids[1]] {
+		t.Errorf("expected pinned issues first, got %v", ids)
+	}
+	if view := ansi.Strip(m.View()); !strings.Contains(view, pinMark) {
+		t.Error("expected pinned rows marked")
+	}
+
+	// A reload keeps what it already suggested and notes only new ones.
+	if added := m.evaluateEscalations(); added != 0 {
+		t.Errorf("expected no new suggestions on a second evaluation, got %d", added)
+	}
+	for i := range m.issues {
+		if m.issues[i].ID == "A-2" {
+			m.issues[i].UpdatedAt = m.issues[i].CreatedAt.Add(-10 * 24 * time.Hour)
+		}
+	}
+	m.statusMsg = "Reloaded 5 issues"
+	m.noteEscalations(m.evaluateEscalations())
+	if m.statusMsg != "Reloaded 5 issues · 1 escalation to review (:escalations)" {
+		t.Errorf("unexpected status %q", m.statusMsg)
+	}
+}
+
+func TestEscalations_ReviewAcceptAndDismiss(t *testing.T) {
+	withBD(t, true)
+	fakeBD(t, `echo "$@" >> args`)
+	m := escalationTestModel(t)
+
+	m, _, err := m.ExecCommand("escalations")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.CurrentContext() != ContextEscalations {
+		t.Fatalf("expected the review to open, got %s", m.CurrentContext())
+	}
+	if view := ansi.Strip(m.View()); !strings.Contains(view, "P2 → P1 A-1") || !strings.Contains(view, "2 pinned") {
+		t.Errorf("expected the suggestions listed, got:\n%s", view)
+	}
+
+	m.readOnly = true
+	m = pressKey(m, runeKey("a"))
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "Read-only") || len(m.escalations) != 2 {
+		t.Fatalf("expected read-only sessions to refuse, got %q", m.statusMsg)
+	}
+	m.readOnly = false
+
+	m = pressKey(m, runeKey("j"))
+	m = pressKey(m, runeKey("d"))
+	if len(m.escalations) != 1 || m.escalations[0].id != "A-1" {
+		t.Fatalf("expected d to dismiss A-3, got %+v", m.escalations)
+	}
+	if m.evaluateEscalations(); len(m.escalations) != 1 {
+		t.Errorf("expected the dismissal to last the session, got %+v", m.escalations)
+	}
+
+	newM, cmd := m.Update(runeKey("A"))
+	m = newM.(Model)
+	if cmd == nil || len(m.escalations) != 0 {
+		t.Fatalf("expected A to accept the rest, got %+v", m.escalations)
+	}
+	newM, _ = m.Update(cmd())
+	m = newM.(Model)
+	if !strings.Contains(m.statusMsg, "Raised A-1 from P2 to P1 (Long-blocked P2s)") {
+		t.Errorf("expected the accept summary, got %q", m.statusMsg)
+	}
+	args, err := os.ReadFile(filepath.Join(m.workDir, "args"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.TrimSpace(string(args)); got != "update A-1 --priority=1" {
+		t.Errorf("unexpected bd arguments %q", got)
+	}
+	if !strings.Contains(ansi.Strip(m.View()), "No issues need a higher priority") {
+		t.Error("expected the emptied review to say so")
+	}
+	m = pressKey(m, runeKey("q"))
+	if m.CurrentContext() == ContextEscalations {
+		t.Error("expected q to close the review")
+	}
+}
+
+func TestEscalations_NeedRules(t *testing.T) {
+	m := commandTestModel(t)
+	if _, _, err := m.ExecCommand("escalations"); err == nil || !strings.Contains(err.Error(), "ui.escalation") {
+		t.Errorf("expected an error pointing at ui.escalation, got %v", err)
+	}
+}
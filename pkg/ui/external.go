@@ -0,0 +1,108 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// External blockers
+//
+// In a workspace, issues can depend on issues of other repos by their
+// prefixed IDs (see workspace.ResolveExternal). SetExternalResolver gives
+// the model a function that looks those up, which runs on load and on every
+// reload. An open external blocker blocks like a local one: the issue drops
+// out of ready and matches blocked:true. The list marks such issues ⇱, the
+// graph draws external blockers square with their repo, and the dependency
+// tree in the details shows their titles and status.
+
+// externalMark marks issues waiting on an open issue of another repo.
+const externalMark = "⇱"
+
+// SetExternalResolver sets the function that returns the issues of other
+// repos the issues depend on, by referenced ID, and resolves them. Call it
+// once, before the program starts.
+func (m *Model) SetExternalResolver(resolve func([]model.Issue) map[string]model.Issue) {
+	m.externalResolver = resolve
+	m.resolveExternal()
+	if len(m.externallyHeld) > 0 {
+		m.applyFilter()
+	}
+}
+
+// resolveExternal looks up the external blockers of the issues and takes
+// the issues they hold up out of the ready count, which must be fresh.
+func (m *Model) resolveExternal() {
+	m.external, m.externallyHeld = nil, nil
+	if m.externalResolver != nil {
+		if found := m.externalResolver(m.issues); len(found) > 0 {
+			m.external = make(map[string]*model.Issue, len(found))
+			for id, issue := range found {
+				m.external[id] = &issue
+			}
+		}
+	}
+	m.graphView.external = m.external
+	defer m.updateListDelegate()
+	if len(m.external) == 0 {
+		return
+	}
+
+	held := make(map[string]bool)
+	for i := range m.issues {
+		issue := &m.issues[i]
+		if isClosedLikeStatus(issue.Status) {
+			continue
+		}
+		external, local := false, false
+		for _, dep := range issue.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() {
+				continue
+			}
+			if blocker, ok := m.issueMap[dep.DependsOnID]; ok && !isClosedLikeStatus(blocker.Status) {
+				local = true
+			} else if blocker, ok := m.external[dep.DependsOnID]; ok && !isClosedLikeStatus(blocker.Status) {
+				external = true
+			}
+		}
+		if !external {
+			continue
+		}
+		held[issue.ID] = true
+		if !local && issue.Status != model.StatusBlocked {
+			m.countReady-- // Counted ready by the local blockers alone
+		}
+	}
+	if len(held) > 0 {
+		m.externallyHeld = held
+	}
+}
+
+// blockerMap is issueMap with the external blockers, for matching ready
+// and blocked.
+func (m Model) blockerMap() map[string]*model.Issue {
+	if len(m.external) == 0 {
+		return m.issueMap
+	}
+	merged := make(map[string]*model.Issue, len(m.issueMap)+len(m.external))
+	for id, issue := range m.external {
+		merged[id] = issue
+	}
+	for id, issue := range m.issueMap {
+		merged[id] = issue
+	}
+	return merged
+}
+
+// externalNote says how many open issues wait on other repos, for the
+// ready filter's status, or "" if none do.
+func (m Model) externalNote() string {
+	switch n := len(m.externallyHeld); n {
+	case 0:
+		return ""
+	case 1:
+		return "1 waiting on another repo " + externalMark
+	default:
+		return fmt.Sprintf("%d waiting on other repos %s", n, externalMark)
+	}
+}
@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func externalTestModel(t *testing.T) Model {
+	t.Helper()
+	m := commandTestModel(t, func(issues []model.Issue) []model.Issue {
+		issues[0].Dependencies = []*model.Dependency{{DependsOnID: "api-AUTH-1", Type: model.DepBlocks}}
+		issues[1].Dependencies = []*model.Dependency{{DependsOnID: "api-AUTH-2", Type: model.DepBlocks}}
+		return issues
+	})
+	m.SetExternalResolver(func(issues []model.Issue) map[string]model.Issue {
+		return map[string]model.Issue{
+			"api-AUTH-1": {ID: "api-AUTH-1", Title: "Token refresh", Status: model.StatusInProgress, SourceRepo: "api"},
+			"api-AUTH-2": {ID: "api-AUTH-2", Title: "Session store", Status: model.StatusClosed, SourceRepo: "api"},
+		}
+	})
+	return m
+}
+
+func TestExternal_OpenBlockersHoldIssues(t *testing.T) {
+	m := externalTestModel(t)
+
+	if m.countReady != 1 {
+		t.Errorf("expected A-1 out of the ready count, got %d ready", m.countReady)
+	}
+	if len(m.externallyHeld) != 1 || !m.externallyHeld["A-1"] {
+		t.Fatalf("expected only A-1 held, got %v", m.externallyHeld)
+	}
+	if view := ansi.Strip(m.View()); !strings.Contains(view, externalMark+" Old open") {
+		t.Errorf("expected A-1 marked in the list, got:\n%s", view)
+	}
+
+	m = pressKey(m, runeKey("r"))
+	if ids := strings.Join(listIDs(m), " "); ids != "A-2" {
+		t.Errorf("expected the ready list without A-1, got %q", ids)
+	}
+	if !strings.Contains(m.statusMsg, "1 waiting on another repo") {
+		t.Errorf("expected the ready filter to note A-1, got %q", m.statusMsg)
+	}
+
+	m.currentFilter = "blocked:true"
+	m.applyFilter()
+	if ids := strings.Join(listIDs(m), " "); ids != "A-1" {
+		t.Errorf("expected blocked:true to match A-1, got %q", ids)
+	}
+
+	// The resolver runs again on reload, with fresh counts.
+	m.countReady = 2
+	m.resolveExternal()
+	if m.countReady != 1 {
+		t.Errorf("expected the reload to take A-1 out again, got %d ready", m.countReady)
+	}
+}
+
+func TestExternal_GraphAndDetailsShowBlockers(t *testing.T) {
+	m := externalTestModel(t)
+
+	m.graphView.SelectByID("A-1")
+	graph := ansi.Strip(m.graphView.View(120, 40))
+	if !strings.Contains(graph, "api-AUTH-1") || !strings.Contains(graph, externalMark+" api") {
+		t.Errorf("expected the external blocker drawn with its repo, got:\n%s", graph)
+	}
+	if strings.Contains(graph, "(not in filter)") {
+		t.Error("expected the external blocker resolved")
+	}
+
+	var sb strings.Builder
+	writeIssueBodyMD(&sb, *m.issueMap["A-1"], m.blockerMap(), time.Now())
+	if !strings.Contains(sb.String(), "Token refresh") {
+		t.Errorf("expected the dependency tree to show the external blocker, got:\n%s", sb.String())
+	}
+}
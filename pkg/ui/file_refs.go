@@ -0,0 +1,278 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Referenced files
+//
+// Paths mentioned in an issue's text, such as "pkg/ui/model.go:120",
+// "./main.go" or "src/app.ts#L42", are listed in the detail view when the
+// file exists in the project. "f" in the action menu opens one in $EDITOR
+// at the line, suspending the TUI until the editor exits. How an editor is
+// told the line depends on the editor: editorTemplates knows the common
+// ones, and ui.editor_templates adds more.
+
+// maxFileRefs bounds the files listed for an issue.
+const maxFileRefs = 20
+
+// fileRefPattern matches a path with a directory or an extension,
+// optionally followed by :LINE, :LINE:COLUMN or #LLINE.
+var fileRefPattern = regexp.MustCompile(`(?:\.{1,2}/|/)?(?:[\w.-]+/)*[\w.-]*\w(?::(\d+)(?::(\d+))?|#L(\d+))?`)
+
+// editorTemplates are the arguments, after the $EDITOR command, that open
+// {file} at {line} and {column}, by editor executable.
+var editorTemplates = map[string]string{
+	"vim":           "+{line} {file}",
+	"vi":            "+{line} {file}",
+	"nvim":          "+{line} {file}",
+	"gvim":          "+{line} {file}",
+	"kak":           "+{line}:{column} {file}",
+	"emacs":         "+{line}:{column} {file}",
+	"emacsclient":   "+{line}:{column} {file}",
+	"nano":          "+{line},{column} {file}",
+	"micro":         "+{line}:{column} {file}",
+	"hx":            "{file}:{line}:{column}",
+	"helix":         "{file}:{line}:{column}",
+	"code":          "--goto {file}:{line}:{column}",
+	"code-insiders": "--goto {file}:{line}:{column}",
+	"cursor":        "--goto {file}:{line}:{column}",
+	"codium":        "--goto {file}:{line}:{column}",
+	"subl":          "{file}:{line}:{column}",
+	"zed":           "{file}:{line}:{column}",
+	"idea":          "--line {line} --column {column} {file}",
+	"goland":        "--line {line} --column {column} {file}",
+	"pycharm":       "--line {line} --column {column} {file}",
+}
+
+// fileRef is a file an issue mentions; Line and Column are 0 when not given.
+type fileRef struct {
+	Path   string // As written, relative to the project
+	Line   int
+	Column int
+}
+
+func (r fileRef) String() string {
+	switch {
+	case r.Line > 0 && r.Column > 0:
+		return fmt.Sprintf("%s:%d:%d", r.Path, r.Line, r.Column)
+	case r.Line > 0:
+		return fmt.Sprintf("%s:%d", r.Path, r.Line)
+	}
+	return r.Path
+}
+
+// findFileRefs returns the files under dir that issue's text mentions, in
+// the order they first appear.
+func findFileRefs(issue model.Issue, dir string) []fileRef {
+	if dir == "" {
+		return nil
+	}
+	texts := []string{issue.Description, issue.Design, issue.AcceptanceCriteria, issue.Notes}
+	for _, c := range issue.Comments {
+		if c != nil {
+			texts = append(texts, c.Text)
+		}
+	}
+	var refs []fileRef
+	seen := make(map[fileRef]bool)
+	exists := make(map[string]bool)
+	for _, text := range texts {
+		for _, match := range fileRefPattern.FindAllStringSubmatch(text, -1) {
+			path := strings.TrimSuffix(match[0], "#L"+match[3])
+			if match[1] != "" {
+				path = path[:strings.IndexByte(path, ':')]
+			}
+			if !strings.ContainsAny(path, "/.") || strings.Contains(path, "//") {
+				continue
+			}
+			ref := fileRef{Path: path}
+			ref.Line, _ = strconv.Atoi(match[1] + match[3])
+			ref.Column, _ = strconv.Atoi(match[2])
+			if seen[ref] {
+				continue
+			}
+			ok, checked := exists[path]
+			if !checked {
+				info, err := os.Stat(resolveFileRef(dir, path))
+				ok = err == nil && info.Mode().IsRegular()
+				exists[path] = ok
+			}
+			if !ok {
+				continue
+			}
+			seen[ref] = true
+			refs = append(refs, ref)
+			if len(refs) == maxFileRefs {
+				return refs
+			}
+		}
+	}
+	return refs
+}
+
+func resolveFileRef(dir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
+
+// fileRefs returns the files issue mentions in the project.
+func (m *Model) fileRefs(issue model.Issue) []fileRef {
+	return findFileRefs(issue, m.workDir)
+}
+
+// writeFileRefsMD lists the referenced files in an issue's detail markdown.
+func writeFileRefsMD(sb *strings.Builder, refs []fileRef) {
+	if len(refs) == 0 {
+		return
+	}
+	sb.WriteString("### " + i18n.T("Referenced Files") + "\n")
+	for _, ref := range refs {
+		sb.WriteString("- `" + ref.String() + "`\n")
+	}
+	sb.WriteString("\n*" + i18n.T("Open one with `.` then `f`.") + "*\n\n")
+}
+
+// ValidateEditorTemplates checks the editor templates of the user config.
+func ValidateEditorTemplates(templates map[string]string) error {
+	names := make([]string, 0, len(templates))
+	for name := range templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if strings.TrimSpace(name) == "" || strings.ContainsAny(name, `/\ `) {
+			return fmt.Errorf("%q: name the editor by its executable, such as code or nvim", name)
+		}
+		args, err := parseCommandLine(templates[name])
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		if !strings.Contains(strings.Join(args, " "), "{file}") {
+			return fmt.Errorf("%s: the template must contain {file}", name)
+		}
+	}
+	return nil
+}
+
+// SetEditorTemplates adds templates, by editor executable, to the built-in
+// ones used to open a file at a line.
+func (m *Model) SetEditorTemplates(templates map[string]string) {
+	m.editorTemplates = templates
+}
+
+// fileRefOpenedMsg reports that the editor opened for a file has exited.
+type fileRefOpenedMsg struct {
+	ref fileRef
+	err error
+}
+
+// openFileRefs opens the file issue mentions, or offers a choice in the
+// action menu when it mentions several.
+func (m Model) openFileRefs(issue model.Issue) (Model, tea.Cmd) {
+	refs := m.fileRefs(issue)
+	switch len(refs) {
+	case 0:
+		m.statusMsg = i18n.Tf("%s mentions no files in the project", issue.ID)
+		m.statusIsError = true
+		return m, nil
+	case 1:
+		return m.openFileRef(refs[0])
+	}
+	m.actionMenuIssue = issue
+	m.actionMenu = nil
+	for i, ref := range refs {
+		key := ""
+		if i < 9 {
+			key = strconv.Itoa(i + 1)
+		}
+		ref := ref
+		m.actionMenu = append(m.actionMenu, issueAction{
+			key:   key,
+			label: "Open " + ref.String(),
+			run: func(m Model, _ model.Issue) (Model, tea.Cmd) {
+				return m.openFileRef(ref)
+			},
+		})
+	}
+	m.actionMenuCursor = 0
+	m.showActionMenu = true
+	m.focusBeforeActionMenu = m.focused
+	m.focused = focusActionMenu
+	return m, nil
+}
+
+// openFileRef opens ref in $EDITOR at its line and returns to the TUI when
+// the editor exits.
+func (m Model) openFileRef(ref fileRef) (Model, tea.Cmd) {
+	if m.refuseReadOnly(i18n.T("Opening the editor")) {
+		return m, nil
+	}
+	args, err := m.fileRefEditorArgs(ref)
+	if err != nil {
+		m.reportError(newError(errConfig, "open editor", err).withHint("set $EDITOR, and ui.editor_templates for editors bv does not know"))
+		return m, nil
+	}
+	c := exec.Command(args[0], args[1:]...)
+	c.Dir = m.workDir
+	return m, tea.ExecProcess(c, func(err error) tea.Msg {
+		return fileRefOpenedMsg{ref: ref, err: err}
+	})
+}
+
+// fileRefEditorArgs builds the command that opens ref in $EDITOR.
+func (m Model) fileRefEditorArgs(ref fileRef) ([]string, error) {
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		editor = os.Getenv("VISUAL")
+	}
+	if editor == "" {
+		return nil, errors.New("$EDITOR is not set")
+	}
+	args, err := parseCommandLine(editor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid $EDITOR/$VISUAL: %w", err)
+	}
+	base, kind := classifyEditorCommand(args)
+	switch kind {
+	case editorCommandEmpty:
+		return nil, errors.New("invalid $EDITOR/$VISUAL: empty command")
+	case editorCommandForbidden:
+		return nil, fmt.Errorf("refusing to run %s as editor (shell/interpreter)", base)
+	}
+
+	template, ok := m.editorTemplates[base]
+	if !ok {
+		template, ok = editorTemplates[base]
+	}
+	if !ok || ref.Line == 0 {
+		template = "{file}"
+	}
+	templateArgs, err := parseCommandLine(template)
+	if err != nil {
+		return nil, fmt.Errorf("editor template for %s: %w", base, err)
+	}
+	replacer := strings.NewReplacer(
+		"{file}", resolveFileRef(m.workDir, ref.Path),
+		"{line}", strconv.Itoa(max(ref.Line, 1)),
+		"{column}", strconv.Itoa(max(ref.Column, 1)),
+	)
+	for _, arg := range templateArgs {
+		args = append(args, replacer.Replace(arg))
+	}
+	return args, nil
+}
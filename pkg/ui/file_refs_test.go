@@ -0,0 +1,147 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// fileRefProject creates pkg/a.go and README.md in a temporary project.
+func fileRefProject(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "pkg"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"pkg/a.go", "README.md"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("x\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestFindFileRefs(t *testing.T) {
+	dir := fileRefProject(t)
+	issue := model.Issue{
+		Description: "Crash in pkg/a.go:12, see ./README.md. Not missing.go, e.g. this, or https://example.com/pkg/a.go.",
+		Notes:       "Again pkg/a.go:12 and src/b.ts#L4",
+		Comments:    []*model.Comment{{Text: "Also pkg/a.go#L30 and pkg/a.go:30:2"}},
+	}
+	var got []string
+	for _, ref := range findFileRefs(issue, dir) {
+		got = append(got, ref.String())
+	}
+	want := []string{"pkg/a.go:12", "./README.md", "pkg/a.go:30", "pkg/a.go:30:2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("findFileRefs() = %q, want %q", got, want)
+	}
+	if refs := findFileRefs(issue, ""); refs != nil {
+		t.Errorf("expected no references without a project, got %v", refs)
+	}
+}
+
+func TestFileRefEditorArgs(t *testing.T) {
+	m := Model{workDir: "/proj"}
+	ref := fileRef{Path: "pkg/a.go", Line: 12}
+	file := filepath.Join("/proj", "pkg/a.go")
+
+	tests := []struct {
+		editor string
+		ref    fileRef
+		want   []string
+	}{
+		{"nvim", ref, []string{"nvim", "+12", file}},
+		{"code --wait", ref, []string{"code", "--wait", "--goto", file + ":12:1"}},
+		{"/usr/bin/emacsclient -t", fileRef{Path: "pkg/a.go", Line: 3, Column: 7}, []string{"/usr/bin/emacsclient", "-t", "+3:7", file}},
+		{"nvim", fileRef{Path: "pkg/a.go"}, []string{"nvim", file}},
+		{"ed", ref, []string{"ed", file}},
+	}
+	for _, tt := range tests {
+		t.Setenv("EDITOR", tt.editor)
+		got, err := m.fileRefEditorArgs(tt.ref)
+		if err != nil || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%q: got %q, %v; want %q", tt.editor, got, err, tt.want)
+		}
+	}
+
+	m.SetEditorTemplates(map[string]string{"ed": "-p {line} {file}", "nvim": "{file} +{line}"})
+	t.Setenv("EDITOR", "ed")
+	if got, _ := m.fileRefEditorArgs(ref); !reflect.DeepEqual(got, []string{"ed", "-p", "12", file}) {
+		t.Errorf("expected the configured template, got %q", got)
+	}
+	t.Setenv("EDITOR", "nvim")
+	if got, _ := m.fileRefEditorArgs(ref); !reflect.DeepEqual(got, []string{"nvim", file, "+12"}) {
+		t.Errorf("expected the configured template to replace the built-in one, got %q", got)
+	}
+
+	t.Setenv("EDITOR", "bash -c")
+	if _, err := m.fileRefEditorArgs(ref); err == nil {
+		t.Error("expected a shell to be refused")
+	}
+	t.Setenv("EDITOR", "")
+	t.Setenv("VISUAL", "")
+	if _, err := m.fileRefEditorArgs(ref); err == nil {
+		t.Error("expected an error without $EDITOR")
+	}
+}
+
+func TestValidateEditorTemplates(t *testing.T) {
+	if err := ValidateEditorTemplates(map[string]string{"hx": "{file}:{line}", "kate": "--line {line} {file}"}); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	for _, templates := range []map[string]string{
+		{"hx": "--line {line}"},
+		{"/usr/bin/hx": "{file}"},
+		{"hx": `"{file}`},
+	} {
+		if err := ValidateEditorTemplates(templates); err == nil {
+			t.Errorf("%v: expected an error", templates)
+		}
+	}
+}
+
+func TestFileRefs_DetailAndActionMenu(t *testing.T) {
+	t.Setenv("EDITOR", "vi")
+	issues := []model.Issue{
+		{ID: "A-1", Title: "One file", Status: model.StatusOpen, Description: "Fails at pkg/a.go:12"},
+		{ID: "A-2", Title: "Two files", Status: model.StatusOpen, Description: "Touches pkg/a.go:12 and README.md"},
+	}
+	m := NewModel(issues, nil, "")
+	m.workDir = fileRefProject(t)
+	newM, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m = newM.(Model)
+	m, _, _ = m.ExecCommand("select A-2")
+	if view := ansi.Strip(m.View()); !strings.Contains(view, "Referenced Files") {
+		t.Fatalf("expected the files in the detail view:\n%s", view)
+	}
+
+	m = pressKey(m, runeKey("."))
+	m = pressKey(m, runeKey("f"))
+	if !m.showActionMenu || actionLabels(m.actionMenu) != "1:Open pkg/a.go:12|2:Open README.md" {
+		t.Fatalf("expected a choice of files, got %s", actionLabels(m.actionMenu))
+	}
+	var cmd tea.Cmd
+	newM, cmd = m.Update(runeKey("2"))
+	m = newM.(Model)
+	if cmd == nil || m.showActionMenu {
+		t.Fatal("expected 2 to run the editor")
+	}
+	newM, _ = m.Update(fileRefOpenedMsg{ref: fileRef{Path: "README.md"}})
+	m = newM.(Model)
+	if m.statusMsg != "📝 Opened README.md" {
+		t.Errorf("unexpected status %q", m.statusMsg)
+	}
+
+	m.readOnly = true
+	m.openActionMenu()
+	if strings.Contains(actionLabels(m.actionMenu), "referenced file") {
+		t.Error("expected read-only sessions not to open files")
+	}
+}
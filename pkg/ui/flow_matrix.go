@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -275,7 +276,7 @@ func (m *FlowMatrixModel) openDrilldown() {
 	m.drilldownIssues = relevant
 	m.drilldownCursor = 0
 	m.drilldownScroll = 0
-	m.drilldownTitle = fmt.Sprintf("Issues with label: %s", selectedLabel)
+	m.drilldownTitle = i18n.Tf("Issues with label: %s", selectedLabel)
 	m.showDrilldown = true
 }
 
@@ -290,7 +291,7 @@ func (m *FlowMatrixModel) SelectedLabel() string {
 // View renders the flow matrix dashboard
 func (m FlowMatrixModel) View() string {
 	if !m.ready {
-		return m.theme.Base.Render("No cross-label dependencies found")
+		return m.theme.Base.Render(i18n.T("No cross-label dependencies found"))
 	}
 
 	if m.showDrilldown {
@@ -383,8 +384,8 @@ func (m FlowMatrixModel) renderHeader() string {
 	statsStyle := m.theme.Renderer.NewStyle().
 		Foreground(m.theme.Subtext)
 
-	title := titleStyle.Render("DEPENDENCY FLOW")
-	stats := statsStyle.Render(fmt.Sprintf("│ %d labels │ %d cross-label deps │ %d bottlenecks",
+	title := titleStyle.Render(i18n.T("DEPENDENCY FLOW"))
+	stats := statsStyle.Render(i18n.Tf("│ %d labels │ %d cross-label deps │ %d bottlenecks",
 		len(m.flow.Labels),
 		m.flow.TotalCrossLabelDeps,
 		len(m.flow.BottleneckLabels)))
@@ -464,13 +465,9 @@ func (m FlowMatrixModel) renderLabelsPanel(width int) string {
 }
 
 func (m FlowMatrixModel) renderLabelRow(stat labelFlowStats, selected bool, barWidth, maxOut, totalWidth int) string {
-	// Label name (truncated if needed, using rune count for UTF-8 safety)
+	// Label name, truncated to its column
 	labelWidth := 12
-	label := stat.Label
-	labelRunes := []rune(label)
-	if len(labelRunes) > labelWidth {
-		label = string(labelRunes[:labelWidth-1]) + "…"
-	}
+	label := truncate(stat.Label, labelWidth)
 
 	// Color based on bottleneck status
 	var labelColor lipgloss.AdaptiveColor
@@ -532,7 +529,7 @@ func (m FlowMatrixModel) renderDetailPanel(width int) string {
 	var b strings.Builder
 
 	if m.cursor >= len(m.labelStats) {
-		return "Select a label"
+		return i18n.T("Select a label")
 	}
 
 	stat := m.labelStats[m.cursor]
@@ -552,28 +549,28 @@ func (m FlowMatrixModel) renderDetailPanel(width int) string {
 
 	// Stats summary
 	summaryStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Subtext)
-	b.WriteString(summaryStyle.Render("IMPACT SUMMARY"))
+	b.WriteString(summaryStyle.Render(i18n.T("IMPACT SUMMARY")))
 	b.WriteString("\n")
 
 	// Blocking power indicator
 	scoreBar := m.renderScoreBar(stat.BottleneckScore, 20)
-	scoreLabel := "Low"
+	scoreLabel := i18n.T("Low")
 	scoreColor := m.theme.Open
 	if stat.BottleneckScore > 0.7 {
-		scoreLabel = "HIGH"
+		scoreLabel = i18n.T("HIGH")
 		scoreColor = m.theme.Blocked
 	} else if stat.BottleneckScore > 0.3 {
-		scoreLabel = "Medium"
+		scoreLabel = i18n.T("Medium")
 		scoreColor = m.theme.Feature
 	}
 	scoreStyle := m.theme.Renderer.NewStyle().Foreground(scoreColor).Bold(true)
-	b.WriteString(fmt.Sprintf("  Blocking Power: %s %s\n", scoreBar, scoreStyle.Render(scoreLabel)))
+	b.WriteString("  " + i18n.Tf("Blocking Power: %s %s", scoreBar, scoreStyle.Render(scoreLabel)) + "\n")
 
 	if stat.IsBottleneck {
 		bottleneckStyle := m.theme.Renderer.NewStyle().
 			Foreground(m.theme.Blocked).
 			Bold(true)
-		b.WriteString(bottleneckStyle.Render("  ⚠ BOTTLENECK"))
+		b.WriteString(bottleneckStyle.Render("  ⚠ " + i18n.T("BOTTLENECK")))
 		b.WriteString("\n")
 	}
 	b.WriteString("\n")
@@ -585,12 +582,12 @@ func (m FlowMatrixModel) renderDetailPanel(width int) string {
 	blocksHeader := m.theme.Renderer.NewStyle().
 		Foreground(m.theme.Blocked).
 		Bold(true).
-		Render(fmt.Sprintf("BLOCKS → (%d)", stat.OutgoingCount))
+		Render(i18n.Tf("BLOCKS → (%d)", stat.OutgoingCount))
 
 	blockedByHeader := m.theme.Renderer.NewStyle().
 		Foreground(m.theme.InProgress).
 		Bold(true).
-		Render(fmt.Sprintf("← BLOCKED BY (%d)", stat.IncomingCount))
+		Render(i18n.Tf("← BLOCKED BY (%d)", stat.IncomingCount))
 
 	b.WriteString(fmt.Sprintf("%-*s  %s\n", halfWidth, blocksHeader, blockedByHeader))
 
@@ -626,10 +623,10 @@ func (m FlowMatrixModel) renderDetailPanel(width int) string {
 		leftMore := ""
 		rightMore := ""
 		if len(outLabels) > maxEntries {
-			leftMore = fmt.Sprintf("  +%d more", len(outLabels)-maxEntries)
+			leftMore = "  " + i18n.Tf("+%d more", len(outLabels)-maxEntries)
 		}
 		if len(inLabels) > maxEntries {
-			rightMore = fmt.Sprintf("  +%d more", len(inLabels)-maxEntries)
+			rightMore = "  " + i18n.Tf("+%d more", len(inLabels)-maxEntries)
 		}
 		b.WriteString(fmt.Sprintf("%-*s  %s\n", halfWidth, moreStyle.Render(leftMore), moreStyle.Render(rightMore)))
 	}
@@ -638,7 +635,7 @@ func (m FlowMatrixModel) renderDetailPanel(width int) string {
 
 	// Hint
 	hintStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Subtext).Italic(true)
-	b.WriteString(hintStyle.Render("Press Enter to see issues"))
+	b.WriteString(hintStyle.Render(i18n.T("Press Enter to see issues")))
 
 	return b.String()
 }
@@ -743,14 +740,14 @@ func (m FlowMatrixModel) renderDrilldown() string {
 		Foreground(m.theme.Primary)
 
 	b.WriteString(headerStyle.Render(m.drilldownTitle))
-	b.WriteString(fmt.Sprintf(" (%d issues)\n", len(m.drilldownIssues)))
+	b.WriteString(" " + i18n.Nf("(%d issue)|(%d issues)", len(m.drilldownIssues)) + "\n")
 
 	borderStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Border)
 	b.WriteString(borderStyle.Render(strings.Repeat("─", m.width)))
 	b.WriteString("\n\n")
 
 	if len(m.drilldownIssues) == 0 {
-		b.WriteString("No issues found")
+		b.WriteString(i18n.T("No issues found"))
 		return b.String()
 	}
 
@@ -783,10 +780,7 @@ func (m FlowMatrixModel) renderDrilldown() string {
 		if maxTitleLen < 20 {
 			maxTitleLen = 20
 		}
-		titleRunes := []rune(title)
-		if len(titleRunes) > maxTitleLen {
-			title = string(titleRunes[:maxTitleLen-1]) + "…"
-		}
+		title = truncate(title, maxTitleLen)
 
 		row := fmt.Sprintf("%s %s %s",
 			statusStyle.Render(statusIndicator),
@@ -812,7 +806,7 @@ func (m FlowMatrixModel) renderDrilldown() string {
 	b.WriteString("\n")
 
 	helpStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Subtext)
-	b.WriteString(helpStyle.Render("j/k: navigate  Esc: back"))
+	b.WriteString(helpStyle.Render(i18n.T("j/k: navigate  Esc: back")))
 
 	return b.String()
 }
@@ -889,17 +883,17 @@ func (m *FlowMatrixModel) SelectedDrilldownIssue() *model.Issue {
 // It now returns a simple text summary pointing to the interactive view
 func FlowMatrixView(flow analysis.CrossLabelFlow, width int) string {
 	if len(flow.Labels) == 0 {
-		return "No cross-label dependencies found"
+		return i18n.T("No cross-label dependencies found")
 	}
 
 	var b strings.Builder
-	b.WriteString("DEPENDENCY FLOW SUMMARY\n")
+	b.WriteString(i18n.T("DEPENDENCY FLOW SUMMARY") + "\n")
 	b.WriteString(strings.Repeat("─", 40))
 	b.WriteString("\n\n")
 
-	b.WriteString(fmt.Sprintf("Labels: %d\n", len(flow.Labels)))
-	b.WriteString(fmt.Sprintf("Cross-label dependencies: %d\n", flow.TotalCrossLabelDeps))
-	b.WriteString(fmt.Sprintf("Bottleneck labels: %v\n", flow.BottleneckLabels))
+	b.WriteString(i18n.Tf("Labels: %d", len(flow.Labels)) + "\n")
+	b.WriteString(i18n.Tf("Cross-label dependencies: %d", flow.TotalCrossLabelDeps) + "\n")
+	b.WriteString(i18n.Tf("Bottleneck labels: %v", flow.BottleneckLabels) + "\n")
 
 	return b.String()
 }
@@ -0,0 +1,160 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+)
+
+// Frame timings
+//
+// ":perf" toggles an overlay in the top right corner with how long the
+// recent frames took to render and the recent messages to update the
+// model, against frameBudget. It is meant for working on bv itself: a
+// feature that makes the list slow to redraw shows up while using it.
+
+// Performance budgets: how long an operation may take on 10,000 issues.
+// TestPerformanceBudgets enforces them with the BenchmarkRenderPath
+// benchmarks, and the :perf overlay measures frames against frameBudget.
+const (
+	loadBudget   = 150 * time.Millisecond // Parse the beads file
+	filterBudget = 250 * time.Millisecond // Rebuild the list for a filter
+	sortBudget   = 400 * time.Millisecond // Rebuild the list for a sort
+	frameBudget  = 16 * time.Millisecond  // Handle a keypress and render the frame
+)
+
+// frameSamples is how many recent frames and updates the overlay covers.
+const frameSamples = 120
+
+// durationRing holds the most recent frameSamples durations.
+type durationRing struct {
+	samples [frameSamples]time.Duration
+	n       int // Samples held
+	next    int // Where the next sample goes
+}
+
+func (r *durationRing) add(d time.Duration) {
+	r.samples[r.next] = d
+	r.next = (r.next + 1) % frameSamples
+	r.n = min(r.n+1, frameSamples)
+}
+
+// last returns the most recent sample.
+func (r *durationRing) last() time.Duration {
+	if r.n == 0 {
+		return 0
+	}
+	return r.samples[(r.next+frameSamples-1)%frameSamples]
+}
+
+// percentile returns the p-th percentile (0-100) of the samples.
+func (r *durationRing) percentile(p int) time.Duration {
+	if r.n == 0 {
+		return 0
+	}
+	sorted := append([]time.Duration(nil), r.samples[:r.n]...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted[min((r.n*p)/100, r.n-1)]
+}
+
+// over counts the samples longer than budget.
+func (r *durationRing) over(budget time.Duration) int {
+	count := 0
+	for _, d := range r.samples[:r.n] {
+		if d > budget {
+			count++
+		}
+	}
+	return count
+}
+
+// frameStats collects frame and update timings. View records into it, so it
+// has a lock.
+type frameStats struct {
+	mu      sync.Mutex
+	frames  durationRing
+	updates durationRing
+}
+
+func (s *frameStats) recordFrame(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.frames.add(d)
+}
+
+// recordUpdate records an update that began at start.
+func (s *frameStats) recordUpdate(start time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.updates.add(time.Since(start))
+}
+
+// togglePerf shows or hides the frame timing overlay.
+func (m *Model) togglePerf() {
+	if m.perf != nil {
+		m.perf = nil
+		m.statusMsg = i18n.T("Frame timings off")
+	} else {
+		m.perf = &frameStats{}
+		m.statusMsg = i18n.Tf("Frame timings on (budget %s a frame); :perf again hides them", formatFrameDuration(frameBudget))
+	}
+	m.statusIsError = false
+}
+
+// formatFrameDuration formats d in milliseconds with one decimal.
+func formatFrameDuration(d time.Duration) string {
+	return fmt.Sprintf("%.1fms", float64(d)/float64(time.Millisecond))
+}
+
+// renderFrameStats renders the overlay box.
+func (m Model) renderFrameStats() string {
+	t := m.theme
+	s := m.perf
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	line := func(label string, r *durationRing) string {
+		return fmt.Sprintf("%-6s %7s  p95 %7s  max %7s", label,
+			formatFrameDuration(r.last()), formatFrameDuration(r.percentile(95)), formatFrameDuration(r.percentile(100)))
+	}
+	color := t.Open
+	if s.frames.percentile(95) > frameBudget {
+		color = t.Blocked
+	}
+	titleStyle := t.Renderer.NewStyle().Foreground(color).Bold(true)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	body := strings.Join([]string{
+		titleStyle.Render("⏱ " + line("frame", &s.frames)),
+		"  " + line("update", &s.updates),
+		mutedStyle.Render(fmt.Sprintf("  %d frames, %d over the %s budget", s.frames.n, s.frames.over(frameBudget), formatFrameDuration(frameBudget))),
+	}, "\n")
+	return t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(color).
+		Padding(0, 1).
+		Render(body)
+}
+
+// overlayTopRight draws box over the top right corner of view.
+func overlayTopRight(view, box string, width int) string {
+	lines := strings.Split(view, "\n")
+	for i, boxLine := range strings.Split(box, "\n") {
+		if i >= len(lines) {
+			break
+		}
+		left := max(width-lipgloss.Width(boxLine), 0)
+		base := ansi.Truncate(lines[i], left, "")
+		if pad := left - lipgloss.Width(base); pad > 0 {
+			base += strings.Repeat(" ", pad)
+		}
+		lines[i] = base + boxLine
+	}
+	return strings.Join(lines, "\n")
+}
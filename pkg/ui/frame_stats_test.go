@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestDurationRing(t *testing.T) {
+	var r durationRing
+	if r.last() != 0 || r.percentile(95) != 0 {
+		t.Fatal("expected an empty ring to report zero")
+	}
+	for i := 1; i <= frameSamples+10; i++ {
+		r.add(time.Duration(i) * time.Millisecond)
+	}
+	if r.n != frameSamples || r.last() != time.Duration(frameSamples+10)*time.Millisecond {
+		t.Fatalf("expected the newest %d samples, got n=%d last=%s", frameSamples, r.n, r.last())
+	}
+	if got := r.percentile(100); got != time.Duration(frameSamples+10)*time.Millisecond {
+		t.Errorf("max = %s", got)
+	}
+	if got := r.percentile(0); got != 11*time.Millisecond {
+		t.Errorf("expected the oldest samples dropped, min = %s", got)
+	}
+	if got := r.over(100 * time.Millisecond); got != 30 {
+		t.Errorf("over = %d, want 30", got)
+	}
+}
+
+func TestOverlayTopRight(t *testing.T) {
+	view := "\x1b[1mabcdefghij\x1b[0m\nshort\nlast line"
+	got := overlayTopRight(view, "[XY]\n[ZW]", 10)
+	lines := strings.Split(ansi.Strip(got), "\n")
+	want := []string{"abcdef[XY]", "short [ZW]", "last line"}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("line %d = %q, want %q", i, lines[i], want[i])
+		}
+	}
+}
+
+func TestPerfCommandShowsFrameTimings(t *testing.T) {
+	m := commandTestModel(t)
+	m, _, err := m.ExecCommand("perf")
+	if err != nil || m.perf == nil {
+		t.Fatalf("expected :perf to turn timings on, got %v", err)
+	}
+	m.View()
+	m = pressKey(m, runeKey("j"))
+	view := ansi.Strip(m.View())
+	if !strings.Contains(view, "⏱ frame") || !strings.Contains(view, "over the 16.0ms budget") {
+		t.Fatalf("expected the overlay, got:\n%s", view)
+	}
+	if m.perf.frames.n < 2 || m.perf.updates.n < 1 {
+		t.Errorf("expected frames and updates recorded, got %d and %d", m.perf.frames.n, m.perf.updates.n)
+	}
+	for _, line := range strings.Split(m.View(), "\n") {
+		if w := ansi.StringWidth(line); w > m.width {
+			t.Fatalf("overlay widened a line to %d columns", w)
+		}
+	}
+
+	m, _, _ = m.ExecCommand("perf")
+	if m.perf != nil || strings.Contains(ansi.Strip(m.View()), "⏱ frame") {
+		t.Error("expected :perf again to hide the overlay")
+	}
+}
@@ -0,0 +1,42 @@
+package ui
+
+import (
+	"testing"
+)
+
+// FuzzCommandLine checks that no line typed at the ":" prompt or given to
+// --cmd makes the command parser, completion or a command it accepts panic.
+//
+// Run with: go test -fuzz=FuzzCommandLine ./pkg/ui/
+func FuzzCommandLine(f *testing.F) {
+	for _, seed := range []string{
+		"", ":", "filter ready label:api", ":FILTER open  priority:0", "filter label:", "sort -created", "view board",
+		"view nowhere", "search a b", "recipe triage", "select A-1", "watch", "unwatch A-9", "run", "stats", "A-2",
+		"p0", "filter priority:9", "view  ", "\x00", "filter 日本", "new title",
+	} {
+		f.Add(seed)
+	}
+
+	m := commandTestModel(f)
+	m.SetAliases(map[string]string{"p0": "filter open priority:0; sort priority"})
+	d := m.completionData()
+	f.Fuzz(func(t *testing.T, line string) {
+		for _, completion := range CompleteCommand(line, d) {
+			_ = ValidateCommand(completion, m.aliases)
+		}
+		_ = ValidateAliases(map[string]string{"x": line})
+		if ValidateCommand(line, m.aliases) != nil {
+			return
+		}
+		next, _, err := m.ExecCommand(line)
+		if err != nil {
+			t.Logf("%q: %v", line, err)
+		}
+		// Update reports panics rather than crashing; they are still bugs.
+		for _, d := range next.diagnostics {
+			if d.err.kind == errInternal {
+				t.Fatalf("%q: %v", line, d.err)
+			}
+		}
+	})
+}
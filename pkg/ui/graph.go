@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 
 	"github.com/charmbracelet/lipgloss"
@@ -38,6 +39,15 @@ type GraphModel struct {
 	rankCriticalPath map[string]int
 	rankInDegree     map[string]int
 	rankOutDegree    map[string]int
+
+	// Other participants of a shared session, marked on their issues
+	presence *PresenceSession
+
+	// Issues on the shown critical path (see critical_path.go)
+	critical map[string]bool
+
+	// Issues of other repos that issues depend on (see external.go)
+	external map[string]*model.Issue
 }
 
 // NewGraphModel creates a new graph view from issues
@@ -296,7 +306,7 @@ func (g *GraphModel) View(width, height int) string {
 			Height(height).
 			Align(lipgloss.Center, lipgloss.Center).
 			Foreground(t.Secondary).
-			Render("No issues to display")
+			Render(i18n.T("No issues to display"))
 	}
 
 	selectedID := g.sortedIDs[g.selectedIdx]
@@ -373,9 +383,16 @@ func (g *GraphModel) renderNodeList(width, height int, t Theme) string {
 
 		isSelected := i == g.selectedIdx
 		statusIcon := getStatusIcon(issue.Status)
-		maxIDLen := width - 4
+		marks := presenceMarks(g.presence, id, t.Renderer)
+		if g.critical[id] {
+			marks += criticalMark(t.Renderer, t)
+		}
+		maxIDLen := width - 4 - lipgloss.Width(marks)
 		displayID := smartTruncateID(id, maxIDLen)
 		line := fmt.Sprintf("%s %s", statusIcon, displayID)
+		if marks != "" {
+			line += " " + marks
+		}
 
 		var style lipgloss.Style
 		if isSelected {
@@ -447,7 +464,7 @@ func (g *GraphModel) renderVisualGraph(id string, issue *model.Issue, width, hei
 		Foreground(t.Secondary).
 		Italic(true)
 	sections = append(sections, "")
-	sections = append(sections, navStyle.Render("j/k: navigate • enter: view details • g: back to list"))
+	sections = append(sections, navStyle.Render(i18n.T("j/k: navigate • enter: view details • g: back to list")))
 
 	return strings.Join(sections, "\n")
 }
@@ -460,7 +477,7 @@ func (g *GraphModel) renderBlockersVisual(blockerIDs []string, width int, t Them
 		Width(width).
 		Align(lipgloss.Center)
 
-	header := headerStyle.Render("▲ BLOCKED BY (must complete first) ▲")
+	header := headerStyle.Render(i18n.T("▲ BLOCKED BY (must complete first) ▲"))
 
 	// Calculate box width based on available space and number of blockers
 	maxBoxes := 5
@@ -550,7 +567,7 @@ func (g *GraphModel) renderDependentsVisual(dependentIDs []string, width int, t
 		Width(width).
 		Align(lipgloss.Center)
 
-	header := headerStyle.Render("▼ BLOCKS (waiting on this) ▼")
+	header := headerStyle.Render(i18n.T("▼ BLOCKS (waiting on this) ▼"))
 
 	return centered + "\n" + header
 }
@@ -561,6 +578,7 @@ func (g *GraphModel) renderNodeBox(id string, boxWidth int, t Theme, isEgo bool)
 
 	var statusIcon, displayID, title string
 	var statusColor lipgloss.AdaptiveColor
+	external := issue == nil && g.external[id] != nil
 
 	if issue != nil {
 		statusIcon = getStatusIcon(issue.Status)
@@ -569,6 +587,12 @@ func (g *GraphModel) renderNodeBox(id string, boxWidth int, t Theme, isEgo bool)
 		if issue.Title != "" {
 			title = truncateRunesHelper(issue.Title, boxWidth-4, "…")
 		}
+	} else if external {
+		ext := g.external[id]
+		statusIcon = getStatusIcon(ext.Status)
+		statusColor = getStatusColor(ext.Status, t)
+		displayID = smartTruncateID(id, boxWidth-4)
+		title = truncateRunesHelper(externalMark+" "+ext.SourceRepo+": "+ext.Title, boxWidth-4, "…")
 	} else {
 		statusIcon = "❓"
 		statusColor = t.Secondary
@@ -578,6 +602,12 @@ func (g *GraphModel) renderNodeBox(id string, boxWidth int, t Theme, isEgo bool)
 
 	// Build box content
 	line1 := fmt.Sprintf("%s %s", statusIcon, displayID)
+	if marks := presenceMarks(g.presence, id, t.Renderer); marks != "" {
+		line1 += " " + marks
+	}
+	if g.critical[id] {
+		line1 += " " + criticalMark(t.Renderer, t)
+	}
 
 	var boxStyle lipgloss.Style
 	if isEgo {
@@ -590,6 +620,24 @@ func (g *GraphModel) renderNodeBox(id string, boxWidth int, t Theme, isEgo bool)
 			Width(boxWidth).
 			Align(lipgloss.Center).
 			Padding(0, 1)
+	} else if g.critical[id] {
+		// Critical path nodes get a thick border in the blocked color
+		boxStyle = t.Renderer.NewStyle().
+			Border(lipgloss.ThickBorder()).
+			BorderForeground(t.Blocked).
+			Foreground(statusColor).
+			Width(boxWidth).
+			Align(lipgloss.Center).
+			Padding(0, 0)
+	} else if external {
+		// Issues of other repos get square corners
+		boxStyle = t.Renderer.NewStyle().
+			Border(lipgloss.NormalBorder()).
+			BorderForeground(statusColor).
+			Foreground(statusColor).
+			Width(boxWidth).
+			Align(lipgloss.Center).
+			Padding(0, 0)
 	} else {
 		boxStyle = t.Renderer.NewStyle().
 			Border(lipgloss.RoundedBorder()).
@@ -637,6 +685,12 @@ func (g *GraphModel) renderEgoNode(id string, issue *model.Issue, width int, t T
 	}
 
 	content := icons + " " + displayID
+	if marks := presenceMarks(g.presence, id, t.Renderer); marks != "" {
+		content += " " + marks
+	}
+	if g.critical[id] {
+		content += " " + criticalMark(t.Renderer, t)
+	}
 	if title != "" {
 		content += "\n" + title
 	}
@@ -712,7 +766,7 @@ func (g *GraphModel) renderMetricsPanel(id string, width int, t Theme) string {
 		Padding(0, 2).
 		Width(width - 4)
 
-	panelTitle := panelHeaderStyle.Render("📊 GRAPH METRICS")
+	panelTitle := panelHeaderStyle.Render(i18n.T("📊 GRAPH METRICS"))
 
 	if g.insights == nil || g.insights.Stats == nil {
 		noDataStyle := t.Renderer.NewStyle().
@@ -721,7 +775,7 @@ func (g *GraphModel) renderMetricsPanel(id string, width int, t Theme) string {
 			Padding(1, 2).
 			Width(width - 4).
 			Align(lipgloss.Center)
-		return panelTitle + "\n" + noDataStyle.Render("No graph analysis data available")
+		return panelTitle + "\n" + noDataStyle.Render(i18n.T("No graph analysis data available"))
 	}
 
 	stats := g.insights.Stats
@@ -839,7 +893,7 @@ func (g *GraphModel) renderMetricsPanel(id string, width int, t Theme) string {
 		Foreground(ColorPrimary).
 		Bold(true).
 		Padding(0, 1)
-	rows = append(rows, sectionStyle.Render("Importance"))
+	rows = append(rows, sectionStyle.Render(i18n.T("Importance")))
 	rows = append(rows, "  "+renderMetricRow("Critical Path", critPath, rankCP, maxCP, false))
 	rows = append(rows, "  "+renderMetricRow("PageRank", pageRank, rankPR, maxPR, false))
 	rows = append(rows, "  "+renderMetricRow("Eigenvector", eigenvector, rankEV, maxEV, false))
@@ -847,7 +901,7 @@ func (g *GraphModel) renderMetricsPanel(id string, width int, t Theme) string {
 	rows = append(rows, "")
 
 	// Section: Flow Metrics
-	rows = append(rows, sectionStyle.Render("Flow & Connectivity"))
+	rows = append(rows, sectionStyle.Render(i18n.T("Flow & Connectivity")))
 	rows = append(rows, "  "+renderMetricRow("Betweenness", betweenness, rankBW, maxBW, false))
 	rows = append(rows, "  "+renderMetricRow("Hub Score", hubs, rankHub, maxHub, false))
 	rows = append(rows, "  "+renderMetricRow("Authority", authorities, rankAuth, maxAuth, false))
@@ -855,7 +909,7 @@ func (g *GraphModel) renderMetricsPanel(id string, width int, t Theme) string {
 	rows = append(rows, "")
 
 	// Section: Degree
-	rows = append(rows, sectionStyle.Render("Connections"))
+	rows = append(rows, sectionStyle.Render(i18n.T("Connections")))
 	rows = append(rows, "  "+renderMetricRow("In-Degree", inDeg, rankIn, maxIn, true))
 	rows = append(rows, "  "+renderMetricRow("Out-Degree", outDeg, rankOut, maxOut, true))
 
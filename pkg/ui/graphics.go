@@ -0,0 +1,343 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"hash/fnv"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// Terminal graphics
+//
+// Terminals that show images get the priority and assignee of the detail
+// view as small pictures: signal bars coloured by priority, and an
+// identicon drawn from the assignee's name, so nothing is fetched. Each
+// picture takes the two cells of the text it stands in for, so the layout
+// is the same with and without them, and terminals that show no images keep
+// the text. ui.graphics turns them on: "auto" uses what the environment
+// says the terminal supports, a protocol name forces it.
+//
+// Kitty images are drawn with Unicode placeholders, ordinary text cells the
+// terminal replaces, so they scroll and redraw like any text. iTerm2 and
+// sixel images are drawn at the cursor over two blank cells.
+
+// Graphics protocols.
+const (
+	graphicsKitty  = "kitty"
+	graphicsITerm2 = "iterm2"
+	graphicsSixel  = "sixel"
+)
+
+// graphicsModes lists the values of ui.graphics.
+var graphicsModes = []string{"auto", "off", graphicsKitty, graphicsITerm2, graphicsSixel}
+
+// ValidateGraphics checks a ui.graphics value.
+func ValidateGraphics(mode string) error {
+	if mode == "" {
+		return nil
+	}
+	for _, m := range graphicsModes {
+		if mode == m {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown graphics mode %q (want %s)", mode, strings.Join(graphicsModes, ", "))
+}
+
+// GraphicsFor returns the image protocol to use for a ui.graphics value, or
+// "" for text.
+func (t Terminal) GraphicsFor(mode string) string {
+	switch mode {
+	case "", "off":
+		return ""
+	case "auto":
+		return t.Graphics
+	}
+	return mode
+}
+
+// graphicsSupported guesses which image protocol the terminal speaks, based
+// on the environment, or returns "".
+func graphicsSupported(getenv func(string) string) string {
+	term := getenv("TERM")
+	// Multiplexers only pass images through when explicitly configured.
+	if getenv("TMUX") != "" || strings.HasPrefix(term, "screen") || strings.HasPrefix(term, "tmux") {
+		return ""
+	}
+	program := getenv("TERM_PROGRAM")
+	switch {
+	case getenv("KITTY_WINDOW_ID") != "", strings.Contains(term, "kitty"), program == "ghostty", strings.Contains(term, "ghostty"):
+		return graphicsKitty
+	case program == "iTerm.app", program == "WezTerm", getenv("LC_TERMINAL") == "iTerm2":
+		return graphicsITerm2
+	case strings.HasPrefix(term, "foot"), strings.HasPrefix(term, "mlterm"), strings.Contains(term, "sixel"):
+		return graphicsSixel
+	}
+	return ""
+}
+
+// EnableGraphics draws the detail view's priority and assignee as images in
+// protocol, one of those GraphicsFor returns. "" keeps text.
+func (m *Model) EnableGraphics(protocol string) {
+	if protocol == "" {
+		m.graphics = nil
+		return
+	}
+	m.graphics = &graphics{protocol: protocol, cache: make(map[string]string)}
+}
+
+// graphics renders the images of the detail view.
+type graphics struct {
+	protocol string
+	cache    map[string]string // Escape sequences by picture
+}
+
+// decorate swaps the priority icon and "@assignee" of the metadata row of a
+// rendered detail view for images.
+func (g *graphics) decorate(rendered string, issue model.Issue) string {
+	lines := strings.Split(rendered, "\n")
+	status := strings.ToUpper(string(issue.Status))
+	for i, line := range lines {
+		if !strings.Contains(line, issue.ID) || !strings.Contains(line, status) {
+			continue
+		}
+		if issue.Priority >= 0 && issue.Priority <= 4 {
+			key := "priority:" + strconv.Itoa(issue.Priority)
+			line = strings.Replace(line, GetPriorityIcon(issue.Priority), g.picture(key, func(scale int) *image.Paletted {
+				return priorityImage(issue.Priority, scale)
+			}), 1)
+		}
+		if issue.Assignee != "" {
+			line = g.withAvatar(line, issue.Assignee)
+		}
+		lines[i] = line
+		break
+	}
+	return strings.Join(lines, "\n")
+}
+
+// withAvatar swaps "@name" in line for the avatar and the name. The avatar
+// takes the "@" and the space after the name, which a table cell always
+// has, past the escape sequences that end its style.
+func (g *graphics) withAvatar(line, name string) string {
+	start := strings.Index(line, "@"+name)
+	if start < 0 {
+		return line
+	}
+	end := start + 1 + len(name)
+	space := end
+	for {
+		loc := ansiSeqPattern.FindStringIndex(line[space:])
+		if loc == nil || loc[0] != 0 {
+			break
+		}
+		space += loc[1]
+	}
+	if space >= len(line) || line[space] != ' ' {
+		return line
+	}
+	avatar := g.picture("avatar:"+name, func(scale int) *image.Paletted {
+		return avatarImage(name, scale)
+	})
+	return line[:start] + avatar + line[start+1:space] + line[space+1:]
+}
+
+// picture returns the escape sequences that draw the image draw makes, two
+// cells wide and one high, from the cache when it was drawn before.
+func (g *graphics) picture(key string, draw func(scale int) *image.Paletted) string {
+	if seq, ok := g.cache[key]; ok {
+		return seq
+	}
+	var seq string
+	switch g.protocol {
+	case graphicsKitty:
+		seq = kittyPicture(key, draw(6))
+	case graphicsITerm2:
+		seq = iterm2Picture(draw(6))
+	case graphicsSixel:
+		// Sixel images are placed in pixels; keep them within a row.
+		seq = "  \x1b[2D\x1b7" + encodeSixel(draw(3)) + "\x1b8\x1b[2C"
+	}
+	g.cache[key] = seq
+	return seq
+}
+
+// kittyPlaceholder is the character kitty replaces with image cells. The
+// combining marks after it give the row and column.
+const kittyPlaceholder = "\U0010EEEE"
+
+// kittyPicture transmits img with a virtual placement and returns it with
+// the two placeholder cells that show it. The image ID, a hash of key, is
+// carried in the placeholders' foreground colour.
+func kittyPicture(key string, img *image.Paletted) string {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	id := h.Sum32()&0xFFFFFF | 1
+
+	data := base64.StdEncoding.EncodeToString(encodePNG(img))
+	var sb strings.Builder
+	// Payloads go in chunks of at most 4096 bytes; q=2 keeps the terminal
+	// from answering, which would arrive as key presses.
+	for first := true; first || data != ""; first = false {
+		chunk := data
+		if len(chunk) > 4096 {
+			chunk = chunk[:4096]
+		}
+		data = data[len(chunk):]
+		more := 0
+		if data != "" {
+			more = 1
+		}
+		if first {
+			fmt.Fprintf(&sb, "\x1b_Ga=T,U=1,f=100,q=2,i=%d,c=2,r=1,m=%d;%s\x1b\\", id, more, chunk)
+		} else {
+			fmt.Fprintf(&sb, "\x1b_Gm=%d;%s\x1b\\", more, chunk)
+		}
+	}
+	fmt.Fprintf(&sb, "\x1b[38;2;%d;%d;%dm", id>>16&0xFF, id>>8&0xFF, id&0xFF)
+	sb.WriteString(kittyPlaceholder + "\u0305\u0305" + kittyPlaceholder + "\u0305\u030D")
+	sb.WriteString("\x1b[39m")
+	return sb.String()
+}
+
+// iterm2Picture draws img inline over two blank cells; iTerm2 leaves the
+// cursor after it.
+func iterm2Picture(img *image.Paletted) string {
+	data := encodePNG(img)
+	return fmt.Sprintf("  \x1b[2D\x1b]1337;File=inline=1;size=%d;width=2;height=1;preserveAspectRatio=1:%s\a",
+		len(data), base64.StdEncoding.EncodeToString(data))
+}
+
+func encodePNG(img image.Image) []byte {
+	var buf bytes.Buffer
+	_ = png.Encode(&buf, img) // Writing to memory cannot fail
+	return buf.Bytes()
+}
+
+// encodeSixel encodes img as sixel, leaving palette index 0 transparent.
+func encodeSixel(img *image.Paletted) string {
+	b := img.Bounds()
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "\x1bP0;1;0q\"1;1;%d;%d", b.Dx(), b.Dy())
+	for i, c := range img.Palette[1:] {
+		r, g, bl, _ := c.RGBA()
+		fmt.Fprintf(&sb, "#%d;2;%d;%d;%d", i+1, r*100/0xFFFF, g*100/0xFFFF, bl*100/0xFFFF)
+	}
+	for y0 := b.Min.Y; y0 < b.Max.Y; y0 += 6 {
+		first := true
+		for ci := 1; ci < len(img.Palette); ci++ {
+			row := make([]byte, 0, b.Dx())
+			used := false
+			for x := b.Min.X; x < b.Max.X; x++ {
+				bits := 0
+				for dy := 0; dy < 6 && y0+dy < b.Max.Y; dy++ {
+					if int(img.ColorIndexAt(x, y0+dy)) == ci {
+						bits |= 1 << dy
+						used = true
+					}
+				}
+				row = append(row, byte(63+bits))
+			}
+			if !used {
+				continue
+			}
+			if !first {
+				sb.WriteByte('$')
+			}
+			first = false
+			fmt.Fprintf(&sb, "#%d%s", ci, row)
+		}
+		sb.WriteByte('-')
+	}
+	sb.WriteString("\x1b\\")
+	return sb.String()
+}
+
+// priorityImage draws priority as four signal bars, as many lit as the
+// priority is urgent, each scale pixels wide on a 5×5 grid.
+func priorityImage(priority, scale int) *image.Paletted {
+	colors := []string{ColorPrioCritical.Dark, ColorPrioHigh.Dark, ColorPrioMedium.Dark, ColorPrioLow.Dark, "#6272A4"}
+	img := image.NewPaletted(image.Rect(0, 0, 5*scale, 5*scale), color.Palette{
+		color.Transparent, hexColor(colors[priority]), hexColor("#44475A"),
+	})
+	lit := 4 - priority
+	for bar := 0; bar < 4; bar++ {
+		index := uint8(2)
+		if bar < lit {
+			index = 1
+		}
+		// Bars grow from a quarter to the full height, a grid cell apart.
+		top := 5*scale - (bar+1)*5*scale/4
+		x0 := bar*scale + bar*scale/3
+		for y := top; y < 5*scale; y++ {
+			for x := x0; x < x0+scale && x < 5*scale; x++ {
+				img.SetColorIndex(x, y, index)
+			}
+		}
+	}
+	return img
+}
+
+// avatarImage draws a 5×5 symmetric identicon for name, each grid cell
+// scale pixels square. The hash of the name picks the pattern and the hue.
+func avatarImage(name string, scale int) *image.Paletted {
+	h := fnv.New64a()
+	h.Write([]byte(name))
+	sum := h.Sum64()
+	img := image.NewPaletted(image.Rect(0, 0, 5*scale, 5*scale), color.Palette{
+		color.Transparent, hslColor(float64((sum>>32)%360), 0.55, 0.6),
+	})
+	for row := 0; row < 5; row++ {
+		for col := 0; col < 3; col++ {
+			if sum>>(row*3+col)&1 == 0 {
+				continue
+			}
+			for _, c := range []int{col, 4 - col} {
+				for y := row * scale; y < (row+1)*scale; y++ {
+					for x := c * scale; x < (c+1)*scale; x++ {
+						img.SetColorIndex(x, y, 1)
+					}
+				}
+			}
+		}
+	}
+	return img
+}
+
+// hexColor parses a "#RRGGBB" colour.
+func hexColor(hex string) color.RGBA {
+	v, _ := strconv.ParseUint(strings.TrimPrefix(hex, "#"), 16, 32)
+	return color.RGBA{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 0xFF}
+}
+
+// hslColor converts a hue in degrees, saturation and lightness to RGB.
+func hslColor(h, s, l float64) color.RGBA {
+	c := (1 - math.Abs(2*l-1)) * s
+	hp := h / 60
+	x := c * (1 - math.Abs(math.Mod(hp, 2)-1))
+	var r, g, b float64
+	switch int(hp) {
+	case 0:
+		r, g = c, x
+	case 1:
+		r, g = x, c
+	case 2:
+		g, b = c, x
+	case 3:
+		g, b = x, c
+	case 4:
+		r, b = x, c
+	default:
+		r, b = c, x
+	}
+	m := l - c/2
+	return color.RGBA{R: uint8((r + m) * 255), G: uint8((g + m) * 255), B: uint8((b + m) * 255), A: 0xFF}
+}
@@ -0,0 +1,139 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestValidateGraphics(t *testing.T) {
+	for _, mode := range []string{"", "auto", "off", "kitty", "iterm2", "sixel"} {
+		if err := ValidateGraphics(mode); err != nil {
+			t.Errorf("%q: unexpected error %v", mode, err)
+		}
+	}
+	if err := ValidateGraphics("png"); err == nil || !strings.Contains(err.Error(), "auto, off, kitty") {
+		t.Errorf("expected an error listing the modes, got %v", err)
+	}
+}
+
+func TestGraphicsSupported(t *testing.T) {
+	cases := []struct {
+		env  map[string]string
+		want string
+	}{
+		{map[string]string{"TERM": "xterm-256color"}, ""},
+		{map[string]string{"TERM": "xterm-kitty"}, "kitty"},
+		{map[string]string{"TERM": "xterm-256color", "KITTY_WINDOW_ID": "1"}, "kitty"},
+		{map[string]string{"TERM": "xterm-ghostty", "TERM_PROGRAM": "ghostty"}, "kitty"},
+		{map[string]string{"TERM": "xterm-256color", "TERM_PROGRAM": "iTerm.app"}, "iterm2"},
+		{map[string]string{"TERM": "xterm-256color", "LC_TERMINAL": "iTerm2"}, "iterm2"},
+		{map[string]string{"TERM": "foot"}, "sixel"},
+		{map[string]string{"TERM": "xterm-kitty", "TMUX": "/tmp/tmux-1000/default,1,0"}, ""},
+		{map[string]string{"TERM": "screen-256color", "TERM_PROGRAM": "iTerm.app"}, ""},
+	}
+	for _, tc := range cases {
+		if got := graphicsSupported(func(key string) string { return tc.env[key] }); got != tc.want {
+			t.Errorf("%v: got %q, want %q", tc.env, got, tc.want)
+		}
+	}
+
+	kitty := Terminal{Graphics: "kitty"}
+	for mode, want := range map[string]string{"": "", "off": "", "auto": "kitty", "sixel": "sixel"} {
+		if got := kitty.GraphicsFor(mode); got != want {
+			t.Errorf("GraphicsFor(%q) = %q, want %q", mode, got, want)
+		}
+	}
+	if got := detectTerminal(func(key string) string { return map[string]string{"TERM": "vt100"}[key] }).Graphics; got != "" {
+		t.Errorf("expected no images on a vt100, got %q", got)
+	}
+}
+
+func graphicsTestModel(t *testing.T) Model {
+	t.Helper()
+	return commandTestModel(t, func(issues []model.Issue) []model.Issue {
+		issues[1].Assignee = "alice"
+		return issues
+	})
+}
+
+func TestGraphics_DecorateKeepsLayout(t *testing.T) {
+	text := graphicsTestModel(t)
+	textLines := strings.Split(text.viewport.View(), "\n")
+
+	for protocol, marker := range map[string]string{
+		"kitty":  kittyPlaceholder,
+		"iterm2": "\x1b]1337;File=",
+		"sixel":  "\x1bP0;1;0q",
+	} {
+		m := graphicsTestModel(t)
+		m.EnableGraphics(protocol)
+		m.updateViewportContent()
+		view := m.viewport.View()
+		if strings.Count(view, marker) < 2 {
+			t.Errorf("%s: expected the priority and avatar images, got %q", protocol, view)
+			continue
+		}
+		if strings.Contains(view, "@alice") || strings.Contains(view, GetPriorityIcon(0)) {
+			t.Errorf("%s: expected the images to replace the text", protocol)
+		}
+		if !strings.Contains(ansi.Strip(view), "alice") {
+			t.Errorf("%s: expected the assignee's name to stay", protocol)
+		}
+		for i, line := range strings.Split(view, "\n") {
+			if i >= len(textLines) {
+				break
+			}
+			if ansi.StringWidth(line) != ansi.StringWidth(textLines[i]) {
+				t.Errorf("%s: line %d is %d cells wide, %d as text", protocol, i, ansi.StringWidth(line), ansi.StringWidth(textLines[i]))
+			}
+			// Columns after the images start where they do in text.
+			if date := time.Now().Format("2006-01-02"); strings.Contains(line, date) {
+				got := ansi.StringWidth(line[:strings.Index(line, date)])
+				want := ansi.StringWidth(textLines[i][:strings.Index(textLines[i], date)])
+				if got != want {
+					t.Errorf("%s: the created date moved from column %d to %d", protocol, want, got)
+				}
+			}
+		}
+	}
+
+	text.EnableGraphics("")
+	text.updateViewportContent()
+	if !strings.Contains(text.viewport.View(), "@alice") {
+		t.Error("expected text without a graphics protocol")
+	}
+}
+
+func TestEncodeSixel(t *testing.T) {
+	img := priorityImage(1, 3)
+	seq := encodeSixel(img)
+	if !strings.HasPrefix(seq, "\x1bP0;1;0q\"1;1;15;15") || !strings.HasSuffix(seq, "-\x1b\\") {
+		t.Errorf("unexpected sixel framing: %q", seq)
+	}
+	// 15 rows make three bands, each ending in a graphics newline.
+	if bands := strings.Count(seq, "-"); bands != 3 {
+		t.Errorf("expected 3 bands, got %d", bands)
+	}
+	if strings.Contains(seq, "#0") {
+		t.Error("expected the transparent colour never to be painted")
+	}
+}
+
+func TestAvatarImage_Symmetric(t *testing.T) {
+	a, b := avatarImage("alice", 2), avatarImage("bob", 2)
+	if a.Palette[1] == b.Palette[1] && string(a.Pix) == string(b.Pix) {
+		t.Error("expected different names to get different avatars")
+	}
+	w := a.Bounds().Dx()
+	for y := 0; y < a.Bounds().Dy(); y++ {
+		for x := 0; x < w/2; x++ {
+			if a.ColorIndexAt(x, y) != a.ColorIndexAt(w-1-x, y) {
+				t.Fatalf("expected a mirrored identicon, differs at %d,%d", x, y)
+			}
+		}
+	}
+}
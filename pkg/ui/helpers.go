@@ -5,74 +5,66 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/mattn/go-runewidth"
+	"github.com/charmbracelet/x/ansi"
 )
 
-// FormatTimeRel returns a relative time string (e.g., "2h ago", "3d ago")
-func FormatTimeRel(t time.Time) string {
+// FormatTimeRel returns how long before now t was (e.g., "2h ago", "3d ago"),
+// in the current locale (see i18n.RelativeTime)
+func FormatTimeRel(t, now time.Time) string {
 	if t.IsZero() {
-		return "unknown"
+		return i18n.T("unknown")
 	}
 
-	d := time.Since(t)
+	d := now.Sub(t)
 	if d < 0 {
 		// Future timestamps treated as now
-		return "now"
+		d = 0
 	}
-	switch {
-	case d < time.Minute:
-		return "now"
-	case d < time.Hour:
-		return fmt.Sprintf("%dm ago", int(d.Minutes()))
-	case d < 24*time.Hour:
-		return fmt.Sprintf("%dh ago", int(d.Hours()))
-	case d < 7*24*time.Hour:
-		return fmt.Sprintf("%dd ago", int(d.Hours()/24))
-	case d < 30*24*time.Hour:
-		return fmt.Sprintf("%dw ago", int(d.Hours()/(24*7)))
-	default:
-		return fmt.Sprintf("%dmo ago", int(d.Hours()/(24*30)))
+	return i18n.RelativeTime(d)
+}
+
+// clockNow returns now(), or the wall clock when no clock is set.
+func clockNow(now func() time.Time) time.Time {
+	if now == nil {
+		return time.Now()
 	}
+	return now()
 }
 
 // truncateRunesHelper truncates a string to max visual width (cells), adding suffix if needed.
-// Uses go-runewidth to handle wide characters correctly.
+// Width is counted per grapheme cluster, as lipgloss.Width counts it: CJK and
+// emoji take two cells, combining marks and ZWJ sequences stay with the
+// character they modify and are never split. ANSI styling is preserved.
 func truncateRunesHelper(s string, maxWidth int, suffix string) string {
 	if maxWidth <= 0 {
 		return ""
 	}
-
-	width := runewidth.StringWidth(s)
-	if width <= maxWidth {
+	if ansi.StringWidth(s) <= maxWidth {
 		return s
 	}
-
-	suffixWidth := runewidth.StringWidth(suffix)
-	if suffixWidth > maxWidth {
+	if ansi.StringWidth(suffix) > maxWidth {
 		// Even suffix is too wide, truncate suffix
-		return runewidth.Truncate(suffix, maxWidth, "")
+		return ansi.Truncate(suffix, maxWidth, "")
 	}
-
-	targetWidth := maxWidth - suffixWidth
-	return runewidth.Truncate(s, targetWidth, "") + suffix
+	return ansi.Truncate(s, maxWidth, suffix)
 }
 
-// padRight pads string s with spaces on the right to reach visual width.
-// Uses go-runewidth to handle wide characters (emojis, CJK) correctly,
-// consistent with truncateRunesHelper which also uses visual width.
+// padRight pads string s with spaces on the right to reach visual width,
+// measured like truncateRunesHelper.
 func padRight(s string, width int) string {
-	visualWidth := runewidth.StringWidth(s)
+	visualWidth := ansi.StringWidth(s)
 	if visualWidth >= width {
 		return s
 	}
 	return s + strings.Repeat(" ", width-visualWidth)
 }
 
-// truncate truncates string s to maxRunes
-func truncate(s string, maxRunes int) string {
-	return truncateRunesHelper(s, maxRunes, "…")
+// truncate truncates string s to maxWidth cells, ending it with "…".
+func truncate(s string, maxWidth int) string {
+	return truncateRunesHelper(s, maxWidth, "…")
 }
 
 // DependencyNode represents a visual node in the dependency tree
@@ -142,11 +134,11 @@ func buildTreeRecursive(id string, issueMap map[string]*model.Issue, depType str
 // RenderDependencyTree renders a dependency tree as a formatted string
 func RenderDependencyTree(node *DependencyNode) string {
 	if node == nil {
-		return "No dependency data."
+		return i18n.T("No dependency data.")
 	}
 
 	var sb strings.Builder
-	sb.WriteString("Dependency Graph:\n")
+	sb.WriteString(i18n.T("Dependency Graph:") + "\n")
 	renderTreeNode(&sb, node, "", true, true) // isRoot=true for root node
 	return sb.String()
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/cass"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/correlation"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
 	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -163,6 +164,7 @@ type HistoryModel struct {
 
 	// View mode transition state (bv-kvlx)
 	modeChangedAt time.Time // Timestamp of last mode toggle for transition animation
+	reducedMotion bool      // Skip the transition flash
 }
 
 // NewHistoryModel creates a new history view from a correlation report
@@ -964,7 +966,9 @@ func (h *HistoryModel) GetSearchModeName() string {
 // ToggleViewMode switches between Bead mode and Git mode
 func (h *HistoryModel) ToggleViewMode() {
 	// Track mode change time for transition animation (bv-kvlx)
-	h.modeChangedAt = time.Now()
+	if !h.reducedMotion {
+		h.modeChangedAt = time.Now()
+	}
 
 	if h.viewMode == historyModeBead {
 		h.viewMode = historyModeGit
@@ -1494,16 +1498,16 @@ func (h *HistoryModel) renderTimelinePanel(width, height int) string {
 
 	// Get selected bead
 	if len(h.beadIDs) == 0 || h.selectedBead >= len(h.beadIDs) {
-		content := titleStyle.Render("TIMELINE") + "\n\n" +
-			r.NewStyle().Foreground(t.Secondary).Render("Select a bead to view timeline")
+		content := titleStyle.Render(i18n.T("TIMELINE")) + "\n\n" +
+			r.NewStyle().Foreground(t.Secondary).Render(i18n.T("Select a bead to view timeline"))
 		return panelStyle.Render(content)
 	}
 
 	beadID := h.beadIDs[h.selectedBead]
 	hist, ok := h.report.Histories[beadID]
 	if !ok {
-		content := titleStyle.Render("TIMELINE") + "\n\n" +
-			r.NewStyle().Foreground(t.Secondary).Render("No history data")
+		content := titleStyle.Render(i18n.T("TIMELINE")) + "\n\n" +
+			r.NewStyle().Foreground(t.Secondary).Render(i18n.T("No history data"))
 		return panelStyle.Render(content)
 	}
 
@@ -1516,7 +1520,7 @@ func (h *HistoryModel) renderTimelinePanel(width, height int) string {
 
 	if len(entries) == 0 {
 		b.WriteString("\n")
-		b.WriteString(r.NewStyle().Foreground(t.Secondary).Render("No events recorded"))
+		b.WriteString(r.NewStyle().Foreground(t.Secondary).Render(i18n.T("No events recorded")))
 	} else {
 		// Render timeline entries
 		maxVisible := height - 6 // Account for title, borders, summary
@@ -1735,7 +1739,7 @@ func (h *HistoryModel) renderCompactTimeline(hist correlation.BeadHistory, maxWi
 	}
 
 	if len(markers) == 0 {
-		return r.NewStyle().Foreground(t.Subtext).Render("(no timeline data)")
+		return r.NewStyle().Foreground(t.Subtext).Render(i18n.T("(no timeline data)"))
 	}
 
 	// Build the timeline string
@@ -1830,7 +1834,7 @@ func (h *HistoryModel) renderHeader() string {
 	}
 
 	modeIndicator := fmt.Sprintf("%s %s", modeIcon, modeLabel)
-	title := titleStyle.Render("HISTORY") + modeStyle.Render(modeIndicator)
+	title := titleStyle.Render(i18n.T("HISTORY")) + modeStyle.Render(modeIndicator)
 
 	// Search input or close hint (bv-nkrj)
 	var rightContent string
@@ -1851,7 +1855,7 @@ func (h *HistoryModel) renderHeader() string {
 		escHint := t.Renderer.NewStyle().
 			Foreground(t.Muted).
 			Padding(0, 1).
-			Render("[Esc] cancel")
+			Render(i18n.T("[Esc] cancel"))
 
 		rightContent = searchBox + escHint
 	} else {
@@ -1859,7 +1863,7 @@ func (h *HistoryModel) renderHeader() string {
 		rightContent = t.Renderer.NewStyle().
 			Foreground(t.Muted).
 			Padding(0, 1).
-			Render("[/] search  [H] close")
+			Render(i18n.T("[/] search  [H] close"))
 	}
 
 	// Combine title line with spacing
@@ -2034,7 +2038,7 @@ func (h *HistoryModel) renderListPanel(width, height int) string {
 		Bold(true).
 		Foreground(t.Primary).
 		Width(width - 4)
-	header := headerStyle.Render("BEADS WITH HISTORY")
+	header := headerStyle.Render(i18n.T("BEADS WITH HISTORY"))
 
 	// Build list content
 	var lines []string
@@ -2102,14 +2106,12 @@ func (h *HistoryModel) renderBeadLine(idx int, hist correlation.BeadHistory, wid
 	}
 
 	// Truncate title
-	maxTitleLen := width - len(indicator) - len(statusIcon) - len(commitCount) - eventBadgeWidth - 6
+	maxTitleLen := width - lipgloss.Width(indicator) - lipgloss.Width(statusIcon) - len(commitCount) - eventBadgeWidth - 6
 	if maxTitleLen < 10 {
 		maxTitleLen = 10
 	}
 	title := hist.Title
-	if len(title) > maxTitleLen {
-		title = title[:maxTitleLen-1] + "…"
-	}
+	title = truncate(title, maxTitleLen)
 
 	// Build line
 	idStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Width(12)
@@ -2244,9 +2246,7 @@ func (h *HistoryModel) renderFileTreeLine(idx int, node *FileTreeNode, width int
 	}
 
 	name := node.Name
-	if len(name) > maxNameLen {
-		name = name[:maxNameLen-1] + "…"
-	}
+	name = truncate(name, maxNameLen)
 
 	// Styling
 	nameStyle := t.Renderer.NewStyle()
@@ -2294,14 +2294,14 @@ func (h *HistoryModel) renderDetailPanel(width, height int) string {
 
 	hist := h.SelectedHistory()
 	if hist == nil {
-		return panelStyle.Render("No bead selected")
+		return panelStyle.Render(i18n.T("No bead selected"))
 	}
 
 	// Header
 	headerStyle := t.Renderer.NewStyle().
 		Bold(true).
 		Foreground(t.Primary)
-	header := headerStyle.Render("COMMIT DETAILS")
+	header := headerStyle.Render(i18n.T("COMMIT DETAILS"))
 
 	// Bead info with status indicator
 	statusIcon := "○"
@@ -2312,10 +2312,10 @@ func (h *HistoryModel) renderDetailPanel(width, height int) string {
 		statusIcon = "●"
 	}
 	beadInfo := fmt.Sprintf("%s %s: %s", statusIcon, hist.BeadID, hist.Title)
-	if width > 10 && len(beadInfo) > width-6 {
-		beadInfo = beadInfo[:width-7] + "…"
-	} else if width <= 10 && len(beadInfo) > 5 {
-		beadInfo = beadInfo[:4] + "…"
+	if width > 10 {
+		beadInfo = truncate(beadInfo, width-6)
+	} else {
+		beadInfo = truncate(beadInfo, 5)
 	}
 	beadInfoStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
 
@@ -2410,7 +2410,7 @@ func (h *HistoryModel) renderDetailPanel(width, height int) string {
 
 	// Navigation hint (bv-xf4p: added o and g keys)
 	hintStyle := t.Renderer.NewStyle().Foreground(t.Muted).Italic(true)
-	lines = append(lines, hintStyle.Render("J/K:nav  y:copy  o:open  g:graph"))
+	lines = append(lines, hintStyle.Render(i18n.T("J/K:nav  y:copy  o:open  g:graph")))
 
 	content := strings.Join(lines, "\n")
 	return panelStyle.Render(content)
@@ -2475,9 +2475,7 @@ func (h *HistoryModel) renderCommitDetail(commit correlation.CorrelatedCommit, w
 			maxAuthor = 10
 		}
 		authorName := commit.Author
-		if len(authorName) > maxAuthor {
-			authorName = authorName[:maxAuthor-1] + "…"
-		}
+		authorName = truncate(authorName, maxAuthor)
 		authorLine = fmt.Sprintf("    %s %s • %s",
 			initialsStyle.Render(initials),
 			authorStyle.Render(authorName),
@@ -2652,34 +2650,17 @@ func authorInitials(name string) string {
 
 // relativeTime formats a time as a relative string (e.g., "2d ago", "3h ago")
 func relativeTime(t time.Time) string {
-	now := time.Now()
-	diff := now.Sub(t)
-
-	if diff < 0 {
-		return "in future"
-	}
-
+	diff := time.Since(t)
 	switch {
+	case diff < 0:
+		return i18n.T("in future")
 	case diff < time.Minute:
-		return "just now"
-	case diff < time.Hour:
-		mins := int(diff.Minutes())
-		return fmt.Sprintf("%dm ago", mins)
-	case diff < 24*time.Hour:
-		hours := int(diff.Hours())
-		return fmt.Sprintf("%dh ago", hours)
-	case diff < 7*24*time.Hour:
-		days := int(diff.Hours() / 24)
-		return fmt.Sprintf("%dd ago", days)
-	case diff < 30*24*time.Hour:
-		weeks := int(diff.Hours() / 24 / 7)
-		return fmt.Sprintf("%dw ago", weeks)
-	case diff < 365*24*time.Hour:
-		months := int(diff.Hours() / 24 / 30)
-		return fmt.Sprintf("%dmo ago", months)
-	default:
+		return i18n.T("just now")
+	case diff >= 365*24*time.Hour:
 		years := int(diff.Hours() / 24 / 365)
-		return fmt.Sprintf("%dy ago", years)
+		return i18n.Nf("%dy ago", years)
+	default:
+		return i18n.RelativeTime(diff)
 	}
 }
 
@@ -3032,7 +3013,7 @@ func (h *HistoryModel) renderGitCommitListPanel(width, height int) string {
 		Bold(true).
 		Foreground(t.Primary).
 		Width(width - 4)
-	header := headerStyle.Render("COMMITS")
+	header := headerStyle.Render(i18n.T("COMMITS"))
 
 	// Build list content
 	var lines []string
@@ -3086,9 +3067,7 @@ func (h *HistoryModel) renderGitCommitLine(idx int, commit CommitListEntry, widt
 		maxMsgLen = 10
 	}
 	msg := commit.Message
-	if len(msg) > maxMsgLen {
-		msg = msg[:maxMsgLen-1] + "…"
-	}
+	msg = truncate(msg, maxMsgLen)
 
 	// Build line
 	shaStyle := t.Renderer.NewStyle().Foreground(t.Primary)
@@ -3128,7 +3107,7 @@ func (h *HistoryModel) renderGitDetailPanel(width, height int) string {
 
 	commit := h.SelectedGitCommit()
 	if commit == nil {
-		return panelStyle.Render("No commit selected")
+		return panelStyle.Render(i18n.T("No commit selected"))
 	}
 
 	var lines []string
@@ -3137,7 +3116,7 @@ func (h *HistoryModel) renderGitDetailPanel(width, height int) string {
 	headerStyle := t.Renderer.NewStyle().
 		Bold(true).
 		Foreground(t.Primary)
-	lines = append(lines, headerStyle.Render("RELATED BEADS"))
+	lines = append(lines, headerStyle.Render(i18n.T("RELATED BEADS")))
 
 	detailSepWidth := width - 4
 	if detailSepWidth < 1 {
@@ -3180,9 +3159,7 @@ func (h *HistoryModel) renderGitDetailPanel(width, height int) string {
 		if maxLen < 10 {
 			maxLen = 10
 		}
-		if len(title) > maxLen {
-			title = title[:maxLen-1] + "…"
-		}
+		title = truncate(title, maxLen)
 
 		beadLine := fmt.Sprintf("%s%s %s %s", indicator, statusIcon, beadID, beadStyle.Render(title))
 		lines = append(lines, beadLine)
@@ -3191,19 +3168,19 @@ func (h *HistoryModel) renderGitDetailPanel(width, height int) string {
 	// Add separator before commit details
 	lines = append(lines, "")
 	lines = append(lines, strings.Repeat("─", detailSepWidth))
-	lines = append(lines, headerStyle.Render("COMMIT DETAILS"))
+	lines = append(lines, headerStyle.Render(i18n.T("COMMIT DETAILS")))
 	lines = append(lines, strings.Repeat("─", detailSepWidth))
 
 	// Commit details
 	shaLine := fmt.Sprintf("SHA: %s", commit.SHA)
-	if width > 10 && len(shaLine) > width-6 {
-		shaLine = shaLine[:width-7] + "…"
+	if width > 10 {
+		shaLine = truncate(shaLine, width-6)
 	}
 	lines = append(lines, t.Renderer.NewStyle().Foreground(t.Primary).Render(shaLine))
 
 	authorLine := fmt.Sprintf("Author: %s", commit.Author)
-	if width > 10 && len(authorLine) > width-6 {
-		authorLine = authorLine[:width-7] + "…"
+	if width > 10 {
+		authorLine = truncate(authorLine, width-6)
 	}
 	lines = append(lines, t.Renderer.NewStyle().Foreground(t.Secondary).Render(authorLine))
 
@@ -3218,8 +3195,8 @@ func (h *HistoryModel) renderGitDetailPanel(width, height int) string {
 	msgStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
 	msgLines := strings.Split(commit.Message, "\n")
 	for _, ml := range msgLines {
-		if width > 6 && len(ml) > width-6 {
-			ml = ml[:width-7] + "…"
+		if width > 6 {
+			ml = truncate(ml, width-6)
 		}
 		lines = append(lines, msgStyle.Render(ml))
 	}
@@ -3244,7 +3221,7 @@ func (h *HistoryModel) renderGitDetailPanel(width, height int) string {
 	// Add footer hint (bv-xf4p)
 	lines = append(lines, strings.Repeat("─", detailSepWidth))
 	hintStyle := t.Renderer.NewStyle().Foreground(t.Muted).Italic(true)
-	lines = append(lines, hintStyle.Render("J/K:bead  y:copy  o:open  g:graph"))
+	lines = append(lines, hintStyle.Render(i18n.T("J/K:bead  y:copy  o:open  g:graph")))
 
 	content := strings.Join(lines, "\n")
 	return panelStyle.Render(content)
@@ -3267,12 +3244,12 @@ func (h *HistoryModel) renderCommitMiddlePanel(width, height int) string {
 
 	hist := h.SelectedHistory()
 	if hist == nil {
-		return panelStyle.Render("Select a bead to view commits")
+		return panelStyle.Render(i18n.T("Select a bead to view commits"))
 	}
 
 	var lines []string
 	headerStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary).Width(width - 4)
-	lines = append(lines, headerStyle.Render("COMMITS"))
+	lines = append(lines, headerStyle.Render(i18n.T("COMMITS")))
 
 	sepWidth := width - 4
 	if sepWidth < 1 {
@@ -3315,9 +3292,7 @@ func (h *HistoryModel) renderCommitMiddlePanel(width, height int) string {
 			maxMsgLen = 10
 		}
 		msg := commit.Message
-		if len(msg) > maxMsgLen {
-			msg = msg[:maxMsgLen-1] + "…"
-		}
+		msg = truncate(msg, maxMsgLen)
 
 		line := fmt.Sprintf("%s%s %s", indicator, shaStyle.Render(commit.ShortSHA), msg)
 		lines = append(lines, line)
@@ -3359,12 +3334,12 @@ func (h *HistoryModel) renderGitBeadListPanel(width, height int) string {
 
 	commit := h.SelectedGitCommit()
 	if commit == nil {
-		return panelStyle.Render("Select a commit to view beads")
+		return panelStyle.Render(i18n.T("Select a commit to view beads"))
 	}
 
 	var lines []string
 	headerStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary).Width(width - 4)
-	lines = append(lines, headerStyle.Render("RELATED BEADS"))
+	lines = append(lines, headerStyle.Render(i18n.T("RELATED BEADS")))
 
 	sepWidth := width - 4
 	if sepWidth < 1 {
@@ -3421,9 +3396,7 @@ func (h *HistoryModel) renderGitBeadListPanel(width, height int) string {
 		if maxLen < 10 {
 			maxLen = 10
 		}
-		if len(title) > maxLen {
-			title = title[:maxLen-1] + "…"
-		}
+		title = truncate(title, maxLen)
 
 		beadLine := fmt.Sprintf("%s%s %s", indicator, statusIcon, beadStyle.Render(title))
 		lines = append(lines, beadLine)
@@ -0,0 +1,119 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/hooks"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Hook trust
+//
+// Hooks come with the repository, so opening someone else's project would
+// run their commands as you. With a trust store (EnableHookTrust), the
+// hooks of .bv/hooks.yaml only run once you have seen and trusted them:
+// the first time the TUI meets a hooks file, or meets it changed, it shows
+// what the file would run and asks. Declining keeps its hooks off for the
+// session. ui.hook_sandbox confines the trusted ones (see SetHookSandbox).
+
+// EnableHookTrust only runs project hooks that trust holds, and asks about
+// the project's hooks file if it does not hold it yet.
+func (m *Model) EnableHookTrust(trust *hooks.Trust) {
+	m.hookTrust = trust
+	if m.skipHooks || m.readOnly || m.workDir == "" {
+		return
+	}
+	loader := m.hookLoader()
+	var untrusted *hooks.UntrustedError
+	if errors.As(loader.Load(), &untrusted) {
+		m.showHookTrust = true
+		m.hookTrustLoader = loader
+	}
+}
+
+// SetHookSandbox confines project hooks by mode (ui.hook_sandbox).
+func (m *Model) SetHookSandbox(mode hooks.SandboxMode) {
+	m.hookSandbox = mode
+}
+
+// hookLoader returns the loader of the project's hooks, subject to trust
+// and the sandbox.
+func (m Model) hookLoader() *hooks.Loader {
+	opts := []hooks.LoaderOption{hooks.WithProjectDir(m.workDir), hooks.WithSandbox(m.hookSandbox)}
+	if m.hookTrust != nil {
+		opts = append(opts, hooks.WithTrust(m.hookTrust))
+	}
+	return hooks.NewLoader(opts...)
+}
+
+// handleHookTrustKeys trusts the hooks file on y and leaves it untrusted
+// on n or esc.
+func (m Model) handleHookTrustKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch msg.String() {
+	case "y", "Y":
+		m.showHookTrust = false
+		if err := m.hookTrustLoader.TrustFile(); err != nil {
+			m.statusMsg = i18n.Tf("Trusting hooks: %v", err)
+			m.statusIsError = true
+		} else {
+			m.statusMsg = i18n.T("Trusted the project's hooks")
+			m.statusIsError = false
+		}
+		m.hookTrustLoader = nil
+	case "n", "N", "esc", "q":
+		m.showHookTrust = false
+		m.hookTrustLoader = nil
+		m.statusMsg = i18n.T("The project's hooks will not run (restart bv to be asked again)")
+		m.statusIsError = false
+	}
+	return m, nil
+}
+
+func (m Model) renderHookTrust() string {
+	t := m.theme
+	var untrusted *hooks.UntrustedError
+	if m.hookTrustLoader == nil || !errors.As(m.hookTrustLoader.Load(), &untrusted) {
+		return ""
+	}
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Feature).
+		Padding(1, 3)
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Feature).Bold(true)
+	headerStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Bold(true)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	textStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+
+	width := max(min(m.width-12, 90), 40)
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(i18n.T("Trust the hooks of this project?")) + "\n\n")
+	sb.WriteString(mutedStyle.Render(truncateRunesHelper(untrusted.Path, width, "…")) + "\n")
+	sandbox := "as you, with your environment"
+	switch m.hookSandbox {
+	case hooks.SandboxEnv:
+		sandbox = "as you, without your environment variables"
+	case hooks.SandboxStrict:
+		sandbox = "in a sandbox without network or your home directory"
+	}
+	sb.WriteString(mutedStyle.Render("Its shell commands would run "+sandbox+":") + "\n")
+
+	lines := max(m.height-18, 4)
+	for _, phase := range hooks.Phases {
+		for _, hook := range untrusted.Hooks.ForPhase(phase) {
+			if lines == 0 {
+				break
+			}
+			lines--
+			sb.WriteString("\n" + headerStyle.Render(fmt.Sprintf("%s %s", phase, hook.Name)) + "\n")
+			sb.WriteString(textStyle.Render("  "+truncateRunesHelper(hook.Command, width-2, "…")) + "\n")
+		}
+	}
+	sb.WriteString("\n" + mutedStyle.Render(i18n.T("y: trust until the file changes • n: don't run them this session")))
+
+	return lipgloss.Place(m.width, m.height-1, lipgloss.Center, lipgloss.Center, boxStyle.Render(sb.String()))
+}
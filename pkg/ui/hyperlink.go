@@ -0,0 +1,117 @@
+package ui
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// OSC 8 hyperlinks: supporting terminals make the wrapped text clickable,
+// others are expected to ignore the sequence, but a few older emulators print
+// it verbatim, so links are only emitted when support is detected or the user
+// opts in via config.
+
+const (
+	osc8Open  = "\x1b]8;;"
+	osc8Close = "\x1b\\"
+)
+
+// hyperlink wraps text in an OSC 8 sequence pointing at url.
+func hyperlink(url, text string) string {
+	return osc8Open + url + osc8Close + text + osc8Open + osc8Close
+}
+
+// hyperlinksSupported guesses whether the terminal renders OSC 8 hyperlinks,
+// based on the environment.
+func hyperlinksSupported(getenv func(string) string) bool {
+	term := getenv("TERM")
+	if term == "dumb" {
+		return false
+	}
+	// tmux and screen only pass OSC 8 through when explicitly configured.
+	if getenv("TMUX") != "" || strings.HasPrefix(term, "screen") {
+		return false
+	}
+	switch getenv("TERM_PROGRAM") {
+	case "iTerm.app", "WezTerm", "vscode", "Hyper", "ghostty", "Tabby", "rio":
+		return true
+	}
+	if getenv("WT_SESSION") != "" || getenv("KITTY_WINDOW_ID") != "" || getenv("KONSOLE_VERSION") != "" {
+		return true
+	}
+	for _, name := range []string{"kitty", "alacritty", "foot", "ghostty", "wezterm"} {
+		if strings.Contains(term, name) {
+			return true
+		}
+	}
+	// GNOME Terminal, Tilix and other VTE terminals since 0.50.
+	if v, err := strconv.Atoi(getenv("VTE_VERSION")); err == nil && v >= 5000 {
+		return true
+	}
+	return false
+}
+
+var (
+	// ansiSeqPattern matches CSI and OSC escape sequences so linkification
+	// only touches visible text.
+	ansiSeqPattern = regexp.MustCompile(`\x1b\[[0-9;?]*[ -/]*[@-~]|\x1b\][^\x07\x1b]*(?:\x07|\x1b\\)`)
+	// linkTokenPattern matches URLs first, then issue-ID-shaped words.
+	linkTokenPattern = regexp.MustCompile(`https?://[^\s<>"'` + "`" + `\x1b]+|[A-Za-z][A-Za-z0-9_]*-[A-Za-z0-9][A-Za-z0-9._-]*`)
+)
+
+// linkify turns URLs and known issue IDs in already-rendered terminal output
+// into OSC 8 hyperlinks. issueURL returns the target for an issue ID, or ""
+// for words that are not (linkable) issues.
+func linkify(rendered string, issueURL func(id string) string) string {
+	var sb strings.Builder
+	last := 0
+	for _, loc := range ansiSeqPattern.FindAllStringIndex(rendered, -1) {
+		sb.WriteString(linkifyText(rendered[last:loc[0]], issueURL))
+		sb.WriteString(rendered[loc[0]:loc[1]])
+		last = loc[1]
+	}
+	sb.WriteString(linkifyText(rendered[last:], issueURL))
+	return sb.String()
+}
+
+func linkifyText(text string, issueURL func(id string) string) string {
+	return linkTokenPattern.ReplaceAllStringFunc(text, func(tok string) string {
+		if strings.HasPrefix(tok, "http://") || strings.HasPrefix(tok, "https://") {
+			url := strings.TrimRight(tok, ".,;:!?)]")
+			return hyperlink(url, url) + tok[len(url):]
+		}
+		// IDs may contain dots (bv-abc.1), so only strip trailing punctuation.
+		id := strings.TrimRight(tok, ".-")
+		if url := issueURL(id); url != "" {
+			return hyperlink(url, id) + tok[len(id):]
+		}
+		return tok
+	})
+}
+
+// issueLinkTarget resolves the hyperlink target for an issue: the configured
+// URL template with {id} substituted, else the issue's external_ref when it
+// is a web URL.
+func (m *Model) issueLinkTarget(id string) string {
+	issue, ok := m.issueMap[id]
+	if !ok {
+		return ""
+	}
+	if m.issueURLTemplate != "" {
+		return strings.ReplaceAll(m.issueURLTemplate, "{id}", id)
+	}
+	if issue.ExternalRef != nil {
+		ref := *issue.ExternalRef
+		if strings.HasPrefix(ref, "http://") || strings.HasPrefix(ref, "https://") {
+			return ref
+		}
+	}
+	return ""
+}
+
+// EnableHyperlinks turns on OSC 8 links in the detail pane. urlTemplate, if
+// non-empty, is used for issue IDs, e.g. "https://tracker.example/{id}".
+func (m *Model) EnableHyperlinks(urlTemplate string) {
+	m.hyperlinks = true
+	m.issueURLTemplate = urlTemplate
+}
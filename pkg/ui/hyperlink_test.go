@@ -0,0 +1,112 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func envFrom(vars map[string]string) func(string) string {
+	return func(k string) string { return vars[k] }
+}
+
+func TestHyperlinksSupported(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want bool
+	}{
+		{"empty", map[string]string{}, false},
+		{"dumb", map[string]string{"TERM": "dumb", "TERM_PROGRAM": "iTerm.app"}, false},
+		{"iterm", map[string]string{"TERM_PROGRAM": "iTerm.app"}, true},
+		{"tmux", map[string]string{"TMUX": "/tmp/tmux", "TERM_PROGRAM": "iTerm.app"}, false},
+		{"kitty term", map[string]string{"TERM": "xterm-kitty"}, true},
+		{"windows terminal", map[string]string{"WT_SESSION": "abc"}, true},
+		{"new vte", map[string]string{"VTE_VERSION": "6003"}, true},
+		{"old vte", map[string]string{"VTE_VERSION": "4601"}, false},
+	}
+	for _, tt := range tests {
+		if got := hyperlinksSupported(envFrom(tt.env)); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestLinkify(t *testing.T) {
+	known := map[string]string{"bv-1": "https://t.example/bv-1", "bv-2.1": "https://t.example/bv-2.1"}
+	resolve := func(id string) string { return known[id] }
+
+	got := linkify("See bv-1, bv-2.1. and https://example.com/x). self-update", resolve)
+
+	for _, want := range []string{
+		hyperlink("https://t.example/bv-1", "bv-1") + ",",
+		hyperlink("https://t.example/bv-2.1", "bv-2.1") + ".",
+		hyperlink("https://example.com/x", "https://example.com/x") + ").",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected %q in %q", want, got)
+		}
+	}
+	if strings.Contains(got, osc8Open+"self") {
+		t.Errorf("unknown words must not be linked: %q", got)
+	}
+}
+
+func TestLinkify_LeavesEscapeSequencesIntact(t *testing.T) {
+	resolve := func(id string) string {
+		if id == "bv-1" {
+			return "u"
+		}
+		return ""
+	}
+	in := "\x1b[38;5;252mbv-1\x1b[0m"
+	want := "\x1b[38;5;252m" + hyperlink("u", "bv-1") + "\x1b[0m"
+	if got := linkify(in, resolve); got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestIssueLinkTarget(t *testing.T) {
+	ref := "https://github.com/o/r/issues/7"
+	issues := []model.Issue{
+		{ID: "A-1", Title: "a", Status: model.StatusOpen, ExternalRef: &ref},
+		{ID: "A-2", Title: "b", Status: model.StatusOpen},
+	}
+	m := NewModel(issues, nil, "")
+
+	if got := m.issueLinkTarget("A-1"); got != ref {
+		t.Fatalf("expected external_ref link, got %q", got)
+	}
+	if got := m.issueLinkTarget("A-2"); got != "" {
+		t.Fatalf("expected no link without template or ref, got %q", got)
+	}
+
+	m.EnableHyperlinks("https://tracker.example/{id}")
+	if got := m.issueLinkTarget("A-2"); got != "https://tracker.example/A-2" {
+		t.Fatalf("expected templated link, got %q", got)
+	}
+	if got := m.issueLinkTarget("NOPE-1"); got != "" {
+		t.Fatalf("unknown IDs must not link, got %q", got)
+	}
+}
+
+func TestDetailPaneHyperlinks(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A-1", Title: "Alpha", Status: model.StatusOpen, Description: "Docs at https://example.com/spec"},
+	}
+	m := NewModel(issues, nil, "")
+	m.EnableHyperlinks("https://tracker.example/{id}")
+	newM, _ := m.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	m = newM.(Model)
+	m.updateViewportContent()
+
+	content := m.viewport.View()
+	if !strings.Contains(content, osc8Open+"https://tracker.example/A-1"+osc8Close) {
+		t.Error("expected issue ID hyperlink in detail pane")
+	}
+	if !strings.Contains(content, osc8Open+"https://example.com/spec"+osc8Close) {
+		t.Error("expected description URL hyperlink in detail pane")
+	}
+}
@@ -0,0 +1,94 @@
+package ui
+
+import (
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// IdleLock blanks the screen after a period without keyboard or mouse input,
+// so issue content isn't left readable on an unattended terminal. Any key
+// resumes. bv never stores issue data encrypted, so there is no passphrase
+// to ask for; the lock is a privacy screen, not access control.
+type IdleLock struct {
+	timeout      time.Duration
+	lastActivity time.Time
+	locked       bool
+}
+
+// NewIdleLock returns a lock that engages after timeout of inactivity.
+// A non-positive timeout returns nil (disabled); all methods are nil-safe.
+func NewIdleLock(timeout time.Duration, now time.Time) *IdleLock {
+	if timeout <= 0 {
+		return nil
+	}
+	return &IdleLock{timeout: timeout, lastActivity: now}
+}
+
+// idleLockTickMsg asks the model to re-check the idle deadline.
+type idleLockTickMsg struct{}
+
+// Locked reports whether the privacy screen is currently shown.
+func (l *IdleLock) Locked() bool {
+	return l != nil && l.locked
+}
+
+// Touch records user activity.
+func (l *IdleLock) Touch(now time.Time) {
+	if l != nil {
+		l.lastActivity = now
+	}
+}
+
+// Unlock dismisses the privacy screen and restarts the idle countdown.
+func (l *IdleLock) Unlock(now time.Time) tea.Cmd {
+	if l == nil {
+		return nil
+	}
+	l.locked = false
+	l.lastActivity = now
+	return l.tickCmd(now)
+}
+
+// Check locks if the deadline has passed. Otherwise it returns a command
+// that re-checks at the new deadline. Only one tick is ever outstanding:
+// none is scheduled while locked, and Unlock starts a fresh one.
+func (l *IdleLock) Check(now time.Time) tea.Cmd {
+	if l == nil || l.locked {
+		return nil
+	}
+	if now.Sub(l.lastActivity) >= l.timeout {
+		l.locked = true
+		return nil
+	}
+	return l.tickCmd(now)
+}
+
+func (l *IdleLock) tickCmd(now time.Time) tea.Cmd {
+	if l == nil {
+		return nil
+	}
+	wait := l.lastActivity.Add(l.timeout).Sub(now)
+	if wait < time.Second {
+		wait = time.Second
+	}
+	return tea.Tick(wait, func(time.Time) tea.Msg {
+		return idleLockTickMsg{}
+	})
+}
+
+// EnableIdleLock blanks the screen after timeout of inactivity.
+// Must be called before the program starts so Init schedules the first check.
+func (m *Model) EnableIdleLock(timeout time.Duration) {
+	m.idleLock = NewIdleLock(timeout, time.Now())
+}
+
+// renderIdleLock renders the privacy screen. It deliberately shows nothing
+// from the project (no title, path, or counts).
+func (m Model) renderIdleLock() string {
+	t := m.theme
+	msg := t.Renderer.NewStyle().Foreground(t.Subtext).Render(i18n.T("🔒 Locked — press any key to resume"))
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, msg)
+}
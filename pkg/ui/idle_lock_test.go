@@ -0,0 +1,78 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestNewIdleLock_DisabledWhenNonPositive(t *testing.T) {
+	if l := NewIdleLock(0, time.Now()); l != nil {
+		t.Fatal("expected nil lock for zero timeout")
+	}
+	var l *IdleLock
+	if l.Locked() || l.Check(time.Now()) != nil || l.Unlock(time.Now()) != nil {
+		t.Fatal("nil lock should be inert")
+	}
+}
+
+func TestIdleLock_CheckLocksAfterTimeout(t *testing.T) {
+	start := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	l := NewIdleLock(5*time.Minute, start)
+
+	if cmd := l.Check(start.Add(4 * time.Minute)); cmd == nil || l.Locked() {
+		t.Fatal("expected re-check command before deadline")
+	}
+
+	l.Touch(start.Add(4 * time.Minute))
+	if l.Check(start.Add(6 * time.Minute)); l.Locked() {
+		t.Fatal("activity should push the deadline out")
+	}
+
+	if cmd := l.Check(start.Add(9 * time.Minute)); cmd != nil || !l.Locked() {
+		t.Fatal("expected lock with no further ticks at deadline")
+	}
+
+	if cmd := l.Unlock(start.Add(10 * time.Minute)); cmd == nil || l.Locked() {
+		t.Fatal("expected unlock to restart the countdown")
+	}
+}
+
+func TestIdleLock_ModelHidesContentAndSwallowsFirstKey(t *testing.T) {
+	issues := []model.Issue{{ID: "SECRET-1", Title: "Confidential roadmap", Status: model.StatusOpen}}
+	m := NewModel(issues, nil, "")
+	newM, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m = newM.(Model)
+
+	m.EnableIdleLock(time.Minute)
+	m.idleLock.lastActivity = time.Now().Add(-2 * time.Minute)
+	newM, _ = m.Update(idleLockTickMsg{})
+	m = newM.(Model)
+
+	if m.CurrentContext() != ContextIdleLock {
+		t.Fatalf("expected idle-lock context, got %s", m.CurrentContext())
+	}
+	view := m.View()
+	if strings.Contains(view, "SECRET-1") || strings.Contains(view, "Confidential") {
+		t.Fatal("locked view must not show issue content")
+	}
+
+	// The unlocking key must not also act (e.g. q must not quit).
+	newM, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	m = newM.(Model)
+	if m.idleLock.Locked() {
+		t.Fatal("expected keypress to unlock")
+	}
+	if m.showQuitConfirm || m.showSessionReview {
+		t.Fatal("unlock key should be swallowed")
+	}
+	if cmd == nil {
+		t.Fatal("expected unlock to schedule the next idle check")
+	}
+	if !strings.Contains(m.View(), "SECRET-1") {
+		t.Fatal("expected content to be visible again after unlock")
+	}
+}
@@ -0,0 +1,196 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Dependency impact
+//
+// The impact view answers two questions about an issue: what closing it
+// unblocks, and what stands between it and being ready. The first is the
+// cascade of issues that become ready when it closes, then when those
+// close in turn; the second is its chain of open blockers down to the ones
+// that can start now. Both come from the analyzer. "i" in the action menu
+// and ":impact" open it.
+
+// impactRow is a line of the impact view that enter can jump to.
+type impactRow struct {
+	id    string
+	depth int
+	note  string
+}
+
+// impactRows returns the issues closing id frees and the chain of blockers
+// of id, excluding id itself, and whether the chain has a cycle. A closed
+// issue has neither.
+func (m Model) impactRows(id string) (freed, blockers []impactRow, cycle bool) {
+	if issue, ok := m.issueMap[id]; !ok || isClosedLikeStatus(issue.Status) {
+		return nil, nil, false
+	}
+	for _, e := range m.analyzer.UnblockCascade(id) {
+		note := "ready"
+		if e.Depth > 1 {
+			note = "after " + e.Via
+		}
+		freed = append(freed, impactRow{id: e.ID, depth: e.Depth, note: note})
+	}
+	chain := m.analyzer.GetBlockerChain(id)
+	if chain == nil {
+		return freed, nil, false
+	}
+	for _, e := range chain.Chain[1:] {
+		note := e.Status
+		if e.Actionable {
+			note = "can start"
+		}
+		blockers = append(blockers, impactRow{id: e.ID, depth: e.Depth, note: note})
+	}
+	return freed, blockers, chain.HasCycle
+}
+
+// impactCommand opens the impact view for the issue args name, or the
+// selection.
+func (m Model) impactCommand(args []string) (Model, tea.Cmd, error) {
+	var id string
+	if len(args) == 1 {
+		if _, ok := m.issueMap[args[0]]; !ok {
+			return m, nil, fmt.Errorf("unknown issue %q", args[0])
+		}
+		id = args[0]
+	} else if target := m.actionTarget(); target != nil {
+		id = target.ID
+	} else {
+		return m, nil, fmt.Errorf("no issue selected")
+	}
+	if err := m.openImpact(id); err != nil {
+		return m, nil, err
+	}
+	return m, nil, nil
+}
+
+func (m *Model) openImpact(id string) error {
+	if m.analyzer == nil {
+		return errors.New("the dependency graph is still being analyzed")
+	}
+	m.showImpact = true
+	m.impactID = id
+	m.impactCursor = 0
+	return nil
+}
+
+// handleImpactKeys moves through the listed issues; enter jumps to one.
+func (m Model) handleImpactKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	freed, blockers, _ := m.impactRows(m.impactID)
+	rows := append(freed, blockers...)
+	switch msg.String() {
+	case "esc", "q":
+		m.showImpact = false
+	case "j", "down":
+		if m.impactCursor < len(rows)-1 {
+			m.impactCursor++
+		}
+	case "k", "up":
+		if m.impactCursor > 0 {
+			m.impactCursor--
+		}
+	case "enter":
+		if m.impactCursor < len(rows) {
+			m.showImpact = false
+			return m.gotoIssue(rows[m.impactCursor].id), nil
+		}
+	}
+	return m, nil
+}
+
+func (m Model) renderImpact() string {
+	t := m.theme
+	issue, ok := m.issueMap[m.impactID]
+	if !ok {
+		return ""
+	}
+	freed, blockers, cycle := m.impactRows(m.impactID)
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 3)
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	headerStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Bold(true)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	textStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+	selectedStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	readyStyle := t.Renderer.NewStyle().Foreground(t.Open)
+
+	width := max(min(m.width-12, 90), 40)
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(truncateRunesHelper("Impact of "+issue.ID+": "+issue.Title, width, "…")) + "\n\n")
+
+	// Keep the cursor visible in a window of rows that fits the screen.
+	visible := max(m.height-16, 4)
+	start := 0
+	if m.impactCursor >= visible {
+		start = m.impactCursor - visible + 1
+	}
+	row := func(i int, r impactRow) {
+		if i < start || i >= start+visible {
+			return
+		}
+		indent := strings.Repeat("  ", r.depth-1)
+		text := truncateRunesHelper(indent+r.id+" "+m.issueMap[r.id].Title, width-14, "…")
+		line := fmt.Sprintf("%-*s %12s", width-14, text, r.note)
+		switch {
+		case i == m.impactCursor:
+			sb.WriteString(selectedStyle.Render("▸ "+line) + "\n")
+		case r.note == "ready" || r.note == "can start":
+			sb.WriteString("  " + readyStyle.Render(line) + "\n")
+		default:
+			sb.WriteString("  " + textStyle.Render(line) + "\n")
+		}
+	}
+
+	if isClosedLikeStatus(issue.Status) {
+		sb.WriteString(headerStyle.Render(i18n.T("Already closed")) + "\n")
+	} else {
+		direct := 0
+		for _, r := range freed {
+			if r.depth == 1 {
+				direct++
+			}
+		}
+		sb.WriteString(headerStyle.Render(fmt.Sprintf("Closing it makes %d ready, %d in all as those close", direct, len(freed))) + "\n")
+		if len(freed) == 0 {
+			sb.WriteString(mutedStyle.Render("  "+i18n.T("Nothing waits on it alone")) + "\n")
+		}
+		for i, r := range freed {
+			row(i, r)
+		}
+	}
+	sb.WriteString("\n")
+
+	if len(blockers) == 0 {
+		sb.WriteString(headerStyle.Render(i18n.T("Nothing blocks it")) + "\n")
+	} else {
+		roots := 0
+		for _, r := range blockers {
+			if r.note == "can start" {
+				roots++
+			}
+		}
+		sb.WriteString(headerStyle.Render(fmt.Sprintf("Blocked by %d open issues; %d can start now", len(blockers), roots)) + "\n")
+		for i, r := range blockers {
+			row(len(freed)+i, r)
+		}
+		if cycle {
+			sb.WriteString(t.Renderer.NewStyle().Foreground(t.Blocked).Render("  "+i18n.T("⚠ The blockers form a cycle, which must be broken first")) + "\n")
+		}
+	}
+	sb.WriteString("\n" + mutedStyle.Render(i18n.T("j/k: navigate • enter: go to issue • esc: close")))
+
+	return lipgloss.Place(m.width, m.height-1, lipgloss.Center, lipgloss.Center, boxStyle.Render(sb.String()))
+}
@@ -0,0 +1,57 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestImpact_UnblocksAndBlockers(t *testing.T) {
+	blockedBy := func(id string) []*model.Dependency {
+		return []*model.Dependency{{DependsOnID: id, Type: model.DepBlocks}}
+	}
+	issues := []model.Issue{
+		{ID: "I-1", Title: "Schema", Status: model.StatusOpen},
+		{ID: "I-2", Title: "API", Status: model.StatusOpen, Dependencies: blockedBy("I-1")},
+		{ID: "I-3", Title: "Client", Status: model.StatusOpen, Dependencies: blockedBy("I-2")},
+		{ID: "I-4", Title: "Docs", Status: model.StatusOpen, Dependencies: blockedBy("I-3")},
+	}
+	m := NewModel(issues, nil, "")
+	newM, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m = newM.(Model)
+
+	m = typeCommand(m, "impact I-2")
+	if m.CurrentContext() != ContextImpact {
+		t.Fatalf("expected the impact view, got %s: %q", m.CurrentContext(), m.statusMsg)
+	}
+	view := m.View()
+	for _, want := range []string{
+		"Impact of I-2: API",
+		"Closing it makes 1 ready, 2 in all as those close",
+		"after I-3",
+		"Blocked by 1 open issues; 1 can start now",
+		"can start",
+	} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected %q in the impact view:\n%s", want, view)
+		}
+	}
+
+	// The rows run through the freed issues, then the blockers.
+	m = pressKey(m, runeKey("j"))
+	m = pressKey(m, runeKey("j"))
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if m.showImpact {
+		t.Fatal("expected enter to close the impact view")
+	}
+	if item, ok := m.list.SelectedItem().(IssueItem); !ok || item.Issue.ID != "I-1" {
+		t.Errorf("expected enter to go to the blocker I-1, got %v", m.list.SelectedItem())
+	}
+
+	m = typeCommand(m, "impact I-9")
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "unknown issue") {
+		t.Errorf("expected an unknown issue to fail, got %q", m.statusMsg)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 
 	"github.com/charmbracelet/bubbles/viewport"
@@ -145,9 +146,9 @@ type InsightsModel struct {
 	topPicks []analysis.TopPick
 
 	// Priority radar data (bv-93) - full recommendations with breakdown
-	recommendations    []analysis.Recommendation
-	recommendationMap  map[string]*analysis.Recommendation // ID -> Recommendation for quick lookup
-	triageDataHash     string                              // Hash of data used for triage
+	recommendations   []analysis.Recommendation
+	recommendationMap map[string]*analysis.Recommendation // ID -> Recommendation for quick lookup
+	triageDataHash    string                              // Hash of data used for triage
 
 	// Navigation state
 	focusedPanel  MetricPanel
@@ -196,8 +197,8 @@ func NewInsightsModel(ins analysis.Insights, issueMap map[string]*model.Issue, t
 		insights:         ins,
 		issueMap:         issueMap,
 		theme:            theme,
-		showExplanations: true,  // Visible by default
-		showCalculation:  true,  // Always show calculation details
+		showExplanations: true, // Visible by default
+		showCalculation:  true, // Always show calculation details
 		showDetailPanel:  true,
 		mdRenderer:       mdRenderer,
 		detailVP:         vp,
@@ -214,7 +215,7 @@ func (m *InsightsModel) SetSize(w, h int) {
 		detailWidth := min(60, w/3)
 		m.detailVP.Width = detailWidth - 4 // Account for border/padding
 		m.detailVP.Height = h - 4
-		if m.mdRenderer != nil {
+		if m.mdRenderer != nil && m.mdRenderer.width != detailWidth-6 {
 			m.mdRenderer.SetWidthWithTheme(detailWidth-6, m.theme)
 		}
 	}
@@ -799,7 +800,7 @@ func (m *InsightsModel) renderMetricPanel(panel MetricPanel, width, height int,
 			reason = "Skipped for performance"
 		}
 		lines = append(lines, skipStyle.Render(reason))
-		lines = append(lines, skipStyle.Render("Use --force-full-analysis to compute"))
+		lines = append(lines, skipStyle.Render(i18n.T("Use --force-full-analysis to compute")))
 
 		return panelStyle.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
 	}
@@ -1009,7 +1010,7 @@ func (m *InsightsModel) renderCyclesPanel(width, height int, t Theme) string {
 			reason = "Skipped for performance"
 		}
 		lines = append(lines, skipStyle.Render(reason))
-		lines = append(lines, skipStyle.Render("Use --force-full-analysis to compute"))
+		lines = append(lines, skipStyle.Render(i18n.T("Use --force-full-analysis to compute")))
 
 		return panelStyle.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
 	}
@@ -1018,8 +1019,8 @@ func (m *InsightsModel) renderCyclesPanel(width, height int, t Theme) string {
 		healthyStyle := t.Renderer.NewStyle().
 			Foreground(t.Open).
 			Bold(true)
-		lines = append(lines, healthyStyle.Render("✓ No cycles detected"))
-		lines = append(lines, t.Renderer.NewStyle().Foreground(t.Subtext).Render("Graph is acyclic (DAG)"))
+		lines = append(lines, healthyStyle.Render(i18n.T("✓ No cycles detected")))
+		lines = append(lines, t.Renderer.NewStyle().Foreground(t.Subtext).Render(i18n.T("Graph is acyclic (DAG)")))
 	} else {
 		selectedIdx := m.selectedIndex[PanelCycles]
 		visibleRows := height - 6
@@ -1116,7 +1117,7 @@ func (m *InsightsModel) renderPriorityPanel(width, height int, t Theme) string {
 		emptyStyle := t.Renderer.NewStyle().
 			Foreground(t.Subtext).
 			Italic(true)
-		lines = append(lines, emptyStyle.Render("No priority recommendations available. Run 'bv --robot-triage' to generate."))
+		lines = append(lines, emptyStyle.Render(i18n.T("No priority recommendations available. Run 'bv --robot-triage' to generate.")))
 		return panelStyle.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
 	}
 
@@ -1192,7 +1193,7 @@ func (m *InsightsModel) renderMiniBar(label string, value float64, width int, t
 	}
 
 	prefix := label + ":"
-	prefixLen := len([]rune(prefix))
+	prefixLen := lipgloss.Width(prefix)
 
 	// Bar width = total - prefix
 	barWidth := width - prefixLen
@@ -1230,12 +1231,11 @@ func (m *InsightsModel) renderMiniBar(label string, value float64, width int, t
 	return labelStyle.Render(prefix) + filledStyle.Render(filledBar) + emptyStyle.Render(emptyBar)
 }
 
-
 // renderPriorityItem renders a single priority recommendation item
 func (m *InsightsModel) renderPriorityItem(pick analysis.TopPick, width, height int, isSelected bool, t Theme) string {
 	itemStyle := t.Renderer.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		Width(width - 2).
+		Width(width-2).
 		Height(height).
 		Padding(0, 1)
 
@@ -1369,17 +1369,17 @@ func (m *InsightsModel) renderHeatmapPanel(width, height int, t Theme) string {
 	} else {
 		titleStyle = titleStyle.Foreground(t.Secondary)
 	}
-	sb.WriteString(strings.TrimRight(titleStyle.Render("📊 Priority Heatmap"), "\n\r"))
+	sb.WriteString(strings.TrimRight(titleStyle.Render(i18n.T("📊 Priority Heatmap")), "\n\r"))
 	sb.WriteString("  ")
 	subtitleStyle := t.Renderer.NewStyle().Foreground(t.Subtext).Italic(true)
-	sb.WriteString(strings.TrimRight(subtitleStyle.Render("j/k/h/l=navigate Enter=drill H=toggle"), "\n\r"))
+	sb.WriteString(strings.TrimRight(subtitleStyle.Render(i18n.T("j/k/h/l=navigate Enter=drill H=toggle")), "\n\r"))
 	sb.WriteString("\n")
 
 	if m.insights.Stats == nil || len(m.topPicks) == 0 {
 		emptyStyle := t.Renderer.NewStyle().
 			Foreground(t.Subtext).
 			Italic(true)
-		sb.WriteString(strings.TrimRight(emptyStyle.Render("No data available. Run 'bv --robot-triage' to generate."), "\n\r"))
+		sb.WriteString(strings.TrimRight(emptyStyle.Render(i18n.T("No data available. Run 'bv --robot-triage' to generate.")), "\n\r"))
 		return panelStyle.Render(sb.String())
 	}
 
@@ -1410,7 +1410,7 @@ func (m *InsightsModel) renderHeatmapPanel(width, height int, t Theme) string {
 
 	// Axis title
 	sb.WriteString(strings.TrimRight(t.Renderer.NewStyle().Foreground(t.Subtext).Italic(true).Render(
-		"      ──── Priority Score ────  Low→High"), "\n\r"))
+		"      "+i18n.T("──── Priority Score ────  Low→High")), "\n\r"))
 	sb.WriteString("\n")
 
 	// Render header row (score labels) with "Total" column
@@ -1484,7 +1484,7 @@ func (m *InsightsModel) renderHeatmapPanel(width, height int, t Theme) string {
 		sb.WriteString(selStyle.Render(fmt.Sprintf("Selected: %s × %s (%d issues)",
 			depthLabels[m.heatmapRow], scoreLabels[m.heatmapCol], selCount)))
 		if selCount > 0 {
-			sb.WriteString(t.Renderer.NewStyle().Foreground(t.Subtext).Italic(true).Render(" [Enter to view]"))
+			sb.WriteString(t.Renderer.NewStyle().Foreground(t.Subtext).Italic(true).Render(" " + i18n.T("[Enter to view]")))
 		}
 	}
 
@@ -1528,7 +1528,7 @@ func (m *InsightsModel) renderHeatmapLegend(t Theme) string {
 	var sb strings.Builder
 
 	legendStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
-	sb.WriteString(legendStyle.Render("Heat: "))
+	sb.WriteString(legendStyle.Render(i18n.T("Heat:") + " "))
 
 	// Show gradient samples
 	samples := []struct {
@@ -1576,11 +1576,11 @@ func (m *InsightsModel) renderHeatmapDrillDown(width int, t Theme) string {
 
 	// Navigation hints
 	hintStyle := t.Renderer.NewStyle().Foreground(t.Subtext).Italic(true)
-	sb.WriteString(hintStyle.Render("j/k=navigate Enter=view Esc=back"))
+	sb.WriteString(hintStyle.Render(i18n.T("j/k=navigate Enter=view Esc=back")))
 	sb.WriteString("\n\n")
 
 	if len(m.heatmapIssues) == 0 {
-		sb.WriteString(t.Renderer.NewStyle().Foreground(t.Subtext).Italic(true).Render("No issues in this cell"))
+		sb.WriteString(t.Renderer.NewStyle().Foreground(t.Subtext).Italic(true).Render(i18n.T("No issues in this cell")))
 		return sb.String()
 	}
 
@@ -1704,11 +1704,7 @@ func (m *InsightsModel) renderCycleChain(cycle []string, maxWidth int, t Theme)
 		parts = append(parts, parts[0])
 	}
 
-	chain := strings.Join(parts, " → ")
-	if len([]rune(chain)) > maxWidth {
-		chain = truncateRunesHelper(chain, maxWidth, "…")
-	}
-	return chain
+	return truncateRunesHelper(strings.Join(parts, " → "), maxWidth, "…")
 }
 
 // buildDetailMarkdown generates markdown content for the detail panel
@@ -1724,25 +1720,25 @@ func (m *InsightsModel) buildDetailMarkdown(selectedID string) string {
 	sb.WriteString(fmt.Sprintf("# %s %s\n\n", GetTypeIconMD(string(issue.IssueType)), issue.Title))
 
 	// === Meta Table ===
-	sb.WriteString("| Field | Value |\n|---|---|\n")
-	sb.WriteString(fmt.Sprintf("| **ID** | `%s` |\n", issue.ID))
-	sb.WriteString(fmt.Sprintf("| **Status** | **%s** |\n", strings.ToUpper(string(issue.Status))))
-	sb.WriteString(fmt.Sprintf("| **Priority** | %s P%d |\n", GetPriorityIcon(issue.Priority), issue.Priority))
+	sb.WriteString(i18n.T("| Field | Value |") + "\n|---|---|\n")
+	sb.WriteString(i18n.Tf("| **ID** | `%s` |", issue.ID) + "\n")
+	sb.WriteString(i18n.Tf("| **Status** | **%s** |", strings.ToUpper(string(issue.Status))) + "\n")
+	sb.WriteString(i18n.Tf("| **Priority** | %s P%d |", GetPriorityIcon(issue.Priority), issue.Priority) + "\n")
 	if issue.Assignee != "" {
-		sb.WriteString(fmt.Sprintf("| **Assignee** | @%s |\n", issue.Assignee))
+		sb.WriteString(i18n.Tf("| **Assignee** | @%s |", issue.Assignee) + "\n")
 	}
-	sb.WriteString(fmt.Sprintf("| **Created** | %s |\n", issue.CreatedAt.Format("2006-01-02")))
+	sb.WriteString(i18n.Tf("| **Created** | %s |", issue.CreatedAt.Format("2006-01-02")) + "\n")
 	sb.WriteString("\n")
 
 	// === Labels ===
 	if len(issue.Labels) > 0 {
-		sb.WriteString(fmt.Sprintf("**Labels:** `%s`\n\n", strings.Join(issue.Labels, "` `")))
+		sb.WriteString(i18n.Tf("**Labels:** `%s`", strings.Join(issue.Labels, "` `")) + "\n\n")
 	}
 
 	// === Graph Metrics Section ===
 	if m.insights.Stats != nil {
 		stats := m.insights.Stats
-		sb.WriteString("### 📊 Graph Analysis\n\n")
+		sb.WriteString("### " + i18n.T("📊 Graph Analysis") + "\n\n")
 
 		// Core metrics in a compact format
 		pr := stats.GetPageRankScore(selectedID)
@@ -1752,39 +1748,39 @@ func (m *InsightsModel) buildDetailMarkdown(selectedID string) string {
 		hub := stats.GetHubScore(selectedID)
 		auth := stats.GetAuthorityScore(selectedID)
 
-		sb.WriteString(fmt.Sprintf("- **Impact Depth:** `%.0f` _(downstream chain length)_\n", imp))
-		sb.WriteString(fmt.Sprintf("- **Centrality:** PR `%.4f` • BW `%.4f` • EV `%.4f`\n", pr, bt, ev))
-		sb.WriteString(fmt.Sprintf("- **Flow Role:** Hub `%.4f` • Auth `%.4f`\n", hub, auth))
-		sb.WriteString(fmt.Sprintf("- **Degree:** In `%d` ← → Out `%d`\n\n", stats.InDegree[selectedID], stats.OutDegree[selectedID]))
+		sb.WriteString(i18n.Tf("- **Impact Depth:** `%.0f` _(downstream chain length)_", imp) + "\n")
+		sb.WriteString(i18n.Tf("- **Centrality:** PR `%.4f` • BW `%.4f` • EV `%.4f`", pr, bt, ev) + "\n")
+		sb.WriteString(i18n.Tf("- **Flow Role:** Hub `%.4f` • Auth `%.4f`", hub, auth) + "\n")
+		sb.WriteString(i18n.Tf("- **Degree:** In `%d` ← → Out `%d`", stats.InDegree[selectedID], stats.OutDegree[selectedID]) + "\n\n")
 	}
 
 	// === Description ===
 	if issue.Description != "" {
-		sb.WriteString("### Description\n\n")
+		sb.WriteString("### " + i18n.T("Description") + "\n\n")
 		sb.WriteString(issue.Description + "\n\n")
 	}
 
 	// === Design ===
 	if issue.Design != "" {
-		sb.WriteString("### Design\n\n")
+		sb.WriteString("### " + i18n.T("Design") + "\n\n")
 		sb.WriteString(issue.Design + "\n\n")
 	}
 
 	// === Acceptance Criteria ===
 	if issue.AcceptanceCriteria != "" {
-		sb.WriteString("### Acceptance Criteria\n\n")
+		sb.WriteString("### " + i18n.T("Acceptance Criteria") + "\n\n")
 		sb.WriteString(issue.AcceptanceCriteria + "\n\n")
 	}
 
 	// === Notes ===
 	if issue.Notes != "" {
-		sb.WriteString("### Notes\n\n")
+		sb.WriteString("### " + i18n.T("Notes") + "\n\n")
 		sb.WriteString("> " + strings.ReplaceAll(issue.Notes, "\n", "\n> ") + "\n\n")
 	}
 
 	// === Dependencies ===
 	if len(issue.Dependencies) > 0 {
-		sb.WriteString(fmt.Sprintf("### Dependencies (%d)\n\n", len(issue.Dependencies)))
+		sb.WriteString("### " + i18n.Tf("Dependencies (%d)", len(issue.Dependencies)) + "\n\n")
 		for _, dep := range issue.Dependencies {
 			depIssue := m.issueMap[dep.DependsOnID]
 			if depIssue != nil {
@@ -1811,20 +1807,20 @@ func (m *InsightsModel) renderCalculationProofMD(selectedID string) string {
 	info := metricDescriptions[m.focusedPanel]
 
 	sb.WriteString("---\n\n")
-	sb.WriteString("### 🔬 Calculation Proof\n\n")
-	sb.WriteString(fmt.Sprintf("**Formula:** %s\n\n", info.FormulaHint))
+	sb.WriteString("### " + i18n.T("🔬 Calculation Proof") + "\n\n")
+	sb.WriteString(i18n.Tf("**Formula:** %s", info.FormulaHint) + "\n\n")
 
 	switch m.focusedPanel {
 	case PanelBottlenecks:
 		bw := stats.GetBetweennessScore(selectedID)
-		sb.WriteString(fmt.Sprintf("**Betweenness Score:** `%.4f`\n\n", bw))
+		sb.WriteString(i18n.Tf("**Betweenness Score:** `%.4f`", bw) + "\n\n")
 		upstream := m.findDependents(selectedID)
 		downstream := m.findDependencies(selectedID)
 		if len(upstream) > 0 {
-			sb.WriteString(fmt.Sprintf("**Beads depending on this (%d):**\n", len(upstream)))
+			sb.WriteString(i18n.Tf("**Beads depending on this (%d):**", len(upstream)) + "\n")
 			for i, id := range upstream {
 				if i >= 5 {
-					sb.WriteString(fmt.Sprintf("- _...+%d more_\n", len(upstream)-5))
+					sb.WriteString(i18n.Tf("- _...+%d more_", len(upstream)-5) + "\n")
 					break
 				}
 				sb.WriteString(fmt.Sprintf("- ↓ %s\n", m.getBeadTitle(id, 40)))
@@ -1832,29 +1828,29 @@ func (m *InsightsModel) renderCalculationProofMD(selectedID string) string {
 			sb.WriteString("\n")
 		}
 		if len(downstream) > 0 {
-			sb.WriteString(fmt.Sprintf("**This depends on (%d):**\n", len(downstream)))
+			sb.WriteString(i18n.Tf("**This depends on (%d):**", len(downstream)) + "\n")
 			for i, id := range downstream {
 				if i >= 5 {
-					sb.WriteString(fmt.Sprintf("- _...+%d more_\n", len(downstream)-5))
+					sb.WriteString(i18n.Tf("- _...+%d more_", len(downstream)-5) + "\n")
 					break
 				}
 				sb.WriteString(fmt.Sprintf("- ↑ %s\n", m.getBeadTitle(id, 40)))
 			}
 		}
-		sb.WriteString("\n> This bead lies on many shortest paths, making it a *critical junction*.\n\n")
+		sb.WriteString("\n> " + i18n.T("This bead lies on many shortest paths, making it a *critical junction*.") + "\n\n")
 
 	case PanelKeystones:
 		impact := stats.GetCriticalPathScore(selectedID)
-		sb.WriteString(fmt.Sprintf("**Impact Depth:** `%.0f` levels deep\n\n", impact))
+		sb.WriteString(i18n.Tf("**Impact Depth:** `%.0f` levels deep", impact) + "\n\n")
 		chain := m.buildImpactChain(selectedID, int(impact))
 		if len(chain) > 0 {
-			sb.WriteString("**Dependency chain:**\n```\n")
+			sb.WriteString("**" + i18n.T("Dependency chain:") + "**\n```\n")
 			for i, id := range chain {
 				indent := strings.Repeat("  ", i)
 				title := m.getBeadTitle(id, 35)
 				sb.WriteString(fmt.Sprintf("%s└─ %s\n", indent, title))
 				if i >= 6 {
-					sb.WriteString(fmt.Sprintf("%s   ... chain continues\n", indent))
+					sb.WriteString(indent + "   " + i18n.T("... chain continues") + "\n")
 					break
 				}
 			}
@@ -1863,49 +1859,49 @@ func (m *InsightsModel) renderCalculationProofMD(selectedID string) string {
 
 	case PanelHubs:
 		hubScore := stats.GetHubScore(selectedID)
-		sb.WriteString(fmt.Sprintf("**Hub Score:** `%.4f`\n\n", hubScore))
+		sb.WriteString(i18n.Tf("**Hub Score:** `%.4f`", hubScore) + "\n\n")
 		deps := m.findDependenciesWithScores(selectedID, stats.Authorities())
 		if len(deps) > 0 {
-			sb.WriteString("**Depends on these authorities:**\n")
+			sb.WriteString("**" + i18n.T("Depends on these authorities:") + "**\n")
 			sumAuth := 0.0
 			for _, d := range deps {
 				sumAuth += d.score
 			}
 			for i, d := range deps {
 				if i >= 5 {
-					sb.WriteString(fmt.Sprintf("- _...+%d more_\n", len(deps)-5))
+					sb.WriteString(i18n.Tf("- _...+%d more_", len(deps)-5) + "\n")
 					break
 				}
 				sb.WriteString(fmt.Sprintf("- → %s (Auth: `%.4f`)\n", m.getBeadTitle(d.id, 30), d.score))
 			}
-			sb.WriteString(fmt.Sprintf("\n> Sum of %d authority scores: `%.4f`\n\n", len(deps), sumAuth))
+			sb.WriteString("\n> " + i18n.Tf("Sum of %d authority scores: `%.4f`", len(deps), sumAuth) + "\n\n")
 		}
 
 	case PanelAuthorities:
 		authScore := stats.GetAuthorityScore(selectedID)
-		sb.WriteString(fmt.Sprintf("**Authority Score:** `%.4f`\n\n", authScore))
+		sb.WriteString(i18n.Tf("**Authority Score:** `%.4f`", authScore) + "\n\n")
 		dependents := m.findDependentsWithScores(selectedID, stats.Hubs())
 		if len(dependents) > 0 {
-			sb.WriteString("**Hubs that depend on this:**\n")
+			sb.WriteString("**" + i18n.T("Hubs that depend on this:") + "**\n")
 			sumHub := 0.0
 			for _, d := range dependents {
 				sumHub += d.score
 			}
 			for i, d := range dependents {
 				if i >= 5 {
-					sb.WriteString(fmt.Sprintf("- _...+%d more_\n", len(dependents)-5))
+					sb.WriteString(i18n.Tf("- _...+%d more_", len(dependents)-5) + "\n")
 					break
 				}
 				sb.WriteString(fmt.Sprintf("- ← %s (Hub: `%.4f`)\n", m.getBeadTitle(d.id, 30), d.score))
 			}
-			sb.WriteString(fmt.Sprintf("\n> Sum of %d hub scores: `%.4f`\n\n", len(dependents), sumHub))
+			sb.WriteString("\n> " + i18n.Tf("Sum of %d hub scores: `%.4f`", len(dependents), sumHub) + "\n\n")
 		}
 
 	case PanelCycles:
 		idx := m.selectedIndex[PanelCycles]
 		if idx >= 0 && idx < len(m.insights.Cycles) {
 			cycle := m.insights.Cycles[idx]
-			sb.WriteString(fmt.Sprintf("**Cycle with %d beads:**\n```\n", len(cycle)))
+			sb.WriteString(i18n.Tf("**Cycle with %d beads:**", len(cycle)) + "\n```\n")
 			for i, id := range cycle {
 				arrow := "→"
 				if i == len(cycle)-1 {
@@ -1914,7 +1910,7 @@ func (m *InsightsModel) renderCalculationProofMD(selectedID string) string {
 				sb.WriteString(fmt.Sprintf("%s %s\n", arrow, m.getBeadTitle(id, 35)))
 			}
 			sb.WriteString("```\n\n")
-			sb.WriteString("> These beads form a circular dependency. *Break the cycle* by removing or reversing one edge.\n\n")
+			sb.WriteString("> " + i18n.T("These beads form a circular dependency. *Break the cycle* by removing or reversing one edge.") + "\n\n")
 		}
 
 	default:
@@ -1927,7 +1923,7 @@ func (m *InsightsModel) renderCalculationProofMD(selectedID string) string {
 
 func (m *InsightsModel) renderDetailPanel(width, height int, t Theme) string {
 	// Update viewport dimensions
-	vpWidth := width - 4  // Account for border
+	vpWidth := width - 4   // Account for border
 	vpHeight := height - 4 // Account for border and scroll hint
 	if vpWidth < 20 {
 		vpWidth = 20
@@ -2176,7 +2172,7 @@ func wrapText(s string, maxWidth int) string {
 	currentLen := 0
 
 	for _, word := range words {
-		wordLen := len([]rune(word))
+		wordLen := lipgloss.Width(word)
 		if currentLen+wordLen+1 > maxWidth && currentLen > 0 {
 			lines = append(lines, currentLine.String())
 			currentLine.Reset()
@@ -52,6 +52,8 @@ type IssueItem struct {
 	IsQuickWin    bool     // True if identified as a quick win
 	IsBlocker     bool     // True if this item blocks significant downstream work
 	UnblocksCount int      // Number of items this unblocks
+
+	filterValue string // Precomputed FilterValue (see cacheFilterValue)
 }
 
 func (i IssueItem) Title() string {
@@ -63,6 +65,20 @@ func (i IssueItem) Description() string {
 }
 
 func (i IssueItem) FilterValue() string {
+	if i.filterValue != "" {
+		return i.filterValue
+	}
+	return i.buildFilterValue()
+}
+
+// cacheFilterValue precomputes FilterValue. Call it after setting Issue and
+// RepoPrefix so fuzzy filtering over large lists doesn't rebuild every string
+// on each keystroke.
+func (i *IssueItem) cacheFilterValue() {
+	i.filterValue = i.buildFilterValue()
+}
+
+func (i IssueItem) buildFilterValue() string {
 	// Enhanced filter value including labels, assignee, and repo prefix
 	var sb strings.Builder
 	sb.WriteString(i.Issue.Title)
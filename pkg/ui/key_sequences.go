@@ -0,0 +1,257 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Key sequences
+//
+// ui.keys binds sequences of keys, such as "g g" or "space f s", to ":"
+// command lines. A key that starts a bound sequence is held back and the
+// footer shows it until the sequence is complete. When the next key does
+// not continue any sequence, or nothing follows within the timeout, the
+// held keys are handled as if no sequence was bound, unless they form a
+// binding of their own: so "g g" can be bound while "g" keeps its meaning.
+// esc drops the held keys.
+
+// keySequenceTimeout is how long a started sequence waits for its next key,
+// like Vim's timeoutlen.
+const keySequenceTimeout = time.Second
+
+// keySequenceTimeoutMsg ends the wait for the next key of a sequence. It
+// carries the generation it was started for, so the timers of sequences
+// that have since moved on are ignored.
+type keySequenceTimeoutMsg struct {
+	gen int
+}
+
+// KeySequenceTracker matches key presses against bound sequences. It
+// generalizes the double-tap timer of CapsLockTracker to any number of keys
+// and bindings.
+type KeySequenceTracker struct {
+	bindings  map[string]string // "g g" → command line
+	prefixes  map[string]bool   // Every proper prefix of a binding
+	timeout   time.Duration
+	pending   []tea.KeyMsg
+	gen       int
+	replaying bool // Held keys are being handled; do not hold them again
+}
+
+// NewKeySequenceTracker returns a tracker for bindings, which map sequences
+// of space-separated key names to command lines. The bindings must have
+// passed ValidateKeyBindings.
+func NewKeySequenceTracker(bindings map[string]string, timeout time.Duration) *KeySequenceTracker {
+	t := &KeySequenceTracker{
+		bindings: make(map[string]string, len(bindings)),
+		prefixes: make(map[string]bool),
+		timeout:  timeout,
+	}
+	for seq, line := range bindings {
+		keys := strings.Fields(seq)
+		t.bindings[strings.Join(keys, " ")] = line
+		for i := 1; i < len(keys); i++ {
+			t.prefixes[strings.Join(keys[:i], " ")] = true
+		}
+	}
+	return t
+}
+
+// keyName is how a key is written in a binding: as tea.Key.String() does,
+// except that the space bar is "space".
+func keyName(msg tea.KeyMsg) string {
+	if s := msg.String(); s != " " {
+		return s
+	}
+	return "space"
+}
+
+func (t *KeySequenceTracker) sequence(extra ...tea.KeyMsg) string {
+	names := make([]string, 0, len(t.pending)+len(extra))
+	for _, k := range append(append([]tea.KeyMsg(nil), t.pending...), extra...) {
+		names = append(names, keyName(k))
+	}
+	return strings.Join(names, " ")
+}
+
+// Press adds msg to the sequence. It returns the command line of a binding
+// that is complete, and the keys to handle as usual after it: msg itself
+// when it plays no part in any binding, or the held keys when they match
+// nothing. cmd starts the timeout when a sequence is held.
+func (t *KeySequenceTracker) Press(msg tea.KeyMsg) (line string, replay []tea.KeyMsg, cmd tea.Cmd) {
+	if msg.Type == tea.KeyEsc && len(t.pending) > 0 {
+		t.Reset() // esc abandons the sequence
+		return "", nil, nil
+	}
+	seq := t.sequence(msg)
+	if t.prefixes[seq] {
+		t.pending = append(t.pending, msg)
+		t.gen++
+		gen := t.gen
+		return "", nil, tea.Tick(t.timeout, func(time.Time) tea.Msg {
+			return keySequenceTimeoutMsg{gen: gen}
+		})
+	}
+	if line, ok := t.bindings[seq]; ok {
+		t.Reset()
+		return line, nil, nil
+	}
+	if len(t.pending) == 0 {
+		return "", []tea.KeyMsg{msg}, nil
+	}
+
+	// The sequence went nowhere. What was held runs as its own binding, if
+	// it is one, or is handled key by key; the new key may then start a
+	// sequence of its own. Bindings have two keys or more, so it completes
+	// none by itself.
+	line = t.bindings[t.sequence()]
+	held := t.flush()
+	if line != "" {
+		held = nil
+	}
+	_, replay, cmd = t.Press(msg)
+	return line, append(held, replay...), cmd
+}
+
+// Timeout resolves a held sequence once its timer fires: the command line
+// of the binding it forms, if any, or the keys to handle as usual.
+func (t *KeySequenceTracker) Timeout(msg keySequenceTimeoutMsg) (line string, replay []tea.KeyMsg) {
+	if msg.gen != t.gen || len(t.pending) == 0 {
+		return "", nil
+	}
+	if line, ok := t.bindings[t.sequence()]; ok {
+		t.Reset()
+		return line, nil
+	}
+	return "", t.flush()
+}
+
+// flush ends the held sequence, returning its keys.
+func (t *KeySequenceTracker) flush() []tea.KeyMsg {
+	held := t.pending
+	t.Reset()
+	return held
+}
+
+// Reset forgets the held sequence.
+func (t *KeySequenceTracker) Reset() {
+	t.pending = nil
+	t.gen++
+}
+
+// Pending returns the held keys, e.g. "space f"; empty when none are.
+func (t *KeySequenceTracker) Pending() string {
+	if t == nil {
+		return ""
+	}
+	return t.sequence()
+}
+
+// Continuations returns the keys that can follow the held ones, each with
+// what it leads to: a command line, or "…" for a longer sequence.
+func (t *KeySequenceTracker) Continuations() []string {
+	prefix := t.Pending() + " "
+	next := make(map[string]string)
+	for seq, line := range t.bindings {
+		rest, ok := strings.CutPrefix(seq, prefix)
+		if !ok {
+			continue
+		}
+		key, _, more := strings.Cut(rest, " ")
+		if more {
+			if _, ok := next[key]; !ok {
+				next[key] = "…"
+			}
+		} else {
+			next[key] = line
+		}
+	}
+	out := make([]string, 0, len(next))
+	for _, key := range sortedKeys(next) {
+		out = append(out, key+" "+next[key])
+	}
+	return out
+}
+
+// ValidateKeyBindings checks ui.keys: each sequence has at least two keys,
+// leaves ctrl+c and esc alone and runs a valid command line.
+func ValidateKeyBindings(bindings map[string]string, aliases map[string]string) error {
+	seqs := make([]string, 0, len(bindings))
+	for seq := range bindings {
+		seqs = append(seqs, seq)
+	}
+	sort.Strings(seqs)
+	for _, seq := range seqs {
+		keys := strings.Fields(seq)
+		if len(keys) < 2 {
+			return fmt.Errorf("%q: a sequence needs at least two keys; single keys belong to bv", seq)
+		}
+		for _, key := range keys {
+			switch key {
+			case "ctrl+c":
+				return fmt.Errorf("%q: ctrl+c always quits", seq)
+			case "esc":
+				return fmt.Errorf("%q: esc abandons a sequence", seq)
+			}
+		}
+		if err := ValidateCommand(bindings[seq], aliases); err != nil {
+			return fmt.Errorf("%q: %w", seq, err)
+		}
+	}
+	return nil
+}
+
+// SetKeyBindings binds the key sequences of ui.keys.
+func (m *Model) SetKeyBindings(bindings map[string]string) {
+	if len(bindings) == 0 {
+		m.keySeqs = nil
+		return
+	}
+	m.keySeqs = NewKeySequenceTracker(bindings, keySequenceTimeout)
+}
+
+// acceptsKeySequences reports whether keys may start a sequence.
+func (m Model) acceptsKeySequences() bool {
+	return m.keySeqs != nil && !m.keySeqs.replaying && m.keysAreFree()
+}
+
+// keysAreFree reports whether keys may have meanings across views: not
+// while typing or in an overlay, which have keys of their own.
+func (m Model) keysAreFree() bool {
+	if m.showTutorial {
+		return false
+	}
+	ctx := m.CurrentContext()
+	return !ctx.IsOverlay() && ctx != ContextFilter && !m.board.IsSearchMode() && !m.historyView.IsSearchActive()
+}
+
+// handleKeySequence passes msg to the tracker and acts on the outcome.
+func (m Model) handleKeySequence(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	line, replay, cmd := m.keySeqs.Press(msg)
+	return m.finishKeySequence(line, replay, cmd)
+}
+
+// finishKeySequence runs the bound command line, if any, then handles the
+// keys given back by the tracker.
+func (m Model) finishKeySequence(line string, replay []tea.KeyMsg, cmd tea.Cmd) (tea.Model, tea.Cmd) {
+	cmds := []tea.Cmd{cmd}
+	if line != "" {
+		var c tea.Cmd
+		m, c = m.runPromptCommand(line)
+		cmds = append(cmds, c)
+	}
+	if len(replay) > 0 {
+		m.keySeqs.replaying = true
+		for _, k := range replay {
+			next, c := m.update(k)
+			m = next.(Model)
+			cmds = append(cmds, c)
+		}
+		m.keySeqs.replaying = false
+	}
+	return m, tea.Batch(cmds...)
+}
@@ -0,0 +1,118 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func keyNames(keys []tea.KeyMsg) string {
+	names := make([]string, len(keys))
+	for i, k := range keys {
+		names[i] = keyName(k)
+	}
+	return strings.Join(names, " ")
+}
+
+func TestKeySequenceTracker(t *testing.T) {
+	tr := NewKeySequenceTracker(map[string]string{
+		"g g":       "view board",
+		"g g x":     "view graph",
+		"space f s": "filter status:open",
+	}, time.Millisecond)
+	space := tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+
+	if line, replay, cmd := tr.Press(runeKey("j")); line != "" || keyNames(replay) != "j" || cmd != nil {
+		t.Fatalf("expected an unbound key back, got %q %q", line, keyNames(replay))
+	}
+
+	// "space f s" completes; the footer lists what may follow.
+	tr.Press(space)
+	tr.Press(runeKey("f"))
+	if got := tr.Pending(); got != "space f" {
+		t.Errorf("expected space f held, got %q", got)
+	}
+	if got := tr.Continuations(); len(got) != 1 || got[0] != "s filter status:open" {
+		t.Errorf("unexpected continuations %q", got)
+	}
+	if line, replay, _ := tr.Press(runeKey("s")); line != "filter status:open" || len(replay) != 0 || tr.Pending() != "" {
+		t.Errorf("expected the binding, got %q %q", line, keyNames(replay))
+	}
+
+	// A dead end gives back what was held; the new key may start anew.
+	tr.Press(space)
+	if line, replay, cmd := tr.Press(runeKey("g")); line != "" || keyNames(replay) != "space" || cmd == nil || tr.Pending() != "g" {
+		t.Errorf("expected space back and g held, got %q %q, held %q", line, keyNames(replay), tr.Pending())
+	}
+
+	// "g g" is a binding and the start of "g g x": what follows decides.
+	tr.Press(runeKey("g"))
+	if line, replay, _ := tr.Press(runeKey("j")); line != "view board" || keyNames(replay) != "j" {
+		t.Errorf("expected g g to run before j, got %q %q", line, keyNames(replay))
+	}
+	tr.Press(runeKey("g"))
+	_, _, cmd := tr.Press(runeKey("g"))
+	if line, replay := tr.Timeout(cmd().(keySequenceTimeoutMsg)); line != "view board" || len(replay) != 0 {
+		t.Errorf("expected g g to run on timeout, got %q %q", line, keyNames(replay))
+	}
+
+	// Stale timers are ignored; esc drops the held keys.
+	_, _, stale := tr.Press(runeKey("g"))
+	tr.Press(tea.KeyMsg{Type: tea.KeyEsc})
+	tr.Press(runeKey("g"))
+	if line, replay := tr.Timeout(stale().(keySequenceTimeoutMsg)); line != "" || len(replay) != 0 || tr.Pending() != "g" {
+		t.Errorf("expected a stale timer to be ignored, got %q %q", line, keyNames(replay))
+	}
+}
+
+func TestKeySequences_InModel(t *testing.T) {
+	m := commandTestModel(t)
+	m.SetKeyBindings(map[string]string{"g g": "view board", "space o": "filter open"})
+	m.keySeqs.timeout = time.Millisecond
+
+	m = pressKey(m, runeKey("j"))
+	if m.list.Index() != 1 {
+		t.Fatalf("expected unbound keys to work as before, at %d", m.list.Index())
+	}
+
+	m = pressKey(m, runeKey("g"))
+	if view := m.View(); !strings.Contains(view, "g …") || !strings.Contains(view, "view board") {
+		t.Errorf("expected the held key and its continuation in the footer:\n%s", view)
+	}
+	m = pressKey(m, runeKey("g"))
+	if m.CurrentContext() != ContextBoard {
+		t.Fatalf("expected g g to open the board, got %s", m.CurrentContext())
+	}
+
+	// A lone g times out and keeps its own meaning.
+	m = typeCommand(m, "view list")
+	newM, cmd := m.Update(runeKey("g"))
+	m = newM.(Model)
+	newM, _ = m.Update(cmd())
+	m = newM.(Model)
+	if m.CurrentContext() != ContextGraph {
+		t.Errorf("expected g alone to toggle the graph, got %s", m.CurrentContext())
+	}
+}
+
+func TestValidateKeyBindings(t *testing.T) {
+	if err := ValidateKeyBindings(map[string]string{"g g": "view board", "space p": "p0"}, map[string]string{"p0": "filter priority:0"}); err != nil {
+		t.Fatalf("expected valid bindings, got %v", err)
+	}
+	for seq, line := range map[string]string{
+		"g":          "view board",
+		"g ctrl+c":   "view board",
+		"esc x":      "view board",
+		"space x":    "bogus",
+		"space p  ":  "",
+		"d d":        "view nowhere",
+		"  ":         "view board",
+		"space f s ": "filter status:open; nope",
+	} {
+		if err := ValidateKeyBindings(map[string]string{seq: line}, nil); err == nil {
+			t.Errorf("expected %q → %q to be rejected", seq, line)
+		}
+	}
+}
@@ -0,0 +1,257 @@
+package ui
+
+import (
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Kitty keyboard protocol
+//
+// Terminals that implement the kitty keyboard protocol (kitty, foot,
+// WezTerm, Ghostty, recent Alacritty) can report every key, CapsLock
+// included, as a CSI u sequence. At startup bv asks the terminal for its
+// protocol flags; only a terminal that answers gets the protocol turned on,
+// everywhere else nothing changes and the backtick stays the way to the
+// tutorial. The flags are pushed on the alternate screen, which keeps its
+// own flag stack, so leaving bv leaves the shell's keyboard as it was.
+//
+// Bubble Tea does not parse CSI u, so the sequences reach Update as
+// unrecognized CSI sequences and are turned into the tea.KeyMsg legacy
+// input would have produced.
+
+const (
+	kittyKeyboardQuery = "\x1b[?u"
+	// Disambiguate (1), report alternate keys (4), report all keys as
+	// escape codes (8): lock keys are only reported with the last one.
+	kittyKeyboardPush = "\x1b[>13u"
+)
+
+// Kitty modifier bits; the sequence carries 1 + the sum of them.
+const (
+	kittyShift    = 1
+	kittyAlt      = 2
+	kittyCtrl     = 4
+	kittyCapsLock = 64
+)
+
+// Kitty key codes for keys without a Unicode code point.
+const (
+	kittyCapsLockKey   = 57358
+	kittyF13           = 57376
+	kittyF20           = 57383
+	kittyKeypad0       = 57399
+	kittyKeypadEnter   = 57414
+	kittyKeypadDelete  = 57426
+	kittyFirstModifier = 57441 // Left shift
+	kittyLastModifier  = 57452 // ISO level 5 shift
+)
+
+// kittyKeypad maps keypad key codes other than the digits and enter to the
+// keys they stand for.
+var kittyKeypad = map[int]tea.Key{
+	57409: {Type: tea.KeyRunes, Runes: []rune{'.'}},
+	57410: {Type: tea.KeyRunes, Runes: []rune{'/'}},
+	57411: {Type: tea.KeyRunes, Runes: []rune{'*'}},
+	57412: {Type: tea.KeyRunes, Runes: []rune{'-'}},
+	57413: {Type: tea.KeyRunes, Runes: []rune{'+'}},
+	57415: {Type: tea.KeyRunes, Runes: []rune{'='}},
+	57416: {Type: tea.KeyRunes, Runes: []rune{','}},
+	57417: {Type: tea.KeyLeft},
+	57418: {Type: tea.KeyRight},
+	57419: {Type: tea.KeyUp},
+	57420: {Type: tea.KeyDown},
+	57421: {Type: tea.KeyPgUp},
+	57422: {Type: tea.KeyPgDown},
+	57423: {Type: tea.KeyHome},
+	57424: {Type: tea.KeyEnd},
+	57425: {Type: tea.KeyInsert},
+	57426: {Type: tea.KeyDelete},
+}
+
+// kittyCtrlKeys maps the punctuation that has a control code of its own.
+var kittyCtrlKeys = map[rune]tea.KeyType{
+	' ':  tea.KeyCtrlAt,
+	'@':  tea.KeyCtrlAt,
+	'[':  tea.KeyEscape,
+	'\\': tea.KeyCtrlBackslash,
+	']':  tea.KeyCtrlCloseBracket,
+	'^':  tea.KeyCtrlCaret,
+	'_':  tea.KeyCtrlUnderscore,
+	'?':  tea.KeyCtrlQuestionMark,
+}
+
+// EnableKittyKeyboard probes the terminal behind out for the kitty keyboard
+// protocol at startup and turns it on if the terminal answers. Only call it
+// when bv draws on the alternate screen.
+func (m *Model) EnableKittyKeyboard(out io.Writer) {
+	m.kittyOut = out
+}
+
+// KittyKeyboardActive reports whether the terminal answered the probe.
+func (m Model) KittyKeyboardActive() bool {
+	return m.kittyActive
+}
+
+// writeTerminalCmd writes seq to out.
+func writeTerminalCmd(out io.Writer, seq string) tea.Cmd {
+	return func() tea.Msg {
+		_, _ = io.WriteString(out, seq)
+		return nil
+	}
+}
+
+// csiSequence returns the bytes of a CSI sequence Bubble Tea did not
+// recognize. It delivers them as an unexported []byte type, so the type is
+// matched by name.
+func csiSequence(msg tea.Msg) ([]byte, bool) {
+	v := reflect.ValueOf(msg)
+	if !v.IsValid() || v.Kind() != reflect.Slice || v.Type().Elem().Kind() != reflect.Uint8 ||
+		v.Type().Name() != "unknownCSISequenceMsg" {
+		return nil, false
+	}
+	return v.Bytes(), true
+}
+
+// handleCSISequence turns seq into the key it encodes. A nil message means
+// there is nothing to pass on: the probe answer, a modifier on its own or a
+// sequence bv has no use for.
+func (m *Model) handleCSISequence(seq []byte) (tea.Msg, tea.Cmd) {
+	s := strings.TrimPrefix(string(seq), "\x1b[")
+	if strings.HasPrefix(s, "?") && strings.HasSuffix(s, "u") {
+		// The answer to the probe: the terminal speaks the protocol.
+		if m.kittyOut == nil || m.kittyActive {
+			return nil, nil
+		}
+		m.kittyActive = true
+		return nil, writeTerminalCmd(m.kittyOut, kittyKeyboardPush)
+	}
+	if key, ok := parseKittyKey(s); ok {
+		return tea.KeyMsg(key), nil
+	}
+	return nil, nil
+}
+
+// parseKittyKey decodes the parameters and final byte of a CSI sequence
+// (the part after ESC [) sent under the kitty keyboard protocol.
+func parseKittyKey(s string) (tea.Key, bool) {
+	if s == "" {
+		return tea.Key{}, false
+	}
+	final := s[len(s)-1]
+	fields := strings.Split(s[:len(s)-1], ";")
+
+	// Modifiers and event type: "mods:event", both optional.
+	mods := 0
+	if len(fields) > 1 && fields[1] != "" {
+		modField, event, _ := strings.Cut(fields[1], ":")
+		if event == "3" {
+			return tea.Key{}, false // A release; only presses are asked for
+		}
+		n, err := strconv.Atoi(modField)
+		if err != nil || n < 1 {
+			return tea.Key{}, false
+		}
+		mods = n - 1
+	}
+
+	switch final {
+	case 'u':
+	case 'P', 'Q', 'S':
+		// F1, F2 and F4 without the legacy "1" parameter.
+		if fields[0] != "" && fields[0] != "1" {
+			return tea.Key{}, false
+		}
+		key := tea.Key{Type: map[byte]tea.KeyType{'P': tea.KeyF1, 'Q': tea.KeyF2, 'S': tea.KeyF4}[final]}
+		key.Alt = mods&kittyAlt != 0
+		return key, true
+	default:
+		return tea.Key{}, false
+	}
+
+	// Key code and alternate keys: "code:shifted:base".
+	codes := strings.Split(fields[0], ":")
+	code, err := strconv.Atoi(codes[0])
+	if err != nil {
+		return tea.Key{}, false
+	}
+	shifted := 0
+	if len(codes) > 1 && codes[1] != "" {
+		shifted, _ = strconv.Atoi(codes[1])
+	}
+	return kittyKey(code, shifted, mods)
+}
+
+// kittyKey is the key legacy input would report for code with mods.
+func kittyKey(code, shifted, mods int) (tea.Key, bool) {
+	alt := mods&kittyAlt != 0
+	ctrl := mods&kittyCtrl != 0
+	shift := mods&kittyShift != 0
+	key := tea.Key{Alt: alt}
+
+	switch {
+	case code == kittyCapsLockKey:
+		return tea.Key{Type: KeyCapsLock}, true
+	case code >= kittyFirstModifier && code <= kittyLastModifier:
+		return tea.Key{}, false // A modifier on its own
+	case code >= kittyF13 && code <= kittyF20:
+		key.Type = tea.KeyF13 + tea.KeyType(code-kittyF13)
+		return key, true
+	case code >= kittyKeypad0 && code < kittyKeypad0+10:
+		code = '0' + code - kittyKeypad0
+	case code == kittyKeypadEnter:
+		code = '\r'
+	case code > kittyKeypad0 && code <= kittyKeypadDelete:
+		k := kittyKeypad[code]
+		k.Runes = append([]rune(nil), k.Runes...)
+		k.Alt = alt
+		return k, true
+	case code >= 57344 && code <= 63743:
+		return tea.Key{}, false // Other keys in the private use area
+	}
+
+	switch code {
+	case 27:
+		key.Type = tea.KeyEscape
+	case '\r':
+		key.Type = tea.KeyEnter
+	case '\t':
+		key.Type = tea.KeyTab
+		if shift {
+			key.Type = tea.KeyShiftTab
+		}
+	case 127:
+		key.Type = tea.KeyBackspace
+	default:
+		r := rune(code)
+		if ctrl {
+			if r >= 'a' && r <= 'z' {
+				key.Type = tea.KeyCtrlA + tea.KeyType(r-'a')
+				return key, true
+			}
+			if t, ok := kittyCtrlKeys[r]; ok {
+				key.Type = t
+				return key, true
+			}
+		}
+		switch {
+		case shift && shifted != 0:
+			r = rune(shifted)
+		case shift || mods&kittyCapsLock != 0:
+			r = unicode.ToUpper(r)
+		}
+		if !unicode.IsPrint(r) {
+			return tea.Key{}, false
+		}
+		key.Type = tea.KeyRunes
+		if r == ' ' {
+			key.Type = tea.KeySpace
+		}
+		key.Runes = []rune{r}
+	}
+	return key, true
+}
@@ -0,0 +1,106 @@
+package ui
+
+import (
+	"bytes"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// unknownCSISequenceMsg stands in for Bubble Tea's unexported message for
+// CSI sequences it does not recognize.
+type unknownCSISequenceMsg []byte
+
+func sendCSI(m Model, seq string) (Model, tea.Cmd) {
+	newM, cmd := m.Update(unknownCSISequenceMsg(seq))
+	return newM.(Model), cmd
+}
+
+func TestParseKittyKey(t *testing.T) {
+	tests := []struct {
+		seq  string
+		want string // tea.Key.String(); "-" when nothing is passed on
+	}{
+		{"97u", "a"},
+		{"97:65;2u", "A"},
+		{"97;65u", "A"}, // CapsLock on
+		{"49:33;2u", "!"},
+		{"99;5u", "ctrl+c"},
+		{"120;3u", "alt+x"},
+		{"27u", "esc"},
+		{"13u", "enter"},
+		{"9;2u", "shift+tab"},
+		{"127u", "backspace"},
+		{"32u", " "},
+		{"57399u", "0"},
+		{"57414u", "enter"},
+		{"57417u", "left"},
+		{"57376u", "f13"},
+		{"P", "f1"},
+		{"S", "f4"},
+		{"57441;2u", "-"}, // Shift on its own
+		{"97;1:3u", "-"},  // A release
+		{"A", "-"},
+		{"", "-"},
+	}
+	for _, tt := range tests {
+		key, ok := parseKittyKey(tt.seq)
+		got := "-"
+		if ok {
+			got = key.String()
+		}
+		if got != tt.want {
+			t.Errorf("parseKittyKey(%q) = %q, want %q", tt.seq, got, tt.want)
+		}
+	}
+	if key, ok := parseKittyKey("57358u"); !ok || !IsCapsLock(tea.KeyMsg(key)) {
+		t.Errorf("expected CapsLock, got %v, %v", key, ok)
+	}
+}
+
+func TestKittyKeyboard_ProbeAndCapsLock(t *testing.T) {
+	m := commandTestModel(t)
+	var out bytes.Buffer
+	m.EnableKittyKeyboard(&out)
+
+	// Keys are translated whether or not the protocol was turned on.
+	m, _ = sendCSI(m, "\x1b[106u")
+	if m.list.Index() != 1 {
+		t.Fatalf("expected CSI 106 u to move down like j, at %d", m.list.Index())
+	}
+
+	m, cmd := sendCSI(m, "\x1b[?0u")
+	if cmd == nil || !m.KittyKeyboardActive() {
+		t.Fatal("expected the probe answer to turn the protocol on")
+	}
+	cmd()
+	if out.String() != kittyKeyboardPush {
+		t.Errorf("expected the flags to be pushed, wrote %q", out.String())
+	}
+	if m, cmd = sendCSI(m, "\x1b[?13u"); cmd != nil {
+		t.Error("expected the flags to be pushed once")
+	}
+
+	// A single tap opens the tutorial once the double-tap window closes.
+	m, cmd = sendCSI(m, "\x1b[57358u")
+	if cmd == nil || m.showTutorial {
+		t.Fatal("expected CapsLock to wait for a second tap")
+	}
+	newM, _ := m.Update(CapsLockTimerExpiredMsg{})
+	m = newM.(Model)
+	if !m.showTutorial || m.tutorialModel.contextMode {
+		t.Fatal("expected a single tap to open the full tutorial")
+	}
+	m, _ = sendCSI(m, "\x1b[57358u")
+	if m.showTutorial {
+		t.Fatal("expected CapsLock to close the tutorial")
+	}
+
+	// A double tap opens the help for the current view.
+	view := m.CurrentContext()
+	m, _ = sendCSI(m, "\x1b[57358u")
+	m, _ = sendCSI(m, "\x1b[57358u")
+	if !m.showTutorial || !m.tutorialModel.contextMode || m.tutorialModel.context != string(view) {
+		t.Errorf("expected the help for %s, got tutorial=%v context=%q", view, m.showTutorial, m.tutorialModel.context)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"unicode"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
 	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -241,7 +242,7 @@ func (m *LabelPickerModel) View() string {
 		Foreground(t.Primary).
 		Bold(true).
 		MarginBottom(1)
-	lines = append(lines, titleStyle.Render("Filter by Label"))
+	lines = append(lines, titleStyle.Render(i18n.T("Filter by Label")))
 	lines = append(lines, "")
 
 	// Search input
@@ -258,7 +259,7 @@ func (m *LabelPickerModel) View() string {
 		dimStyle := t.Renderer.NewStyle().
 			Foreground(t.Secondary).
 			Italic(true)
-		lines = append(lines, dimStyle.Render("  No matching labels"))
+		lines = append(lines, dimStyle.Render("  "+i18n.T("No matching labels")))
 	} else {
 		// Calculate visible window
 		start := 0
@@ -318,7 +319,7 @@ func (m *LabelPickerModel) View() string {
 	footerStyle := t.Renderer.NewStyle().
 		Foreground(t.Secondary).
 		Italic(true)
-	lines = append(lines, footerStyle.Render("j/k: navigate | enter: apply | esc: cancel"))
+	lines = append(lines, footerStyle.Render(i18n.T("j/k: navigate | enter: apply | esc: cancel")))
 
 	content := strings.Join(lines, "\n")
 
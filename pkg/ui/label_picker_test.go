@@ -1,6 +1,12 @@
 package ui
 
-import "testing"
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
 
 func TestFuzzyScoreExactMatch(t *testing.T) {
 	score := fuzzyScore("api", "api")
@@ -153,6 +159,31 @@ func TestLabelPickerReset(t *testing.T) {
 	}
 }
 
+func TestLabelPicker_FiltersByLabelWithSpaces(t *testing.T) {
+	m := NewModel([]model.Issue{
+		{ID: "A-1", Title: "Review me", Status: model.StatusOpen, Labels: []string{"needs review"}},
+		{ID: "A-2", Title: "Other", Status: model.StatusOpen, Labels: []string{"needs"}},
+	}, nil, "")
+	newM, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m = newM.(Model)
+
+	m = pressKey(m, runeKey("l"))
+	for _, r := range "rev" {
+		m = pressKey(m, runeKey(string(r)))
+	}
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEnter})
+
+	if m.currentFilter != `label:"needs review"` {
+		t.Fatalf("expected the label quoted in the filter, got %q", m.currentFilter)
+	}
+	if m.statusIsError {
+		t.Fatalf("expected the filter to parse, got %q", m.statusMsg)
+	}
+	if ids := strings.Join(listIDs(m), " "); ids != "A-1" {
+		t.Errorf("expected only A-1, got %q", ids)
+	}
+}
+
 func TestItoaHelper(t *testing.T) {
 	tests := []struct {
 		input    int
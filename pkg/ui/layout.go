@@ -0,0 +1,207 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Layout breakpoints
+//
+// ui.layout sets the terminal sizes the main screen adapts at, so it
+// rearranges instead of wrapping. Wider than split_width, the list and the
+// details sit side by side. Narrower, a terminal at least stack_height rows
+// tall stacks the details below the list, keeping the split view's keys;
+// shorter ones show one at a time. Below compact_width, list rows shrink to
+// the essentials and the status bar to what is shown and its state. Below
+// min_width by min_height, bv says how big the terminal needs to be.
+
+// Default breakpoints, in columns and rows.
+const (
+	defaultCompactWidth = 80
+	defaultStackHeight  = 40
+	defaultMinWidth     = 40
+	defaultMinHeight    = 10
+)
+
+// compactListHeader heads the list in compact layouts, whose rows show the
+// status as an icon.
+const compactListHeader = "  TYPE PRI ST ID  TITLE"
+
+// layoutPolicy holds the breakpoints, defaults applied.
+type layoutPolicy struct {
+	splitWidth   int
+	stackHeight  int // Negative never stacks
+	compactWidth int
+	minWidth     int
+	minHeight    int
+}
+
+// newLayoutPolicy applies the defaults to the zero fields of c.
+func newLayoutPolicy(c config.LayoutConfig) layoutPolicy {
+	or := func(v, def int) int {
+		if v == 0 {
+			return def
+		}
+		return v
+	}
+	return layoutPolicy{
+		splitWidth:   or(c.SplitWidth, SplitViewThreshold),
+		stackHeight:  or(c.StackHeight, defaultStackHeight),
+		compactWidth: or(c.CompactWidth, defaultCompactWidth),
+		minWidth:     or(c.MinWidth, defaultMinWidth),
+		minHeight:    or(c.MinHeight, defaultMinHeight),
+	}
+}
+
+// ValidateLayout checks ui.layout: sizes must be positive, stack_height may
+// be -1, and min_width ≤ compact_width ≤ split_width once defaults apply.
+func ValidateLayout(c config.LayoutConfig) error {
+	for _, v := range []struct {
+		name  string
+		value int
+	}{
+		{"split_width", c.SplitWidth},
+		{"compact_width", c.CompactWidth},
+		{"min_width", c.MinWidth},
+		{"min_height", c.MinHeight},
+	} {
+		if v.value < 0 {
+			return fmt.Errorf("%s must be positive, got %d", v.name, v.value)
+		}
+	}
+	if c.StackHeight < -1 {
+		return fmt.Errorf("stack_height must be positive, or -1 to never stack, got %d", c.StackHeight)
+	}
+	p := newLayoutPolicy(c)
+	if p.minWidth > p.compactWidth {
+		return fmt.Errorf("min_width %d is wider than compact_width %d", p.minWidth, p.compactWidth)
+	}
+	if p.compactWidth > p.splitWidth {
+		return fmt.Errorf("compact_width %d is wider than split_width %d", p.compactWidth, p.splitWidth)
+	}
+	return nil
+}
+
+// SetLayout sets the breakpoints of the main screen. Check them with
+// ValidateLayout first.
+func (m *Model) SetLayout(c config.LayoutConfig) {
+	m.layout = newLayoutPolicy(c)
+	m.layoutCache = nil
+}
+
+// arrange returns how a width×height terminal shows the list and details:
+// split side by side or, when stacked, one above the other; neither means
+// one at a time.
+func (p layoutPolicy) arrange(width, height int) (split, stacked bool) {
+	if width > p.splitWidth {
+		return true, false
+	}
+	if p.stackHeight > 0 && height >= p.stackHeight {
+		return true, true
+	}
+	return false, false
+}
+
+// layoutView names the ways the main screen lays out its panels.
+type layoutView int
+
+const (
+	panelsView     layoutView = iota // Split, stacked or single by size
+	accessibleView                   // One panel at a time, for screen readers
+)
+
+// layoutKey is what a panelLayout depends on besides the breakpoints.
+type layoutKey struct {
+	width, height int
+	view          layoutView
+}
+
+// panelLayout is where the main screen puts the list and details.
+type panelLayout struct {
+	split, stacked bool
+	body           int // Rows above the footer
+	listWidth      int
+	listHeight     int
+	detailWidth    int // Also the width the details wrap at
+	detailHeight   int
+}
+
+// layoutCacheSize bounds the layouts Model.panelLayout remembers; a window
+// dragged across many sizes starts the cache over.
+const layoutCacheSize = 64
+
+// panels lays out the list and details for key.
+func (p layoutPolicy) panels(key layoutKey) panelLayout {
+	l := panelLayout{body: max(key.height-1, 5)} // Keep a row for the footer
+	if key.view == panelsView {
+		l.split, l.stacked = p.arrange(key.width, key.height)
+	}
+	switch {
+	case l.stacked:
+		// Both panels span the width, border(2)+padding(2) = 4 overhead;
+		// the list takes two fifths of the height, the details the rest
+		available := max(l.body-4, 6)
+		listPanelHeight := available * 2 / 5
+		l.listWidth = max(key.width-4, 10)
+		l.listHeight = listPanelHeight - 2
+		l.detailWidth = l.listWidth
+		l.detailHeight = available - listPanelHeight
+	case l.split:
+		// Two panels with borders(2)+padding(2) = 4 overhead each; the list
+		// fits its header (1) and page line (1) inside its border
+		availWidth := max(key.width-8, 10)
+		l.listWidth = int(float64(availWidth) * 0.4)
+		l.listHeight = max(l.body-4, 3)
+		l.detailWidth = availWidth - l.listWidth
+		l.detailHeight = l.body - 2
+	default:
+		l.listWidth = key.width
+		l.listHeight = max(l.body-2, 3)
+		l.detailWidth = key.width
+		l.detailHeight = l.body - 1
+	}
+	return l
+}
+
+// panelLayout returns the layout for a width×height terminal, from the
+// cache when this size was laid out before.
+func (m *Model) panelLayout(width, height int) panelLayout {
+	key := layoutKey{width: width, height: height}
+	if m.accessible {
+		key.view = accessibleView
+	}
+	if l, ok := m.layoutCache[key]; ok {
+		return l
+	}
+	if len(m.layoutCache) >= layoutCacheSize || m.layoutCache == nil {
+		m.layoutCache = make(map[layoutKey]panelLayout)
+	}
+	l := m.layout.panels(key)
+	m.layoutCache[key] = l
+	return l
+}
+
+// compact reports whether width calls for compact rows and status bar.
+func (p layoutPolicy) compact(width int) bool {
+	return width < p.compactWidth
+}
+
+// tooSmall reports whether a width×height terminal is below the minimum.
+func (p layoutPolicy) tooSmall(width, height int) bool {
+	return width < p.minWidth || height < p.minHeight
+}
+
+// renderTooSmall is the screen shown instead of a layout that would wrap.
+func (m Model) renderTooSmall() string {
+	t := m.theme
+	title := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true).Render(i18n.T("Terminal too small"))
+	text := t.Renderer.NewStyle().Foreground(t.Secondary).Render(i18n.Tf(
+		"bv needs %d×%d,\nthis is %d×%d.\n\nEnlarge the window\nor shrink the font.\n\nctrl+c quits",
+		m.layout.minWidth, m.layout.minHeight, m.width, m.height))
+	body := lipgloss.JoinVertical(lipgloss.Center, title, "", text)
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, body,
+		lipgloss.WithWhitespaceChars(" "))
+}
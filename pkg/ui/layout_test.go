@@ -0,0 +1,227 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func resizeTo(m Model, width, height int) Model {
+	newM, _ := m.Update(tea.WindowSizeMsg{Width: width, Height: height})
+	return newM.(Model)
+}
+
+func TestValidateLayout(t *testing.T) {
+	valid := []config.LayoutConfig{
+		{},
+		{SplitWidth: 120, StackHeight: -1},
+		{CompactWidth: 60, MinWidth: 30, MinHeight: 8},
+	}
+	for _, c := range valid {
+		if err := ValidateLayout(c); err != nil {
+			t.Errorf("%+v: unexpected error %v", c, err)
+		}
+	}
+	invalid := map[string]config.LayoutConfig{
+		"split_width":    {SplitWidth: -5},
+		"stack_height":   {StackHeight: -2},
+		"min_width 90":   {MinWidth: 90},
+		"split_width 70": {SplitWidth: 70},
+	}
+	for want, c := range invalid {
+		if err := ValidateLayout(c); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%+v: expected an error mentioning %q, got %v", c, want, err)
+		}
+	}
+}
+
+func TestLayoutPolicy_Arrange(t *testing.T) {
+	p := newLayoutPolicy(config.LayoutConfig{})
+	cases := []struct {
+		width, height  int
+		split, stacked bool
+	}{
+		{120, 30, true, false},
+		{90, 45, true, true},
+		{90, 30, false, false},
+		{100, 40, true, true},
+	}
+	for _, tc := range cases {
+		split, stacked := p.arrange(tc.width, tc.height)
+		if split != tc.split || stacked != tc.stacked {
+			t.Errorf("%dx%d: got split=%v stacked=%v, want %v %v", tc.width, tc.height, split, stacked, tc.split, tc.stacked)
+		}
+	}
+
+	never := newLayoutPolicy(config.LayoutConfig{StackHeight: -1})
+	if split, _ := never.arrange(90, 80); split {
+		t.Error("expected stack_height -1 never to stack")
+	}
+	if !p.compact(79) || p.compact(80) {
+		t.Error("expected compact below 80 columns")
+	}
+	if !p.tooSmall(39, 20) || !p.tooSmall(60, 9) || p.tooSmall(40, 10) {
+		t.Error("expected too small below 40x10")
+	}
+}
+
+func TestLayout_StackedDetails(t *testing.T) {
+	m := resizeTo(commandTestModel(t), 90, 45)
+	if !m.isSplitView || !m.stackedView {
+		t.Fatalf("expected a stacked split view at 90x45, got split=%v stacked=%v", m.isSplitView, m.stackedView)
+	}
+
+	selected := m.list.SelectedItem().(IssueItem).Issue.Title
+	lines := strings.Split(m.View(), "\n")
+	if len(lines) > 45 {
+		t.Errorf("expected at most 45 lines, got %d", len(lines))
+	}
+	header, details := -1, -1
+	for i, line := range lines {
+		if w := ansi.StringWidth(line); w > 90 {
+			t.Errorf("line %d is %d cells wide: %q", i, w, ansi.Strip(line))
+		}
+		plain := ansi.Strip(line)
+		if header < 0 && strings.Contains(plain, "TYPE PRI") {
+			header = i
+		}
+		if details < 0 && strings.HasPrefix(strings.TrimLeft(plain, "│ "), "• "+selected) {
+			details = i
+		}
+	}
+	if header < 0 || details <= header {
+		t.Fatalf("expected the list above the details:\n%s", ansi.Strip(strings.Join(lines, "\n")))
+	}
+
+	m.SetLayout(config.LayoutConfig{StackHeight: -1})
+	m = resizeTo(m, 90, 45)
+	if m.isSplitView || m.stackedView {
+		t.Error("expected one view at a time when stacking is off")
+	}
+}
+
+func TestLayout_TooSmall(t *testing.T) {
+	m := resizeTo(commandTestModel(t), 30, 8)
+	view := ansi.Strip(m.View())
+	if !strings.Contains(view, "Terminal too small") || !strings.Contains(view, "40×10") || !strings.Contains(view, "30×8") {
+		t.Errorf("expected the minimum size screen, got:\n%s", view)
+	}
+
+	m = resizeTo(m, 40, 10)
+	if strings.Contains(ansi.Strip(m.View()), "Terminal too small") {
+		t.Error("expected the UI back at the minimum size")
+	}
+}
+
+func TestLayout_CompactRows(t *testing.T) {
+	m := resizeTo(commandTestModel(t), 60, 20)
+	view := ansi.Strip(m.View())
+	if strings.Contains(view, "OPEN") || !strings.Contains(view, "🔵") {
+		t.Errorf("expected status icons in compact rows, got:\n%s", view)
+	}
+	if !strings.Contains(view, compactListHeader) {
+		t.Errorf("expected the compact list header, got:\n%s", view)
+	}
+}
+
+func TestStatusBar_FitsNarrowTerminals(t *testing.T) {
+	m := commandTestModel(t)
+	m.macros.recording = "a"
+	for _, width := range []int{40, 50, 60, 79, 80, 100, 120} {
+		m = resizeTo(m, width, 30)
+		footer := m.renderFooter()
+		if strings.Contains(footer, "\n") {
+			t.Errorf("width %d: expected a one-line footer, got %q", width, ansi.Strip(footer))
+		}
+		if w := lipgloss.Width(footer); w > width {
+			t.Errorf("width %d: footer is %d cells wide: %q", width, w, ansi.Strip(footer))
+		}
+		if !strings.Contains(footer, "ALL") {
+			t.Errorf("width %d: expected the filter to stay, got %q", width, ansi.Strip(footer))
+		}
+		if width >= 60 && !strings.Contains(footer, "recording a") {
+			t.Errorf("width %d: expected the recording to stay, got %q", width, ansi.Strip(footer))
+		}
+	}
+}
+
+func TestPanelLayout_Cache(t *testing.T) {
+	m := commandTestModel(t)
+	first := m.panelLayout(100, 30)
+	if got := m.panelLayout(100, 30); got != first {
+		t.Fatalf("cached layout %+v differs from %+v", got, first)
+	}
+	if len(m.layoutCache) != 2 { // 120x40 from the first resize
+		t.Fatalf("expected 2 cached layouts, got %d", len(m.layoutCache))
+	}
+
+	m.accessible = true
+	if l := m.panelLayout(100, 30); l.split || l.detailWidth != 100 {
+		t.Errorf("accessible layout should show one panel at the full width, got %+v", l)
+	}
+	if len(m.layoutCache) != 3 {
+		t.Errorf("accessible layout should be cached apart, got %d entries", len(m.layoutCache))
+	}
+
+	m.SetLayout(config.LayoutConfig{SplitWidth: 90})
+	if m.layoutCache != nil {
+		t.Error("SetLayout should drop the cached layouts")
+	}
+	for w := range layoutCacheSize + 10 {
+		m.panelLayout(40+w, 30)
+	}
+	if len(m.layoutCache) > layoutCacheSize {
+		t.Errorf("cache grew to %d, above %d", len(m.layoutCache), layoutCacheSize)
+	}
+}
+
+func TestResize_HeightOnlyKeepsScrollAndRenderer(t *testing.T) {
+	m := commandTestModel(t)
+	m.viewport.SetContent(strings.Repeat("line\n", 200))
+	m.viewport.SetYOffset(10)
+	renderer := m.renderer.renderer
+	m.resizing = resizeState{}
+
+	m = resizeTo(m, 120, 36)
+	if m.viewport.YOffset != 10 {
+		t.Errorf("scroll position reset to %d", m.viewport.YOffset)
+	}
+	if m.renderer.renderer != renderer {
+		t.Error("a height-only resize should not rebuild the markdown renderer")
+	}
+	if m.viewport.Height != m.panelLayout(120, 36).detailHeight {
+		t.Errorf("viewport height %d not resized", m.viewport.Height)
+	}
+}
+
+func TestResize_StormWrapsDetailsOnceSettled(t *testing.T) {
+	m := commandTestModel(t)
+	before := m.renderer.width
+
+	var seqs []int
+	for _, w := range []int{110, 100, 130} {
+		m = resizeTo(m, w, 40)
+		seqs = append(seqs, m.resizing.seq)
+	}
+	if m.renderer.width != before || !m.resizing.pending {
+		t.Fatalf("details rewrapped to %d during the storm", m.renderer.width)
+	}
+	if m.list.Width() != m.panelLayout(130, 40).listWidth {
+		t.Errorf("list width %d not resized during the storm", m.list.Width())
+	}
+
+	newM, _ := m.Update(resizeSettledMsg{seq: seqs[0]})
+	m = newM.(Model)
+	if m.renderer.width != before {
+		t.Error("a stale settle should not rewrap the details")
+	}
+	newM, _ = m.Update(resizeSettledMsg{seq: seqs[2]})
+	m = newM.(Model)
+	if want := m.panelLayout(130, 40).detailWidth; m.renderer.width != want || m.resizing.pending {
+		t.Errorf("details wrap at %d after settling, want %d", m.renderer.width, want)
+	}
+}
@@ -0,0 +1,184 @@
+package ui
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/bubbles/list"
+)
+
+// Large databases (50k+ issues) keep the list responsive through three caches:
+//
+//   - IssueItem precomputes its FilterValue when built, so fuzzy filtering
+//     does not rebuild 50k strings on every keystroke.
+//   - incrementalFuzzyFilter narrows the previous result set when the query
+//     only grew, instead of rescanning every issue.
+//   - rowRenderCache memoizes rendered list rows. The list only renders the
+//     visible page; the cache keeps recently shown rows around it, so paging
+//     back and forth does not re-run lipgloss layout.
+
+// maxCachedRows bounds rowRenderCache. A few pages' worth is enough to cover
+// the visible window plus the rows just scrolled past.
+const maxCachedRows = 512
+
+// rowRenderCache maps a row fingerprint to its rendered string. It is shared
+// by pointer between Model copies and the delegate values rebuilt from them.
+type rowRenderCache struct {
+	mu   sync.Mutex
+	rows map[string]string
+}
+
+func newRowRenderCache() *rowRenderCache {
+	return &rowRenderCache{rows: make(map[string]string)}
+}
+
+func (c *rowRenderCache) get(key string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	row, ok := c.rows[key]
+	return row, ok
+}
+
+func (c *rowRenderCache) put(key, row string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.rows) >= maxCachedRows {
+		// Cheaper than LRU bookkeeping; the next frame refills the visible page.
+		c.rows = make(map[string]string, maxCachedRows)
+	}
+	c.rows[key] = row
+}
+
+// rowCacheKey fingerprints everything IssueDelegate.Render reads for a row.
+// Selected rows are never cached, so selection is not part of the key.
+func (d IssueDelegate) rowCacheKey(i IssueItem, width int) string {
+	var sb strings.Builder
+	sb.Grow(128)
+	sb.WriteString(strconv.Itoa(width))
+	sb.WriteByte('|')
+	sb.WriteString(i.Issue.ID)
+	sb.WriteByte('|')
+	sb.WriteString(i.Issue.Title)
+	sb.WriteByte('|')
+	sb.WriteString(string(i.Issue.Status))
+	sb.WriteByte('|')
+	sb.WriteString(string(i.Issue.IssueType))
+	sb.WriteByte('|')
+	sb.WriteString(strconv.Itoa(i.Issue.Priority))
+	sb.WriteByte('|')
+	sb.WriteString(i.Issue.Assignee)
+	sb.WriteByte('|')
+	sb.WriteString(strings.Join(i.Issue.Labels, ","))
+	sb.WriteByte('|')
+	// Relative age is rendered, so the key changes as the row ages.
+	sb.WriteString(FormatTimeRel(i.Issue.CreatedAt, clockNow(d.Now)))
+	sb.WriteByte('|')
+	sb.WriteString(d.agingBadge(&i.Issue))
+	sb.WriteByte('|')
+	sb.WriteString(strconv.Itoa(len(i.Issue.Comments)))
+	sb.WriteByte('|')
+	sb.WriteString(strconv.FormatFloat(i.GraphScore, 'g', 4, 64))
+	sb.WriteByte('|')
+	sb.WriteString(i.RepoPrefix)
+	sb.WriteByte('|')
+	sb.WriteString(strconv.Itoa(int(i.DiffStatus)))
+	sb.WriteByte('|')
+	sb.WriteString(strconv.FormatBool(i.IsQuickWin))
+	sb.WriteString(strconv.FormatBool(i.IsBlocker))
+	sb.WriteString(strconv.Itoa(i.UnblocksCount))
+	sb.WriteByte('|')
+	if d.ShowSearchScores && i.SearchScoreSet {
+		sb.WriteString(strconv.FormatFloat(i.SearchScore, 'f', 2, 64))
+	}
+	sb.WriteByte('|')
+	sb.WriteString(strconv.FormatBool(d.WorkspaceMode))
+	sb.WriteString(strconv.FormatBool(d.Compact))
+	sb.WriteString(d.presenceMark(i.Issue.ID))
+	sb.WriteString(strconv.FormatBool(d.Critical[i.Issue.ID]))
+	sb.WriteString(strconv.FormatBool(d.Watches.Watching(i.Issue.ID)))
+	sb.WriteString(strconv.FormatBool(d.Pinned[i.Issue.ID]))
+	sb.WriteString(strconv.FormatBool(d.External[i.Issue.ID]))
+	sb.WriteByte('|')
+	sb.WriteString(strconv.FormatBool(d.ShowPriorityHints))
+	if d.ShowPriorityHints {
+		if hint, ok := d.PriorityHints[i.Issue.ID]; ok {
+			sb.WriteString(hint.Direction)
+		}
+	}
+	return sb.String()
+}
+
+// incrementalFuzzyFilter wraps list.DefaultFilter. Fuzzy matching is a
+// subsequence test, so every target matching "abc" also matches "ab": when
+// the new term extends the previous one over the same targets, only the
+// previous matches need to be searched. Filtering runs in a tea.Cmd
+// goroutine, hence the mutex.
+type incrementalFuzzyFilter struct {
+	mu      sync.Mutex
+	term    string
+	targets []string
+	matches []int // Indexes into targets matched by term, in target order
+}
+
+// Filter implements list.FilterFunc.
+func (f *incrementalFuzzyFilter) Filter(term string, targets []string) []list.Rank {
+	f.mu.Lock()
+	candidates := f.candidatesLocked(term, targets)
+	f.mu.Unlock()
+
+	var ranks []list.Rank
+	if candidates == nil {
+		ranks = list.DefaultFilter(term, targets)
+	} else {
+		subset := make([]string, len(candidates))
+		for i, idx := range candidates {
+			subset[i] = targets[idx]
+		}
+		ranks = list.DefaultFilter(term, subset)
+		for i := range ranks {
+			ranks[i].Index = candidates[ranks[i].Index]
+		}
+	}
+
+	matches := make([]int, len(ranks))
+	for i, r := range ranks {
+		matches[i] = r.Index
+	}
+	// Keep target order so a narrowed search ranks ties exactly like a full one.
+	sort.Ints(matches)
+
+	f.mu.Lock()
+	f.term = term
+	f.targets = targets
+	f.matches = matches
+	f.mu.Unlock()
+	return ranks
+}
+
+// candidatesLocked returns the indexes worth searching for term, or nil to
+// search everything.
+func (f *incrementalFuzzyFilter) candidatesLocked(term string, targets []string) []int {
+	if f.term == "" || len(term) <= len(f.term) || !strings.HasPrefix(term, f.term) {
+		return nil
+	}
+	if len(targets) != len(f.targets) {
+		return nil
+	}
+	// The list rebuilds the targets slice for every query, so compare
+	// contents. With cached FilterValues the strings share storage and
+	// this is a pointer comparison per entry in the common case.
+	for i := range targets {
+		if targets[i] != f.targets[i] {
+			return nil
+		}
+	}
+	return f.matches
+}
@@ -0,0 +1,128 @@
+package ui
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/testutil"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestIssueItem_CachedFilterValueMatchesBuilt(t *testing.T) {
+	item := IssueItem{
+		Issue: model.Issue{
+			ID: "api-7", Title: "Fix login", Status: model.StatusOpen, IssueType: model.TypeBug,
+			Assignee: "sam", Labels: []string{"auth", "p0"},
+		},
+		RepoPrefix: "api",
+	}
+	want := item.FilterValue()
+	item.cacheFilterValue()
+	if item.filterValue != want || item.FilterValue() != want {
+		t.Fatalf("cached %q, want %q", item.filterValue, want)
+	}
+}
+
+func TestIncrementalFuzzyFilter_MatchesFullFilter(t *testing.T) {
+	targets := []string{
+		"Fix login bug api-1", "Login page redesign web-2", "Logging cleanup api-3",
+		"Billing export api-4", "Lint config web-5", "login timeout api-6",
+	}
+	f := &incrementalFuzzyFilter{}
+	for _, term := range []string{"l", "lo", "log", "logi", "login", "lo", "lint", "xyz", "xyzq"} {
+		got := f.Filter(term, targets)
+		want := list.DefaultFilter(term, targets)
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("term %q: incremental %v, full %v", term, got, want)
+		}
+	}
+}
+
+func TestIncrementalFuzzyFilter_RescansWhenTargetsChange(t *testing.T) {
+	f := &incrementalFuzzyFilter{}
+	f.Filter("lo", []string{"login", "other"})
+
+	targets := []string{"other", "login"}
+	got := f.Filter("log", targets)
+	if len(got) != 1 || got[0].Index != 1 {
+		t.Fatalf("expected a full rescan over new targets, got %v", got)
+	}
+}
+
+func TestRowRenderCache_ReusesAndInvalidates(t *testing.T) {
+	cache := newRowRenderCache()
+	d := IssueDelegate{Theme: DefaultTheme(lipgloss.NewRenderer(nil)), rowCache: cache}
+	items := []list.Item{
+		IssueItem{Issue: model.Issue{ID: "A-1", Title: "Selected", Status: model.StatusOpen}},
+		IssueItem{Issue: model.Issue{ID: "A-2", Title: "First title", Status: model.StatusOpen}},
+	}
+	l := list.New(items, d, 100, 10)
+
+	render := func(idx int, item list.Item) string {
+		var buf bytes.Buffer
+		d.Render(&buf, l, idx, item)
+		return buf.String()
+	}
+
+	render(0, items[0])
+	if len(cache.rows) != 0 {
+		t.Fatal("selected rows must not be cached")
+	}
+
+	first := render(1, items[1])
+	if len(cache.rows) != 1 || render(1, items[1]) != first {
+		t.Fatal("expected unselected row to be cached and reused")
+	}
+
+	renamed := items[1].(IssueItem)
+	renamed.Issue.Title = "Second title"
+	if got := render(1, renamed); got == first || len(cache.rows) != 2 {
+		t.Fatal("expected a changed title to miss the cache")
+	}
+}
+
+func TestRowRenderCache_Bounded(t *testing.T) {
+	cache := newRowRenderCache()
+	for i := 0; i < maxCachedRows+10; i++ {
+		cache.put(fmt.Sprintf("k%d", i), "row")
+	}
+	if len(cache.rows) > maxCachedRows {
+		t.Fatalf("cache grew to %d rows", len(cache.rows))
+	}
+}
+
+// BenchmarkListScroll measures one scroll step (update + render) in the list
+// view on a large database.
+func BenchmarkListScroll(b *testing.B) {
+	issues := testutil.QuickRandom(50000, 0.0001)
+	m := NewModel(issues, nil, "")
+	tm, _ := m.Update(tea.WindowSizeMsg{Width: 100, Height: 50})
+	m = tm.(Model)
+
+	down := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tm, _ = m.Update(down)
+		m = tm.(Model)
+		_ = m.View()
+	}
+}
+
+// BenchmarkApplyFilter50k measures rebuilding the list for a status filter.
+func BenchmarkApplyFilter50k(b *testing.B) {
+	issues := testutil.QuickRandom(50000, 0.0001)
+	m := NewModel(issues, nil, "")
+	m.currentFilter = "open"
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m.applyFilter()
+	}
+}
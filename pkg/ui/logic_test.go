@@ -175,7 +175,7 @@ func TestFormatTimeRel(t *testing.T) {
 	}
 
 	for _, tt := range tests {
-		got := FormatTimeRel(tt.t)
+		got := FormatTimeRel(tt.t, now)
 		if got != tt.expected {
 			t.Errorf("FormatTimeRel(%v): expected %s, got %s", tt.t, tt.expected, got)
 		}
@@ -0,0 +1,214 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+)
+
+// Macros
+//
+// Like Vim's registers, but for ":" commands: Q followed by a letter starts
+// recording the commands run at the prompt or through ui.keys into that
+// register, and Q stops (q is taken by quit). ":play a" runs them again and
+// ":each a" runs them once for every issue in the list, selecting each in
+// turn, which makes the filtered list the set a macro is applied to.
+// Macros last for the session; the status line shows what was recorded, to
+// keep as an alias.
+
+// macroCommands are not recorded: they drive the recorder itself.
+var macroCommands = map[string]bool{"record": true, "play": true, "each": true}
+
+// macroRecorder holds the registers.
+type macroRecorder struct {
+	registers map[string][]string
+	recording string   // Register being recorded into; empty when not
+	lines     []string // Recorded so far
+	awaiting  bool     // Q was pressed; the next key names the register
+	playing   bool     // A macro is running; macros do not nest
+}
+
+func newMacroRecorder() *macroRecorder {
+	return &macroRecorder{registers: make(map[string][]string)}
+}
+
+// validRegister reports whether name can hold a macro: a single letter.
+func validRegister(name string) bool {
+	return len(name) == 1 && name[0] >= 'a' && name[0] <= 'z'
+}
+
+// registerArg checks that the first argument names a register.
+func registerArg(args []string) error {
+	if len(args) == 0 || !validRegister(args[0]) {
+		return fmt.Errorf("expected a register a-z")
+	}
+	return nil
+}
+
+// record adds a command run at the prompt to the macro being recorded.
+func (r *macroRecorder) record(line string) {
+	if r == nil || r.recording == "" || r.playing {
+		return
+	}
+	fields := splitCommandLine(line)
+	if len(fields) == 0 || macroCommands[strings.ToLower(fields[0])] {
+		return
+	}
+	r.lines = append(r.lines, strings.Join(fields, " "))
+}
+
+// names returns the registers holding a macro.
+func (r *macroRecorder) names() []string {
+	if r == nil {
+		return nil
+	}
+	names := make([]string, 0, len(r.registers))
+	for name := range r.registers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// startRecording records into register, replacing what it held.
+func (m Model) startRecording(register string) (Model, error) {
+	if !validRegister(register) {
+		return m, fmt.Errorf("register must be a letter a-z, got %q", register)
+	}
+	if m.macros.recording != "" {
+		return m, fmt.Errorf("already recording into %s; Q or :record stops", m.macros.recording)
+	}
+	m.macros.recording = register
+	m.macros.lines = nil
+	m.statusMsg = i18n.Tf("Recording into %s: run commands at the : prompt, Q to stop", register)
+	m.statusIsError = false
+	return m, nil
+}
+
+// stopRecording saves the recorded commands into their register.
+func (m Model) stopRecording() (Model, error) {
+	r := m.macros
+	if r.recording == "" {
+		return m, fmt.Errorf("not recording")
+	}
+	register, lines := r.recording, r.lines
+	r.recording, r.lines = "", nil
+	if len(lines) == 0 {
+		delete(r.registers, register)
+		m.statusMsg = i18n.Tf("Nothing recorded; register %s is empty", register)
+	} else {
+		r.registers[register] = lines
+		m.statusMsg = i18n.Nf("Recorded %d command into %s: %s|Recorded %d commands into %s: %s", len(lines), register, strings.Join(lines, "; "))
+	}
+	m.statusIsError = false
+	return m, nil
+}
+
+// playMacro runs the macro in register count times, stopping at the first
+// failing command.
+func (m Model) playMacro(register string, count int) (Model, tea.Cmd, error) {
+	lines, err := m.macroLines(register)
+	if err != nil {
+		return m, nil, err
+	}
+	m.macros.playing = true
+	defer func() { m.macros.playing = false }()
+
+	var cmds []tea.Cmd
+	for i := 0; i < count; i++ {
+		for _, line := range lines {
+			var cmd tea.Cmd
+			m, cmd, err = m.execPromptLine(line)
+			cmds = append(cmds, cmd)
+			if err != nil {
+				return m, tea.Batch(cmds...), fmt.Errorf("macro %s: %w", register, err)
+			}
+		}
+	}
+	return m, tea.Batch(cmds...), nil
+}
+
+// eachMacro runs the macro in register once for every issue in the list,
+// with that issue selected. The issues are those listed when it starts.
+func (m Model) eachMacro(register string) (Model, tea.Cmd, error) {
+	if _, err := m.macroLines(register); err != nil {
+		return m, nil, err
+	}
+	var ids []string
+	for _, it := range m.list.Items() {
+		if item, ok := it.(IssueItem); ok {
+			ids = append(ids, item.Issue.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return m, nil, fmt.Errorf("no issues in the list")
+	}
+
+	var cmds []tea.Cmd
+	for _, id := range ids {
+		var cmd tea.Cmd
+		var err error
+		m, cmd, err = m.ExecCommand("select " + id)
+		if err == nil {
+			m, cmd, err = m.playMacro(register, 1)
+		}
+		cmds = append(cmds, cmd)
+		if err != nil {
+			return m, tea.Batch(cmds...), fmt.Errorf("%s: %w", id, err)
+		}
+	}
+	m.statusMsg = i18n.Tf("Ran macro %s on %d issues", register, len(ids))
+	m.statusIsError = false
+	return m, tea.Batch(cmds...), nil
+}
+
+func (m Model) macroLines(register string) ([]string, error) {
+	if m.macros.playing {
+		return nil, fmt.Errorf("macros cannot run macros")
+	}
+	if m.macros.recording == register {
+		return nil, fmt.Errorf("register %s is being recorded", register)
+	}
+	lines, ok := m.macros.registers[register]
+	if !ok {
+		return nil, fmt.Errorf("register %s is empty; Q%s records into it", register, register)
+	}
+	return lines, nil
+}
+
+// handleMacroKeys handles Q and the register letter after it. It reports
+// whether msg was one of them.
+func (m Model) handleMacroKeys(msg tea.KeyMsg) (Model, bool) {
+	r := m.macros
+	if r == nil {
+		return m, false
+	}
+	if r.awaiting {
+		r.awaiting = false
+		if msg.Type == tea.KeyEsc {
+			m.statusMsg = ""
+			return m, true
+		}
+		var err error
+		if m, err = m.startRecording(msg.String()); err != nil {
+			m.statusMsg = i18n.Tf("Not recording: %v", err)
+			m.statusIsError = true
+		}
+		return m, true
+	}
+	if msg.String() != "Q" {
+		return m, false
+	}
+	if r.recording != "" {
+		m, _ = m.stopRecording()
+		return m, true
+	}
+	r.awaiting = true
+	m.statusMsg = i18n.T("Record into register (a-z)…")
+	m.statusIsError = false
+	return m, true
+}
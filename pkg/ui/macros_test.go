@@ -0,0 +1,84 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestMacros_RecordAndPlay(t *testing.T) {
+	m := commandTestModel(t)
+
+	m = pressKey(m, runeKey("Q"))
+	m = pressKey(m, runeKey("a"))
+	if m.macros.recording != "a" {
+		t.Fatalf("expected Q a to record into a, status %q", m.statusMsg)
+	}
+	m.statusMsg = ""
+	if view := m.View(); !strings.Contains(view, "recording a") {
+		t.Errorf("expected the footer to show the recording:\n%s", view)
+	}
+	m = typeCommand(m, "filter priority:0")
+	m = typeCommand(m, "bogus") // Failing commands are not recorded
+	m = typeCommand(m, "A-2")
+	m = typeCommand(m, "view board")
+	m = pressKey(m, runeKey("Q"))
+	if got := strings.Join(m.macros.registers["a"], "; "); got != "filter priority:0; A-2; view board" {
+		t.Fatalf("unexpected macro %q", got)
+	}
+	if !strings.Contains(m.statusMsg, "Recorded 3 commands into a") {
+		t.Errorf("expected the recorded commands in the status, got %q", m.statusMsg)
+	}
+
+	m = typeCommand(m, "view list")
+	m = typeCommand(m, "filter all")
+	m = typeCommand(m, "play a")
+	if m.CurrentContext() != ContextBoard || m.currentFilter != "priority:0" {
+		t.Errorf("expected the macro to filter and open the board, got %s with %q", m.CurrentContext(), m.currentFilter)
+	}
+
+	m = typeCommand(m, "play b")
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "register b is empty") {
+		t.Errorf("expected an empty register to fail, got %q", m.statusMsg)
+	}
+
+	// esc after Q records nothing.
+	m = pressKey(m, runeKey("Q"))
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.macros.recording != "" || m.macros.awaiting {
+		t.Error("expected esc to cancel recording")
+	}
+}
+
+func TestMacros_EachIssue(t *testing.T) {
+	m := commandTestModel(t)
+	if err := m.EnableWatches(filepath.Join(t.TempDir(), "watches.json")); err != nil {
+		t.Fatal(err)
+	}
+	m = typeCommand(m, "record w")
+	m = typeCommand(m, "watch")
+	m = typeCommand(m, "record")
+
+	m = typeCommand(m, "filter open")
+	m = typeCommand(m, "each w")
+	if m.statusIsError {
+		t.Fatalf("each failed: %s", m.statusMsg)
+	}
+	for id, want := range map[string]bool{"A-1": true, "A-2": true, "A-3": false} {
+		if got := m.watches.Watching(id); got != want {
+			t.Errorf("%s watched = %v, want %v", id, got, want)
+		}
+	}
+
+	// Macros cannot run macros, even through an alias.
+	m.SetAliases(map[string]string{"again": "each w"})
+	m = typeCommand(m, "record r")
+	m = typeCommand(m, "again")
+	m = typeCommand(m, "record")
+	m = typeCommand(m, "play r")
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "macros cannot run macros") {
+		t.Errorf("expected nested macros to fail, got %q", m.statusMsg)
+	}
+}
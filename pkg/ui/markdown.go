@@ -7,7 +7,8 @@ import (
 )
 
 // MarkdownRenderer provides theme-aware markdown rendering using glamour.
-// It detects the terminal's color scheme and uses appropriate styles.
+// It detects the terminal's color scheme and uses appropriate styles, with no
+// more colors than lipgloss renders (glamour itself assumes true color).
 type MarkdownRenderer struct {
 	renderer  *glamour.TermRenderer
 	width     int
@@ -32,6 +33,7 @@ func NewMarkdownRenderer(width int) *MarkdownRenderer {
 	renderer, _ := glamour.NewTermRenderer(
 		glamour.WithStylePath(styleName),
 		glamour.WithWordWrap(width),
+		glamour.WithColorProfile(lipgloss.ColorProfile()),
 	)
 
 	return &MarkdownRenderer{
@@ -52,6 +54,7 @@ func NewMarkdownRendererWithTheme(width int, theme Theme) *MarkdownRenderer {
 	renderer, err := glamour.NewTermRenderer(
 		glamour.WithStyles(styleConfig),
 		glamour.WithWordWrap(width),
+		glamour.WithColorProfile(lipgloss.ColorProfile()),
 	)
 	if err != nil {
 		// Fall back to built-in style if custom theme fails
@@ -64,6 +67,7 @@ func NewMarkdownRendererWithTheme(width int, theme Theme) *MarkdownRenderer {
 		renderer, _ = glamour.NewTermRenderer(
 			glamour.WithStylePath(styleName),
 			glamour.WithWordWrap(width),
+			glamour.WithColorProfile(lipgloss.ColorProfile()),
 		)
 	}
 
@@ -98,6 +102,7 @@ func (mr *MarkdownRenderer) SetWidth(width int) {
 		if r, err := glamour.NewTermRenderer(
 			glamour.WithStyles(styleConfig),
 			glamour.WithWordWrap(width),
+			glamour.WithColorProfile(lipgloss.ColorProfile()),
 		); err == nil {
 			mr.renderer = r
 			mr.width = width
@@ -116,6 +121,7 @@ func (mr *MarkdownRenderer) SetWidth(width int) {
 	if r, err := glamour.NewTermRenderer(
 		glamour.WithStylePath(styleName),
 		glamour.WithWordWrap(width),
+		glamour.WithColorProfile(lipgloss.ColorProfile()),
 	); err == nil {
 		mr.renderer = r
 		mr.width = width
@@ -137,6 +143,7 @@ func (mr *MarkdownRenderer) SetWidthWithTheme(width int, theme Theme) {
 	r, err := glamour.NewTermRenderer(
 		glamour.WithStyles(styleConfig),
 		glamour.WithWordWrap(width),
+		glamour.WithColorProfile(lipgloss.ColorProfile()),
 	)
 	if err != nil {
 		// Fall back to built-in style if custom theme fails
@@ -149,6 +156,7 @@ func (mr *MarkdownRenderer) SetWidthWithTheme(width int, theme Theme) {
 		r, _ = glamour.NewTermRenderer(
 			glamour.WithStylePath(styleName),
 			glamour.WithWordWrap(width),
+			glamour.WithColorProfile(lipgloss.ColorProfile()),
 		)
 	}
 	if r != nil {
@@ -2,7 +2,9 @@ package ui
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -14,10 +16,14 @@ import (
 	"github.com/Dicklesworthstone/beads_viewer/pkg/agents"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/baseline"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/beadsview"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/cass"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/correlation"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/drift"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/export"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/hooks"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/instance"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
@@ -28,6 +34,7 @@ import (
 
 	"github.com/atotto/clipboard"
 	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textarea"
 	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
@@ -61,42 +68,19 @@ const (
 	focusHistory
 	focusAttention
 	focusLabelPicker
-	focusSprint      // Sprint dashboard view (bv-161)
-	focusAgentPrompt // AGENTS.md integration prompt (bv-i8dk)
-	focusFlowMatrix  // Cross-label flow matrix view
-	focusTutorial    // Interactive tutorial (bv-8y31)
-	focusCassModal   // Cass session preview modal (bv-5bqh)
-	focusUpdateModal // Self-update modal (bv-182)
+	focusSprint        // Sprint dashboard view (bv-161)
+	focusAgentPrompt   // AGENTS.md integration prompt (bv-i8dk)
+	focusFlowMatrix    // Cross-label flow matrix view
+	focusTutorial      // Interactive tutorial (bv-8y31)
+	focusCassModal     // Cass session preview modal (bv-5bqh)
+	focusUpdateModal   // Self-update modal (bv-182)
+	focusSessionReview // Quit-time session summary
+	focusCommandPrompt // ":" command prompt
+	focusActionMenu    // Per-issue action menu (".")
+	focusTreemap       // Treemap of open issues by estimate
+	focusTimeline      // Timeline of issues with start or due dates
 )
 
-// SortMode represents the current list sorting mode (bv-3ita)
-type SortMode int
-
-const (
-	SortDefault     SortMode = iota // Priority asc, then created desc (original default)
-	SortCreatedAsc                  // By creation date, oldest first
-	SortCreatedDesc                 // By creation date, newest first
-	SortPriority                    // By priority only (ascending)
-	SortUpdated                     // By last update, newest first
-	numSortModes                    // Keep this last - used for cycling
-)
-
-// String returns a human-readable label for the sort mode
-func (s SortMode) String() string {
-	switch s {
-	case SortCreatedAsc:
-		return "Created ↑"
-	case SortCreatedDesc:
-		return "Created ↓"
-	case SortPriority:
-		return "Priority"
-	case SortUpdated:
-		return "Updated"
-	default:
-		return "Default"
-	}
-}
-
 // LabelGraphAnalysisResult holds label-specific graph analysis results (bv-109)
 type LabelGraphAnalysisResult struct {
 	Label        string
@@ -208,11 +192,14 @@ func WaitForBackgroundWorkerMsgCmd(w *BackgroundWorker) tea.Cmd {
 	}
 }
 
-// CheckUpdateCmd returns a command that checks for updates
-func CheckUpdateCmd() tea.Cmd {
+// CheckUpdateCmd returns a command that checks for updates that prefs allow
+func CheckUpdateCmd(ctx context.Context, prefs updater.Preferences) tea.Cmd {
 	return func() tea.Msg {
-		tag, url, err := updater.CheckForUpdates()
-		if err == nil && tag != "" {
+		tag, url, err := updater.CheckForUpdates(ctx, prefs)
+		if err != nil {
+			return updateCheckFailedMsg{err: err}
+		}
+		if tag != "" {
 			return UpdateMsg{TagName: tag, URL: url}
 		}
 		return nil
@@ -327,9 +314,10 @@ type Model struct {
 	issueMap     map[string]*model.Issue
 	analyzer     *analysis.Analyzer
 	analysis     *analysis.GraphStats
-	beadsPath    string           // Path to beads.jsonl for reloading
+	beadsPath    string           // Path to beads.jsonl or beads.db for reloading
 	watcher      *watcher.Watcher // File watcher for live reload
 	instanceLock *instance.Lock   // Multi-instance coordination lock
+	life         *lifecycle       // Cancels background work on Stop (see shutdown.go)
 
 	// Background Worker (Phase 2 architecture - bv-m7v8)
 	// snapshot is the current immutable data snapshot from BackgroundWorker.
@@ -356,6 +344,8 @@ type Model struct {
 	tree               TreeModel // Hierarchical tree view (bv-gllx)
 	insightsPanel      InsightsModel
 	flowMatrix         FlowMatrixModel // Cross-label flow matrix
+	treemap            TreemapModel    // Open issues sized by estimate
+	timeline           TimelineModel   // Scheduled issues along a calendar
 	theme              Theme
 
 	// Update State
@@ -367,6 +357,10 @@ type Model struct {
 	focused                  focus
 	focusBeforeHelp          focus // Stores focus before opening help overlay
 	isSplitView              bool
+	stackedView              bool // Split view with details below the list
+	layout                   layoutPolicy
+	layoutCache              map[layoutKey]panelLayout // See panelLayout
+	resizing                 resizeState
 	isBoardView              bool
 	isGraphView              bool
 	isActionableView         bool
@@ -404,7 +398,7 @@ type Model struct {
 
 	// Filter and sort state
 	currentFilter          string
-	sortMode               SortMode // bv-3ita: current sort mode
+	sorts                  map[string]beadsview.Sort // bv-3ita: sort of the list and board views; missing is the default
 	semanticSearchEnabled  bool
 	semanticIndexBuilding  bool
 	semanticSearch         *SemanticSearch
@@ -461,6 +455,13 @@ type Model struct {
 	statusMsg     string
 	statusIsError bool
 
+	// Accessibility mode: linear plain-text views (see accessible.go)
+	accessible   bool
+	announcement string // What the last message changed, shown as the status line
+
+	// Reduced motion: no spinners, flashes or sub-second ticks
+	reducedMotion bool
+
 	// Workspace mode state
 	workspaceMode    bool            // True when viewing multiple repos
 	availableRepos   []string        // List of repo prefixes available
@@ -482,6 +483,30 @@ type Model struct {
 	isSprintView   bool
 	sprintViewText string
 
+	// Sprint planning (see sprint_plan.go)
+	showSprintPlan     bool
+	sprintPlanID       string
+	sprintPlanCursor   int
+	sprintPlanInSprint bool // Cursor is in the sprint column, not the backlog
+
+	// Dependency impact of an issue (see impact.go)
+	showImpact   bool
+	impactID     string
+	impactCursor int
+
+	// Duplicate review (see duplicates.go)
+	showDuplicates    bool
+	duplicatesLoading bool
+	duplicatePairs    []analysis.DuplicatePair
+	duplicatesCursor  int
+
+	// Agent activity (see activity.go)
+	showActivity   bool
+	activityHours  int
+	activity       []correlation.ActorActivity
+	activityRows   []activityRow
+	activityCursor int
+
 	// AGENTS.md integration (bv-i8dk)
 	showAgentPrompt  bool
 	agentPromptModal AgentPromptModal
@@ -490,6 +515,17 @@ type Model struct {
 	// Tutorial integration (bv-8y31)
 	showTutorial  bool
 	tutorialModel TutorialModel
+	capsLock      *CapsLockTracker // Shared by copies, like session
+
+	// Key sequences of ui.keys; nil when none are bound (see key_sequences.go)
+	keySeqs *KeySequenceTracker
+
+	// Recorded command macros (see macros.go)
+	macros *macroRecorder
+
+	// Kitty keyboard protocol, probed at startup (see kitty_keyboard.go)
+	kittyOut    io.Writer
+	kittyActive bool
 
 	// Cass session preview modal (bv-5bqh)
 	showCassModal  bool
@@ -499,6 +535,164 @@ type Model struct {
 	// Self-update modal (bv-182)
 	showUpdateModal bool
 	updateModal     UpdateModal
+
+	// Session review shown on quit
+	session              *SessionTracker
+	sessionReviewEnabled bool
+	showSessionReview    bool
+
+	// Privacy screen after inactivity (nil when disabled)
+	idleLock *IdleLock
+
+	// OSC 8 hyperlinks in the detail pane
+	hyperlinks       bool
+	issueURLTemplate string
+
+	// Images in the detail pane (see graphics.go); nil shows text
+	graphics *graphics
+
+	// Parses live-reload changes incrementally; created on first reload
+	issueLoader *loader.IncrementalLoader
+
+	// Commands to run once the program starts (--cmd)
+	startupCommands []string
+
+	// Where the session state is saved on quit, and the state to restore
+	// before the startup commands (see session_state.go)
+	sessionStatePath string
+	restoreState     *SessionState
+
+	// ":" command prompt and user-defined aliases
+	commandInput       textinput.Model
+	showCommandPrompt  bool
+	focusBeforeCommand focus
+	aliases            map[string]string
+
+	// Per-issue action menu (see actions.go)
+	showActionMenu        bool
+	actionMenu            []issueAction
+	actionMenuCursor      int
+	actionMenuIssue       model.Issue
+	focusBeforeActionMenu focus
+
+	// External commands from ui.commands and the pane streaming their output
+	userCommands      []config.UserCommand
+	showCommandOutput bool
+	commandOutput     outputPane
+	outputRunID       int
+
+	// Paging long text (see pager.go)
+	pagerCommand string
+	showPager    bool
+	pager        textPager
+
+	// Editor commands that open a referenced file at a line (see file_refs.go)
+	editorTemplates map[string]string
+
+	// Frame timings for the :perf overlay; nil when off
+	perf *frameStats
+
+	// View-only session served over SSH (see EnableReadOnly)
+	readOnly bool
+
+	// Opt-outs of ui.check_updates and ui.run_hooks (see EnableUpdateCheck
+	// and EnableHooks)
+	skipUpdateCheck bool
+	skipHooks       bool
+
+	// Releases the update check offers (see SetUpdatePreferences)
+	updatePrefs updater.Preferences
+
+	// Trust and confinement of the project's hooks (see hook_trust.go)
+	hookTrust       *hooks.Trust
+	hookSandbox     hooks.SandboxMode
+	showHookTrust   bool
+	hookTrustLoader *hooks.Loader
+
+	// Clock for the relative times and ages the views render (see SetClock)
+	now func() time.Time
+
+	// Watched issues and the feed of their changes (see watch.go)
+	watches            *WatchList
+	watchNotifications bool
+	showWatchFeed      bool
+	watchFeedCursor    int
+
+	// Footer segments in order; nil means DefaultStatusBar (see status_bar.go)
+	statusBar []string
+
+	// Back and forward stacks of visited views and issues (see navigation.go)
+	nav navigation
+
+	// Other participants of a shared serve-ssh session (see presence.go)
+	presence *PresenceSession
+
+	// Schedule whose critical path is marked (see critical_path.go)
+	criticalPath *analysis.EpicSchedule
+	criticalIDs  map[string]bool
+
+	// Quick capture form, and the issue to select once it loads (see capture.go)
+	showCapture bool
+	capture     captureForm
+	capturedID  string
+
+	// Stale threshold, and which open issues were stale at the last check
+	// (see aging.go)
+	staleAfter time.Duration
+	staleSeen  map[string]bool
+
+	// Limits of the board's status columns (see wip.go)
+	wip wipPolicy
+
+	// Escalation rules, their suggestions and pins, and the review (see
+	// escalation.go)
+	escalationRules      []escalationRule
+	escalations          []escalation
+	escalationsDismissed map[escalationKey]bool
+	pinnedIDs            map[string]bool
+	showEscalations      bool
+	escalationsCursor    int
+
+	// Issues of other repos the issues depend on, and the issues an open
+	// one holds up (see external.go)
+	externalResolver func([]model.Issue) map[string]model.Issue
+	external         map[string]*model.Issue
+	externallyHeld   map[string]bool
+
+	// Local draft issues, not yet created with bd (see drafts.go)
+	drafts       *DraftList
+	showDrafts   bool
+	draftsCursor int
+
+	// Comment composer and the issue it comments on (see comments.go)
+	showComposer  bool
+	composer      textarea.Model
+	composerIssue string
+
+	// Reported errors (see errors.go)
+	diagnostics       []diagnostic
+	showDiagnostics   bool
+	diagnosticsCursor int
+	errorModal        *uiError // Shown until a key is pressed
+
+	// Effective configuration and its inspector (see config_view.go)
+	configSettings config.Settings
+	showConfig     bool
+	configCursor   int
+
+	// Opt-in local usage counts and their view (see usage.go)
+	usage     *usageTracker
+	showUsage bool
+
+	// Tail of the structured log, toggled with F12 (see debug_console.go)
+	showDebugConsole bool
+
+	// List caches for large databases (see list_cache.go)
+	rowCache    *rowRenderCache
+	fuzzyFilter *incrementalFuzzyFilter
+
+	// Startup timings for --verbose; nil unless debug logging is on (see startup.go)
+	startup *startupTrace
 }
 
 // labelCount is a simple label->count pair for display
@@ -633,6 +827,15 @@ func (m *Model) updateListDelegate() {
 		PriorityHints:     m.priorityHints,
 		WorkspaceMode:     m.workspaceMode,
 		ShowSearchScores:  m.shouldShowSearchScores(),
+		Now:               m.now,
+		StaleAfter:        m.staleAfter,
+		Presence:          m.presence,
+		Critical:          m.criticalIDs,
+		Watches:           m.watches,
+		Pinned:            m.pinnedIDs,
+		External:          m.externallyHeld,
+		Compact:           m.layout.compact(m.width),
+		rowCache:          m.rowCache,
 	})
 }
 
@@ -702,15 +905,63 @@ func (m *Model) issuesForAsync() []model.Issue {
 	return m.issues
 }
 
+// newIssueList returns a list of IssueItems styled for bv: no title, help,
+// status bar or pagination, since bv draws its own header and footer.
+func newIssueList(items []list.Item, delegate IssueDelegate, filter *incrementalFuzzyFilter, theme Theme, width, height int) list.Model {
+	l := list.New(items, delegate, width, height)
+	l.Filter = filter.Filter
+	l.Title = ""
+	l.SetShowTitle(false)
+	l.SetShowHelp(false)
+	l.SetShowStatusBar(false)
+	l.SetShowPagination(false)
+	l.SetFilteringEnabled(true)
+	l.DisableQuitKeybindings()
+	// Clear all default styles that might add extra lines
+	l.Styles.Title = lipgloss.NewStyle()
+	l.Styles.TitleBar = lipgloss.NewStyle()
+	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(theme.Primary)
+	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(theme.Primary)
+	l.Styles.StatusBar = lipgloss.NewStyle()
+	l.Styles.StatusEmpty = lipgloss.NewStyle()
+	l.Styles.StatusBarActiveFilter = lipgloss.NewStyle()
+	l.Styles.StatusBarFilterCount = lipgloss.NewStyle()
+	l.Styles.NoItems = lipgloss.NewStyle()
+	l.Styles.PaginationStyle = lipgloss.NewStyle()
+	l.Styles.HelpStyle = lipgloss.NewStyle()
+	return l
+}
+
 // NewModel creates a new Model from the given issues
-// beadsPath is the path to the beads.jsonl file for live reload support
+// beadsPath is the path to the beads.jsonl file, or to the SQLite database
+// when that is the backend, for live reload support
 func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath string) Model {
+	life := newLifecycle()
+
 	// Graph Analysis - Phase 1 is instant, Phase 2 runs in background
 	analyzer := analysis.NewAnalyzer(issues)
-	graphStats := analyzer.AnalyzeAsync(context.Background())
+	graphStats := analyzer.AnalyzeAsync(life.context())
 
 	// Sort issues
-	if activeRecipe != nil && activeRecipe.Sort.Field != "" {
+	var recipeExpr *beadsview.Expr
+	if activeRecipe != nil && activeRecipe.Sort.Expr != "" {
+		recipeExpr, _ = beadsview.ParseExpr(activeRecipe.Sort.Expr)
+	}
+	if recipeExpr != nil {
+		// Score expressions rank the highest first unless the direction is asc
+		now := time.Now()
+		scores := make(map[string]float64, len(issues))
+		for _, issue := range issues {
+			scores[issue.ID] = recipeExpr.Eval(issue, now)
+		}
+		ascending := activeRecipe.Sort.Direction == "asc"
+		sort.SliceStable(issues, func(i, j int) bool {
+			if ascending {
+				return scores[issues[i].ID] < scores[issues[j].ID]
+			}
+			return scores[issues[i].ID] > scores[issues[j].ID]
+		})
+	} else if activeRecipe != nil && activeRecipe.Sort.Field != "" {
 		r := activeRecipe
 		descending := r.Sort.Direction == "desc"
 
@@ -758,12 +1009,14 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 	for i := range issues {
 		issueMap[issues[i].ID] = &issues[i]
 
-		items[i] = IssueItem{
+		item := IssueItem{
 			Issue:      issues[i],
 			GraphScore: graphStats.GetPageRankScore(issues[i].ID),
 			Impact:     graphStats.GetCriticalPathScore(issues[i].ID),
 			RepoPrefix: ExtractRepoPrefix(issues[i].ID),
 		}
+		item.cacheFilterValue()
+		items[i] = item
 	}
 
 	// Compute stats
@@ -798,7 +1051,7 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 	}
 
 	// Theme
-	theme := DefaultTheme(lipgloss.NewRenderer(os.Stdout))
+	theme := DefaultTheme(lipgloss.DefaultRenderer())
 
 	// Default dimensions for immediate ready state (updated when WindowSizeMsg arrives)
 	// This eliminates the "Initializing..." phase entirely, fixing slow startup issues
@@ -807,27 +1060,10 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 	const defaultHeight = 40
 
 	// List setup - initialize with default dimensions so UI is immediately usable
-	delegate := IssueDelegate{Theme: theme, WorkspaceMode: false}
-	l := list.New(items, delegate, defaultWidth, defaultHeight-3)
-	l.Title = ""
-	l.SetShowTitle(false)
-	l.SetShowHelp(false)
-	l.SetShowStatusBar(false)
-	l.SetShowPagination(false)
-	l.SetFilteringEnabled(true)
-	l.DisableQuitKeybindings()
-	// Clear all default styles that might add extra lines
-	l.Styles.Title = lipgloss.NewStyle()
-	l.Styles.TitleBar = lipgloss.NewStyle()
-	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(theme.Primary)
-	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(theme.Primary)
-	l.Styles.StatusBar = lipgloss.NewStyle()
-	l.Styles.StatusEmpty = lipgloss.NewStyle()
-	l.Styles.StatusBarActiveFilter = lipgloss.NewStyle()
-	l.Styles.StatusBarFilterCount = lipgloss.NewStyle()
-	l.Styles.NoItems = lipgloss.NewStyle()
-	l.Styles.PaginationStyle = lipgloss.NewStyle()
-	l.Styles.HelpStyle = lipgloss.NewStyle()
+	rowCache := newRowRenderCache()
+	fuzzyFilter := &incrementalFuzzyFilter{}
+	delegate := IssueDelegate{Theme: theme, WorkspaceMode: false, rowCache: rowCache}
+	l := newIssueList(items, delegate, fuzzyFilter, theme, defaultWidth, defaultHeight-3)
 
 	// Theme-aware markdown renderer
 	renderer := NewMarkdownRendererWithTheme(80, theme)
@@ -850,46 +1086,8 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 	// This avoids blocking startup on expensive graph analysis
 	priorityHints := make(map[string]*analysis.PriorityRecommendation)
 
-	// Compute triage insights (bv-151) - reuse existing analyzer/stats (bv-runn.12)
-	triageResult := analysis.ComputeTriageFromAnalyzer(analyzer, graphStats, issues, analysis.TriageOptions{}, time.Now())
-	triageScores := make(map[string]float64, len(triageResult.Recommendations))
-	triageReasons := make(map[string]analysis.TriageReasons, len(triageResult.Recommendations))
-	quickWinSet := make(map[string]bool, len(triageResult.QuickWins))
-	blockerSet := make(map[string]bool, len(triageResult.BlockersToClear))
-	unblocksMap := make(map[string][]string, len(triageResult.Recommendations))
-
-	for _, rec := range triageResult.Recommendations {
-		triageScores[rec.ID] = rec.Score
-		if len(rec.Reasons) > 0 {
-			triageReasons[rec.ID] = analysis.TriageReasons{
-				Primary:    rec.Reasons[0],
-				All:        rec.Reasons,
-				ActionHint: rec.Action,
-			}
-		}
-		unblocksMap[rec.ID] = rec.UnblocksIDs
-	}
-	for _, qw := range triageResult.QuickWins {
-		quickWinSet[qw.ID] = true
-	}
-	for _, bl := range triageResult.BlockersToClear {
-		blockerSet[bl.ID] = true
-	}
-
-	// Update items with triage data
-	for i := range items {
-		if issueItem, ok := items[i].(IssueItem); ok {
-			issueItem.TriageScore = triageScores[issueItem.Issue.ID]
-			if reasons, exists := triageReasons[issueItem.Issue.ID]; exists {
-				issueItem.TriageReason = reasons.Primary
-				issueItem.TriageReasons = reasons.All
-			}
-			issueItem.IsQuickWin = quickWinSet[issueItem.Issue.ID]
-			issueItem.IsBlocker = blockerSet[issueItem.Issue.ID]
-			issueItem.UnblocksCount = len(unblocksMap[issueItem.Issue.ID])
-			items[i] = issueItem
-		}
-	}
+	// Triage insights (bv-151) and alerts (bv-168) are computed after the
+	// first paint by startupStatsCmd (see startup.go).
 
 	// Initialize recipe loader
 	recipeLoader := recipe.NewLoader()
@@ -910,6 +1108,16 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 	ti.PromptStyle = lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
 	ti.TextStyle = lipgloss.NewStyle().Foreground(theme.Base.GetForeground())
 
+	// Initialize ":" command prompt
+	ci := textinput.New()
+	ci.Placeholder = "command, alias or issue ID"
+	ci.CharLimit = 200
+	ci.Prompt = ":"
+	ci.PromptStyle = lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+	ci.TextStyle = lipgloss.NewStyle().Foreground(theme.Base.GetForeground())
+	ci.ShowSuggestions = true
+	ci.CompletionStyle = lipgloss.NewStyle().Foreground(theme.Subtext)
+
 	// Initialize file watcher for live reload
 	var fileWatcher *watcher.Watcher
 	var watcherErr error
@@ -940,6 +1148,8 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 	if beadsPath != "" && backgroundWorker == nil {
 		w, err := watcher.NewWatcher(beadsPath,
 			watcher.WithDebounceDuration(200*time.Millisecond),
+			watcher.WithPollInterval(envDurationMilliseconds("BV_POLL_INTERVAL_MS", watcher.DefaultPollInterval)),
+			watcher.WithPollJitter(envDurationMilliseconds("BV_POLL_JITTER_MS", 0)),
 		)
 		if err != nil {
 			watcherErr = err
@@ -985,9 +1195,6 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 		initialStatusErr = true
 	}
 
-	// Precompute drift/health alerts (bv-168)
-	alerts, alertsCritical, alertsWarning, alertsInfo := computeAlerts(issues, graphStats, analyzer)
-
 	// Load sprints from the same directory as beadsPath (bv-161)
 	var sprints []model.Sprint
 	if beadsPath != "" {
@@ -1013,6 +1220,7 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 		snapshotInitPending:    backgroundWorker != nil,
 		backgroundWorker:       backgroundWorker,
 		instanceLock:           instLock,
+		life:                   life,
 		list:                   l,
 		viewport:               vp,
 		renderer:               renderer,
@@ -1032,6 +1240,7 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 		semanticHybridReady:    false,
 		lastSearchTerm:         "",
 		focused:                focusList,
+		layout:                 newLayoutPolicy(config.LayoutConfig{}),
 		// Initialize as ready with default dimensions to eliminate "Initializing..." phase
 		ready:               true,
 		width:               defaultWidth,
@@ -1042,25 +1251,17 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 		countClosed:         cClosed,
 		priorityHints:       priorityHints,
 		showPriorityHints:   false, // Off by default, toggle with 'p'
-		triageScores:        triageScores,
-		triageReasons:       triageReasons,
-		unblocksMap:         unblocksMap,
-		quickWinSet:         quickWinSet,
-		blockerSet:          blockerSet,
 		recipeLoader:        recipeLoader,
 		recipePicker:        recipePicker,
 		activeRecipe:        activeRecipe,
 		labelPicker:         labelPicker,
 		labelDrilldownCache: make(map[string][]model.Issue),
 		timeTravelInput:     ti,
+		commandInput:        ci,
 		statusMsg:           initialStatus,
 		statusIsError:       initialStatusErr,
 		historyLoading:      len(issues) > 0, // Will be loaded in Init()
 		// Alerts panel (bv-168)
-		alerts:          alerts,
-		alertsCritical:  alertsCritical,
-		alertsWarning:   alertsWarning,
-		alertsInfo:      alertsInfo,
 		dismissedAlerts: make(map[string]bool),
 		// Sprint view (bv-161)
 		sprints: sprints,
@@ -1075,6 +1276,15 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 		}(),
 		// Tutorial integration (bv-8y31)
 		tutorialModel: NewTutorialModel(theme),
+		capsLock:      NewCapsLockTracker(),
+		macros:        newMacroRecorder(),
+		session:       NewSessionTracker(time.Now()),
+		watches:       NewWatchList(),
+		drafts:        NewDraftList(),
+		staleAfter:    defaultStaleAfter,
+		now:           time.Now,
+		rowCache:      rowCache,
+		fuzzyFilter:   fuzzyFilter,
 	}
 }
 
@@ -1082,14 +1292,19 @@ func (m Model) Init() tea.Cmd {
 	// Note: ReadyTimeoutCmd is no longer needed since the model is now
 	// initialized as ready with default dimensions in NewModel().
 	// This eliminates the "Initializing..." phase entirely.
-	cmds := []tea.Cmd{
-		CheckUpdateCmd(),
-		WaitForPhase2Cmd(m.analysis),
+	cmds := []tea.Cmd{WaitForPhase2Cmd(m.analysis), startupStatsCmd(m.analyzer, m.analysis, m.issuesForAsync(), clockNow(m.now), m.startup)}
+	if !m.readOnly && !m.skipUpdateCheck {
+		cmds = append(cmds, CheckUpdateCmd(m.life.context(), m.updatePrefs))
+	}
+	if m.kittyOut != nil {
+		cmds = append(cmds, writeTerminalCmd(m.kittyOut, kittyKeyboardQuery))
 	}
 	if m.backgroundWorker != nil {
 		cmds = append(cmds, StartBackgroundWorkerCmd(m.backgroundWorker))
 		cmds = append(cmds, WaitForBackgroundWorkerMsgCmd(m.backgroundWorker))
-		cmds = append(cmds, workerPollTickCmd())
+		if !m.reducedMotion {
+			cmds = append(cmds, workerPollTickCmd())
+		}
 	} else if m.watcher != nil {
 		cmds = append(cmds, WatchFileCmd(m.watcher))
 	}
@@ -1098,16 +1313,55 @@ func (m Model) Init() tea.Cmd {
 		cmds = append(cmds, LoadHistoryCmd(m.issuesForAsync(), m.beadsPath))
 	}
 	// Check for AGENTS.md integration prompt (bv-i8dk)
-	if m.workDir != "" && !m.workspaceMode {
+	if m.workDir != "" && !m.workspaceMode && !m.readOnly {
 		cmds = append(cmds, CheckAgentFileCmd(m.workDir))
 	}
+	if m.idleLock != nil {
+		cmds = append(cmds, m.idleLock.Check(time.Now()))
+	}
+	if m.presence != nil {
+		cmds = append(cmds, waitForPresenceCmd(m.presence))
+	}
+	if len(m.startupCommands) > 0 || m.restoreState != nil {
+		cmds = append(cmds, func() tea.Msg { return startupCommandsMsg{} })
+	}
+	if !m.readOnly {
+		cmds = append(cmds, func() tea.Msg { return staleCheckMsg{} })
+	}
 	return tea.Batch(cmds...)
 }
 
-func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+func (m Model) Update(msg tea.Msg) (newM tea.Model, cmd tea.Cmd) {
+	defer m.recoverUpdate(msg, &newM, &cmd)
+	if m.perf != nil {
+		defer m.perf.recordUpdate(time.Now())
+	}
+	logMsg(msg)
+	if seq, ok := csiSequence(msg); ok {
+		if msg, cmd = m.handleCSISequence(seq); msg == nil {
+			return m, cmd
+		}
+	}
+	if m.accessible {
+		newM, cmd = m.accessibleUpdate(msg)
+	} else if _, isKey := msg.(tea.KeyMsg); isKey && !m.nav.busy {
+		newM, cmd = m.recordNavigation(msg)
+	} else {
+		newM, cmd = m.update(msg)
+	}
+	if updated, ok := newM.(Model); ok {
+		updated.publishPresence()
+	}
+	return newM, cmd
+}
+
+func (m Model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 	var cmds []tea.Cmd
 
+	m.session.Observe(m.CurrentContext(), time.Now())
+	m.usage.observeFilter(m.currentFilter)
+
 	if m.backgroundWorker != nil {
 		switch msg.(type) {
 		case tea.KeyMsg, tea.MouseMsg:
@@ -1115,12 +1369,27 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 	}
 
+	// While the privacy screen is up, the first key only unlocks.
+	switch msg.(type) {
+	case tea.KeyMsg, tea.MouseMsg:
+		if m.idleLock.Locked() {
+			if _, isKey := msg.(tea.KeyMsg); isKey {
+				return m, m.idleLock.Unlock(time.Now())
+			}
+			return m, nil
+		}
+		m.idleLock.Touch(time.Now())
+	}
+
 	switch msg := msg.(type) {
 	case UpdateMsg:
 		m.updateAvailable = true
 		m.updateTag = msg.TagName
 		m.updateURL = msg.URL
 
+	case updateCheckFailedMsg:
+		m.reportError(newError(errNetwork, "check for updates", msg.err))
+
 	case UpdateCompleteMsg:
 		// Forward to the update modal
 		if m.showUpdateModal {
@@ -1128,6 +1397,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			cmds = append(cmds, cmd)
 		}
 
+	case ChangelogMsg:
+		// Forward to the update modal
+		if m.showUpdateModal {
+			m.updateModal, cmd = m.updateModal.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+
 	case UpdateProgressMsg:
 		// Forward to the update modal
 		if m.showUpdateModal {
@@ -1154,8 +1430,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.Error != nil {
 			// If indexing fails, revert to fuzzy mode for predictable behavior.
 			m.semanticSearchEnabled = false
-			m.list.Filter = list.DefaultFilter
-			m.statusMsg = fmt.Sprintf("Semantic search unavailable: %v", msg.Error)
+			m.list.Filter = m.fuzzyFilter.Filter
+			m.statusMsg = i18n.Tf("Semantic search unavailable: %v", msg.Error)
 			m.statusIsError = true
 			break
 		}
@@ -1163,11 +1439,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.semanticSearch.SetIndex(msg.Index, msg.Embedder)
 		}
 		if !msg.Loaded {
-			m.statusMsg = fmt.Sprintf("Semantic index built (%d embedded)", msg.Stats.Embedded)
+			m.statusMsg = i18n.Tf("Semantic index built (%d embedded)", msg.Stats.Embedded)
 		} else if msg.Stats.Changed() {
-			m.statusMsg = fmt.Sprintf("Semantic index updated (+%d ~%d -%d)", msg.Stats.Added, msg.Stats.Updated, msg.Stats.Removed)
+			m.statusMsg = i18n.Tf("Semantic index updated (+%d ~%d -%d)", msg.Stats.Added, msg.Stats.Updated, msg.Stats.Removed)
 		} else {
-			m.statusMsg = "Semantic index up to date"
+			m.statusMsg = i18n.T("Semantic index up to date")
 		}
 		m.statusIsError = false
 
@@ -1190,7 +1466,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.semanticSearch.SetMetricsCache(nil)
 				m.semanticSearch.SetHybridConfig(false, m.semanticHybridPreset)
 			}
-			m.statusMsg = fmt.Sprintf("Hybrid search unavailable: %v", msg.Error)
+			m.statusMsg = i18n.Tf("Hybrid search unavailable: %v", msg.Error)
 			m.statusIsError = true
 			break
 		}
@@ -1198,7 +1474,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.semanticSearch.SetMetricsCache(msg.Cache)
 		}
 		m.semanticHybridReady = msg.Cache != nil
-		m.statusMsg = fmt.Sprintf("Hybrid search ready (%s)", m.semanticHybridPreset)
+		m.statusMsg = i18n.Tf("Hybrid search ready (%s)", m.semanticHybridPreset)
 		m.statusIsError = false
 
 		// Recompute semantic results if hybrid is enabled and search is active.
@@ -1236,19 +1512,104 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+	case idleLockTickMsg:
+		cmds = append(cmds, m.idleLock.Check(time.Now()))
+
+	case presenceChangedMsg:
+		cmds = append(cmds, waitForPresenceCmd(m.presence))
+
+	case startupCommandsMsg:
+		return m.runStartupCommands()
+
+	case issueActionResultMsg:
+		if msg.err != nil {
+			m.reportError(msg.err)
+		} else {
+			m.statusMsg = "✅ " + msg.summary
+			m.statusIsError = false
+		}
+		return m, nil
+
+	case controlMsg:
+		var cmd tea.Cmd
+		var err error
+		m, cmd, err = m.handleControl(msg.req)
+		msg.reply <- err
+		return m, cmd
+
+	case duplicatesMsg:
+		m.duplicatesLoading = false
+		m.duplicatePairs = msg.pairs
+		m.duplicatesCursor = 0
+		return m, nil
+
+	case staleCheckMsg:
+		return m, tea.Batch(m.checkStale(), staleCheckCmd())
+
+	case keySequenceTimeoutMsg:
+		if m.keySeqs == nil {
+			return m, nil
+		}
+		line, replay := m.keySeqs.Timeout(msg)
+		return m.finishKeySequence(line, replay, nil)
+
+	case CapsLockTimerExpiredMsg:
+		if m.capsLock.HandleTimerExpired() == TriggerFullTutorial {
+			m.TriggerFullTutorial()
+		}
+		return m, nil
+
+	case issueCreatedMsg:
+		if msg.err != nil {
+			m.reportError(msg.err)
+			return m, nil
+		}
+		return m, m.issueCreated(msg)
+
+	case composerEditedMsg:
+		m.applyEditedDraft(msg)
+		return m, nil
+
+	case fileRefOpenedMsg:
+		if msg.err != nil {
+			m.reportError(newError(errTerminal, "open "+msg.ref.Path, msg.err))
+		} else {
+			m.statusMsg = i18n.Tf("📝 Opened %s", msg.ref.String())
+			m.statusIsError = false
+		}
+		return m, nil
+
+	case pagerTextMsg:
+		if msg.err != nil {
+			m.reportError(msg.err)
+			return m, nil
+		}
+		return m.openPager(msg.title, msg.text, msg.ext)
+
+	case pagerDoneMsg:
+		m.pagerDone(msg)
+		return m, nil
+
+	case outputChunkMsg, outputDoneMsg:
+		return m.handleOutputMsg(msg)
+
 	case workerPollTickMsg:
 		if m.backgroundWorker != nil {
 			state := m.backgroundWorker.State()
-			if state == WorkerProcessing {
+			if state == WorkerProcessing && !m.reducedMotion {
 				m.workerSpinnerIdx = (m.workerSpinnerIdx + 1) % len(workerSpinnerFrames)
 			} else {
 				m.workerSpinnerIdx = 0
 			}
-			if state != WorkerStopped {
+			if state != WorkerStopped && !m.reducedMotion {
 				cmds = append(cmds, workerPollTickCmd())
 			}
 		}
 
+	case startupStatsMsg:
+		m.applyStartupStats(msg)
+		return m, nil
+
 	case Phase2ReadyMsg:
 		// Ignore stale Phase2 completions (from before a file reload)
 		if msg.Stats != m.analysis {
@@ -1282,7 +1643,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		// Generate triage for priority panel (bv-91) - reuse existing analyzer/stats (bv-runn.12)
-		triage := analysis.ComputeTriageFromAnalyzer(m.analyzer, m.analysis, m.issues, analysis.TriageOptions{}, time.Now())
+		triage := analysis.ComputeTriageFromAnalyzer(m.analyzer, m.analysis, m.issues, analysis.TriageOptions{}, clockNow(m.now))
 		m.insightsPanel.SetTopPicks(triage.QuickRef.TopPicks)
 
 		// Set full recommendations with breakdown for priority radar (bv-93)
@@ -1306,7 +1667,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.labelHealthCache = analysis.ComputeAllLabelHealth(m.issues, cfg, time.Now().UTC(), m.analysis)
 			m.labelHealthCached = true
 			m.labelDashboard.SetData(m.labelHealthCache.Labels)
-			m.statusMsg = fmt.Sprintf("Labels: %d total • critical %d • warning %d", m.labelHealthCache.TotalLabels, m.labelHealthCache.CriticalCount, m.labelHealthCache.WarningCount)
+			m.statusMsg = i18n.Tf("Labels: %d total • critical %d • warning %d", m.labelHealthCache.TotalLabels, m.labelHealthCache.CriticalCount, m.labelHealthCache.WarningCount)
 		}
 
 		// Re-sort issues if sorting by Phase 2 metrics (impact/pagerank)
@@ -1389,11 +1750,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.historyLoading = false
 		if msg.Error != nil {
 			m.historyLoadFailed = true
-			m.statusMsg = fmt.Sprintf("History load failed: %v", msg.Error)
-			m.statusIsError = true
+			m.reportError(newError(errData, "load history", msg.Error))
 		} else if msg.Report != nil {
 			m.historyView = NewHistoryModel(msg.Report, m.theme)
 			m.historyView.SetSize(m.width, m.height-1)
+			m.historyView.reducedMotion = m.reducedMotion
+			if m.showActivity {
+				m.refreshActivity()
+			}
 			// Refresh detail pane if visible
 			if m.isSplitView || m.showDetails {
 				m.updateViewportContent()
@@ -1463,6 +1827,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.backgroundWorker.recordUIUpdateLatency(time.Since(latencyStart))
 			}
 		}
+		// Compare against the old issues before they go back to the pool.
+		m.session.ObserveReload(m.issueMap, msg.Snapshot.Issues)
+		watchChanges := m.watches.ObserveReload(m.issueMap, msg.Snapshot.Issues, time.Now())
 		if oldSnapshot != nil && len(oldSnapshot.pooledIssues) > 0 {
 			go loader.ReturnIssuePtrsToPool(oldSnapshot.pooledIssues)
 		}
@@ -1493,6 +1860,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.alerts, m.alertsCritical, m.alertsWarning, m.alertsInfo = computeAlerts(m.issues, m.analysis, m.analyzer)
 		m.dismissedAlerts = make(map[string]bool)
 		m.showAlertsPanel = false
+		m.resolveExternal()
+		newEscalations := m.evaluateEscalations()
 
 		// Reset semantic caches for the new dataset.
 		if m.semanticSearch != nil {
@@ -1563,6 +1932,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			filteredItems = make([]list.Item, 0, len(msg.Snapshot.ListItems))
 			filteredIssues = make([]model.Issue, 0, len(msg.Snapshot.ListItems))
 
+			matchesFilter := m.listFilter()
 			for _, item := range msg.Snapshot.ListItems {
 				issue := item.Issue
 
@@ -1574,40 +1944,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					}
 				}
 
-				include := false
-				switch m.currentFilter {
-				case "all":
-					include = true
-				case "open":
-					include = !isClosedLikeStatus(issue.Status)
-				case "closed":
-					include = isClosedLikeStatus(issue.Status)
-				case "ready":
-					// Ready = Open/InProgress AND NO Open Blockers
-					if !isClosedLikeStatus(issue.Status) && issue.Status != model.StatusBlocked {
-						isBlocked := false
-						for _, dep := range issue.Dependencies {
-							if dep == nil || !dep.Type.IsBlocking() {
-								continue
-							}
-							if blocker, exists := m.issueMap[dep.DependsOnID]; exists && !isClosedLikeStatus(blocker.Status) {
-								isBlocked = true
-								break
-							}
-						}
-						include = !isBlocked
-					}
-				default:
-					if strings.HasPrefix(m.currentFilter, "label:") {
-						label := strings.TrimPrefix(m.currentFilter, "label:")
-						for _, l := range issue.Labels {
-							if l == label {
-								include = true
-								break
-							}
-						}
-					}
-				}
+				include := matchesFilter(issue)
 
 				if include {
 					filteredItems = append(filteredItems, item)
@@ -1676,7 +2013,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Keep semantic index current when enabled.
 		if m.semanticSearchEnabled && !m.semanticIndexBuilding {
 			m.semanticIndexBuilding = true
-			cmds = append(cmds, BuildSemanticIndexCmd(m.issuesForAsync()))
+			cmds = append(cmds, BuildSemanticIndexCmd(m.issuesForAsync(), !m.readOnly))
 		}
 
 		// Reload sprints (bv-161)
@@ -1703,19 +2040,24 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+		m.noteLoadWarnings(msg.Snapshot.LoadWarnings)
 		if firstSnapshot {
 			// For the initial background snapshot, avoid flashing "Reloaded" at startup.
-			if msg.Snapshot.LoadWarningCount > 0 {
-				m.statusMsg = fmt.Sprintf("Loaded %d issues (%d warnings)", len(m.issues), msg.Snapshot.LoadWarningCount)
+			if n := len(msg.Snapshot.LoadWarnings); n > 0 {
+				m.statusMsg = i18n.Tf("Loaded %d issues (%d warnings, see :errors)", len(m.issues), n)
 			} else {
 				m.statusMsg = ""
 			}
-		} else if msg.Snapshot.LoadWarningCount > 0 {
-			m.statusMsg = fmt.Sprintf("Reloaded %d issues (%d warnings)", len(m.issues), msg.Snapshot.LoadWarningCount)
+		} else if n := len(msg.Snapshot.LoadWarnings); n > 0 {
+			m.statusMsg = i18n.Tf("Reloaded %d issues (%d warnings, see :errors)", len(m.issues), n)
 		} else {
-			m.statusMsg = fmt.Sprintf("Reloaded %d issues", len(m.issues))
+			m.statusMsg = i18n.Tf("Reloaded %d issues", len(m.issues))
 		}
 		m.statusIsError = false
+		cmds = append(cmds, m.noteWatchChanges(watchChanges), m.checkStale())
+		m.noteEscalations(newEscalations)
+		m.focusCaptured()
+		m.refreshCriticalPath()
 
 		// Wait for Phase 2 if not ready
 		if msg.Snapshot.Analysis != nil {
@@ -1735,12 +2077,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.snapshotInitPending = false
 		}
 		if msg.Err != nil {
+			e := newError(errData, "reload issues", msg.Err)
 			if msg.Recoverable {
-				m.statusMsg = fmt.Sprintf("Background reload error (will retry): %v", msg.Err)
-			} else {
-				m.statusMsg = fmt.Sprintf("Background reload error: %v", msg.Err)
+				e.withHint("will retry on the next change")
 			}
-			m.statusIsError = true
+			m.reportError(e)
 		}
 		if m.backgroundWorker != nil {
 			cmds = append(cmds, WaitForBackgroundWorkerMsgCmd(m.backgroundWorker))
@@ -1777,17 +2118,25 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.modifiedIssueIDs = nil
 		}
 
-		// Reload issues from disk
-		// Use custom warning handler to prevent stderr pollution during TUI render (bv-fix)
-		var reloadWarnings []string
-		newIssues, err := loader.LoadIssuesFromFileWithOptions(m.beadsPath, loader.ParseOptions{
-			WarningHandler: func(msg string) {
-				reloadWarnings = append(reloadWarnings, msg)
-			},
-		})
+		// Reload issues from disk. The incremental loader only parses records
+		// appended since the last reload; warnings are collected in the update
+		// to prevent stderr pollution during TUI render (bv-fix)
+		if m.issueLoader == nil {
+			m.issueLoader = loader.NewIncrementalLoader(m.beadsPath, loader.ParseOptions{})
+		}
+		update, err := m.issueLoader.Refresh()
+		reloadWarnings := update.Warnings
+		m.noteLoadWarnings(reloadWarnings)
+		if err == nil && !update.Changed() && len(m.issues) > 0 {
+			// Only an unfinished record was appended; wait for the next write.
+			if m.watcher != nil {
+				cmds = append(cmds, WatchFileCmd(m.watcher))
+			}
+			return m, tea.Batch(cmds...)
+		}
+		newIssues := m.issueLoader.Issues()
 		if err != nil {
-			m.statusMsg = fmt.Sprintf("Reload error: %v", err)
-			m.statusIsError = true
+			m.reportError(newError(errData, "reload issues", err))
 			// Re-start watch for next change
 			if m.watcher != nil {
 				cmds = append(cmds, WatchFileCmd(m.watcher))
@@ -1816,11 +2165,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return newIssues[i].CreatedAt.After(newIssues[j].CreatedAt)
 		})
 
+		m.session.ObserveReload(m.issueMap, newIssues)
+		watchChanges := m.watches.ObserveReload(m.issueMap, newIssues, time.Now())
+
 		// Recompute analysis (async Phase 1/Phase 2) with caching
 		m.issues = newIssues
 		cachedAnalyzer := analysis.NewCachedAnalyzer(newIssues, nil)
 		m.analyzer = cachedAnalyzer.Analyzer
-		m.analysis = cachedAnalyzer.AnalyzeAsync(context.Background())
+		m.analysis = cachedAnalyzer.AnalyzeAsync(m.life.context())
 		cacheHit := cachedAnalyzer.WasCacheHit()
 		m.labelHealthCached = false
 		m.attentionCached = false
@@ -1866,16 +2218,20 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.alerts, m.alertsCritical, m.alertsWarning, m.alertsInfo = computeAlerts(m.issues, m.analysis, m.analyzer)
 		m.dismissedAlerts = make(map[string]bool)
 		m.showAlertsPanel = false
+		m.resolveExternal()
+		newEscalations := m.evaluateEscalations()
 
 		// Rebuild list items
 		items := make([]list.Item, len(m.issues))
 		for i := range m.issues {
-			items[i] = IssueItem{
+			item := IssueItem{
 				Issue:      m.issues[i],
 				GraphScore: m.analysis.GetPageRankScore(m.issues[i].ID),
 				Impact:     m.analysis.GetCriticalPathScore(m.issues[i].ID),
 				RepoPrefix: ExtractRepoPrefix(m.issues[i].ID),
 			}
+			item.cacheFilterValue()
+			items[i] = item
 		}
 		m.updateSemanticIDs(items)
 		m.clearSemanticScores()
@@ -1913,6 +2269,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		// Generate priority recommendations now that Phase 2 is ready
 		m.board = NewBoardModel(m.issues, m.theme)
+		m.board.now = m.now
+		m.board.wip = m.wip
 
 		// Re-apply recipe filter if active
 		if m.activeRecipe != nil {
@@ -1946,18 +2304,22 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Keep semantic index current when enabled.
 		if m.semanticSearchEnabled && !m.semanticIndexBuilding {
 			m.semanticIndexBuilding = true
-			cmds = append(cmds, BuildSemanticIndexCmd(m.issuesForAsync()))
+			cmds = append(cmds, BuildSemanticIndexCmd(m.issuesForAsync(), !m.readOnly))
 		}
 
 		if cacheHit {
-			m.statusMsg = fmt.Sprintf("Reloaded %d issues (cached)", len(newIssues))
+			m.statusMsg = i18n.Tf("Reloaded %d issues (cached)", len(newIssues))
 		} else {
-			m.statusMsg = fmt.Sprintf("Reloaded %d issues", len(newIssues))
+			m.statusMsg = i18n.Tf("Reloaded %d issues", len(newIssues))
 		}
 		if len(reloadWarnings) > 0 {
-			m.statusMsg += fmt.Sprintf(" (%d warnings)", len(reloadWarnings))
+			m.statusMsg += fmt.Sprintf(" (%d warnings, see :errors)", len(reloadWarnings))
 		}
 		m.statusIsError = false
+		cmds = append(cmds, m.noteWatchChanges(watchChanges), m.checkStale())
+		m.noteEscalations(newEscalations)
+		m.focusCaptured()
+		m.refreshCriticalPath()
 		// Invalidate label-derived caches
 		m.labelHealthCached = false
 		m.labelDrilldownCache = make(map[string][]model.Issue)
@@ -1975,6 +2337,33 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.statusMsg = ""
 		m.statusIsError = false
 
+		// Any key closes an error modal
+		if m.errorModal != nil {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m.errorModal = nil
+			return m, nil
+		}
+
+		// The debug console takes every key while open, so it shows what
+		// the terminal sends without acting on it
+		if m.showDebugConsole || msg.String() == "f12" {
+			return m.handleDebugConsoleKeys(msg)
+		}
+
+		// Keys that start a bound sequence are held until it is complete
+		if m.acceptsKeySequences() {
+			return m.handleKeySequence(msg)
+		}
+
+		// Q records macros (see macros.go)
+		if m.keysAreFree() {
+			if next, handled := m.handleMacroKeys(msg); handled {
+				return next, nil
+			}
+		}
+
 		// Handle AGENTS.md prompt modal (bv-i8dk)
 		if m.showAgentPrompt {
 			m.agentPromptModal, cmd = m.agentPromptModal.Update(msg)
@@ -1986,10 +2375,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				// User accepted - add blurb to file
 				filePath := m.agentPromptModal.FilePath()
 				if err := agents.AppendBlurbToFile(filePath); err != nil {
-					m.statusMsg = "Failed to update " + filepath.Base(filePath) + ": " + err.Error()
-					m.statusIsError = true
+					m.reportError(newError(errData, "update "+filepath.Base(filePath), err))
 				} else {
-					m.statusMsg = "✓ Added beads instructions to " + filepath.Base(filePath)
+					m.statusMsg = i18n.Tf("✓ Added beads instructions to %s", filepath.Base(filePath))
 					// Record acceptance
 					_ = agents.RecordAccept(m.workDir)
 				}
@@ -2025,8 +2413,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		// Handle self-update modal (bv-182)
 		if m.showUpdateModal {
+			// Keys scroll the release notes, and esc only leaves them
+			inChangelog := m.updateModal.ShowingChangelog()
 			m.updateModal, cmd = m.updateModal.Update(msg)
 			cmds = append(cmds, cmd)
+			if inChangelog {
+				return m, tea.Batch(cmds...)
+			}
 
 			// Handle modal state changes
 			switch msg.String() {
@@ -2057,6 +2450,18 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.focused = focusList
 					return m, tea.Batch(cmds...)
 				}
+			case "s", "S", "d", "D":
+				// Skip this release, or stop checking, for good
+				if m.updateModal.AwaitsChoice() {
+					if strings.EqualFold(msg.String(), "s") {
+						m.skipUpdateRelease()
+					} else {
+						m.disableUpdateChecks()
+					}
+					m.showUpdateModal = false
+					m.focused = focusList
+					return m, tea.Batch(cmds...)
+				}
 			}
 			return m, tea.Batch(cmds...)
 		}
@@ -2086,7 +2491,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case "enter":
 				// Apply label filter to main list and close drilldown
 				if m.labelDrilldownLabel != "" {
-					m.currentFilter = "label:" + m.labelDrilldownLabel
+					m.currentFilter = "label:" + beadsview.QuoteFilterValue(m.labelDrilldownLabel)
 					m.applyFilter()
 					m.focused = focusList
 				}
@@ -2143,9 +2548,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				idx := int(s[0] - '1')
 				if idx >= 0 && idx < len(m.attentionCache.Labels) {
 					label := m.attentionCache.Labels[idx].Label
-					m.currentFilter = "label:" + label
+					m.currentFilter = "label:" + beadsview.QuoteFilterValue(label)
 					m.applyFilter()
-					m.statusMsg = fmt.Sprintf("Filtered to label %s (attention #%d)", label, idx+1)
+					m.statusMsg = i18n.Tf("Filtered to label %s (attention #%d)", label, idx+1)
 					m.statusIsError = false
 				}
 				return m, nil
@@ -2238,11 +2643,16 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// Session review shown on quit
+		if m.showSessionReview {
+			return m.handleSessionReviewKeys(msg)
+		}
+
 		// Handle quit confirmation first
 		if m.showQuitConfirm {
 			switch msg.String() {
 			case "esc", "y", "Y":
-				return m, tea.Quit
+				return m.quitOrReview()
 			default:
 				m.showQuitConfirm = false
 				m.focused = focusList
@@ -2265,41 +2675,36 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		// Handle tutorial toggle (backtick `) - bv-8y31
 		if msg.String() == "`" && m.list.FilterState() != list.Filtering {
-			m.showTutorial = !m.showTutorial
 			if m.showTutorial {
-				m.showHelp = false // Close help if open
-				m.tutorialModel.SetSize(m.width, m.height)
-				m.focused = focusTutorial
-			} else {
+				m.showTutorial = false
 				m.focused = focusList
+			} else {
+				m.TriggerFullTutorial()
 			}
 			return m, nil
 		}
 
-		// Force refresh (bv-4auz): Ctrl+R / F5 triggers an immediate reload.
-		if (msg.String() == "ctrl+r" || msg.String() == "f5") && m.list.FilterState() != list.Filtering {
-			now := time.Now()
-			if !m.lastForceRefresh.IsZero() && now.Sub(m.lastForceRefresh) < time.Second {
+		// CapsLock works like the backtick; tapped twice it opens the help
+		// for the current view. Only reported under the kitty keyboard
+		// protocol.
+		if IsCapsLock(msg) {
+			if m.showTutorial {
+				m.showTutorial = false
+				m.focused = focusList
 				return m, nil
 			}
-			m.lastForceRefresh = now
-
-			m.statusMsg = "Refreshing…"
-			m.statusIsError = false
-
-			if m.backgroundWorker != nil {
-				m.backgroundWorker.ForceRefresh()
-				cmds = append(cmds, WaitForBackgroundWorkerMsgCmd(m.backgroundWorker))
-				return m, tea.Batch(cmds...)
-			}
-
-			if m.beadsPath == "" && m.watcher == nil {
-				m.statusMsg = "Refresh unavailable"
-				m.statusIsError = true
-				return m, nil
+			trigger, cmd := m.capsLock.HandlePress()
+			if trigger == TriggerContextHelp {
+				m.TriggerContextHelp()
 			}
+			return m, cmd
+		}
 
-			cmds = append(cmds, func() tea.Msg { return FileChangedMsg{} })
+		// Force refresh (bv-4auz): Ctrl+R / F5 triggers an immediate reload.
+		if (msg.String() == "ctrl+r" || msg.String() == "f5") && m.list.FilterState() != list.Filtering {
+			var cmd tea.Cmd
+			m, cmd = m.forceRefresh()
+			cmds = append(cmds, cmd)
 			return m, tea.Batch(cmds...)
 		}
 
@@ -2308,7 +2713,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.showShortcutsSidebar = !m.showShortcutsSidebar
 			if m.showShortcutsSidebar {
 				m.shortcutsSidebar.ResetScroll()
-				m.statusMsg = "Shortcuts sidebar: ; hide | ctrl+j/k scroll"
+				m.statusMsg = i18n.T("Shortcuts sidebar: ; hide | ctrl+j/k scroll")
 				m.statusIsError = false
 			} else {
 				m.statusMsg = ""
@@ -2336,7 +2741,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.semanticHybridEnabled = !m.semanticHybridEnabled
 				if m.semanticSearch == nil {
 					m.semanticHybridEnabled = false
-					m.statusMsg = "Hybrid search unavailable"
+					m.statusMsg = i18n.T("Hybrid search unavailable")
 					m.statusIsError = true
 					return m, nil
 				}
@@ -2345,12 +2750,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.clearSemanticScores()
 				if m.semanticHybridEnabled && !m.semanticHybridReady && !m.semanticHybridBuilding {
 					m.semanticHybridBuilding = true
-					m.statusMsg = "Hybrid search: computing metrics…"
+					m.statusMsg = i18n.T("Hybrid search: computing metrics…")
 					cmds = append(cmds, BuildHybridMetricsCmd(m.issuesForAsync()))
 				} else if m.semanticHybridEnabled {
-					m.statusMsg = fmt.Sprintf("Hybrid search enabled (%s)", m.semanticHybridPreset)
+					m.statusMsg = i18n.Tf("Hybrid search enabled (%s)", m.semanticHybridPreset)
 				} else {
-					m.statusMsg = "Semantic search: text-only"
+					m.statusMsg = i18n.T("Semantic search: text-only")
 				}
 				if m.semanticSearchEnabled && m.list.FilterState() != list.Unfiltered {
 					currentTerm := m.list.FilterInput.Value()
@@ -2369,9 +2774,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				m.clearSemanticScores()
 				if m.semanticHybridEnabled {
-					m.statusMsg = fmt.Sprintf("Hybrid preset: %s", m.semanticHybridPreset)
+					m.statusMsg = i18n.Tf("Hybrid preset: %s", m.semanticHybridPreset)
 				} else {
-					m.statusMsg = fmt.Sprintf("Hybrid preset set (%s)", m.semanticHybridPreset)
+					m.statusMsg = i18n.Tf("Hybrid preset set (%s)", m.semanticHybridPreset)
 				}
 				if m.semanticSearchEnabled && m.semanticHybridEnabled && m.list.FilterState() != list.Unfiltered {
 					currentTerm := m.list.FilterInput.Value()
@@ -2384,8 +2789,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
-		// Semantic search toggle (bv-9gf.3)
-		if msg.String() == "ctrl+s" && m.focused == focusList {
+		// Semantic search toggle (bv-9gf.3); the capture form and the
+		// composer use ctrl+s themselves
+		if msg.String() == "ctrl+s" && m.focused == focusList && !m.showCapture && !m.showComposer {
 			m.statusIsError = false
 			m.semanticSearchEnabled = !m.semanticSearchEnabled
 			if m.semanticSearchEnabled {
@@ -2393,17 +2799,17 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.list.Filter = m.semanticSearch.Filter
 					if !m.semanticSearch.Snapshot().Ready && !m.semanticIndexBuilding {
 						m.semanticIndexBuilding = true
-						m.statusMsg = "Semantic search: building index…"
-						cmds = append(cmds, BuildSemanticIndexCmd(m.issuesForAsync()))
+						m.statusMsg = i18n.T("Semantic search: building index…")
+						cmds = append(cmds, BuildSemanticIndexCmd(m.issuesForAsync(), !m.readOnly))
 					} else if !m.semanticSearch.Snapshot().Ready && m.semanticIndexBuilding {
-						m.statusMsg = "Semantic search: indexing…"
+						m.statusMsg = i18n.T("Semantic search: indexing…")
 					} else {
-						m.statusMsg = "Semantic search enabled"
+						m.statusMsg = i18n.T("Semantic search enabled")
 					}
 				} else {
 					m.semanticSearchEnabled = false
-					m.list.Filter = list.DefaultFilter
-					m.statusMsg = "Semantic search unavailable"
+					m.list.Filter = m.fuzzyFilter.Filter
+					m.statusMsg = i18n.T("Semantic search unavailable")
 					m.statusIsError = true
 				}
 				if m.semanticHybridEnabled && !m.semanticHybridReady && !m.semanticHybridBuilding {
@@ -2411,8 +2817,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					cmds = append(cmds, BuildHybridMetricsCmd(m.issuesForAsync()))
 				}
 			} else {
-				m.list.Filter = list.DefaultFilter
-				m.statusMsg = "Fuzzy search enabled"
+				m.list.Filter = m.fuzzyFilter.Filter
+				m.statusMsg = i18n.T("Fuzzy search enabled")
 				m.clearSemanticScores()
 			}
 
@@ -2458,53 +2864,219 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m = m.handleTimeTravelInputKeys(msg)
 			return m, nil
 		}
-
-		// Handle keys when not filtering
-		if m.list.FilterState() != list.Filtering {
-			switch msg.String() {
-			case "ctrl+c":
+		if m.focused == focusCommandPrompt {
+			if msg.String() == "ctrl+c" {
 				return m, tea.Quit
-
-			case "q":
-				// q closes current view or quits if at top level
-				if m.showDetails && !m.isSplitView {
-					m.showDetails = false
-					m.focused = focusList
-					return m, nil
-				}
-				if m.focused == focusInsights {
-					m.focused = focusList
-					return m, nil
-				}
-				if m.focused == focusFlowMatrix {
-					if m.flowMatrix.showDrilldown {
-						m.flowMatrix.showDrilldown = false
-						return m, nil
-					}
-					m.focused = focusList
-					return m, nil
-				}
-				if m.isGraphView {
-					m.isGraphView = false
-					m.focused = focusList
-					return m, nil
-				}
-				if m.isBoardView {
-					m.isBoardView = false
-					m.focused = focusList
-					return m, nil
-				}
+			}
+			return m.handleCommandPromptKeys(msg)
+		}
+		if m.focused == focusActionMenu {
+			if msg.String() == "ctrl+c" {
 				return m, tea.Quit
-
-			case "esc":
-				// Escape closes modals and goes back
-				if m.showDetails && !m.isSplitView {
-					m.showDetails = false
-					m.focused = focusList
-					return m, nil
-				}
-				if m.focused == focusInsights {
-					m.focused = focusList
+			}
+			return m.handleActionMenuKeys(msg)
+		}
+		if m.showCommandOutput {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handleCommandOutputKeys(msg)
+		}
+		if m.showPager {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handlePagerKeys(msg)
+		}
+		if m.showWatchFeed {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handleWatchFeedKeys(msg)
+		}
+		if m.showDiagnostics {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handleDiagnosticsKeys(msg)
+		}
+		if m.showConfig {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handleConfigKeys(msg)
+		}
+		if m.showUsage {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handleUsageKeys(msg)
+		}
+		if m.showSprintPlan {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handleSprintPlanKeys(msg)
+		}
+		if m.showImpact {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handleImpactKeys(msg)
+		}
+		if m.showDuplicates {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handleDuplicatesKeys(msg)
+		}
+		if m.showEscalations {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handleEscalationsKeys(msg)
+		}
+		if m.showActivity {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handleActivityKeys(msg)
+		}
+		if m.showHookTrust {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handleHookTrustKeys(msg)
+		}
+		if m.showCapture {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handleCaptureKeys(msg)
+		}
+		if m.showDrafts {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handleDraftsKeys(msg)
+		}
+		if m.showComposer {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handleComposerKeys(msg)
+		}
+
+		// Handle keys when not filtering
+		if m.list.FilterState() != list.Filtering {
+			switch msg.String() {
+			case "ctrl+c":
+				return m, tea.Quit
+
+			case ":":
+				// Leave ":" to views that are taking text input.
+				if !m.board.IsSearchMode() && !m.historyView.IsSearchActive() && !m.showLabelPicker && !m.showRecipePicker && !m.showRepoPicker {
+					m.openCommandPrompt()
+					return m, nil
+				}
+
+			case ".":
+				if !m.board.IsSearchMode() && !m.historyView.IsSearchActive() && !m.showLabelPicker && !m.showRecipePicker && !m.showRepoPicker {
+					m.openActionMenu()
+					return m, nil
+				}
+
+			case "W":
+				if !m.board.IsSearchMode() && !m.historyView.IsSearchActive() && !m.showLabelPicker && !m.showRecipePicker && !m.showRepoPicker {
+					m.openWatchFeed()
+					return m, nil
+				}
+
+			case "D":
+				if !m.board.IsSearchMode() && !m.historyView.IsSearchActive() && !m.showLabelPicker && !m.showRecipePicker && !m.showRepoPicker {
+					m.openDrafts()
+					return m, nil
+				}
+
+			case "Z":
+				// Pause/resume auto-refresh; Ctrl+R still reloads while paused.
+				if !m.board.IsSearchMode() && !m.historyView.IsSearchActive() && !m.showLabelPicker && !m.showRecipePicker && !m.showRepoPicker {
+					if err := m.setAutoRefreshPaused(!m.autoRefreshPaused()); err != nil {
+						m.statusMsg = err.Error()
+						m.statusIsError = true
+					}
+					return m, nil
+				}
+
+			case "+":
+				if !m.board.IsSearchMode() && !m.historyView.IsSearchActive() && !m.showLabelPicker && !m.showRecipePicker && !m.showRepoPicker {
+					m.openCapture("")
+					return m, nil
+				}
+
+			case "|":
+				if !m.board.IsSearchMode() && !m.historyView.IsSearchActive() && !m.showLabelPicker && !m.showRecipePicker && !m.showRepoPicker {
+					return m.pageSelection()
+				}
+
+			case "backspace", "ctrl+o":
+				if !m.board.IsSearchMode() && !m.historyView.IsSearchActive() && !m.showLabelPicker && !m.showRecipePicker && !m.showRepoPicker {
+					return m.goBack(), nil
+				}
+
+			case "q":
+				// q closes current view or quits if at top level
+				if m.showDetails && !m.isSplitView {
+					m.showDetails = false
+					m.focused = focusList
+					return m, nil
+				}
+				if m.focused == focusInsights {
+					m.focused = focusList
+					return m, nil
+				}
+				if m.focused == focusFlowMatrix {
+					if m.flowMatrix.showDrilldown {
+						m.flowMatrix.showDrilldown = false
+						return m, nil
+					}
+					m.focused = focusList
+					return m, nil
+				}
+				if m.focused == focusTreemap {
+					m.focused = focusList
+					return m, nil
+				}
+				if m.focused == focusTimeline {
+					m.focused = focusList
+					return m, nil
+				}
+				if m.isGraphView {
+					m.isGraphView = false
+					m.focused = focusList
+					return m, nil
+				}
+				if m.isBoardView {
+					m.isBoardView = false
+					m.focused = focusList
+					return m, nil
+				}
+				return m.quitOrReview()
+
+			case "esc":
+				// Escape goes back to the previous view, and from the list
+				// clears filters, then asks to quit
+				if loc, ok := m.location(); ok && loc.view != "list" && len(m.nav.back) > 0 &&
+					!m.showLabelPicker && !m.flowMatrix.showDrilldown {
+					return m.goBack(), nil
+				}
+				if m.showDetails && !m.isSplitView {
+					m.showDetails = false
+					m.focused = focusList
+					return m, nil
+				}
+				if m.focused == focusInsights {
+					m.focused = focusList
 					return m, nil
 				}
 				if m.focused == focusFlowMatrix {
@@ -2515,6 +3087,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.focused = focusList
 					return m, nil
 				}
+				if m.focused == focusTreemap {
+					m.focused = focusList
+					return m, nil
+				}
+				if m.focused == focusTimeline {
+					m.focused = focusList
+					return m, nil
+				}
 				if m.isGraphView {
 					m.isGraphView = false
 					m.focused = focusList
@@ -2557,6 +3137,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 
 			case "tab":
+				// Terminals send Tab for Ctrl+I, the jumplist's forward key.
+				// Only the full-width list and details leave Tab free for it.
+				if !m.isSplitView && (m.focused == focusList || m.focused == focusDetail) && len(m.nav.forward) > 0 {
+					return m.goForward(), nil
+				}
 				if m.isSplitView && !m.isBoardView {
 					if m.focused == focusList {
 						m.focused = focusDetail
@@ -2655,7 +3240,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					if hasInsights {
 						m.insightsPanel = NewInsightsModel(ins, m.issueMap, m.theme)
 						// Include priority triage (bv-91) - reuse existing analyzer/stats (bv-runn.12)
-						triage := analysis.ComputeTriageFromAnalyzer(m.analyzer, m.analysis, m.issues, analysis.TriageOptions{}, time.Now())
+						triage := analysis.ComputeTriageFromAnalyzer(m.analyzer, m.analysis, m.issues, analysis.TriageOptions{}, clockNow(m.now))
 						m.insightsPanel.SetTopPicks(triage.QuickRef.TopPicks)
 						// Set full recommendations with breakdown for priority radar (bv-93)
 						dataHash := fmt.Sprintf("v%s@%s#%d", triage.Meta.Version, triage.Meta.GeneratedAt.Format("15:04:05"), triage.Meta.IssueCount)
@@ -2678,9 +3263,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				if m.showPriorityHints {
 					count := len(m.priorityHints)
 					if count > 0 {
-						m.statusMsg = fmt.Sprintf("Priority hints: ↑ increase ↓ decrease (%d suggestions)", count)
+						m.statusMsg = i18n.Tf("Priority hints: ↑ increase ↓ decrease (%d suggestions)", count)
 					} else {
-						m.statusMsg = "Priority hints: No misalignments detected (analysis ongoing)"
+						m.statusMsg = i18n.T("Priority hints: No misalignments detected (analysis ongoing)")
 					}
 				} else {
 					m.statusMsg = ""
@@ -2723,7 +3308,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				m.labelDashboard.SetData(m.labelHealthCache.Labels)
 				m.labelDashboard.SetSize(m.width, m.height-1)
-				m.statusMsg = fmt.Sprintf("Labels: %d total • critical %d • warning %d", m.labelHealthCache.TotalLabels, m.labelHealthCache.CriticalCount, m.labelHealthCache.WarningCount)
+				m.statusMsg = i18n.Tf("Labels: %d total • critical %d • warning %d", m.labelHealthCache.TotalLabels, m.labelHealthCache.CriticalCount, m.labelHealthCache.WarningCount)
 				m.statusIsError = false
 				return m, nil
 
@@ -2751,6 +3336,40 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.insightsPanel.SetSize(m.width, panelHeight)
 				return m, nil
 
+			case "#":
+				// Treemap of open issues by estimate
+				m.clearAttentionOverlay()
+				if m.focused == focusTreemap {
+					m.focused = focusList
+					return m, nil
+				}
+				m.isGraphView = false
+				m.isBoardView = false
+				m.isActionableView = false
+				m.isHistoryView = false
+				m.focused = focusTreemap
+				m.treemap = NewTreemapModel(m.theme)
+				m.treemap.SetSize(m.width, m.height-1)
+				m.treemap.SetData(m.issues, clockNow(m.now))
+				return m, nil
+
+			case "@":
+				// Timeline of issues with start or due dates
+				m.clearAttentionOverlay()
+				if m.focused == focusTimeline {
+					m.focused = focusList
+					return m, nil
+				}
+				m.isGraphView = false
+				m.isBoardView = false
+				m.isActionableView = false
+				m.isHistoryView = false
+				m.focused = focusTimeline
+				m.timeline = NewTimelineModel(m.theme)
+				m.timeline.SetSize(m.width, m.height-1)
+				m.timeline.SetData(m.issues, clockNow(m.now))
+				return m, nil
+
 			case "f":
 				// Flow matrix view (cross-label dependencies)
 				m.clearAttentionOverlay()
@@ -2783,7 +3402,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.showAlertsPanel = !m.showAlertsPanel
 					m.alertsCursor = 0 // Reset cursor when opening
 				} else {
-					m.statusMsg = "No active alerts"
+					m.statusMsg = i18n.T("No active alerts")
 					m.statusIsError = false
 				}
 				return m, nil
@@ -2802,7 +3421,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case "w":
 				// Toggle repo picker overlay (workspace mode)
 				if !m.workspaceMode || len(m.availableRepos) == 0 {
-					m.statusMsg = "Repo filter available only in workspace mode"
+					m.statusMsg = i18n.T("Repo filter available only in workspace mode")
 					m.statusIsError = false
 					return m, nil
 				}
@@ -2859,7 +3478,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case focusLabelDashboard:
 				if selectedLabel, cmd := m.labelDashboard.Update(msg); selectedLabel != "" {
 					// Filter list by selected label and jump back to list view
-					m.currentFilter = "label:" + selectedLabel
+					m.currentFilter = "label:" + beadsview.QuoteFilterValue(selectedLabel)
 					m.applyFilter()
 					m.focused = focusList
 					return m, cmd
@@ -2906,10 +3525,26 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case focusFlowMatrix:
 				m = m.handleFlowMatrixKeys(msg)
 
+			case focusTreemap:
+				m = m.handleTreemapKeys(msg)
+
+			case focusTimeline:
+				m = m.handleTimelineKeys(msg)
+
 			case focusList:
+				if msg.String() == "v" {
+					return m.toggleVote(m.actionTarget())
+				}
 				m = m.handleListKeys(msg)
 
 			case focusDetail:
+				switch msg.String() {
+				case "m":
+					m.openComposer(m.actionTarget())
+					return m, nil
+				case "v":
+					return m.toggleVote(m.actionTarget())
+				}
 				m.viewport, cmd = m.viewport.Update(msg)
 				cmds = append(cmds, cmd)
 			}
@@ -2979,63 +3614,23 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	case tea.WindowSizeMsg:
-		m.width = msg.Width
-		m.height = msg.Height
-		m.isSplitView = msg.Width > SplitViewThreshold
-		m.ready = true
-		bodyHeight := m.height - 1 // keep 1 row for footer
-		if bodyHeight < 5 {
-			bodyHeight = 5
-		}
-
-		if m.isSplitView {
-			// Calculate dimensions accounting for 2 panels with borders(2)+padding(2) = 4 overhead each
-			// Total overhead = 8
-			availWidth := msg.Width - 8
-			if availWidth < 10 {
-				availWidth = 10
-			}
-
-			listInnerWidth := int(float64(availWidth) * 0.4)
-			detailInnerWidth := availWidth - listInnerWidth
-
-			// listHeight fits header (1) + page line (1) inside a panel with Border (2)
-			listHeight := bodyHeight - 4
-			if listHeight < 3 {
-				listHeight = 3
-			}
-
-			m.list.SetSize(listInnerWidth, listHeight)
-			m.viewport = viewport.New(detailInnerWidth, bodyHeight-2) // Account for border
-
-			m.renderer.SetWidthWithTheme(detailInnerWidth, m.theme)
-		} else {
-			listHeight := bodyHeight - 2
-			if listHeight < 3 {
-				listHeight = 3
-			}
-			m.list.SetSize(msg.Width, listHeight)
-			m.viewport = viewport.New(msg.Width, bodyHeight-1)
-
-			// Update renderer for full width
-			m.renderer.SetWidthWithTheme(msg.Width, m.theme)
+		apply, resizeCmd := platformResize(msg)
+		if !apply {
+			return m, nil
 		}
+		cmds = append(cmds, resizeCmd, m.applySize(msg.Width, msg.Height))
 
-		m.updateListDelegate()
-
-		// Resize label dashboard table and modal overlay sizing
-		m.labelDashboard.SetSize(m.width, bodyHeight)
-
-		m.insightsPanel.SetSize(m.width, bodyHeight)
-		m.updateViewportContent()
+	case resizeSettledMsg:
+		m.settleResize(msg)
 	}
 
 	// Update list for navigation, but NOT for WindowSizeMsg
 	// (we handle sizing ourselves to account for header/footer)
 	// Only forward keyboard messages to list when list has focus (bv-hmkz fix)
 	// This prevents j/k keys in detail view from changing list selection
+	_, isWindowSize := msg.(tea.WindowSizeMsg)
 	if m.focused == focusList {
-		if _, isWindowSize := msg.(tea.WindowSizeMsg); !isWindowSize {
+		if !isWindowSize {
 			m.list, cmd = m.list.Update(msg)
 			cmds = append(cmds, cmd)
 		}
@@ -3054,8 +3649,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.updateListDelegate()
 	}
 
-	// Update viewport if list selection changed in split view
-	if m.isSplitView && m.focused == focusList {
+	// Update viewport if list selection changed in split view; a resize
+	// rewraps the details itself (see resize.go)
+	if m.isSplitView && m.focused == focusList && !isWindowSize {
 		m.updateViewportContent()
 	}
 
@@ -3180,37 +3776,34 @@ func (m Model) handleBoardKeys(msg tea.KeyMsg) Model {
 	// Copy ID to clipboard (bv-yg39)
 	case "y":
 		if selected := m.board.SelectedIssue(); selected != nil {
-			if err := clipboard.WriteAll(selected.ID); err != nil {
-				m.statusMsg = fmt.Sprintf("❌ Clipboard error: %v", err)
-				m.statusIsError = true
-			} else {
-				m.statusMsg = fmt.Sprintf("📋 Copied %s to clipboard", selected.ID)
-				m.statusIsError = false
-			}
+			m.copyToClipboard(selected.ID, selected.ID)
 		}
 
 	// Global filter keys (bv-naov) - consistent with list view
 	case "o":
 		m.currentFilter = "open"
 		m.applyFilter()
-		m.statusMsg = "Filter: Open issues"
+		m.statusMsg = i18n.T("Filter: Open issues")
 		m.statusIsError = false
 	case "c":
 		m.currentFilter = "closed"
 		m.applyFilter()
-		m.statusMsg = "Filter: Closed issues"
+		m.statusMsg = i18n.T("Filter: Closed issues")
 		m.statusIsError = false
 	case "r":
 		m.currentFilter = "ready"
 		m.applyFilter()
-		m.statusMsg = "Filter: Ready (no blockers)"
+		m.statusMsg = i18n.T("Filter: Ready (no blockers)")
+		if note := m.externalNote(); note != "" {
+			m.statusMsg += " · " + note
+		}
 		m.statusIsError = false
 
 	// Swimlane mode cycling (bv-wjs0)
 	case "s":
 		m.board.CycleSwimLaneMode()
 		modeName := m.board.GetSwimLaneModeName()
-		m.statusMsg = fmt.Sprintf("🔀 Swimlane: %s", modeName)
+		m.statusMsg = i18n.Tf("🔀 Swimlane: %s", modeName)
 		m.statusIsError = false
 
 	// Empty column visibility toggle (bv-tf6j)
@@ -3219,9 +3812,9 @@ func (m Model) handleBoardKeys(msg tea.KeyMsg) Model {
 		visMode := m.board.GetEmptyColumnVisibilityMode()
 		hidden := m.board.HiddenColumnCount()
 		if hidden > 0 {
-			m.statusMsg = fmt.Sprintf("👁 Empty columns: %s (%d hidden)", visMode, hidden)
+			m.statusMsg = i18n.Tf("👁 Empty columns: %s (%d hidden)", visMode, hidden)
 		} else {
-			m.statusMsg = fmt.Sprintf("👁 Empty columns: %s", visMode)
+			m.statusMsg = i18n.Tf("👁 Empty columns: %s", visMode)
 		}
 		m.statusIsError = false
 
@@ -3229,9 +3822,9 @@ func (m Model) handleBoardKeys(msg tea.KeyMsg) Model {
 	case "d":
 		m.board.ToggleExpand()
 		if m.board.HasExpandedCard() {
-			m.statusMsg = "📋 Card expanded (d=collapse, j/k=auto-collapse)"
+			m.statusMsg = i18n.T("📋 Card expanded (d=collapse, j/k=auto-collapse)")
 		} else {
-			m.statusMsg = "📋 Card collapsed"
+			m.statusMsg = i18n.T("📋 Card collapsed")
 		}
 		m.statusIsError = false
 
@@ -3401,7 +3994,7 @@ func (m Model) handleHistoryKeys(msg tea.KeyMsg) Model {
 		switch msg.String() {
 		case "esc":
 			m.historyView.CancelSearch()
-			m.statusMsg = "🔍 Search cancelled"
+			m.statusMsg = i18n.T("🔍 Search cancelled")
 			m.statusIsError = false
 			return m
 		case "enter":
@@ -3413,9 +4006,9 @@ func (m Model) handleHistoryKeys(msg tea.KeyMsg) Model {
 			m.historyView.UpdateSearchInput(msg)
 			query := m.historyView.SearchQuery()
 			if query != "" {
-				m.statusMsg = fmt.Sprintf("🔍 Filtering: %s", query)
+				m.statusMsg = i18n.Tf("🔍 Filtering: %s", query)
 			} else {
-				m.statusMsg = "🔍 Type to search..."
+				m.statusMsg = i18n.T("🔍 Type to search...")
 			}
 			m.statusIsError = false
 			return m
@@ -3440,7 +4033,7 @@ func (m Model) handleHistoryKeys(msg tea.KeyMsg) Model {
 				} else {
 					m.historyView.SelectFile()
 					name := m.historyView.SelectedFileName()
-					m.statusMsg = fmt.Sprintf("📁 Filtering by: %s", name)
+					m.statusMsg = i18n.Tf("📁 Filtering by: %s", name)
 					m.statusIsError = false
 				}
 			}
@@ -3453,10 +4046,10 @@ func (m Model) handleHistoryKeys(msg tea.KeyMsg) Model {
 			// If filter is active, clear it; otherwise close file tree
 			if m.historyView.GetFileFilter() != "" {
 				m.historyView.ClearFileFilter()
-				m.statusMsg = "📁 File filter cleared"
+				m.statusMsg = i18n.T("📁 File filter cleared")
 			} else {
 				m.historyView.SetFileTreeFocus(false)
-				m.statusMsg = "📁 File tree: press Tab to return focus"
+				m.statusMsg = i18n.T("📁 File tree: press Tab to return focus")
 			}
 			m.statusIsError = false
 			return m
@@ -3471,15 +4064,15 @@ func (m Model) handleHistoryKeys(msg tea.KeyMsg) Model {
 	case "/":
 		// Start search (bv-nkrj)
 		m.historyView.StartSearch()
-		m.statusMsg = "🔍 Type to search commits, beads, authors..."
+		m.statusMsg = i18n.T("🔍 Type to search commits, beads, authors...")
 		m.statusIsError = false
 	case "v":
 		// Toggle between Bead mode and Git mode (bv-tl3n)
 		m.historyView.ToggleViewMode()
 		if m.historyView.IsGitMode() {
-			m.statusMsg = "🔀 Git Mode: commits on left, related beads on right"
+			m.statusMsg = i18n.T("🔀 Git Mode: commits on left, related beads on right")
 		} else {
-			m.statusMsg = "📦 Bead Mode: beads on left, commits on right"
+			m.statusMsg = i18n.T("📦 Bead Mode: beads on left, commits on right")
 		}
 		m.statusIsError = false
 	case "j", "down":
@@ -3565,15 +4158,9 @@ func (m Model) handleHistoryKeys(msg tea.KeyMsg) Model {
 			}
 		}
 		if sha != "" {
-			if err := clipboard.WriteAll(sha); err != nil {
-				m.statusMsg = fmt.Sprintf("❌ Clipboard error: %v", err)
-				m.statusIsError = true
-			} else {
-				m.statusMsg = fmt.Sprintf("📋 Copied %s to clipboard", shortSHA)
-				m.statusIsError = false
-			}
+			m.copyToClipboard(sha, shortSHA)
 		} else {
-			m.statusMsg = "❌ No commit selected"
+			m.statusMsg = i18n.T("❌ No commit selected")
 			m.statusIsError = true
 		}
 	case "c":
@@ -3582,9 +4169,9 @@ func (m Model) handleHistoryKeys(msg tea.KeyMsg) Model {
 			m.historyView.CycleConfidence()
 			conf := m.historyView.GetMinConfidence()
 			if conf == 0 {
-				m.statusMsg = "🔍 Showing all commits"
+				m.statusMsg = i18n.T("🔍 Showing all commits")
 			} else {
-				m.statusMsg = fmt.Sprintf("🔍 Confidence filter: ≥%.0f%%", conf*100)
+				m.statusMsg = i18n.Tf("🔍 Confidence filter: ≥%.0f%%", conf*100)
 			}
 			m.statusIsError = false
 		}
@@ -3592,9 +4179,9 @@ func (m Model) handleHistoryKeys(msg tea.KeyMsg) Model {
 		// Toggle file tree panel (bv-190l)
 		m.historyView.ToggleFileTree()
 		if m.historyView.IsFileTreeVisible() {
-			m.statusMsg = "📁 File tree: j/k navigate, Enter select, Esc close"
+			m.statusMsg = i18n.T("📁 File tree: j/k navigate, Enter select, Esc close")
 		} else {
-			m.statusMsg = "📁 File tree hidden"
+			m.statusMsg = i18n.T("📁 File tree hidden")
 		}
 		m.statusIsError = false
 	case "o":
@@ -3612,24 +4199,26 @@ func (m Model) handleHistoryKeys(msg tea.KeyMsg) Model {
 		if sha != "" {
 			url := m.getCommitURL(sha)
 			if url != "" {
+				if m.refuseReadOnly(i18n.T("Opening the browser")) {
+					break
+				}
 				if err := openBrowserURL(url); err != nil {
-					m.statusMsg = fmt.Sprintf("❌ Could not open browser: %v", err)
-					m.statusIsError = true
+					m.reportError(newError(errTerminal, "open browser", err))
 				} else {
 					// Safely truncate SHA for display (bv-xf4p fix)
 					shortSHA := sha
 					if len(sha) > 7 {
 						shortSHA = sha[:7]
 					}
-					m.statusMsg = fmt.Sprintf("🌐 Opened %s in browser", shortSHA)
+					m.statusMsg = i18n.Tf("🌐 Opened %s in browser", shortSHA)
 					m.statusIsError = false
 				}
 			} else {
-				m.statusMsg = "❌ No git remote configured"
+				m.statusMsg = i18n.T("❌ No git remote configured")
 				m.statusIsError = true
 			}
 		} else {
-			m.statusMsg = "❌ No commit selected"
+			m.statusMsg = i18n.T("❌ No commit selected")
 			m.statusIsError = true
 		}
 	case "g":
@@ -3652,10 +4241,10 @@ func (m Model) handleHistoryKeys(msg tea.KeyMsg) Model {
 			m.isHistoryView = false
 			m.graphView.SelectByID(selectedID)
 			m.focused = focusGraph
-			m.statusMsg = fmt.Sprintf("📊 Graph view: %s", selectedID)
+			m.statusMsg = i18n.Tf("📊 Graph view: %s", selectedID)
 			m.statusIsError = false
 		} else {
-			m.statusMsg = "❌ No bead selected"
+			m.statusMsg = i18n.T("❌ No bead selected")
 			m.statusIsError = true
 		}
 	case "h", "esc":
@@ -3783,6 +4372,68 @@ func (m Model) handleFlowMatrixKeys(msg tea.KeyMsg) Model {
 	return m
 }
 
+// handleTreemapKeys handles keyboard input when the treemap view is focused
+func (m Model) handleTreemapKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "j", "down", "right":
+		m.treemap.MoveNext()
+	case "k", "up", "left":
+		m.treemap.MovePrev()
+	case "c":
+		m.treemap.ToggleColoring()
+	case "enter":
+		issue := m.treemap.SelectedIssue()
+		if issue == nil {
+			return m
+		}
+		m.focused = focusList
+		m = m.gotoIssue(issue.ID)
+		if m.isSplitView {
+			m.focused = focusDetail
+		} else {
+			m.showDetails = true
+			m.focused = focusDetail
+			m.viewport.GotoTop()
+		}
+		m.updateViewportContent()
+	}
+	return m
+}
+
+// handleTimelineKeys handles keyboard input when the timeline view is focused
+func (m Model) handleTimelineKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "j", "down":
+		m.timeline.MoveDown()
+	case "k", "up":
+		m.timeline.MoveUp()
+	case "right", "L":
+		m.timeline.Scroll(true)
+	case "left", "H":
+		m.timeline.Scroll(false)
+	case "z":
+		m.timeline.CycleZoom()
+	case "t":
+		m.timeline.ScrollToToday()
+	case "enter":
+		issue := m.timeline.SelectedIssue()
+		if issue == nil {
+			return m
+		}
+		m.focused = focusList
+		m = m.gotoIssue(issue.ID)
+		if m.isSplitView {
+			m.focused = focusDetail
+		} else {
+			m.showDetails = true
+			m.focused = focusDetail
+			m.viewport.GotoTop()
+		}
+		m.updateViewportContent()
+	}
+	return m
+}
+
 // handleRecipePickerKeys handles keyboard input when recipe picker is focused
 func (m Model) handleRecipePickerKeys(msg tea.KeyMsg) Model {
 	switch msg.String() {
@@ -3825,10 +4476,10 @@ func (m Model) handleRepoPickerKeys(msg tea.KeyMsg) Model {
 		// Normalize: nil means "all repos" (no filter). Also treat empty as "all" to avoid hiding everything.
 		if len(selected) == 0 || len(selected) == len(m.availableRepos) {
 			m.activeRepos = nil
-			m.statusMsg = "Repo filter: all repos"
+			m.statusMsg = i18n.T("Repo filter: all repos")
 		} else {
 			m.activeRepos = selected
-			m.statusMsg = fmt.Sprintf("Repo filter: %s", formatRepoList(sortedRepoKeys(selected), 3))
+			m.statusMsg = i18n.Tf("Repo filter: %s", formatRepoList(sortedRepoKeys(selected), 3))
 		}
 		m.statusIsError = false
 
@@ -3857,9 +4508,9 @@ func (m Model) handleLabelPickerKeys(msg tea.KeyMsg) Model {
 		m.labelPicker.MoveUp()
 	case "enter":
 		if selected := m.labelPicker.SelectedLabel(); selected != "" {
-			m.currentFilter = "label:" + selected
+			m.currentFilter = "label:" + beadsview.QuoteFilterValue(selected)
 			m.applyFilter()
-			m.statusMsg = fmt.Sprintf("Filtered by label: %s", selected)
+			m.statusMsg = i18n.Tf("Filtered by label: %s", selected)
 			m.statusIsError = false
 		}
 		m.showLabelPicker = false
@@ -3969,6 +4620,9 @@ func (m Model) handleListKeys(msg tea.KeyMsg) Model {
 	case "r":
 		m.currentFilter = "ready"
 		m.applyFilter()
+		if note := m.externalNote(); note != "" {
+			m.statusMsg, m.statusIsError = "Ready: "+note, false
+		}
 	case "a":
 		m.currentFilter = "all"
 		m.applyFilter()
@@ -4009,7 +4663,7 @@ func (m Model) handleListKeys(msg tea.KeyMsg) Model {
 		}
 	case "s":
 		// Cycle sort mode (bv-3ita)
-		m.cycleSortMode()
+		m.cycleSort()
 	case "V":
 		// Show cass session preview modal (bv-5bqh)
 		m.showCassSessionModal()
@@ -4045,6 +4699,25 @@ func (m Model) handleTimeTravelInputKeys(msg tea.KeyMsg) Model {
 	return m
 }
 
+// TriggerFullTutorial opens the interactive tutorial with all of its pages,
+// closing help if it is open.
+func (m *Model) TriggerFullTutorial() {
+	m.showHelp = false
+	m.helpScroll = 0
+	m.showTutorial = true
+	m.tutorialModel.SetContextMode(false)
+	m.tutorialModel.SetSize(m.width, m.height)
+	m.focused = focusTutorial
+}
+
+// TriggerContextHelp shows the tutorial pages for the current view.
+func (m *Model) TriggerContextHelp() {
+	ctx := m.CurrentContext()
+	m.TriggerFullTutorial()
+	m.tutorialModel.SetContext(string(ctx))
+	m.tutorialModel.SetContextMode(true)
+}
+
 // restoreFocusFromHelp returns the appropriate focus based on current view state.
 // This fixes the bug where dismissing help would always return to focusList,
 // even when the user was in a specialized view (graph, board, insights, etc.).
@@ -4080,6 +4753,12 @@ func (m Model) restoreFocusFromHelp() focus {
 	if m.focusBeforeHelp == focusFlowMatrix {
 		return focusFlowMatrix
 	}
+	if m.focusBeforeHelp == focusTreemap {
+		return focusTreemap
+	}
+	if m.focusBeforeHelp == focusTimeline {
+		return focusTimeline
+	}
 	if m.focusBeforeHelp == focusAttention {
 		return focusAttention
 	}
@@ -4120,11 +4799,7 @@ func (m Model) handleHelpKeys(msg tea.KeyMsg) Model {
 		m.helpScroll = 0
 		m.focused = m.restoreFocusFromHelp()
 	case " ": // Space opens interactive tutorial (bv-0trk, bv-8y31)
-		m.showHelp = false
-		m.helpScroll = 0
-		m.showTutorial = true
-		m.tutorialModel.SetSize(m.width, m.height)
-		m.focused = focusTutorial
+		m.TriggerFullTutorial()
 	default:
 		// Any other key dismisses help and restores previous focus
 		m.showHelp = false
@@ -4147,7 +4822,7 @@ func (m Model) renderLoadingScreen() string {
 	lines := []string{
 		spinnerStyle.Render(frame),
 		"",
-		titleStyle.Render("Loading beads..."),
+		titleStyle.Render(i18n.T("Loading beads...")),
 	}
 	if m.beadsPath != "" {
 		lines = append(lines, "", subStyle.Render(m.beadsPath))
@@ -4158,15 +4833,43 @@ func (m Model) renderLoadingScreen() string {
 }
 
 func (m Model) View() string {
+	if m.perf == nil || !m.ready || m.accessible {
+		return m.view()
+	}
+	start := time.Now()
+	view := m.view()
+	m.perf.recordFrame(time.Since(start))
+	return overlayTopRight(view, m.renderFrameStats(), m.width)
+}
+
+func (m Model) view() string {
 	if !m.ready {
 		return "Initializing..."
 	}
+	m.startup.paint()
+
+	// Privacy screen hides everything, footer included
+	if m.idleLock.Locked() {
+		if m.accessible {
+			return plainText(m.renderIdleLock())
+		}
+		return m.renderIdleLock()
+	}
+
+	// Below the minimum size any layout wraps; say what is needed instead
+	if m.layout.tooSmall(m.width, m.height) && !m.accessible {
+		return m.renderTooSmall()
+	}
 
 	var body string
 
-	// Quit confirmation overlay takes highest priority
-	if m.showQuitConfirm {
+	// Quit-time overlays take highest priority
+	if m.showSessionReview {
+		body = m.renderSessionReview()
+	} else if m.showQuitConfirm {
 		body = m.renderQuitConfirm()
+	} else if m.errorModal != nil {
+		body = m.renderErrorModal()
 	} else if m.showAgentPrompt {
 		// AGENTS.md prompt modal (bv-i8dk)
 		body = m.agentPromptModal.CenterModal(m.width, m.height-1)
@@ -4186,6 +4889,40 @@ func (m Model) View() string {
 		body = m.renderAlertsPanel()
 	} else if m.showTimeTravelPrompt {
 		body = m.renderTimeTravelPrompt()
+	} else if m.showActionMenu {
+		body = m.renderActionMenu()
+	} else if m.showCommandOutput {
+		body = m.renderCommandOutput()
+	} else if m.showPager {
+		body = m.renderPager()
+	} else if m.showWatchFeed {
+		body = m.renderWatchFeed()
+	} else if m.showDiagnostics {
+		body = m.renderDiagnostics()
+	} else if m.showConfig {
+		body = m.renderConfig()
+	} else if m.showUsage {
+		body = m.renderUsage()
+	} else if m.showSprintPlan {
+		body = m.renderSprintPlan()
+	} else if m.showImpact {
+		body = m.renderImpact()
+	} else if m.showDuplicates {
+		body = m.renderDuplicates()
+	} else if m.showEscalations {
+		body = m.renderEscalations()
+	} else if m.showActivity {
+		body = m.renderActivity()
+	} else if m.showHookTrust {
+		body = m.renderHookTrust()
+	} else if m.showDebugConsole {
+		body = m.renderDebugConsole()
+	} else if m.showCapture {
+		body = m.renderCapture()
+	} else if m.showDrafts {
+		body = m.renderDrafts()
+	} else if m.showComposer {
+		body = m.renderComposer()
 	} else if m.showRecipePicker {
 		body = m.recipePicker.View()
 	} else if m.showRepoPicker {
@@ -4205,6 +4942,12 @@ func (m Model) View() string {
 	} else if m.focused == focusFlowMatrix {
 		m.flowMatrix.SetSize(m.width, m.height-1)
 		body = m.flowMatrix.View()
+	} else if m.focused == focusTreemap {
+		m.treemap.SetSize(m.width, m.height-1)
+		body = m.treemap.View()
+	} else if m.focused == focusTimeline {
+		m.timeline.SetSize(m.width, m.height-1)
+		body = m.timeline.View()
 	} else if m.focused == focusTree {
 		// Hierarchical tree view (bv-gllx)
 		m.tree.SetSize(m.width, m.height-1)
@@ -4228,7 +4971,9 @@ func (m Model) View() string {
 		body = m.labelDashboard.View()
 	} else {
 		// Mobile view
-		if m.showDetails {
+		if m.accessible && !m.showDetails {
+			body = m.renderAccessibleList()
+		} else if m.showDetails {
 			body = m.viewport.View()
 		} else {
 			body = m.renderListWithHeader()
@@ -4236,7 +4981,7 @@ func (m Model) View() string {
 	}
 
 	// Add shortcuts sidebar if enabled (bv-3qi5)
-	if m.showShortcutsSidebar {
+	if m.showShortcutsSidebar && !m.accessible {
 		// Update sidebar context based on current focus
 		m.shortcutsSidebar.SetContext(ContextFromFocus(m.focused))
 		m.shortcutsSidebar.SetSize(m.shortcutsSidebar.Width(), m.height-2)
@@ -4244,7 +4989,14 @@ func (m Model) View() string {
 		body = lipgloss.JoinHorizontal(lipgloss.Top, body, sidebar)
 	}
 
-	footer := m.renderFooter()
+	if m.accessible {
+		return m.renderAccessible(body)
+	}
+
+	footer := m.renderFooter()
+	if m.showCommandPrompt {
+		footer = m.renderCommandPrompt()
+	}
 
 	// Ensure the final output fits exactly in the terminal height
 	// This prevents the header from being pushed off the top
@@ -4276,9 +5028,9 @@ func (m Model) renderQuitConfirm() string {
 		Foreground(t.Primary).
 		Bold(true)
 
-	content := titleStyle.Render("Quit bv?") + "\n\n" +
-		textStyle.Render("Press ") + keyStyle.Render("Esc") + textStyle.Render(" or ") + keyStyle.Render("Y") + textStyle.Render(" to quit\n") +
-		textStyle.Render("Press any other key to cancel")
+	content := titleStyle.Render(i18n.T("Quit bv?")) + "\n\n" +
+		textStyle.Render(i18n.Tf("Press %s or %s to quit", keyStyle.Render("Esc"), keyStyle.Render("Y"))) + "\n" +
+		textStyle.Render(i18n.T("Press any other key to cancel"))
 
 	box := boxStyle.Render(content)
 
@@ -4312,7 +5064,10 @@ func (m Model) renderListWithHeader() string {
 		// Account for repo badges like [API] shown in workspace mode.
 		headerText = "  REPO TYPE PRI STATUS      ID                               TITLE"
 	}
-	header := headerStyle.Render(headerText)
+	if m.layout.compact(m.width) {
+		headerText = compactListHeader
+	}
+	header := headerStyle.Render(truncateRunesHelper(headerText, m.width-2, ""))
 
 	// Page info
 	totalItems := len(m.list.Items())
@@ -4396,7 +5151,11 @@ func (m Model) renderSplitView() string {
 		Bold(true).
 		Width(listInnerWidth)
 
-	header := headerStyle.Render("  TYPE PRI STATUS      ID                     TITLE")
+	headerText := "  TYPE PRI STATUS      ID                     TITLE"
+	if m.layout.compact(m.width) {
+		headerText = compactListHeader
+	}
+	header := headerStyle.Render(truncateRunesHelper(headerText, listInnerWidth, ""))
 
 	// Page info for list
 	totalItems := len(m.list.Items())
@@ -4434,6 +5193,27 @@ func (m Model) renderSplitView() string {
 	// Combine header + list + page indicator
 	listContent := lipgloss.JoinVertical(lipgloss.Left, header, m.list.View(), pageLine)
 
+	if m.stackedView {
+		// Both panels span the width; the list is sized in Update to leave
+		// the details the rest of the body. Border adds 2 rows to each.
+		listPanelHeight := m.list.Height() + 2
+		detailPanelHeight := panelHeight - listPanelHeight - 4
+		if detailPanelHeight < 1 {
+			detailPanelHeight = 1
+		}
+		listView := listStyle.
+			Width(listInnerWidth + 2).
+			Height(listPanelHeight).
+			MaxHeight(listPanelHeight + 2).
+			Render(listContent)
+		detailView := detailStyle.
+			Width(m.viewport.Width + 2).
+			Height(detailPanelHeight).
+			MaxHeight(detailPanelHeight + 2).
+			Render(m.viewport.View())
+		return lipgloss.JoinVertical(lipgloss.Left, listView, detailView)
+	}
+
 	// List Panel Width: Inner + 2 (Padding). Border adds another 2.
 	// Use MaxHeight to ensure content doesn't overflow
 	listView := listStyle.
@@ -4527,106 +5307,121 @@ func (m *Model) renderHelpOverlay() string {
 
 	// Define all sections
 	navSection := []struct{ key, desc string }{
-		{"j / ↓", "Move down"},
-		{"k / ↑", "Move up"},
-		{"G/end", "Go to last"},
-		{"Ctrl+d", "Page down"},
-		{"Ctrl+u", "Page up"},
-		{"Tab", "Switch focus"},
-		{"Enter", "View details"},
-		{"Esc", "Back / close"},
+		{"j / ↓", i18n.T("Move down")},
+		{"k / ↑", i18n.T("Move up")},
+		{"G/end", i18n.T("Go to last")},
+		{"Ctrl+d", i18n.T("Page down")},
+		{"Ctrl+u", i18n.T("Page up")},
+		{"Tab", i18n.T("Switch focus")},
+		{"Enter", i18n.T("View details")},
+		{"Esc", i18n.T("Back / close")},
+		{"Bksp/^O", i18n.T("Previous view")},
+		{"Tab/^I", i18n.T("Next view (full list)")},
 	}
 
 	viewsSection := []struct{ key, desc string }{
-		{"b", "Kanban board"},
-		{"g", "Graph view"},
-		{"i", "Insights"},
-		{"h", "History view"},
-		{"a", "Actionable"},
-		{"f", "Flow matrix"},
-		{"[", "Label dashboard"},
-		{"]", "Attention view"},
+		{"b", i18n.T("Kanban board")},
+		{"g", i18n.T("Graph view")},
+		{"i", i18n.T("Insights")},
+		{"h", i18n.T("History view")},
+		{"a", i18n.T("Actionable")},
+		{"f", i18n.T("Flow matrix")},
+		{"#", i18n.T("Treemap")},
+		{"@", i18n.T("Timeline")},
+		{"[", i18n.T("Label dashboard")},
+		{"]", i18n.T("Attention view")},
 	}
 
 	globalSection := []struct{ key, desc string }{
-		{"?", "This help"},
-		{";", "Shortcuts bar"},
-		{"!", "Alerts panel"},
-		{"'", "Recipes"},
-		{"w", "Repo picker"},
-		{"q", "Back / Quit"},
-		{"Ctrl+c", "Force quit"},
+		{"?", i18n.T("This help")},
+		{";", i18n.T("Shortcuts bar")},
+		{"!", i18n.T("Alerts panel")},
+		{"'", i18n.T("Recipes")},
+		{"w", i18n.T("Repo picker")},
+		{"+", i18n.T("New issue")},
+		{"q", i18n.T("Back / Quit")},
+		{"Ctrl+c", i18n.T("Force quit")},
 	}
 
 	filterSection := []struct{ key, desc string }{
-		{"/", "Fuzzy search"},
-		{"Ctrl+S", "Semantic search"},
-		{"H", "Hybrid ranking"},
-		{"Alt+H", "Hybrid preset"},
-		{"o", "Open issues"},
-		{"c", "Closed issues"},
-		{"r", "Ready (unblocked)"},
-		{"l", "Filter by label"},
-		{"s", "Cycle sort"},
-		{"S", "Triage sort"},
+		{"/", i18n.T("Fuzzy search")},
+		{"Ctrl+S", i18n.T("Semantic search")},
+		{"H", i18n.T("Hybrid ranking")},
+		{"Alt+H", i18n.T("Hybrid preset")},
+		{"o", i18n.T("Open issues")},
+		{"c", i18n.T("Closed issues")},
+		{"r", i18n.T("Ready (unblocked)")},
+		{"l", i18n.T("Filter by label")},
+		{"s", i18n.T("Cycle sort")},
+		{"S", i18n.T("Triage sort")},
 	}
 
 	graphSection := []struct{ key, desc string }{
-		{"hjkl", "Navigate nodes"},
-		{"H/L", "Scroll left/right"},
-		{"PgUp/Dn", "Scroll up/down"},
-		{"Enter", "Jump to issue"},
+		{"hjkl", i18n.T("Navigate nodes")},
+		{"H/L", i18n.T("Scroll left/right")},
+		{"PgUp/Dn", i18n.T("Scroll up/down")},
+		{"Enter", i18n.T("Jump to issue")},
 	}
 
 	insightsSection := []struct{ key, desc string }{
-		{"h/l/Tab", "Switch panels"},
-		{"j/k", "Navigate items"},
-		{"e", "Explanations"},
-		{"x", "Calc details"},
-		{"m", "Toggle heatmap"},
-		{"Enter", "Jump to issue"},
+		{"h/l/Tab", i18n.T("Switch panels")},
+		{"j/k", i18n.T("Navigate items")},
+		{"e", i18n.T("Explanations")},
+		{"x", i18n.T("Calc details")},
+		{"m", i18n.T("Toggle heatmap")},
+		{"Enter", i18n.T("Jump to issue")},
 	}
 
 	historySection := []struct{ key, desc string }{
-		{"j/k", "Navigate beads"},
-		{"J/K", "Navigate commits"},
-		{"Tab", "Toggle focus"},
-		{"y", "Copy SHA"},
-		{"c", "Confidence filter"},
+		{"j/k", i18n.T("Navigate beads")},
+		{"J/K", i18n.T("Navigate commits")},
+		{"Tab", i18n.T("Toggle focus")},
+		{"y", i18n.T("Copy SHA")},
+		{"c", i18n.T("Confidence filter")},
 	}
 
 	actionsSection := []struct{ key, desc string }{
-		{"p", "Priority hints"},
-		{"Ctrl+R", "Force refresh"},
-		{"F5", "Force refresh"},
-		{"t", "Time-travel"},
-		{"T", "Quick time-travel"},
-		{"x", "Export markdown"},
-		{"C", "Copy to clipboard"},
-		{"O", "Open in editor"},
+		{"p", i18n.T("Priority hints")},
+		{":", i18n.T("Command / alias / goto")},
+		{".", i18n.T("Issue actions")},
+		{"W", i18n.T("Watched changes")},
+		{"D", i18n.T("Drafts")},
+		{"Ctrl+R", i18n.T("Force refresh")},
+		{"F5", i18n.T("Force refresh")},
+		{"Z", i18n.T("Pause auto-refresh")},
+		{"t", i18n.T("Time-travel")},
+		{"T", i18n.T("Quick time-travel")},
+		{"x", i18n.T("Export markdown")},
+		{"C", i18n.T("Copy to clipboard")},
+		{"O", i18n.T("Open in editor")},
+		{"|", i18n.T("Page in $PAGER")},
+		{"m", i18n.T("Comment (details)")},
+		{"v", i18n.T("Vote / withdraw vote")},
 	}
 
 	statusSection := []struct{ key, desc string }{
-		{"◌ metrics", "Phase 2 metrics computing"},
-		{"⚠ age", "Snapshot getting stale"},
-		{"⚠ STALE", "Snapshot is stale"},
-		{"✗ bg", "Background worker errors"},
-		{"↻ recov", "Worker self-healed"},
-		{"⚠ dead", "Worker unresponsive"},
-		{"polling", "Live reload uses polling"},
+		{"◌ metrics", i18n.T("Phase 2 metrics computing")},
+		{"⚠ age", i18n.T("Snapshot getting stale")},
+		{"⚠ STALE", i18n.T("Snapshot is stale")},
+		{"✗ bg", i18n.T("Background worker errors")},
+		{"↻ recov", i18n.T("Worker self-healed")},
+		{"⚠ dead", i18n.T("Worker unresponsive")},
+		{"polling", i18n.T("Live reload uses polling")},
+		{"⏸ paused", i18n.T("Auto-refresh paused")},
+		{"🔒 read-only", i18n.T("Changes are disabled")},
 	}
 
 	// Build panels
 	panels := []string{
-		renderPanel("Navigation", "🧭", 0, navSection),
-		renderPanel("Views", "👁", 1, viewsSection),
-		renderPanel("Global", "🌐", 2, globalSection),
-		renderPanel("Filters & Sort", "🔍", 3, filterSection),
-		renderPanel("Graph View", "📊", 4, graphSection),
-		renderPanel("Insights", "💡", 5, insightsSection),
-		renderPanel("Status", "🩺", 2, statusSection),
-		renderPanel("History", "📜", 0, historySection),
-		renderPanel("Actions", "⚡", 1, actionsSection),
+		renderPanel(i18n.T("Navigation"), "🧭", 0, navSection),
+		renderPanel(i18n.T("Views"), "👁", 1, viewsSection),
+		renderPanel(i18n.T("Global"), "🌐", 2, globalSection),
+		renderPanel(i18n.T("Filters & Sort"), "🔍", 3, filterSection),
+		renderPanel(i18n.T("Graph View"), "📊", 4, graphSection),
+		renderPanel(i18n.T("Insights"), "💡", 5, insightsSection),
+		renderPanel(i18n.T("Status"), "🩺", 2, statusSection),
+		renderPanel(i18n.T("History"), "📜", 0, historySection),
+		renderPanel(i18n.T("Actions"), "⚡", 1, actionsSection),
 	}
 
 	// Arrange panels into columns
@@ -4660,8 +5455,8 @@ func (m *Model) renderHelpOverlay() string {
 		Foreground(t.Secondary).
 		Italic(true)
 
-	title := titleStyle.Render("⌨️  Keyboard Shortcuts")
-	subtitle := subtitleStyle.Render("Space: Tutorial │ ? or Esc to close")
+	title := titleStyle.Render("⌨️  " + i18n.T("Keyboard Shortcuts"))
+	subtitle := subtitleStyle.Render(i18n.T("Space: Tutorial │ ? or Esc to close"))
 	titleBar := lipgloss.JoinHorizontal(lipgloss.Center, title, "  ", subtitle)
 
 	// Combine title and body
@@ -4755,23 +5550,23 @@ func (m Model) renderLabelHealthDetail(lh analysis.LabelHealth) string {
 		Render(fmt.Sprintf("Label Health: %s", lh.Label)))
 	sb.WriteString("\n")
 
-	sb.WriteString(labelStyle.Render("Overall: "))
+	sb.WriteString(labelStyle.Render(i18n.T("Overall:") + " "))
 	sb.WriteString(valStyle.Render(fmt.Sprintf("%d/100 (%s)", lh.Health, lh.HealthLevel)))
 	sb.WriteString("\n")
 	sb.WriteString(bar(lh.Health))
 	sb.WriteString("\n\n")
 
-	sb.WriteString(labelStyle.Render("Issues: "))
+	sb.WriteString(labelStyle.Render(i18n.T("Issues:") + " "))
 	sb.WriteString(valStyle.Render(fmt.Sprintf("%d total (%d open, %d blocked, %d closed)", lh.IssueCount, lh.OpenCount, lh.Blocked, lh.ClosedCount)))
 	sb.WriteString("\n\n")
 
-	sb.WriteString(labelStyle.Render("Velocity: "))
+	sb.WriteString(labelStyle.Render(i18n.T("Velocity:") + " "))
 	sb.WriteString(valStyle.Render(fmt.Sprintf("%d/100 (7d=%d, 30d=%d, avg_close=%.1fd, trend=%s %.1f%%)", lh.Velocity.VelocityScore, lh.Velocity.ClosedLast7Days, lh.Velocity.ClosedLast30Days, lh.Velocity.AvgDaysToClose, lh.Velocity.TrendDirection, lh.Velocity.TrendPercent)))
 	sb.WriteString("\n")
 	sb.WriteString(bar(lh.Velocity.VelocityScore))
 	sb.WriteString("\n\n")
 
-	sb.WriteString(labelStyle.Render("Freshness: "))
+	sb.WriteString(labelStyle.Render(i18n.T("Freshness:") + " "))
 	oldest := "n/a"
 	if !lh.Freshness.OldestOpenIssue.IsZero() {
 		oldest = lh.Freshness.OldestOpenIssue.Format("2006-01-02")
@@ -4785,7 +5580,7 @@ func (m Model) renderLabelHealthDetail(lh analysis.LabelHealth) string {
 	sb.WriteString(bar(lh.Freshness.FreshnessScore))
 	sb.WriteString("\n\n")
 
-	sb.WriteString(labelStyle.Render("Flow: "))
+	sb.WriteString(labelStyle.Render(i18n.T("Flow:") + " "))
 	sb.WriteString(valStyle.Render(fmt.Sprintf("%d/100 (in=%d from %v, out=%d to %v, external blocked=%d blocking=%d)", lh.Flow.FlowScore, lh.Flow.IncomingDeps, lh.Flow.IncomingLabels, lh.Flow.OutgoingDeps, lh.Flow.OutgoingLabels, lh.Flow.BlockedByExternal, lh.Flow.BlockingExternal)))
 	sb.WriteString("\n")
 	sb.WriteString(bar(lh.Flow.FlowScore))
@@ -4793,7 +5588,7 @@ func (m Model) renderLabelHealthDetail(lh analysis.LabelHealth) string {
 
 	// Cross-Label Flow Table (incoming/outgoing dependencies)
 	if len(m.labelHealthDetailFlow.Incoming) > 0 || len(m.labelHealthDetailFlow.Outgoing) > 0 {
-		sb.WriteString(labelStyle.Render("Cross-label deps:"))
+		sb.WriteString(labelStyle.Render(i18n.T("Cross-label deps:")))
 		sb.WriteString("\n")
 
 		if in := flowList("  Incoming", m.labelHealthDetailFlow.Incoming, "←"); in != "" {
@@ -4806,7 +5601,7 @@ func (m Model) renderLabelHealthDetail(lh analysis.LabelHealth) string {
 		}
 	}
 
-	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Secondary).Italic(true).Render("Press Esc to close"))
+	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Secondary).Italic(true).Render(i18n.T("Press Esc to close")))
 
 	content := boxStyle.Render(sb.String())
 
@@ -4920,19 +5715,19 @@ func (m Model) renderLabelDrilldown() string {
 	sb.WriteString("\n\n")
 
 	if lh != nil {
-		sb.WriteString(labelStyle.Render("Health: "))
+		sb.WriteString(labelStyle.Render(i18n.T("Health:") + " "))
 		sb.WriteString(valStyle.Render(fmt.Sprintf("%d/100 (%s)", lh.Health, lh.HealthLevel)))
 		sb.WriteString("\n")
 		sb.WriteString(bar(lh.Health))
 		sb.WriteString("\n\n")
 	}
 
-	sb.WriteString(labelStyle.Render("Issues: "))
+	sb.WriteString(labelStyle.Render(i18n.T("Issues:") + " "))
 	sb.WriteString(valStyle.Render(fmt.Sprintf("%d total (open %d, blocked %d, in-progress %d, closed %d)", total, open, blocked, inProgress, closed)))
 	sb.WriteString("\n\n")
 
 	if len(scoredIssues) > 0 {
-		sb.WriteString(labelStyle.Render("Top issues by PageRank:"))
+		sb.WriteString(labelStyle.Render(i18n.T("Top issues by PageRank:")))
 		sb.WriteString("\n")
 		for _, si := range scoredIssues {
 			line := fmt.Sprintf("  %s  %-10s  PR=%.3f  %s", getStatusIcon(si.issue.Status), si.issue.ID, si.score, si.issue.Title)
@@ -4945,7 +5740,7 @@ func (m Model) renderLabelDrilldown() string {
 	// Cross-label flows summary
 	flow := m.getCrossFlowsForLabel(m.labelDrilldownLabel)
 	if len(flow.Incoming) > 0 || len(flow.Outgoing) > 0 {
-		sb.WriteString(labelStyle.Render("Cross-label deps:"))
+		sb.WriteString(labelStyle.Render(i18n.T("Cross-label deps:")))
 		sb.WriteString("\n")
 		renderFlowList := func(title string, items []labelCount, arrow string) {
 			if len(items) == 0 {
@@ -4973,7 +5768,7 @@ func (m Model) renderLabelDrilldown() string {
 		sb.WriteString("\n")
 	}
 
-	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Secondary).Italic(true).Render("Press Esc to close • g for graph analysis"))
+	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Secondary).Italic(true).Render(i18n.T("Press Esc to close • g for graph analysis")))
 
 	content := boxStyle.Render(sb.String())
 
@@ -5015,24 +5810,24 @@ func (m Model) renderLabelGraphAnalysis() string {
 	var sb strings.Builder
 	sb.WriteString(titleStyle.Render(fmt.Sprintf("Graph Analysis: %s", r.Label)))
 	sb.WriteString("\n")
-	sb.WriteString(subtextStyle.Render("PageRank & Critical Path computed on label subgraph"))
+	sb.WriteString(subtextStyle.Render(i18n.T("PageRank & Critical Path computed on label subgraph")))
 	sb.WriteString("\n\n")
 
 	// Subgraph stats
-	sb.WriteString(labelStyle.Render("Subgraph: "))
+	sb.WriteString(labelStyle.Render(i18n.T("Subgraph:") + " "))
 	sb.WriteString(valStyle.Render(fmt.Sprintf("%d issues (%d core, %d dependencies), %d edges",
 		r.Subgraph.IssueCount, r.Subgraph.CoreCount,
 		r.Subgraph.IssueCount-r.Subgraph.CoreCount, r.Subgraph.EdgeCount)))
 	sb.WriteString("\n\n")
 
 	// Critical Path section
-	sb.WriteString(labelStyle.Render("🛤️  Critical Path"))
+	sb.WriteString(labelStyle.Render(i18n.T("🛤️  Critical Path")))
 	if r.CriticalPath.HasCycle {
-		sb.WriteString(valStyle.Render(" ⚠️  (cycle detected - path unreliable)"))
+		sb.WriteString(valStyle.Render(" " + i18n.T("⚠️  (cycle detected - path unreliable)")))
 	}
 	sb.WriteString("\n")
 	if r.CriticalPath.PathLength == 0 {
-		sb.WriteString(subtextStyle.Render("  No dependency chains found"))
+		sb.WriteString(subtextStyle.Render("  " + i18n.T("No dependency chains found")))
 	} else {
 		sb.WriteString(valStyle.Render(fmt.Sprintf("  Length: %d issues (max height: %d)",
 			r.CriticalPath.PathLength, r.CriticalPath.MaxHeight)))
@@ -5067,9 +5862,7 @@ func (m Model) renderLabelGraphAnalysis() string {
 			if maxTitleLen < 20 {
 				maxTitleLen = 20
 			}
-			if len(title) > maxTitleLen {
-				title = title[:maxTitleLen-1] + "…"
-			}
+			title = truncate(title, maxTitleLen)
 
 			height := r.CriticalPath.AllHeights[issueID]
 			line := fmt.Sprintf("%s %-12s [h=%d] %s", arrow, issueID, height, title)
@@ -5084,10 +5877,10 @@ func (m Model) renderLabelGraphAnalysis() string {
 	sb.WriteString("\n")
 
 	// PageRank section
-	sb.WriteString(labelStyle.Render("📊 PageRank (Top Issues)"))
+	sb.WriteString(labelStyle.Render(i18n.T("📊 PageRank (Top Issues)")))
 	sb.WriteString("\n")
 	if len(r.PageRank.TopIssues) == 0 {
-		sb.WriteString(subtextStyle.Render("  No issues to rank"))
+		sb.WriteString(subtextStyle.Render("  " + i18n.T("No issues to rank")))
 	} else {
 		maxPRRows := 8
 		showPRCount := len(r.PageRank.TopIssues)
@@ -5112,9 +5905,7 @@ func (m Model) renderLabelGraphAnalysis() string {
 			if maxTitleLen < 15 {
 				maxTitleLen = 15
 			}
-			if len(title) > maxTitleLen {
-				title = title[:maxTitleLen-1] + "…"
-			}
+			title = truncate(title, maxTitleLen)
 
 			normalized := r.PageRank.Normalized[item.ID]
 			line := fmt.Sprintf("  %s %-12s PR=%.4f (%.0f%%) %s",
@@ -5129,7 +5920,7 @@ func (m Model) renderLabelGraphAnalysis() string {
 	}
 
 	sb.WriteString("\n")
-	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Secondary).Italic(true).Render("Press Esc/q/g to close"))
+	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Secondary).Italic(true).Render(i18n.T("Press Esc/q/g to close")))
 
 	content := boxStyle.Render(sb.String())
 
@@ -5167,7 +5958,11 @@ func (m *Model) renderFooter() string {
 		if m.statusIsError {
 			prefix = "✗ "
 		}
-		msgSection := msgStyle.Render(prefix + m.statusMsg)
+		msg := prefix + m.statusMsg
+		if m.width > 4 {
+			msg = truncate(msg, m.width-4) // Padding(0, 2) takes 4 cells
+		}
+		msgSection := msgStyle.Render(msg)
 		remaining := m.width - lipgloss.Width(msgSection)
 		if remaining < 0 {
 			remaining = 0
@@ -5247,19 +6042,19 @@ func (m *Model) renderFooter() string {
 
 	// Sort badge - only show when not default (bv-3ita)
 	sortBadge := ""
-	if m.sortMode != SortDefault {
+	if order := m.currentSort(); !isDefaultSort(order) {
 		sortBadge = lipgloss.NewStyle().
 			Background(ColorBgHighlight).
 			Foreground(ColorSecondary).
 			Padding(0, 1).
-			Render(fmt.Sprintf("↕ %s", m.sortMode.String()))
+			Render(fmt.Sprintf("↕ %s", sortLabel(order)))
 	}
 
 	labelHint := lipgloss.NewStyle().
 		Foreground(ColorMuted).
 		Background(ColorBgDark).
 		Padding(0, 1).
-		Render("L:labels • h:detail")
+		Render(i18n.T("L:labels • h:detail"))
 
 	// Board-specific hints (bv-yg39, bv-naov)
 	if m.isBoardView {
@@ -5293,7 +6088,7 @@ func (m *Model) renderFooter() string {
 			Foreground(ColorMuted).
 			Background(ColorBgDark).
 			Padding(0, 1).
-			Render("A:attention • 1-9 filter • esc close")
+			Render(i18n.T("A:attention • 1-9 filter • esc close"))
 	}
 
 	// ─────────────────────────────────────────────────────────────────────────
@@ -5355,7 +6150,7 @@ func (m *Model) renderFooter() string {
 		var snapshotAge time.Duration
 		hasSnapshotAge := false
 		if m.snapshot != nil && !m.snapshot.CreatedAt.IsZero() {
-			snapshotAge = time.Since(m.snapshot.CreatedAt)
+			snapshotAge = clockNow(m.now).Sub(m.snapshot.CreatedAt)
 			hasSnapshotAge = true
 		}
 
@@ -5397,7 +6192,7 @@ func (m *Model) renderFooter() string {
 				Foreground(ColorWarning).
 				Bold(true).
 				Padding(0, 1)
-			text = fmt.Sprintf("⚠ bg %s (%s)", lastErr.Phase, formatAge(time.Since(lastErr.Time)))
+			text = fmt.Sprintf("⚠ bg %s (%s)", lastErr.Phase, formatAge(clockNow(m.now).Sub(lastErr.Time)))
 
 		case hasSnapshotAge && snapshotAge >= freshnessStaleThreshold():
 			style = lipgloss.NewStyle().
@@ -5441,40 +6236,32 @@ func (m *Model) renderFooter() string {
 			Background(ColorBgHighlight).
 			Foreground(ColorInfo).
 			Padding(0, 1)
-		phase2Section = phase2Style.Render("◌ metrics…")
+		phase2Section = phase2Style.Render(i18n.T("◌ metrics…"))
 	}
 
 	// ─────────────────────────────────────────────────────────────────────────
 	// WATCHER MODE - show polling mode when fsnotify isn't reliable (bv-3zwy)
 	// ─────────────────────────────────────────────────────────────────────────
 	watcherSection := ""
-	{
-		var (
-			polling      bool
-			fsType       watcher.FilesystemType
-			pollInterval time.Duration
-		)
-
+	if w := m.liveReloadWatcher(); w != nil {
+		watcherStyle := lipgloss.NewStyle().
+			Background(ColorBgHighlight).
+			Foreground(ColorMuted).
+			Padding(0, 1)
 		switch {
-		case m.backgroundWorker != nil:
-			polling, fsType, pollInterval = m.backgroundWorker.WatcherInfo()
-		case m.watcher != nil:
-			polling = m.watcher.IsPolling()
-			fsType = m.watcher.FilesystemType()
-			pollInterval = m.watcher.PollInterval()
-		}
-
-		if polling {
-			watcherStyle := lipgloss.NewStyle().
-				Background(ColorBgHighlight).
-				Foreground(ColorMuted).
-				Padding(0, 1)
+		case w.IsPaused():
+			watcherSection = watcherStyle.Foreground(ColorWarning).Render(i18n.T("⏸ refresh paused"))
+		case w.IsPolling():
+			fsType := w.FilesystemType()
 			label := "polling"
 			if fsType != watcher.FSTypeUnknown && fsType != watcher.FSTypeLocal {
 				label = fmt.Sprintf("polling %s", fsType.String())
 			}
-			if pollInterval > 0 {
-				label = fmt.Sprintf("%s %s", label, pollInterval.String())
+			if interval := w.PollInterval(); interval > 0 {
+				label = fmt.Sprintf("%s %s", label, interval.String())
+				if jitter := w.PollJitter(); jitter > 0 {
+					label = fmt.Sprintf("%s±%s", label, jitter.String())
+				}
 			}
 			watcherSection = watcherStyle.Render(label)
 		}
@@ -5520,8 +6307,11 @@ func (m *Model) renderFooter() string {
 	activeAlerts := 0
 	activeCritical := 0
 	activeWarning := 0
+	// Large databases can carry thousands of alerts; skip the per-alert key
+	// lookups on every frame while nothing has been dismissed.
+	noneDismissed := len(m.dismissedAlerts) == 0
 	for _, a := range m.alerts {
-		if !m.dismissedAlerts[alertKey(a)] {
+		if noneDismissed || !m.dismissedAlerts[alertKey(a)] {
 			activeAlerts++
 			switch a.Severity {
 			case drift.SeverityCritical:
@@ -5625,53 +6415,105 @@ func (m *Model) renderFooter() string {
 	sepStyle := lipgloss.NewStyle().Foreground(ColorMuted)
 	sep := sepStyle.Render(" │ ")
 
+	// hint renders a key and what it does, translated.
+	hint := func(key, action string) string {
+		return keyStyle.Render(key) + " " + i18n.T(action)
+	}
+
 	var keyHints []string
-	if m.showHelp {
-		keyHints = append(keyHints, "Press any key to close")
+	if m.macros != nil && m.macros.recording != "" {
+		keyHints = append(keyHints, keyStyle.Render("●")+" "+i18n.Tf("recording %s", m.macros.recording), hint("Q", "stop"))
+	}
+	if pending := m.keySeqs.Pending(); pending != "" {
+		keyHints = append(keyHints, keyStyle.Render(pending)+" …")
+		for _, next := range m.keySeqs.Continuations() {
+			key, action, _ := strings.Cut(next, " ")
+			keyHints = append(keyHints, keyStyle.Render(key)+" "+action)
+		}
+	} else if m.showHelp {
+		keyHints = append(keyHints, i18n.T("Press any key to close"))
 	} else if m.showRecipePicker {
-		keyHints = append(keyHints, keyStyle.Render("j/k")+" nav", keyStyle.Render("⏎")+" apply", keyStyle.Render("esc")+" cancel")
+		keyHints = append(keyHints, hint("j/k", "nav"), hint("⏎", "apply"), hint("esc", "cancel"))
 	} else if m.showRepoPicker {
-		keyHints = append(keyHints, keyStyle.Render("j/k")+" nav", keyStyle.Render("space")+" toggle", keyStyle.Render("⏎")+" apply", keyStyle.Render("esc")+" cancel")
+		keyHints = append(keyHints, hint("j/k", "nav"), hint("space", "toggle"), hint("⏎", "apply"), hint("esc", "cancel"))
 	} else if m.showLabelPicker {
-		keyHints = append(keyHints, "type to filter", keyStyle.Render("j/k")+" nav", keyStyle.Render("⏎")+" apply", keyStyle.Render("esc")+" cancel")
+		keyHints = append(keyHints, i18n.T("type to filter"), hint("j/k", "nav"), hint("⏎", "apply"), hint("esc", "cancel"))
 	} else if m.focused == focusInsights {
-		keyHints = append(keyHints, keyStyle.Render("h/l")+" panels", keyStyle.Render("e")+" explain", keyStyle.Render("⏎")+" jump", keyStyle.Render("?")+" help")
-		keyHints = append(keyHints, keyStyle.Render("A")+" attention", keyStyle.Render("F")+" flow")
+		keyHints = append(keyHints, hint("h/l", "panels"), hint("e", "explain"), hint("⏎", "jump"), hint("?", "help"))
+		keyHints = append(keyHints, hint("A", "attention"), hint("F", "flow"))
 	} else if m.focused == focusFlowMatrix {
-		keyHints = append(keyHints, keyStyle.Render("j/k")+" nav", keyStyle.Render("tab")+" panel", keyStyle.Render("⏎")+" drill", keyStyle.Render("esc")+" back", keyStyle.Render("f")+" close")
+		keyHints = append(keyHints, hint("j/k", "nav"), hint("tab", "panel"), hint("⏎", "drill"), hint("esc", "back"), hint("f", "close"))
+	} else if m.focused == focusTreemap {
+		keyHints = append(keyHints, hint("j/k", "nav"), hint("c", "color"), hint("⏎", "view"), hint("#", "close"))
+	} else if m.focused == focusTimeline {
+		keyHints = append(keyHints, hint("j/k", "nav"), hint("←/→", "scroll"), hint("z", "zoom"), hint("t", "today"), hint("⏎", "view"), hint("@", "close"))
 	} else if m.isGraphView {
-		keyHints = append(keyHints, keyStyle.Render("hjkl")+" nav", keyStyle.Render("H/L")+" scroll", keyStyle.Render("⏎")+" view", keyStyle.Render("g")+" list")
+		keyHints = append(keyHints, hint("hjkl", "nav"), hint("H/L", "scroll"), hint("⏎", "view"), hint("g", "list"))
 	} else if m.isBoardView {
-		keyHints = append(keyHints, keyStyle.Render("hjkl")+" nav", keyStyle.Render("G")+" bottom", keyStyle.Render("⏎")+" view", keyStyle.Render("b")+" list")
+		keyHints = append(keyHints, hint("hjkl", "nav"), hint("G", "bottom"), hint("⏎", "view"), hint("b", "list"))
 	} else if m.isActionableView {
-		keyHints = append(keyHints, keyStyle.Render("j/k")+" nav", keyStyle.Render("⏎")+" view", keyStyle.Render("a")+" list", keyStyle.Render("?")+" help")
+		keyHints = append(keyHints, hint("j/k", "nav"), hint("⏎", "view"), hint("a", "list"), hint("?", "help"))
 	} else if m.isHistoryView {
-		keyHints = append(keyHints, keyStyle.Render("j/k")+" nav", keyStyle.Render("tab")+" focus", keyStyle.Render("⏎")+" jump", keyStyle.Render("H")+" close")
+		keyHints = append(keyHints, hint("j/k", "nav"), hint("tab", "focus"), hint("⏎", "jump"), hint("H", "close"))
 	} else if m.list.FilterState() == list.Filtering {
-		mode := "fuzzy"
+		mode := i18n.T("fuzzy")
 		if m.semanticSearchEnabled {
-			mode = "semantic"
+			mode = i18n.T("semantic")
 			if m.semanticIndexBuilding {
-				mode = "semantic (indexing)"
+				mode = i18n.T("semantic (indexing)")
 			}
 		}
-		keyHints = append(keyHints, keyStyle.Render("esc")+" cancel", keyStyle.Render("ctrl+s")+" "+mode, keyStyle.Render("⏎")+" select")
+		keyHints = append(keyHints, hint("esc", "cancel"), keyStyle.Render("ctrl+s")+" "+mode, hint("⏎", "select"))
 		if m.semanticSearchEnabled {
-			keyHints = append(keyHints, keyStyle.Render("H")+" hybrid", keyStyle.Render("alt+h")+" preset")
+			keyHints = append(keyHints, hint("H", "hybrid"), hint("alt+h", "preset"))
 		}
 	} else if m.showTimeTravelPrompt {
-		keyHints = append(keyHints, keyStyle.Render("⏎")+" compare", keyStyle.Render("esc")+" cancel")
+		keyHints = append(keyHints, hint("⏎", "compare"), hint("esc", "cancel"))
+	} else if m.showActionMenu {
+		keyHints = append(keyHints, hint("j/k", "move"), hint("⏎", "run"), hint("esc", "close"))
+	} else if m.showCommandOutput {
+		keyHints = append(keyHints, hint("j/k", "scroll"), hint("x", "kill"), hint("esc", "close"))
+	} else if m.showPager {
+		keyHints = append(keyHints, hint("j/k", "scroll"), hint("/", "search"), hint("n/N", "next/prev"), hint("esc", "close"))
+	} else if m.showWatchFeed {
+		keyHints = append(keyHints, hint("j/k", "move"), hint("⏎", "go to"), hint("l", "list"), hint("esc", "close"))
+	} else if m.showImpact {
+		keyHints = append(keyHints, hint("j/k", "move"), hint("⏎", "go to"), hint("esc", "close"))
+	} else if m.showDuplicates {
+		keyHints = append(keyHints, hint("j/k", "move"), hint("⏎", "go to"), hint("d/D", "close as duplicate"), hint("esc", "close"))
+	} else if m.showEscalations {
+		keyHints = append(keyHints, hint("j/k", "move"), hint("⏎", "go to"), hint("a/A", "accept/all"), hint("d", "dismiss"), hint("esc", "close"))
+	} else if m.showActivity {
+		keyHints = append(keyHints, hint("j/k", "move"), hint("⏎", "go to"), hint("esc", "close"))
+	} else if m.errorModal != nil {
+		keyHints = append(keyHints, hint("any key", "close"))
+	} else if m.showDiagnostics || m.showConfig {
+		keyHints = append(keyHints, hint("j/k", "move"), hint("esc", "close"))
+	} else if m.showUsage {
+		keyHints = append(keyHints, hint("esc", "close"))
+	} else if m.showHookTrust {
+		keyHints = append(keyHints, hint("y", "trust"), hint("n", "don't run"))
+	} else if m.showSprintPlan {
+		keyHints = append(keyHints, hint("⏎", "move across"), hint("e", "estimate"), hint("c", "capacity"), hint("esc", "close"))
+	} else if m.showDebugConsole {
+		keyHints = append(keyHints, hint("c", "clear"), hint("F12", "close"))
+	} else if m.showCapture {
+		keyHints = append(keyHints, hint("tab", "next field"), hint("⏎", "create"), hint("ctrl+s", "draft"), hint("esc", "cancel"))
+	} else if m.showDrafts {
+		keyHints = append(keyHints, hint("j/k", "move"), hint("p", "publish"), hint("x", "delete"), hint("esc", "close"))
+	} else if m.showComposer {
+		keyHints = append(keyHints, hint("ctrl+s", "post"), hint("ctrl+e", "editor"), hint("esc", "discard"))
 	} else {
 		if m.timeTravelMode {
-			keyHints = append(keyHints, keyStyle.Render("t")+" exit diff", keyStyle.Render("C")+" copy", keyStyle.Render("abgi")+" views", keyStyle.Render("?")+" help")
+			keyHints = append(keyHints, hint("t", "exit diff"), hint("C", "copy"), hint("abgi", "views"), hint("?", "help"))
 		} else if m.isSplitView {
-			keyHints = append(keyHints, keyStyle.Render("tab")+" focus", keyStyle.Render("C")+" copy", keyStyle.Render("x")+" export", keyStyle.Render("Ctrl+R")+" refresh", keyStyle.Render("?")+" help")
+			keyHints = append(keyHints, hint("tab", "focus"), hint("C", "copy"), hint("x", "export"), hint("Ctrl+R", "refresh"), hint("?", "help"))
 		} else if m.showDetails {
-			keyHints = append(keyHints, keyStyle.Render("esc")+" back", keyStyle.Render("C")+" copy", keyStyle.Render("O")+" edit", keyStyle.Render("Ctrl+R")+" refresh", keyStyle.Render("?")+" help")
+			keyHints = append(keyHints, hint("esc", "back"), hint("C", "copy"), hint("O", "edit"), hint("|", "page"), hint("Ctrl+R", "refresh"), hint("?", "help"))
 		} else {
-			keyHints = append(keyHints, keyStyle.Render("⏎")+" details", keyStyle.Render("t")+" diff", keyStyle.Render("S")+" triage", keyStyle.Render("l")+" labels", keyStyle.Render("Ctrl+R")+" refresh", keyStyle.Render("?")+" help")
+			keyHints = append(keyHints, hint("⏎", "details"), hint("t", "diff"), hint("S", "triage"), hint("l", "labels"), hint("Ctrl+R", "refresh"), hint("?", "help"))
 			if m.workspaceMode {
-				keyHints = append(keyHints, keyStyle.Render("w")+" repos")
+				keyHints = append(keyHints, hint("w", "repos"))
 			}
 		}
 	}
@@ -5687,100 +6529,63 @@ func (m *Model) renderFooter() string {
 	countBadge := lipgloss.NewStyle().
 		Foreground(ColorSecondary).
 		Padding(0, 1).
-		Render(fmt.Sprintf("%d issues", len(m.list.Items())))
+		Render(i18n.Tf("%d issues", len(m.list.Items())))
 
 	// ─────────────────────────────────────────────────────────────────────────
-	// ASSEMBLE FOOTER with proper spacing
+	// HOOKS - Hooks and user commands still running
 	// ─────────────────────────────────────────────────────────────────────────
-	leftWidth := lipgloss.Width(filterBadge) + lipgloss.Width(labelHint) + lipgloss.Width(statsSection)
-	if phase2Section != "" {
-		leftWidth += lipgloss.Width(phase2Section) + 1
-	}
-	if watcherSection != "" {
-		leftWidth += lipgloss.Width(watcherSection) + 1
-	}
-	if workerSection != "" {
-		leftWidth += lipgloss.Width(workerSection) + 1
-	}
-	if searchBadge != "" {
-		leftWidth += lipgloss.Width(searchBadge) + 1
-	}
-	if sortBadge != "" {
-		leftWidth += lipgloss.Width(sortBadge) + 1
-	}
-	if alertsSection != "" {
-		leftWidth += lipgloss.Width(alertsSection) + 1
-	}
-	if instanceSection != "" {
-		leftWidth += lipgloss.Width(instanceSection) + 1
-	}
-	if sessionSection != "" {
-		leftWidth += lipgloss.Width(sessionSection) + 1
-	}
-	if workspaceSection != "" {
-		leftWidth += lipgloss.Width(workspaceSection) + 1
-	}
-	if repoFilterSection != "" {
-		leftWidth += lipgloss.Width(repoFilterSection) + 1
-	}
-	if updateSection != "" {
-		leftWidth += lipgloss.Width(updateSection) + 1
-	}
-	if datasetSection != "" {
-		leftWidth += lipgloss.Width(datasetSection) + 1
-	}
-	rightWidth := lipgloss.Width(countBadge) + lipgloss.Width(keysSection)
-
-	remaining := m.width - leftWidth - rightWidth - 1
-	if remaining < 0 {
-		remaining = 0
+	hooksSection := ""
+	if n := m.life.runningHooks(); n > 0 {
+		noun := "hooks"
+		if n == 1 {
+			noun = "hook"
+		}
+		hooksSection = lipgloss.NewStyle().
+			Background(ColorBgHighlight).
+			Foreground(ColorInfo).
+			Padding(0, 1).
+			Render(fmt.Sprintf("⚙ %d %s", n, noun))
 	}
-	filler := lipgloss.NewStyle().Background(ColorBgDark).Width(remaining).Render("")
 
-	// Build the footer
-	var parts []string
-	parts = append(parts, filterBadge)
-	if searchBadge != "" {
-		parts = append(parts, searchBadge)
-	}
-	if sortBadge != "" {
-		parts = append(parts, sortBadge)
-	}
-	parts = append(parts, labelHint)
-	if alertsSection != "" {
-		parts = append(parts, alertsSection)
-	}
-	if instanceSection != "" {
-		parts = append(parts, instanceSection)
-	}
-	if sessionSection != "" {
-		parts = append(parts, sessionSection)
-	}
-	if workspaceSection != "" {
-		parts = append(parts, workspaceSection)
-	}
-	if repoFilterSection != "" {
-		parts = append(parts, repoFilterSection)
-	}
-	if updateSection != "" {
-		parts = append(parts, updateSection)
-	}
-	if datasetSection != "" {
-		parts = append(parts, datasetSection)
-	}
-	parts = append(parts, statsSection)
-	if phase2Section != "" {
-		parts = append(parts, phase2Section)
-	}
-	if watcherSection != "" {
-		parts = append(parts, watcherSection)
-	}
-	if workerSection != "" {
-		parts = append(parts, workerSection)
+	// ─────────────────────────────────────────────────────────────────────────
+	// READ-ONLY - Changes are disabled (--read-only, serve-ssh)
+	// ─────────────────────────────────────────────────────────────────────────
+	readOnlySection := ""
+	if m.readOnly {
+		readOnlySection = lipgloss.NewStyle().
+			Background(ColorBgHighlight).
+			Foreground(ColorWarning).
+			Bold(true).
+			Padding(0, 1).
+			Render(i18n.T("🔒 read-only"))
 	}
-	parts = append(parts, filler, countBadge, keysSection)
 
-	return lipgloss.JoinHorizontal(lipgloss.Bottom, parts...)
+	// ─────────────────────────────────────────────────────────────────────────
+	// ASSEMBLE FOOTER from the configured segments (see status_bar.go)
+	// ─────────────────────────────────────────────────────────────────────────
+	return m.layoutStatusBar(map[string]string{
+		"readonly":    readOnlySection,
+		"filter":      filterBadge,
+		"breadcrumbs": m.renderBreadcrumbs(),
+		"presence":    m.renderPresence(),
+		"search":      searchBadge,
+		"sort":        sortBadge,
+		"hints":       labelHint,
+		"alerts":      alertsSection,
+		"instance":    instanceSection,
+		"sessions":    sessionSection,
+		"workspace":   workspaceSection,
+		"repos":       repoFilterSection,
+		"update":      updateSection,
+		"dataset":     datasetSection,
+		"counts":      statsSection,
+		"metrics":     phase2Section,
+		"watcher":     watcherSection,
+		"freshness":   workerSection,
+		"hooks":       hooksSection,
+		"total":       countBadge,
+		"keys":        keysSection,
+	})
 }
 
 func nextHybridPreset(current search.PresetName) search.PresetName {
@@ -5796,8 +6601,10 @@ func nextHybridPreset(current search.PresetName) search.PresetName {
 	return presets[0]
 }
 
-// getDiffStatus returns the diff status for an issue if time-travel mode is active
-func (m Model) getDiffStatus(id string) DiffStatus {
+// getDiffStatus returns the diff status for an issue if time-travel mode is active.
+// It runs once per issue in applyFilter, so it takes a pointer receiver:
+// copying the whole Model per call dominated filtering on large databases.
+func (m *Model) getDiffStatus(id string) DiffStatus {
 	if !m.timeTravelMode {
 		return DiffStatusNone
 	}
@@ -5844,9 +6651,10 @@ func (m *Model) setActiveRecipe(r *recipe.Recipe) {
 }
 
 func (m *Model) applyFilter() {
-	var filteredItems []list.Item
-	var filteredIssues []model.Issue
+	filteredItems := make([]list.Item, 0, len(m.issues))
+	filteredIssues := make([]model.Issue, 0, len(m.issues))
 
+	matchesFilter := m.listFilter()
 	for _, issue := range m.issues {
 		// Workspace repo filter (nil = all repos)
 		if m.workspaceMode && m.activeRepos != nil {
@@ -5856,40 +6664,7 @@ func (m *Model) applyFilter() {
 			}
 		}
 
-		include := false
-		switch m.currentFilter {
-		case "all":
-			include = true
-		case "open":
-			include = !isClosedLikeStatus(issue.Status)
-		case "closed":
-			include = isClosedLikeStatus(issue.Status)
-		case "ready":
-			// Ready = Open/InProgress AND NO Open Blockers
-			if !isClosedLikeStatus(issue.Status) && issue.Status != model.StatusBlocked {
-				isBlocked := false
-				for _, dep := range issue.Dependencies {
-					if dep == nil || !dep.Type.IsBlocking() {
-						continue
-					}
-					if blocker, exists := m.issueMap[dep.DependsOnID]; exists && !isClosedLikeStatus(blocker.Status) {
-						isBlocked = true
-						break
-					}
-				}
-				include = !isBlocked
-			}
-		default:
-			if strings.HasPrefix(m.currentFilter, "label:") {
-				label := strings.TrimPrefix(m.currentFilter, "label:")
-				for _, l := range issue.Labels {
-					if l == label {
-						include = true
-						break
-					}
-				}
-			}
-		}
+		include := matchesFilter(issue)
 
 		if include {
 			// Use pre-computed graph scores (avoid redundant calculation)
@@ -5900,6 +6675,7 @@ func (m *Model) applyFilter() {
 				DiffStatus: m.getDiffStatus(issue.ID),
 				RepoPrefix: ExtractRepoPrefix(issue.ID),
 			}
+			item.cacheFilterValue()
 			// Add triage data (bv-151)
 			item.TriageScore = m.triageScores[issue.ID]
 			if reasons, exists := m.triageReasons[issue.ID]; exists {
@@ -5939,54 +6715,78 @@ func (m *Model) applyFilter() {
 	m.updateViewportContent()
 }
 
-// cycleSortMode cycles through available sort modes (bv-3ita)
-func (m *Model) cycleSortMode() {
-	m.sortMode = (m.sortMode + 1) % numSortModes
-	m.applyFilter() // Re-apply filter with new sort
+// sortView names the view a sort applies to: the board orders its cards
+// itself, every other view shows the list's order.
+func (m *Model) sortView() string {
+	if m.isBoardView {
+		return "board"
+	}
+	return "list"
+}
+
+// currentSort returns the sort of the current view.
+func (m *Model) currentSort() beadsview.Sort {
+	return m.sorts[m.sortView()]
 }
 
-// sortFilteredItems sorts the filtered items based on current sortMode (bv-3ita)
+// setSort sets the sort of view and reorders it.
+func (m *Model) setSort(view string, order beadsview.Sort) {
+	if m.sorts == nil {
+		m.sorts = make(map[string]beadsview.Sort)
+	}
+	m.sorts[view] = order
+	if view == "board" {
+		m.board.SetSort(order)
+	} else {
+		m.applyFilter()
+	}
+}
+
+// cycleSort moves the list to the next sort key (bv-3ita); a composite or
+// scored sort goes back to the default.
+func (m *Model) cycleSort() {
+	next := beadsview.Sort{}
+	if mode, ok := m.sorts["list"].Mode(); ok {
+		next = sortForMode(mode.Next())
+	}
+	m.setSort("list", next)
+}
+
+// sortForMode returns the sort made of the key of mode.
+func sortForMode(mode beadsview.SortMode) beadsview.Sort {
+	for _, key := range sortKeys {
+		if m, _ := beadsview.ParseSortMode(key); m == mode {
+			order, _ := beadsview.ParseSort(key)
+			return order
+		}
+	}
+	return beadsview.Sort{}
+}
+
+// isDefaultSort reports whether order is the default order.
+func isDefaultSort(order beadsview.Sort) bool {
+	mode, ok := order.Mode()
+	return ok && mode == beadsview.SortDefault
+}
+
+// sortLabel names order for the status bar: the name of a single key, or
+// the terms as typed.
+func sortLabel(order beadsview.Sort) string {
+	if mode, ok := order.Mode(); ok {
+		return mode.String()
+	}
+	return order.String()
+}
+
+// sortFilteredItems sorts the filtered items by the list's sort (bv-3ita)
 func (m *Model) sortFilteredItems(items []list.Item, issues []model.Issue) {
 	if len(items) == 0 {
 		return
 	}
 
-	// Sort indices to keep items and issues in sync
-	indices := make([]int, len(items))
-	for i := range indices {
-		indices[i] = i
-	}
-
-	sort.Slice(indices, func(i, j int) bool {
-		iItem := items[indices[i]].(IssueItem)
-		jItem := items[indices[j]].(IssueItem)
-
-		switch m.sortMode {
-		case SortCreatedAsc:
-			// Oldest first
-			return iItem.Issue.CreatedAt.Before(jItem.Issue.CreatedAt)
-		case SortCreatedDesc:
-			// Newest first
-			return iItem.Issue.CreatedAt.After(jItem.Issue.CreatedAt)
-		case SortPriority:
-			// Priority ascending (P0 first)
-			return iItem.Issue.Priority < jItem.Issue.Priority
-		case SortUpdated:
-			// Most recently updated first
-			return iItem.Issue.UpdatedAt.After(jItem.Issue.UpdatedAt)
-		default:
-			// Default: Open first, then priority, then newest
-			iClosed := isClosedLikeStatus(iItem.Issue.Status)
-			jClosed := isClosedLikeStatus(jItem.Issue.Status)
-			if iClosed != jClosed {
-				return !iClosed
-			}
-			if iItem.Issue.Priority != jItem.Issue.Priority {
-				return iItem.Issue.Priority < jItem.Issue.Priority
-			}
-			return iItem.Issue.CreatedAt.After(jItem.Issue.CreatedAt)
-		}
-	})
+	// Sort indices to keep items and issues in sync; pinned issues go
+	// first (see escalation.go)
+	indices := pinFirst(m.sorts["list"].Order(issues, clockNow(m.now)), issues, m.pinnedIDs)
 
 	// Reorder items and issues based on sorted indices
 	sortedItems := make([]list.Item, len(items))
@@ -5999,25 +6799,6 @@ func (m *Model) sortFilteredItems(items []list.Item, issues []model.Issue) {
 	copy(issues, sortedIssues)
 }
 
-func matchesRecipeStatus(status model.Status, filter string) bool {
-	normalized := strings.ToLower(strings.TrimSpace(filter))
-	statusKey := strings.ToLower(string(status))
-	switch normalized {
-	case string(model.StatusClosed):
-		return isClosedLikeStatus(status)
-	case string(model.StatusTombstone):
-		return status == model.StatusTombstone
-	case string(model.StatusOpen):
-		return status == model.StatusOpen
-	case string(model.StatusInProgress):
-		return status == model.StatusInProgress
-	case string(model.StatusBlocked):
-		return status == model.StatusBlocked
-	default:
-		return statusKey == normalized
-	}
-}
-
 // applyRecipe applies a recipe's filters and sort to the current view
 func (m *Model) applyRecipe(r *recipe.Recipe) {
 	if r == nil {
@@ -6026,6 +6807,7 @@ func (m *Model) applyRecipe(r *recipe.Recipe) {
 
 	var filteredItems []list.Item
 	var filteredIssues []model.Issue
+	blockers := m.blockerMap()
 
 	for _, issue := range m.issues {
 		include := true
@@ -6042,7 +6824,7 @@ func (m *Model) applyRecipe(r *recipe.Recipe) {
 		if len(r.Filters.Status) > 0 {
 			statusMatch := false
 			for _, s := range r.Filters.Status {
-				if matchesRecipeStatus(issue.Status, s) {
+				if beadsview.MatchesStatus(issue.Status, s) {
 					statusMatch = true
 					break
 				}
@@ -6084,7 +6866,7 @@ func (m *Model) applyRecipe(r *recipe.Recipe) {
 				if dep == nil || !dep.Type.IsBlocking() {
 					continue
 				}
-				if blocker, exists := m.issueMap[dep.DependsOnID]; exists && !isClosedLikeStatus(blocker.Status) {
+				if blocker, exists := blockers[dep.DependsOnID]; exists && !isClosedLikeStatus(blocker.Status) {
 					isBlocked = true
 					break
 				}
@@ -6100,6 +6882,7 @@ func (m *Model) applyRecipe(r *recipe.Recipe) {
 				DiffStatus: m.getDiffStatus(issue.ID),
 				RepoPrefix: ExtractRepoPrefix(issue.ID),
 			}
+			item.cacheFilterValue()
 			// Add triage data (bv-151)
 			item.TriageScore = m.triageScores[issue.ID]
 			if reasons, exists := m.triageReasons[issue.ID]; exists {
@@ -6117,9 +6900,33 @@ func (m *Model) applyRecipe(r *recipe.Recipe) {
 	// Apply sort
 	field := r.Sort.Field
 	descending := r.Sort.Direction == "desc"
+	// A score expression ranks the highest first unless the direction is asc
+	var scores map[string]float64
+	if r.Sort.Expr != "" {
+		if expr, err := beadsview.ParseExpr(r.Sort.Expr); err != nil {
+			m.statusMsg = i18n.Tf("Recipe %s: invalid sort expr: %v", r.Name, err)
+			m.statusIsError = true
+		} else {
+			field, descending = "expr", r.Sort.Direction != "asc"
+			now := clockNow(m.now)
+			scores = make(map[string]float64, len(filteredIssues))
+			for _, issue := range filteredIssues {
+				scores[issue.ID] = expr.Eval(issue, now)
+			}
+		}
+	}
 	if field != "" {
 		compare := func(a, b model.Issue) int {
 			switch field {
+			case "expr":
+				switch {
+				case scores[a.ID] < scores[b.ID]:
+					return -1
+				case scores[a.ID] > scores[b.ID]:
+					return 1
+				default:
+					return 0
+				}
 			case "priority":
 				switch {
 				case a.Priority < b.Priority:
@@ -6262,34 +7069,24 @@ func (m *Model) updateViewportContent() {
 		return
 	}
 	item := issueItem.Issue
+	m.session.RecordViewed(item.ID)
+
+	if m.accessible {
+		m.viewport.SetContent(m.accessibleDetail(issueItem))
+		return
+	}
 
 	var sb strings.Builder
 
 	if m.updateAvailable {
-		sb.WriteString(fmt.Sprintf("⭐ **Update Available:** [%s](%s)\n\n", m.updateTag, m.updateURL))
+		sb.WriteString(i18n.Tf("⭐ **Update Available:** [%s](%s)", m.updateTag, m.updateURL) + "\n\n")
 	}
 
-	// Title Block
-	sb.WriteString(fmt.Sprintf("# %s %s\n", GetTypeIconMD(string(item.IssueType)), item.Title))
-
-	// Meta Table
-	sb.WriteString("| ID | Status | Priority | Assignee | Created |\n|---|---|---|---|---|\n")
-	sb.WriteString(fmt.Sprintf("| **%s** | **%s** | %s | @%s | %s |\n\n",
-		item.ID,
-		strings.ToUpper(string(item.Status)),
-		GetPriorityIcon(item.Priority),
-		item.Assignee,
-		item.CreatedAt.Format("2006-01-02"),
-	))
-
-	// Labels (bv-f103 fix: display labels in detail view)
-	if len(item.Labels) > 0 {
-		sb.WriteString(fmt.Sprintf("**Labels:** %s\n\n", strings.Join(item.Labels, ", ")))
-	}
+	writeIssueSummaryMD(&sb, item)
 
 	// Triage Insights (bv-151)
 	if issueItem.TriageScore > 0 || issueItem.TriageReason != "" || issueItem.UnblocksCount > 0 || issueItem.IsQuickWin || issueItem.IsBlocker {
-		sb.WriteString("### 🎯 Triage Insights\n")
+		sb.WriteString("### " + i18n.T("🎯 Triage Insights") + "\n")
 
 		// Score with visual indicator
 		scoreIcon := "🔵"
@@ -6298,29 +7095,29 @@ func (m *Model) updateViewportContent() {
 		} else if issueItem.TriageScore >= 0.4 {
 			scoreIcon = "🟠"
 		}
-		sb.WriteString(fmt.Sprintf("- **Triage Score:** %s %.2f/1.00\n", scoreIcon, issueItem.TriageScore))
+		sb.WriteString(i18n.Tf("- **Triage Score:** %s %.2f/1.00", scoreIcon, issueItem.TriageScore) + "\n")
 
 		// Special flags
 		if issueItem.IsQuickWin {
-			sb.WriteString("- **⭐ Quick Win** — Low effort, high impact opportunity\n")
+			sb.WriteString(i18n.T("- **⭐ Quick Win** — Low effort, high impact opportunity") + "\n")
 		}
 		if issueItem.IsBlocker {
-			sb.WriteString("- **🔴 Critical Blocker** — Completing this unblocks significant downstream work\n")
+			sb.WriteString(i18n.T("- **🔴 Critical Blocker** — Completing this unblocks significant downstream work") + "\n")
 		}
 
 		// Unblocks count
 		if issueItem.UnblocksCount > 0 {
-			sb.WriteString(fmt.Sprintf("- **🔓 Unblocks:** %d downstream items when completed\n", issueItem.UnblocksCount))
+			sb.WriteString(i18n.Tf("- **🔓 Unblocks:** %d downstream items when completed", issueItem.UnblocksCount) + "\n")
 		}
 
 		// Primary reason
 		if issueItem.TriageReason != "" {
-			sb.WriteString(fmt.Sprintf("- **Primary Reason:** %s\n", issueItem.TriageReason))
+			sb.WriteString(i18n.Tf("- **Primary Reason:** %s", issueItem.TriageReason) + "\n")
 		}
 
 		// All reasons (if multiple)
 		if len(issueItem.TriageReasons) > 1 {
-			sb.WriteString("- **All Reasons:**\n")
+			sb.WriteString(i18n.T("- **All Reasons:**") + "\n")
 			for _, reason := range issueItem.TriageReasons {
 				sb.WriteString(fmt.Sprintf("  - %s\n", reason))
 			}
@@ -6331,11 +7128,11 @@ func (m *Model) updateViewportContent() {
 
 	// Search Scores (hybrid mode)
 	if m.semanticSearchEnabled && m.semanticHybridEnabled && issueItem.SearchScoreSet && m.list.FilterState() != list.Unfiltered {
-		sb.WriteString("### 🔎 Search Scores\n")
-		sb.WriteString(fmt.Sprintf("- **Hybrid Score:** %.3f\n", issueItem.SearchScore))
-		sb.WriteString(fmt.Sprintf("- **Text Score:** %.3f\n", issueItem.SearchTextScore))
+		sb.WriteString("### " + i18n.T("🔎 Search Scores") + "\n")
+		sb.WriteString(i18n.Tf("- **Hybrid Score:** %.3f", issueItem.SearchScore) + "\n")
+		sb.WriteString(i18n.Tf("- **Text Score:** %.3f", issueItem.SearchTextScore) + "\n")
 		if len(issueItem.SearchComponents) > 0 {
-			sb.WriteString("- **Components:**\n")
+			sb.WriteString(i18n.T("- **Components:**") + "\n")
 			order := []string{"pagerank", "status", "impact", "priority", "recency"}
 			for _, key := range order {
 				if val, ok := issueItem.SearchComponents[key]; ok {
@@ -6354,67 +7151,114 @@ func (m *Model) updateViewportContent() {
 	hub := m.analysis.GetHubScore(item.ID)
 	auth := m.analysis.GetAuthorityScore(item.ID)
 
-	sb.WriteString("### Graph Analysis\n")
-	sb.WriteString(fmt.Sprintf("- **Impact Depth**: %.0f (downstream chain length)\n", imp))
-	sb.WriteString(fmt.Sprintf("- **Centrality**: PR %.4f • BW %.4f • EV %.4f\n", pr, bt, ev))
-	sb.WriteString(fmt.Sprintf("- **Flow Role**: Hub %.4f • Authority %.4f\n\n", hub, auth))
+	sb.WriteString("### " + i18n.T("Graph Analysis") + "\n")
+	sb.WriteString(i18n.Tf("- **Impact Depth**: %.0f (downstream chain length)", imp) + "\n")
+	sb.WriteString(i18n.Tf("- **Centrality**: PR %.4f • BW %.4f • EV %.4f", pr, bt, ev) + "\n")
+	sb.WriteString(i18n.Tf("- **Flow Role**: Hub %.4f • Authority %.4f", hub, auth) + "\n\n")
+
+	writeIssueBodyMD(&sb, item, m.blockerMap(), clockNow(m.now))
+
+	// Referenced files (see file_refs.go)
+	writeFileRefsMD(&sb, m.fileRefs(item))
+
+	// History Section (if data is loaded)
+	if m.historyView.HasReport() {
+		historyMD := m.renderBeadHistoryMD(item.ID)
+		if historyMD != "" {
+			sb.WriteString(historyMD)
+		}
+	}
+
+	rendered, err := m.renderer.Render(sb.String())
+	if err != nil {
+		m.viewport.SetContent(i18n.Tf("Error rendering markdown: %v", err))
+	} else {
+		if m.hyperlinks {
+			rendered = linkify(rendered, m.issueLinkTarget)
+		}
+		// After linkify, whose patterns could match image data
+		if m.graphics != nil {
+			rendered = m.graphics.decorate(rendered, item)
+		}
+		m.viewport.SetContent(rendered)
+	}
+}
+
+// writeIssueSummaryMD writes the title, metadata table and labels of an
+// issue's detail markdown.
+func writeIssueSummaryMD(sb *strings.Builder, item model.Issue) {
+	// Title Block
+	sb.WriteString(fmt.Sprintf("# %s %s\n", GetTypeIconMD(string(item.IssueType)), item.Title))
+
+	// Meta Table
+	sb.WriteString(i18n.T("| ID | Status | Priority | Assignee | Created |") + "\n|---|---|---|---|---|\n")
+	sb.WriteString(fmt.Sprintf("| **%s** | **%s** | %s | @%s | %s |\n\n",
+		item.ID,
+		strings.ToUpper(string(item.Status)),
+		GetPriorityIcon(item.Priority),
+		item.Assignee,
+		item.CreatedAt.Format("2006-01-02"),
+	))
+
+	// Labels (bv-f103 fix: display labels in detail view)
+	if len(item.Labels) > 0 {
+		sb.WriteString(i18n.Tf("**Labels:** %s", strings.Join(item.Labels, ", ")) + "\n\n")
+	}
+
+	// Schedule (see timeline.go)
+	var schedule []string
+	if item.StartDate != nil {
+		schedule = append(schedule, i18n.Tf("**Starts:** %s", item.StartDate.Format("2006-01-02")))
+	}
+	if item.DueDate != nil {
+		schedule = append(schedule, i18n.Tf("**Due:** %s", item.DueDate.Format("2006-01-02")))
+	}
+	if len(schedule) > 0 {
+		sb.WriteString(strings.Join(schedule, " · ") + "\n\n")
+	}
+
+	// Votes (see comments.go)
+	if voters := item.Voters(); len(voters) > 0 {
+		sb.WriteString(i18n.Tf("**Votes:** ▲%d (%s)", len(voters), strings.Join(voters, ", ")) + "\n\n")
+	}
+}
 
+// writeIssueBodyMD writes the free-text sections, dependency tree and
+// comments of an issue's detail markdown.
+func writeIssueBodyMD(sb *strings.Builder, item model.Issue, issueMap map[string]*model.Issue, now time.Time) {
 	// Description
 	if item.Description != "" {
-		sb.WriteString("### Description\n")
+		sb.WriteString("### " + i18n.T("Description") + "\n")
 		sb.WriteString(item.Description + "\n\n")
 	}
 
 	// Design Notes
 	if item.Design != "" {
-		sb.WriteString("### Design Notes\n")
+		sb.WriteString("### " + i18n.T("Design Notes") + "\n")
 		sb.WriteString(item.Design + "\n\n")
 	}
 
 	// Acceptance Criteria
 	if item.AcceptanceCriteria != "" {
-		sb.WriteString("### Acceptance Criteria\n")
+		sb.WriteString("### " + i18n.T("Acceptance Criteria") + "\n")
 		sb.WriteString(item.AcceptanceCriteria + "\n\n")
 	}
 
 	// Notes
 	if item.Notes != "" {
-		sb.WriteString("### Notes\n")
+		sb.WriteString("### " + i18n.T("Notes") + "\n")
 		sb.WriteString(item.Notes + "\n\n")
 	}
 
 	// Dependency Graph (Tree)
 	if len(item.Dependencies) > 0 {
-		rootNode := BuildDependencyTree(item.ID, m.issueMap, 3) // Max depth 3
+		rootNode := BuildDependencyTree(item.ID, issueMap, 3) // Max depth 3
 		treeStr := RenderDependencyTree(rootNode)
 		sb.WriteString("```\n" + treeStr + "```\n\n")
 	}
 
-	// Comments
-	if len(item.Comments) > 0 {
-		sb.WriteString(fmt.Sprintf("### Comments (%d)\n", len(item.Comments)))
-		for _, comment := range item.Comments {
-			sb.WriteString(fmt.Sprintf("> **%s** (%s)\n> \n> %s\n\n",
-				comment.Author,
-				FormatTimeRel(comment.CreatedAt),
-				strings.ReplaceAll(comment.Text, "\n", "\n> ")))
-		}
-	}
-
-	// History Section (if data is loaded)
-	if m.historyView.HasReport() {
-		historyMD := m.renderBeadHistoryMD(item.ID)
-		if historyMD != "" {
-			sb.WriteString(historyMD)
-		}
-	}
-
-	rendered, err := m.renderer.Render(sb.String())
-	if err != nil {
-		m.viewport.SetContent(fmt.Sprintf("Error rendering markdown: %v", err))
-	} else {
-		m.viewport.SetContent(rendered)
-	}
+	// Comments (see comments.go)
+	writeCommentsMD(sb, item.Comments, now)
 }
 
 // renderBeadHistoryMD generates markdown for a bead's history
@@ -6425,28 +7269,28 @@ func (m *Model) renderBeadHistoryMD(beadID string) string {
 	}
 
 	var sb strings.Builder
-	sb.WriteString("### 📜 History\n\n")
+	sb.WriteString("### " + i18n.T("📜 History") + "\n\n")
 
 	// Lifecycle milestones from events
 	if len(hist.Events) > 0 {
-		sb.WriteString("**Lifecycle:**\n")
+		sb.WriteString("**" + i18n.T("Lifecycle:") + "**\n")
 		for _, event := range hist.Events {
 			icon := getEventIcon(event.EventType)
-			sb.WriteString(fmt.Sprintf("- %s **%s** %s by %s\n",
+			sb.WriteString(i18n.Tf("- %s **%s** %s by %s",
 				icon,
-				event.EventType,
+				eventTypeName(event.EventType),
 				event.Timestamp.Format("Jan 02 15:04"),
 				event.Author,
-			))
+			) + "\n")
 		}
 		sb.WriteString("\n")
 	}
 
 	// Correlated commits
-	sb.WriteString(fmt.Sprintf("**Related Commits (%d):**\n", len(hist.Commits)))
+	sb.WriteString(i18n.Tf("**Related Commits (%d):**", len(hist.Commits)) + "\n")
 	for i, commit := range hist.Commits {
 		if i >= 5 {
-			sb.WriteString(fmt.Sprintf("  ... and %d more commits\n", len(hist.Commits)-5))
+			sb.WriteString("  " + i18n.Tf("... and %d more commits", len(hist.Commits)-5) + "\n")
 			break
 		}
 
@@ -6473,7 +7317,7 @@ func (m *Model) renderBeadHistoryMD(beadID string) string {
 		}
 	}
 
-	sb.WriteString("\n*Press H for full history view*\n\n")
+	sb.WriteString("\n*" + i18n.T("Press H for full history view") + "*\n\n")
 	return sb.String()
 }
 
@@ -6495,20 +7339,13 @@ func getEventIcon(eventType correlation.EventType) string {
 	}
 }
 
-// truncateString truncates a string to maxLen runes with ellipsis.
-// Uses rune-based counting to safely handle UTF-8 multi-byte characters.
+// truncateString truncates a string to maxLen cells with ellipsis. At three
+// cells or fewer there is no room for one, so it just cuts.
 func truncateString(s string, maxLen int) string {
-	if maxLen <= 0 {
-		return ""
-	}
-	runes := []rune(s)
-	if len(runes) <= maxLen {
-		return s
-	}
 	if maxLen <= 3 {
-		return string(runes[:maxLen])
+		return truncateRunesHelper(s, maxLen, "")
 	}
-	return string(runes[:maxLen-1]) + "…"
+	return truncate(s, maxLen)
 }
 
 // GetTypeIconMD returns the emoji icon for an issue type (for markdown)
@@ -6574,7 +7411,7 @@ func (m Model) IsWorkspaceMode() bool {
 func (m *Model) enterHistoryView() {
 	cwd, err := os.Getwd()
 	if err != nil {
-		m.statusMsg = "Cannot get working directory for history"
+		m.statusMsg = i18n.T("Cannot get working directory for history")
 		m.statusIsError = true
 		return
 	}
@@ -6597,18 +7434,18 @@ func (m *Model) enterHistoryView() {
 
 	report, err := correlator.GenerateReport(beads, opts)
 	if err != nil {
-		m.statusMsg = fmt.Sprintf("History load failed: %v", err)
-		m.statusIsError = true
+		m.reportError(newError(errData, "load history", err))
 		return
 	}
 
 	// Initialize or update history view
 	m.historyView = NewHistoryModel(report, m.theme)
 	m.historyView.SetSize(m.width, m.height-1)
+	m.historyView.reducedMotion = m.reducedMotion
 	m.isHistoryView = true
 	m.focused = focusHistory
 
-	m.statusMsg = fmt.Sprintf("Loaded history: %d beads with commits", report.Stats.BeadsWithCommits)
+	m.statusMsg = i18n.Tf("Loaded history: %d beads with commits", report.Stats.BeadsWithCommits)
 	m.statusIsError = false
 }
 
@@ -6616,7 +7453,7 @@ func (m *Model) enterHistoryView() {
 func (m *Model) enterTimeTravelMode(revision string) {
 	cwd, err := os.Getwd()
 	if err != nil {
-		m.statusMsg = "❌ Time-travel failed: cannot get working directory"
+		m.statusMsg = i18n.T("❌ Time-travel failed: cannot get working directory")
 		m.statusIsError = true
 		return
 	}
@@ -6625,7 +7462,7 @@ func (m *Model) enterTimeTravelMode(revision string) {
 
 	// Check if we're in a git repo first
 	if _, err := gitLoader.ResolveRevision("HEAD"); err != nil {
-		m.statusMsg = "❌ Time-travel requires a git repository"
+		m.statusMsg = i18n.T("❌ Time-travel requires a git repository")
 		m.statusIsError = true
 		return
 	}
@@ -6633,7 +7470,7 @@ func (m *Model) enterTimeTravelMode(revision string) {
 	// Check if beads files exist at the revision
 	hasBeads, err := gitLoader.HasBeadsAtRevision(revision)
 	if err != nil || !hasBeads {
-		m.statusMsg = fmt.Sprintf("❌ No beads history at %s (try fewer commits back)", revision)
+		m.statusMsg = i18n.Tf("❌ No beads history at %s (try fewer commits back)", revision)
 		m.statusIsError = true
 		return
 	}
@@ -6641,8 +7478,7 @@ func (m *Model) enterTimeTravelMode(revision string) {
 	// Load historical issues
 	historicalIssues, err := gitLoader.LoadAt(revision)
 	if err != nil {
-		m.statusMsg = fmt.Sprintf("❌ Time-travel failed: %v", err)
-		m.statusIsError = true
+		m.reportError(newError(errData, "load issues at "+revision, err))
 		return
 	}
 
@@ -6672,7 +7508,7 @@ func (m *Model) enterTimeTravelMode(revision string) {
 	m.timeTravelSince = revision
 
 	// Success feedback
-	m.statusMsg = fmt.Sprintf("⏱️ Time-travel: comparing with %s (+%d ✅%d ~%d)",
+	m.statusMsg = i18n.Tf("⏱️ Time-travel: comparing with %s (+%d ✅%d ~%d)",
 		revision, diff.Summary.IssuesAdded, diff.Summary.IssuesClosed, diff.Summary.IssuesModified)
 	m.statusIsError = false
 
@@ -6690,7 +7526,7 @@ func (m *Model) exitTimeTravelMode() {
 	m.modifiedIssueIDs = nil
 
 	// Feedback
-	m.statusMsg = "⏱️ Time-travel mode disabled"
+	m.statusMsg = i18n.T("⏱️ Time-travel mode disabled")
 	m.statusIsError = false
 
 	// Rebuild list without diff info
@@ -6758,12 +7594,22 @@ func (m Model) FocusState() string {
 		return "agent_prompt"
 	case focusFlowMatrix:
 		return "flow_matrix"
+	case focusTreemap:
+		return "treemap"
+	case focusTimeline:
+		return "timeline"
 	case focusTutorial:
 		return "tutorial"
 	case focusCassModal:
 		return "cass_modal"
 	case focusUpdateModal:
 		return "update_modal"
+	case focusSessionReview:
+		return "session_review"
+	case focusCommandPrompt:
+		return "command_prompt"
+	case focusActionMenu:
+		return "action_menu"
 	default:
 		return "unknown"
 	}
@@ -6791,18 +7637,20 @@ func (m Model) IsHistoryView() bool {
 
 // exportToMarkdown exports all issues to a Markdown file with auto-generated filename
 func (m *Model) exportToMarkdown() {
+	if m.refuseReadOnly(i18n.T("Export")) {
+		return
+	}
 	// Generate smart filename: beads_report_<project>_YYYY-MM-DD.md
 	filename := m.generateExportFilename()
 
 	// Export the issues
 	err := export.SaveMarkdownToFile(m.issues, filename)
 	if err != nil {
-		m.statusMsg = fmt.Sprintf("❌ Export failed: %v", err)
-		m.statusIsError = true
+		m.reportError(newError(errData, "export", err))
 		return
 	}
 
-	m.statusMsg = fmt.Sprintf("✅ Exported %d issues to %s", len(m.issues), filename)
+	m.statusMsg = i18n.Tf("✅ Exported %d issues to %s", len(m.issues), filename)
 	m.statusIsError = false
 }
 
@@ -6855,12 +7703,11 @@ func (m Model) renderTimeTravelPrompt() string {
 		Foreground(t.Base.GetForeground())
 
 	// Build content
-	content := titleStyle.Render("⏱️  Time-Travel Mode") + "\n\n" +
-		subtitleStyle.Render("Compare current state with a historical revision") + "\n\n" +
+	content := titleStyle.Render(i18n.T("⏱️  Time-Travel Mode")) + "\n\n" +
+		subtitleStyle.Render(i18n.T("Compare current state with a historical revision")) + "\n\n" +
 		m.timeTravelInput.View() + "\n\n" +
-		exampleStyle.Render("Examples: HEAD~5, main, v1.0.0, 2024-01-01, abc123") + "\n\n" +
-		textStyle.Render("Press ") + keyStyle.Render("Enter") + textStyle.Render(" to compare, ") +
-		keyStyle.Render("Esc") + textStyle.Render(" to cancel")
+		exampleStyle.Render(i18n.T("Examples: HEAD~5, main, v1.0.0, 2024-01-01, abc123")) + "\n\n" +
+		textStyle.Render(i18n.Tf("Press %s to compare, %s to cancel", keyStyle.Render("Enter"), keyStyle.Render("Esc")))
 
 	box := boxStyle.Render(content)
 
@@ -6877,46 +7724,55 @@ func (m Model) renderTimeTravelPrompt() string {
 func (m *Model) copyIssueToClipboard() {
 	selectedItem := m.list.SelectedItem()
 	if selectedItem == nil {
-		m.statusMsg = "❌ No issue selected"
+		m.statusMsg = i18n.T("❌ No issue selected")
 		m.statusIsError = true
 		return
 	}
 
 	issueItem, ok := selectedItem.(IssueItem)
 	if !ok {
-		m.statusMsg = "❌ Invalid item type"
+		m.statusMsg = i18n.T("❌ Invalid item type")
 		m.statusIsError = true
 		return
 	}
-	issue := issueItem.Issue
+	m.copyIssueMarkdown(issueItem.Issue)
+}
 
+// copyIssueMarkdown copies issue to the clipboard as Markdown
+func (m *Model) copyIssueMarkdown(issue model.Issue) {
 	// Format issue as Markdown
 	var sb strings.Builder
 
 	sb.WriteString(fmt.Sprintf("# %s %s\n\n", GetTypeIconMD(string(issue.IssueType)), issue.Title))
-	sb.WriteString(fmt.Sprintf("**ID:** %s  \n", issue.ID))
-	sb.WriteString(fmt.Sprintf("**Status:** %s  \n", strings.ToUpper(string(issue.Status))))
-	sb.WriteString(fmt.Sprintf("**Priority:** P%d  \n", issue.Priority))
+	sb.WriteString(i18n.Tf("**ID:** %s", issue.ID) + "  \n")
+	sb.WriteString(i18n.Tf("**Status:** %s", strings.ToUpper(string(issue.Status))) + "  \n")
+	sb.WriteString(i18n.Tf("**Priority:** P%d", issue.Priority) + "  \n")
 	if issue.Assignee != "" {
-		sb.WriteString(fmt.Sprintf("**Assignee:** @%s  \n", issue.Assignee))
+		sb.WriteString(i18n.Tf("**Assignee:** @%s", issue.Assignee) + "  \n")
+	}
+	sb.WriteString(i18n.Tf("**Created:** %s", issue.CreatedAt.Format("2006-01-02")) + "  \n")
+	if issue.StartDate != nil {
+		sb.WriteString(i18n.Tf("**Starts:** %s", issue.StartDate.Format("2006-01-02")) + "  \n")
+	}
+	if issue.DueDate != nil {
+		sb.WriteString(i18n.Tf("**Due:** %s", issue.DueDate.Format("2006-01-02")) + "  \n")
 	}
-	sb.WriteString(fmt.Sprintf("**Created:** %s  \n", issue.CreatedAt.Format("2006-01-02")))
 
 	if len(issue.Labels) > 0 {
-		sb.WriteString(fmt.Sprintf("**Labels:** %s  \n", strings.Join(issue.Labels, ", ")))
+		sb.WriteString(i18n.Tf("**Labels:** %s", strings.Join(issue.Labels, ", ")) + "  \n")
 	}
 
 	if issue.Description != "" {
-		sb.WriteString(fmt.Sprintf("\n## Description\n\n%s\n", issue.Description))
+		sb.WriteString("\n## " + i18n.T("Description") + "\n\n" + issue.Description + "\n")
 	}
 
 	if issue.AcceptanceCriteria != "" {
-		sb.WriteString(fmt.Sprintf("\n## Acceptance Criteria\n\n%s\n", issue.AcceptanceCriteria))
+		sb.WriteString("\n## " + i18n.T("Acceptance Criteria") + "\n\n" + issue.AcceptanceCriteria + "\n")
 	}
 
 	// Dependencies
 	if len(issue.Dependencies) > 0 {
-		sb.WriteString("\n## Dependencies\n\n")
+		sb.WriteString("\n## " + i18n.T("Dependencies") + "\n\n")
 		for _, dep := range issue.Dependencies {
 			if dep == nil {
 				continue
@@ -6925,15 +7781,21 @@ func (m *Model) copyIssueToClipboard() {
 		}
 	}
 
-	// Copy to clipboard
-	err := clipboard.WriteAll(sb.String())
+	m.copyToClipboard(sb.String(), issue.ID)
+}
+
+// copyToClipboard writes text to the clipboard and reports what was copied
+func (m *Model) copyToClipboard(text, what string) {
+	if m.refuseReadOnly(i18n.T("The clipboard")) {
+		return
+	}
+	err := clipboard.WriteAll(text)
 	if err != nil {
-		m.statusMsg = fmt.Sprintf("❌ Clipboard error: %v", err)
-		m.statusIsError = true
+		m.reportError(newError(errTerminal, "copy to clipboard", err))
 		return
 	}
 
-	m.statusMsg = fmt.Sprintf("📋 Copied %s to clipboard", issue.ID)
+	m.statusMsg = i18n.Tf("📋 Copied %s to clipboard", what)
 	m.statusIsError = false
 }
 
@@ -6956,7 +7818,7 @@ func (m *Model) showCassSessionModal() {
 		// Initialize correlator lazily
 		detector := cass.NewDetector()
 		if detector.Check() != cass.StatusHealthy {
-			m.statusMsg = "⚠️ cass not available (install it for session correlation)"
+			m.statusMsg = i18n.T("⚠️ cass not available (install it for session correlation)")
 			m.statusIsError = false
 			return
 		}
@@ -6973,7 +7835,7 @@ func (m *Model) showCassSessionModal() {
 
 	// If no sessions found, just show a status message
 	if len(result.TopSessions) == 0 {
-		m.statusMsg = "No correlated sessions found for " + issue.ID
+		m.statusMsg = i18n.Tf("No correlated sessions found for %s", issue.ID)
 		m.statusIsError = false
 		return
 	}
@@ -6989,7 +7851,7 @@ func (m *Model) showCassSessionModal() {
 func (m *Model) showSelfUpdateModal() {
 	// Check if an update is available
 	if !m.updateAvailable || m.updateTag == "" {
-		m.statusMsg = "No update available - you're running the latest version"
+		m.statusMsg = i18n.T("No update available - you're running the latest version")
 		m.statusIsError = false
 		return
 	}
@@ -6997,10 +7859,39 @@ func (m *Model) showSelfUpdateModal() {
 	// Create and show the modal
 	m.updateModal = NewUpdateModal(m.updateTag, m.updateURL, m.theme)
 	m.updateModal.SetSize(m.width, m.height)
+	m.updateModal.reducedMotion = m.reducedMotion
+	m.updateModal.ctx = m.life.context()
+	m.updateModal.SetOrigin(updater.DetectInstallOrigin())
+	m.updateModal.prefs = m.updatePrefs
 	m.showUpdateModal = true
 	m.focused = focusUpdateModal
 }
 
+// skipUpdateRelease stops the reminders about the release on offer, saving
+// it as ui.skip_release in the user config.
+func (m *Model) skipUpdateRelease() {
+	if err := config.SetValues(config.DefaultPath(), map[string]any{"ui.skip_release": m.updateTag}); err != nil {
+		m.reportError(newError(errConfig, "skip "+m.updateTag, err))
+		return
+	}
+	m.updatePrefs.SkipRelease = m.updateTag
+	m.updateAvailable = false
+	m.statusMsg = i18n.Tf("Skipping %s; bv will remind you about the next release", m.updateTag)
+	m.statusIsError = false
+}
+
+// disableUpdateChecks turns ui.check_updates off in the user config.
+func (m *Model) disableUpdateChecks() {
+	if err := config.SetValues(config.DefaultPath(), map[string]any{"ui.check_updates": false}); err != nil {
+		m.reportError(newError(errConfig, "turn off update checks", err))
+		return
+	}
+	m.skipUpdateCheck = true
+	m.updateAvailable = false
+	m.statusMsg = i18n.T("Update checks turned off; set ui.check_updates to true to turn them back on")
+	m.statusIsError = false
+}
+
 // getCassSessionCount returns the cached session count for the selected bead (bv-y836)
 // Returns 0 if no sessions found, cass not available, or no bead selected.
 // This method only checks the cache - it never triggers new correlation requests.
@@ -7319,6 +8210,9 @@ func startAllowlistedGUIEditor(kind allowlistedGUIEditorKind, targetFile string)
 // openInEditor opens the beads file in the user's preferred editor
 // Uses m.beadsPath which respects issues.jsonl (canonical per beads upstream)
 func (m *Model) openInEditor() {
+	if m.refuseReadOnly(i18n.T("Opening the editor")) {
+		return
+	}
 	// Use the configured beadsPath instead of hardcoded path
 	beadsFile := m.beadsPath
 	if beadsFile == "" {
@@ -7328,13 +8222,15 @@ func (m *Model) openInEditor() {
 		}
 	}
 	if beadsFile == "" {
-		m.statusMsg = "❌ No .beads directory or beads.jsonl found"
-		m.statusIsError = true
+		m.reportError(newError(errData, "open editor", errors.New("no .beads directory or beads.jsonl found")))
+		return
+	}
+	if loader.IsSQLitePath(beadsFile) {
+		m.reportError(newError(errData, "open editor", fmt.Errorf("%s is a SQLite database; edit issues with bd", beadsFile)))
 		return
 	}
 	if _, err := os.Stat(beadsFile); os.IsNotExist(err) {
-		m.statusMsg = fmt.Sprintf("❌ Beads file not found: %s", beadsFile)
-		m.statusIsError = true
+		m.reportError(newError(errData, "open editor", fmt.Errorf("beads file not found: %s", beadsFile)))
 		return
 	}
 
@@ -7349,24 +8245,22 @@ func (m *Model) openInEditor() {
 	if editor != "" {
 		editorArgs, err := parseCommandLine(editor)
 		if err != nil {
-			m.statusMsg = fmt.Sprintf("❌ Invalid $EDITOR/$VISUAL: %v", err)
-			m.statusIsError = true
+			m.reportError(newError(errConfig, "open editor", fmt.Errorf("invalid $EDITOR/$VISUAL: %w", err)))
 			return
 		}
 
 		editorBase, kind := classifyEditorCommand(editorArgs)
 		switch kind {
 		case editorCommandTerminal:
-			m.statusMsg = fmt.Sprintf("⚠️ %s is a terminal editor - set $EDITOR to a GUI editor or quit first", editorBase)
-			m.statusIsError = true
+			m.reportError(newError(errTerminal, "open editor", fmt.Errorf("%s is a terminal editor", editorBase)).
+				withHint("set $EDITOR to a GUI editor or quit first"))
 			return
 		case editorCommandForbidden:
-			m.statusMsg = fmt.Sprintf("❌ Refusing to run %s as editor (shell/interpreter). Set $EDITOR to a GUI editor", editorBase)
-			m.statusIsError = true
+			m.reportError(newError(errConfig, "open editor", fmt.Errorf("refusing to run %s as editor (shell/interpreter)", editorBase)).
+				withHint("set $EDITOR to a GUI editor"))
 			return
 		case editorCommandEmpty:
-			m.statusMsg = "❌ Invalid $EDITOR/$VISUAL: empty command"
-			m.statusIsError = true
+			m.reportError(newError(errConfig, "open editor", errors.New("invalid $EDITOR/$VISUAL: empty command")))
 			return
 		default:
 			requestedEditorKind = allowlistedGUIEditorKindForBase(editorBase)
@@ -7396,23 +8290,22 @@ func (m *Model) openInEditor() {
 	}
 
 	if requestedEditorKind == allowlistedGUIEditorUnknown {
-		m.statusMsg = "❌ No GUI editor found. Set $EDITOR to a GUI editor"
-		m.statusIsError = true
+		m.reportError(newError(errConfig, "open editor", errors.New("no GUI editor found")).
+			withHint("set $EDITOR to a GUI editor"))
 		return
 	}
 
 	actualKind, err := startAllowlistedGUIEditor(requestedEditorKind, beadsFile)
 	if err != nil {
-		m.statusMsg = fmt.Sprintf("❌ Failed to open editor: %v", err)
-		m.statusIsError = true
+		m.reportError(newError(errTerminal, "open editor", err))
 		return
 	}
 	requestedEditorKind = actualKind
 
 	if ignoredEditorBase != "" {
-		m.statusMsg = fmt.Sprintf("📝 Opened in %s (ignored $EDITOR=%s)", allowlistedGUIEditorDisplayName(requestedEditorKind), ignoredEditorBase)
+		m.statusMsg = i18n.Tf("📝 Opened in %s (ignored $EDITOR=%s)", allowlistedGUIEditorDisplayName(requestedEditorKind), ignoredEditorBase)
 	} else {
-		m.statusMsg = fmt.Sprintf("📝 Opened in %s", allowlistedGUIEditorDisplayName(requestedEditorKind))
+		m.statusMsg = i18n.Tf("📝 Opened in %s", allowlistedGUIEditorDisplayName(requestedEditorKind))
 	}
 	m.statusIsError = false
 }
@@ -7420,6 +8313,9 @@ func (m *Model) openInEditor() {
 // Stop cleans up resources (file watcher, instance lock, background worker, etc.)
 // Should be called when the program exits
 func (m *Model) Stop() {
+	if canceled := m.life.shutdown(shutdownGrace); canceled > 0 {
+		fmt.Fprintf(os.Stderr, "Warning: canceled %d bd command(s) still running after %s\n", canceled, shutdownGrace)
+	}
 	if m.backgroundWorker != nil {
 		m.backgroundWorker.Stop()
 	}
@@ -7431,6 +8327,126 @@ func (m *Model) Stop() {
 	}
 }
 
+// EnableReadOnly makes the model a view-only session, for someone other than
+// the local user (bv serve-ssh) or on a repository that is not yours
+// (--read-only): anything that would act on this machine, such as bd
+// actions, hooks, the editor, exports or the clipboard, is refused, nothing
+// is written under .beads or .bv, and the model stays out of multi-instance
+// coordination. The status bar says so.
+func (m *Model) EnableReadOnly() {
+	m.readOnly = true
+	m.tree.readOnly = true
+	if m.instanceLock != nil {
+		m.instanceLock.Release()
+		m.instanceLock = nil
+	}
+}
+
+// liveReloadWatcher returns the watcher behind live reload, or nil when
+// live reload is off.
+func (m Model) liveReloadWatcher() *watcher.Watcher {
+	if m.backgroundWorker != nil {
+		return m.backgroundWorker.Watcher()
+	}
+	return m.watcher
+}
+
+// autoRefreshPaused reports whether live reload is paused.
+func (m Model) autoRefreshPaused() bool {
+	w := m.liveReloadWatcher()
+	return w != nil && w.IsPaused()
+}
+
+// setAutoRefreshPaused pauses or resumes live reload. Changes made while
+// paused are loaded on resume.
+func (m *Model) setAutoRefreshPaused(paused bool) error {
+	w := m.liveReloadWatcher()
+	if w == nil {
+		return fmt.Errorf("live reload is off")
+	}
+	if paused {
+		w.Pause()
+		m.statusMsg = i18n.T("Auto-refresh paused (Z resumes, Ctrl+R reloads now)")
+	} else {
+		w.Resume()
+		m.statusMsg = i18n.T("Auto-refresh resumed")
+	}
+	m.statusIsError = false
+	return nil
+}
+
+// forceRefresh reloads the issues now rather than waiting for live reload
+// (bv-4auz). Presses within a second of the last one are ignored.
+func (m Model) forceRefresh() (Model, tea.Cmd) {
+	now := time.Now()
+	if !m.lastForceRefresh.IsZero() && now.Sub(m.lastForceRefresh) < time.Second {
+		return m, nil
+	}
+	m.lastForceRefresh = now
+
+	m.statusMsg = i18n.T("Refreshing…")
+	m.statusIsError = false
+
+	if m.backgroundWorker != nil {
+		m.backgroundWorker.ForceRefresh()
+		return m, WaitForBackgroundWorkerMsgCmd(m.backgroundWorker)
+	}
+
+	if m.beadsPath == "" && m.watcher == nil {
+		m.statusMsg = i18n.T("Refresh unavailable")
+		m.statusIsError = true
+		return m, nil
+	}
+
+	return m, func() tea.Msg { return FileChangedMsg{} }
+}
+
+// EnableReducedMotion stops everything that repaints on a timer: the
+// background refresh spinner and its 120ms tick, the history mode flash and
+// the update progress spinner. The screen then only changes on key presses
+// and data updates, which matters over slow SSH links and on battery.
+func (m *Model) EnableReducedMotion(enabled bool) {
+	m.reducedMotion = enabled
+	m.historyView.reducedMotion = enabled
+	m.updateModal.reducedMotion = enabled
+}
+
+// EnableUpdateCheck turns the check for a new release at startup on or off.
+// It is on by default.
+func (m *Model) EnableUpdateCheck(enabled bool) {
+	m.skipUpdateCheck = !enabled
+}
+
+// SetUpdatePreferences sets the release to skip (ui.skip_release) and the
+// major version updates stay within (ui.update_pin).
+func (m *Model) SetUpdatePreferences(prefs updater.Preferences) {
+	m.updatePrefs = prefs
+}
+
+// SetClock replaces the wall clock the views use for relative times ("3d
+// ago") and ages, so that rendering depends only on model state. Tests use
+// it to render golden output.
+func (m *Model) SetClock(now func() time.Time) {
+	m.now = now
+	m.board.now = now
+	// Triage reasons and alerts mention ages ("no activity in N days").
+	stats := computeStartupStats(m.analyzer, m.analysis, m.issues, now(), nil)
+	m.alerts, m.alertsCritical, m.alertsWarning, m.alertsInfo = stats.alerts, stats.critical, stats.warning, stats.info
+	m.setTriage(stats.triage)
+	m.updateListDelegate()
+}
+
+// refuseReadOnly reports whether the model is read-only, explaining in the
+// status bar that action is unavailable.
+func (m *Model) refuseReadOnly(action string) bool {
+	if !m.readOnly {
+		return false
+	}
+	m.statusMsg = i18n.Tf("%s is not available in a read-only session", action)
+	m.statusIsError = true
+	return true
+}
+
 // clearAttentionOverlay hides the attention overlay and clears its rendered text.
 func (m *Model) clearAttentionOverlay() {
 	if m.showAttentionView {
@@ -7503,7 +8519,7 @@ func computeAlerts(issues []model.Issue, stats *analysis.GraphStats, analyzer *a
 
 // alertKey generates a unique key for an alert (for dismissal tracking)
 func alertKey(a drift.Alert) string {
-	return fmt.Sprintf("%s:%s:%s", a.Type, a.Severity, a.IssueID)
+	return string(a.Type) + ":" + string(a.Severity) + ":" + a.IssueID
 }
 
 // renderAlertsPanel renders the alerts overlay panel
@@ -7531,11 +8547,11 @@ func (m Model) renderAlertsPanel() string {
 	}
 
 	var sb strings.Builder
-	sb.WriteString(titleStyle.Render("🔔 Alerts Panel"))
+	sb.WriteString(titleStyle.Render(i18n.T("🔔 Alerts Panel")))
 	sb.WriteString("\n\n")
 
 	if len(visibleAlerts) == 0 {
-		sb.WriteString(t.Renderer.NewStyle().Foreground(ColorSuccess).Render("✓ No active alerts"))
+		sb.WriteString(t.Renderer.NewStyle().Foreground(ColorSuccess).Render(i18n.T("✓ No active alerts")))
 		sb.WriteString("\n\n")
 	} else {
 		// Summary line
@@ -7606,7 +8622,7 @@ func (m Model) renderAlertsPanel() string {
 
 	sb.WriteString("\n")
 	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Muted).Italic(true).Render(
-		"j/k: navigate • Enter: jump to issue • d: dismiss • Esc: close"))
+		i18n.T("j/k: navigate • Enter: jump to issue • d: dismiss • Esc: close")))
 
 	content := boxStyle.Render(sb.String())
 
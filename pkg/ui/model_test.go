@@ -108,7 +108,7 @@ func TestFormatTimeRel(t *testing.T) {
 	}
 
 	for _, tt := range tests {
-		got := ui.FormatTimeRel(tt.t)
+		got := ui.FormatTimeRel(tt.t, now)
 		if got != tt.expected {
 			t.Errorf("FormatTimeRel(%v) = %s; want %s", tt.t, got, tt.expected)
 		}
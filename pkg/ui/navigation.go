@@ -0,0 +1,215 @@
+package ui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Navigation history
+//
+// Every key that moves to another view, or to another issue's details, pushes
+// the location it left onto a back stack. Backspace and Ctrl+O walk back
+// through it and Tab (the key Ctrl+I sends) walks forward again, like a
+// vim jumplist; moving somewhere new drops the forward entries. The
+// breadcrumbs status bar segment shows the path.
+
+// maxNavHistory bounds the back stack.
+const maxNavHistory = 50
+
+// location is a place in the UI that navigation can return to.
+type location struct {
+	view    string // "list", "detail" or a commandViewKeys name
+	issueID string // Selected issue, if the view has a selection
+}
+
+// label is how the breadcrumbs show a location: issue details by ID,
+// everything else by view.
+func (l location) label() string {
+	if l.view == "detail" && l.issueID != "" {
+		return l.issueID
+	}
+	return l.view
+}
+
+type navigation struct {
+	back    []location
+	forward []location
+
+	busy     bool // An Update is already recording; commands re-entering Update don't
+	traveled bool // This key went back or forward, so it is not a new jump
+	jump     bool // This key jumped to another issue (see gotoIssue)
+}
+
+// location reports where the UI is. Views navigation cannot return to, such
+// as the sprint view, report false.
+func (m Model) location() (location, bool) {
+	var loc location
+	switch {
+	case m.focused == focusInsights && m.showAttentionView:
+		loc.view = "attention"
+	case m.focused == focusInsights:
+		loc.view = "insights"
+	case m.focused == focusFlowMatrix:
+		loc.view = "flow"
+	case m.focused == focusTreemap:
+		loc.view = "treemap"
+	case m.focused == focusTimeline:
+		loc.view = "timeline"
+	case m.focused == focusLabelDashboard:
+		loc.view = "labels"
+	case m.focused == focusTree:
+		loc.view = "tree"
+		if issue := m.tree.SelectedIssue(); issue != nil {
+			loc.issueID = issue.ID
+		}
+	case m.isGraphView:
+		loc.view = "graph"
+		if issue := m.graphView.SelectedIssue(); issue != nil {
+			loc.issueID = issue.ID
+		}
+	case m.isBoardView:
+		loc.view = "board"
+		if issue := m.board.SelectedIssue(); issue != nil {
+			loc.issueID = issue.ID
+		}
+	case m.isActionableView:
+		loc.view = "actionable"
+	case m.isHistoryView:
+		loc.view = "history"
+	case m.isSprintView:
+		return location{}, false
+	default:
+		loc.view = "list"
+		if m.showDetails && !m.isSplitView {
+			loc.view = "detail"
+		}
+		if item, ok := m.list.SelectedItem().(IssueItem); ok {
+			loc.issueID = item.Issue.ID
+		}
+	}
+	return loc, true
+}
+
+// recordNavigation runs update for a key and pushes the location it left if
+// the key moved to another view, or to another issue in the detail view or
+// by a jump.
+func (m Model) recordNavigation(msg tea.Msg) (tea.Model, tea.Cmd) {
+	from, fromOK := m.location()
+	m.nav.busy, m.nav.jump = true, false
+	newM, cmd := m.update(msg)
+	next, ok := newM.(Model)
+	if !ok {
+		return newM, cmd
+	}
+	nav := &next.nav
+	to, toOK := next.location()
+	moved := from.view != to.view ||
+		(from.issueID != to.issueID && (to.view == "detail" || nav.jump))
+	if fromOK && toOK && moved && !nav.traveled {
+		nav.back = pushLocation(nav.back, from)
+		nav.forward = nil
+	}
+	nav.busy, nav.traveled, nav.jump = false, false, false
+	return next, cmd
+}
+
+// pushLocation appends without sharing the backing array of earlier Model
+// copies, dropping the oldest entry past maxNavHistory.
+func pushLocation(stack []location, loc location) []location {
+	if n := len(stack); n >= maxNavHistory {
+		stack = stack[n-maxNavHistory+1:]
+	}
+	return append(stack[:len(stack):len(stack)], loc)
+}
+
+// goBack returns to the previous location, if any.
+func (m Model) goBack() Model {
+	n := len(m.nav.back)
+	if n == 0 {
+		return m
+	}
+	target := m.nav.back[n-1]
+	if cur, ok := m.location(); ok {
+		m.nav.forward = pushLocation(m.nav.forward, cur)
+	}
+	m.nav.back = m.nav.back[: n-1 : n-1]
+	return m.travelTo(target)
+}
+
+// goForward undoes a goBack.
+func (m Model) goForward() Model {
+	n := len(m.nav.forward)
+	if n == 0 {
+		return m
+	}
+	target := m.nav.forward[n-1]
+	if cur, ok := m.location(); ok {
+		m.nav.back = pushLocation(m.nav.back, cur)
+	}
+	m.nav.forward = m.nav.forward[: n-1 : n-1]
+	return m.travelTo(target)
+}
+
+// travelTo restores a location. An issue that has since been filtered out
+// or closed leaves the view's selection where it is.
+func (m Model) travelTo(loc location) Model {
+	m.nav.traveled = true
+	m.showDetails = false
+
+	view := loc.view
+	if view == "detail" {
+		view = "list"
+	}
+	if next, _, err := m.ExecCommand("view " + view); err == nil {
+		m = next
+	}
+	if loc.issueID == "" {
+		return m
+	}
+
+	switch loc.view {
+	case "board":
+		m.board.SelectIssueByID(loc.issueID)
+	case "graph":
+		m.graphView.SelectByID(loc.issueID)
+	case "tree":
+		m.tree.SelectByID(loc.issueID)
+	case "list", "detail":
+		if next, _, err := m.ExecCommand("select " + loc.issueID); err == nil {
+			m = next
+		}
+		if loc.view == "detail" {
+			m.showDetails = true
+			m.focused = focusDetail
+			m.viewport.GotoTop()
+			m.updateViewportContent()
+		}
+	}
+	return m
+}
+
+// renderBreadcrumbs renders the last few locations before the current one.
+func (m Model) renderBreadcrumbs() string {
+	if len(m.nav.back) == 0 {
+		return ""
+	}
+	const shown = 4
+	var labels []string
+	start := max(len(m.nav.back)-shown, 0)
+	if start > 0 {
+		labels = append(labels, "…")
+	}
+	for _, loc := range m.nav.back[start:] {
+		labels = append(labels, loc.label())
+	}
+	if cur, ok := m.location(); ok {
+		labels = append(labels, cur.label())
+	}
+	return lipgloss.NewStyle().
+		Background(ColorBgHighlight).
+		Foreground(ColorText).
+		Padding(0, 1).
+		Render(strings.Join(labels, " › "))
+}
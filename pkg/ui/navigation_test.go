@@ -0,0 +1,124 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func navTestModel(t *testing.T) Model {
+	t.Helper()
+	m := commandTestModel(t)
+	// Narrow enough for the full-width detail view
+	newM, _ := m.Update(tea.WindowSizeMsg{Width: 80, Height: 30})
+	return newM.(Model)
+}
+
+func pressKey(m Model, key tea.KeyMsg) Model {
+	newM, _ := m.Update(key)
+	return newM.(Model)
+}
+
+func runeKey(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func mustLocation(t *testing.T, m Model, view, issueID string) {
+	t.Helper()
+	loc, ok := m.location()
+	if !ok || loc.view != view || (issueID != "" && loc.issueID != issueID) {
+		t.Fatalf("expected %s %s, got %+v", view, issueID, loc)
+	}
+}
+
+func TestNavigation_BackAndForward(t *testing.T) {
+	m := navTestModel(t)
+	mustLocation(t, m, "list", "")
+	first, _ := m.location()
+
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEnter})
+	mustLocation(t, m, "detail", first.issueID)
+
+	m = pressKey(m, runeKey("g"))
+	mustLocation(t, m, "graph", "")
+	m.graphView.SelectByID("A-3")
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEnter})
+	mustLocation(t, m, "detail", "A-3")
+
+	footer := m.renderFooter()
+	if !strings.Contains(footer, "list › "+first.issueID+" › graph › A-3") {
+		t.Errorf("expected the breadcrumbs in the footer, got %q", footer)
+	}
+
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyBackspace})
+	mustLocation(t, m, "graph", "A-3")
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyCtrlO})
+	mustLocation(t, m, "detail", first.issueID)
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEsc})
+	mustLocation(t, m, "list", first.issueID)
+	if len(m.nav.back) != 0 {
+		t.Fatalf("expected the start of the history, got %v", m.nav.back)
+	}
+
+	// Tab is Ctrl+I: forward again
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyTab})
+	mustLocation(t, m, "detail", first.issueID)
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyTab})
+	mustLocation(t, m, "graph", "A-3")
+
+	// Going somewhere new drops the forward history
+	m = pressKey(m, runeKey("b"))
+	mustLocation(t, m, "board", "")
+	if len(m.nav.forward) != 0 {
+		t.Errorf("expected the forward history dropped, got %v", m.nav.forward)
+	}
+}
+
+func TestNavigation_EscWithoutHistory(t *testing.T) {
+	m := navTestModel(t)
+	m = pressKey(m, runeKey("b"))
+	m.nav = navigation{}
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEsc})
+	mustLocation(t, m, "list", "")
+
+	// From the list, Esc still asks to quit rather than going back
+	m = pressKey(m, runeKey("b"))
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEsc})
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEsc})
+	if !m.showQuitConfirm {
+		t.Error("expected Esc at the list to ask to quit")
+	}
+}
+
+func TestNavigation_JumpsAndBound(t *testing.T) {
+	m := navTestModel(t)
+	m = m.gotoIssue("A-3")
+	m = pressKey(m, runeKey(":"))
+	for _, r := range "A-2" {
+		m = pressKey(m, runeKey(string(r)))
+	}
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEnter})
+	mustLocation(t, m, "list", "A-2")
+	if n := len(m.nav.back); n != 1 || m.nav.back[0].view != "list" {
+		t.Fatalf("expected the jump recorded, got %v", m.nav.back)
+	}
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyBackspace})
+	if loc, _ := m.location(); loc.issueID == "A-2" {
+		t.Error("expected Backspace to undo the jump")
+	}
+
+	// j/k within a view are not jumps
+	m.nav = navigation{}
+	m = pressKey(m, runeKey("j"))
+	if len(m.nav.back) != 0 {
+		t.Errorf("expected moving the selection not to be recorded, got %v", m.nav.back)
+	}
+
+	for i := 0; i < maxNavHistory+10; i++ {
+		m = pressKey(m, runeKey("b"))
+	}
+	if len(m.nav.back) != maxNavHistory {
+		t.Errorf("expected %d entries, got %d", maxNavHistory, len(m.nav.back))
+	}
+}
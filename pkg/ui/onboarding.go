@@ -0,0 +1,252 @@
+package ui
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Onboarding
+//
+// The first time bv runs without a user config, or with --setup, it shows
+// where it found the issues and asks for the settings new users most often
+// change. It runs as its own program before the TUI, so the answers are
+// written to the user config and loaded like any other setting; finishing
+// it opens the full tutorial.
+
+// onboardingOption is one answer to an onboarding question.
+type onboardingOption struct {
+	label string
+	value any // Config value; nil removes the key
+}
+
+// onboardingQuestion asks for the value of one config key.
+type onboardingQuestion struct {
+	key     string
+	title   string
+	help    string
+	options []onboardingOption
+}
+
+func onboardingQuestions(hasHooks bool) []onboardingQuestion {
+	hooksHelp := "Hooks in .bv/hooks.yaml run shell commands when issues are created or go stale, and from the action menu. This project has none yet."
+	if hasHooks {
+		hooksHelp = "Hooks in .bv/hooks.yaml run shell commands when issues are created or go stale, and from the action menu. This project has some."
+	}
+	return []onboardingQuestion{
+		{key: "ui.theme", title: "Theme", help: "Colors for the terminal's background.", options: []onboardingOption{
+			{"Follow the terminal", "auto"}, {"Dark", "dark"}, {"Light", "light"},
+		}},
+		{key: "ui.startup_commands", title: "Default view", help: "What bv shows when it starts.", options: []onboardingOption{
+			{"Issue list", nil},
+			{"Kanban board", []string{"view board"}},
+			{"Actionable issues", []string{"view actionable"}},
+			{"Insights", []string{"view insights"}},
+			{"Dependency graph", []string{"view graph"}},
+		}},
+		{key: "ui.check_updates", title: "Update checks", help: "Ask GitHub for the latest bv release at startup. Nothing about your issues is sent.", options: []onboardingOption{
+			{"Check for updates", true}, {"Never check", false},
+		}},
+		{key: "ui.run_hooks", title: "Project hooks", help: hooksHelp, options: []onboardingOption{
+			{"Run project hooks", true}, {"Never run hooks from the TUI", false},
+		}},
+	}
+}
+
+// Onboarding is the first-run setup wizard.
+type Onboarding struct {
+	theme      Theme
+	database   string // Where the issues were found; empty when not a single file
+	issueCount int
+	configPath string
+
+	questions []onboardingQuestion
+	choices   []int // Selected option of each question
+	step      int   // 0 is the welcome, then one per question, then the summary
+
+	width, height int
+	finished      bool // Saved from the summary
+	skipped       bool // esc: keep the defaults
+	canceled      bool // ctrl+c: save nothing
+}
+
+// NewOnboarding returns the setup wizard for the issues found at database,
+// to be saved at configPath. current preselects the answers.
+func NewOnboarding(database string, issueCount int, hasHooks bool, configPath string, current config.UIConfig) Onboarding {
+	o := Onboarding{
+		theme:      DefaultTheme(lipgloss.DefaultRenderer()),
+		database:   database,
+		issueCount: issueCount,
+		configPath: configPath,
+		questions:  onboardingQuestions(hasHooks),
+		width:      80,
+		height:     24,
+	}
+	on := func(b *bool) bool { return b == nil || *b }
+	theme := current.Theme
+	if theme == "" {
+		theme = "auto"
+	}
+	var startup any
+	if len(current.StartupCommands) > 0 {
+		startup = current.StartupCommands
+	}
+	values := map[string]any{
+		"ui.theme":            theme,
+		"ui.startup_commands": startup,
+		"ui.check_updates":    on(current.CheckUpdates),
+		"ui.run_hooks":        on(current.RunHooks),
+	}
+	o.choices = make([]int, len(o.questions))
+	for i, q := range o.questions {
+		o.choices[i] = -1
+		for j, opt := range q.options {
+			if reflect.DeepEqual(opt.value, values[q.key]) {
+				o.choices[i] = j
+			}
+		}
+		if o.choices[i] < 0 {
+			// Keep a setting the wizard has no option for, such as custom
+			// startup commands.
+			keep := onboardingOption{"Keep " + formatOnboardingValue(values[q.key]), values[q.key]}
+			o.questions[i].options = append([]onboardingOption{keep}, q.options...)
+			o.choices[i] = 0
+		}
+	}
+	return o
+}
+
+func formatOnboardingValue(v any) string {
+	if cmds, ok := v.([]string); ok {
+		return strings.Join(cmds, "; ")
+	}
+	return fmt.Sprint(v)
+}
+
+// Init implements tea.Model.
+func (o Onboarding) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model.
+func (o Onboarding) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		o.width, o.height = msg.Width, msg.Height
+	case tea.KeyMsg:
+		return o.handleKeys(msg)
+	}
+	return o, nil
+}
+
+func (o Onboarding) handleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	last := len(o.questions) + 1
+	switch msg.String() {
+	case "ctrl+c":
+		o.canceled = true
+		return o, tea.Quit
+	case "esc":
+		o.skipped = true
+		for i := range o.choices {
+			o.choices[i] = 0
+		}
+		return o, tea.Quit
+	case "enter", "right", "l":
+		if o.step == last {
+			o.finished = true
+			return o, tea.Quit
+		}
+		o.step++
+	case "left", "h", "backspace":
+		if o.step > 0 {
+			o.step--
+		}
+	case "j", "down":
+		if q := o.step - 1; q >= 0 && q < len(o.questions) && o.choices[q] < len(o.questions[q].options)-1 {
+			o.choices[q]++
+		}
+	case "k", "up":
+		if q := o.step - 1; q >= 0 && q < len(o.questions) && o.choices[q] > 0 {
+			o.choices[q]--
+		}
+	}
+	return o, nil
+}
+
+// Values returns the config values to save, keyed like "ui.theme"; nil
+// when the wizard was canceled. Skipping it saves the defaults, so that it
+// is not shown again.
+func (o Onboarding) Values() map[string]any {
+	if o.canceled || (!o.finished && !o.skipped) {
+		return nil
+	}
+	values := make(map[string]any, len(o.questions))
+	for i, q := range o.questions {
+		values[q.key] = q.options[o.choices[i]].value
+	}
+	return values
+}
+
+// Tutorial reports whether the tutorial should open next: the wizard was
+// finished rather than skipped.
+func (o Onboarding) Tutorial() bool {
+	return o.finished
+}
+
+// View implements tea.Model.
+func (o Onboarding) View() string {
+	t := o.theme
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 3)
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	textStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+	selectedStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+
+	width := max(min(o.width-12, 70), 30)
+	wrap := textStyle.Width(width)
+	last := len(o.questions) + 1
+	var sb strings.Builder
+	switch {
+	case o.step == 0:
+		sb.WriteString(titleStyle.Render(i18n.T("Welcome to bv")) + "\n\n")
+		if o.database != "" {
+			sb.WriteString(wrap.Render(fmt.Sprintf("Found %d issues in %s.", o.issueCount, o.database)) + "\n\n")
+		} else {
+			sb.WriteString(wrap.Render(fmt.Sprintf("Loaded %d issues.", o.issueCount)) + "\n\n")
+		}
+		sb.WriteString(wrap.Render(fmt.Sprintf("A few questions to set bv up. The answers are saved to %s; run bv --setup to change them later.", o.configPath)) + "\n")
+		sb.WriteString("\n" + mutedStyle.Render(i18n.T("enter: start • esc: skip and keep the defaults")))
+	case o.step == last:
+		sb.WriteString(titleStyle.Render(i18n.T("Ready")) + "\n\n")
+		for i, q := range o.questions {
+			sb.WriteString(mutedStyle.Render(fmt.Sprintf("%-15s", q.title)) + textStyle.Render(q.options[o.choices[i]].label) + "\n")
+		}
+		sb.WriteString("\n" + wrap.Render("Saving writes "+o.configPath+" and opens the tutorial.") + "\n")
+		sb.WriteString("\n" + mutedStyle.Render(i18n.T("enter: save • ←: back • esc: skip and keep the defaults")))
+	default:
+		i := o.step - 1
+		q := o.questions[i]
+		sb.WriteString(titleStyle.Render(q.title) + mutedStyle.Render(fmt.Sprintf("  %d/%d", o.step, len(o.questions))) + "\n\n")
+		sb.WriteString(wrap.Render(q.help) + "\n\n")
+		for j, opt := range q.options {
+			if j == o.choices[i] {
+				sb.WriteString(selectedStyle.Render("▸ "+opt.label) + "\n")
+			} else {
+				sb.WriteString("  " + textStyle.Render(opt.label) + "\n")
+			}
+		}
+		sb.WriteString("\n" + mutedStyle.Render(i18n.T("j/k: choose • enter: next • ←: back • esc: skip")))
+	}
+
+	return lipgloss.Place(o.width, o.height, lipgloss.Center, lipgloss.Center, boxStyle.Render(sb.String()))
+}
@@ -0,0 +1,94 @@
+package ui
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func sendOnboardingKeys(o Onboarding, keys ...tea.KeyMsg) (Onboarding, tea.Cmd) {
+	var cmd tea.Cmd
+	for _, key := range keys {
+		var next tea.Model
+		next, cmd = o.Update(key)
+		o = next.(Onboarding)
+	}
+	return o, cmd
+}
+
+func TestOnboarding_SavesAnswersAndOpensTutorial(t *testing.T) {
+	o := NewOnboarding(".beads/beads.jsonl", 12, true, "/home/me/.config/bv/config.yaml", config.UIConfig{})
+	if view := o.View(); !strings.Contains(view, "Found 12 issues in .beads/beads.jsonl") {
+		t.Fatalf("expected the database on the welcome page:\n%s", view)
+	}
+	enter, down := tea.KeyMsg{Type: tea.KeyEnter}, runeKey("j")
+	o, _ = sendOnboardingKeys(o, enter, down, enter, down, enter, down, enter)
+	if view := o.View(); !strings.Contains(view, "This project has some") {
+		t.Fatalf("expected the hooks question to mention the project's hooks:\n%s", view)
+	}
+	o, _ = sendOnboardingKeys(o, down, enter)
+	if view := o.View(); !strings.Contains(view, "Kanban board") || !strings.Contains(view, "Never check") {
+		t.Fatalf("expected the answers on the summary:\n%s", view)
+	}
+	o, cmd := sendOnboardingKeys(o, enter)
+	if cmd == nil || !o.Tutorial() {
+		t.Fatal("expected saving to quit and open the tutorial")
+	}
+	want := map[string]any{
+		"ui.theme":            "dark",
+		"ui.startup_commands": []string{"view board"},
+		"ui.check_updates":    false,
+		"ui.run_hooks":        false,
+	}
+	if got := o.Values(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestOnboarding_SkipAndCancel(t *testing.T) {
+	o := NewOnboarding("", 3, false, "config.yaml", config.UIConfig{})
+	skipped, _ := sendOnboardingKeys(o, tea.KeyMsg{Type: tea.KeyEnter}, runeKey("j"), tea.KeyMsg{Type: tea.KeyEsc})
+	if skipped.Tutorial() || skipped.Values()["ui.theme"] != "auto" || skipped.Values()["ui.check_updates"] != true {
+		t.Fatalf("expected esc to keep the defaults without the tutorial, got %v", skipped.Values())
+	}
+	canceled, _ := sendOnboardingKeys(o, tea.KeyMsg{Type: tea.KeyCtrlC})
+	if canceled.Values() != nil {
+		t.Fatal("expected ctrl+c to save nothing")
+	}
+}
+
+func TestOnboarding_PreselectsCurrentSettings(t *testing.T) {
+	off := false
+	o := NewOnboarding("", 0, false, "config.yaml", config.UIConfig{Theme: "light", StartupCommands: []string{"filter ready"}, RunHooks: &off})
+	o.finished = true
+	values := o.Values()
+	want := map[string]any{
+		"ui.theme":            "light",
+		"ui.startup_commands": []string{"filter ready"},
+		"ui.check_updates":    true,
+		"ui.run_hooks":        false,
+	}
+	if !reflect.DeepEqual(values, want) {
+		t.Fatalf("got %v, want %v", values, want)
+	}
+	if o.questions[1].options[0].label != "Keep filter ready" {
+		t.Fatalf("expected custom startup commands offered to keep, got %q", o.questions[1].options[0].label)
+	}
+}
+
+func TestTriggerFullTutorial(t *testing.T) {
+	m := commandTestModel(t)
+	m.showHelp = true
+	m.TriggerFullTutorial()
+	if !m.showTutorial || m.showHelp || m.focused != focusTutorial {
+		t.Fatalf("expected the tutorial open with help closed, got tutorial=%v help=%v", m.showTutorial, m.showHelp)
+	}
+	newM, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 30})
+	if got := newM.(Model).tutorialModel.width; got != 90 {
+		t.Errorf("expected the tutorial resized with the window, got width %d", got)
+	}
+}
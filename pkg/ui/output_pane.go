@@ -0,0 +1,392 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/hooks"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// outputPaneMaxLines bounds the scrollback; older lines are dropped.
+	outputPaneMaxLines = 5000
+	// outputDrainDelay is how long output is still read after the command
+	// exits.
+	outputDrainDelay = 2 * time.Second
+)
+
+// outputPane streams the combined stdout/stderr of an external command while
+// it runs. SGR color sequences pass through, other terminal control sequences
+// are dropped, and the process can be killed from the pane.
+type outputPane struct {
+	id      int // Distinguishes messages of the current run from earlier ones
+	title   string
+	lines   []string
+	partial string // Current line, not yet terminated by "\n"
+	view    viewport.Model
+
+	running bool
+	killed  bool
+	exitErr error
+	cancel  context.CancelFunc
+	events  chan tea.Msg
+}
+
+// outputChunkMsg carries output read from the process of run id.
+type outputChunkMsg struct {
+	id   int
+	data []byte
+}
+
+// outputDoneMsg reports that the process of run id exited.
+type outputDoneMsg struct {
+	id  int
+	err error
+}
+
+// startOutput runs command through the shell in dir and streams its output
+// into the pane, replacing (and killing) any earlier run.
+func (m *Model) startOutput(title, command, dir string, env []string) tea.Cmd {
+	return m.streamOutput(title, 0, func(ctx context.Context) (*exec.Cmd, error) {
+		cmd := hooks.ShellCommand(ctx, command)
+		cmd.Dir = dir
+		// Output goes to a pipe; ask common tools to keep their colors.
+		cmd.Env = append(append(os.Environ(), env...), "FORCE_COLOR=1", "CLICOLOR_FORCE=1")
+		return cmd, nil
+	})
+}
+
+// startHookOutput runs an issue-action hook for issue, in its sandbox and
+// within its timeout, and streams its output into the pane.
+func (m *Model) startHookOutput(hook hooks.Hook, issue model.Issue) tea.Cmd {
+	ic := issueHookContext(issue)
+	return m.streamOutput("Hook "+hook.Name+" "+issue.ID, hook.RunTimeout(), func(ctx context.Context) (*exec.Cmd, error) {
+		return hooks.IssueHookCommand(ctx, hook, ic)
+	})
+}
+
+// streamOutput starts the command build returns and streams its output into
+// the pane, replacing (and killing) any earlier run. The command is killed
+// after timeout, unless that is 0.
+func (m *Model) streamOutput(title string, timeout time.Duration, build func(ctx context.Context) (*exec.Cmd, error)) tea.Cmd {
+	m.discardOutput()
+	m.outputRunID++
+
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(m.life.context(), timeout)
+	} else {
+		ctx, cancel = context.WithCancel(m.life.context())
+	}
+
+	m.commandOutput = outputPane{
+		id:     m.outputRunID,
+		title:  title,
+		view:   viewport.New(0, 0),
+		cancel: cancel,
+	}
+	m.commandOutput.resize(m.width, m.height)
+	m.showCommandOutput = true
+
+	cmd, err := build(ctx)
+	if err != nil {
+		cancel()
+		m.commandOutput.exitErr = err
+		m.commandOutput.setContent()
+		return nil
+	}
+	r, w, err := os.Pipe()
+	if err != nil {
+		cancel()
+		m.commandOutput.exitErr = err
+		m.commandOutput.setContent()
+		return nil
+	}
+	cmd.Stdout = w
+	cmd.Stderr = w
+	if err := cmd.Start(); err != nil {
+		cancel()
+		r.Close()
+		w.Close()
+		m.commandOutput.exitErr = err
+		m.commandOutput.setContent()
+		return nil
+	}
+	w.Close()
+
+	id := m.outputRunID
+	events := make(chan tea.Msg, 64)
+	readDone := make(chan struct{})
+	waitErr := make(chan error, 1)
+	go func() {
+		err := cmd.Wait()
+		// Background children can keep the pipe open after the command
+		// exits: give them a moment to finish writing, none if killed.
+		if ctx.Err() == nil {
+			select {
+			case <-readDone:
+			case <-time.After(outputDrainDelay):
+			}
+		}
+		r.Close()
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			err = fmt.Errorf("timeout after %v", timeout)
+		}
+		waitErr <- err
+	}()
+	go func() {
+		defer close(events)
+		defer cancel()
+		buf := make([]byte, 4096)
+		for {
+			n, err := r.Read(buf)
+			if n > 0 {
+				events <- outputChunkMsg{id: id, data: append([]byte(nil), buf[:n]...)}
+			}
+			if err != nil {
+				break
+			}
+		}
+		close(readDone)
+		events <- outputDoneMsg{id: id, err: <-waitErr}
+	}()
+
+	m.commandOutput.running = true
+	m.commandOutput.events = events
+	m.commandOutput.setContent()
+	return m.commandOutput.wait()
+}
+
+// resize fits the pane to a width x height screen, leaving room for the
+// border, header and hints.
+func (p *outputPane) resize(width, height int) {
+	p.view.Width = max(width-8, 20)
+	p.view.Height = max(height-9, 3)
+}
+
+// wait returns a command delivering the next message of the run.
+func (p *outputPane) wait() tea.Cmd {
+	events := p.events
+	if events == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		msg, ok := <-events
+		if !ok {
+			return nil
+		}
+		return msg
+	}
+}
+
+// killOutput kills the process of the current run. Its remaining output and
+// exit status still arrive.
+func (m *Model) killOutput() {
+	p := &m.commandOutput
+	if p.running && !p.killed {
+		p.killed = true
+		p.cancel()
+	}
+}
+
+// discardOutput kills the current run and stops listening to it; the
+// remaining messages are drained so the reader goroutine can exit.
+func (m *Model) discardOutput() {
+	m.killOutput()
+	p := &m.commandOutput
+	if events := p.events; events != nil {
+		go func() {
+			for range events {
+			}
+		}()
+	}
+	p.events = nil
+	p.running = false
+}
+
+// StopCommands kills a user command that is still streaming into the output
+// pane. Call it on the final model when the program exits.
+func (m *Model) StopCommands() {
+	m.discardOutput()
+}
+
+// handleOutputMsg applies a message from a run. Messages from earlier runs
+// are ignored.
+func (m Model) handleOutputMsg(msg tea.Msg) (Model, tea.Cmd) {
+	p := &m.commandOutput
+	switch msg := msg.(type) {
+	case outputChunkMsg:
+		if msg.id != p.id {
+			return m, nil
+		}
+		follow := p.view.AtBottom()
+		p.write(string(msg.data))
+		p.setContent()
+		if follow {
+			p.view.GotoBottom()
+		}
+		return m, p.wait()
+	case outputDoneMsg:
+		if msg.id != p.id {
+			return m, nil
+		}
+		p.running = false
+		p.exitErr = msg.err
+		p.events = nil
+		if p.partial != "" {
+			p.write("\n")
+		}
+		p.setContent()
+	}
+	return m, nil
+}
+
+// write appends raw process output, keeping only what a terminal would show
+// on each line after carriage returns (progress bars).
+func (p *outputPane) write(data string) {
+	data = p.partial + data
+	lines := strings.Split(data, "\n")
+	p.partial = lastCarriageSegment(lines[len(lines)-1])
+	for _, line := range lines[:len(lines)-1] {
+		p.lines = append(p.lines, sanitizeOutputLine(lastCarriageSegment(strings.TrimSuffix(line, "\r"))))
+	}
+	if extra := len(p.lines) - outputPaneMaxLines; extra > 0 {
+		p.lines = append([]string(nil), p.lines[extra:]...)
+	}
+}
+
+func lastCarriageSegment(line string) string {
+	if i := strings.LastIndexByte(line, '\r'); i >= 0 {
+		return line[i+1:]
+	}
+	return line
+}
+
+// sanitizeOutputLine keeps text and SGR (color) escape sequences. Cursor
+// movement, OSC and other control sequences could corrupt the layout.
+func sanitizeOutputLine(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == 0x1b && i+1 < len(s) && s[i+1] == '[':
+			// CSI: parameters and intermediates, then a final byte 0x40-0x7e.
+			j := i + 2
+			for j < len(s) && (s[j] < 0x40 || s[j] > 0x7e) {
+				j++
+			}
+			if j < len(s) && s[j] == 'm' {
+				sb.WriteString(s[i : j+1])
+			}
+			i = j
+		case c == 0x1b && i+1 < len(s) && s[i+1] == ']':
+			// OSC: terminated by BEL or ESC \.
+			j := i + 2
+			for j < len(s) && s[j] != 0x07 && !(s[j] == 0x1b && j+1 < len(s) && s[j+1] == '\\') {
+				j++
+			}
+			if j < len(s) && s[j] == 0x1b {
+				j++
+			}
+			i = j
+		case c == 0x1b:
+			i++ // Two-byte escape
+		case c == '\t':
+			sb.WriteString("    ")
+		case c < 0x20 || c == 0x7f:
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
+
+func (p *outputPane) setContent() {
+	lines := p.lines
+	if p.partial != "" {
+		lines = append(lines[:len(lines):len(lines)], sanitizeOutputLine(p.partial))
+	}
+	if len(lines) == 0 {
+		if p.running {
+			p.view.SetContent("(waiting for output)")
+		} else {
+			p.view.SetContent("(no output)")
+		}
+		return
+	}
+	// Reset colors per line so an unterminated sequence doesn't bleed.
+	p.view.SetContent(strings.Join(lines, "\x1b[0m\n") + "\x1b[0m")
+}
+
+// status describes the state of the run for the pane header.
+func (p *outputPane) status() string {
+	switch {
+	case p.running:
+		return "running"
+	case p.killed:
+		return "killed"
+	case p.exitErr != nil:
+		return p.exitErr.Error()
+	default:
+		return "exited 0"
+	}
+}
+
+// handleCommandOutputKeys scrolls the pane, kills the process (x) or closes
+// the pane; closing also kills a process that is still running.
+func (m Model) handleCommandOutputKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q":
+		m.discardOutput()
+		m.showCommandOutput = false
+		return m, nil
+	case "x":
+		m.killOutput()
+		return m, nil
+	case "g", "home":
+		m.commandOutput.view.GotoTop()
+		return m, nil
+	case "G", "end":
+		m.commandOutput.view.GotoBottom()
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.commandOutput.view, cmd = m.commandOutput.view.Update(msg)
+	return m, cmd
+}
+
+func (m Model) renderCommandOutput() string {
+	t := m.theme
+	p := &m.commandOutput
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(0, 1)
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	statusStyle := mutedStyle
+	if !p.running && (p.killed || p.exitErr != nil) {
+		statusStyle = t.Renderer.NewStyle().Foreground(t.Blocked)
+	}
+
+	hints := "j/k: scroll • g/G: top/bottom • esc: close"
+	if p.running {
+		hints = "j/k: scroll • g/G: top/bottom • x: kill • esc: kill & close"
+	}
+	content := titleStyle.Render(p.title) + "  " + statusStyle.Render("["+p.status()+"]") + "\n" +
+		p.view.View() + "\n" +
+		mutedStyle.Render(fmt.Sprintf("%3.0f%% • %s", p.view.ScrollPercent()*100, hints))
+	return lipgloss.Place(m.width, m.height-1, lipgloss.Center, lipgloss.Center, boxStyle.Render(content))
+}
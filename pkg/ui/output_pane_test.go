@@ -0,0 +1,106 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/hooks"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestSanitizeOutputLine(t *testing.T) {
+	in := "\x1b[31mred\x1b[0m \x1b[2Jclear\x1b]0;title\x07 bell\x07 tab\tend"
+	want := "\x1b[31mred\x1b[0m clear bell tab    end"
+	if got := sanitizeOutputLine(in); got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestOutputPane_WriteKeepsTerminalView(t *testing.T) {
+	var p outputPane
+	p.write("progress 10%\rprogress 100%\r\nsecond")
+	p.write(" line\nthird")
+	if got := strings.Join(p.lines, "|"); got != "progress 100%|second line" {
+		t.Fatalf("lines = %q", got)
+	}
+	if p.partial != "third" {
+		t.Fatalf("partial = %q", p.partial)
+	}
+
+	p = outputPane{}
+	p.write(strings.Repeat("x\n", outputPaneMaxLines+10))
+	if len(p.lines) != outputPaneMaxLines {
+		t.Fatalf("expected scrollback capped at %d, got %d", outputPaneMaxLines, len(p.lines))
+	}
+}
+
+// pumpOutput feeds run messages into m until cond holds or the run ends.
+func pumpOutput(t *testing.T, m Model, cmd tea.Cmd, cond func(Model) bool) (Model, tea.Cmd) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for cmd != nil && !cond(m) {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for output")
+		}
+		msg := cmd()
+		if msg == nil {
+			return m, nil
+		}
+		var newM tea.Model
+		newM, cmd = m.Update(msg)
+		m = newM.(Model)
+	}
+	return m, cmd
+}
+
+func TestOutputPane_StreamsAndKills(t *testing.T) {
+	m := commandTestModel(t)
+	cmd := m.startOutput("slow A-1", "printf 'first\\n'; sleep 10; echo never", t.TempDir(), nil)
+	if m.CurrentContext() != ContextCommandOutput {
+		t.Fatalf("expected output pane, got %s", m.CurrentContext())
+	}
+
+	m, cmd = pumpOutput(t, m, cmd, func(m Model) bool { return len(m.commandOutput.lines) > 0 })
+	if !m.commandOutput.running || !strings.Contains(m.View(), "first") || !strings.Contains(m.View(), "[running]") {
+		t.Fatal("expected first line while the command runs")
+	}
+
+	newM, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	m = newM.(Model)
+	m, _ = pumpOutput(t, m, cmd, func(m Model) bool { return !m.commandOutput.running })
+	if m.commandOutput.running || !strings.Contains(m.View(), "[killed]") || strings.Contains(m.View(), "never") {
+		t.Fatalf("expected the run killed, status %q", m.commandOutput.status())
+	}
+
+	newM, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if newM.(Model).showCommandOutput {
+		t.Fatal("expected esc to close the pane")
+	}
+}
+
+func TestOutputPane_ReportsExitStatus(t *testing.T) {
+	m := commandTestModel(t)
+	cmd := m.startOutput("fail", "echo oops; exit 3", t.TempDir(), []string{"BV_ISSUE_ID=A-1"})
+	m, _ = pumpOutput(t, m, cmd, func(m Model) bool { return !m.commandOutput.running })
+	if got := m.commandOutput.status(); got != "exit status 3" {
+		t.Fatalf("status = %q", got)
+	}
+	if strings.Join(m.commandOutput.lines, "\n") != "oops" {
+		t.Fatalf("lines = %q", m.commandOutput.lines)
+	}
+}
+
+func TestOutputPane_StopsHookAtItsTimeout(t *testing.T) {
+	m := commandTestModel(t)
+	hook := hooks.Hook{Name: "slow", Command: "echo $BV_ISSUE_ID; sleep 10", Timeout: 200 * time.Millisecond}
+	cmd := m.startHookOutput(hook, model.Issue{ID: "A-1"})
+	m, _ = pumpOutput(t, m, cmd, func(m Model) bool { return !m.commandOutput.running })
+	if got := m.commandOutput.status(); got != "timeout after 200ms" {
+		t.Fatalf("status = %q", got)
+	}
+	if strings.Join(m.commandOutput.lines, "\n") != "A-1" {
+		t.Fatalf("lines = %q", m.commandOutput.lines)
+	}
+}
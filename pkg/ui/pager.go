@@ -0,0 +1,399 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/export"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Pager
+//
+// "|" shows long text in a pager: the selected issue as markdown or, in the
+// history view, the selected commit's diff; ":page export" shows the export
+// report. ui.pager names the program, which is given a temporary file and
+// runs in the terminal while the TUI is suspended, like the editor. Without
+// one, $PAGER is used, and without that bv's own pager, which numbers lines
+// and searches with "/". Read-only sessions always use bv's own, as they run
+// nothing on the host.
+
+// pagerInternal is the ui.pager value selecting bv's own pager.
+const pagerInternal = "internal"
+
+// pageSources lists the arguments of the page command.
+var pageSources = []string{"issue", "diff", "export"}
+
+// pagerTextMsg carries text to page that was produced in the background.
+type pagerTextMsg struct {
+	title, text, ext string
+	err              error
+}
+
+// pagerDoneMsg reports that the external pager showing path exited.
+type pagerDoneMsg struct {
+	path string
+	err  error
+}
+
+// ValidatePager checks a ui.pager value.
+func ValidatePager(command string) error {
+	if command == "" || command == pagerInternal {
+		return nil
+	}
+	_, err := pagerArgs(command)
+	return err
+}
+
+// pagerArgs splits a pager command line, refusing shells: the file to show
+// would be run as a script.
+func pagerArgs(command string) ([]string, error) {
+	args, err := parseCommandLine(command)
+	if err != nil {
+		return nil, err
+	}
+	switch base, kind := classifyEditorCommand(args); kind {
+	case editorCommandEmpty:
+		return nil, errors.New("empty command")
+	case editorCommandForbidden:
+		return nil, fmt.Errorf("refusing to run %s as pager (shell/interpreter)", base)
+	}
+	return args, nil
+}
+
+// SetPager sets the program long text is paged with: a command line, or
+// "internal" for bv's own pager. Empty uses $PAGER. Check it with
+// ValidatePager first.
+func (m *Model) SetPager(command string) {
+	m.pagerCommand = command
+}
+
+// openPager pages text, named by title, in the configured pager. ext is the
+// extension of the temporary file, which pagers such as bat highlight by.
+func (m Model) openPager(title, text, ext string) (Model, tea.Cmd) {
+	command := m.pagerCommand
+	if command == "" {
+		command = os.Getenv("PAGER")
+	}
+	if m.readOnly || command == "" || command == pagerInternal {
+		m.openTextPager(title, text)
+		return m, nil
+	}
+	args, err := pagerArgs(command)
+	if err != nil {
+		m.reportError(newError(errConfig, "open pager", fmt.Errorf("invalid pager %q: %w", command, err)).
+			withHint("set ui.pager or $PAGER to a pager, or to internal"))
+		return m, nil
+	}
+
+	f, err := os.CreateTemp("", "bv-page-*"+ext)
+	if err == nil {
+		_, err = f.WriteString(text)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if err != nil {
+		m.reportError(newError(errTerminal, "open pager", err))
+		return m, nil
+	}
+	path := f.Name()
+	c := exec.Command(args[0], append(args[1:], path)...)
+	return m, tea.ExecProcess(c, func(err error) tea.Msg {
+		return pagerDoneMsg{path: path, err: err}
+	})
+}
+
+// pagerDone cleans up after the external pager.
+func (m *Model) pagerDone(msg pagerDoneMsg) {
+	_ = os.Remove(msg.path)
+	if msg.err != nil {
+		m.reportError(newError(errTerminal, "open pager", msg.err))
+	}
+}
+
+// pageSelection pages what "|" applies to: the selected commit's diff in
+// the history view, the selected issue elsewhere.
+func (m Model) pageSelection() (Model, tea.Cmd) {
+	if m.isHistoryView {
+		return m.pageCommitDiff()
+	}
+	return m.pageIssue()
+}
+
+// pageIssue pages the selected issue as the markdown the detail view shows.
+func (m Model) pageIssue() (Model, tea.Cmd) {
+	target := m.actionTarget()
+	if target == nil {
+		m.statusMsg = i18n.T("No issue selected")
+		m.statusIsError = true
+		return m, nil
+	}
+	var sb strings.Builder
+	writeIssueSummaryMD(&sb, *target)
+	writeIssueBodyMD(&sb, *target, m.blockerMap(), clockNow(m.now))
+	return m.openPager(target.ID, sb.String(), ".md")
+}
+
+// pageCommitDiff pages the stat and patch of the commit selected in the
+// history view. git runs in the background.
+func (m Model) pageCommitDiff() (Model, tea.Cmd) {
+	var sha, short string
+	if m.historyView.IsGitMode() {
+		if c := m.historyView.SelectedGitCommit(); c != nil {
+			sha, short = c.SHA, c.ShortSHA
+		}
+	} else if c := m.historyView.SelectedCommit(); c != nil {
+		sha, short = c.SHA, c.ShortSHA
+	}
+	if sha == "" {
+		m.statusMsg = i18n.T("No commit selected")
+		m.statusIsError = true
+		return m, nil
+	}
+	dir := m.workDir
+	return m, func() tea.Msg {
+		cmd := exec.Command("git", "show", "--stat", "--patch", "--no-color", sha, "--")
+		cmd.Dir = dir
+		out, err := cmd.Output()
+		if err != nil {
+			return pagerTextMsg{err: newError(errData, "show commit "+short, err)}
+		}
+		return pagerTextMsg{title: short, text: string(out), ext: ".diff"}
+	}
+}
+
+// pageExport pages the markdown report the export writes.
+func (m Model) pageExport() (Model, tea.Cmd) {
+	text, err := export.GenerateReport(m.issues)
+	if err != nil {
+		m.reportError(newError(errData, "generate export", err))
+		return m, nil
+	}
+	return m.openPager("Beads Export", text, ".md")
+}
+
+// pageCommand runs "page [issue|diff|export]".
+func (m Model) pageCommand(args []string) (Model, tea.Cmd, error) {
+	source := "issue"
+	if len(args) == 1 {
+		source = args[0]
+	}
+	var cmd tea.Cmd
+	switch source {
+	case "issue":
+		m, cmd = m.pageIssue()
+	case "diff":
+		if !m.isHistoryView {
+			return m, nil, fmt.Errorf("diff needs a commit selected in the history view")
+		}
+		m, cmd = m.pageCommitDiff()
+	case "export":
+		m, cmd = m.pageExport()
+	}
+	return m, cmd, nil
+}
+
+func validatePageArgs(args []string) error {
+	if len(args) > 1 {
+		return fmt.Errorf("expected at most one source")
+	}
+	if len(args) == 1 && !slices.Contains(pageSources, args[0]) {
+		return fmt.Errorf("unknown source %q", args[0])
+	}
+	return nil
+}
+
+// textPager is bv's own pager: numbered lines, wrapped to the width, and
+// case-insensitive search.
+type textPager struct {
+	title string
+	lines []string
+	view  viewport.Model
+
+	gutterStyle lipgloss.Style
+	matchStyle  lipgloss.Style
+
+	searching bool   // Typing a query
+	query     string // Last query confirmed
+	input     string // Query being typed
+	matchRows []int  // Rows of the lines that match query
+	match     int    // Index into matchRows of the current match
+}
+
+// openTextPager shows text in the internal pager.
+func (m *Model) openTextPager(title, text string) {
+	t := m.theme
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = sanitizeOutputLine(line)
+	}
+	m.pager = textPager{
+		title:       title,
+		lines:       lines,
+		view:        viewport.New(0, 0),
+		gutterStyle: t.Renderer.NewStyle().Foreground(t.Subtext),
+		matchStyle:  t.Renderer.NewStyle().Background(t.Highlight).Foreground(t.Primary).Bold(true),
+	}
+	m.pager.resize(m.width, m.height)
+	m.showPager = true
+}
+
+// resize fits the pager to a width x height screen, like the output pane,
+// and rewraps the text.
+func (p *textPager) resize(width, height int) {
+	p.view.Width = max(width-8, 20)
+	p.view.Height = max(height-9, 3)
+	p.setContent()
+}
+
+// setContent numbers and wraps the lines, highlighting matches of the query
+// and noting the row each matching line starts at.
+func (p *textPager) setContent() {
+	digits := len(fmt.Sprint(len(p.lines)))
+	width := max(p.view.Width-digits-1, 10)
+	var re *regexp.Regexp
+	if p.query != "" {
+		re = regexp.MustCompile("(?i)" + regexp.QuoteMeta(p.query))
+	}
+	p.matchRows = p.matchRows[:0]
+	var rows []string
+	for i, line := range p.lines {
+		if re != nil && re.MatchString(ansi.Strip(line)) {
+			p.matchRows = append(p.matchRows, len(rows))
+			line = re.ReplaceAllStringFunc(line, func(s string) string { return p.matchStyle.Render(s) })
+		}
+		for j, part := range strings.Split(ansi.Hardwrap(line, width, true), "\n") {
+			number := ""
+			if j == 0 {
+				number = fmt.Sprint(i + 1)
+			}
+			rows = append(rows, p.gutterStyle.Render(fmt.Sprintf("%*s ", digits, number))+part+"\x1b[0m")
+		}
+	}
+	p.view.SetContent(strings.Join(rows, "\n"))
+	if p.match >= len(p.matchRows) {
+		p.match = 0
+	}
+}
+
+// search runs query and scrolls to its first match at or below the top of
+// the view.
+func (p *textPager) search(query string) {
+	p.query = query
+	p.match = 0
+	p.setContent()
+	for i, row := range p.matchRows {
+		if row >= p.view.YOffset {
+			p.match = i
+			break
+		}
+	}
+	p.showMatch()
+}
+
+// step moves to the next (delta 1) or previous (-1) match, wrapping around.
+func (p *textPager) step(delta int) {
+	if len(p.matchRows) == 0 {
+		return
+	}
+	p.match = (p.match + delta + len(p.matchRows)) % len(p.matchRows)
+	p.showMatch()
+}
+
+func (p *textPager) showMatch() {
+	if len(p.matchRows) > 0 {
+		p.view.SetYOffset(p.matchRows[p.match])
+	}
+}
+
+// status describes the text and the search for the pager header.
+func (p *textPager) status() string {
+	lines := fmt.Sprintf("%d lines", len(p.lines))
+	if p.query == "" {
+		return lines
+	}
+	if len(p.matchRows) == 0 {
+		return fmt.Sprintf("%s • no match for %q", lines, p.query)
+	}
+	return fmt.Sprintf("%s • match %d/%d", lines, p.match+1, len(p.matchRows))
+}
+
+// handlePagerKeys scrolls the internal pager, searches it or closes it.
+func (m Model) handlePagerKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	p := &m.pager
+	if p.searching {
+		switch msg.Type {
+		case tea.KeyEnter:
+			p.searching = false
+			p.search(p.input)
+		case tea.KeyEsc:
+			p.searching = false
+		case tea.KeyBackspace:
+			if r := []rune(p.input); len(r) > 0 {
+				p.input = string(r[:len(r)-1])
+			}
+		case tea.KeySpace:
+			p.input += " "
+		case tea.KeyRunes:
+			p.input += string(msg.Runes)
+		}
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "esc", "q":
+		m.showPager = false
+		m.pager = textPager{}
+		return m, nil
+	case "/":
+		p.searching = true
+		p.input = ""
+		return m, nil
+	case "n":
+		p.step(1)
+		return m, nil
+	case "N":
+		p.step(-1)
+		return m, nil
+	case "g", "home":
+		p.view.GotoTop()
+		return m, nil
+	case "G", "end":
+		p.view.GotoBottom()
+		return m, nil
+	}
+	var cmd tea.Cmd
+	p.view, cmd = p.view.Update(msg)
+	return m, cmd
+}
+
+func (m Model) renderPager() string {
+	t := m.theme
+	p := &m.pager
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(0, 1)
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+
+	footer := mutedStyle.Render(fmt.Sprintf("%3.0f%% • j/k: scroll • g/G: top/bottom • /: search • n/N: next/prev • esc: close", p.view.ScrollPercent()*100))
+	if p.searching {
+		footer = titleStyle.Render("/") + p.input + "█"
+	}
+	content := titleStyle.Render(p.title) + "  " + mutedStyle.Render("["+p.status()+"]") + "\n" +
+		p.view.View() + "\n" + footer
+	return lipgloss.Place(m.width, m.height-1, lipgloss.Center, lipgloss.Center, boxStyle.Render(content))
+}
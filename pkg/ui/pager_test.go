@@ -0,0 +1,136 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestValidatePager(t *testing.T) {
+	for _, command := range []string{"", "internal", "less -R", "bat --paging=always"} {
+		if err := ValidatePager(command); err != nil {
+			t.Errorf("%q: unexpected error %v", command, err)
+		}
+	}
+	for command, want := range map[string]string{
+		"sh -c":    "refusing to run sh",
+		`less "-R`: "unterminated",
+	} {
+		if err := ValidatePager(command); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%q: expected an error mentioning %q, got %v", command, want, err)
+		}
+	}
+	if err := ValidateCommand("page bogus", nil); err == nil {
+		t.Error("expected an unknown page source to be rejected")
+	}
+}
+
+func pagerTestModel(t *testing.T) Model {
+	t.Helper()
+	t.Setenv("PAGER", "")
+	return commandTestModel(t, func(issues []model.Issue) []model.Issue {
+		issues[1].Description = "First step\nthen the Second step\n\nand a third STEP"
+		return issues
+	})
+}
+
+func TestPager_InternalSearch(t *testing.T) {
+	m := pagerTestModel(t)
+	for i, id := range listIDs(m) {
+		if id == "A-2" {
+			m.list.Select(i)
+		}
+	}
+	newM, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("|")})
+	m = newM.(Model)
+	if !m.showPager || m.CurrentContext() != ContextPager {
+		t.Fatalf("expected the internal pager, got %s", m.CurrentContext())
+	}
+	view := ansi.Strip(m.View())
+	if !strings.Contains(view, m.pager.title) || !strings.Contains(view, "1 # ") {
+		t.Errorf("expected the title and numbered lines, got:\n%s", view)
+	}
+
+	for _, key := range []tea.KeyMsg{
+		{Type: tea.KeyRunes, Runes: []rune("/")},
+		{Type: tea.KeyRunes, Runes: []rune("step")},
+		{Type: tea.KeyEnter},
+	} {
+		newM, _ = m.Update(key)
+		m = newM.(Model)
+	}
+	if len(m.pager.matchRows) != 3 || m.pager.match != 0 {
+		t.Fatalf("expected 3 case-insensitive matches, got %v", m.pager.matchRows)
+	}
+	if !strings.Contains(ansi.Strip(m.View()), "match 1/3") {
+		t.Error("expected the match count in the header")
+	}
+	m, _ = m.handlePagerKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("N")})
+	if m.pager.match != 2 {
+		t.Errorf("expected N to wrap to the last match, got %d", m.pager.match)
+	}
+
+	newM, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = newM.(Model)
+	if m.showPager {
+		t.Error("expected esc to close the pager")
+	}
+}
+
+func TestPager_WrapsUnderOneNumber(t *testing.T) {
+	var m Model
+	m.theme = commandTestModel(t).theme
+	m.width, m.height = 40, 20
+	m.openTextPager("long", strings.Repeat("word ", 30)+"\nshort")
+	rows := strings.Split(ansi.Strip(m.pager.view.View()), "\n")
+	if !strings.HasPrefix(rows[0], "1 ") || !strings.HasPrefix(rows[1], "  ") {
+		t.Fatalf("expected continuation rows without a number, got %q", rows[:2])
+	}
+	for i, row := range rows {
+		if strings.HasPrefix(row, "2 short") {
+			return
+		} else if i > 5 {
+			break
+		}
+	}
+	t.Errorf("expected the second line numbered 2, got %q", rows)
+}
+
+func TestPager_External(t *testing.T) {
+	tmp := t.TempDir()
+	t.Setenv("TMPDIR", tmp)
+	m := pagerTestModel(t)
+	m.SetPager("less -R")
+
+	m, cmd, err := m.ExecCommand("page export")
+	if err != nil || cmd == nil || m.showPager {
+		t.Fatalf("expected the external pager to run, got cmd=%v err=%v", cmd != nil, err)
+	}
+	files, _ := filepath.Glob(filepath.Join(tmp, "bv-page-*.md"))
+	if len(files) != 1 {
+		t.Fatalf("expected one page file, got %v", files)
+	}
+	data, _ := os.ReadFile(files[0])
+	if !strings.HasPrefix(string(data), "# Beads Export") || !strings.Contains(string(data), "New open") {
+		t.Errorf("expected the export report, got %q", data)
+	}
+	m.pagerDone(pagerDoneMsg{path: files[0]})
+	if _, err := os.Stat(files[0]); !os.IsNotExist(err) {
+		t.Error("expected the page file to be removed")
+	}
+
+	m.readOnly = true
+	m, cmd, _ = m.ExecCommand("page")
+	if cmd != nil || !m.showPager {
+		t.Error("expected read-only sessions to use the internal pager")
+	}
+	if _, _, err := m.ExecCommand("page diff"); err == nil {
+		t.Error("expected page diff to need the history view")
+	}
+}
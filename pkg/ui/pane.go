@@ -0,0 +1,284 @@
+package ui
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/beadsview"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Embeddable panes
+//
+// A Pane is a read-only beads browser that other Bubble Tea programs can
+// embed: the bv issue list, issue detail and dependency graph views without
+// bv's header, footer, overlays or file watching. It follows the bubbles
+// component conventions: the host forwards messages to Update, places View
+// in its layout, sizes it with SetSize and routes keys to it with Focus and
+// Blur.
+
+// PaneView is what a Pane shows.
+type PaneView int
+
+const (
+	PaneList   PaneView = iota // Issue list; enter opens the detail view
+	PaneDetail                 // Detail of the selected issue
+	PaneGraph                  // Dependency graph around the selected issue
+)
+
+// PaneOptions configures a Pane.
+type PaneOptions struct {
+	Issues []model.Issue
+
+	// View is the initial view. From the list and graph, enter opens the
+	// detail view and esc returns.
+	View PaneView
+
+	// Filter and Sort select the listed issues as in bv's ":filter" and
+	// ":sort" commands (see beadsview.FilterIssues). Filter defaults to "all".
+	Filter string
+	Sort   beadsview.SortMode
+
+	Width, Height int
+
+	// Theme defaults to DefaultTheme(lipgloss.DefaultRenderer()). Hosts
+	// rendering for another output, such as an SSH session, pass a theme
+	// built on that session's renderer.
+	Theme *Theme
+
+	// Now is the clock for relative times; nil means time.Now.
+	Now func() time.Time
+}
+
+// IssueSelectedMsg is sent when the selected issue of a Pane changes.
+type IssueSelectedMsg struct {
+	Issue model.Issue
+}
+
+// Pane is an embeddable issue list, detail and graph view.
+type Pane struct {
+	opts     PaneOptions
+	theme    Theme
+	view     PaneView
+	back     PaneView // View that esc returns to from the detail view
+	focused  bool
+	issueMap map[string]*model.Issue
+
+	list     list.Model
+	viewport viewport.Model
+	renderer *MarkdownRenderer
+	graph    GraphModel
+}
+
+// NewPane returns a focused Pane.
+func NewPane(opts PaneOptions) Pane {
+	if opts.Filter == "" {
+		opts.Filter = "all"
+	}
+	theme := DefaultTheme(lipgloss.DefaultRenderer())
+	if opts.Theme != nil {
+		theme = *opts.Theme
+	}
+	delegate := IssueDelegate{Theme: theme, rowCache: newRowRenderCache(), Now: opts.Now}
+	p := Pane{
+		opts:     opts,
+		theme:    theme,
+		view:     opts.View,
+		back:     opts.View,
+		focused:  true,
+		list:     newIssueList(nil, delegate, &incrementalFuzzyFilter{}, theme, opts.Width, opts.Height),
+		viewport: viewport.New(opts.Width, opts.Height),
+		renderer: NewMarkdownRendererWithTheme(opts.Width, theme),
+	}
+	if p.back == PaneDetail {
+		p.back = PaneList
+	}
+	p.SetIssues(opts.Issues)
+	return p
+}
+
+// SetIssues replaces the issues, keeping the selection when the selected
+// issue is still listed. The dependency graph is analyzed before it returns.
+func (p *Pane) SetIssues(issues []model.Issue) {
+	selected := p.SelectedIssue()
+	p.opts.Issues = issues
+	p.issueMap = make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		p.issueMap[issues[i].ID] = &issues[i]
+	}
+	stats := analysis.NewAnalyzer(issues).Analyze()
+
+	shown := beadsview.FilterIssues(issues, p.opts.Filter, p.opts.Sort)
+	items := make([]list.Item, len(shown))
+	for i, issue := range shown {
+		item := IssueItem{
+			Issue:      issue,
+			GraphScore: stats.GetPageRankScore(issue.ID),
+			Impact:     stats.GetCriticalPathScore(issue.ID),
+			RepoPrefix: ExtractRepoPrefix(issue.ID),
+		}
+		item.cacheFilterValue()
+		items[i] = item
+	}
+	p.list.SetItems(items)
+	ins := stats.GenerateInsights(len(shown))
+	p.graph = NewGraphModel(shown, &ins, p.theme)
+	if selected == nil || !p.SelectIssue(selected.ID) {
+		p.list.Select(0)
+		if first := p.SelectedIssue(); first != nil {
+			p.graph.SelectByID(first.ID)
+		}
+		p.refreshDetail()
+	}
+}
+
+// SetSize sets the size the pane renders at.
+func (p *Pane) SetSize(width, height int) {
+	p.opts.Width, p.opts.Height = width, height
+	p.list.SetSize(width, height)
+	p.viewport.Width, p.viewport.Height = width, height
+	p.renderer.SetWidthWithTheme(width, p.theme)
+	p.refreshDetail()
+}
+
+// SetView switches the view.
+func (p *Pane) SetView(v PaneView) {
+	if v != PaneDetail {
+		p.back = v
+	}
+	p.view = v
+}
+
+// CurrentView reports the current view.
+func (p Pane) CurrentView() PaneView {
+	return p.view
+}
+
+// Focus makes the pane handle key presses.
+func (p *Pane) Focus() { p.focused = true }
+
+// Blur makes the pane ignore key presses.
+func (p *Pane) Blur() { p.focused = false }
+
+// Focused reports whether the pane handles key presses.
+func (p Pane) Focused() bool { return p.focused }
+
+// SelectedIssue returns the selected issue, or nil when nothing is listed.
+func (p Pane) SelectedIssue() *model.Issue {
+	if item, ok := p.list.SelectedItem().(IssueItem); ok {
+		return p.issueMap[item.Issue.ID]
+	}
+	return nil
+}
+
+// SelectIssue selects the listed issue with the given ID.
+func (p *Pane) SelectIssue(id string) bool {
+	for i, it := range p.list.Items() {
+		if item, ok := it.(IssueItem); ok && item.Issue.ID == id {
+			p.list.Select(i)
+			p.graph.SelectByID(id)
+			p.refreshDetail()
+			return true
+		}
+	}
+	return false
+}
+
+// Init implements tea.Model; a Pane starts no commands.
+func (p Pane) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles key presses while focused. It returns an IssueSelectedMsg
+// command when the selection changes.
+func (p Pane) Update(msg tea.Msg) (Pane, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok || !p.focused {
+		return p, nil
+	}
+	before := p.SelectedIssue()
+
+	var cmd tea.Cmd
+	switch p.view {
+	case PaneList:
+		if key.String() == "enter" && p.list.FilterState() != list.Filtering {
+			p.SetView(PaneDetail)
+			return p, nil
+		}
+		p.list, cmd = p.list.Update(msg)
+	case PaneGraph:
+		switch key.String() {
+		case "j", "down":
+			p.graph.MoveDown()
+		case "k", "up":
+			p.graph.MoveUp()
+		case "pgdown", "ctrl+d":
+			p.graph.PageDown()
+		case "pgup", "ctrl+u":
+			p.graph.PageUp()
+		case "enter":
+			p.SetView(PaneDetail)
+			return p, nil
+		}
+		if issue := p.graph.SelectedIssue(); issue != nil {
+			p.SelectIssue(issue.ID)
+		}
+	case PaneDetail:
+		if key.String() == "esc" {
+			p.view = p.back
+			return p, nil
+		}
+		p.viewport, cmd = p.viewport.Update(msg)
+		return p, cmd
+	}
+
+	after := p.SelectedIssue()
+	if after != nil && (before == nil || before.ID != after.ID) {
+		p.graph.SelectByID(after.ID)
+		p.refreshDetail()
+		selected := *after
+		cmd = tea.Batch(cmd, func() tea.Msg { return IssueSelectedMsg{Issue: selected} })
+	}
+	return p, cmd
+}
+
+// View renders the current view at the pane's size.
+func (p Pane) View() string {
+	switch p.view {
+	case PaneDetail:
+		return p.viewport.View()
+	case PaneGraph:
+		return p.graph.View(p.opts.Width, p.opts.Height)
+	default:
+		if len(p.list.Items()) == 0 {
+			return p.theme.Renderer.NewStyle().Width(p.opts.Width).Height(p.opts.Height).
+				Foreground(p.theme.Secondary).Render(i18n.T("No issues"))
+		}
+		return p.list.View()
+	}
+}
+
+// refreshDetail renders the selected issue into the detail viewport.
+func (p *Pane) refreshDetail() {
+	issue := p.SelectedIssue()
+	if issue == nil {
+		p.viewport.SetContent("No issue selected")
+		return
+	}
+	var sb strings.Builder
+	writeIssueSummaryMD(&sb, *issue)
+	writeIssueBodyMD(&sb, *issue, p.issueMap, clockNow(p.opts.Now))
+	rendered, err := p.renderer.Render(sb.String())
+	if err != nil {
+		rendered = sb.String()
+	}
+	p.viewport.SetContent(rendered)
+	p.viewport.GotoTop()
+}
@@ -0,0 +1,121 @@
+package ui_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/beadsview"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/testutil"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/ui"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// paneHost embeds a Pane the way a host application would, recording the
+// selections it reports.
+type paneHost struct {
+	pane     ui.Pane
+	selected []string
+}
+
+func (h paneHost) Init() tea.Cmd { return h.pane.Init() }
+
+func (h paneHost) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		h.pane.SetSize(msg.Width, msg.Height)
+		return h, nil
+	case ui.IssueSelectedMsg:
+		h.selected = append(h.selected, msg.Issue.ID)
+		return h, nil
+	}
+	var cmd tea.Cmd
+	h.pane, cmd = h.pane.Update(msg)
+	// Deliver the pane's messages synchronously; the harness drops commands.
+	for _, m := range runCmd(cmd) {
+		next, _ := h.Update(m)
+		h = next.(paneHost)
+	}
+	return h, nil
+}
+
+func (h paneHost) View() string { return h.pane.View() }
+
+func runCmd(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	switch msg := cmd().(type) {
+	case tea.BatchMsg:
+		var out []tea.Msg
+		for _, c := range msg {
+			out = append(out, runCmd(c)...)
+		}
+		return out
+	case nil:
+		return nil
+	default:
+		return []tea.Msg{msg}
+	}
+}
+
+func newPaneHost(opts ui.PaneOptions) paneHost {
+	issues := renderFixtureModel().(ui.Model).FilteredIssues()
+	opts.Issues = issues
+	opts.Now = func() time.Time { return renderFixtureTime }
+	return paneHost{pane: ui.NewPane(opts)}
+}
+
+func TestPane_ListDetailAndGraph(t *testing.T) {
+	h := testutil.NewHarness(t, newPaneHost(ui.PaneOptions{Filter: "open", Sort: beadsview.SortPriority}), testutil.TermSize{Width: 80, Height: 20})
+	h.AssertContains("Ship the importer", "Crash on empty file")
+	if strings.Contains(h.View(), "Document the CLI") {
+		t.Error("closed issue listed despite the open filter")
+	}
+
+	h.Keys("j")
+	host := h.Model().(paneHost)
+	if len(host.selected) != 1 || host.pane.SelectedIssue() == nil || host.selected[0] != host.pane.SelectedIssue().ID {
+		t.Fatalf("expected one selection message for the new selection, got %v", host.selected)
+	}
+
+	h.Keys("enter")
+	if h.Model().(paneHost).pane.CurrentView() != ui.PaneDetail {
+		t.Fatal("expected enter to open the detail view")
+	}
+	h.AssertContains(h.Model().(paneHost).pane.SelectedIssue().Title)
+	h.Keys("esc")
+	if h.Model().(paneHost).pane.CurrentView() != ui.PaneList {
+		t.Fatal("expected esc to return to the list")
+	}
+
+	host = h.Model().(paneHost)
+	host.pane.SetView(ui.PaneGraph)
+	host.pane.Blur()
+	h = testutil.NewHarness(t, host, testutil.TermSize{Width: 100, Height: 30})
+	before := h.View()
+	h.Keys("j")
+	if h.View() != before {
+		t.Error("a blurred pane should ignore keys")
+	}
+}
+
+func TestPane_SetIssuesKeepsSelection(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "a", Title: "First", Status: model.StatusOpen, Priority: 1},
+		{ID: "b", Title: "Second", Status: model.StatusOpen, Priority: 2},
+	}
+	p := ui.NewPane(ui.PaneOptions{Issues: issues, Width: 60, Height: 10})
+	if !p.SelectIssue("b") {
+		t.Fatal("expected b to be selectable")
+	}
+	p.SetIssues(append([]model.Issue{{ID: "c", Title: "New", Status: model.StatusOpen, Priority: 0}}, issues...))
+	if got := p.SelectedIssue(); got == nil || got.ID != "b" {
+		t.Fatalf("expected b to stay selected, got %v", got)
+	}
+	p.SetIssues(nil)
+	if p.SelectedIssue() != nil || !strings.Contains(p.View(), "No issues") {
+		t.Fatalf("expected an empty pane, got %q", p.View())
+	}
+}
@@ -0,0 +1,196 @@
+package ui
+
+import (
+	"strings"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Presence
+//
+// bv serve-ssh runs every connection in one process, so the sessions can
+// see each other: each publishes where it is, and the list and graph views
+// mark the issues other participants have selected with their color. The
+// presence status bar segment lists who is connected, which is enough to
+// run a triage meeting with everyone in the viewer.
+
+// presenceColors are handed to participants in the order they join.
+var presenceColors = []lipgloss.Color{"#FF79C6", "#8BE9FD", "#F1FA8C", "#FFB86C", "#50FA7B", "#BD93F9"}
+
+// Presence is the room shared by the sessions of one server.
+type Presence struct {
+	mu      sync.Mutex
+	members []*PresenceSession // In join order
+	joined  int
+}
+
+// NewPresence returns an empty room.
+func NewPresence() *Presence {
+	return &Presence{}
+}
+
+// Participant is what the others see of a session.
+type Participant struct {
+	Name    string
+	Color   lipgloss.Color
+	View    string // View the participant is in, as in the breadcrumbs
+	IssueID string // Selected issue; "" if none
+}
+
+// PresenceSession is one participant's membership of the room. Its methods
+// are safe to call from any goroutine.
+type PresenceSession struct {
+	room    *Presence
+	self    Participant   // Guarded by room.mu
+	changed chan struct{} // Another participant moved, joined or left
+	left    chan struct{}
+}
+
+// presenceChangedMsg tells a session to redraw the other participants.
+type presenceChangedMsg struct{}
+
+// Join adds a participant called name to the room.
+func (p *Presence) Join(name string) *PresenceSession {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	s := &PresenceSession{
+		room:    p,
+		self:    Participant{Name: name, Color: presenceColors[p.joined%len(presenceColors)]},
+		changed: make(chan struct{}, 1),
+		left:    make(chan struct{}),
+	}
+	p.joined++
+	p.members = append(p.members, s)
+	p.notifyLocked(s)
+	return s
+}
+
+// Leave removes the participant from the room.
+func (s *PresenceSession) Leave() {
+	p := s.room
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i, other := range p.members {
+		if other == s {
+			p.members = append(p.members[:i:i], p.members[i+1:]...)
+			close(s.left)
+			p.notifyLocked(s)
+			return
+		}
+	}
+}
+
+// Move publishes the participant's view and selected issue.
+func (s *PresenceSession) Move(view, issueID string) {
+	p := s.room
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if s.self.View == view && s.self.IssueID == issueID {
+		return
+	}
+	s.self.View, s.self.IssueID = view, issueID
+	p.notifyLocked(s)
+}
+
+// notifyLocked wakes every member but from. A pending wake-up is enough:
+// the members read the current state when they redraw.
+func (p *Presence) notifyLocked(from *PresenceSession) {
+	for _, s := range p.members {
+		if s == from {
+			continue
+		}
+		select {
+		case s.changed <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Others returns the other participants, in join order.
+func (s *PresenceSession) Others() []Participant {
+	if s == nil {
+		return nil
+	}
+	p := s.room
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var others []Participant
+	for _, m := range p.members {
+		if m != s {
+			others = append(others, m.self)
+		}
+	}
+	return others
+}
+
+// On returns the other participants who have issue id selected.
+func (s *PresenceSession) On(id string) []Participant {
+	var on []Participant
+	for _, other := range s.Others() {
+		if other.IssueID == id {
+			on = append(on, other)
+		}
+	}
+	return on
+}
+
+// waitForPresenceCmd waits until another participant changes.
+func waitForPresenceCmd(s *PresenceSession) tea.Cmd {
+	return func() tea.Msg {
+		select {
+		case <-s.changed:
+		case <-s.left:
+		}
+		select {
+		case <-s.left:
+			return nil
+		default:
+			return presenceChangedMsg{}
+		}
+	}
+}
+
+// EnablePresence shares the session's location through s and shows the
+// other participants. Must be called before the program starts.
+func (m *Model) EnablePresence(s *PresenceSession) {
+	m.presence = s
+	m.graphView.presence = s
+	m.updateListDelegate()
+}
+
+// publishPresence tells the other participants where the session is now.
+func (m Model) publishPresence() {
+	if m.presence == nil {
+		return
+	}
+	loc, _ := m.location()
+	m.presence.Move(loc.view, loc.issueID)
+}
+
+// presenceMarks renders a dot in the color of each participant on id.
+func presenceMarks(s *PresenceSession, id string, r *lipgloss.Renderer) string {
+	var sb strings.Builder
+	for _, p := range s.On(id) {
+		sb.WriteString(r.NewStyle().Foreground(p.Color).Render("●"))
+	}
+	return sb.String()
+}
+
+// renderPresence lists the other participants for the status bar.
+func (m Model) renderPresence() string {
+	others := m.presence.Others()
+	if len(others) == 0 {
+		return ""
+	}
+	names := make([]string, len(others))
+	for i, p := range others {
+		names[i] = lipgloss.NewStyle().Background(ColorBgHighlight).Foreground(p.Color).Render("● " + p.Name)
+	}
+	return lipgloss.NewStyle().
+		Background(ColorBgHighlight).
+		Foreground(ColorText).
+		Padding(0, 1).
+		Render("👥 " + strings.Join(names, lipgloss.NewStyle().Background(ColorBgHighlight).Render("  ")))
+}
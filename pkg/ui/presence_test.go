@@ -0,0 +1,92 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestPresence_SharedSelections(t *testing.T) {
+	room := NewPresence()
+	ana, kim := room.Join("ana"), room.Join("kim")
+	anaModel, kimModel := commandTestModel(t), commandTestModel(t)
+	anaModel.EnablePresence(ana)
+	kimModel.EnablePresence(kim)
+
+	anaModel = pressKey(anaModel, runeKey("g"))
+	anaModel.graphView.SelectByID("A-3")
+	newM, _ := anaModel.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	anaModel = newM.(Model)
+	if got := kim.Others(); len(got) != 1 || got[0].Name != "ana" || got[0].View != "graph" || got[0].IssueID != "A-3" {
+		t.Fatalf("expected ana's location published, got %+v", got)
+	}
+
+	// kim is woken up and redraws with ana's marker
+	msg := waitForPresenceCmd(kim)()
+	if _, ok := msg.(presenceChangedMsg); !ok {
+		t.Fatalf("expected a presence change, got %T", msg)
+	}
+	newM, cmd := kimModel.Update(msg)
+	kimModel = newM.(Model)
+	if cmd == nil {
+		t.Error("expected kim to keep waiting for changes")
+	}
+	if !strings.Contains(kimModel.renderFooter(), "ana") {
+		t.Error("expected ana in kim's presence list")
+	}
+	marked := strings.Count(kimModel.list.View(), "●")
+	kimModel = pressKey(kimModel, runeKey("g"))
+	kimModel.graphView.SelectByID("A-1")
+	if graph := kimModel.graphView.View(120, 30); !strings.Contains(graph, "●") {
+		t.Error("expected ana's marker in kim's graph")
+	}
+
+	ana.Leave()
+	if len(kim.Others()) != 0 || kimModel.renderPresence() != "" {
+		t.Error("expected ana gone after leaving")
+	}
+	kimModel = pressKey(kimModel, runeKey("g"))
+	if strings.Count(kimModel.list.View(), "●") != marked-1 {
+		t.Error("expected ana's marker in kim's list until ana left")
+	}
+	if msg := waitForPresenceCmd(ana)(); msg != nil {
+		t.Errorf("expected no more changes after leaving, got %T", msg)
+	}
+	ana.Leave() // Leaving twice is harmless
+}
+
+func TestPresence_ColorsAndMoves(t *testing.T) {
+	room := NewPresence()
+	sessions := make([]*PresenceSession, len(presenceColors)+1)
+	for i := range sessions {
+		sessions[i] = room.Join("user")
+	}
+	others := sessions[0].Others()
+	if others[0].Color == others[1].Color {
+		t.Error("expected participants to get distinct colors")
+	}
+	if others[len(others)-1].Color != sessions[0].self.Color {
+		t.Error("expected colors to wrap around")
+	}
+
+	// Moving to the same place does not wake anyone
+	watcher := room.Join("watcher")
+	sessions[0].Move("list", "A-1")
+	<-watcher.changed
+	sessions[0].Move("list", "A-1")
+	select {
+	case <-watcher.changed:
+		t.Error("expected no change for an unchanged location")
+	default:
+	}
+}
+
+func TestPresence_DisabledOutsideSharedSessions(t *testing.T) {
+	m := commandTestModel(t)
+	newM, _ := m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m = newM.(Model)
+	if m.renderPresence() != "" || presenceMarks(nil, "A-1", m.theme.Renderer) != "" {
+		t.Error("expected nothing shown without a shared session")
+	}
+}
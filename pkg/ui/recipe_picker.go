@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
 
 	"github.com/charmbracelet/lipgloss"
@@ -88,7 +89,7 @@ func (m *RecipePickerModel) View() string {
 		Foreground(t.Primary).
 		Bold(true).
 		MarginBottom(1)
-	lines = append(lines, titleStyle.Render("Select Recipe"))
+	lines = append(lines, titleStyle.Render(i18n.T("Select Recipe")))
 	lines = append(lines, "")
 
 	// Recipe list
@@ -131,7 +132,7 @@ func (m *RecipePickerModel) View() string {
 	footerStyle := t.Renderer.NewStyle().
 		Foreground(t.Secondary).
 		Italic(true)
-	lines = append(lines, footerStyle.Render("j/k: navigate • enter: apply • esc: cancel"))
+	lines = append(lines, footerStyle.Render(i18n.T("j/k: navigate • enter: apply • esc: cancel")))
 
 	content := strings.Join(lines, "\n")
 
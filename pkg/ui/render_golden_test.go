@@ -0,0 +1,73 @@
+package ui_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/testutil"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/ui"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// renderFixtureTime is the clock for golden renders; fixture timestamps are
+// relative to it.
+var renderFixtureTime = time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+
+func renderFixtureModel() tea.Model {
+	at := func(d time.Duration) time.Time { return renderFixtureTime.Add(-d) }
+	day := 24 * time.Hour
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Ship the importer", Status: model.StatusInProgress, Priority: 0, IssueType: model.TypeFeature,
+			Assignee: "ana", Labels: []string{"import"}, CreatedAt: at(20 * day), UpdatedAt: at(2 * time.Hour),
+			Description:  "Read issues from the legacy tracker.",
+			Dependencies: []*model.Dependency{{IssueID: "bv-1", DependsOnID: "bv-2", Type: model.DepBlocks}},
+			Comments:     []*model.Comment{{ID: 1, IssueID: "bv-1", Author: "kim", Text: "Schema is settled.", CreatedAt: at(3 * day)}}},
+		{ID: "bv-2", Title: "Map legacy fields", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask,
+			Labels: []string{"import"}, CreatedAt: at(10 * day), UpdatedAt: at(10 * day)},
+		{ID: "bv-3", Title: "Crash on empty file", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeBug,
+			CreatedAt: at(45 * time.Minute), UpdatedAt: at(45 * time.Minute)},
+		{ID: "bv-4", Title: "Document the CLI", Status: model.StatusClosed, Priority: 3, IssueType: model.TypeChore,
+			CreatedAt: at(40 * day), UpdatedAt: at(5 * day), ClosedAt: ptrTime(at(5 * day))},
+	}
+	m := ui.NewModel(issues, nil, "")
+	m.SetClock(func() time.Time { return renderFixtureTime })
+	return m
+}
+
+func ptrTime(t time.Time) *time.Time { return &t }
+
+func TestRenderGolden(t *testing.T) {
+	dir := testutil.GoldenDir("../..", "ui")
+	cases := []struct {
+		name string
+		keys []string
+	}{
+		{"list", nil},
+		{"detail", []string{"enter"}},
+		{"board", []string{"b"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			testutil.AssertGoldenSizes(t, renderFixtureModel, testutil.StandardSizes, tc.keys, dir, tc.name)
+		})
+	}
+}
+
+func TestRenderHarness_IsDeterministic(t *testing.T) {
+	size := testutil.TermSize{Width: 80, Height: 24}
+	first := testutil.NewHarness(t, renderFixtureModel(), size).View()
+	second := testutil.NewHarness(t, renderFixtureModel(), size).View()
+	if first != second {
+		t.Fatalf("two renders of the same state differ:\n%s\n---\n%s", first, second)
+	}
+
+	h := testutil.NewHarness(t, renderFixtureModel(), size)
+	h.AssertContains("bv-3", "45m ago", "Crash on empty file")
+	h.Keys("j")
+	if h.View() == first {
+		t.Fatal("expected moving the cursor to change the view")
+	}
+	h.Send(tea.WindowSizeMsg{Width: 120, Height: 40})
+	h.AssertContains("Crash on empty file", "Map legacy fields")
+}
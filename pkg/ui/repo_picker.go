@@ -3,6 +3,7 @@ package ui
 import (
 	"strings"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -125,12 +126,12 @@ func (m *RepoPickerModel) View() string {
 		Foreground(t.Primary).
 		Bold(true).
 		MarginBottom(1)
-	lines = append(lines, titleStyle.Render("Repo Filter"))
+	lines = append(lines, titleStyle.Render(i18n.T("Repo Filter")))
 	lines = append(lines, "")
 
 	if len(m.repos) == 0 {
 		emptyStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Italic(true)
-		lines = append(lines, emptyStyle.Render("No repos available."))
+		lines = append(lines, emptyStyle.Render(i18n.T("No repos available.")))
 	} else {
 		for i, repo := range m.repos {
 			isCursor := i == m.selectedIndex
@@ -159,7 +160,7 @@ func (m *RepoPickerModel) View() string {
 	footerStyle := t.Renderer.NewStyle().
 		Foreground(t.Secondary).
 		Italic(true)
-	lines = append(lines, footerStyle.Render("j/k: navigate • space: toggle • a: all • enter: apply • esc: cancel"))
+	lines = append(lines, footerStyle.Render(i18n.T("j/k: navigate • space: toggle • a: all • enter: apply • esc: cancel")))
 
 	content := strings.Join(lines, "\n")
 
@@ -0,0 +1,85 @@
+package ui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Resizing
+//
+// A new window size resizes the panels at once, which is cheap and keeps
+// the details scrolled where they were. Rendering the details again to wrap
+// at a new width is not cheap, so while sizes arrive less than resizeSettle
+// apart, as they do while a window is dragged, that waits for the last one.
+
+// resizeSettle is how long the window size has to hold before the details
+// are wrapped to it.
+const resizeSettle = 80 * time.Millisecond
+
+// resizeState tracks the window sizes arriving.
+type resizeState struct {
+	last    time.Time // When the last size arrived
+	seq     int       // Sizes so far, so only the last one settles
+	pending bool      // The details wait to be wrapped to the new width
+}
+
+// resizeSettledMsg arrives resizeSettle after size seq.
+type resizeSettledMsg struct {
+	seq int
+}
+
+// applySize lays the screen out for a width×height terminal and returns
+// the command, if any, that wraps the details once resizing stops.
+func (m *Model) applySize(width, height int) tea.Cmd {
+	now := time.Now() // Not m.now: the pace is the terminal's, not the views'
+	storm := !m.resizing.last.IsZero() && now.Sub(m.resizing.last) < resizeSettle
+	m.resizing.last = now
+	m.resizing.seq++
+
+	l := m.panelLayout(width, height)
+	if l.detailWidth != m.renderer.width {
+		m.resizing.pending = true
+	}
+	m.width, m.height = width, height
+	m.isSplitView, m.stackedView = l.split, l.stacked
+	m.ready = true
+
+	m.commandOutput.resize(width, height)
+	if m.showPager {
+		m.pager.resize(width, height)
+	}
+	m.tutorialModel.SetSize(width, height)
+	m.list.SetSize(l.listWidth, l.listHeight)
+	m.viewport.Width, m.viewport.Height = l.detailWidth, l.detailHeight
+	m.viewport.SetYOffset(m.viewport.YOffset) // Stay in range of the new height
+	m.updateListDelegate()
+	m.labelDashboard.SetSize(width, l.body)
+	m.insightsPanel.SetSize(width, l.body)
+
+	if !m.resizing.pending {
+		return nil
+	}
+	if !storm {
+		m.wrapDetails()
+		return nil
+	}
+	seq := m.resizing.seq
+	return tea.Tick(resizeSettle, func(time.Time) tea.Msg {
+		return resizeSettledMsg{seq: seq}
+	})
+}
+
+// settleResize wraps the details if no size arrived after msg's.
+func (m *Model) settleResize(msg resizeSettledMsg) {
+	if msg.seq == m.resizing.seq && m.resizing.pending {
+		m.wrapDetails()
+	}
+}
+
+// wrapDetails renders the details again at the width of their panel.
+func (m *Model) wrapDetails() {
+	m.resizing.pending = false
+	m.renderer.SetWidthWithTheme(m.viewport.Width, m.theme)
+	m.updateViewportContent()
+}
@@ -0,0 +1,11 @@
+//go:build !windows
+
+package ui
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// platformResize reports whether to lay out for a new window size, and what
+// to run with it. Only the Windows console needs help (see resize_windows.go).
+func platformResize(tea.WindowSizeMsg) (bool, tea.Cmd) {
+	return true, nil
+}
@@ -0,0 +1,16 @@
+//go:build windows
+
+package ui
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// platformResize works around ConPTY, which hosts Windows terminals. It
+// reflows the last frame itself when the window is resized, leaving lines
+// the renderer does not know to repaint, so the screen is cleared. A
+// minimized window reports 0x0, which is ignored rather than laid out.
+func platformResize(msg tea.WindowSizeMsg) (bool, tea.Cmd) {
+	if msg.Width <= 0 || msg.Height <= 0 {
+		return false, nil
+	}
+	return true, tea.ClearScreen
+}
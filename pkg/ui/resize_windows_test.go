@@ -0,0 +1,23 @@
+//go:build windows
+
+package ui
+
+import (
+	"reflect"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestPlatformResize_Windows(t *testing.T) {
+	apply, cmd := platformResize(tea.WindowSizeMsg{Width: 100, Height: 30})
+	if !apply || cmd == nil || !reflect.DeepEqual(cmd(), tea.ClearScreen()) {
+		t.Fatal("expected a resize to clear the screen")
+	}
+
+	m := commandTestModel(t)
+	newM, _ := m.Update(tea.WindowSizeMsg{Width: 0, Height: 0})
+	if got := newM.(Model); got.width != m.width || got.height != m.height {
+		t.Errorf("expected a minimized window to keep the layout, got %dx%d", got.width, got.height)
+	}
+}
@@ -477,7 +477,9 @@ func BuildHybridMetricsCmd(issues []model.Issue) tea.Cmd {
 }
 
 // BuildSemanticIndexCmd builds or updates the semantic index for the given issues.
-func BuildSemanticIndexCmd(issues []model.Issue) tea.Cmd {
+// With save false, a changed index is kept in memory instead of written back
+// to .bv/semantic, as in read-only sessions.
+func BuildSemanticIndexCmd(issues []model.Issue, save bool) tea.Cmd {
 	return func() tea.Msg {
 		cfg := search.EmbeddingConfigFromEnv()
 		embedder, err := search.NewEmbedderFromConfig(cfg)
@@ -504,7 +506,7 @@ func BuildSemanticIndexCmd(issues []model.Issue) tea.Cmd {
 		if err != nil {
 			return SemanticIndexReadyMsg{Error: err}
 		}
-		if !loaded || stats.Changed() {
+		if save && (!loaded || stats.Changed()) {
 			if err := idx.Save(indexPath); err != nil {
 				return SemanticIndexReadyMsg{Error: fmt.Errorf("save semantic index: %w", err)}
 			}
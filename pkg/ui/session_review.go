@@ -0,0 +1,310 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// WorklogFileName is the project-local file session summaries are appended to.
+const WorklogFileName = "worklog.md"
+
+// SessionTracker records what happened during a single bv session:
+// which issues were looked at, which changed underneath us (edited/closed),
+// and how long was spent in each view. It is held by pointer so that the
+// value copies Bubble Tea makes of Model all feed the same tracker.
+type SessionTracker struct {
+	startedAt    time.Time
+	lastObserved time.Time
+	viewTime     map[Context]time.Duration
+
+	viewedOrder []string
+	viewed      map[string]bool
+	edited      map[string]bool
+	closed      map[string]bool
+}
+
+// NewSessionTracker creates a tracker whose session starts at now.
+func NewSessionTracker(now time.Time) *SessionTracker {
+	return &SessionTracker{
+		startedAt:    now,
+		lastObserved: now,
+		viewTime:     make(map[Context]time.Duration),
+		viewed:       make(map[string]bool),
+		edited:       make(map[string]bool),
+		closed:       make(map[string]bool),
+	}
+}
+
+// Observe attributes the time since the previous observation to ctx.
+// Call it at the start of every Update with the context that was on screen
+// while waiting for the message, so the elapsed time lands on the right view.
+func (s *SessionTracker) Observe(ctx Context, now time.Time) {
+	if s == nil {
+		return
+	}
+	if elapsed := now.Sub(s.lastObserved); elapsed > 0 {
+		s.viewTime[ctx] += elapsed
+	}
+	s.lastObserved = now
+}
+
+// RecordViewed marks an issue as viewed in the detail pane.
+func (s *SessionTracker) RecordViewed(id string) {
+	if s == nil || id == "" || s.viewed[id] {
+		return
+	}
+	s.viewed[id] = true
+	s.viewedOrder = append(s.viewedOrder, id)
+}
+
+// ObserveReload compares the issue set before and after a reload and records
+// issues that were modified or closed while the session was running.
+func (s *SessionTracker) ObserveReload(before map[string]*model.Issue, after []model.Issue) {
+	if s == nil || len(before) == 0 {
+		return
+	}
+	for i := range after {
+		cur := &after[i]
+		prev, ok := before[cur.ID]
+		if !ok || prev == nil {
+			continue
+		}
+		if !prev.Status.IsClosed() && cur.Status.IsClosed() {
+			s.closed[cur.ID] = true
+			continue
+		}
+		if !cur.UpdatedAt.Equal(prev.UpdatedAt) || cur.Status != prev.Status || cur.Priority != prev.Priority {
+			s.edited[cur.ID] = true
+		}
+	}
+}
+
+// SessionViewTime is the time spent in a single view.
+type SessionViewTime struct {
+	View     Context
+	Duration time.Duration
+}
+
+// SessionSummary is an immutable snapshot of a session for display/export.
+type SessionSummary struct {
+	StartedAt time.Time
+	EndedAt   time.Time
+	Viewed    []string
+	Edited    []string
+	Closed    []string
+	Views     []SessionViewTime // Sorted by duration, longest first
+}
+
+// Duration returns the wall-clock length of the session.
+func (s SessionSummary) Duration() time.Duration {
+	return s.EndedAt.Sub(s.StartedAt)
+}
+
+// Summary returns a snapshot of the session as of now.
+func (s *SessionTracker) Summary(now time.Time) SessionSummary {
+	if s == nil {
+		return SessionSummary{StartedAt: now, EndedAt: now}
+	}
+	sum := SessionSummary{
+		StartedAt: s.startedAt,
+		EndedAt:   now,
+		Viewed:    append([]string(nil), s.viewedOrder...),
+		Edited:    sortedKeys(s.edited),
+		Closed:    sortedKeys(s.closed),
+	}
+	for ctx, d := range s.viewTime {
+		sum.Views = append(sum.Views, SessionViewTime{View: ctx, Duration: d})
+	}
+	sort.Slice(sum.Views, func(i, j int) bool {
+		if sum.Views[i].Duration != sum.Views[j].Duration {
+			return sum.Views[i].Duration > sum.Views[j].Duration
+		}
+		return sum.Views[i].View < sum.Views[j].View
+	})
+	return sum
+}
+
+func sortedKeys[V any](set map[string]V) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Markdown renders the summary as a worklog entry.
+func (s SessionSummary) Markdown() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("## Session %s (%s)\n\n",
+		s.StartedAt.Format("2006-01-02 15:04"), formatSessionDuration(s.Duration())))
+	writeList := func(title string, ids []string) {
+		if len(ids) == 0 {
+			return
+		}
+		sb.WriteString(fmt.Sprintf("**%s (%d):** %s\n\n", title, len(ids), strings.Join(ids, ", ")))
+	}
+	writeList(i18n.T("Viewed"), s.Viewed)
+	writeList(i18n.T("Edited"), s.Edited)
+	writeList(i18n.T("Closed"), s.Closed)
+	if len(s.Views) > 0 {
+		sb.WriteString(i18n.T("| View | Time |") + "\n|---|---|\n")
+		for _, v := range s.Views {
+			sb.WriteString(fmt.Sprintf("| %s | %s |\n", v.View, formatSessionDuration(v.Duration)))
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// AppendToWorklog appends the summary to <dir>/.bv/worklog.md, creating the
+// file (with a heading) if needed. Returns the path written.
+func (s SessionSummary) AppendToWorklog(projectDir string) (string, error) {
+	if projectDir == "" {
+		return "", fmt.Errorf("no project directory")
+	}
+	dir := filepath.Join(projectDir, ".bv")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("creating %s: %w", dir, err)
+	}
+	path := filepath.Join(dir, WorklogFileName)
+
+	_, statErr := os.Stat(path)
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return "", fmt.Errorf("opening worklog: %w", err)
+	}
+	defer f.Close()
+
+	if os.IsNotExist(statErr) {
+		if _, err := f.WriteString("# " + i18n.T("bv worklog") + "\n\n"); err != nil {
+			return "", fmt.Errorf("writing worklog: %w", err)
+		}
+	}
+	if _, err := f.WriteString(s.Markdown()); err != nil {
+		return "", fmt.Errorf("writing worklog: %w", err)
+	}
+	return path, nil
+}
+
+// formatSessionDuration renders a duration at a granularity suited to a summary.
+func formatSessionDuration(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm%02ds", int(d.Minutes()), int(d.Seconds())%60)
+	default:
+		return fmt.Sprintf("%dh%02dm", int(d.Hours()), int(d.Minutes())%60)
+	}
+}
+
+// renderSessionReview renders the quit-time session summary overlay.
+func (m Model) renderSessionReview() string {
+	t := m.theme
+	sum := m.session.Summary(time.Now())
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 3)
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	labelStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Bold(true)
+	textStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	keyStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+
+	idList := func(ids []string) string {
+		if len(ids) == 0 {
+			return mutedStyle.Render("—")
+		}
+		const maxShown = 8
+		shown := ids
+		suffix := ""
+		if len(shown) > maxShown {
+			shown = shown[:maxShown]
+			suffix = fmt.Sprintf(" +%d more", len(ids)-maxShown)
+		}
+		return textStyle.Render(strings.Join(shown, ", ") + suffix)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(i18n.T("Session review")))
+	sb.WriteString(mutedStyle.Render("  " + formatSessionDuration(sum.Duration())))
+	sb.WriteString("\n\n")
+	sb.WriteString(labelStyle.Render(i18n.Tf("Viewed (%d):", len(sum.Viewed))+" ") + idList(sum.Viewed) + "\n")
+	sb.WriteString(labelStyle.Render(i18n.Tf("Edited (%d):", len(sum.Edited))+" ") + idList(sum.Edited) + "\n")
+	sb.WriteString(labelStyle.Render(i18n.Tf("Closed (%d):", len(sum.Closed))+" ") + idList(sum.Closed) + "\n")
+
+	if len(sum.Views) > 0 {
+		sb.WriteString("\n" + labelStyle.Render(i18n.T("Time per view")) + "\n")
+		for i, v := range sum.Views {
+			if i >= 6 {
+				break
+			}
+			sb.WriteString(textStyle.Render(fmt.Sprintf("  %-16s %s", v.View, formatSessionDuration(v.Duration))) + "\n")
+		}
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(keyStyle.Render("e") + textStyle.Render(" "+i18n.T("export to worklog & quit")+"  ") +
+		keyStyle.Render("q") + textStyle.Render(" "+i18n.T("quit")+"  ") +
+		keyStyle.Render("esc") + textStyle.Render(" "+i18n.T("back")))
+
+	return lipgloss.Place(m.width, m.height-1, lipgloss.Center, lipgloss.Center, boxStyle.Render(sb.String()))
+}
+
+// EnableSessionReview controls whether quitting shows the session summary first.
+func (m *Model) EnableSessionReview(enabled bool) {
+	m.sessionReviewEnabled = enabled
+}
+
+// SessionSummary returns a snapshot of the current session.
+func (m Model) SessionSummary() SessionSummary {
+	return m.session.Summary(time.Now())
+}
+
+// quitOrReview quits, or shows the session review first when enabled.
+func (m Model) quitOrReview() (Model, tea.Cmd) {
+	if !m.sessionReviewEnabled || m.showSessionReview {
+		return m, tea.Quit
+	}
+	m.showQuitConfirm = false
+	m.showSessionReview = true
+	m.focused = focusSessionReview
+	return m, nil
+}
+
+// handleSessionReviewKeys handles input while the session review is shown.
+func (m Model) handleSessionReviewKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch msg.String() {
+	case "e", "E":
+		if m.refuseReadOnly(i18n.T("Exporting the worklog")) {
+			return m, nil
+		}
+		path, err := m.session.Summary(time.Now()).AppendToWorklog(m.workDir)
+		if err != nil {
+			m.reportError(newError(errData, "append to worklog", err))
+			return m, nil
+		}
+		m.statusMsg = i18n.Tf("Session appended to %s", path)
+		m.statusIsError = false
+		return m, tea.Quit
+	case "esc":
+		m.showSessionReview = false
+		m.focused = focusList
+		return m, nil
+	case "q", "Q", "y", "Y", "enter", "ctrl+c":
+		return m, tea.Quit
+	}
+	return m, nil
+}
@@ -0,0 +1,148 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestSessionTracker_ObserveAttributesTimeToContext(t *testing.T) {
+	start := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	s := NewSessionTracker(start)
+
+	s.Observe(ContextList, start.Add(2*time.Minute))
+	s.Observe(ContextBoard, start.Add(3*time.Minute))
+	s.Observe(ContextList, start.Add(4*time.Minute))
+
+	sum := s.Summary(start.Add(5 * time.Minute))
+	if len(sum.Views) != 2 {
+		t.Fatalf("expected 2 views, got %d", len(sum.Views))
+	}
+	if sum.Views[0].View != ContextList || sum.Views[0].Duration != 3*time.Minute {
+		t.Fatalf("expected list first with 3m, got %+v", sum.Views[0])
+	}
+	if sum.Duration() != 5*time.Minute {
+		t.Fatalf("expected 5m session, got %v", sum.Duration())
+	}
+}
+
+func TestSessionTracker_RecordViewedDedupesInOrder(t *testing.T) {
+	s := NewSessionTracker(time.Now())
+	s.RecordViewed("b")
+	s.RecordViewed("a")
+	s.RecordViewed("b")
+	s.RecordViewed("")
+
+	got := s.Summary(time.Now()).Viewed
+	if strings.Join(got, ",") != "b,a" {
+		t.Fatalf("expected [b a], got %v", got)
+	}
+}
+
+func TestSessionTracker_ObserveReload(t *testing.T) {
+	t0 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := map[string]*model.Issue{
+		"A": {ID: "A", Status: model.StatusOpen, UpdatedAt: t0},
+		"B": {ID: "B", Status: model.StatusOpen, UpdatedAt: t0},
+		"C": {ID: "C", Status: model.StatusOpen, UpdatedAt: t0},
+	}
+	after := []model.Issue{
+		{ID: "A", Status: model.StatusOpen, UpdatedAt: t0},
+		{ID: "B", Status: model.StatusInProgress, UpdatedAt: t0.Add(time.Hour)},
+		{ID: "C", Status: model.StatusClosed, UpdatedAt: t0.Add(time.Hour)},
+		{ID: "D", Status: model.StatusOpen, UpdatedAt: t0},
+	}
+
+	s := NewSessionTracker(t0)
+	s.ObserveReload(before, after)
+	sum := s.Summary(t0)
+
+	if strings.Join(sum.Edited, ",") != "B" {
+		t.Fatalf("expected edited [B], got %v", sum.Edited)
+	}
+	if strings.Join(sum.Closed, ",") != "C" {
+		t.Fatalf("expected closed [C], got %v", sum.Closed)
+	}
+}
+
+func TestSessionSummary_AppendToWorklog(t *testing.T) {
+	dir := t.TempDir()
+	start := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	sum := SessionSummary{
+		StartedAt: start,
+		EndedAt:   start.Add(90 * time.Second),
+		Viewed:    []string{"bv-1"},
+		Closed:    []string{"bv-2"},
+		Views:     []SessionViewTime{{View: ContextList, Duration: 90 * time.Second}},
+	}
+
+	path, err := sum.AppendToWorklog(dir)
+	if err != nil {
+		t.Fatalf("AppendToWorklog failed: %v", err)
+	}
+	if _, err := sum.AppendToWorklog(dir); err != nil {
+		t.Fatalf("second AppendToWorklog failed: %v", err)
+	}
+	if path != filepath.Join(dir, ".bv", WorklogFileName) {
+		t.Fatalf("unexpected path %q", path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := string(data)
+	if strings.Count(content, "# bv worklog") != 1 {
+		t.Fatalf("expected a single heading, got:\n%s", content)
+	}
+	if strings.Count(content, "## Session 2025-01-01 09:00 (1m30s)") != 2 {
+		t.Fatalf("expected two session entries, got:\n%s", content)
+	}
+	if !strings.Contains(content, "**Closed (1):** bv-2") {
+		t.Fatalf("expected closed list, got:\n%s", content)
+	}
+}
+
+func TestSessionReview_QuitFlow(t *testing.T) {
+	issues := []model.Issue{{ID: "A", Title: "Alpha", Status: model.StatusOpen}}
+	m := NewModel(issues, nil, "")
+	newM, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m = newM.(Model)
+
+	// Disabled by default: q quits immediately.
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	if cmd == nil {
+		t.Fatal("expected quit command when session review is disabled")
+	}
+
+	m.EnableSessionReview(true)
+	newM, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	m = newM.(Model)
+	if cmd != nil || !m.showSessionReview {
+		t.Fatal("expected q to open the session review")
+	}
+	if m.CurrentContext() != ContextSessionReview {
+		t.Fatalf("expected session-review context, got %s", m.CurrentContext())
+	}
+	if !strings.Contains(m.View(), "Session review") {
+		t.Fatal("expected session review to be rendered")
+	}
+
+	newM, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = newM.(Model)
+	if m.showSessionReview {
+		t.Fatal("expected esc to dismiss the session review")
+	}
+
+	newM, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	m = newM.(Model)
+	_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	if cmd == nil {
+		t.Fatal("expected q in the session review to quit")
+	}
+}
@@ -0,0 +1,173 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/beadsview"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// SessionState is where the user left bv in a project: it is saved on quit
+// and restored on the next start, unless bv runs with --fresh.
+type SessionState struct {
+	View      string `json:"view,omitempty"`       // Argument of the view command; empty is the list
+	Filter    string `json:"filter,omitempty"`     // List filter, unless a recipe is active
+	Recipe    string `json:"recipe,omitempty"`     // Active recipe
+	Sort      string `json:"sort,omitempty"`       // Sort of the list and the views showing it
+	BoardSort string `json:"board_sort,omitempty"` // Sort of the board's cards
+	Selected  string `json:"selected,omitempty"`   // Selected issue ID
+	Scroll    int    `json:"scroll,omitempty"`     // Detail pane offset, in lines
+}
+
+// sortKeys are the sort keys of the sort modes, in order.
+var sortKeys = []string{"default", "created", "-created", "priority", "updated", "votes"}
+
+// SessionStatePath returns where the session state of the project in
+// projectDir is kept, next to its watch list.
+func SessionStatePath(projectDir string) (string, error) {
+	return userProjectFile("sessions", projectDir)
+}
+
+// LoadSessionState reads the state saved at path; a missing file is the
+// zero state.
+func LoadSessionState(path string) (SessionState, error) {
+	var s SessionState
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return s, err
+	}
+	if err := json.Unmarshal(data, &s); err != nil {
+		return SessionState{}, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return s, nil
+}
+
+// EnableSessionState saves the session state to path when SaveSessionState
+// is called. With restore, the state saved there before is applied once
+// the TUI starts, ahead of the startup commands.
+func (m *Model) EnableSessionState(path string, restore bool) error {
+	m.sessionStatePath = path
+	if !restore {
+		return nil
+	}
+	s, err := LoadSessionState(path)
+	if err != nil {
+		return err
+	}
+	m.restoreState = &s
+	return nil
+}
+
+// SaveSessionState writes the current session state. Call it on the final
+// model when the program exits.
+func (m *Model) SaveSessionState() error {
+	if m.sessionStatePath == "" {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(m.sessionStatePath), 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(m.sessionState(), "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(m.sessionStatePath, data, 0o644)
+}
+
+// sessionState captures the current view, filter, sort and selection.
+func (m *Model) sessionState() SessionState {
+	var s SessionState
+	switch {
+	case m.focused == focusInsights && m.showAttentionView:
+		s.View = "attention"
+	case m.focused == focusInsights:
+		s.View = "insights"
+	case m.focused == focusFlowMatrix:
+		s.View = "flow"
+	case m.focused == focusTreemap:
+		s.View = "treemap"
+	case m.focused == focusTimeline:
+		s.View = "timeline"
+	case m.focused == focusLabelDashboard:
+		s.View = "labels"
+	case m.focused == focusTree:
+		s.View = "tree"
+	case m.isGraphView:
+		s.View = "graph"
+	case m.isBoardView:
+		s.View = "board"
+	case m.isActionableView:
+		s.View = "actionable"
+	case m.isHistoryView:
+		s.View = "history"
+	}
+	if m.activeRecipe != nil {
+		s.Recipe = m.activeRecipe.Name
+	} else if m.currentFilter != "all" {
+		s.Filter = m.currentFilter
+	}
+	if order := m.sorts["list"]; !isDefaultSort(order) {
+		s.Sort = order.String()
+	}
+	if order := m.sorts["board"]; !isDefaultSort(order) {
+		s.BoardSort = order.String()
+	}
+	if issue := m.actionTarget(); issue != nil {
+		s.Selected = issue.ID
+	}
+	s.Scroll = m.viewport.YOffset
+	return s
+}
+
+// restoreSession applies the state saved by the last session. Parts that
+// no longer apply, such as a deleted issue or recipe, are skipped; a
+// recipe chosen on the command line wins over the saved filter.
+func (m Model) restoreSession(s SessionState) (Model, tea.Cmd) {
+	var lines []string
+	if m.activeRecipe == nil {
+		switch {
+		case s.Recipe != "":
+			lines = append(lines, "recipe "+s.Recipe)
+		case s.Filter != "":
+			lines = append(lines, "filter "+s.Filter)
+		}
+	}
+	if s.Sort != "" {
+		lines = append(lines, "sort "+s.Sort)
+	}
+	if s.View != "" {
+		lines = append(lines, "view "+s.View)
+	}
+	if s.Selected != "" {
+		lines = append(lines, "select "+s.Selected)
+	}
+
+	// The sort command applies to the current view; set the board's directly.
+	if order, err := beadsview.ParseSort(s.BoardSort); s.BoardSort != "" && err == nil {
+		m.setSort("board", order)
+	}
+
+	var cmds []tea.Cmd
+	for _, line := range lines {
+		if ValidateCommand(line, nil) != nil {
+			continue
+		}
+		var cmd tea.Cmd
+		var err error
+		if m, cmd, err = m.ExecCommand(line); err == nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+	if s.Selected != "" {
+		m.board.SelectIssueByID(s.Selected)
+		m.viewport.SetYOffset(s.Scroll)
+	}
+	return m, tea.Batch(cmds...)
+}
@@ -0,0 +1,81 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSessionState_SaveAndRestore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sessions", "p.json")
+	m := commandTestModel(t)
+	if err := m.EnableSessionState(path, true); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{"filter open", "sort priority", "view board", "sort -created"} {
+		var err error
+		if m, _, err = m.ExecCommand(line); err != nil {
+			t.Fatal(err)
+		}
+	}
+	m.board.SelectIssueByID("A-1")
+	if err := m.SaveSessionState(); err != nil {
+		t.Fatal(err)
+	}
+	saved, err := LoadSessionState(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := SessionState{View: "board", Filter: "open", Sort: "priority", BoardSort: "-created", Selected: "A-1"}
+	if saved != want {
+		t.Fatalf("saved %+v, want %+v", saved, want)
+	}
+
+	m = commandTestModel(t)
+	if err := m.EnableSessionState(path, true); err != nil {
+		t.Fatal(err)
+	}
+	newM, _ := m.Update(startupCommandsMsg{})
+	m = newM.(Model)
+	if !m.isBoardView || m.currentFilter != "open" || m.sorts["list"].String() != "priority" {
+		t.Fatalf("expected the board, filter and sort restored, got board=%v filter=%q sort=%v", m.isBoardView, m.currentFilter, m.sorts["list"])
+	}
+	if got := m.sorts["board"].String(); got != "-created" {
+		t.Errorf("expected the board sort restored, got %s", got)
+	}
+	if got := m.board.SelectedIssue(); got == nil || got.ID != "A-1" {
+		t.Fatalf("expected A-1 selected on the board, got %v", got)
+	}
+	if m.restoreState != nil {
+		t.Error("expected the state restored once")
+	}
+}
+
+func TestSessionState_FreshAndStartupCommands(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "p.json")
+	if err := os.WriteFile(path, []byte(`{"view":"board","filter":"closed","selected":"GONE-1"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	m := commandTestModel(t)
+	if err := m.EnableSessionState(path, false); err != nil {
+		t.Fatal(err)
+	}
+	if m.restoreState != nil {
+		t.Fatal("expected --fresh to skip the saved state")
+	}
+
+	m = commandTestModel(t)
+	if err := m.EnableSessionState(path, true); err != nil {
+		t.Fatal(err)
+	}
+	m.SetStartupCommands([]string{"view list"})
+	newM, _ := m.Update(startupCommandsMsg{})
+	m = newM.(Model)
+	if m.isBoardView || m.currentFilter != "closed" {
+		t.Errorf("expected the startup command to win over the saved view, got board=%v filter=%q", m.isBoardView, m.currentFilter)
+	}
+	if m.errorModal != nil || m.statusIsError {
+		t.Errorf("expected the missing issue skipped quietly, got %q", m.statusMsg)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -220,7 +221,7 @@ func (s *ShortcutsSidebar) View() string {
 	// Build content
 	var sb strings.Builder
 
-	sb.WriteString(titleStyle.Render("Shortcuts"))
+	sb.WriteString(titleStyle.Render(i18n.T("Shortcuts")))
 	sb.WriteString("\n")
 
 	// Filter sections by context
@@ -287,7 +288,7 @@ func (s *ShortcutsSidebar) View() string {
 		}
 		footer = dimStyle.Render(fmt.Sprintf("j/k scroll %d%%", scrollPercent))
 	} else {
-		footer = dimStyle.Render("; hide")
+		footer = dimStyle.Render(i18n.T("; hide"))
 	}
 
 	// Combine content and footer
@@ -0,0 +1,131 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Shutdown
+//
+// Background work the TUI starts is tied to the model's lifetime. Network
+// calls (update check and download), issue hooks, user commands, the output
+// pane and graph analysis run under ctx, which Stop cancels. bd writes run
+// under a context of their own: Stop lets the writes in flight finish first,
+// so closing an issue and quitting right away still closes it.
+
+// shutdownGrace bounds how long Stop waits for bd writes in flight.
+const shutdownGrace = 5 * time.Second
+
+// lifecycle is shared by all copies of a Model.
+type lifecycle struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	writeCtx    context.Context
+	writeCancel context.CancelFunc
+
+	mu       sync.Mutex
+	writes   int           // bd writes in flight
+	idle     chan struct{} // Closed when writes drops to zero
+	stopping bool
+	hooks    int // Hooks and user commands running
+}
+
+func newLifecycle() *lifecycle {
+	l := &lifecycle{}
+	l.ctx, l.cancel = context.WithCancel(context.Background())
+	l.writeCtx, l.writeCancel = context.WithCancel(context.Background())
+	return l
+}
+
+// context returns the context of cancelable background work.
+func (l *lifecycle) context() context.Context {
+	if l == nil {
+		return context.Background()
+	}
+	return l.ctx
+}
+
+// runWrite runs bd in dir, unless bv is shutting down, and returns its
+// stdout. Stop waits for it.
+func (l *lifecycle) runWrite(dir string, args ...string) (string, error) {
+	if l == nil {
+		return runBD(context.Background(), dir, args...)
+	}
+	l.mu.Lock()
+	if l.stopping {
+		l.mu.Unlock()
+		return "", fmt.Errorf("bd %s: bv is shutting down", args[0])
+	}
+	if l.writes == 0 {
+		l.idle = make(chan struct{})
+	}
+	l.writes++
+	l.mu.Unlock()
+
+	defer func() {
+		l.mu.Lock()
+		l.writes--
+		if l.writes == 0 {
+			close(l.idle)
+		}
+		l.mu.Unlock()
+	}()
+	return runBD(l.writeCtx, dir, args...)
+}
+
+// startHook counts a hook or user command as running until done is called.
+func (l *lifecycle) startHook() (done func()) {
+	if l == nil {
+		return func() {}
+	}
+	l.mu.Lock()
+	l.hooks++
+	l.mu.Unlock()
+	return func() {
+		l.mu.Lock()
+		l.hooks--
+		l.mu.Unlock()
+	}
+}
+
+// runningHooks returns the number of hooks and user commands running.
+func (l *lifecycle) runningHooks() int {
+	if l == nil {
+		return 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.hooks
+}
+
+// shutdown cancels background work, then waits up to grace for the bd
+// writes in flight before canceling them too. It returns the number of
+// writes that had to be canceled.
+func (l *lifecycle) shutdown(grace time.Duration) int {
+	if l == nil {
+		return 0
+	}
+	l.cancel()
+	defer l.writeCancel()
+
+	l.mu.Lock()
+	l.stopping = true
+	idle := l.idle
+	pending := l.writes
+	l.mu.Unlock()
+	if pending == 0 {
+		return 0
+	}
+
+	select {
+	case <-idle:
+		return 0
+	case <-time.After(grace):
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		return l.writes
+	}
+}
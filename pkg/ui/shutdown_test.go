@@ -0,0 +1,90 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// fakeBD puts a bd on PATH that runs script.
+func fakeBD(t *testing.T, script string) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("fake bd is a shell script")
+	}
+	bin := t.TempDir()
+	if err := os.WriteFile(filepath.Join(bin, "bd"), []byte("#!/bin/sh\n"+script+"\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+// startWrite runs a bd write in the background and waits until it is tracked.
+func startWrite(t *testing.T, l *lifecycle, dir string) <-chan error {
+	t.Helper()
+	done := make(chan error, 1)
+	go func() {
+		_, err := l.runWrite(dir, "close", "A-1")
+		done <- err
+	}()
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		l.mu.Lock()
+		n := l.writes
+		l.mu.Unlock()
+		if n > 0 {
+			return done
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("write never started")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestLifecycle_ShutdownFlushesWrites(t *testing.T) {
+	fakeBD(t, "sleep 0.2; touch written")
+	dir := t.TempDir()
+	l := newLifecycle()
+	done := startWrite(t, l, dir)
+
+	if canceled := l.shutdown(5 * time.Second); canceled != 0 {
+		t.Fatalf("expected the write to finish, %d canceled", canceled)
+	}
+	if err := <-done; err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "written")); err != nil {
+		t.Fatal("expected the write to complete before shutdown returned")
+	}
+	if l.context().Err() == nil {
+		t.Error("expected background work to be canceled")
+	}
+	if _, err := l.runWrite(dir, "close", "A-2"); err == nil {
+		t.Error("expected writes to be refused after shutdown")
+	}
+}
+
+func TestLifecycle_ShutdownCancelsSlowWrites(t *testing.T) {
+	fakeBD(t, "exec sleep 10")
+	l := newLifecycle()
+	done := startWrite(t, l, t.TempDir())
+
+	start := time.Now()
+	if canceled := l.shutdown(50 * time.Millisecond); canceled != 1 {
+		t.Fatalf("expected one canceled write, got %d", canceled)
+	}
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Error("expected the canceled write to fail")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("canceled write kept running")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("shutdown took %s", elapsed)
+	}
+}
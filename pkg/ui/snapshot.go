@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/beadsview"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
 )
@@ -183,9 +184,10 @@ type DataSnapshot struct {
 	TruncatedCount int
 	// LargeDatasetWarning is a short, user-facing warning to show in the footer.
 	LargeDatasetWarning string
-	// LoadWarningCount is the number of non-fatal parse warnings encountered while loading.
-	// In TUI mode, warnings must not be printed to stderr during render.
-	LoadWarningCount int
+	// LoadWarnings are the non-fatal parse warnings encountered while loading.
+	// In TUI mode, warnings must not be printed to stderr during render; they
+	// go to the diagnostics log instead.
+	LoadWarnings []string
 
 	// Phase 2 analysis status
 	// Phase2Ready is true when expensive metrics (PageRank, Betweenness, etc.) are computed
@@ -415,52 +417,12 @@ func (b *SnapshotBuilder) Build() *DataSnapshot {
 		listItemsIncremental = true
 	}
 
-	var (
-		triageScores  map[string]float64
-		triageReasons map[string]analysis.TriageReasons
-		quickWinSet   map[string]bool
-		blockerSet    map[string]bool
-		unblocksMap   map[string][]string
-	)
-
 	// Compute triage insights (may be skipped for large/huge datasets; bv-9thm).
+	var triage triageLookups
 	if b.cfg.PrecomputeTriage {
-		triageResult := analysis.ComputeTriageFromAnalyzer(b.analyzer, graphStats, issues, analysis.TriageOptions{}, time.Now())
-		triageScores = make(map[string]float64, len(triageResult.Recommendations))
-		triageReasons = make(map[string]analysis.TriageReasons, len(triageResult.Recommendations))
-		quickWinSet = make(map[string]bool, len(triageResult.QuickWins))
-		blockerSet = make(map[string]bool, len(triageResult.BlockersToClear))
-		unblocksMap = make(map[string][]string, len(triageResult.Recommendations))
-
-		for _, rec := range triageResult.Recommendations {
-			triageScores[rec.ID] = rec.Score
-			if len(rec.Reasons) > 0 {
-				triageReasons[rec.ID] = analysis.TriageReasons{
-					Primary:    rec.Reasons[0],
-					All:        rec.Reasons,
-					ActionHint: rec.Action,
-				}
-			}
-			unblocksMap[rec.ID] = rec.UnblocksIDs
-		}
-		for _, qw := range triageResult.QuickWins {
-			quickWinSet[qw.ID] = true
-		}
-		for _, bl := range triageResult.BlockersToClear {
-			blockerSet[bl.ID] = true
-		}
-
-		// Update list items with triage data
+		triage = newTriageLookups(analysis.ComputeTriageFromAnalyzer(b.analyzer, graphStats, issues, analysis.TriageOptions{}, time.Now()))
 		for i := range listItems {
-			id := listItems[i].Issue.ID
-			listItems[i].TriageScore = triageScores[id]
-			if reasons, exists := triageReasons[id]; exists {
-				listItems[i].TriageReason = reasons.Primary
-				listItems[i].TriageReasons = reasons.All
-			}
-			listItems[i].IsQuickWin = quickWinSet[id]
-			listItems[i].IsBlocker = blockerSet[id]
-			listItems[i].UnblocksCount = len(unblocksMap[id])
+			triage.annotate(&listItems[i])
 		}
 	}
 
@@ -499,11 +461,11 @@ func (b *SnapshotBuilder) Build() *DataSnapshot {
 		CountBlocked:  cBlocked,
 		CountClosed:   cClosed,
 		ListItems:     listItems,
-		TriageScores:  triageScores,
-		TriageReasons: triageReasons,
-		QuickWinSet:   quickWinSet,
-		BlockerSet:    blockerSet,
-		UnblocksMap:   unblocksMap,
+		TriageScores:  triage.scores,
+		TriageReasons: triage.reasons,
+		QuickWinSet:   triage.quickWins,
+		BlockerSet:    triage.blockers,
+		UnblocksMap:   triage.unblocks,
 		TreeRoots:     treeRoots,
 		TreeNodeMap:   treeNodeMap,
 		BoardState:    boardState,
@@ -558,6 +520,56 @@ func shouldUseIncrementalList(prev *DataSnapshot, diff *analysis.IssueDiff, r *r
 	return stats.Ratio <= incrementalListMaxChangeRatio
 }
 
+// triageLookups indexes a triage result by issue ID.
+type triageLookups struct {
+	scores    map[string]float64
+	reasons   map[string]analysis.TriageReasons
+	quickWins map[string]bool
+	blockers  map[string]bool
+	unblocks  map[string][]string // IDs that would be unblocked
+}
+
+func newTriageLookups(result analysis.TriageResult) triageLookups {
+	l := triageLookups{
+		scores:    make(map[string]float64, len(result.Recommendations)),
+		reasons:   make(map[string]analysis.TriageReasons, len(result.Recommendations)),
+		quickWins: make(map[string]bool, len(result.QuickWins)),
+		blockers:  make(map[string]bool, len(result.BlockersToClear)),
+		unblocks:  make(map[string][]string, len(result.Recommendations)),
+	}
+	for _, rec := range result.Recommendations {
+		l.scores[rec.ID] = rec.Score
+		if len(rec.Reasons) > 0 {
+			l.reasons[rec.ID] = analysis.TriageReasons{
+				Primary:    rec.Reasons[0],
+				All:        rec.Reasons,
+				ActionHint: rec.Action,
+			}
+		}
+		l.unblocks[rec.ID] = rec.UnblocksIDs
+	}
+	for _, qw := range result.QuickWins {
+		l.quickWins[qw.ID] = true
+	}
+	for _, bl := range result.BlockersToClear {
+		l.blockers[bl.ID] = true
+	}
+	return l
+}
+
+// annotate copies the triage data of item's issue onto item.
+func (l triageLookups) annotate(item *IssueItem) {
+	id := item.Issue.ID
+	item.TriageScore = l.scores[id]
+	if reasons, exists := l.reasons[id]; exists {
+		item.TriageReason = reasons.Primary
+		item.TriageReasons = reasons.All
+	}
+	item.IsQuickWin = l.quickWins[id]
+	item.IsBlocker = l.blockers[id]
+	item.UnblocksCount = len(l.unblocks[id])
+}
+
 func buildListItems(issues []model.Issue, stats *analysis.GraphStats) []IssueItem {
 	listItems := make([]IssueItem, len(issues))
 	for i := range issues {
@@ -611,6 +623,7 @@ func resetIssueItemForSnapshot(item *IssueItem, issue model.Issue, stats *analys
 		item.Impact = 0
 	}
 	item.RepoPrefix = ExtractRepoPrefix(issue.ID)
+	item.cacheFilterValue()
 	item.DiffStatus = DiffStatusNone
 
 	item.SearchScore = 0
@@ -640,7 +653,7 @@ func issueMatchesRecipe(issue model.Issue, issueMap map[string]*model.Issue, r *
 	if len(r.Filters.Status) > 0 {
 		statusMatch := false
 		for _, s := range r.Filters.Status {
-			if matchesRecipeStatus(issue.Status, s) {
+			if beadsview.MatchesStatus(issue.Status, s) {
 				statusMatch = true
 				break
 			}
@@ -0,0 +1,554 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Sprint planning
+//
+// Estimates are the estimated_minutes of beads, set with ":estimate 3h",
+// which runs bd update. ":sprint new 2026-11-02 2026-11-13 60h Sprint 12"
+// defines a sprint with a date range and a capacity in .beads/sprints.jsonl,
+// next to the beads file, and ":sprint" opens the planning view: the backlog
+// (the open issues in the list that no sprint holds) beside the issues in
+// the sprint, under a meter of their estimates against the capacity. Moving
+// an issue across saves the sprint.
+
+// estimateDayMinutes is a day of work, as in the treemap and timeline.
+const estimateDayMinutes = 8 * 60
+
+// maxEstimateMinutes bounds estimates and capacities: a thousand days.
+const maxEstimateMinutes = 1000 * estimateDayMinutes
+
+// parseEstimate reads a duration such as "90m", "1.5h" or "2d" (days of
+// eight hours) as minutes. A bare number is minutes, as bd stores them.
+func parseEstimate(s string) (int, error) {
+	num, unit := s, 1.0
+	switch {
+	case strings.HasSuffix(s, "m"):
+		num = strings.TrimSuffix(s, "m")
+	case strings.HasSuffix(s, "h"):
+		num, unit = strings.TrimSuffix(s, "h"), 60
+	case strings.HasSuffix(s, "d"):
+		num, unit = strings.TrimSuffix(s, "d"), estimateDayMinutes
+	}
+	v, err := strconv.ParseFloat(num, 64)
+	minutes := math.Round(v * unit)
+	if err != nil || !(minutes >= 1) || minutes > maxEstimateMinutes {
+		return 0, fmt.Errorf("expected a duration like 90m, 3h or 2d, got %q", s)
+	}
+	return int(minutes), nil
+}
+
+// formatHours shows minutes as hours, to a tenth.
+func formatHours(minutes int) string {
+	return strconv.FormatFloat(math.Round(float64(minutes)/6)/10, 'f', -1, 64) + "h"
+}
+
+// issueEstimate is the estimate of issue in minutes; 0 when it has none.
+func issueEstimate(issue *model.Issue) int {
+	if issue.EstimatedMinutes == nil || *issue.EstimatedMinutes <= 0 {
+		return 0
+	}
+	return *issue.EstimatedMinutes
+}
+
+// validateEstimateArgs checks "estimate <duration> [issue-id]".
+func validateEstimateArgs(args []string) error {
+	if len(args) == 0 || len(args) > 2 {
+		return fmt.Errorf("expected a duration and an optional issue ID")
+	}
+	_, err := parseEstimate(args[0])
+	return err
+}
+
+// estimateCommand sets the estimate of the issue args name, or of the
+// selection, with bd.
+func (m Model) estimateCommand(args []string) (Model, tea.Cmd, error) {
+	minutes, err := parseEstimate(args[0])
+	if err != nil {
+		return m, nil, err
+	}
+	var id string
+	if len(args) == 2 {
+		if _, ok := m.issueMap[args[1]]; !ok {
+			return m, nil, fmt.Errorf("unknown issue %q", args[1])
+		}
+		id = args[1]
+	} else if target := m.actionTarget(); target != nil {
+		id = target.ID
+	} else {
+		return m, nil, fmt.Errorf("no issue selected")
+	}
+	if m.readOnly {
+		return m, nil, fmt.Errorf("read-only session: cannot set estimates")
+	}
+	if !bdAvailable() {
+		return m, nil, fmt.Errorf("bd not found on PATH: estimates are set with bd update")
+	}
+	summary := i18n.Tf("Estimated %s at %s", id, formatEstimate(minutes))
+	return m, m.runBDCmd(summary, "update", id, fmt.Sprintf("--estimate=%d", minutes)), nil
+}
+
+// parseSprintDates reads the first and last day of a sprint. The sprint
+// ends at the end of its last day.
+func parseSprintDates(first, last string) (start, end time.Time, err error) {
+	start, err = time.ParseInLocation("2006-01-02", first, time.Local)
+	if err != nil {
+		return start, end, fmt.Errorf("start date: expected YYYY-MM-DD, got %q", first)
+	}
+	lastDay, err := time.ParseInLocation("2006-01-02", last, time.Local)
+	if err != nil {
+		return start, end, fmt.Errorf("end date: expected YYYY-MM-DD, got %q", last)
+	}
+	if lastDay.Before(start) {
+		return start, end, fmt.Errorf("the sprint ends (%s) before it starts (%s)", last, first)
+	}
+	return start, lastDay.AddDate(0, 0, 1).Add(-time.Second), nil
+}
+
+// validateSprintArgs checks the forms of the sprint command:
+// "sprint [sprint-id]", "sprint new <start> <end> <capacity> [name]" and
+// "sprint capacity <capacity>".
+func validateSprintArgs(args []string) error {
+	if len(args) == 0 {
+		return nil
+	}
+	switch args[0] {
+	case "new":
+		if len(args) < 4 {
+			return fmt.Errorf("expected new <start> <end> <capacity> [name]")
+		}
+		if _, _, err := parseSprintDates(args[1], args[2]); err != nil {
+			return err
+		}
+		_, err := parseEstimate(args[3])
+		return err
+	case "capacity":
+		if len(args) != 2 {
+			return fmt.Errorf("expected capacity <duration>")
+		}
+		_, err := parseEstimate(args[1])
+		return err
+	}
+	if len(args) != 1 {
+		return fmt.Errorf("expected a sprint ID, new or capacity")
+	}
+	return nil
+}
+
+// sprintCommand runs the sprint command; see validateSprintArgs.
+func (m Model) sprintCommand(args []string) (Model, tea.Cmd, error) {
+	if len(args) > 0 {
+		switch args[0] {
+		case "new":
+			return m.newSprint(args[1], args[2], args[3], strings.Join(args[4:], " "))
+		case "capacity":
+			return m.setSprintCapacity(args[1])
+		}
+	}
+	i := currentSprintIndex(m.sprints, clockNow(m.now))
+	if len(args) == 1 {
+		i = slices.IndexFunc(m.sprints, func(s model.Sprint) bool { return s.ID == args[0] })
+		if i < 0 {
+			return m, nil, fmt.Errorf("unknown sprint %q", args[0])
+		}
+	}
+	if i < 0 {
+		return m, nil, fmt.Errorf("no sprints: define one with sprint new <start> <end> <capacity> [name]")
+	}
+	m.openSprintPlan(m.sprints[i].ID)
+	return m, nil, nil
+}
+
+// sprintIDs returns the IDs of the sprints, in file order.
+func (m Model) sprintIDs() []string {
+	ids := make([]string, len(m.sprints))
+	for i, s := range m.sprints {
+		ids[i] = s.ID
+	}
+	return ids
+}
+
+// currentSprintIndex picks the sprint to plan: the one running at now, else
+// the next to start, else the last defined. It is -1 when there are none.
+func currentSprintIndex(sprints []model.Sprint, now time.Time) int {
+	next := -1
+	for i, s := range sprints {
+		if s.StartDate.IsZero() {
+			continue
+		}
+		if !s.StartDate.After(now) && (s.EndDate.IsZero() || !s.EndDate.Before(now)) {
+			return i
+		}
+		if s.StartDate.After(now) && (next < 0 || s.StartDate.Before(sprints[next].StartDate)) {
+			next = i
+		}
+	}
+	if next >= 0 {
+		return next
+	}
+	return len(sprints) - 1
+}
+
+// newSprint adds a sprint, named name or after its ID, and plans it.
+func (m Model) newSprint(first, last, capacity, name string) (Model, tea.Cmd, error) {
+	start, end, err := parseSprintDates(first, last)
+	if err != nil {
+		return m, nil, err
+	}
+	minutes, err := parseEstimate(capacity)
+	if err != nil {
+		return m, nil, err
+	}
+	id := ""
+	for n := len(m.sprints) + 1; id == ""; n++ {
+		candidate := fmt.Sprintf("sprint-%d", n)
+		if !slices.ContainsFunc(m.sprints, func(s model.Sprint) bool { return s.ID == candidate }) {
+			id = candidate
+		}
+	}
+	if name == "" {
+		name = id
+	}
+	now := clockNow(m.now)
+	sprints := append(slices.Clone(m.sprints), model.Sprint{
+		ID:              id,
+		Name:            name,
+		StartDate:       start,
+		EndDate:         end,
+		CapacityMinutes: minutes,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	})
+	if err := m.saveSprints(sprints); err != nil {
+		return m, nil, err
+	}
+	m.openSprintPlan(id)
+	m.statusMsg = i18n.Tf("Created %s (%s → %s, %s)", name, start.Format("Jan 2"), end.Format("Jan 2"), formatHours(minutes))
+	m.statusIsError = false
+	return m, nil, nil
+}
+
+// setSprintCapacity sets the capacity of the sprint being planned, or of
+// the current one.
+func (m Model) setSprintCapacity(capacity string) (Model, tea.Cmd, error) {
+	minutes, err := parseEstimate(capacity)
+	if err != nil {
+		return m, nil, err
+	}
+	i := m.sprintPlanIndex()
+	if i < 0 {
+		i = currentSprintIndex(m.sprints, clockNow(m.now))
+	}
+	if i < 0 {
+		return m, nil, fmt.Errorf("no sprints: define one with sprint new <start> <end> <capacity> [name]")
+	}
+	sprints := slices.Clone(m.sprints)
+	sprints[i].CapacityMinutes = minutes
+	sprints[i].UpdatedAt = clockNow(m.now)
+	if err := m.saveSprints(sprints); err != nil {
+		return m, nil, err
+	}
+	m.statusMsg = i18n.Tf("%s capacity: %s", sprints[i].Name, formatHours(minutes))
+	m.statusIsError = false
+	return m, nil, nil
+}
+
+// saveSprints writes sprints to the sprints file beside the beads file and
+// makes them the model's.
+func (m *Model) saveSprints(sprints []model.Sprint) error {
+	if m.readOnly {
+		return errors.New("read-only session: cannot change sprints")
+	}
+	if m.beadsPath == "" {
+		return errors.New("sprints are kept beside a single beads file, so not in workspace mode")
+	}
+	path := filepath.Join(filepath.Dir(m.beadsPath), loader.SprintsFileName)
+	if err := loader.SaveSprintsToFile(path, sprints); err != nil {
+		return fmt.Errorf("save sprints: %w", err)
+	}
+	m.sprints = sprints
+	if m.selectedSprint != nil {
+		// Point the dashboard at the new copy of its sprint.
+		id := m.selectedSprint.ID
+		m.selectedSprint = nil
+		for i := range m.sprints {
+			if m.sprints[i].ID == id {
+				m.selectedSprint = &m.sprints[i]
+			}
+		}
+	}
+	return nil
+}
+
+func (m *Model) openSprintPlan(id string) {
+	m.showSprintPlan = true
+	m.sprintPlanID = id
+	m.sprintPlanCursor = 0
+	m.sprintPlanInSprint = false
+}
+
+// sprintPlanIndex is the index in m.sprints of the sprint being planned;
+// -1 when the view is closed or the sprint is gone.
+func (m Model) sprintPlanIndex() int {
+	if !m.showSprintPlan {
+		return -1
+	}
+	return slices.IndexFunc(m.sprints, func(s model.Sprint) bool { return s.ID == m.sprintPlanID })
+}
+
+// sprintPlanColumns returns the backlog, in list order, and the issues in
+// sprint, in the order they were added.
+func (m Model) sprintPlanColumns(sprint model.Sprint) (backlog, planned []model.Issue) {
+	taken := make(map[string]bool)
+	for _, s := range m.sprints {
+		for _, id := range s.BeadIDs {
+			taken[id] = true
+		}
+	}
+	for _, it := range m.list.Items() {
+		item, ok := it.(IssueItem)
+		if ok && !taken[item.Issue.ID] && !isClosedLikeStatus(item.Issue.Status) {
+			backlog = append(backlog, item.Issue)
+		}
+	}
+	for _, id := range sprint.BeadIDs {
+		if issue, ok := m.issueMap[id]; ok {
+			planned = append(planned, *issue)
+		}
+	}
+	return backlog, planned
+}
+
+// sprintPlanSelection is the issue under the cursor of the planning view.
+func (m Model) sprintPlanSelection() *model.Issue {
+	i := m.sprintPlanIndex()
+	if i < 0 {
+		return nil
+	}
+	backlog, planned := m.sprintPlanColumns(m.sprints[i])
+	column := backlog
+	if m.sprintPlanInSprint {
+		column = planned
+	}
+	if m.sprintPlanCursor >= len(column) {
+		return nil
+	}
+	issue := column[m.sprintPlanCursor]
+	return &issue
+}
+
+// handleSprintPlanKeys moves through the columns; enter moves the issue
+// under the cursor into or out of the sprint.
+func (m Model) handleSprintPlanKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	i := m.sprintPlanIndex()
+	if i < 0 {
+		m.showSprintPlan = false
+		return m, nil
+	}
+	backlog, planned := m.sprintPlanColumns(m.sprints[i])
+	column := backlog
+	if m.sprintPlanInSprint {
+		column = planned
+	}
+	switch msg.String() {
+	case "esc", "q":
+		m.showSprintPlan = false
+	case "j", "down":
+		if m.sprintPlanCursor < len(column)-1 {
+			m.sprintPlanCursor++
+		}
+	case "k", "up":
+		if m.sprintPlanCursor > 0 {
+			m.sprintPlanCursor--
+		}
+	case "tab", "h", "l", "left", "right":
+		m.sprintPlanInSprint = !m.sprintPlanInSprint
+		other := planned
+		if !m.sprintPlanInSprint {
+			other = backlog
+		}
+		m.sprintPlanCursor = max(min(m.sprintPlanCursor, len(other)-1), 0)
+	case "enter", " ":
+		if m.sprintPlanCursor < len(column) {
+			m.moveSprintIssue(i, column[m.sprintPlanCursor].ID)
+			m.sprintPlanCursor = max(min(m.sprintPlanCursor, len(column)-2), 0)
+		}
+	case "e", "c":
+		m.openCommandPrompt()
+		if msg.String() == "e" {
+			m.commandInput.SetValue("estimate ")
+		} else {
+			m.commandInput.SetValue("sprint capacity ")
+		}
+		m.commandInput.CursorEnd()
+	case "[", "]":
+		step := 1
+		if msg.String() == "[" {
+			step = -1
+		}
+		next := (i + step + len(m.sprints)) % len(m.sprints)
+		m.openSprintPlan(m.sprints[next].ID)
+	}
+	return m, nil
+}
+
+// moveSprintIssue adds id to the sprint at index i, or takes it out, and
+// saves the sprints.
+func (m *Model) moveSprintIssue(i int, id string) {
+	sprints := slices.Clone(m.sprints)
+	s := &sprints[i]
+	verb := i18n.T("Added %s to %s")
+	if j := slices.Index(s.BeadIDs, id); j >= 0 {
+		s.BeadIDs = slices.Delete(slices.Clone(s.BeadIDs), j, j+1)
+		verb = i18n.T("Took %s out of %s")
+	} else {
+		s.BeadIDs = append(slices.Clone(s.BeadIDs), id)
+	}
+	s.UpdatedAt = clockNow(m.now)
+	if err := m.saveSprints(sprints); err != nil {
+		m.reportError(newError(errData, "plan sprint", err))
+		return
+	}
+	m.statusMsg = fmt.Sprintf(verb, id, s.Name)
+	if used := m.sprintLoad(*s); s.CapacityMinutes > 0 && used > s.CapacityMinutes {
+		m.statusMsg += i18n.Tf(" (over capacity by %s)", formatHours(used-s.CapacityMinutes))
+	}
+	m.statusIsError = false
+}
+
+// sprintLoad is the estimated work in sprint, in minutes.
+func (m Model) sprintLoad(sprint model.Sprint) int {
+	total := 0
+	for _, id := range sprint.BeadIDs {
+		if issue, ok := m.issueMap[id]; ok {
+			total += issueEstimate(issue)
+		}
+	}
+	return total
+}
+
+func (m Model) renderSprintPlan() string {
+	t := m.theme
+	i := m.sprintPlanIndex()
+	if i < 0 {
+		return ""
+	}
+	sprint := m.sprints[i]
+	backlog, planned := m.sprintPlanColumns(sprint)
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2)
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	labelStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Bold(true)
+
+	width := max(min(m.width-10, 110), 50)
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render("📅 Sprint planning: "+sprint.Name) + " " +
+		mutedStyle.Render(fmt.Sprintf("%s → %s  (%d/%d)", sprint.StartDate.Format("Jan 2"), sprint.EndDate.Format("Jan 2"), i+1, len(m.sprints))) + "\n\n")
+
+	used, unestimated := 0, 0
+	for j := range planned {
+		used += issueEstimate(&planned[j])
+		if issueEstimate(&planned[j]) == 0 && !isClosedLikeStatus(planned[j].Status) {
+			unestimated++
+		}
+	}
+	sb.WriteString(labelStyle.Render(i18n.T("Capacity")+"  ") + m.renderCapacityMeter(used, sprint.CapacityMinutes, width-40) + "\n")
+	if unestimated > 0 {
+		sb.WriteString(mutedStyle.Render(fmt.Sprintf("          %d issues in the sprint have no estimate (e to set)", unestimated)) + "\n")
+	}
+	sb.WriteString("\n")
+
+	columnWidth := (width - 3) / 2
+	rows := max(m.height-16, 3)
+	left := m.renderSprintPlanColumn(fmt.Sprintf("Backlog (%d)", len(backlog)), backlog, !m.sprintPlanInSprint, columnWidth, rows)
+	right := m.renderSprintPlanColumn(fmt.Sprintf("Sprint (%d)", len(planned)), planned, m.sprintPlanInSprint, columnWidth, rows)
+	sb.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, left, "   ", right) + "\n")
+	sb.WriteString("\n" + mutedStyle.Render(i18n.T("j/k: move • tab: column • enter: move across • e: estimate • c: capacity • [ ]: sprint • esc: close")))
+
+	return lipgloss.Place(m.width, m.height-1, lipgloss.Center, lipgloss.Center, boxStyle.Render(sb.String()))
+}
+
+// renderCapacityMeter shows used against capacity as a bar that turns
+// yellow near the capacity and red past it.
+func (m Model) renderCapacityMeter(used, capacity, barWidth int) string {
+	t := m.theme
+	if capacity <= 0 {
+		return fmt.Sprintf("%s planned, no capacity set (c to set)", formatHours(used))
+	}
+	ratio := float64(used) / float64(capacity)
+	color := t.Open
+	switch {
+	case ratio > 1:
+		color = t.Blocked
+	case ratio > 0.85:
+		color = t.Feature
+	}
+	barWidth = max(barWidth, 10)
+	filled := min(int(ratio*float64(barWidth)+0.5), barWidth)
+	bar := t.Renderer.NewStyle().Foreground(color).Render(strings.Repeat("█", filled)) +
+		t.Renderer.NewStyle().Foreground(t.Muted).Render(strings.Repeat("░", barWidth-filled))
+	return fmt.Sprintf("%s %s of %s (%.0f%%)", bar, formatHours(used), formatHours(capacity), ratio*100)
+}
+
+// renderSprintPlanColumn renders a column of issues with their estimates;
+// the active one shows the cursor, scrolled into view.
+func (m Model) renderSprintPlanColumn(title string, issues []model.Issue, active bool, width, rows int) string {
+	t := m.theme
+	headerStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Bold(true)
+	if active {
+		headerStyle = headerStyle.Underline(true)
+	}
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	textStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+	selectedStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+
+	lines := []string{headerStyle.Render(title)}
+	if len(issues) == 0 {
+		lines = append(lines, mutedStyle.Render(i18n.T("(none)")))
+	}
+	start := 0
+	if active && m.sprintPlanCursor >= rows {
+		start = m.sprintPlanCursor - rows + 1
+	}
+	for j := start; j < len(issues) && j < start+rows; j++ {
+		issue := issues[j]
+		estimate := "–"
+		if minutes := issueEstimate(&issue); minutes > 0 {
+			estimate = formatEstimate(minutes)
+		}
+		text := truncateRunesHelper(issue.ID+" "+issue.Title, width-8, "…")
+		line := fmt.Sprintf("  %-*s %4s", width-8, text, estimate)
+		switch {
+		case active && j == m.sprintPlanCursor:
+			line = selectedStyle.Render("▸ " + line[2:])
+		case isClosedLikeStatus(issue.Status):
+			line = mutedStyle.Render(line)
+		default:
+			line = textStyle.Render(line)
+		}
+		lines = append(lines, line)
+	}
+	if hidden := len(issues) - start - rows; hidden > 0 {
+		lines = append(lines, mutedStyle.Render(fmt.Sprintf("  … +%d more", hidden)))
+	}
+	return lipgloss.NewStyle().Width(width).Render(strings.Join(lines, "\n"))
+}
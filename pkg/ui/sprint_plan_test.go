@@ -0,0 +1,132 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestParseEstimate(t *testing.T) {
+	for s, want := range map[string]int{"90": 90, "45m": 45, "1.5h": 90, "2d": 960, "0.5m": 1} {
+		if got, err := parseEstimate(s); err != nil || got != want {
+			t.Errorf("parseEstimate(%q) = %d, %v; want %d", s, got, err, want)
+		}
+	}
+	for _, s := range []string{"", "h", "0", "-3h", "3w", "NaNh", "Infd", "1001d"} {
+		if _, err := parseEstimate(s); err == nil {
+			t.Errorf("parseEstimate(%q): expected an error", s)
+		}
+	}
+	if got := formatHours(90) + " " + formatHours(20) + " " + formatHours(600); got != "1.5h 0.3h 10h" {
+		t.Errorf("unexpected hours %q", got)
+	}
+}
+
+func sprintTestModel(t *testing.T) Model {
+	t.Helper()
+	three, two := 180, 120
+	m := commandTestModel(t, func(issues []model.Issue) []model.Issue {
+		issues[0].EstimatedMinutes, issues[1].EstimatedMinutes = &three, &two
+		return issues
+	})
+	m.beadsPath = filepath.Join(t.TempDir(), "issues.jsonl")
+	m.now = func() time.Time { return time.Date(2026, 10, 15, 12, 0, 0, 0, time.Local) }
+	return m
+}
+
+func TestSprintPlanning(t *testing.T) {
+	m := sprintTestModel(t)
+
+	m = typeCommand(m, "sprint")
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "no sprints") {
+		t.Fatalf("expected planning without sprints to fail, got %q", m.statusMsg)
+	}
+	m = typeCommand(m, "sprint new 2026-10-12 2026-10-23 4h Sprint One")
+	if m.statusIsError || m.CurrentContext() != ContextSprintPlan {
+		t.Fatalf("expected the new sprint to open for planning, got %s: %q", m.CurrentContext(), m.statusMsg)
+	}
+	if view := m.View(); !strings.Contains(view, "Sprint planning: Sprint One") || !strings.Contains(view, "Backlog (2)") {
+		t.Errorf("expected the sprint and the open issues in the backlog:\n%s", view)
+	}
+
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if view := m.View(); !strings.Contains(view, "2h of 4h (50%)") {
+		t.Errorf("expected A-2 to fill the meter:\n%s", view)
+	}
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if !strings.Contains(m.statusMsg, "over capacity by 1h") {
+		t.Errorf("expected an over-capacity note, got %q", m.statusMsg)
+	}
+
+	saved, err := loader.LoadSprintsFromFile(filepath.Join(filepath.Dir(m.beadsPath), loader.SprintsFileName))
+	if err != nil || len(saved) != 1 {
+		t.Fatalf("expected one saved sprint, got %v, %v", saved, err)
+	}
+	s := saved[0]
+	if s.ID != "sprint-1" || strings.Join(s.BeadIDs, ",") != "A-2,A-1" || s.CapacityMinutes != 240 || s.EndDate.Day() != 23 {
+		t.Errorf("unexpected saved sprint %+v", s)
+	}
+
+	// In the sprint column, enter takes an issue out again.
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyTab})
+	if target := m.actionTarget(); target == nil || target.ID != "A-2" {
+		t.Fatalf("expected A-2 under the cursor, got %v", target)
+	}
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if got := strings.Join(m.sprints[0].BeadIDs, ","); got != "A-1" {
+		t.Errorf("expected A-2 taken out, sprint holds %q", got)
+	}
+
+	// c opens the prompt at "sprint capacity ".
+	for _, key := range []tea.KeyMsg{runeKey("c"), runeKey("6"), runeKey("h"), {Type: tea.KeyEnter}} {
+		m = pressKey(m, key)
+	}
+	if m.sprints[0].CapacityMinutes != 360 || !m.showSprintPlan {
+		t.Errorf("expected the capacity set from the planning view, got %d (%q)", m.sprints[0].CapacityMinutes, m.statusMsg)
+	}
+}
+
+func TestEstimateCommand(t *testing.T) {
+	m := sprintTestModel(t)
+
+	withBD(t, false)
+	m = typeCommand(m, "estimate 3h A-1")
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "bd not found") {
+		t.Errorf("expected estimates to need bd, got %q", m.statusMsg)
+	}
+
+	withBD(t, true)
+	if _, cmd, err := m.ExecCommand("estimate 3h A-9"); err == nil || cmd != nil {
+		t.Errorf("expected an unknown issue to fail, got %v", err)
+	}
+	if _, cmd, err := m.ExecCommand("estimate 1.5h"); err != nil || cmd == nil {
+		t.Errorf("expected a bd update for the selection, got %v", err)
+	}
+	if err := ValidateCommand("estimate soon", nil); err == nil {
+		t.Error("expected a bad duration to be rejected")
+	}
+}
+
+func TestCurrentSprintIndex(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 10, d, 0, 0, 0, 0, time.Local) }
+	m := sprintTestModel(t)
+	for _, line := range []string{"sprint new 2026-10-01 2026-10-09 10h", "sprint new 2026-10-26 2026-11-06 10h", "sprint new 2026-10-19 2026-10-23 10h"} {
+		var err error
+		if m, _, err = m.ExecCommand(line); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for d, want := range map[int]int{5: 0, 12: 2, 21: 2, 30: 1} {
+		if got := currentSprintIndex(m.sprints, day(d)); got != want {
+			t.Errorf("on day %d: got sprint %d, want %d", d, got, want)
+		}
+	}
+	if currentSprintIndex(nil, day(1)) != -1 {
+		t.Error("expected no sprint without sprints")
+	}
+}
@@ -3,8 +3,8 @@ package ui
 import (
 	"fmt"
 	"strings"
-	"time"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -31,7 +31,7 @@ func (m Model) renderSprintDashboard() string {
 	sb.WriteString("\n\n")
 
 	// Date range and days remaining
-	now := time.Now()
+	now := clockNow(m.now)
 	labelStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Bold(true)
 	valStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
 
@@ -60,7 +60,7 @@ func (m Model) renderSprintDashboard() string {
 	dateInfo := fmt.Sprintf("%s → %s",
 		sprint.StartDate.Format("Jan 2"),
 		sprint.EndDate.Format("Jan 2"))
-	sb.WriteString(labelStyle.Render("Dates:    "))
+	sb.WriteString(labelStyle.Render(i18n.T("Dates:") + "    "))
 	sb.WriteString(valStyle.Render(dateInfo))
 	sb.WriteString("\n")
 
@@ -71,7 +71,7 @@ func (m Model) renderSprintDashboard() string {
 	} else if daysRemaining == 0 {
 		daysStyle = t.Renderer.NewStyle().Foreground(t.Blocked) // Critical
 	}
-	sb.WriteString(labelStyle.Render("Remaining:"))
+	sb.WriteString(labelStyle.Render(i18n.T("Remaining:")))
 	sb.WriteString(daysStyle.Render(fmt.Sprintf(" %d days", daysRemaining)))
 	sb.WriteString("\n\n")
 
@@ -101,7 +101,7 @@ func (m Model) renderSprintDashboard() string {
 	}
 
 	// Progress bar
-	sb.WriteString(labelStyle.Render("Progress: "))
+	sb.WriteString(labelStyle.Render(i18n.T("Progress:") + " "))
 	progressPct := 0.0
 	if totalBeads > 0 {
 		progressPct = float64(closedBeads) / float64(totalBeads)
@@ -121,7 +121,7 @@ func (m Model) renderSprintDashboard() string {
 	sb.WriteString(fmt.Sprintf(" %d/%d (%.0f%%)\n", closedBeads, totalBeads, progressPct*100))
 
 	// Status breakdown
-	sb.WriteString(labelStyle.Render("Status:   "))
+	sb.WriteString(labelStyle.Render(i18n.T("Status:") + "   "))
 	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Open).Render(fmt.Sprintf("✓%d ", closedBeads)))
 	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Feature).Render(fmt.Sprintf("⏳%d ", inProgressBeads)))
 	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Blocked).Render(fmt.Sprintf("⛔%d ", blockedBeads)))
@@ -129,7 +129,7 @@ func (m Model) renderSprintDashboard() string {
 	sb.WriteString("\n\n")
 
 	// Simple burndown chart (ASCII)
-	sb.WriteString(labelStyle.Render("Burndown:"))
+	sb.WriteString(labelStyle.Render(i18n.T("Burndown:")))
 	sb.WriteString("\n")
 	if sprintDuration > 0 && totalBeads > 0 {
 		// Ideal line: from totalBeads to 0 over sprintDuration days
@@ -164,15 +164,15 @@ func (m Model) renderSprintDashboard() string {
 		sb.WriteString("  ")
 		sb.WriteString(strings.Repeat("─", chartWidth+1))
 		sb.WriteString("\n")
-		sb.WriteString(t.Renderer.NewStyle().Foreground(t.Muted).Italic(true).Render("  · ideal  ● actual"))
+		sb.WriteString(t.Renderer.NewStyle().Foreground(t.Muted).Italic(true).Render("  " + i18n.T("· ideal  ● actual")))
 		sb.WriteString("\n\n")
 	} else {
-		sb.WriteString(valStyle.Render("  (insufficient data)"))
+		sb.WriteString(valStyle.Render("  " + i18n.T("(insufficient data)")))
 		sb.WriteString("\n\n")
 	}
 
 	// At-risk items (in_progress for more than X days without update)
-	sb.WriteString(labelStyle.Render("At Risk:"))
+	sb.WriteString(labelStyle.Render(i18n.T("At Risk:")))
 	sb.WriteString("\n")
 	const staleThresholdDays = 3
 	var atRisk []model.Issue
@@ -185,7 +185,7 @@ func (m Model) renderSprintDashboard() string {
 		}
 	}
 	if len(atRisk) == 0 {
-		sb.WriteString(t.Renderer.NewStyle().Foreground(t.Open).Render("  ✓ No at-risk items"))
+		sb.WriteString(t.Renderer.NewStyle().Foreground(t.Open).Render("  " + i18n.T("✓ No at-risk items")))
 		sb.WriteString("\n")
 	} else {
 		for i, iss := range atRisk {
@@ -196,13 +196,13 @@ func (m Model) renderSprintDashboard() string {
 			}
 			daysSinceUpdate := int(now.Sub(iss.UpdatedAt).Hours() / 24)
 			sb.WriteString(t.Renderer.NewStyle().Foreground(t.Feature).Render(
-				fmt.Sprintf("  ⚠ %s - %s (%dd stale)\n", iss.ID, truncateStrSprint(iss.Title, 30), daysSinceUpdate)))
+				fmt.Sprintf("  ⚠ %s - %s (%dd stale)\n", iss.ID, truncateString(iss.Title, 30), daysSinceUpdate)))
 		}
 	}
 	sb.WriteString("\n")
 
 	// Sprint beads list (abbreviated)
-	sb.WriteString(labelStyle.Render("Beads in Sprint:"))
+	sb.WriteString(labelStyle.Render(i18n.T("Beads in Sprint:")))
 	sb.WriteString("\n")
 	displayLimit := min(10, len(sprintIssues))
 	for i := 0; i < displayLimit; i++ {
@@ -222,7 +222,7 @@ func (m Model) renderSprintDashboard() string {
 				statusStyle = t.Renderer.NewStyle().Foreground(t.Blocked)
 			}
 		}
-		sb.WriteString(statusStyle.Render(fmt.Sprintf("  %s %s - %s\n", statusIcon, iss.ID, truncateStrSprint(iss.Title, 40))))
+		sb.WriteString(statusStyle.Render(fmt.Sprintf("  %s %s - %s\n", statusIcon, iss.ID, truncateString(iss.Title, 40))))
 	}
 	if len(sprintIssues) > displayLimit {
 		sb.WriteString(valStyle.Render(fmt.Sprintf("  … +%d more", len(sprintIssues)-displayLimit)))
@@ -232,7 +232,7 @@ func (m Model) renderSprintDashboard() string {
 	// Footer
 	sb.WriteString("\n")
 	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Muted).Italic(true).Render(
-		"P: close sprint view • j/k: navigate sprints"))
+		i18n.T("P: close sprint view • j/k: navigate sprints")))
 
 	// Wrap in a box
 	boxStyle := t.Renderer.NewStyle().
@@ -251,19 +251,6 @@ func (m Model) renderSprintDashboard() string {
 	)
 }
 
-// truncateStrSprint truncates a string to maxLen runes, adding ellipsis if needed.
-// Uses rune-based counting to safely handle UTF-8 multi-byte characters.
-func truncateStrSprint(s string, maxLen int) string {
-	runes := []rune(s)
-	if len(runes) <= maxLen {
-		return s
-	}
-	if maxLen <= 3 {
-		return string(runes[:maxLen])
-	}
-	return string(runes[:maxLen-1]) + "…"
-}
-
 // handleSprintKeys handles keyboard input when in sprint view (bv-161)
 func (m Model) handleSprintKeys(msg tea.KeyMsg) Model {
 	switch msg.String() {
@@ -57,89 +57,6 @@ func TestHandleSprintKeys_NextPrevSprint(t *testing.T) {
 	}
 }
 
-// =============================================================================
-// truncateStrSprint Tests
-// =============================================================================
-
-func TestTruncateStrSprint(t *testing.T) {
-	tests := []struct {
-		name     string
-		input    string
-		maxLen   int
-		expected string
-	}{
-		{
-			name:     "short string unchanged",
-			input:    "hello",
-			maxLen:   10,
-			expected: "hello",
-		},
-		{
-			name:     "exact length unchanged",
-			input:    "hello",
-			maxLen:   5,
-			expected: "hello",
-		},
-		{
-			name:     "long string truncated",
-			input:    "hello world",
-			maxLen:   8,
-			expected: "hello w…",
-		},
-		{
-			name:     "maxLen 3 no ellipsis",
-			input:    "hello",
-			maxLen:   3,
-			expected: "hel",
-		},
-		{
-			name:     "maxLen 2 no ellipsis",
-			input:    "hello",
-			maxLen:   2,
-			expected: "he",
-		},
-		{
-			name:     "maxLen 1 no ellipsis",
-			input:    "hello",
-			maxLen:   1,
-			expected: "h",
-		},
-		{
-			name:     "empty string",
-			input:    "",
-			maxLen:   10,
-			expected: "",
-		},
-		{
-			name:     "maxLen 0",
-			input:    "hello",
-			maxLen:   0,
-			expected: "",
-		},
-		{
-			name:     "unicode string truncation",
-			input:    "日本語テスト",
-			maxLen:   4,
-			expected: "日本語…",
-		},
-		{
-			name:     "mixed unicode",
-			input:    "hello世界",
-			maxLen:   6,
-			expected: "hello…",
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := truncateStrSprint(tt.input, tt.maxLen)
-			if result != tt.expected {
-				t.Errorf("truncateStrSprint(%q, %d) = %q, want %q", tt.input, tt.maxLen, result, tt.expected)
-			}
-		})
-	}
-}
-
 // =============================================================================
 // renderSprintDashboard Tests
 // =============================================================================
@@ -0,0 +1,140 @@
+package ui
+
+import (
+	"sync"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/debug"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/drift"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Startup
+//
+// NewModel does only what the first frame needs: the sorted list, the
+// counts, the board and Phase 1 graph metrics. Everything else starts once
+// the TUI is up:
+//
+//   - triage annotations and drift alerts: startupStatsCmd, from Init
+//   - Phase 2 metrics, history and the update check: their own commands
+//   - the semantic search index: on the first switch to semantic search
+//   - hooks: read from .bv/hooks.yaml each time they fire
+//
+// With --verbose, steps before the TUI starts are logged as they finish.
+// The TUI owns the terminal afterwards, so later steps, up to the first
+// paint and the deferred stats, are recorded and logged when it exits.
+
+// startupStatsMsg carries the stats startupStatsCmd computed.
+type startupStatsMsg struct {
+	stats  *analysis.GraphStats // Analysis the stats come from
+	phase2 bool                 // Whether Phase 2 was complete when the alerts were computed
+	triage triageLookups
+
+	alerts                  []drift.Alert
+	critical, warning, info int
+}
+
+// startupStatsCmd computes the triage annotations and drift alerts that
+// NewModel leaves out.
+func startupStatsCmd(analyzer *analysis.Analyzer, stats *analysis.GraphStats, issues []model.Issue, now time.Time, trace *startupTrace) tea.Cmd {
+	return func() tea.Msg {
+		return computeStartupStats(analyzer, stats, issues, now, trace)
+	}
+}
+
+func computeStartupStats(analyzer *analysis.Analyzer, stats *analysis.GraphStats, issues []model.Issue, now time.Time, trace *startupTrace) startupStatsMsg {
+	start := time.Now()
+	msg := startupStatsMsg{stats: stats}
+	msg.triage = newTriageLookups(analysis.ComputeTriageFromAnalyzer(analyzer, stats, issues, analysis.TriageOptions{}, now))
+	trace.record("triage", time.Since(start))
+
+	start = time.Now()
+	msg.phase2 = stats.IsPhase2Ready()
+	msg.alerts, msg.critical, msg.warning, msg.info = computeAlerts(issues, stats, analyzer)
+	trace.record("alerts", time.Since(start))
+	return msg
+}
+
+// applyStartupStats shows the stats of msg, unless the issues were reloaded
+// since. Alerts from before Phase 2 completed are dropped once it has: the
+// Phase2ReadyMsg handler recomputes them with cycles.
+func (m *Model) applyStartupStats(msg startupStatsMsg) {
+	if msg.stats != m.analysis {
+		return
+	}
+	if msg.phase2 || !m.analysis.IsPhase2Ready() {
+		m.alerts, m.alertsCritical, m.alertsWarning, m.alertsInfo = msg.alerts, msg.critical, msg.warning, msg.info
+	}
+	m.setTriage(msg.triage)
+}
+
+// setTriage replaces the triage data and refreshes the list items with it.
+func (m *Model) setTriage(triage triageLookups) {
+	m.triageScores, m.triageReasons, m.unblocksMap = triage.scores, triage.reasons, triage.unblocks
+	m.quickWinSet, m.blockerSet = triage.quickWins, triage.blockers
+	if m.activeRecipe != nil {
+		m.applyRecipe(m.activeRecipe)
+	} else {
+		m.applyFilter()
+	}
+}
+
+// startupTrace records how long the startup steps after NewModel took.
+// Its methods do nothing on a nil trace.
+type startupTrace struct {
+	mu      sync.Mutex
+	start   time.Time
+	painted bool
+	steps   []startupStep
+}
+
+type startupStep struct {
+	name string
+	took time.Duration
+}
+
+func (t *startupTrace) record(name string, took time.Duration) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.steps = append(t.steps, startupStep{name, took})
+}
+
+// paint records the time from the start of bv to the first frame.
+func (t *startupTrace) paint() {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.painted {
+		t.painted = true
+		t.steps = append(t.steps, startupStep{"first paint since start", time.Since(t.start)})
+	}
+}
+
+// TraceStartup records the startup steps of the TUI for LogStartup, when
+// debug logging is on. start is when bv started.
+func (m *Model) TraceStartup(start time.Time) {
+	if debug.Enabled() {
+		m.startup = &startupTrace{start: start}
+	}
+}
+
+// LogStartup writes the steps TraceStartup recorded to the debug log. Call
+// it once the TUI has exited.
+func (m Model) LogStartup() {
+	if m.startup == nil {
+		return
+	}
+	m.startup.mu.Lock()
+	defer m.startup.mu.Unlock()
+	for _, step := range m.startup.steps {
+		debug.LogTiming("startup: "+step.name, step.took)
+	}
+}
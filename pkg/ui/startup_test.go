@@ -0,0 +1,79 @@
+package ui
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/debug"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestStartupStats_AppliedAfterFirstPaint(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "S-1", Title: "Blocker", Status: model.StatusOpen, Priority: 1},
+		{ID: "S-2", Title: "Blocked", Status: model.StatusOpen, Priority: 2,
+			Dependencies: []*model.Dependency{{IssueID: "S-2", DependsOnID: "S-1", Type: model.DepBlocks}}},
+	}
+	m := NewModel(issues, nil, "")
+	if len(m.triageScores) != 0 {
+		t.Fatal("expected NewModel to leave triage to startupStatsCmd")
+	}
+
+	msg := startupStatsCmd(m.analyzer, m.analysis, m.issuesForAsync(), time.Now(), nil)()
+	stale := msg.(startupStatsMsg)
+	stale.stats = nil
+	newM, _ := m.Update(stale)
+	if len(newM.(Model).triageScores) != 0 {
+		t.Fatal("expected stats of other issues ignored")
+	}
+
+	newM, _ = m.Update(msg)
+	m = newM.(Model)
+	if m.triageScores["S-1"] == 0 || len(m.unblocksMap["S-1"]) != 1 {
+		t.Fatalf("expected triage for S-1, got score %v unblocks %v", m.triageScores["S-1"], m.unblocksMap["S-1"])
+	}
+	for _, it := range m.list.Items() {
+		if item := it.(IssueItem); item.Issue.ID == "S-1" && item.UnblocksCount != 1 {
+			t.Errorf("expected the list item annotated, got %+v", item)
+		}
+	}
+}
+
+func TestStartupTrace(t *testing.T) {
+	var nilTrace *startupTrace
+	nilTrace.record("x", time.Second)
+	nilTrace.paint()
+
+	was := debug.Enabled()
+	defer debug.SetEnabled(was)
+	debug.SetEnabled(false)
+	m := NewModel(nil, nil, "")
+	m.TraceStartup(time.Now())
+	if m.startup != nil {
+		t.Fatal("expected no trace without --verbose")
+	}
+
+	debug.SetEnabled(true)
+	m.TraceStartup(time.Now().Add(-time.Second))
+	m.View()
+	m.View()
+	if len(m.startup.steps) != 1 || m.startup.steps[0].took < time.Second {
+		t.Fatalf("expected the first paint recorded once, got %+v", m.startup.steps)
+	}
+}
+
+func BenchmarkNewModel(b *testing.B) {
+	issues := make([]model.Issue, 5000)
+	for i := range issues {
+		issues[i] = model.Issue{ID: fmt.Sprintf("B-%d", i), Title: "Issue", Status: model.StatusOpen, Priority: i % 5}
+		if i > 0 {
+			issues[i].Dependencies = []*model.Dependency{{IssueID: issues[i].ID, DependsOnID: issues[i-1].ID, Type: model.DepBlocks}}
+		}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m := NewModel(issues, nil, "")
+		m.Stop()
+	}
+}
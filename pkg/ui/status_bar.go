@@ -0,0 +1,182 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Status bar
+//
+// The footer is built from named segments, laid out in the order of
+// ui.status_bar the way a shell prompt is. A segment with nothing to report
+// renders nothing, so most of them only appear when they matter. "fill"
+// takes the width left over and pushes the segments after it to the right.
+// When the segments outgrow the terminal, the least useful ones are left
+// out, in the order of statusShedOrder, rather than wrapping the footer.
+
+// statusSegment describes one footer segment.
+type statusSegment struct {
+	name    string
+	summary string
+	always  bool // Rendered on every frame, rather than only when relevant
+}
+
+// statusSegments lists the segments in their default order.
+var statusSegments = []statusSegment{
+	{"readonly", "Read-only session", false},
+	{"filter", "Current filter or recipe", true},
+	{"search", "Search mode while searching", false},
+	{"sort", "Sort order, unless the default", false},
+	{"hints", "Hints for the current view", true},
+	{"alerts", "Active project health alerts", false},
+	{"instance", "Another bv instance is running", false},
+	{"sessions", "Coding sessions of the selected issue", false},
+	{"workspace", "Workspace summary", false},
+	{"repos", "Repo filter in workspace mode", false},
+	{"update", "A new bv version is available", false},
+	{"dataset", "Large dataset mode", false},
+	{"counts", "Open, ready, blocked and closed issues", true},
+	{"metrics", "Graph metrics still computing", false},
+	{"watcher", "Auto-refresh paused, or polling for changes", false},
+	{"freshness", "Background refresh, stale data and reload errors", false},
+	{"hooks", "Hooks and user commands running", false},
+	{"breadcrumbs", "Views visited, for Backspace and Ctrl+O", false},
+	{"presence", "Others connected to a shared serve-ssh session", false},
+	{"fill", "Pushes the segments after it to the right", true},
+	{"total", "Number of listed issues", true},
+	{"keys", "Key hints", true},
+}
+
+// statusShedOrder lists the segments a footer too wide for the terminal
+// leaves out, first to last. Keys are cut to the room left first, keeping
+// a macro recording or pending key sequence, which lead them. The rest
+// always stay: they say what is shown and whether it is current.
+var statusShedOrder = []string{
+	"keys", "breadcrumbs", "hints", "presence", "sessions", "workspace", "repos",
+	"sort", "metrics", "dataset", "instance", "update", "counts", "hooks",
+	"alerts", "watcher", "search", "total",
+}
+
+// compactStatusShed lists the segments compact layouts leave out from the
+// start (see layout.go).
+var compactStatusShed = []string{"breadcrumbs", "hints"}
+
+// minKeysWidth is the narrowest the keys segment is cut to before it is
+// left out.
+const minKeysWidth = 12
+
+// DefaultStatusBar returns the segment names of the default footer.
+func DefaultStatusBar() []string {
+	names := make([]string, len(statusSegments))
+	for i, s := range statusSegments {
+		names[i] = s.name
+	}
+	return names
+}
+
+// StatusSegmentUsage describes the status bar segments, one per line.
+func StatusSegmentUsage() string {
+	var sb strings.Builder
+	for _, s := range statusSegments {
+		fmt.Fprintf(&sb, "  %-11s %s\n", s.name, s.summary)
+	}
+	return sb.String()
+}
+
+// ValidateStatusBar checks a ui.status_bar layout: every segment must be
+// known and appear at most once.
+func ValidateStatusBar(segments []string) error {
+	seen := make(map[string]bool, len(segments))
+	for _, name := range segments {
+		if _, ok := findStatusSegment(name); !ok {
+			return fmt.Errorf("unknown status bar segment %q", name)
+		}
+		if seen[name] {
+			return fmt.Errorf("status bar segment %q listed twice", name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+// SetStatusBar sets the footer segments, in order. Nil or empty restores
+// the default. Check the layout with ValidateStatusBar first; unknown
+// segments are ignored.
+func (m *Model) SetStatusBar(segments []string) {
+	if len(segments) == 0 {
+		segments = nil
+	}
+	m.statusBar = segments
+}
+
+func findStatusSegment(name string) (statusSegment, bool) {
+	for _, s := range statusSegments {
+		if s.name == name {
+			return s, true
+		}
+	}
+	return statusSegment{}, false
+}
+
+// layoutStatusBar joins the rendered segments in the configured order. As
+// the footer always has, fill leaves a spare column per conditional segment
+// shown, plus one. Segments that do not fit are deleted from rendered.
+func (m *Model) layoutStatusBar(rendered map[string]string) string {
+	layout := m.statusBar
+	if layout == nil {
+		layout = DefaultStatusBar()
+	}
+
+	measure := func() (used, spare int) {
+		spare = 1
+		for _, name := range layout {
+			seg, ok := findStatusSegment(name)
+			if !ok || rendered[name] == "" {
+				continue
+			}
+			used += lipgloss.Width(rendered[name])
+			if !seg.always {
+				spare++
+			}
+		}
+		return used, spare
+	}
+	if m.layout.compact(m.width) {
+		for _, name := range compactStatusShed {
+			delete(rendered, name)
+		}
+	}
+	used, spare := measure()
+	for _, name := range statusShedOrder {
+		if m.width <= 0 || used+spare <= m.width {
+			break
+		}
+		if rendered[name] == "" {
+			continue
+		}
+		if room := m.width - spare - used + lipgloss.Width(rendered[name]); name == "keys" && room >= minKeysWidth {
+			rendered[name] = ansi.Truncate(rendered[name], room, "…")
+		} else {
+			delete(rendered, name)
+		}
+		used, spare = measure()
+	}
+	filler := lipgloss.NewStyle().Background(ColorBgDark).Width(max(m.width-used-spare, 0)).Render("")
+
+	var parts []string
+	for _, name := range layout {
+		if name == "fill" {
+			parts = append(parts, filler)
+		} else if r := rendered[name]; r != "" {
+			parts = append(parts, r)
+		}
+	}
+	bar := lipgloss.JoinHorizontal(lipgloss.Bottom, parts...)
+	if m.width > 0 && lipgloss.Width(bar) > m.width {
+		bar = ansi.Truncate(bar, m.width, "")
+	}
+	return bar
+}
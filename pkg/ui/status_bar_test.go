@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestValidateStatusBar(t *testing.T) {
+	if err := ValidateStatusBar(DefaultStatusBar()); err != nil {
+		t.Fatalf("default layout rejected: %v", err)
+	}
+	if err := ValidateStatusBar([]string{"counts", "clock"}); err == nil || !strings.Contains(err.Error(), "clock") {
+		t.Errorf("expected an unknown segment to be rejected, got %v", err)
+	}
+	if err := ValidateStatusBar([]string{"keys", "fill", "keys"}); err == nil {
+		t.Error("expected a repeated segment to be rejected")
+	}
+	if !strings.Contains(StatusSegmentUsage(), "hooks") {
+		t.Error("expected every segment in the usage")
+	}
+}
+
+func TestStatusBar_CustomSegments(t *testing.T) {
+	m := commandTestModel(t)
+	m.SetStatusBar([]string{"counts", "hooks", "fill", "total"})
+
+	footer := m.renderFooter()
+	if strings.Contains(footer, "ALL") || strings.Contains(footer, "refresh") {
+		t.Errorf("expected only the configured segments, got %q", footer)
+	}
+	if !strings.Contains(footer, "3 issues") || !strings.HasSuffix(strings.TrimSpace(footer), "3 issues") {
+		t.Errorf("expected the total pushed right, got %q", footer)
+	}
+	if w := lipgloss.Width(footer); w != m.width-1 {
+		t.Errorf("expected the fill to span the footer, got width %d of %d", w, m.width)
+	}
+
+	done := m.life.startHook()
+	if footer := m.renderFooter(); !strings.Contains(footer, "⚙ 1 hook") {
+		t.Errorf("expected the running hook, got %q", footer)
+	}
+	done()
+	if footer := m.renderFooter(); strings.Contains(footer, "hook") {
+		t.Errorf("expected the hook segment to go away, got %q", footer)
+	}
+
+	m.SetStatusBar(nil)
+	if footer := m.renderFooter(); !strings.Contains(footer, "ALL") {
+		t.Errorf("expected the default layout back, got %q", footer)
+	}
+}
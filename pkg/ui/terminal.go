@@ -0,0 +1,123 @@
+package ui
+
+import (
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/muesli/termenv"
+)
+
+// Terminal capabilities
+//
+// bv is written for modern emulators. Over SSH, inside multiplexers and on
+// consoles TERM often describes something older, and sending such a terminal
+// the full TUI leaves escape sequences on screen. The capabilities are
+// therefore worked out from TERM at startup and whatever the terminal lacks
+// is turned off. BV_TERM stands in for TERM, so the restricted profiles can
+// be tried from any terminal: BV_TERM=dumb bv.
+
+// Terminal is what bv may use on a terminal.
+type Terminal struct {
+	Term       string          // The TERM value the profile was derived from
+	Color      termenv.Profile // Richest color profile to use
+	AltScreen  bool            // Draw on the alternate screen
+	Mouse      bool            // Request mouse reporting
+	Hyperlinks bool            // Emit OSC 8 hyperlinks
+	Graphics   string          // Image protocol: "kitty", "iterm2", "sixel" or "" (see graphics.go)
+	Motion     bool            // Spinners and sub-second repaints are affordable
+	Kitty      bool            // May be probed for the kitty keyboard protocol
+	Plain      bool            // Only linear text works: use accessible output
+}
+
+// DetectTerminal returns the capabilities of the terminal bv runs in.
+func DetectTerminal() Terminal {
+	return detectTerminal(os.Getenv)
+}
+
+// TerminalFor returns the capabilities of a terminal identified by term, for
+// sessions whose TERM is not in bv's own environment (serve-ssh).
+func TerminalFor(term string) Terminal {
+	return detectTerminal(func(key string) string {
+		if key == "TERM" {
+			return term
+		}
+		return ""
+	})
+}
+
+func detectTerminal(getenv func(string) string) Terminal {
+	term := getenv("BV_TERM")
+	if term != "" {
+		// Simulating another terminal: what the real one supports is moot.
+		getenv = func(key string) string {
+			if key == "TERM" {
+				return term
+			}
+			return ""
+		}
+	} else {
+		term = getenv("TERM")
+	}
+
+	t := Terminal{
+		Term:       term,
+		Color:      termenv.TrueColor,
+		AltScreen:  true,
+		Mouse:      true,
+		Hyperlinks: hyperlinksSupported(getenv),
+		Graphics:   graphicsSupported(getenv),
+		Motion:     true,
+		Kitty:      true,
+	}
+	switch {
+	case term == "dumb":
+		// No cursor addressing: anything but appended lines is garbled.
+		t.Color = termenv.Ascii
+		t.AltScreen = false
+		t.Mouse = false
+		t.Motion = false
+		t.Kitty = false
+		t.Graphics = ""
+		t.Plain = true
+	case strings.HasPrefix(term, "vt"):
+		// DEC terminals and their emulations: monochrome, no alternate
+		// screen or mouse, often behind a slow line.
+		t.Color = termenv.Ascii
+		t.AltScreen = false
+		t.Mouse = false
+		t.Motion = false
+		t.Kitty = false
+		t.Graphics = ""
+	case strings.HasPrefix(term, "screen"), strings.HasPrefix(term, "tmux"), term == "linux":
+		// Multiplexers only pass on 256 colors when TERM says so; the
+		// Linux console has 8. Neither shows images.
+		t.Color = termenv.ANSI
+		t.Graphics = ""
+		if strings.Contains(term, "256color") {
+			t.Color = termenv.ANSI256
+		}
+	}
+	return t
+}
+
+// LimitColors lowers profile to what the terminal can show.
+func (t Terminal) LimitColors(profile termenv.Profile) termenv.Profile {
+	// termenv orders profiles from most to fewest colors.
+	if t.Color > profile {
+		return t.Color
+	}
+	return profile
+}
+
+// ProgramOptions are the Bubble Tea options the terminal supports.
+func (t Terminal) ProgramOptions() []tea.ProgramOption {
+	var opts []tea.ProgramOption
+	if t.AltScreen {
+		opts = append(opts, tea.WithAltScreen())
+	}
+	if t.Mouse {
+		opts = append(opts, tea.WithMouseCellMotion())
+	}
+	return opts
+}
@@ -0,0 +1,89 @@
+package ui
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func TestDetectTerminal(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want Terminal
+	}{
+		{"kitty", map[string]string{"TERM": "xterm-kitty"},
+			Terminal{Term: "xterm-kitty", Color: termenv.TrueColor, AltScreen: true, Mouse: true, Hyperlinks: true, Graphics: "kitty", Motion: true, Kitty: true}},
+		{"dumb", map[string]string{"TERM": "dumb"},
+			Terminal{Term: "dumb", Color: termenv.Ascii, Plain: true}},
+		{"vt100", map[string]string{"TERM": "vt100"},
+			Terminal{Term: "vt100", Color: termenv.Ascii}},
+		{"screen", map[string]string{"TERM": "screen"},
+			Terminal{Term: "screen", Color: termenv.ANSI, AltScreen: true, Mouse: true, Motion: true, Kitty: true}},
+		{"tmux 256", map[string]string{"TERM": "tmux-256color", "TMUX": "/tmp/tmux"},
+			Terminal{Term: "tmux-256color", Color: termenv.ANSI256, AltScreen: true, Mouse: true, Motion: true, Kitty: true}},
+		{"BV_TERM simulates", map[string]string{"TERM": "xterm-kitty", "KITTY_WINDOW_ID": "1", "BV_TERM": "screen-256color"},
+			Terminal{Term: "screen-256color", Color: termenv.ANSI256, AltScreen: true, Mouse: true, Motion: true, Kitty: true}},
+	}
+	for _, tt := range tests {
+		if got := detectTerminal(envFrom(tt.env)); got != tt.want {
+			t.Errorf("%s: got %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+
+	if got := TerminalFor("vt220"); got.AltScreen || got.Mouse || got.Color != termenv.Ascii {
+		t.Errorf("expected vt220 sessions degraded, got %+v", got)
+	}
+	if got := (Terminal{Color: termenv.ANSI}).LimitColors(termenv.TrueColor); got != termenv.ANSI {
+		t.Errorf("expected colors limited to ANSI, got %v", got)
+	}
+	if got := (Terminal{Color: termenv.ANSI256}).LimitColors(termenv.Ascii); got != termenv.Ascii {
+		t.Errorf("expected a poorer detected profile kept, got %v", got)
+	}
+	if n := len(TerminalFor("dumb").ProgramOptions()); n != 0 {
+		t.Errorf("expected no alt screen or mouse on dumb terminals, got %d options", n)
+	}
+}
+
+// sgrColorPattern matches SGR sequences that set a foreground or background
+// color; bold and other attributes are fine on monochrome terminals.
+var sgrColorPattern = regexp.MustCompile(`\x1b\[(?:[0-9]*;)*(?:3|4|9|10)[0-9](?:;[0-9]*)*m`)
+
+// TestTerminalMatrix renders the TUI the way bv starts it under each TERM and
+// checks that nothing the terminal cannot show is sent.
+func TestTerminalMatrix(t *testing.T) {
+	prev := lipgloss.ColorProfile()
+	t.Cleanup(func() { lipgloss.SetColorProfile(prev) })
+
+	for _, term := range []string{"xterm-kitty", "screen", "screen-256color", "vt100", "dumb"} {
+		terminal := TerminalFor(term)
+		lipgloss.SetColorProfile(terminal.LimitColors(termenv.TrueColor))
+		m := commandTestModel(t)
+		if terminal.Hyperlinks {
+			m.EnableHyperlinks("https://t.example/{id}")
+		}
+		m.EnableAccessible(terminal.Plain)
+		m.EnableReducedMotion(!terminal.Motion)
+		m.updateViewportContent()
+
+		view := m.View()
+		if !strings.Contains(view, "A-1") {
+			t.Errorf("%s: expected the issues in the view", term)
+		}
+		if got := strings.Contains(view, "\x1b]8;"); got != terminal.Hyperlinks {
+			t.Errorf("%s: hyperlinks sent = %v, want %v", term, got, terminal.Hyperlinks)
+		}
+		if terminal.Color == termenv.Ascii && sgrColorPattern.MatchString(view) {
+			t.Errorf("%s: expected no color sequences, got %q", term, view)
+		}
+		if terminal.Plain && strings.ContainsAny(view, "│─╭╰") {
+			t.Errorf("%s: expected plain text without box drawing, got %q", term, view)
+		}
+		if !terminal.Motion && !m.reducedMotion {
+			t.Errorf("%s: expected reduced motion", term)
+		}
+	}
+}
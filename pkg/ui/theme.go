@@ -1,6 +1,8 @@
 package ui
 
 import (
+	"fmt"
+
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -150,3 +152,11 @@ func (t Theme) GetTypeIcon(typ string) (string, lipgloss.AdaptiveColor) {
 	}
 }
 
+// ValidateTheme checks a ui.theme value: auto, dark or light.
+func ValidateTheme(name string) error {
+	switch name {
+	case "", "auto", "dark", "light":
+		return nil
+	}
+	return fmt.Errorf("unknown theme %q (want auto, dark or light)", name)
+}
@@ -0,0 +1,470 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Timeline
+//
+// The timeline view ("@") is a Gantt chart for the issues that have a start
+// or due date: one row per issue, a bar from start to due along a calendar
+// axis, a diamond for an issue that only has a due date, and an arrow from
+// the end of each blocker to the start of the issue it blocks. An arrow
+// drawn in red starts after the bar it points at, meaning the issue is
+// scheduled to begin before its blocker is due. "z" zooms between days,
+// weeks and months; the axis scrolls sideways with the arrow keys.
+
+// timelineLabelWidth is the width of the issue ID column.
+const timelineLabelWidth = 14
+
+type timelineZoom int
+
+const (
+	timelineDays timelineZoom = iota
+	timelineWeeks
+	timelineMonths
+)
+
+func (z timelineZoom) String() string {
+	switch z {
+	case timelineDays:
+		return "days"
+	case timelineWeeks:
+		return "weeks"
+	default:
+		return "months"
+	}
+}
+
+// timelineRow is one scheduled issue; start and end are inclusive days
+// since the timeline origin.
+type timelineRow struct {
+	issue    *model.Issue
+	start    int
+	end      int
+	hasStart bool
+	blockers []int // Rows of the issue's blockers that are on the timeline
+}
+
+// timelineCell is one character of the chart area.
+type timelineCell struct {
+	r     rune
+	color lipgloss.TerminalColor // nil for plain text
+}
+
+// TimelineModel renders scheduled issues along a calendar axis.
+type TimelineModel struct {
+	rows        []timelineRow
+	unscheduled int
+	origin      time.Time // Monday on or before the earliest date, UTC midnight
+	days        int       // Days from origin the axis covers
+	today       int
+	zoom        timelineZoom
+	cursor      int
+	top         int // First visible row
+	scroll      int // First visible chart column
+	width       int
+	height      int
+	theme       Theme
+}
+
+// NewTimelineModel creates an empty timeline view.
+func NewTimelineModel(theme Theme) TimelineModel {
+	return TimelineModel{theme: theme, zoom: timelineWeeks}
+}
+
+// timelineDate is the calendar date of t, at UTC midnight.
+func timelineDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// SetData lays out the issues with a start or due date, earliest first.
+func (t *TimelineModel) SetData(issues []model.Issue, now time.Time) {
+	var scheduled []*model.Issue
+	t.unscheduled = 0
+	earliest := timelineDate(now)
+	for i := range issues {
+		issue := &issues[i]
+		if issue.StartDate == nil && issue.DueDate == nil {
+			if !isClosedLikeStatus(issue.Status) {
+				t.unscheduled++
+			}
+			continue
+		}
+		scheduled = append(scheduled, issue)
+		for _, d := range []*time.Time{issue.StartDate, issue.DueDate} {
+			if d != nil && timelineDate(*d).Before(earliest) {
+				earliest = timelineDate(*d)
+			}
+		}
+	}
+	t.origin = earliest.AddDate(0, 0, -(int(earliest.Weekday())+6)%7)
+	t.today = t.day(now)
+
+	t.rows = make([]timelineRow, 0, len(scheduled))
+	t.days = t.today + 7
+	for _, issue := range scheduled {
+		row := timelineRow{issue: issue, hasStart: issue.StartDate != nil}
+		if row.hasStart {
+			row.start = t.day(*issue.StartDate)
+			row.end = row.start
+			if issue.EstimatedMinutes != nil && *issue.EstimatedMinutes > 0 {
+				// Without a due date, the estimate in days of eight hours
+				row.end += (*issue.EstimatedMinutes+8*60-1)/(8*60) - 1
+			}
+		}
+		if issue.DueDate != nil {
+			row.end = t.day(*issue.DueDate)
+			if !row.hasStart {
+				row.start = row.end
+			}
+		}
+		row.end = max(row.end, row.start)
+		t.days = max(t.days, row.end+7)
+		t.rows = append(t.rows, row)
+	}
+	sort.SliceStable(t.rows, func(i, j int) bool {
+		a, b := t.rows[i], t.rows[j]
+		if a.start != b.start {
+			return a.start < b.start
+		}
+		if a.end != b.end {
+			return a.end < b.end
+		}
+		return a.issue.ID < b.issue.ID
+	})
+
+	index := make(map[string]int, len(t.rows))
+	for i, row := range t.rows {
+		index[row.issue.ID] = i
+	}
+	for i := range t.rows {
+		for _, id := range blockerIDs(*t.rows[i].issue) {
+			if b, ok := index[id]; ok {
+				t.rows[i].blockers = append(t.rows[i].blockers, b)
+			}
+		}
+	}
+
+	t.cursor, t.top = 0, 0
+	t.ScrollToToday()
+}
+
+// SetSize sets the rendering area.
+func (t *TimelineModel) SetSize(width, height int) {
+	t.width, t.height = width, height
+	t.clampScroll()
+	t.keepCursorVisible()
+}
+
+// day is the number of days from the origin to the date of d.
+func (t TimelineModel) day(d time.Time) int {
+	return int(timelineDate(d).Sub(t.origin).Hours()/24 + 0.5)
+}
+
+// col is the chart column where day begins at the current zoom.
+func (t TimelineModel) col(day int) int {
+	switch t.zoom {
+	case timelineDays:
+		return day * 3
+	case timelineWeeks:
+		return day
+	default:
+		return day / 7
+	}
+}
+
+// dayAt is the first day shown in column c.
+func (t TimelineModel) dayAt(c int) int {
+	switch t.zoom {
+	case timelineDays:
+		return c / 3
+	case timelineWeeks:
+		return c
+	default:
+		return c * 7
+	}
+}
+
+// chartWidth is the width left for the chart by the ID column.
+func (t TimelineModel) chartWidth() int {
+	return max(t.width-timelineLabelWidth-1, 10)
+}
+
+// rowsHeight is the number of issue rows that fit under the title and axis
+// and above the selection line.
+func (t TimelineModel) rowsHeight() int {
+	return max(t.height-3, 1)
+}
+
+func (t *TimelineModel) clampScroll() {
+	t.scroll = max(min(t.scroll, t.col(t.days)-t.chartWidth()), 0)
+}
+
+func (t *TimelineModel) keepCursorVisible() {
+	if t.cursor < t.top {
+		t.top = t.cursor
+	}
+	if h := t.rowsHeight(); t.cursor >= t.top+h {
+		t.top = t.cursor - h + 1
+	}
+}
+
+// ScrollToToday scrolls the axis so today is near the left edge.
+func (t *TimelineModel) ScrollToToday() {
+	t.scroll = t.col(t.today) - t.chartWidth()/4
+	t.clampScroll()
+}
+
+// Scroll moves the axis by a quarter of its width, later if forward.
+func (t *TimelineModel) Scroll(forward bool) {
+	step := max(t.chartWidth()/4, 1)
+	if !forward {
+		step = -step
+	}
+	t.scroll += step
+	t.clampScroll()
+}
+
+// CycleZoom switches between days, weeks and months, keeping the first
+// visible day in place.
+func (t *TimelineModel) CycleZoom() {
+	first := t.dayAt(t.scroll)
+	t.zoom = (t.zoom + 1) % 3
+	t.scroll = t.col(first)
+	t.clampScroll()
+}
+
+// MoveDown selects the next issue.
+func (t *TimelineModel) MoveDown() {
+	if t.cursor < len(t.rows)-1 {
+		t.cursor++
+		t.keepCursorVisible()
+	}
+}
+
+// MoveUp selects the previous issue.
+func (t *TimelineModel) MoveUp() {
+	if t.cursor > 0 {
+		t.cursor--
+		t.keepCursorVisible()
+	}
+}
+
+// SelectedIssue returns the selected issue, or nil.
+func (t TimelineModel) SelectedIssue() *model.Issue {
+	if t.cursor < 0 || t.cursor >= len(t.rows) {
+		return nil
+	}
+	return t.rows[t.cursor].issue
+}
+
+// overdue reports whether the row is due before today and still open.
+func (t TimelineModel) overdue(row timelineRow) bool {
+	return row.issue.DueDate != nil && row.end < t.today && !isClosedLikeStatus(row.issue.Status)
+}
+
+// conflict reports whether the row starts before its blocker b ends.
+func (t TimelineModel) conflict(row timelineRow, b int) bool {
+	return row.hasStart && row.start <= t.rows[b].end
+}
+
+// View renders the title, axis, rows and selected issue.
+func (t TimelineModel) View() string {
+	theme := t.theme
+	if len(t.rows) == 0 {
+		msg := "No issues with a start or due date"
+		if t.unscheduled > 0 {
+			msg += fmt.Sprintf(" (%d open issues unscheduled)", t.unscheduled)
+		}
+		return theme.Renderer.NewStyle().
+			Width(t.width).
+			Height(t.height).
+			Align(lipgloss.Center, lipgloss.Center).
+			Foreground(theme.Secondary).
+			Render(msg)
+	}
+
+	title := fmt.Sprintf("📅 Timeline — %d scheduled issues • zoom: %s", len(t.rows), t.zoom)
+	if t.unscheduled > 0 {
+		title += fmt.Sprintf(" • %d open without dates", t.unscheduled)
+	}
+	lines := []string{
+		theme.Renderer.NewStyle().Bold(true).Foreground(theme.Primary).Render(title),
+		strings.Repeat(" ", timelineLabelWidth+1) + t.renderAxis(),
+	}
+
+	grid := t.layoutChart()
+	end := min(t.top+t.rowsHeight(), len(t.rows))
+	for i := t.top; i < end; i++ {
+		lines = append(lines, t.renderLabel(i)+" "+t.renderCells(grid[i]))
+	}
+	lines = append(lines, t.renderSelection())
+	return strings.Join(lines, "\n")
+}
+
+// renderAxis labels the chart columns with dates: the Mondays when zoomed
+// to days or weeks, the months when zoomed out.
+func (t TimelineModel) renderAxis() string {
+	w := t.chartWidth()
+	axis := []rune(strings.Repeat(" ", w))
+	free := 0
+	for day := t.dayAt(t.scroll); ; day++ {
+		c := t.col(day) - t.scroll
+		if c >= w {
+			break
+		}
+		date := t.origin.AddDate(0, 0, day)
+		var label string
+		switch {
+		case t.zoom == timelineMonths && date.Day() == 1:
+			label = date.Format("Jan")
+			if date.Month() == time.January {
+				label = date.Format("2006")
+			}
+		case t.zoom != timelineMonths && date.Weekday() == time.Monday:
+			label = date.Format("Jan 2")
+		}
+		if label == "" || c < free {
+			continue
+		}
+		for i, r := range label {
+			if c+i < w {
+				axis[c+i] = r
+			}
+		}
+		free = c + len(label) + 1
+	}
+	return t.theme.Renderer.NewStyle().Foreground(t.theme.Subtext).Render(string(axis))
+}
+
+// layoutChart draws today's line, the dependency arrows and the bars into
+// a grid with a row per issue, in that order so bars stay on top.
+func (t TimelineModel) layoutChart() [][]timelineCell {
+	w := t.chartWidth()
+	grid := make([][]timelineCell, len(t.rows))
+	for i := range grid {
+		grid[i] = make([]timelineCell, w)
+		for c := range grid[i] {
+			grid[i][c].r = ' '
+		}
+	}
+	set := func(row, c int, r rune, color lipgloss.TerminalColor) {
+		if c >= 0 && c < w {
+			grid[row][c] = timelineCell{r: r, color: color}
+		}
+	}
+
+	today := t.col(t.today) - t.scroll
+	for i := range grid {
+		set(i, today, '┊', t.theme.Primary)
+	}
+
+	for d, row := range t.rows {
+		for _, b := range row.blockers {
+			color := lipgloss.TerminalColor(t.theme.Subtext)
+			if t.conflict(row, b) {
+				color = ColorPrioCritical
+			}
+			from := t.barEnd(t.rows[b])
+			to := t.col(row.start) - t.scroll
+			step, corner := 1, '└'
+			if b > d {
+				step, corner = -1, '┌'
+			}
+			for i := b + step; i != d; i += step {
+				set(i, from, '│', color)
+			}
+			set(d, from, corner, color)
+			for c := from + 1; c < to-1; c++ {
+				set(d, c, '─', color)
+			}
+			if to-1 > from {
+				set(d, to-1, '▶', color)
+			}
+		}
+	}
+
+	for i, row := range t.rows {
+		color := lipgloss.TerminalColor(t.theme.GetStatusColor(string(row.issue.Status)))
+		if t.overdue(row) {
+			color = ColorPrioCritical
+		}
+		start := t.col(row.start) - t.scroll
+		if !row.hasStart {
+			set(i, start, '◆', color)
+			continue
+		}
+		for c := start; c <= t.barEnd(row); c++ {
+			set(i, c, '█', color)
+		}
+	}
+	return grid
+}
+
+// barEnd is the last chart column of a row's bar.
+func (t TimelineModel) barEnd(row timelineRow) int {
+	return max(t.col(row.end+1)-1, t.col(row.start)) - t.scroll
+}
+
+// renderCells renders a grid row, one style per run of a color.
+func (t TimelineModel) renderCells(cells []timelineCell) string {
+	var sb strings.Builder
+	for i := 0; i < len(cells); {
+		j := i
+		var run strings.Builder
+		for ; j < len(cells) && cells[j].color == cells[i].color; j++ {
+			run.WriteRune(cells[j].r)
+		}
+		if cells[i].color == nil {
+			sb.WriteString(run.String())
+		} else {
+			sb.WriteString(t.theme.Renderer.NewStyle().Foreground(cells[i].color).Render(run.String()))
+		}
+		i = j
+	}
+	return sb.String()
+}
+
+// renderLabel renders the ID column of row i.
+func (t TimelineModel) renderLabel(i int) string {
+	row := t.rows[i]
+	style := t.theme.Renderer.NewStyle().Width(timelineLabelWidth)
+	if isClosedLikeStatus(row.issue.Status) {
+		style = style.Foreground(t.theme.Closed)
+	}
+	if i == t.cursor {
+		style = style.Reverse(true).Bold(true)
+	}
+	return style.Render(truncateRunesHelper(row.issue.ID, timelineLabelWidth, "…"))
+}
+
+// renderSelection describes the selected issue's schedule under the chart.
+func (t TimelineModel) renderSelection() string {
+	row := t.rows[t.cursor]
+	issue := row.issue
+	var parts []string
+	if issue.StartDate != nil {
+		parts = append(parts, "starts "+issue.StartDate.Format("Jan 2"))
+	}
+	if issue.DueDate != nil {
+		due := "due " + issue.DueDate.Format("Jan 2")
+		if t.overdue(row) {
+			due += fmt.Sprintf(" (overdue %dd)", t.today-row.end)
+		}
+		parts = append(parts, due)
+	}
+	for _, b := range row.blockers {
+		if t.conflict(row, b) {
+			parts = append(parts, "starts before "+t.rows[b].issue.ID+" ends")
+		}
+	}
+	desc := fmt.Sprintf("%s %s — %s", issue.ID, issue.Title, strings.Join(parts, ", "))
+	return t.theme.Renderer.NewStyle().Foreground(t.theme.Subtext).Render(truncateRunesHelper(desc, t.width, "…"))
+}
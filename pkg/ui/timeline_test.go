@@ -0,0 +1,127 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/charmbracelet/lipgloss"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func date(day int) *time.Time {
+	d := time.Date(2025, 3, day, 9, 0, 0, 0, time.UTC)
+	return &d
+}
+
+func TestTimeline_Layout(t *testing.T) {
+	blockedBy := func(id string) []*model.Dependency {
+		return []*model.Dependency{{DependsOnID: id, Type: model.DepBlocks}}
+	}
+	issues := []model.Issue{
+		{ID: "S-1", Title: "Design", Status: model.StatusOpen, StartDate: date(3), DueDate: date(7)},
+		{ID: "S-2", Title: "Build", Status: model.StatusInProgress, StartDate: date(10), DueDate: date(14), Dependencies: blockedBy("S-1")},
+		{ID: "S-3", Title: "Review", Status: model.StatusOpen, DueDate: date(5)},
+		{ID: "S-4", Title: "Docs", Status: model.StatusOpen, StartDate: date(6), EstimatedMinutes: minutes(960), Dependencies: blockedBy("S-2")},
+		{ID: "S-5", Title: "Someday", Status: model.StatusOpen},
+	}
+	tl := NewTimelineModel(DefaultTheme(lipgloss.NewRenderer(nil)))
+	tl.SetSize(80, 20)
+	tl.SetData(issues, time.Date(2025, 3, 12, 15, 0, 0, 0, time.UTC))
+
+	var order []string
+	for _, row := range tl.rows {
+		order = append(order, row.issue.ID)
+	}
+	if got := strings.Join(order, " "); got != "S-1 S-3 S-4 S-2" || tl.unscheduled != 1 {
+		t.Fatalf("expected scheduled issues by start date, got %s (%d unscheduled)", got, tl.unscheduled)
+	}
+	if tl.today != 9 || tl.rows[2].end != 4 {
+		t.Errorf("expected days counted from Monday Mar 3 and S-4 to last two days, got today %d, end %d", tl.today, tl.rows[2].end)
+	}
+
+	grid := tl.layoutChart()
+	cells := func(row, from, to int) string {
+		var sb strings.Builder
+		for _, c := range grid[row][from:to] {
+			sb.WriteRune(c.r)
+		}
+		return sb.String()
+	}
+	if got := cells(0, 0, 10); got != "█████    ┊" {
+		t.Errorf("expected S-1 from Mar 3 to 7 and today's line, got %q", got)
+	}
+	if grid[1][2].r != '◆' || grid[1][2].color != ColorPrioCritical {
+		t.Error("expected S-3 as an overdue milestone")
+	}
+	if got := cells(3, 4, 12); got != "└─▶█████" {
+		t.Errorf("expected an arrow from S-1 to S-2, got %q", got)
+	}
+	if grid[2][11].r != '┌' || grid[2][11].color != ColorPrioCritical {
+		t.Error("expected the arrow from S-2 up to S-4 marked as a conflict")
+	}
+
+	view := tl.View()
+	for _, want := range []string{"4 scheduled issues", "1 open without dates", "Mar 3", "Mar 10", "S-1 Design — starts Mar 3, due Mar 7 (overdue 5d)"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected %q in the timeline", want)
+		}
+	}
+	tl.MoveDown()
+	tl.MoveDown()
+	if got := tl.renderSelection(); !strings.Contains(got, "starts before S-2 ends") {
+		t.Errorf("expected the conflict explained, got %q", got)
+	}
+
+	tl.CycleZoom()
+	if tl.zoom != timelineMonths || !strings.Contains(tl.View(), "zoom: months") {
+		t.Error("expected z to zoom out to months")
+	}
+	tl.CycleZoom()
+	if tl.zoom != timelineDays || tl.col(3) != 9 {
+		t.Error("expected days zoom to give each day three columns")
+	}
+}
+
+func TestTimeline_Keys(t *testing.T) {
+	m := commandTestModel(t)
+	if !strings.Contains(pressKey(m, runeKey("@")).View(), "No issues with a start or due date") {
+		t.Error("expected an empty timeline without dates")
+	}
+	for i := range m.issues {
+		if m.issues[i].ID == "A-2" {
+			m.issues[i].DueDate = date(20)
+		}
+	}
+	m = pressKey(m, runeKey("@"))
+	if m.CurrentContext() != ContextTimeline {
+		t.Fatalf("expected the timeline, got %s", m.CurrentContext())
+	}
+	m = pressKey(m, runeKey("z"))
+	if m.timeline.zoom != timelineMonths {
+		t.Error("expected z to change the zoom")
+	}
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if m.focused != focusDetail {
+		t.Fatalf("expected enter to open the issue, got %s", m.FocusState())
+	}
+	if item, ok := m.list.SelectedItem().(IssueItem); !ok || item.Issue.ID != "A-2" {
+		t.Errorf("expected A-2 selected, got %v", m.list.SelectedItem())
+	}
+	var sb strings.Builder
+	writeIssueSummaryMD(&sb, model.Issue{ID: "A-2", StartDate: date(3), DueDate: date(20)})
+	if !strings.Contains(sb.String(), "**Starts:** 2025-03-03 · **Due:** 2025-03-20") {
+		t.Errorf("expected the schedule in the details, got %q", sb.String())
+	}
+
+	m, _, err := m.ExecCommand("view timeline")
+	if err != nil || m.CurrentContext() != ContextTimeline {
+		t.Fatalf("expected :view timeline to open it, got %s (%v)", m.CurrentContext(), err)
+	}
+	m = pressKey(m, runeKey("@"))
+	if m.CurrentContext() == ContextTimeline {
+		t.Error("expected @ to close the timeline")
+	}
+}
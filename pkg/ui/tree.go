@@ -10,6 +10,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	"github.com/charmbracelet/bubbles/viewport"
 	"github.com/charmbracelet/lipgloss"
@@ -75,6 +76,9 @@ func (t *TreeModel) SetBeadsDir(dir string) {
 // Only stores explicit user changes; nodes not in the map use default behavior.
 // Errors are logged but do not interrupt the user experience.
 func (t *TreeModel) saveState() {
+	if t.readOnly {
+		return
+	}
 	state := &TreeState{
 		Version:  TreeStateVersion,
 		Expanded: make(map[string]bool),
@@ -193,6 +197,7 @@ type TreeModel struct {
 
 	// Persistence state (bv-19vz)
 	beadsDir string // Directory containing .beads (for tree-state.json)
+	readOnly bool   // Keep expand/collapse state in memory only
 }
 
 // NewTreeModel creates an empty tree model
@@ -540,15 +545,15 @@ func (t *TreeModel) renderEmptyState() string {
 		Foreground(t.theme.Muted)
 
 	var sb strings.Builder
-	sb.WriteString(titleStyle.Render("Tree View"))
+	sb.WriteString(titleStyle.Render(i18n.T("Tree View")))
 	sb.WriteString("\n\n")
-	sb.WriteString(mutedStyle.Render("No issues to display."))
+	sb.WriteString(mutedStyle.Render(i18n.T("No issues to display.")))
 	sb.WriteString("\n\n")
-	sb.WriteString(mutedStyle.Render("To create hierarchy, add parent-child dependencies:"))
+	sb.WriteString(mutedStyle.Render(i18n.T("To create hierarchy, add parent-child dependencies:")))
 	sb.WriteString("\n")
-	sb.WriteString(mutedStyle.Render("  bd dep add <child> parent-child:<parent>"))
+	sb.WriteString(mutedStyle.Render("  " + i18n.T("bd dep add <child> parent-child:<parent>")))
 	sb.WriteString("\n\n")
-	sb.WriteString(mutedStyle.Render("Press E to return to list view."))
+	sb.WriteString(mutedStyle.Render(i18n.T("Press E to return to list view.")))
 
 	return sb.String()
 }
@@ -707,18 +712,13 @@ func (t *TreeModel) getExpandIndicator(node *IssueTreeNode) string {
 	return "▸" // Collapsed
 }
 
-// truncateTitle truncates a title to the given max length with ellipsis.
+// truncateTitle truncates a title to maxLen cells with ellipsis.
 func (t *TreeModel) truncateTitle(title string, maxLen int) string {
 	if maxLen <= 3 {
 		return "..."
 	}
 
-	runes := []rune(title)
-	if len(runes) <= maxLen {
-		return title
-	}
-
-	return string(runes[:maxLen-1]) + "…"
+	return truncate(title, maxLen)
 }
 
 // GetPriorityColor returns the color for a priority level.
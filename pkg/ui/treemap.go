@@ -0,0 +1,315 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Treemap
+//
+// The treemap view ("#") fills the screen with the open issues, one
+// rectangle each, with the area following the estimate: the big chunks of
+// remaining work are the big tiles. Color shows priority, or age after "c",
+// so a manager can see at a glance where the risk sits. The layout splits
+// the issues into two halves of about equal weight, side by side or stacked
+// depending on the shape of the space left, and recurses.
+
+// treemapDefaultMinutes is the weight of an issue without an estimate.
+const treemapDefaultMinutes = 60
+
+// treemapMinCells is the smallest area worth a tile; issues beyond what fits
+// are folded into one "more" tile.
+const treemapMinCells = 24
+
+type treemapColoring int
+
+const (
+	treemapByPriority treemapColoring = iota
+	treemapByAge
+)
+
+// treemapTile is one rectangle: an issue, or the issues that did not fit.
+type treemapTile struct {
+	issue   *model.Issue // nil for the "more" tile
+	minutes int
+	folded  int // Issues in the "more" tile
+}
+
+// TreemapModel renders open issues as a treemap sized by estimate.
+type TreemapModel struct {
+	issues      []model.Issue // Open issues, largest estimate first
+	tiles       []treemapTile
+	unestimated int
+	cursor      int
+	coloring    treemapColoring
+	now         time.Time
+	width       int
+	height      int
+	theme       Theme
+}
+
+// NewTreemapModel creates an empty treemap view.
+func NewTreemapModel(theme Theme) TreemapModel {
+	return TreemapModel{theme: theme}
+}
+
+// SetData shows the open issues among issues; ages are relative to now.
+func (t *TreemapModel) SetData(issues []model.Issue, now time.Time) {
+	t.issues = nil
+	t.unestimated = 0
+	for _, issue := range issues {
+		if isClosedLikeStatus(issue.Status) {
+			continue
+		}
+		if issue.EstimatedMinutes == nil || *issue.EstimatedMinutes <= 0 {
+			t.unestimated++
+		}
+		t.issues = append(t.issues, issue)
+	}
+	sort.SliceStable(t.issues, func(i, j int) bool {
+		return treemapMinutes(&t.issues[i]) > treemapMinutes(&t.issues[j])
+	})
+	t.now = now
+	t.cursor = 0
+	t.layoutTiles()
+}
+
+// SetSize sets the rendering area; fewer tiles fit in a smaller one.
+func (t *TreemapModel) SetSize(width, height int) {
+	if width == t.width && height == t.height {
+		return
+	}
+	t.width, t.height = width, height
+	t.layoutTiles()
+}
+
+func treemapMinutes(issue *model.Issue) int {
+	if issue.EstimatedMinutes == nil || *issue.EstimatedMinutes <= 0 {
+		return treemapDefaultMinutes
+	}
+	return *issue.EstimatedMinutes
+}
+
+// layoutTiles picks the tiles: as many of the largest issues as the map
+// has room for, and one tile for the rest.
+func (t *TreemapModel) layoutTiles() {
+	t.tiles = nil
+	fit := max(t.width*t.mapHeight()/treemapMinCells, 2)
+	for i := range t.issues {
+		issue := &t.issues[i]
+		if i < fit {
+			t.tiles = append(t.tiles, treemapTile{issue: issue, minutes: treemapMinutes(issue)})
+			continue
+		}
+		if i == fit {
+			// The last tile that fits becomes the "more" tile.
+			last := &t.tiles[len(t.tiles)-1]
+			*last = treemapTile{minutes: last.minutes, folded: 1}
+		}
+		last := &t.tiles[len(t.tiles)-1]
+		last.minutes += treemapMinutes(issue)
+		last.folded++
+	}
+	t.cursor = min(t.cursor, max(len(t.tiles)-1, 0))
+}
+
+// mapHeight is the height left for the tiles by the header and footer.
+func (t TreemapModel) mapHeight() int {
+	return max(t.height-2, 1)
+}
+
+// MoveNext selects the next smaller tile.
+func (t *TreemapModel) MoveNext() {
+	if t.cursor < len(t.tiles)-1 {
+		t.cursor++
+	}
+}
+
+// MovePrev selects the next larger tile.
+func (t *TreemapModel) MovePrev() {
+	if t.cursor > 0 {
+		t.cursor--
+	}
+}
+
+// ToggleColoring switches between coloring by priority and by age.
+func (t *TreemapModel) ToggleColoring() {
+	if t.coloring == treemapByPriority {
+		t.coloring = treemapByAge
+	} else {
+		t.coloring = treemapByPriority
+	}
+}
+
+// SelectedIssue returns the issue of the selected tile, or nil.
+func (t TreemapModel) SelectedIssue() *model.Issue {
+	if t.cursor < 0 || t.cursor >= len(t.tiles) {
+		return nil
+	}
+	return t.tiles[t.cursor].issue
+}
+
+// View renders the header, the map and the selected issue.
+func (t TreemapModel) View() string {
+	theme := t.theme
+	if len(t.tiles) == 0 {
+		return theme.Renderer.NewStyle().
+			Width(t.width).
+			Height(t.height).
+			Align(lipgloss.Center, lipgloss.Center).
+			Foreground(theme.Secondary).
+			Render(i18n.T("No open issues"))
+	}
+
+	total := 0
+	for _, tile := range t.tiles {
+		total += tile.minutes
+	}
+	coloring := "priority"
+	if t.coloring == treemapByAge {
+		coloring = "age"
+	}
+	header := theme.Renderer.NewStyle().Bold(true).Foreground(theme.Primary).
+		Render(fmt.Sprintf("▦ Treemap — %d open issues, %s estimated • colored by %s",
+			len(t.issues), formatEstimate(total), coloring))
+
+	body := t.renderArea(0, len(t.tiles), t.width, t.mapHeight())
+	return header + "\n" + body + "\n" + t.renderSelection()
+}
+
+// renderArea lays out tiles[from:to] in a w×h block.
+func (t TreemapModel) renderArea(from, to, w, h int) string {
+	if to-from == 1 || w < 2 && h < 2 {
+		return t.renderTile(from, w, h)
+	}
+
+	total, half := 0, 0
+	for _, tile := range t.tiles[from:to] {
+		total += tile.minutes
+	}
+	// Split after the tile that brings the first part closest to half.
+	split := from + 1
+	for i := from; i < to-1; i++ {
+		half += t.tiles[i].minutes
+		split = i + 1
+		if 2*half >= total {
+			break
+		}
+	}
+	share := float64(half) / float64(total)
+
+	// Cells are about twice as tall as wide: split the longer side.
+	if (w >= 2*h || h < 2) && w >= 2 {
+		wa := min(max(int(float64(w)*share+0.5), 1), w-1)
+		return lipgloss.JoinHorizontal(lipgloss.Top,
+			t.renderArea(from, split, wa, h), t.renderArea(split, to, w-wa, h))
+	}
+	ha := min(max(int(float64(h)*share+0.5), 1), h-1)
+	return lipgloss.JoinVertical(lipgloss.Left,
+		t.renderArea(from, split, w, ha), t.renderArea(split, to, w, h-ha))
+}
+
+// renderTile draws one tile, leaving a gap on its right and bottom edges
+// when there is room so neighbors of the same color stay apart.
+func (t TreemapModel) renderTile(i, w, h int) string {
+	tile := t.tiles[i]
+	innerW, innerH := w, h
+	if w > 2 {
+		innerW--
+	}
+	if h > 2 {
+		innerH--
+	}
+
+	var lines []string
+	if tile.issue == nil {
+		lines = []string{fmt.Sprintf("+%d more", tile.folded), formatEstimate(tile.minutes)}
+	} else {
+		lines = []string{
+			tile.issue.ID,
+			tile.issue.Title,
+			fmt.Sprintf("P%d %s %s", tile.issue.Priority, formatEstimate(tile.minutes), FormatTimeRel(tile.issue.CreatedAt, t.now)),
+		}
+	}
+	lines = lines[:min(len(lines), innerH)]
+	for j := range lines {
+		lines[j] = truncateRunesHelper(lines[j], innerW, "…")
+	}
+
+	style := t.theme.Renderer.NewStyle().
+		Width(innerW).
+		Height(innerH).
+		MaxHeight(innerH).
+		Background(t.tileColor(tile)).
+		Foreground(lipgloss.Color("#282A36"))
+	if i == t.cursor {
+		style = style.Reverse(true).Bold(true)
+	}
+	return lipgloss.NewStyle().Width(w).Height(h).Render(style.Render(strings.Join(lines, "\n")))
+}
+
+// tileColor is the color of a tile by priority or by age.
+func (t TreemapModel) tileColor(tile treemapTile) lipgloss.TerminalColor {
+	if tile.issue == nil {
+		return t.theme.Secondary
+	}
+	if t.coloring == treemapByAge {
+		switch age := t.now.Sub(tile.issue.CreatedAt); {
+		case age < 7*24*time.Hour:
+			return ColorPrioLow
+		case age < 30*24*time.Hour:
+			return ColorPrioMedium
+		case age < 90*24*time.Hour:
+			return ColorPrioHigh
+		default:
+			return ColorPrioCritical
+		}
+	}
+	switch tile.issue.Priority {
+	case 0:
+		return ColorPrioCritical
+	case 1:
+		return ColorPrioHigh
+	case 2:
+		return ColorPrioMedium
+	case 3:
+		return ColorPrioLow
+	default:
+		return t.theme.Secondary
+	}
+}
+
+// renderSelection describes the selected tile under the map.
+func (t TreemapModel) renderSelection() string {
+	muted := t.theme.Renderer.NewStyle().Foreground(t.theme.Subtext)
+	tile := t.tiles[t.cursor]
+	var desc string
+	if tile.issue == nil {
+		desc = fmt.Sprintf("%d smaller issues, %s", tile.folded, formatEstimate(tile.minutes))
+	} else {
+		desc = fmt.Sprintf("%s %s — P%d, %s, opened %s", tile.issue.ID, tile.issue.Title,
+			tile.issue.Priority, formatEstimate(tile.minutes), FormatTimeRel(tile.issue.CreatedAt, t.now))
+	}
+	if t.unestimated > 0 {
+		desc += fmt.Sprintf(" • %d without estimate counted as %s", t.unestimated, formatEstimate(treemapDefaultMinutes))
+	}
+	return muted.Render(truncateRunesHelper(desc, t.width, "…"))
+}
+
+// formatEstimate shows an estimate in hours, or days of eight hours.
+func formatEstimate(minutes int) string {
+	switch {
+	case minutes < 60:
+		return fmt.Sprintf("%dm", minutes)
+	case minutes < 8*60:
+		return fmt.Sprintf("%dh", (minutes+30)/60)
+	default:
+		return fmt.Sprintf("%dd", (minutes+4*60)/(8*60))
+	}
+}
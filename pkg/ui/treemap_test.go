@@ -0,0 +1,127 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/charmbracelet/lipgloss"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func minutes(n int) *int { return &n }
+
+func TestTreemap_LayoutFillsArea(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	issues := []model.Issue{
+		{ID: "T-1", Title: "Small", Status: model.StatusOpen, Priority: 3, EstimatedMinutes: minutes(30), CreatedAt: now},
+		{ID: "T-2", Title: "Huge", Status: model.StatusInProgress, Priority: 0, EstimatedMinutes: minutes(240), CreatedAt: now.AddDate(0, -4, 0)},
+		{ID: "T-3", Title: "Done", Status: model.StatusClosed, EstimatedMinutes: minutes(9000)},
+		{ID: "T-4", Title: "Unsized", Status: model.StatusBlocked, Priority: 1, CreatedAt: now.AddDate(0, 0, -10)},
+	}
+	tm := NewTreemapModel(DefaultTheme(lipgloss.NewRenderer(nil)))
+	tm.SetSize(60, 20)
+	tm.SetData(issues, now)
+
+	if len(tm.issues) != 3 || tm.issues[0].ID != "T-2" || tm.unestimated != 1 {
+		t.Fatalf("expected open issues largest first, got %v (%d unestimated)", tm.issues, tm.unestimated)
+	}
+	if got := tm.SelectedIssue(); got == nil || got.ID != "T-2" {
+		t.Fatalf("expected the largest tile selected, got %v", got)
+	}
+
+	view := tm.View()
+	lines := strings.Split(view, "\n")
+	if len(lines) != 20 {
+		t.Errorf("expected 20 lines, got %d", len(lines))
+	}
+	for i, line := range lines[1 : len(lines)-1] {
+		if w := lipgloss.Width(line); w != 60 {
+			t.Errorf("map line %d is %d wide, want 60", i, w)
+		}
+	}
+	for _, want := range []string{"T-2", "T-4", "3 open issues", "1 without estimate"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected %q in the treemap", want)
+		}
+	}
+	if strings.Contains(view, "T-3") {
+		t.Error("expected closed issues left out")
+	}
+
+	if tm.tileColor(tm.tiles[0]) != ColorPrioCritical {
+		t.Error("expected P0 colored critical")
+	}
+	tm.ToggleColoring()
+	if tm.tileColor(tm.tiles[0]) != ColorPrioCritical || tm.tileColor(tm.tiles[2]) != ColorPrioLow {
+		t.Error("expected old issues red and new ones green by age")
+	}
+}
+
+func TestTreemap_FoldsWhatDoesNotFit(t *testing.T) {
+	var issues []model.Issue
+	for i := 0; i < 40; i++ {
+		issues = append(issues, model.Issue{ID: fmt.Sprintf("T-%d", i), Status: model.StatusOpen, EstimatedMinutes: minutes(100 - i)})
+	}
+	tm := NewTreemapModel(DefaultTheme(lipgloss.NewRenderer(nil)))
+	tm.SetSize(40, 8)
+	tm.SetData(issues, time.Now())
+
+	fit := 40 * 6 / treemapMinCells
+	if len(tm.tiles) != fit {
+		t.Fatalf("expected %d tiles, got %d", fit, len(tm.tiles))
+	}
+	more := tm.tiles[len(tm.tiles)-1]
+	if more.issue != nil || more.folded != 40-fit+1 {
+		t.Errorf("expected the rest folded into one tile, got %+v", more)
+	}
+	if !strings.Contains(tm.View(), fmt.Sprintf("+%d more", more.folded)) {
+		t.Error("expected the folded tile labeled")
+	}
+	for i := 0; i < len(tm.tiles)+2; i++ {
+		tm.MoveNext()
+	}
+	if tm.SelectedIssue() != nil {
+		t.Error("expected no issue for the folded tile")
+	}
+
+	if got := formatEstimate(45) + " " + formatEstimate(150) + " " + formatEstimate(2400); got != "45m 3h 5d" {
+		t.Errorf("unexpected estimates %q", got)
+	}
+}
+
+func TestTreemap_Keys(t *testing.T) {
+	m := commandTestModel(t)
+	m = pressKey(m, runeKey("#"))
+	if m.CurrentContext() != ContextTreemap {
+		t.Fatalf("expected the treemap, got %s", m.CurrentContext())
+	}
+	if !strings.Contains(m.View(), "2 open issues") {
+		t.Error("expected the open issues in the treemap")
+	}
+	m = pressKey(m, runeKey("c"))
+	if m.treemap.coloring != treemapByAge {
+		t.Error("expected c to color by age")
+	}
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyDown})
+	want := m.treemap.SelectedIssue().ID
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if m.focused != focusDetail {
+		t.Fatalf("expected enter to open the issue, got %s", m.FocusState())
+	}
+	if item, ok := m.list.SelectedItem().(IssueItem); !ok || item.Issue.ID != want {
+		t.Errorf("expected %s selected, got %v", want, m.list.SelectedItem())
+	}
+
+	m, _, err := m.ExecCommand("view treemap")
+	if err != nil || m.CurrentContext() != ContextTreemap {
+		t.Fatalf("expected :view treemap to open it, got %s (%v)", m.CurrentContext(), err)
+	}
+	m = pressKey(m, runeKey("#"))
+	if m.CurrentContext() == ContextTreemap {
+		t.Error("expected # to close the treemap")
+	}
+}
@@ -3,9 +3,11 @@ package ui
 import (
 	"testing"
 	"unicode/utf8"
+
+	"github.com/charmbracelet/lipgloss"
 )
 
-func TestTruncateString_UTF8Safe(t *testing.T) {
+func TestTruncateString(t *testing.T) {
 	tests := []struct {
 		name   string
 		input  string
@@ -13,9 +15,17 @@ func TestTruncateString_UTF8Safe(t *testing.T) {
 		want   string
 	}{
 		{name: "zero max", input: "hello", maxLen: 0, want: ""},
+		{name: "empty string", input: "", maxLen: 10, want: ""},
 		{name: "fits", input: "hello", maxLen: 10, want: "hello"},
-		{name: "small max no ellipsis", input: "こんにちは", maxLen: 3, want: "こんに"},
-		{name: "ellipsis", input: "a🙂b🙂c", maxLen: 4, want: "a🙂b…"},
+		{name: "exact length", input: "hello", maxLen: 5, want: "hello"},
+		{name: "ellipsis", input: "hello world", maxLen: 8, want: "hello w…"},
+		{name: "small max no ellipsis", input: "hello", maxLen: 3, want: "hel"},
+		{name: "max 1 no ellipsis", input: "hello", maxLen: 1, want: "h"},
+		{name: "wide characters fill cells", input: "こんにちは", maxLen: 3, want: "こ"},
+		{name: "wide characters no ellipsis", input: "🙂🙂🙂", maxLen: 2, want: "🙂"},
+		{name: "emoji with ellipsis", input: "a🙂b🙂c", maxLen: 4, want: "a🙂…"},
+		{name: "CJK with ellipsis", input: "日本語テスト", maxLen: 4, want: "日…"},
+		{name: "mixed", input: "hello世界", maxLen: 6, want: "hello…"},
 	}
 
 	for _, tt := range tests {
@@ -27,38 +37,46 @@ func TestTruncateString_UTF8Safe(t *testing.T) {
 			if !utf8.ValidString(got) {
 				t.Fatalf("truncateString output is not valid UTF-8: %q", got)
 			}
-			if tt.maxLen >= 0 && len([]rune(got)) > tt.maxLen {
-				t.Fatalf("truncateString output has %d runes; max %d", len([]rune(got)), tt.maxLen)
+			if w := lipgloss.Width(got); w > tt.maxLen {
+				t.Fatalf("truncateString output is %d cells wide; max %d", w, tt.maxLen)
 			}
 		})
 	}
 }
 
-func TestTruncateStrSprint_UTF8Safe(t *testing.T) {
+func TestTruncateRunesHelper_GraphemeClusters(t *testing.T) {
 	tests := []struct {
-		name   string
-		input  string
-		maxLen int
-		want   string
+		name  string
+		input string
+		max   int
+		want  string
 	}{
-		{name: "zero max", input: "hello", maxLen: 0, want: ""},
-		{name: "fits", input: "hello", maxLen: 10, want: "hello"},
-		{name: "small max no ellipsis", input: "🙂🙂🙂", maxLen: 2, want: "🙂🙂"},
-		{name: "ellipsis", input: "a🙂b🙂c", maxLen: 4, want: "a🙂b…"},
+		// é written as e + U+0301 is one cell; the accent stays with its e.
+		{name: "combining mark", input: "Cafe\u0301 au lait", max: 5, want: "Cafe\u0301…"},
+		// A ZWJ family is one two-cell cluster, kept whole or dropped whole.
+		{name: "ZWJ sequence kept", input: "👨‍👩‍👧 family", max: 4, want: "👨‍👩‍👧 …"},
+		{name: "ZWJ sequence dropped", input: "a👨‍👩‍👧b", max: 3, want: "a…"},
+		// ❤️ with the emoji presentation selector is two cells wide.
+		{name: "variation selector", input: "❤️❤️❤️", max: 5, want: "❤️❤️…"},
+		{name: "styled text", input: "\x1b[1mbold text\x1b[0m", max: 5, want: "\x1b[1mbold…\x1b[0m"},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := truncateStrSprint(tt.input, tt.maxLen)
+			got := truncateRunesHelper(tt.input, tt.max, "…")
 			if got != tt.want {
-				t.Fatalf("truncateStrSprint(%q, %d) = %q; want %q", tt.input, tt.maxLen, got, tt.want)
-			}
-			if !utf8.ValidString(got) {
-				t.Fatalf("truncateStrSprint output is not valid UTF-8: %q", got)
+				t.Fatalf("truncateRunesHelper(%q, %d) = %q; want %q", tt.input, tt.max, got, tt.want)
 			}
-			if tt.maxLen >= 0 && len([]rune(got)) > tt.maxLen {
-				t.Fatalf("truncateStrSprint output has %d runes; max %d", len([]rune(got)), tt.maxLen)
+			if w := lipgloss.Width(got); w > tt.max {
+				t.Fatalf("output is %d cells wide; max %d", w, tt.max)
 			}
 		})
 	}
 }
+
+func TestPadRight_WideCharacters(t *testing.T) {
+	for _, s := range []string{"abc", "日本", "❤️", "e\u0301e\u0301", "👨‍👩‍👧"} {
+		if got := lipgloss.Width(padRight(s, 6)); got != 6 {
+			t.Errorf("padRight(%q, 6) is %d cells wide", s, got)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -13,7 +14,7 @@ type TutorialPage struct {
 	ID       string   // Unique identifier (e.g., "intro", "navigation")
 	Title    string   // Page title displayed in header
 	Content  string   // Markdown content
-	Section  string   // Parent section for TOC grouping
+	Section  string   // Parent section for TOC grouping, in English; translated when shown
 	Contexts []string // Which view contexts this page applies to (empty = all)
 }
 
@@ -257,7 +258,7 @@ func (m TutorialModel) View() string {
 	sectionStyle := r.NewStyle().Foreground(m.theme.Subtext).Italic(true)
 	pageTitle := pageTitleStyle.Render(currentPage.Title)
 	if currentPage.Section != "" {
-		pageTitle += sectionStyle.Render(" — " + currentPage.Section)
+		pageTitle += sectionStyle.Render(" — " + i18n.T(currentPage.Section))
 	}
 	b.WriteString(pageTitle)
 	b.WriteString("\n")
@@ -325,7 +326,7 @@ func (m TutorialModel) renderHeader(page TutorialPage, totalPages int) string {
 			Render(strings.Repeat("░", barWidth-filledWidth))
 
 	// Title
-	title := titleStyle.Render("📚 beads_viewer Tutorial")
+	title := titleStyle.Render(i18n.T("📚 beads_viewer Tutorial"))
 
 	// Calculate spacing to align progress to the right
 	headerContent := title + "  " + progressText + " " + progressBar
@@ -408,11 +409,11 @@ func (m TutorialModel) renderContent(page TutorialPage, width int) string {
 
 	// Add scroll indicators (these are accounted for in the height calculation)
 	if m.scrollOffset > 0 {
-		scrollUpHint := r.NewStyle().Foreground(m.theme.Muted).Render("↑ more above")
+		scrollUpHint := r.NewStyle().Foreground(m.theme.Muted).Render(i18n.T("↑ more above"))
 		content = scrollUpHint + "\n" + content
 	}
 	if endLine < len(lines) {
-		scrollDownHint := r.NewStyle().Foreground(m.theme.Muted).Render("↓ more below")
+		scrollDownHint := r.NewStyle().Foreground(m.theme.Muted).Render(i18n.T("↓ more below"))
 		content = content + "\n" + scrollDownHint
 	}
 
@@ -454,11 +455,11 @@ func (m TutorialModel) renderStructuredContent(page StructuredTutorialPage, widt
 
 	// Add scroll indicators
 	if m.scrollOffset > 0 {
-		scrollUpHint := m.theme.Renderer.NewStyle().Foreground(m.theme.Muted).Render("↑ more above")
+		scrollUpHint := m.theme.Renderer.NewStyle().Foreground(m.theme.Muted).Render(i18n.T("↑ more above"))
 		content = scrollUpHint + "\n" + content
 	}
 	if endLine < len(lines) {
-		scrollDownHint := m.theme.Renderer.NewStyle().Foreground(m.theme.Muted).Render("↓ more below")
+		scrollDownHint := m.theme.Renderer.NewStyle().Foreground(m.theme.Muted).Render(i18n.T("↓ more below"))
 		content = content + "\n" + scrollDownHint
 	}
 
@@ -506,7 +507,7 @@ func (m TutorialModel) renderTOC(pages []TutorialPage) string {
 		Foreground(m.theme.Open)
 
 	var b strings.Builder
-	b.WriteString(headerStyle.Render("Contents"))
+	b.WriteString(headerStyle.Render(i18n.T("Contents")))
 	if m.focus == focusTutorialTOC {
 		b.WriteString(r.NewStyle().Foreground(m.theme.Primary).Render(" ●"))
 	}
@@ -518,7 +519,7 @@ func (m TutorialModel) renderTOC(pages []TutorialPage) string {
 		if page.Section != currentSection && page.Section != "" {
 			currentSection = page.Section
 			b.WriteString("\n")
-			b.WriteString(sectionStyle.Render("▸ " + currentSection))
+			b.WriteString(sectionStyle.Render("▸ " + i18n.T(currentSection)))
 			b.WriteString("\n")
 		}
 
@@ -538,8 +539,8 @@ func (m TutorialModel) renderTOC(pages []TutorialPage) string {
 
 		// Truncate long titles
 		title := page.Title
-		if len(title) > 14 {
-			title = title[:12] + "…"
+		if lipgloss.Width(title) > 14 {
+			title = truncateRunesHelper(title, 13, "…")
 		}
 
 		// Viewed indicator
@@ -574,20 +575,20 @@ func (m TutorialModel) renderFooter(totalPages int) string {
 	if m.focus == focusTutorialTOC && m.tocVisible {
 		// TOC-focused hints
 		hints = []string{
-			keyStyle.Render("j/k") + descStyle.Render(" select"),
-			keyStyle.Render("Enter") + descStyle.Render(" go to page"),
-			keyStyle.Render("Tab") + descStyle.Render(" back to content"),
-			keyStyle.Render("t") + descStyle.Render(" hide TOC"),
-			keyStyle.Render("q") + descStyle.Render(" close"),
+			keyStyle.Render("j/k") + descStyle.Render(" "+i18n.T("select")),
+			keyStyle.Render("Enter") + descStyle.Render(" "+i18n.T("go to page")),
+			keyStyle.Render("Tab") + descStyle.Render(" "+i18n.T("back to content")),
+			keyStyle.Render("t") + descStyle.Render(" "+i18n.T("hide TOC")),
+			keyStyle.Render("q") + descStyle.Render(" "+i18n.T("close")),
 		}
 	} else {
 		// Content-focused hints
 		hints = []string{
-			keyStyle.Render("←/→/Space") + descStyle.Render(" pages"),
-			keyStyle.Render("j/k") + descStyle.Render(" scroll"),
-			keyStyle.Render("Ctrl+d/u") + descStyle.Render(" half-page"),
-			keyStyle.Render("t") + descStyle.Render(" TOC"),
-			keyStyle.Render("q") + descStyle.Render(" close"),
+			keyStyle.Render("←/→/Space") + descStyle.Render(" "+i18n.T("pages")),
+			keyStyle.Render("j/k") + descStyle.Render(" "+i18n.T("scroll")),
+			keyStyle.Render("Ctrl+d/u") + descStyle.Render(" "+i18n.T("half-page")),
+			keyStyle.Render("t") + descStyle.Render(" "+i18n.T("TOC")),
+			keyStyle.Render("q") + descStyle.Render(" "+i18n.T("close")),
 		}
 	}
 
@@ -605,7 +606,7 @@ func (m TutorialModel) renderEmptyState() string {
 		Padding(2, 4).
 		Width(m.width)
 
-	return style.Render("No tutorial pages available for this context.")
+	return style.Render(i18n.T("No tutorial pages available for this context."))
 }
 
 // NextPage advances to the next page.
@@ -790,27 +791,27 @@ func defaultTutorialPages() []TutorialPage {
 		// =============================================================
 		{
 			ID:      "intro-welcome",
-			Title:   "Welcome",
+			Title:   i18n.T("Welcome"),
 			Section: "Introduction",
-			Content: introWelcomeContent,
+			Content: i18n.T(introWelcomeContent),
 		},
 		{
 			ID:      "intro-philosophy",
-			Title:   "The Beads Philosophy",
+			Title:   i18n.T("The Beads Philosophy"),
 			Section: "Introduction",
-			Content: introPhilosophyContent,
+			Content: i18n.T(introPhilosophyContent),
 		},
 		{
 			ID:      "intro-audience",
-			Title:   "Who Is This For?",
+			Title:   i18n.T("Who Is This For?"),
 			Section: "Introduction",
-			Content: introAudienceContent,
+			Content: i18n.T(introAudienceContent),
 		},
 		{
 			ID:      "intro-quickstart",
-			Title:   "Quick Start",
+			Title:   i18n.T("Quick Start"),
 			Section: "Introduction",
-			Content: introQuickstartContent,
+			Content: i18n.T(introQuickstartContent),
 		},
 
 		// =============================================================
@@ -818,33 +819,33 @@ func defaultTutorialPages() []TutorialPage {
 		// =============================================================
 		{
 			ID:      "concepts-beads",
-			Title:   "What Are Beads?",
+			Title:   i18n.T("What Are Beads?"),
 			Section: "Core Concepts",
-			Content: conceptsBeadsContent,
+			Content: i18n.T(conceptsBeadsContent),
 		},
 		{
 			ID:      "concepts-dependencies",
-			Title:   "Dependencies & Blocking",
+			Title:   i18n.T("Dependencies & Blocking"),
 			Section: "Core Concepts",
-			Content: conceptsDependenciesContent,
+			Content: i18n.T(conceptsDependenciesContent),
 		},
 		{
 			ID:      "concepts-labels",
-			Title:   "Labels & Organization",
+			Title:   i18n.T("Labels & Organization"),
 			Section: "Core Concepts",
-			Content: conceptsLabelsContent,
+			Content: i18n.T(conceptsLabelsContent),
 		},
 		{
 			ID:      "concepts-priorities",
-			Title:   "Priorities & Status",
+			Title:   i18n.T("Priorities & Status"),
 			Section: "Core Concepts",
-			Content: conceptsPrioritiesContent,
+			Content: i18n.T(conceptsPrioritiesContent),
 		},
 		{
 			ID:      "concepts-graph",
-			Title:   "The Dependency Graph",
+			Title:   i18n.T("The Dependency Graph"),
 			Section: "Core Concepts",
-			Content: conceptsGraphContent,
+			Content: i18n.T(conceptsGraphContent),
 		},
 
 		// =============================================================
@@ -852,58 +853,58 @@ func defaultTutorialPages() []TutorialPage {
 		// =============================================================
 		{
 			ID:      "views-nav-fundamentals",
-			Title:   "Navigation Fundamentals",
+			Title:   i18n.T("Navigation Fundamentals"),
 			Section: "Views",
-			Content: viewsNavFundamentalsContent,
+			Content: i18n.T(viewsNavFundamentalsContent),
 		},
 		{
 			ID:       "views-list",
-			Title:    "List View",
+			Title:    i18n.T("List View"),
 			Section:  "Views",
 			Contexts: []string{"list"},
-			Content:  viewsListContent,
+			Content:  i18n.T(viewsListContent),
 		},
 		{
 			ID:       "views-detail",
-			Title:    "Detail View",
+			Title:    i18n.T("Detail View"),
 			Section:  "Views",
 			Contexts: []string{"detail"},
-			Content:  viewsDetailContent,
+			Content:  i18n.T(viewsDetailContent),
 		},
 		{
 			ID:       "views-split",
-			Title:    "Split View",
+			Title:    i18n.T("Split View"),
 			Section:  "Views",
 			Contexts: []string{"split"},
-			Content:  viewsSplitContent,
+			Content:  i18n.T(viewsSplitContent),
 		},
 		{
 			ID:       "views-board",
-			Title:    "Board View",
+			Title:    i18n.T("Board View"),
 			Section:  "Views",
 			Contexts: []string{"board"},
-			Content:  viewsBoardContent,
+			Content:  i18n.T(viewsBoardContent),
 		},
 		{
 			ID:       "views-graph",
-			Title:    "Graph View",
+			Title:    i18n.T("Graph View"),
 			Section:  "Views",
 			Contexts: []string{"graph"},
-			Content:  viewsGraphContent,
+			Content:  i18n.T(viewsGraphContent),
 		},
 		{
 			ID:       "views-insights",
-			Title:    "Insights Panel",
+			Title:    i18n.T("Insights Panel"),
 			Section:  "Views",
 			Contexts: []string{"insights"},
-			Content:  viewsInsightsContent,
+			Content:  i18n.T(viewsInsightsContent),
 		},
 		{
 			ID:       "views-history",
-			Title:    "History View",
+			Title:    i18n.T("History View"),
 			Section:  "Views",
 			Contexts: []string{"history"},
-			Content:  viewsHistoryContent,
+			Content:  i18n.T(viewsHistoryContent),
 		},
 
 		// =============================================================
@@ -911,45 +912,45 @@ func defaultTutorialPages() []TutorialPage {
 		// =============================================================
 		{
 			ID:      "advanced-semantic-search",
-			Title:   "Semantic + Hybrid Search",
+			Title:   i18n.T("Semantic + Hybrid Search"),
 			Section: "Advanced",
-			Content: advancedSemanticSearchContent,
+			Content: i18n.T(advancedSemanticSearchContent),
 		},
 		{
 			ID:      "advanced-time-travel",
-			Title:   "Time Travel",
+			Title:   i18n.T("Time Travel"),
 			Section: "Advanced",
-			Content: advancedTimeTravelContent,
+			Content: i18n.T(advancedTimeTravelContent),
 		},
 		{
 			ID:      "advanced-label-analytics",
-			Title:   "Label Analytics",
+			Title:   i18n.T("Label Analytics"),
 			Section: "Advanced",
-			Content: advancedLabelAnalyticsContent,
+			Content: i18n.T(advancedLabelAnalyticsContent),
 		},
 		{
 			ID:      "advanced-export",
-			Title:   "Export & Deployment",
+			Title:   i18n.T("Export & Deployment"),
 			Section: "Advanced",
-			Content: advancedExportContent,
+			Content: i18n.T(advancedExportContent),
 		},
 		{
 			ID:      "advanced-workspace",
-			Title:   "Workspace Mode",
+			Title:   i18n.T("Workspace Mode"),
 			Section: "Advanced",
-			Content: advancedWorkspaceContent,
+			Content: i18n.T(advancedWorkspaceContent),
 		},
 		{
 			ID:      "advanced-recipes",
-			Title:   "Recipes",
+			Title:   i18n.T("Recipes"),
 			Section: "Advanced",
-			Content: advancedRecipesContent,
+			Content: i18n.T(advancedRecipesContent),
 		},
 		{
 			ID:      "advanced-ai",
-			Title:   "AI Agent Integration",
+			Title:   i18n.T("AI Agent Integration"),
 			Section: "Advanced",
-			Content: advancedAIAgentContent,
+			Content: i18n.T(advancedAIAgentContent),
 		},
 
 		// =============================================================
@@ -957,33 +958,33 @@ func defaultTutorialPages() []TutorialPage {
 		// =============================================================
 		{
 			ID:      "workflow-new-feature",
-			Title:   "Starting a New Feature",
+			Title:   i18n.T("Starting a New Feature"),
 			Section: "Workflows",
-			Content: workflowNewFeatureContent,
+			Content: i18n.T(workflowNewFeatureContent),
 		},
 		{
 			ID:      "workflow-bug-triage",
-			Title:   "Triaging a Bug Report",
+			Title:   i18n.T("Triaging a Bug Report"),
 			Section: "Workflows",
-			Content: workflowBugTriageContent,
+			Content: i18n.T(workflowBugTriageContent),
 		},
 		{
 			ID:      "workflow-sprint-planning",
-			Title:   "Sprint Planning Session",
+			Title:   i18n.T("Sprint Planning Session"),
 			Section: "Workflows",
-			Content: workflowSprintPlanningContent,
+			Content: i18n.T(workflowSprintPlanningContent),
 		},
 		{
 			ID:      "workflow-onboarding",
-			Title:   "Onboarding New Members",
+			Title:   i18n.T("Onboarding New Members"),
 			Section: "Workflows",
-			Content: workflowOnboardingContent,
+			Content: i18n.T(workflowOnboardingContent),
 		},
 		{
 			ID:      "workflow-stakeholder-review",
-			Title:   "Stakeholder Reviews",
+			Title:   i18n.T("Stakeholder Reviews"),
 			Section: "Workflows",
-			Content: workflowStakeholderReviewContent,
+			Content: i18n.T(workflowStakeholderReviewContent),
 		},
 
 		// =============================================================
@@ -991,9 +992,9 @@ func defaultTutorialPages() []TutorialPage {
 		// =============================================================
 		{
 			ID:      "ref-keyboard",
-			Title:   "Keyboard Reference",
+			Title:   i18n.T("Keyboard Reference"),
 			Section: "Reference",
-			Content: `## Quick Keyboard Reference
+			Content: i18n.T(`## Quick Keyboard Reference
 
 ### Global
 | Key | Action |
@@ -1020,7 +1021,7 @@ func defaultTutorialPages() []TutorialPage {
 | **Alt+H** | Hybrid preset |
 | **o/c/r/a** | Status filter |
 
-> Press **?** in any view for context help.`,
+> Press **?** in any view for context help.`),
 		},
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/table"
 	"github.com/charmbracelet/lipgloss/tree"
@@ -125,7 +126,7 @@ func (t Tip) Render(theme Theme, width int) string {
 		Foreground(theme.Feature).
 		Bold(true)
 
-	icon := iconStyle.Render("💡 TIP  ")
+	icon := iconStyle.Render(i18n.T("💡 TIP") + "  ")
 
 	return boxStyle.Render(icon + t.Text)
 }
@@ -376,7 +377,7 @@ func (w Warning) Render(theme Theme, width int) string {
 		Foreground(theme.Blocked).
 		Bold(true)
 
-	icon := iconStyle.Render("⚠️  WARN ")
+	icon := iconStyle.Render(i18n.T("⚠️  WARN") + " ")
 
 	return boxStyle.Render(icon + w.Text)
 }
@@ -400,7 +401,7 @@ func (n Note) Render(theme Theme, width int) string {
 		Foreground(theme.InProgress).
 		Bold(true)
 
-	icon := iconStyle.Render("ℹ️  NOTE ")
+	icon := iconStyle.Render(i18n.T("ℹ️  NOTE") + " ")
 
 	return boxStyle.Render(icon + n.Text)
 }
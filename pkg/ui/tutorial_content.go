@@ -1,6 +1,9 @@
 package ui
 
-import "github.com/charmbracelet/lipgloss"
+import (
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+	"github.com/charmbracelet/lipgloss"
+)
 
 // StructuredTutorialPage represents a tutorial page with typed elements
 type StructuredTutorialPage struct {
@@ -37,22 +40,22 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Title:   "Welcome",
 			Section: "Introduction",
 			Elements: []TutorialElement{
-				Section{Title: "Welcome to beads_viewer"},
-				Paragraph{Text: "Issue tracking that lives in your code."},
+				Section{Title: i18n.T("Welcome to beads_viewer")},
+				Paragraph{Text: i18n.T("Issue tracking that lives in your code.")},
 				Spacer{Lines: 1},
-				Paragraph{Text: "The problem: You're deep in flow, coding away, when you need to check an issue. You switch to a browser, navigate to your tracker, lose context, and break concentration."},
+				Paragraph{Text: i18n.T("The problem: You're deep in flow, coding away, when you need to check an issue. You switch to a browser, navigate to your tracker, lose context, and break concentration.")},
 				Spacer{Lines: 1},
-				Paragraph{Text: "The solution: bv brings issue tracking into your terminal, where you already work. No browser tabs. No context switching. No cloud dependencies."},
+				Paragraph{Text: i18n.T("The solution: bv brings issue tracking into your terminal, where you already work. No browser tabs. No context switching. No cloud dependencies.")},
 				Spacer{Lines: 1},
-				Section{Title: "The 30-Second Value Proposition"},
+				Section{Title: i18n.T("The 30-Second Value Proposition")},
 				Bullet{Items: []string{
-					"Issues live in your repo - version controlled, diffable, greppable",
-					"Works offline - no internet required, no accounts to manage",
-					"AI-native - designed for both humans and coding agents",
-					"Zero dependencies - just a single binary and your git repo",
+					i18n.T("Issues live in your repo - version controlled, diffable, greppable"),
+					i18n.T("Works offline - no internet required, no accounts to manage"),
+					i18n.T("AI-native - designed for both humans and coding agents"),
+					i18n.T("Zero dependencies - just a single binary and your git repo"),
 				}},
 				Spacer{Lines: 1},
-				Tip{Text: "Press -> or Space to continue"},
+				Tip{Text: i18n.T("Press -> or Space to continue")},
 			},
 		},
 		{
@@ -60,18 +63,18 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Title:   "The Beads Philosophy",
 			Section: "Introduction",
 			Elements: []TutorialElement{
-				Section{Title: "Why \"beads\"?"},
-				Paragraph{Text: "Think of git commits as beads on a string - each one a discrete, meaningful step in your project's history. Issues are beads too."},
+				Section{Title: i18n.T("Why \"beads\"?")},
+				Paragraph{Text: i18n.T("Think of git commits as beads on a string - each one a discrete, meaningful step in your project's history. Issues are beads too.")},
 				Spacer{Lines: 1},
-				Section{Title: "Core Principles"},
+				Section{Title: i18n.T("Core Principles")},
 				Spacer{Lines: 1},
-				ValueProp{Icon: "①", Text: "Issues as First-Class Citizens - Your .beads/ directory gets the same git treatment as code: branching, merging, history."},
+				ValueProp{Icon: "①", Text: i18n.T("Issues as First-Class Citizens - Your .beads/ directory gets the same git treatment as code: branching, merging, history.")},
 				Spacer{Lines: 1},
-				ValueProp{Icon: "②", Text: "No External Dependencies - No servers. No accounts. No API keys. Git + terminal = everything."},
+				ValueProp{Icon: "②", Text: i18n.T("No External Dependencies - No servers. No accounts. No API keys. Git + terminal = everything.")},
 				Spacer{Lines: 1},
-				ValueProp{Icon: "③", Text: "Diffable and Greppable - Issues stored as plain JSONL. Git diff your backlog. Grep for patterns."},
+				ValueProp{Icon: "③", Text: i18n.T("Diffable and Greppable - Issues stored as plain JSONL. Git diff your backlog. Grep for patterns.")},
 				Spacer{Lines: 1},
-				ValueProp{Icon: "④", Text: "Human and Agent Readable - Same data works for humans (bv) and AI agents (--robot-* flags)."},
+				ValueProp{Icon: "④", Text: i18n.T("Human and Agent Readable - Same data works for humans (bv) and AI agents (--robot-* flags).")},
 			},
 		},
 		{
@@ -79,19 +82,19 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Title:   "Who Is This For?",
 			Section: "Introduction",
 			Elements: []TutorialElement{
-				Section{Title: "Solo Developers"},
-				Paragraph{Text: "Managing personal projects? Keep your TODO lists organized without heavyweight tools. Everything stays in your repo, backs up with your code."},
+				Section{Title: i18n.T("Solo Developers")},
+				Paragraph{Text: i18n.T("Managing personal projects? Keep your TODO lists organized without heavyweight tools. Everything stays in your repo, backs up with your code.")},
 				Spacer{Lines: 1},
-				Section{Title: "Small Teams"},
-				Paragraph{Text: "Want lightweight issue tracking without subscription fees? Share your .beads/ directory through git. Everyone sees the same state."},
+				Section{Title: i18n.T("Small Teams")},
+				Paragraph{Text: i18n.T("Want lightweight issue tracking without subscription fees? Share your .beads/ directory through git. Everyone sees the same state.")},
 				Spacer{Lines: 1},
-				Section{Title: "AI Coding Agents"},
-				Paragraph{Text: "This is where bv shines. AI agents need structured task management. The --robot-* flags output machine-readable JSON:"},
+				Section{Title: i18n.T("AI Coding Agents")},
+				Paragraph{Text: i18n.T("This is where bv shines. AI agents need structured task management. The --robot-* flags output machine-readable JSON:")},
 				Spacer{Lines: 1},
 				Code{Text: "bv --robot-triage    # What should I work on?\nbv --robot-plan      # How can work be parallelized?"},
 				Spacer{Lines: 1},
-				Section{Title: "Anyone Tired of Context-Switching"},
-				Paragraph{Text: "If you've ever lost your train of thought switching between your editor and a web-based tracker, bv is for you."},
+				Section{Title: i18n.T("Anyone Tired of Context-Switching")},
+				Paragraph{Text: i18n.T("If you've ever lost your train of thought switching between your editor and a web-based tracker, bv is for you.")},
 			},
 		},
 		{
@@ -99,32 +102,32 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Title:   "Quick Start",
 			Section: "Introduction",
 			Elements: []TutorialElement{
-				Section{Title: "You're already running bv!"},
+				Section{Title: i18n.T("You're already running bv!")},
 				Spacer{Lines: 1},
-				Section{Title: "Basic Navigation"},
+				Section{Title: i18n.T("Basic Navigation")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "j / k", Desc: "Move down / up"},
-					{Key: "Enter", Desc: "Open issue details"},
-					{Key: "Esc", Desc: "Close overlay / go back"},
-					{Key: "q", Desc: "Quit bv"},
+					{Key: "j / k", Desc: i18n.T("Move down / up")},
+					{Key: "Enter", Desc: i18n.T("Open issue details")},
+					{Key: "Esc", Desc: i18n.T("Close overlay / go back")},
+					{Key: "q", Desc: i18n.T("Quit bv")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Switching Views"},
+				Section{Title: i18n.T("Switching Views")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "b", Desc: "Board (Kanban)"},
-					{Key: "g", Desc: "Graph (dependencies)"},
-					{Key: "i", Desc: "Insights panel"},
-					{Key: "h", Desc: "History"},
+					{Key: "b", Desc: i18n.T("Board (Kanban)")},
+					{Key: "g", Desc: i18n.T("Graph (dependencies)")},
+					{Key: "i", Desc: i18n.T("Insights panel")},
+					{Key: "h", Desc: i18n.T("History")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Getting Help"},
+				Section{Title: i18n.T("Getting Help")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "?", Desc: "Quick help overlay"},
-					{Key: "Space", Desc: "This tutorial (in help)"},
-					{Key: ";", Desc: "Shortcuts sidebar"},
+					{Key: "?", Desc: i18n.T("Quick help overlay")},
+					{Key: "Space", Desc: i18n.T("This tutorial (in help)")},
+					{Key: ";", Desc: i18n.T("Shortcuts sidebar")},
 				}},
 				Spacer{Lines: 1},
-				Tip{Text: "Press t to see Table of Contents"},
+				Tip{Text: i18n.T("Press t to see Table of Contents")},
 			},
 		},
 
@@ -136,24 +139,24 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Title:   "What Are Beads?",
 			Section: "Core Concepts",
 			Elements: []TutorialElement{
-				Section{Title: "A bead is a unit of work"},
-				Paragraph{Text: "Think of your project's work as beads on a string - discrete items that together form the complete picture."},
+				Section{Title: i18n.T("A bead is a unit of work")},
+				Paragraph{Text: i18n.T("Think of your project's work as beads on a string - discrete items that together form the complete picture.")},
 				Spacer{Lines: 1},
-				Section{Title: "Issue Types"},
+				Section{Title: i18n.T("Issue Types")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "bug", Desc: "Something broken that needs fixing"},
-					{Key: "feature", Desc: "New functionality to add"},
-					{Key: "task", Desc: "General work item"},
-					{Key: "epic", Desc: "Large initiative with sub-tasks"},
-					{Key: "chore", Desc: "Maintenance, cleanup, tech debt"},
+					{Key: "bug", Desc: i18n.T("Something broken that needs fixing")},
+					{Key: "feature", Desc: i18n.T("New functionality to add")},
+					{Key: "task", Desc: i18n.T("General work item")},
+					{Key: "epic", Desc: i18n.T("Large initiative with sub-tasks")},
+					{Key: "chore", Desc: i18n.T("Maintenance, cleanup, tech debt")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Storage"},
-				Paragraph{Text: "Issues live in .beads/issues.jsonl - a simple JSON Lines file:"},
+				Section{Title: i18n.T("Storage")},
+				Paragraph{Text: i18n.T("Issues live in .beads/issues.jsonl - a simple JSON Lines file:")},
 				Bullet{Items: []string{
-					"Version controlled - branch, merge, history",
-					"Diffable - see exactly what changed",
-					"Greppable - search with standard tools",
+					i18n.T("Version controlled - branch, merge, history"),
+					i18n.T("Diffable - see exactly what changed"),
+					i18n.T("Greppable - search with standard tools"),
 				}},
 			},
 		},
@@ -162,29 +165,29 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Title:   "Dependencies & Blocking",
 			Section: "Core Concepts",
 			Elements: []TutorialElement{
-				Section{Title: "Not all work can happen in parallel"},
-				Paragraph{Text: "Some issues must wait for others. This is where dependencies come in."},
+				Section{Title: i18n.T("Not all work can happen in parallel")},
+				Paragraph{Text: i18n.T("Some issues must wait for others. This is where dependencies come in.")},
 				Spacer{Lines: 1},
-				Section{Title: "The Relationship"},
+				Section{Title: i18n.T("The Relationship")},
 				StatusFlow{Steps: []FlowStep{
 					{Label: "Auth Fix", Color: colorOpen},
 					{Label: "Deploy", Color: colorBlocked},
 				}},
 				Spacer{Lines: 1},
-				Paragraph{Text: "Auth Fix BLOCKS Deploy. You can't deploy until auth is fixed."},
+				Paragraph{Text: i18n.T("Auth Fix BLOCKS Deploy. You can't deploy until auth is fixed.")},
 				Spacer{Lines: 1},
-				Section{Title: "Visual Indicators"},
+				Section{Title: i18n.T("Visual Indicators")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "Red", Desc: "Blocked - waiting on something"},
-					{Key: "Green", Desc: "Ready - no blockers, can start"},
-					{Key: "->", Desc: "Shows what this issue blocks"},
-					{Key: "<-", Desc: "Shows what blocks this issue"},
+					{Key: i18n.T("Red"), Desc: i18n.T("Blocked - waiting on something")},
+					{Key: i18n.T("Green"), Desc: i18n.T("Ready - no blockers, can start")},
+					{Key: "->", Desc: i18n.T("Shows what this issue blocks")},
+					{Key: "<-", Desc: i18n.T("Shows what blocks this issue")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "The Ready Filter"},
-				Paragraph{Text: "Press r to filter to ready issues: Open + Zero Blockers"},
+				Section{Title: i18n.T("The Ready Filter")},
+				Paragraph{Text: i18n.T("Press r to filter to ready issues: Open + Zero Blockers")},
 				Spacer{Lines: 1},
-				Tip{Text: "Start your day with 'bd ready' to see actionable work"},
+				Tip{Text: i18n.T("Start your day with 'bd ready' to see actionable work")},
 			},
 		},
 		{
@@ -192,25 +195,25 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Title:   "Labels & Organization",
 			Section: "Core Concepts",
 			Elements: []TutorialElement{
-				Section{Title: "Flexible categorization"},
-				Paragraph{Text: "Labels provide flexible categorization that cuts across types and priorities."},
+				Section{Title: i18n.T("Flexible categorization")},
+				Paragraph{Text: i18n.T("Labels provide flexible categorization that cuts across types and priorities.")},
 				Spacer{Lines: 1},
-				Section{Title: "Common Label Patterns"},
+				Section{Title: i18n.T("Common Label Patterns")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "Area", Desc: "frontend, backend, api, database"},
-					{Key: "Owner", Desc: "team-alpha, @alice, contractor"},
-					{Key: "Scope", Desc: "mvp, v2, tech-debt, nice-to-have"},
-					{Key: "State", Desc: "needs-review, blocked-external"},
+					{Key: i18n.T("Area"), Desc: "frontend, backend, api, database"},
+					{Key: i18n.T("Owner"), Desc: "team-alpha, @alice, contractor"},
+					{Key: i18n.T("Scope"), Desc: "mvp, v2, tech-debt, nice-to-have"},
+					{Key: i18n.T("State"), Desc: "needs-review, blocked-external"},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Working with Labels"},
+				Section{Title: i18n.T("Working with Labels")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "L", Desc: "Open label picker"},
-					{Key: "Shift+L", Desc: "Filter by label"},
-					{Key: "[", Desc: "Labels dashboard view"},
+					{Key: "L", Desc: i18n.T("Open label picker")},
+					{Key: "Shift+L", Desc: i18n.T("Filter by label")},
+					{Key: "[", Desc: i18n.T("Labels dashboard view")},
 				}},
 				Spacer{Lines: 1},
-				Tip{Text: "Keep your label set small. Too many = no one uses them."},
+				Tip{Text: i18n.T("Keep your label set small. Too many = no one uses them.")},
 			},
 		},
 		{
@@ -218,31 +221,31 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Title:   "Priorities & Status",
 			Section: "Core Concepts",
 			Elements: []TutorialElement{
-				Section{Title: "How important? Where in the workflow?"},
+				Section{Title: i18n.T("How important? Where in the workflow?")},
 				Spacer{Lines: 1},
-				Section{Title: "Priority Levels"},
+				Section{Title: i18n.T("Priority Levels")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "P0", Desc: "Critical/emergency - drop everything"},
-					{Key: "P1", Desc: "High priority - this sprint/week"},
-					{Key: "P2", Desc: "Medium - this cycle/month"},
-					{Key: "P3", Desc: "Low - when you have time"},
-					{Key: "P4", Desc: "Backlog - someday/maybe"},
+					{Key: "P0", Desc: i18n.T("Critical/emergency - drop everything")},
+					{Key: "P1", Desc: i18n.T("High priority - this sprint/week")},
+					{Key: "P2", Desc: i18n.T("Medium - this cycle/month")},
+					{Key: "P3", Desc: i18n.T("Low - when you have time")},
+					{Key: "P4", Desc: i18n.T("Backlog - someday/maybe")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Status Flow"},
+				Section{Title: i18n.T("Status Flow")},
 				StatusFlow{Steps: []FlowStep{
 					{Label: "open", Color: colorOpen},
 					{Label: "in_progress", Color: colorInProgress},
 					{Label: "closed", Color: colorClosed},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Changing Priority/Status"},
+				Section{Title: i18n.T("Changing Priority/Status")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "p", Desc: "Change priority"},
-					{Key: "s", Desc: "Change status"},
+					{Key: "p", Desc: i18n.T("Change priority")},
+					{Key: "s", Desc: i18n.T("Change status")},
 				}},
 				Spacer{Lines: 1},
-				Tip{Text: "If everything is P0, nothing is P0"},
+				Tip{Text: i18n.T("If everything is P0, nothing is P0")},
 			},
 		},
 		{
@@ -250,10 +253,10 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Title:   "The Dependency Graph",
 			Section: "Core Concepts",
 			Elements: []TutorialElement{
-				Section{Title: "Your issues form a directed graph"},
-				Paragraph{Text: "Work flows in one direction - no cycles allowed."},
+				Section{Title: i18n.T("Your issues form a directed graph")},
+				Paragraph{Text: i18n.T("Work flows in one direction - no cycles allowed.")},
 				Spacer{Lines: 1},
-				Section{Title: "Example Dependency Tree"},
+				Section{Title: i18n.T("Example Dependency Tree")},
 				Tree{
 					Root: "Epic: User Auth (bv-001)",
 					Children: []TutorialTreeNode{
@@ -267,28 +270,28 @@ func structuredTutorialPages() []StructuredTutorialPage {
 					},
 				},
 				Spacer{Lines: 1},
-				Section{Title: "Key Insights"},
+				Section{Title: i18n.T("Key Insights")},
 				Bullet{Items: []string{
-					"Root nodes (no arrows in) → Can start immediately",
-					"Leaf nodes (no arrows out) → Nothing depends on them",
-					"High fan-out → Completing this unblocks many items",
-					"Critical path → Longest chain = minimum time",
+					i18n.T("Root nodes (no arrows in) → Can start immediately"),
+					i18n.T("Leaf nodes (no arrows out) → Nothing depends on them"),
+					i18n.T("High fan-out → Completing this unblocks many items"),
+					i18n.T("Critical path → Longest chain = minimum time"),
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Visual Encoding"},
+				Section{Title: i18n.T("Visual Encoding")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "Node size", Desc: "Priority (bigger = higher)"},
-					{Key: "Green", Desc: "Closed"},
-					{Key: "Blue", Desc: "In progress"},
-					{Key: "Red", Desc: "Blocked"},
-					{Key: "A → B", Desc: "A blocks B"},
+					{Key: i18n.T("Node size"), Desc: i18n.T("Priority (bigger = higher)")},
+					{Key: i18n.T("Green"), Desc: i18n.T("Closed")},
+					{Key: i18n.T("Blue"), Desc: i18n.T("In progress")},
+					{Key: i18n.T("Red"), Desc: i18n.T("Blocked")},
+					{Key: "A → B", Desc: i18n.T("A blocks B")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "What to Look For"},
+				Section{Title: i18n.T("What to Look For")},
 				Bullet{Items: []string{
-					"Bottlenecks: One issue blocking many others",
-					"Parallel tracks: Independent work streams",
-					"Priority inversions: Low-priority blocking high-priority",
+					i18n.T("Bottlenecks: One issue blocking many others"),
+					i18n.T("Parallel tracks: Independent work streams"),
+					i18n.T("Priority inversions: Low-priority blocking high-priority"),
 				}},
 			},
 		},
@@ -301,34 +304,34 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Title:   "Navigation Fundamentals",
 			Section: "Views",
 			Elements: []TutorialElement{
-				Section{Title: "Vim-style navigation throughout"},
-				Paragraph{Text: "If you know vim, you're already at home. If not, you'll pick it up in minutes."},
+				Section{Title: i18n.T("Vim-style navigation throughout")},
+				Paragraph{Text: i18n.T("If you know vim, you're already at home. If not, you'll pick it up in minutes.")},
 				Spacer{Lines: 1},
-				Section{Title: "Core Movement"},
+				Section{Title: i18n.T("Core Movement")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "j", Desc: "Move down"},
-					{Key: "k", Desc: "Move up"},
-					{Key: "h", Desc: "Move left (multi-column)"},
-					{Key: "l", Desc: "Move right (multi-column)"},
+					{Key: "j", Desc: i18n.T("Move down")},
+					{Key: "k", Desc: i18n.T("Move up")},
+					{Key: "h", Desc: i18n.T("Move left (multi-column)")},
+					{Key: "l", Desc: i18n.T("Move right (multi-column)")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Jump Commands"},
+				Section{Title: i18n.T("Jump Commands")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "g", Desc: "Jump to top"},
-					{Key: "G", Desc: "Jump to bottom"},
-					{Key: "Ctrl+d", Desc: "Half-page down"},
-					{Key: "Ctrl+u", Desc: "Half-page up"},
+					{Key: "g", Desc: i18n.T("Jump to top")},
+					{Key: "G", Desc: i18n.T("Jump to bottom")},
+					{Key: "Ctrl+d", Desc: i18n.T("Half-page down")},
+					{Key: "Ctrl+u", Desc: i18n.T("Half-page up")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Universal Keys"},
+				Section{Title: i18n.T("Universal Keys")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "?", Desc: "Help overlay"},
-					{Key: "Esc", Desc: "Close / go back"},
-					{Key: "Enter", Desc: "Select / open"},
-					{Key: "q", Desc: "Quit bv"},
+					{Key: "?", Desc: i18n.T("Help overlay")},
+					{Key: "Esc", Desc: i18n.T("Close / go back")},
+					{Key: "Enter", Desc: i18n.T("Select / open")},
+					{Key: "q", Desc: i18n.T("Quit bv")},
 				}},
 				Spacer{Lines: 1},
-				Tip{Text: "Press ; for a shortcuts sidebar that stays visible"},
+				Tip{Text: i18n.T("Press ; for a shortcuts sidebar that stays visible")},
 			},
 		},
 		{
@@ -337,30 +340,30 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Section:  "Views",
 			Contexts: []string{"list"},
 			Elements: []TutorialElement{
-				Section{Title: "Your issue inbox"},
-				Paragraph{Text: "This is where you'll spend most of your time."},
+				Section{Title: i18n.T("Your issue inbox")},
+				Paragraph{Text: i18n.T("This is where you'll spend most of your time.")},
 				Spacer{Lines: 1},
-				Section{Title: "Filtering"},
+				Section{Title: i18n.T("Filtering")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "o", Desc: "Open issues only"},
-					{Key: "c", Desc: "Closed issues only"},
-					{Key: "r", Desc: "Ready (no blockers)"},
-					{Key: "a", Desc: "All (reset filter)"},
+					{Key: "o", Desc: i18n.T("Open issues only")},
+					{Key: "c", Desc: i18n.T("Closed issues only")},
+					{Key: "r", Desc: i18n.T("Ready (no blockers)")},
+					{Key: "a", Desc: i18n.T("All (reset filter)")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Searching"},
+				Section{Title: i18n.T("Searching")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "/", Desc: "Fuzzy search (fast, typo-tolerant)"},
-					{Key: "Ctrl+S", Desc: "Semantic search (vector index)"},
-					{Key: "H", Desc: "Hybrid ranking (semantic)"},
-					{Key: "Alt+H", Desc: "Cycle hybrid preset"},
-					{Key: "n / N", Desc: "Next / prev result"},
+					{Key: "/", Desc: i18n.T("Fuzzy search (fast, typo-tolerant)")},
+					{Key: "Ctrl+S", Desc: i18n.T("Semantic search (vector index)")},
+					{Key: "H", Desc: i18n.T("Hybrid ranking (semantic)")},
+					{Key: "Alt+H", Desc: i18n.T("Cycle hybrid preset")},
+					{Key: "n / N", Desc: i18n.T("Next / prev result")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Sorting"},
-				Paragraph{Text: "Press s to cycle: priority -> created -> updated. Press S to reverse."},
+				Section{Title: i18n.T("Sorting")},
+				Paragraph{Text: i18n.T("Press s to cycle: priority -> created -> updated. Press S to reverse.")},
 				Spacer{Lines: 1},
-				Tip{Text: "Filter to r (ready) and work top-down for daily triage"},
+				Tip{Text: i18n.T("Filter to r (ready) and work top-down for daily triage")},
 			},
 		},
 		{
@@ -369,27 +372,27 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Section:  "Views",
 			Contexts: []string{"detail"},
 			Elements: []TutorialElement{
-				Section{Title: "Full issue details"},
-				Paragraph{Text: "Press Enter on any issue to see its full details."},
+				Section{Title: i18n.T("Full issue details")},
+				Paragraph{Text: i18n.T("Press Enter on any issue to see its full details.")},
 				Spacer{Lines: 1},
-				Section{Title: "What You See"},
+				Section{Title: i18n.T("What You See")},
 				Bullet{Items: []string{
-					"Status, Priority, Type, Created date",
-					"Full description with markdown rendering",
-					"Dependencies (what it blocks, what blocks it)",
-					"Labels and other metadata",
+					i18n.T("Status, Priority, Type, Created date"),
+					i18n.T("Full description with markdown rendering"),
+					i18n.T("Dependencies (what it blocks, what blocks it)"),
+					i18n.T("Labels and other metadata"),
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Detail View Actions"},
+				Section{Title: i18n.T("Detail View Actions")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "O", Desc: "Open in external editor"},
-					{Key: "C", Desc: "Copy issue ID to clipboard"},
-					{Key: "j / k", Desc: "Scroll content"},
-					{Key: "Esc", Desc: "Return to list"},
+					{Key: "O", Desc: i18n.T("Open in external editor")},
+					{Key: "C", Desc: i18n.T("Copy issue ID to clipboard")},
+					{Key: "j / k", Desc: i18n.T("Scroll content")},
+					{Key: "Esc", Desc: i18n.T("Return to list")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Markdown Support"},
-				Paragraph{Text: "Descriptions render with headers, bold, code blocks, lists, and tables."},
+				Section{Title: i18n.T("Markdown Support")},
+				Paragraph{Text: i18n.T("Descriptions render with headers, bold, code blocks, lists, and tables.")},
 			},
 		},
 		{
@@ -398,24 +401,24 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Section:  "Views",
 			Contexts: []string{"split"},
 			Elements: []TutorialElement{
-				Section{Title: "List and detail side by side"},
-				Paragraph{Text: "Press Tab from Detail view to enter Split view."},
+				Section{Title: i18n.T("List and detail side by side")},
+				Paragraph{Text: i18n.T("Press Tab from Detail view to enter Split view.")},
 				Spacer{Lines: 1},
-				Section{Title: "Navigation"},
+				Section{Title: i18n.T("Navigation")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "Tab", Desc: "Switch focus between panes"},
-					{Key: "j / k", Desc: "Navigate in focused pane"},
-					{Key: "Esc", Desc: "Return to full list"},
+					{Key: "Tab", Desc: i18n.T("Switch focus between panes")},
+					{Key: "j / k", Desc: i18n.T("Navigate in focused pane")},
+					{Key: "Esc", Desc: i18n.T("Return to full list")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "When to Use"},
+				Section{Title: i18n.T("When to Use")},
 				Bullet{Items: []string{
-					"Code review: Quickly scan multiple issues",
-					"Triage session: Read details without losing context",
-					"Dependency analysis: Navigate while viewing relationships",
+					i18n.T("Code review: Quickly scan multiple issues"),
+					i18n.T("Triage session: Read details without losing context"),
+					i18n.T("Dependency analysis: Navigate while viewing relationships"),
 				}},
 				Spacer{Lines: 1},
-				Tip{Text: "Detail pane auto-updates as you navigate the list"},
+				Tip{Text: i18n.T("Detail pane auto-updates as you navigate the list")},
 			},
 		},
 		{
@@ -424,29 +427,29 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Section:  "Views",
 			Contexts: []string{"board"},
 			Elements: []TutorialElement{
-				Section{Title: "Kanban-style board"},
-				Paragraph{Text: "Press b to switch to the board view."},
+				Section{Title: i18n.T("Kanban-style board")},
+				Paragraph{Text: i18n.T("Press b to switch to the board view.")},
 				Spacer{Lines: 1},
-				Section{Title: "Navigation"},
+				Section{Title: i18n.T("Navigation")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "h / l", Desc: "Move between columns"},
-					{Key: "j / k", Desc: "Move within column"},
-					{Key: "Tab", Desc: "Toggle detail panel"},
-					{Key: "Enter", Desc: "View issue details"},
+					{Key: "h / l", Desc: i18n.T("Move between columns")},
+					{Key: "j / k", Desc: i18n.T("Move within column")},
+					{Key: "Tab", Desc: i18n.T("Toggle detail panel")},
+					{Key: "Enter", Desc: i18n.T("View issue details")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Grouping Modes"},
+				Section{Title: i18n.T("Grouping Modes")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "s", Desc: "Cycle: Status -> Priority -> Type"},
-					{Key: "e", Desc: "Toggle empty columns"},
-					{Key: "d", Desc: "Inline card expansion"},
+					{Key: "s", Desc: i18n.T("Cycle: Status -> Priority -> Type")},
+					{Key: "e", Desc: i18n.T("Toggle empty columns")},
+					{Key: "d", Desc: i18n.T("Inline card expansion")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Card Border Colors"},
+				Section{Title: i18n.T("Card Border Colors")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "Red", Desc: "Has blockers"},
-					{Key: "Yellow", Desc: "High-impact (blocks others)"},
-					{Key: "Green", Desc: "Ready to work"},
+					{Key: i18n.T("Red"), Desc: i18n.T("Has blockers")},
+					{Key: i18n.T("Yellow"), Desc: i18n.T("High-impact (blocks others)")},
+					{Key: i18n.T("Green"), Desc: i18n.T("Ready to work")},
 				}},
 			},
 		},
@@ -456,30 +459,30 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Section:  "Views",
 			Contexts: []string{"graph"},
 			Elements: []TutorialElement{
-				Section{Title: "Visualize dependencies"},
-				Paragraph{Text: "Press g to see issues as a dependency graph."},
+				Section{Title: i18n.T("Visualize dependencies")},
+				Paragraph{Text: i18n.T("Press g to see issues as a dependency graph.")},
 				Spacer{Lines: 1},
-				Section{Title: "Reading the Graph"},
+				Section{Title: i18n.T("Reading the Graph")},
 				Bullet{Items: []string{
-					"Arrows point TO what's blocked (A->B = A blocks B)",
-					"Node size reflects priority",
-					"Color indicates status",
-					"Highlighted node is your selection",
+					i18n.T("Arrows point TO what's blocked (A->B = A blocks B)"),
+					i18n.T("Node size reflects priority"),
+					i18n.T("Color indicates status"),
+					i18n.T("Highlighted node is your selection"),
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Navigation"},
+				Section{Title: i18n.T("Navigation")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "j / k", Desc: "Navigate between nodes"},
-					{Key: "h / l", Desc: "Navigate siblings"},
-					{Key: "f", Desc: "Focus on subgraph"},
-					{Key: "Enter", Desc: "View selected issue"},
+					{Key: "j / k", Desc: i18n.T("Navigate between nodes")},
+					{Key: "h / l", Desc: i18n.T("Navigate siblings")},
+					{Key: "f", Desc: i18n.T("Focus on subgraph")},
+					{Key: "Enter", Desc: i18n.T("View selected issue")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Use Cases"},
+				Section{Title: i18n.T("Use Cases")},
 				Bullet{Items: []string{
-					"Critical path analysis",
-					"Dependency planning",
-					"Impact assessment",
+					i18n.T("Critical path analysis"),
+					i18n.T("Dependency planning"),
+					i18n.T("Impact assessment"),
 				}},
 			},
 		},
@@ -489,27 +492,27 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Section:  "Views",
 			Contexts: []string{"insights"},
 			Elements: []TutorialElement{
-				Section{Title: "AI-powered prioritization"},
-				Paragraph{Text: "Press i to open the Insights panel."},
+				Section{Title: i18n.T("AI-powered prioritization")},
+				Paragraph{Text: i18n.T("Press i to open the Insights panel.")},
 				Spacer{Lines: 1},
-				Section{Title: "Priority Score Factors"},
+				Section{Title: i18n.T("Priority Score Factors")},
 				Bullet{Items: []string{
-					"Explicit priority (P0-P4)",
-					"Blocking factor - how many issues it unblocks",
-					"Freshness - recently updated scores higher",
-					"Type weight - bugs often over features",
+					i18n.T("Explicit priority (P0-P4)"),
+					i18n.T("Blocking factor - how many issues it unblocks"),
+					i18n.T("Freshness - recently updated scores higher"),
+					i18n.T("Type weight - bugs often over features"),
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Attention Scores"},
-				Paragraph{Text: "The panel highlights issues needing attention:"},
+				Section{Title: i18n.T("Attention Scores")},
+				Paragraph{Text: i18n.T("The panel highlights issues needing attention:")},
 				Bullet{Items: []string{
-					"Stale issues: Open too long without updates",
-					"Blocked chains: Issues creating bottlenecks",
-					"Priority inversions: Low blocking high",
+					i18n.T("Stale issues: Open too long without updates"),
+					i18n.T("Blocked chains: Issues creating bottlenecks"),
+					i18n.T("Priority inversions: Low blocking high"),
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Heatmap Mode"},
-				Paragraph{Text: "Press m to color by attention: Red=high, Yellow=moderate, Green=on track"},
+				Section{Title: i18n.T("Heatmap Mode")},
+				Paragraph{Text: i18n.T("Press m to color by attention: Red=high, Yellow=moderate, Green=on track")},
 			},
 		},
 		{
@@ -518,28 +521,28 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Section:  "Views",
 			Contexts: []string{"history"},
 			Elements: []TutorialElement{
-				Section{Title: "Git-integrated timeline"},
-				Paragraph{Text: "Press h to see commits correlated with bead changes."},
+				Section{Title: i18n.T("Git-integrated timeline")},
+				Paragraph{Text: i18n.T("Press h to see commits correlated with bead changes.")},
 				Spacer{Lines: 1},
-				Section{Title: "Navigation"},
+				Section{Title: i18n.T("Navigation")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "j / k", Desc: "Navigate timeline"},
-					{Key: "v", Desc: "Toggle Bead/Git mode"},
-					{Key: "f", Desc: "Toggle file tree panel"},
-					{Key: "Tab", Desc: "Cycle focus"},
+					{Key: "j / k", Desc: i18n.T("Navigate timeline")},
+					{Key: "v", Desc: i18n.T("Toggle Bead/Git mode")},
+					{Key: "f", Desc: i18n.T("Toggle file tree panel")},
+					{Key: "Tab", Desc: i18n.T("Cycle focus")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Causality Markers"},
+				Section{Title: i18n.T("Causality Markers")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "Direct", Desc: "Commit mentions bead ID"},
-					{Key: "Temporal", Desc: "Within time window"},
-					{Key: "File", Desc: "Touches associated files"},
+					{Key: "Direct", Desc: i18n.T("Commit mentions bead ID")},
+					{Key: "Temporal", Desc: i18n.T("Within time window")},
+					{Key: "File", Desc: i18n.T("Touches associated files")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Time Travel"},
-				Paragraph{Text: "Press Enter on a commit to see project state at that point (read-only)."},
+				Section{Title: i18n.T("Time Travel")},
+				Paragraph{Text: i18n.T("Press Enter on a commit to see project state at that point (read-only).")},
 				Spacer{Lines: 1},
-				Tip{Text: "Use t for time travel with git ref input"},
+				Tip{Text: i18n.T("Use t for time travel with git ref input")},
 			},
 		},
 
@@ -551,33 +554,33 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Title:   "Semantic + Hybrid Search",
 			Section: "Advanced",
 			Elements: []TutorialElement{
-				Section{Title: "Find issues by meaning, then rank by importance"},
-				Paragraph{Text: "Semantic search builds a local vector index from issue text so you can search by meaning without leaving the terminal."},
-				Paragraph{Text: "Hybrid mode re-ranks those semantic matches using graph signals (impact, status, priority, recency), so results stay relevant while surfacing what matters most."},
+				Section{Title: i18n.T("Find issues by meaning, then rank by importance")},
+				Paragraph{Text: i18n.T("Semantic search builds a local vector index from issue text so you can search by meaning without leaving the terminal.")},
+				Paragraph{Text: i18n.T("Hybrid mode re-ranks those semantic matches using graph signals (impact, status, priority, recency), so results stay relevant while surfacing what matters most.")},
 				Spacer{Lines: 1},
-				Section{Title: "Search Modes"},
+				Section{Title: i18n.T("Search Modes")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "/", Desc: "Fuzzy search (literal text)"},
-					{Key: "Ctrl+S", Desc: "Semantic search (meaning)"},
-					{Key: "H", Desc: "Hybrid ranking (meaning + graph)"},
-					{Key: "Alt+H", Desc: "Cycle hybrid preset"},
+					{Key: "/", Desc: i18n.T("Fuzzy search (literal text)")},
+					{Key: "Ctrl+S", Desc: i18n.T("Semantic search (meaning)")},
+					{Key: "H", Desc: i18n.T("Hybrid ranking (meaning + graph)")},
+					{Key: "Alt+H", Desc: i18n.T("Cycle hybrid preset")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Example"},
-				Paragraph{Text: "Searching \"permissions\":"},
+				Section{Title: i18n.T("Example")},
+				Paragraph{Text: i18n.T("Searching \"permissions\":")},
 				Bullet{Items: []string{
-					"Fuzzy finds issues containing the word permissions",
-					"Semantic finds access control, roles, authorization, ACLs",
-					"Hybrid keeps those results but floats the ones with higher impact",
+					i18n.T("Fuzzy finds issues containing the word permissions"),
+					i18n.T("Semantic finds access control, roles, authorization, ACLs"),
+					i18n.T("Hybrid keeps those results but floats the ones with higher impact"),
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "How It Stays Fast"},
-				Paragraph{Text: "The index uses a weighted issue document (ID/title emphasized) so quick searches are precise. Short queries get a literal-match boost so you can type a single word and still land on the right issue."},
+				Section{Title: i18n.T("How It Stays Fast")},
+				Paragraph{Text: i18n.T("The index uses a weighted issue document (ID/title emphasized) so quick searches are precise. Short queries get a literal-match boost so you can type a single word and still land on the right issue.")},
 				Spacer{Lines: 1},
-				Section{Title: "Tuning"},
+				Section{Title: i18n.T("Tuning")},
 				Code{Text: "BV_SEARCH_MODE=hybrid\nBV_SEARCH_PRESET=impact-first\nBV_SEARCH_WEIGHTS='{\"text\":0.4,\"pagerank\":0.2,\"status\":0.15,\"impact\":0.1,\"priority\":0.1,\"recency\":0.05}'"},
 				Spacer{Lines: 1},
-				Tip{Text: "Use natural language for semantic search and switch to hybrid when you want the most important matches surfaced."},
+				Tip{Text: i18n.T("Use natural language for semantic search and switch to hybrid when you want the most important matches surfaced.")},
 			},
 		},
 		{
@@ -585,28 +588,28 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Title:   "Time Travel",
 			Section: "Advanced",
 			Elements: []TutorialElement{
-				Section{Title: "See how your project looked at any point"},
+				Section{Title: i18n.T("See how your project looked at any point")},
 				Spacer{Lines: 1},
-				Section{Title: "Accessing Time Travel"},
+				Section{Title: i18n.T("Accessing Time Travel")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "t", Desc: "Full time travel with git ref input"},
-					{Key: "T", Desc: "Quick travel to HEAD~5"},
-					{Key: "h", Desc: "History view (visual timeline)"},
+					{Key: "t", Desc: i18n.T("Full time travel with git ref input")},
+					{Key: "T", Desc: i18n.T("Quick travel to HEAD~5")},
+					{Key: "h", Desc: i18n.T("History view (visual timeline)")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Git Reference Syntax"},
+				Section{Title: i18n.T("Git Reference Syntax")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "HEAD~5", Desc: "5 commits ago"},
-					{Key: "main", Desc: "Tip of main branch"},
-					{Key: "v1.2.0", Desc: "Tagged release"},
-					{Key: "@{2.weeks.ago}", Desc: "Two weeks back"},
+					{Key: "HEAD~5", Desc: i18n.T("5 commits ago")},
+					{Key: "main", Desc: i18n.T("Tip of main branch")},
+					{Key: "v1.2.0", Desc: i18n.T("Tagged release")},
+					{Key: "@{2.weeks.ago}", Desc: i18n.T("Two weeks back")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Use Cases"},
+				Section{Title: i18n.T("Use Cases")},
 				Bullet{Items: []string{
-					"Sprint review: What did we accomplish?",
-					"Debugging: When did this get blocked?",
-					"Onboarding: What was the project like 6mo ago?",
+					i18n.T("Sprint review: What did we accomplish?"),
+					i18n.T("Debugging: When did this get blocked?"),
+					i18n.T("Onboarding: What was the project like 6mo ago?"),
 				}},
 			},
 		},
@@ -615,26 +618,26 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Title:   "Label Analytics",
 			Section: "Advanced",
 			Elements: []TutorialElement{
-				Section{Title: "Labels are a lens for understanding"},
-				Paragraph{Text: "Press [ to open the Labels dashboard."},
+				Section{Title: i18n.T("Labels are a lens for understanding")},
+				Paragraph{Text: i18n.T("Press [ to open the Labels dashboard.")},
 				Spacer{Lines: 1},
-				Section{Title: "Health Indicators"},
+				Section{Title: i18n.T("Health Indicators")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "OK", Desc: "Healthy - good progress, few blockers"},
-					{Key: "WARN", Desc: "Warning - stale or slow velocity"},
-					{Key: "CRIT", Desc: "Critical - high blocked ratio"},
+					{Key: "OK", Desc: i18n.T("Healthy - good progress, few blockers")},
+					{Key: "WARN", Desc: i18n.T("Warning - stale or slow velocity")},
+					{Key: "CRIT", Desc: i18n.T("Critical - high blocked ratio")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Health Score Factors"},
+				Section{Title: i18n.T("Health Score Factors")},
 				Bullet{Items: []string{
-					"Velocity: How fast are issues closing?",
-					"Staleness: Are old issues piling up?",
-					"Blocked ratio: What % is stuck?",
-					"Work distribution: Is work spread evenly?",
+					i18n.T("Velocity: How fast are issues closing?"),
+					i18n.T("Staleness: Are old issues piling up?"),
+					i18n.T("Blocked ratio: What % is stuck?"),
+					i18n.T("Work distribution: Is work spread evenly?"),
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Cross-Label Flow"},
-				Paragraph{Text: "Press f in Labels view to see which areas block others."},
+				Section{Title: i18n.T("Cross-Label Flow")},
+				Paragraph{Text: i18n.T("Press f in Labels view to see which areas block others.")},
 			},
 		},
 		{
@@ -642,23 +645,23 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Title:   "Export & Deployment",
 			Section: "Advanced",
 			Elements: []TutorialElement{
-				Section{Title: "Share with non-terminal users"},
+				Section{Title: i18n.T("Share with non-terminal users")},
 				Spacer{Lines: 1},
-				Section{Title: "Quick Markdown Export"},
-				Paragraph{Text: "Press x in any view to export current state to markdown. Great for Slack, email, meeting notes."},
+				Section{Title: i18n.T("Quick Markdown Export")},
+				Paragraph{Text: i18n.T("Press x in any view to export current state to markdown. Great for Slack, email, meeting notes.")},
 				Spacer{Lines: 1},
-				Section{Title: "Static Site Generation"},
+				Section{Title: i18n.T("Static Site Generation")},
 				Code{Text: "bv --pages              # Interactive wizard\nbv --export-pages ./out # Export to directory\nbv --preview-pages ./out # Preview locally"},
 				Spacer{Lines: 1},
-				Section{Title: "Output Includes"},
+				Section{Title: i18n.T("Output Includes")},
 				Bullet{Items: []string{
-					"Triage recommendations",
-					"Dependency graph visualization",
-					"Full-text search",
-					"Works offline - no server required",
+					i18n.T("Triage recommendations"),
+					i18n.T("Dependency graph visualization"),
+					i18n.T("Full-text search"),
+					i18n.T("Works offline - no server required"),
 				}},
 				Spacer{Lines: 1},
-				Tip{Text: "Deploy to GitHub Pages or Cloudflare Pages"},
+				Tip{Text: i18n.T("Deploy to GitHub Pages or Cloudflare Pages")},
 			},
 		},
 		{
@@ -666,26 +669,26 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Title:   "Workspace Mode",
 			Section: "Advanced",
 			Elements: []TutorialElement{
-				Section{Title: "Multiple repos, unified view"},
+				Section{Title: i18n.T("Multiple repos, unified view")},
 				Spacer{Lines: 1},
-				Section{Title: "When to Use"},
+				Section{Title: i18n.T("When to Use")},
 				Bullet{Items: []string{
-					"Monorepo alternatives: Multiple related repos",
-					"Microservices: Track issues across services",
-					"Frontend + Backend: Separate repos, unified view",
+					i18n.T("Monorepo alternatives: Multiple related repos"),
+					i18n.T("Microservices: Track issues across services"),
+					i18n.T("Frontend + Backend: Separate repos, unified view"),
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Setup"},
-				Paragraph{Text: "Create .beads/workspace.json with repo paths and prefixes."},
+				Section{Title: i18n.T("Setup")},
+				Paragraph{Text: i18n.T("Create .beads/workspace.json with repo paths and prefixes.")},
 				Spacer{Lines: 1},
-				Section{Title: "Navigation"},
+				Section{Title: i18n.T("Navigation")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "w", Desc: "Toggle workspace picker"},
-					{Key: "W", Desc: "Workspace-wide search"},
+					{Key: "w", Desc: i18n.T("Toggle workspace picker")},
+					{Key: "W", Desc: i18n.T("Workspace-wide search")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Cross-Repo Dependencies"},
-				Paragraph{Text: "Issues can depend on issues in other repos. The graph shows these relationships."},
+				Section{Title: i18n.T("Cross-Repo Dependencies")},
+				Paragraph{Text: i18n.T("Issues can depend on issues in other repos. The graph shows these relationships.")},
 			},
 		},
 		{
@@ -693,22 +696,22 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Title:   "Recipes",
 			Section: "Advanced",
 			Elements: []TutorialElement{
-				Section{Title: "Saved filter combinations"},
-				Paragraph{Text: "Press ' (single quote) to open the recipe picker."},
+				Section{Title: i18n.T("Saved filter combinations")},
+				Paragraph{Text: i18n.T("Press ' (single quote) to open the recipe picker.")},
 				Spacer{Lines: 1},
-				Section{Title: "Built-in Recipes"},
+				Section{Title: i18n.T("Built-in Recipes")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "Sprint Ready", Desc: "Actionable work for this sprint"},
-					{Key: "Quick Wins", Desc: "Low-effort items"},
-					{Key: "Blocked Review", Desc: "Stuck items needing attention"},
-					{Key: "High Impact", Desc: "Top PageRank scores"},
-					{Key: "Stale Items", Desc: "No updates in 2+ weeks"},
+					{Key: "Sprint Ready", Desc: i18n.T("Actionable work for this sprint")},
+					{Key: "Quick Wins", Desc: i18n.T("Low-effort items")},
+					{Key: "Blocked Review", Desc: i18n.T("Stuck items needing attention")},
+					{Key: "High Impact", Desc: i18n.T("Top PageRank scores")},
+					{Key: "Stale Items", Desc: i18n.T("No updates in 2+ weeks")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Custom Recipes"},
-				Paragraph{Text: "Stored in .beads/recipes.json - version controlled with your project."},
+				Section{Title: i18n.T("Custom Recipes")},
+				Paragraph{Text: i18n.T("Stored in .beads/recipes.json - version controlled with your project.")},
 				Spacer{Lines: 1},
-				Section{Title: "Filter Options"},
+				Section{Title: i18n.T("Filter Options")},
 				Paragraph{Text: "status, labels, labels_exclude, priority_min/max, type, assignee"},
 			},
 		},
@@ -717,27 +720,27 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Title:   "AI Agent Integration",
 			Section: "Advanced",
 			Elements: []TutorialElement{
-				Section{Title: "Designed for AI coding agents"},
+				Section{Title: i18n.T("Designed for AI coding agents")},
 				Spacer{Lines: 1},
-				Section{Title: "Human vs Agent"},
+				Section{Title: i18n.T("Human vs Agent")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "bv", Desc: "Interactive TUI for humans"},
-					{Key: "--robot-*", Desc: "JSON output for agents"},
+					{Key: "bv", Desc: i18n.T("Interactive TUI for humans")},
+					{Key: "--robot-*", Desc: i18n.T("JSON output for agents")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Key Robot Commands"},
+				Section{Title: i18n.T("Key Robot Commands")},
 				Code{Text: "bv --robot-triage  # The mega-command\nbv --robot-next    # Single top priority\nbv --robot-plan    # Parallel execution\nbv --robot-alerts  # Stale, inversions"},
 				Spacer{Lines: 1},
-				Section{Title: "Agent Workflow"},
+				Section{Title: i18n.T("Agent Workflow")},
 				Bullet{Items: []string{
-					"Call: bv --robot-next",
-					"Claim: bd update ID --status=in_progress",
-					"Work: Do the implementation",
-					"Complete: bd close ID",
-					"Repeat",
+					i18n.T("Call: bv --robot-next"),
+					i18n.T("Claim: bd update ID --status=in_progress"),
+					i18n.T("Work: Do the implementation"),
+					i18n.T("Complete: bd close ID"),
+					i18n.T("Repeat"),
 				}},
 				Spacer{Lines: 1},
-				Tip{Text: "Every project should have AGENTS.md explaining robot commands"},
+				Tip{Text: i18n.T("Every project should have AGENTS.md explaining robot commands")},
 			},
 		},
 
@@ -749,26 +752,26 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Title:   "Starting a New Feature",
 			Section: "Workflows",
 			Elements: []TutorialElement{
-				Section{Title: "Feature implementation walkthrough"},
+				Section{Title: i18n.T("Feature implementation walkthrough")},
 				Spacer{Lines: 1},
-				Section{Title: "Step 1: Find Available Work"},
+				Section{Title: i18n.T("Step 1: Find Available Work")},
 				Code{Text: "bd ready  # Show actionable issues"},
-				Paragraph{Text: "Or in bv: press r to filter to ready issues."},
+				Paragraph{Text: i18n.T("Or in bv: press r to filter to ready issues.")},
 				Spacer{Lines: 1},
-				Section{Title: "Step 2: Review & Claim"},
+				Section{Title: i18n.T("Step 2: Review & Claim")},
 				Bullet{Items: []string{
-					"Enter: View full details",
-					"g: See dependency graph",
+					i18n.T("Enter: View full details"),
+					i18n.T("g: See dependency graph"),
 					"bd update ID --status=in_progress",
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Step 3: Create Sub-Tasks"},
+				Section{Title: i18n.T("Step 3: Create Sub-Tasks")},
 				Code{Text: "bd create --title=\"Implement logic\" --type=task\nbd dep add bv-tests bv-endpoint"},
 				Spacer{Lines: 1},
-				Section{Title: "Step 4: Complete & Sync"},
+				Section{Title: i18n.T("Step 4: Complete & Sync")},
 				Code{Text: "bd close ID\nbd sync  # Commit changes to git"},
 				Spacer{Lines: 1},
-				Tip{Text: "Check bd ready after each close - new work may have unblocked"},
+				Tip{Text: i18n.T("Check bd ready after each close - new work may have unblocked")},
 			},
 		},
 		{
@@ -776,31 +779,31 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Title:   "Triaging a Bug Report",
 			Section: "Workflows",
 			Elements: []TutorialElement{
-				Section{Title: "Efficient bug triage process"},
+				Section{Title: i18n.T("Efficient bug triage process")},
 				Spacer{Lines: 1},
-				Section{Title: "Step 1: Create the Issue"},
+				Section{Title: i18n.T("Step 1: Create the Issue")},
 				Code{Text: "bd create --title=\"Login fails with special chars\" \\\n  --type=bug --priority=2"},
 				Spacer{Lines: 1},
-				Section{Title: "Step 2: Assess Severity"},
+				Section{Title: i18n.T("Step 2: Assess Severity")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "P0", Desc: "System down, data loss"},
-					{Key: "P1", Desc: "Major feature broken"},
-					{Key: "P2", Desc: "Feature degraded"},
-					{Key: "P3-P4", Desc: "Minor, cosmetic"},
+					{Key: "P0", Desc: i18n.T("System down, data loss")},
+					{Key: "P1", Desc: i18n.T("Major feature broken")},
+					{Key: "P2", Desc: i18n.T("Feature degraded")},
+					{Key: "P3-P4", Desc: i18n.T("Minor, cosmetic")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Step 3: Add Labels"},
-				Paragraph{Text: "Press L to open label picker. Select: bug, auth, user-reported"},
+				Section{Title: i18n.T("Step 3: Add Labels")},
+				Paragraph{Text: i18n.T("Press L to open label picker. Select: bug, auth, user-reported")},
 				Spacer{Lines: 1},
-				Section{Title: "Step 4: Check for Blockers"},
+				Section{Title: i18n.T("Step 4: Check for Blockers")},
 				Code{Text: "bd dep add bv-feature1 bv-bug1  # Feature blocked by bug"},
 				Spacer{Lines: 1},
-				Section{Title: "Checklist"},
+				Section{Title: i18n.T("Checklist")},
 				Bullet{Items: []string{
-					"Create issue with descriptive title",
-					"Set priority based on severity",
-					"Add relevant labels",
-					"Check if it blocks other work",
+					i18n.T("Create issue with descriptive title"),
+					i18n.T("Set priority based on severity"),
+					i18n.T("Add relevant labels"),
+					i18n.T("Check if it blocks other work"),
 				}},
 			},
 		},
@@ -809,27 +812,27 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Title:   "Sprint Planning Session",
 			Section: "Workflows",
 			Elements: []TutorialElement{
-				Section{Title: "Data-driven sprint decisions"},
+				Section{Title: i18n.T("Data-driven sprint decisions")},
 				Spacer{Lines: 1},
-				Section{Title: "Step 1: Review Health"},
-				Paragraph{Text: "Press i for Insights panel. Check open/blocked counts and top blockers."},
+				Section{Title: i18n.T("Step 1: Review Health")},
+				Paragraph{Text: i18n.T("Press i for Insights panel. Check open/blocked counts and top blockers.")},
 				Spacer{Lines: 1},
-				Section{Title: "Step 2: Identify Dependencies"},
-				Paragraph{Text: "Press g for graph view:"},
+				Section{Title: i18n.T("Step 2: Identify Dependencies")},
+				Paragraph{Text: i18n.T("Press g for graph view:")},
 				Bullet{Items: []string{
-					"Tall chains = sequential (can't parallelize)",
-					"Wide clusters = parallel opportunities",
-					"Bottlenecks = single nodes blocking many",
+					i18n.T("Tall chains = sequential (can't parallelize)"),
+					i18n.T("Wide clusters = parallel opportunities"),
+					i18n.T("Bottlenecks = single nodes blocking many"),
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Step 3: Filter to Ready Work"},
-				Paragraph{Text: "Press r to show only unblocked issues."},
+				Section{Title: i18n.T("Step 3: Filter to Ready Work")},
+				Paragraph{Text: i18n.T("Press r to show only unblocked issues.")},
 				Spacer{Lines: 1},
-				Section{Title: "Step 4: Assign & Label"},
-				Paragraph{Text: "For each sprint candidate: L -> 'sprint-42'"},
+				Section{Title: i18n.T("Step 4: Assign & Label")},
+				Paragraph{Text: i18n.T("For each sprint candidate: L -> 'sprint-42'")},
 				Spacer{Lines: 1},
-				Section{Title: "Step 5: Export Plan"},
-				Paragraph{Text: "Press x to export filtered list to markdown."},
+				Section{Title: i18n.T("Step 5: Export Plan")},
+				Paragraph{Text: i18n.T("Press x to export filtered list to markdown.")},
 			},
 		},
 		{
@@ -837,28 +840,28 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Title:   "Onboarding New Team Members",
 			Section: "Workflows",
 			Elements: []TutorialElement{
-				Section{Title: "Fast onboarding - it's in the repo"},
+				Section{Title: i18n.T("Fast onboarding - it's in the repo")},
 				Spacer{Lines: 1},
-				Section{Title: "Step 1: Clone & Run"},
+				Section{Title: i18n.T("Step 1: Clone & Run")},
 				Code{Text: "git clone <repo>\ncd project\nbv  # Tutorial launches!"},
-				Paragraph{Text: "No separate tool installation. No access requests."},
+				Paragraph{Text: i18n.T("No separate tool installation. No access requests.")},
 				Spacer{Lines: 1},
-				Section{Title: "Step 2: Point to Help"},
+				Section{Title: i18n.T("Step 2: Point to Help")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "?", Desc: "Quick reference overlay"},
-					{Key: "`", Desc: "Full interactive tutorial"},
-					{Key: ";", Desc: "Shortcuts sidebar"},
+					{Key: "?", Desc: i18n.T("Quick reference overlay")},
+					{Key: "`", Desc: i18n.T("Full interactive tutorial")},
+					{Key: ";", Desc: i18n.T("Shortcuts sidebar")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Step 3: First Task"},
-				Paragraph{Text: "Find a good-first-issue: Press L, filter to that label."},
+				Section{Title: i18n.T("Step 3: First Task")},
+				Paragraph{Text: i18n.T("Find a good-first-issue: Press L, filter to that label.")},
 				Spacer{Lines: 1},
-				Section{Title: "Step 4: Walk Through Workflow"},
+				Section{Title: i18n.T("Step 4: Walk Through Workflow")},
 				Bullet{Items: []string{
-					"Find: filters (o/r) and search (/)",
-					"Review: Enter for details, g for graph",
-					"Claim: bd update ID --status=in_progress",
-					"Complete: bd close ID && bd sync",
+					i18n.T("Find: filters (o/r) and search (/)"),
+					i18n.T("Review: Enter for details, g for graph"),
+					i18n.T("Claim: bd update ID --status=in_progress"),
+					i18n.T("Complete: bd close ID && bd sync"),
 				}},
 			},
 		},
@@ -867,27 +870,27 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Title:   "Stakeholder Review",
 			Section: "Workflows",
 			Elements: []TutorialElement{
-				Section{Title: "Share with non-terminal users"},
+				Section{Title: i18n.T("Share with non-terminal users")},
 				Spacer{Lines: 1},
-				Section{Title: "Generate Dashboard"},
+				Section{Title: i18n.T("Generate Dashboard")},
 				Code{Text: "bv --pages  # Interactive wizard\n# Or direct:\nbv --export-pages ./dashboard --pages-title \"Sprint 42\""},
 				Spacer{Lines: 1},
-				Section{Title: "Output Includes"},
+				Section{Title: i18n.T("Output Includes")},
 				Bullet{Items: []string{
-					"Triage recommendations",
-					"Dependency graph visualization",
-					"Full-text search",
-					"Works offline after load",
+					i18n.T("Triage recommendations"),
+					i18n.T("Dependency graph visualization"),
+					i18n.T("Full-text search"),
+					i18n.T("Works offline after load"),
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Sharing Options"},
+				Section{Title: i18n.T("Sharing Options")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "GitHub Pages", Desc: "Use wizard for auto-deploy"},
-					{Key: "Cloudflare", Desc: "Upload ./dashboard"},
-					{Key: "Email", Desc: "Zip and send"},
+					{Key: "GitHub Pages", Desc: i18n.T("Use wizard for auto-deploy")},
+					{Key: "Cloudflare", Desc: i18n.T("Upload ./dashboard")},
+					{Key: i18n.T("Email"), Desc: i18n.T("Zip and send")},
 				}},
 				Spacer{Lines: 1},
-				Tip{Text: "Add to CI/CD to auto-update on each push"},
+				Tip{Text: i18n.T("Add to CI/CD to auto-update on each push")},
 			},
 		},
 
@@ -899,32 +902,32 @@ func structuredTutorialPages() []StructuredTutorialPage {
 			Title:   "Keyboard Reference",
 			Section: "Reference",
 			Elements: []TutorialElement{
-				Section{Title: "Global"},
+				Section{Title: i18n.T("Global")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "?", Desc: "Help overlay"},
-					{Key: "q", Desc: "Quit"},
-					{Key: "Esc", Desc: "Close/back"},
-					{Key: "b/g/i/h", Desc: "Switch views"},
+					{Key: "?", Desc: i18n.T("Help overlay")},
+					{Key: "q", Desc: i18n.T("Quit")},
+					{Key: "Esc", Desc: i18n.T("Close/back")},
+					{Key: "b/g/i/h", Desc: i18n.T("Switch views")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Navigation"},
+				Section{Title: i18n.T("Navigation")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "j / k", Desc: "Move down/up"},
-					{Key: "h / l", Desc: "Move left/right"},
-					{Key: "g / G", Desc: "Top/bottom"},
-					{Key: "Enter", Desc: "Select"},
+					{Key: "j / k", Desc: i18n.T("Move down/up")},
+					{Key: "h / l", Desc: i18n.T("Move left/right")},
+					{Key: "g / G", Desc: i18n.T("Top/bottom")},
+					{Key: "Enter", Desc: i18n.T("Select")},
 				}},
 				Spacer{Lines: 1},
-				Section{Title: "Filtering"},
+				Section{Title: i18n.T("Filtering")},
 				KeyTable{Bindings: []KeyBinding{
-					{Key: "/", Desc: "Fuzzy search"},
-					{Key: "Ctrl+S", Desc: "Semantic search"},
-					{Key: "H", Desc: "Hybrid ranking"},
-					{Key: "Alt+H", Desc: "Hybrid preset"},
-					{Key: "o/c/r/a", Desc: "Status filter"},
+					{Key: "/", Desc: i18n.T("Fuzzy search")},
+					{Key: "Ctrl+S", Desc: i18n.T("Semantic search")},
+					{Key: "H", Desc: i18n.T("Hybrid ranking")},
+					{Key: "Alt+H", Desc: i18n.T("Hybrid preset")},
+					{Key: "o/c/r/a", Desc: i18n.T("Status filter")},
 				}},
 				Spacer{Lines: 1},
-				Tip{Text: "Press ? in any view for context-specific help"},
+				Tip{Text: i18n.T("Press ? in any view for context-specific help")},
 			},
 		},
 	}
@@ -6,6 +6,8 @@ import (
 	"path/filepath"
 	"sync"
 	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
 )
 
 // TutorialProgress tracks which tutorial pages have been viewed.
@@ -46,11 +48,11 @@ func GetTutorialProgressManager() *tutorialProgressManager {
 
 // TutorialProgressPath returns the path to the tutorial progress config file.
 func TutorialProgressPath() string {
-	home, err := os.UserHomeDir()
-	if err != nil {
+	dir := config.Dir()
+	if dir == "" {
 		return ""
 	}
-	return filepath.Join(home, ".config", "bv", "tutorial-progress.json")
+	return filepath.Join(dir, "tutorial-progress.json")
 }
 
 // Load reads tutorial progress from disk.
@@ -1,13 +1,16 @@
 package ui
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/updater"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/version"
 
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -23,6 +26,7 @@ const (
 	UpdateStateInstalling
 	UpdateStateSuccess
 	UpdateStateError
+	UpdateStateManaged // A package manager owns the binary; show its command
 )
 
 // UpdateProgress represents progress during download
@@ -46,6 +50,13 @@ type UpdateCompleteMsg struct {
 	RequireRoot bool
 }
 
+// ChangelogMsg carries the release notes between the running version and
+// the update.
+type ChangelogMsg struct {
+	Notes string // Markdown, a section per release
+	Err   error
+}
+
 // UpdateModal displays the update confirmation and progress.
 type UpdateModal struct {
 	currentVersion string
@@ -60,7 +71,19 @@ type UpdateModal struct {
 	width          int
 	height         int
 	startTime      time.Time
-	confirmFocus   int // 0 = Update, 1 = Cancel
+	confirmFocus   int  // 0 = Update, 1 = Cancel
+	reducedMotion  bool // Show a still spinner frame
+	ctx            context.Context
+	origin         updater.InstallOrigin
+	prefs          updater.Preferences // Pin of the release to install
+
+	// Release notes of the versions the update brings, shown in place of
+	// the confirmation when asked for.
+	showChangelog    bool
+	changelogLoading bool
+	changelogNotes   string
+	changelogErr     error
+	changelog        viewport.Model
 }
 
 // NewUpdateModal creates a new update modal.
@@ -74,28 +97,40 @@ func NewUpdateModal(newVersion, releaseURL string, theme Theme) UpdateModal {
 		width:          60,
 		height:         20,
 		confirmFocus:   0, // Default to "Update" button
+		ctx:            context.Background(),
+		changelog:      viewport.New(56, 10),
 	}
 }
 
-// PerformUpdateCmd returns a command that performs the update in the background.
-func PerformUpdateCmd() tea.Cmd {
+// SetOrigin records how bv was installed. A managed install shows the
+// package manager's upgrade command instead of offering to update.
+func (m *UpdateModal) SetOrigin(origin updater.InstallOrigin) {
+	m.origin = origin
+	if origin.Managed() && m.state == UpdateStateConfirm {
+		m.state = UpdateStateManaged
+	}
+}
+
+// PerformUpdateCmd returns a command that performs the update in the background,
+// to the latest release within prefs.Pin. Canceling ctx aborts the download.
+func PerformUpdateCmd(ctx context.Context, prefs updater.Preferences) tea.Cmd {
 	return func() tea.Msg {
-		release, err := updater.GetLatestRelease()
+		release, err := updater.GetLatestRelease(ctx, prefs)
 		if err != nil {
 			return UpdateCompleteMsg{
 				Success: false,
-				Message: fmt.Sprintf("Failed to fetch release info: %v", err),
+				Message: i18n.Tf("Failed to fetch release info: %v", err),
 			}
 		}
 
-		result, err := updater.PerformUpdate(release, true) // Skip confirm since TUI already confirmed
+		result, err := updater.PerformUpdate(ctx, release, true) // Skip confirm since TUI already confirmed
 		if err != nil {
-			msg := fmt.Sprintf("Update failed: %v", err)
+			msg := i18n.Tf("Update failed: %v", err)
 			requireRoot := false
 			if result != nil {
 				if result.RequireRoot {
 					requireRoot = true
-					msg = "Update requires elevated permissions. Run: sudo bv --update"
+					msg = i18n.T("Update requires elevated permissions. Run: sudo bv --update")
 				}
 			}
 			return UpdateCompleteMsg{
@@ -114,10 +149,81 @@ func PerformUpdateCmd() tea.Cmd {
 	}
 }
 
+// FetchChangelogCmd returns a command that fetches the release notes of
+// the versions after from up to to.
+func FetchChangelogCmd(ctx context.Context, from, to string) tea.Cmd {
+	return func() tea.Msg {
+		releases, err := updater.GetReleasesBetween(ctx, from, to)
+		if err != nil {
+			return ChangelogMsg{Err: err}
+		}
+		if len(releases) == 0 {
+			return ChangelogMsg{Notes: "_No release notes found between " + from + " and " + to + "._"}
+		}
+		return ChangelogMsg{Notes: updater.ReleaseNotes(releases)}
+	}
+}
+
+// ShowingChangelog reports whether the modal shows the release notes.
+func (m UpdateModal) ShowingChangelog() bool {
+	return m.showChangelog
+}
+
+// openChangelog shows the release notes, fetching them the first time.
+func (m UpdateModal) openChangelog() (UpdateModal, tea.Cmd) {
+	m.showChangelog = true
+	if m.changelogNotes != "" || m.changelogLoading {
+		return m, nil
+	}
+	m.changelogLoading = true
+	m.changelogErr = nil
+	m.changelog.SetContent("")
+	return m, FetchChangelogCmd(m.ctx, m.currentVersion, m.newVersion)
+}
+
+// renderChangelog renders the fetched notes into the changelog viewport.
+func (m *UpdateModal) renderChangelog() {
+	if m.changelogNotes == "" {
+		return
+	}
+	content := m.changelogNotes
+	if rendered, err := NewMarkdownRendererWithTheme(m.changelog.Width, m.theme).Render(m.changelogNotes); err == nil {
+		content = strings.TrimSpace(rendered)
+	}
+	m.changelog.SetContent(content)
+}
+
+// handleChangelogKeys scrolls the release notes; esc, q or c go back.
+func (m UpdateModal) handleChangelogKeys(msg tea.KeyMsg) (UpdateModal, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q", "c":
+		m.showChangelog = false
+	case "j", "down":
+		m.changelog.ScrollDown(1)
+	case "k", "up":
+		m.changelog.ScrollUp(1)
+	case "pgdown", "ctrl+d", " ":
+		m.changelog.HalfPageDown()
+	case "pgup", "ctrl+u":
+		m.changelog.HalfPageUp()
+	case "g", "home":
+		m.changelog.GotoTop()
+	case "G", "end":
+		m.changelog.GotoBottom()
+	}
+	return m, nil
+}
+
 // Update handles input for the modal.
 func (m UpdateModal) Update(msg tea.Msg) (UpdateModal, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if m.showChangelog {
+			return m.handleChangelogKeys(msg)
+		}
+		if msg.String() == "c" && (m.state == UpdateStateConfirm || m.state == UpdateStateManaged) {
+			return m.openChangelog()
+		}
 		switch m.state {
 		case UpdateStateConfirm:
 			switch msg.String() {
@@ -132,7 +238,7 @@ func (m UpdateModal) Update(msg tea.Msg) (UpdateModal, tea.Cmd) {
 					// User confirmed update
 					m.state = UpdateStateDownloading
 					m.startTime = time.Now()
-					return m, PerformUpdateCmd()
+					return m, PerformUpdateCmd(m.ctx, m.prefs)
 				}
 				// Cancel - will be handled by parent
 				return m, nil
@@ -140,13 +246,13 @@ func (m UpdateModal) Update(msg tea.Msg) (UpdateModal, tea.Cmd) {
 				// Quick confirm
 				m.state = UpdateStateDownloading
 				m.startTime = time.Now()
-				return m, PerformUpdateCmd()
+				return m, PerformUpdateCmd(m.ctx, m.prefs)
 			case "n", "N":
 				// Quick cancel - will be handled by parent
 				return m, nil
 			}
 
-		case UpdateStateSuccess, UpdateStateError:
+		case UpdateStateSuccess, UpdateStateError, UpdateStateManaged:
 			// Any key to dismiss
 			switch msg.String() {
 			case "enter", "esc", "q":
@@ -166,6 +272,12 @@ func (m UpdateModal) Update(msg tea.Msg) (UpdateModal, tea.Cmd) {
 			m.state = UpdateStateInstalling
 		}
 
+	case ChangelogMsg:
+		m.changelogLoading = false
+		m.changelogErr = msg.Err
+		m.changelogNotes = msg.Notes
+		m.renderChangelog()
+
 	case UpdateCompleteMsg:
 		if msg.Success {
 			m.state = UpdateStateSuccess
@@ -232,29 +344,53 @@ func (m UpdateModal) View() string {
 
 	var b strings.Builder
 
+	if m.showChangelog {
+		b.WriteString(headerStyle.Render(i18n.Tf("What's new: %s → %s", m.currentVersion, m.newVersion)))
+		b.WriteString("\n\n")
+		switch {
+		case m.changelogLoading:
+			b.WriteString(m.renderSpinner())
+			b.WriteString(" " + i18n.T("Fetching release notes..."))
+		case m.changelogErr != nil:
+			b.WriteString(errorStyle.Render(i18n.T("Could not fetch release notes")))
+			b.WriteString("\n\n")
+			b.WriteString(m.changelogErr.Error())
+			if m.releaseURL != "" {
+				b.WriteString("\n\n" + i18n.Tf("See %s", m.releaseURL))
+			}
+		default:
+			b.WriteString(m.changelog.View())
+			b.WriteString("\n")
+			b.WriteString(subtextStyle.Render(fmt.Sprintf("%3.0f%%", m.changelog.ScrollPercent()*100)))
+		}
+		b.WriteString("\n\n")
+		b.WriteString(subtextStyle.Render(i18n.T("[j/k] Scroll   [Esc] Back")))
+		return modalStyle.Render(b.String())
+	}
+
 	switch m.state {
 	case UpdateStateConfirm:
-		b.WriteString(headerStyle.Render("Update Available"))
+		b.WriteString(headerStyle.Render(i18n.T("Update Available")))
 		b.WriteString("\n\n")
 
-		b.WriteString("Current version: ")
+		b.WriteString(i18n.T("Current version: "))
 		b.WriteString(currentVersionStyle.Render(m.currentVersion))
 		b.WriteString("\n")
 
-		b.WriteString("New version:     ")
+		b.WriteString(i18n.T("New version:     "))
 		b.WriteString(newVersionStyle.Render(m.newVersion))
 		b.WriteString("\n\n")
 
-		b.WriteString("Would you like to update now?\n\n")
+		b.WriteString(i18n.T("Would you like to update now?") + "\n\n")
 
 		// Buttons
 		var updateBtn, cancelBtn string
 		if m.confirmFocus == 0 {
-			updateBtn = selectedButtonStyle.Render(" Update ")
-			cancelBtn = buttonStyle.Render(" Cancel ")
+			updateBtn = selectedButtonStyle.Render(" " + i18n.T("Update") + " ")
+			cancelBtn = buttonStyle.Render(" " + i18n.T("Cancel") + " ")
 		} else {
-			updateBtn = buttonStyle.Render(" Update ")
-			cancelBtn = selectedButtonStyle.Render(" Cancel ")
+			updateBtn = buttonStyle.Render(" " + i18n.T("Update") + " ")
+			cancelBtn = selectedButtonStyle.Render(" " + i18n.T("Cancel") + " ")
 		}
 		b.WriteString("    ")
 		b.WriteString(updateBtn)
@@ -262,53 +398,73 @@ func (m UpdateModal) View() string {
 		b.WriteString(cancelBtn)
 		b.WriteString("\n\n")
 
-		b.WriteString(subtextStyle.Render("[Y] Update   [N] Cancel   [Enter] Select"))
+		b.WriteString(subtextStyle.Render(i18n.T("[Y] Update   [N] Cancel   [C] What's new")))
+		b.WriteString("\n")
+		b.WriteString(subtextStyle.Render(i18n.T("[S] Skip this version   [D] Don't check for updates")))
+
+	case UpdateStateManaged:
+		b.WriteString(headerStyle.Render(i18n.T("Update Available")))
+		b.WriteString("\n\n")
+
+		b.WriteString(i18n.T("Current version: "))
+		b.WriteString(currentVersionStyle.Render(m.currentVersion))
+		b.WriteString("\n")
+
+		b.WriteString(i18n.T("New version:     "))
+		b.WriteString(newVersionStyle.Render(m.newVersion))
+		b.WriteString("\n\n")
+
+		b.WriteString(i18n.Tf("bv was installed with %s. Update it with:", m.origin.Name()) + "\n\n")
+		b.WriteString("  ")
+		b.WriteString(newVersionStyle.Render(m.origin.UpgradeCommand()))
+		b.WriteString("\n\n")
+		b.WriteString(subtextStyle.Render(i18n.T("[C] What's new   [S] Skip this version   [Enter] Close")))
 
 	case UpdateStateDownloading:
-		b.WriteString(headerStyle.Render("Updating..."))
+		b.WriteString(headerStyle.Render(i18n.T("Updating...")))
 		b.WriteString("\n\n")
 		b.WriteString(m.renderSpinner())
-		b.WriteString(" Downloading ")
+		b.WriteString(" " + i18n.T("Downloading") + " ")
 		b.WriteString(newVersionStyle.Render(m.newVersion))
 		b.WriteString("...\n\n")
 		b.WriteString(m.renderProgressBar())
 		b.WriteString("\n\n")
 		elapsed := time.Since(m.startTime).Round(time.Second)
-		b.WriteString(subtextStyle.Render(fmt.Sprintf("Elapsed: %s", elapsed)))
+		b.WriteString(subtextStyle.Render(i18n.Tf("Elapsed: %s", elapsed)))
 
 	case UpdateStateVerifying:
-		b.WriteString(headerStyle.Render("Updating..."))
+		b.WriteString(headerStyle.Render(i18n.T("Updating...")))
 		b.WriteString("\n\n")
 		b.WriteString(m.renderSpinner())
-		b.WriteString(" Verifying checksum...\n")
+		b.WriteString(" " + i18n.T("Verifying checksum...") + "\n")
 
 	case UpdateStateInstalling:
-		b.WriteString(headerStyle.Render("Updating..."))
+		b.WriteString(headerStyle.Render(i18n.T("Updating...")))
 		b.WriteString("\n\n")
 		b.WriteString(m.renderSpinner())
-		b.WriteString(" Installing new version...\n")
+		b.WriteString(" " + i18n.T("Installing new version...") + "\n")
 
 	case UpdateStateSuccess:
-		b.WriteString(successStyle.Render("Update Complete!"))
+		b.WriteString(successStyle.Render(i18n.T("Update Complete!")))
 		b.WriteString("\n\n")
 		b.WriteString(m.successMessage)
 		b.WriteString("\n\n")
 		if m.backupPath != "" {
-			b.WriteString(subtextStyle.Render(fmt.Sprintf("Backup: %s", m.backupPath)))
+			b.WriteString(subtextStyle.Render(i18n.Tf("Backup: %s", m.backupPath)))
 			b.WriteString("\n")
-			b.WriteString(subtextStyle.Render("Run 'bv --rollback' to restore if needed"))
+			b.WriteString(subtextStyle.Render(i18n.T("Run 'bv --rollback' to restore if needed")))
 			b.WriteString("\n\n")
 		}
-		b.WriteString(successStyle.Render("Restart bv to use the new version."))
+		b.WriteString(successStyle.Render(i18n.T("Restart bv to use the new version.")))
 		b.WriteString("\n\n")
-		b.WriteString(subtextStyle.Render("[Enter] Close"))
+		b.WriteString(subtextStyle.Render(i18n.T("[Enter] Close")))
 
 	case UpdateStateError:
-		b.WriteString(errorStyle.Render("Update Failed"))
+		b.WriteString(errorStyle.Render(i18n.T("Update Failed")))
 		b.WriteString("\n\n")
 		b.WriteString(m.errorMessage)
 		b.WriteString("\n\n")
-		b.WriteString(subtextStyle.Render("[Enter] Close"))
+		b.WriteString(subtextStyle.Render(i18n.T("[Enter] Close")))
 	}
 
 	return modalStyle.Render(b.String())
@@ -317,6 +473,9 @@ func (m UpdateModal) View() string {
 // renderSpinner returns an animated spinner character
 func (m UpdateModal) renderSpinner() string {
 	frames := []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+	if m.reducedMotion {
+		return frames[0]
+	}
 	idx := int(time.Since(m.startTime).Milliseconds()/100) % len(frames)
 	return frames[idx]
 }
@@ -350,6 +509,14 @@ func (m *UpdateModal) SetSize(width, height int) {
 	}
 	m.width = maxWidth
 	m.height = height
+
+	// The modal's padding and the changelog's header and footer take the rest.
+	m.changelog.Width = maxWidth - 4
+	m.changelog.Height = height - 14
+	if m.changelog.Height < 5 {
+		m.changelog.Height = 5
+	}
+	m.renderChangelog()
 }
 
 // IsConfirming returns true if the modal is in confirm state
@@ -357,14 +524,21 @@ func (m UpdateModal) IsConfirming() bool {
 	return m.state == UpdateStateConfirm
 }
 
+// AwaitsChoice returns true while the modal offers the update, or the
+// package manager's command for it
+func (m UpdateModal) AwaitsChoice() bool {
+	return m.state == UpdateStateConfirm || m.state == UpdateStateManaged
+}
+
 // IsCancelled returns true if user selected Cancel
 func (m UpdateModal) IsCancelled() bool {
 	return m.state == UpdateStateConfirm && m.confirmFocus == 1
 }
 
-// IsComplete returns true if the update is done (success or error)
+// IsComplete returns true if the update is done (success or error), or
+// left to a package manager
 func (m UpdateModal) IsComplete() bool {
-	return m.state == UpdateStateSuccess || m.state == UpdateStateError
+	return m.state == UpdateStateSuccess || m.state == UpdateStateError || m.state == UpdateStateManaged
 }
 
 // IsInProgress returns true if the update is in progress
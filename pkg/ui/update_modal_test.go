@@ -1,12 +1,17 @@
 package ui
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/updater"
+
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 )
 
 // ============================================================================
@@ -479,6 +484,119 @@ func TestUpdateModal_View_ErrorState(t *testing.T) {
 	}
 }
 
+func TestUpdateModal_ManagedInstallShowsUpgradeCommand(t *testing.T) {
+	theme := DefaultTheme(lipgloss.NewRenderer(nil))
+	m := NewUpdateModal("v2.0.0", "", theme)
+	m.SetOrigin(updater.InstallOrigin{Installer: updater.InstallerHomebrew})
+	m.SetSize(80, 24)
+
+	view := m.View()
+	if !strings.Contains(view, "Homebrew") || !strings.Contains(view, "brew upgrade bv") {
+		t.Errorf("expected the brew command in view:\n%s", view)
+	}
+	if strings.Contains(view, "[Y] Update") {
+		t.Error("expected no Update button for a managed install")
+	}
+
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	if cmd != nil || m.IsInProgress() {
+		t.Error("expected y not to start an update")
+	}
+	if !m.IsComplete() {
+		t.Error("expected enter or esc to close the modal")
+	}
+}
+
+func TestUpdateModal_Changelog(t *testing.T) {
+	theme := DefaultTheme(lipgloss.NewRenderer(nil))
+	m := NewUpdateModal("v2.0.0", "https://example.com/release", theme)
+	m.SetSize(80, 30)
+
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	if cmd == nil || !m.ShowingChangelog() {
+		t.Fatal("expected c to fetch and show the release notes")
+	}
+	if view := m.View(); !strings.Contains(view, "Fetching release notes") {
+		t.Errorf("expected a loading message, got:\n%s", view)
+	}
+
+	var notes strings.Builder
+	notes.WriteString("# v2.0.0\n\n")
+	for i := 0; i < 40; i++ {
+		notes.WriteString(fmt.Sprintf("- change %d\n", i))
+	}
+	m, _ = m.Update(ChangelogMsg{Notes: notes.String()})
+	if view := ansi.Strip(m.View()); !strings.Contains(view, "change 0") || strings.Contains(view, "change 39") {
+		t.Errorf("expected the start of the notes in a scrolling view, got:\n%s", view)
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'G'}})
+	if view := ansi.Strip(m.View()); !strings.Contains(view, "change 39") {
+		t.Errorf("expected G to scroll to the end, got:\n%s", view)
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.ShowingChangelog() || !m.IsConfirming() {
+		t.Fatal("expected esc to go back to the confirmation")
+	}
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}}); cmd != nil {
+		t.Error("expected the notes fetched once")
+	}
+}
+
+func TestUpdateModal_ChangelogError(t *testing.T) {
+	theme := DefaultTheme(lipgloss.NewRenderer(nil))
+	m := NewUpdateModal("v2.0.0", "https://example.com/release", theme)
+	m.SetSize(80, 30)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	m, _ = m.Update(ChangelogMsg{Err: fmt.Errorf("github api returned status: 403 Forbidden")})
+	view := m.View()
+	if !strings.Contains(view, "403 Forbidden") || !strings.Contains(view, "https://example.com/release") {
+		t.Errorf("expected the error and the release page, got:\n%s", view)
+	}
+}
+
+func TestUpdateModal_SkipAndDisableAreSaved(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("AppData", home)
+	key := func(r rune) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}} }
+	open := func() Model {
+		m := commandTestModel(t)
+		m.updateAvailable = true
+		m.updateTag = "v9.0.0"
+		m.showSelfUpdateModal()
+		return m
+	}
+
+	m := open()
+	next, _ := m.Update(key('s'))
+	m = next.(Model)
+	if m.showUpdateModal || m.updateAvailable || m.updatePrefs.SkipRelease != "v9.0.0" {
+		t.Fatalf("expected s to skip v9.0.0 and close the dialog, got prefs %+v", m.updatePrefs)
+	}
+	cfg, err := config.LoadFrom(config.DefaultPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.UI.SkipRelease != "v9.0.0" {
+		t.Errorf("expected ui.skip_release saved, got %+v", cfg.UI)
+	}
+
+	m = open()
+	next, _ = m.Update(key('d'))
+	m = next.(Model)
+	if m.showUpdateModal || !m.skipUpdateCheck {
+		t.Fatal("expected d to turn update checks off and close the dialog")
+	}
+	cfg, err = config.LoadFrom(config.DefaultPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.UI.CheckUpdates == nil || *cfg.UI.CheckUpdates || cfg.UI.SkipRelease != "v9.0.0" {
+		t.Errorf("expected ui.check_updates: false saved beside the skip, got %+v", cfg.UI)
+	}
+}
+
 // ============================================================================
 // SetSize tests
 // ============================================================================
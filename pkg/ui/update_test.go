@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"database/sql"
 	"os"
 	"path/filepath"
 	"testing"
@@ -8,6 +9,7 @@ import (
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	"github.com/charmbracelet/bubbles/list"
+	_ "modernc.org/sqlite"
 )
 
 // exercise Phase2Ready and FileChanged branches of Update for coverage.
@@ -108,6 +110,41 @@ func TestUpdateFileChangedReloadsSelection(t *testing.T) {
 	}
 }
 
+func TestUpdateFileChangedReloadsSQLite(t *testing.T) {
+	beads := filepath.Join(t.TempDir(), "beads.db")
+	db, err := sql.Open("sqlite", beads)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	exec := func(stmt string) {
+		t.Helper()
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("%s: %v", stmt, err)
+		}
+	}
+	exec(`CREATE TABLE issues (id TEXT PRIMARY KEY, title TEXT, status TEXT, issue_type TEXT)`)
+	exec(`INSERT INTO issues VALUES ('ONE', 'One', 'open', 'task')`)
+
+	m := NewModel([]model.Issue{{ID: "ONE", Title: "One", Status: model.StatusOpen, IssueType: model.TypeTask}}, nil, beads)
+	if m.watcher != nil {
+		m.watcher.Stop()
+	}
+	exec(`INSERT INTO issues VALUES ('TWO', 'Two', 'open', 'task')`)
+
+	updated, _ := m.Update(FileChangedMsg{})
+	m2 := updated.(Model)
+	if m2.statusIsError {
+		t.Fatalf("expected successful reload, got error %q", m2.statusMsg)
+	}
+	if len(m2.issues) != 2 {
+		t.Errorf("expected the reload to read both issues from the database, got %d", len(m2.issues))
+	}
+	if want := filepath.Dir(filepath.Dir(beads)); m2.workDir != want {
+		t.Errorf("expected the project dir %q, got %q", want, m2.workDir)
+	}
+}
+
 func TestNewModel_SetsTreeBeadsDirFromBeadsPath(t *testing.T) {
 	tmp := t.TempDir()
 	beads := filepath.Join(tmp, "beads.jsonl")
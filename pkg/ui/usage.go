@@ -0,0 +1,280 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Usage stats
+//
+// With ui.usage_stats on, bv counts which views, ":" commands and filters
+// are used and for how long, across sessions and projects, so users can see
+// where their time goes. The counts are kept in a file in the user config
+// directory and are never sent anywhere. ":stats" shows them.
+
+// UsageStats are the usage counts kept between sessions.
+type UsageStats struct {
+	Since    time.Time          `json:"since"`              // First recorded session
+	Sessions int                `json:"sessions"`           // Sessions recorded
+	Seconds  float64            `json:"seconds"`            // Time in bv
+	Views    map[string]float64 `json:"views,omitempty"`    // Seconds per view
+	Commands map[string]int     `json:"commands,omitempty"` // Runs per ":" command or alias
+	Filters  map[string]int     `json:"filters,omitempty"`  // Times each list filter was chosen
+}
+
+// add adds the counts of o to u.
+func (u *UsageStats) add(o UsageStats) {
+	if u.Since.IsZero() || (!o.Since.IsZero() && o.Since.Before(u.Since)) {
+		u.Since = o.Since
+	}
+	u.Sessions += o.Sessions
+	u.Seconds += o.Seconds
+	u.Views = addCounts(u.Views, o.Views)
+	u.Commands = addCounts(u.Commands, o.Commands)
+	u.Filters = addCounts(u.Filters, o.Filters)
+}
+
+func addCounts[N int | float64](dst, src map[string]N) map[string]N {
+	if len(src) == 0 {
+		return dst
+	}
+	if dst == nil {
+		dst = make(map[string]N, len(src))
+	}
+	for k, n := range src {
+		dst[k] += n
+	}
+	return dst
+}
+
+// UsageStatsPath returns where the usage stats are kept.
+func UsageStatsPath() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, "bv", "usage.json"), nil
+}
+
+// LoadUsageStats reads the stats saved at path; a missing file is no usage.
+func LoadUsageStats(path string) (UsageStats, error) {
+	var u UsageStats
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return u, nil
+	}
+	if err != nil {
+		return u, err
+	}
+	if err := json.Unmarshal(data, &u); err != nil {
+		return UsageStats{}, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return u, nil
+}
+
+// usageTracker counts the commands and filters of this session. The time per
+// view comes from the SessionTracker.
+type usageTracker struct {
+	path       string
+	saved      UsageStats // As of the start of the session
+	commands   map[string]int
+	filters    map[string]int
+	lastFilter string
+}
+
+// recordCommand counts a command run at the ":" prompt.
+func (u *usageTracker) recordCommand(name string) {
+	if u == nil {
+		return
+	}
+	u.commands[strings.ToLower(name)]++
+}
+
+// observeFilter counts filter when it differs from the last one observed.
+func (u *usageTracker) observeFilter(filter string) {
+	if u == nil || filter == u.lastFilter {
+		return
+	}
+	u.lastFilter = filter
+	if filter != "" && filter != "all" {
+		u.filters[filter]++
+	}
+}
+
+// EnableUsageStats records usage to path, adding to what is saved there.
+// Call SaveUsageStats on the final model when the program exits.
+func (m *Model) EnableUsageStats(path string) error {
+	saved, err := LoadUsageStats(path)
+	if err != nil {
+		return err
+	}
+	m.usage = &usageTracker{
+		path:       path,
+		saved:      saved,
+		commands:   make(map[string]int),
+		filters:    make(map[string]int),
+		lastFilter: m.currentFilter,
+	}
+	return nil
+}
+
+// sessionUsage returns the usage of this session as of now.
+func (m Model) sessionUsage(now time.Time) UsageStats {
+	sum := m.session.Summary(now)
+	u := UsageStats{
+		Since:    sum.StartedAt,
+		Sessions: 1,
+		Seconds:  sum.Duration().Seconds(),
+		Views:    make(map[string]float64, len(sum.Views)),
+		Commands: m.usage.commands,
+		Filters:  m.usage.filters,
+	}
+	for _, v := range sum.Views {
+		u.Views[string(v.View)] += v.Duration.Seconds()
+	}
+	return u
+}
+
+// SaveUsageStats adds this session to the saved usage stats. The file is
+// read again first, so sessions running side by side are all counted.
+func (m Model) SaveUsageStats() error {
+	if m.usage == nil {
+		return nil
+	}
+	total, err := LoadUsageStats(m.usage.path)
+	if err != nil {
+		return err
+	}
+	total.add(m.sessionUsage(time.Now()))
+	if err := os.MkdirAll(filepath.Dir(m.usage.path), 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(total, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(m.usage.path, data, 0o644)
+}
+
+// usageCount is one row of the stats view.
+type usageCount struct {
+	name  string
+	value float64
+}
+
+// topUsage returns the n largest counts, largest first.
+func topUsage[N int | float64](counts map[string]N, n int) []usageCount {
+	rows := make([]usageCount, 0, len(counts))
+	for name, v := range counts {
+		rows = append(rows, usageCount{name, float64(v)})
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].value != rows[j].value {
+			return rows[i].value > rows[j].value
+		}
+		return rows[i].name < rows[j].name
+	})
+	if len(rows) > n {
+		rows = rows[:n]
+	}
+	return rows
+}
+
+// handleUsageKeys closes the stats view.
+func (m Model) handleUsageKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q", "enter":
+		m.showUsage = false
+	}
+	return m, nil
+}
+
+// renderUsage shows the saved usage stats with this session added.
+func (m Model) renderUsage() string {
+	t := m.theme
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 3)
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	labelStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Bold(true)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	textStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(i18n.T("Usage stats")) + "\n\n")
+	if m.usage == nil {
+		sb.WriteString(textStyle.Render(i18n.T("Usage stats are off. Set ui.usage_stats: true in")) + "\n")
+		sb.WriteString(textStyle.Render(i18n.T("~/.config/bv/config.yaml to count the views, commands")) + "\n")
+		sb.WriteString(textStyle.Render(i18n.T("and filters you use. They stay on this machine.")) + "\n")
+		sb.WriteString("\n" + mutedStyle.Render(i18n.T("esc: close")))
+		return lipgloss.Place(m.width, m.height-1, lipgloss.Center, lipgloss.Center, boxStyle.Render(sb.String()))
+	}
+
+	var u UsageStats
+	u.add(m.usage.saved)
+	u.add(m.sessionUsage(time.Now()))
+	sb.WriteString(mutedStyle.Render(fmt.Sprintf("Since %s • %d sessions • %s in bv • kept in %s",
+		u.Since.Format("2006-01-02"), u.Sessions, formatSessionDuration(time.Duration(u.Seconds*float64(time.Second))), m.usage.path)) + "\n")
+
+	section := func(title string, rows []usageCount, format func(float64) string) {
+		sb.WriteString("\n" + labelStyle.Render(title) + "\n")
+		if len(rows) == 0 {
+			sb.WriteString(mutedStyle.Render("  "+i18n.T("none yet")) + "\n")
+			return
+		}
+		for _, r := range rows {
+			sb.WriteString(textStyle.Render(fmt.Sprintf("  %-24s %s", truncateRunesHelper(r.name, 24, "…"), format(r.value))) + "\n")
+		}
+	}
+	views := topUsage(u.Views, 6)
+	section("Time per view", views, func(v float64) string {
+		share := ""
+		if u.Seconds > 0 {
+			share = fmt.Sprintf(" (%.0f%%)", v/u.Seconds*100)
+		}
+		return formatSessionDuration(time.Duration(v*float64(time.Second))) + share
+	})
+	times := func(v float64) string { return fmt.Sprintf("%d×", int(v)) }
+	section("Commands", topUsage(u.Commands, 5), times)
+	filters := topUsage(u.Filters, 5)
+	section("Filters", filters, times)
+
+	// A filter without a key of its own, chosen again and again, is worth
+	// an alias.
+	if len(filters) > 0 && filters[0].value >= 5 && needsAlias(filters[0].name) && !m.hasAliasFor("filter "+filters[0].name) {
+		sb.WriteString("\n" + mutedStyle.Render(fmt.Sprintf("Tip: add an alias for %q under ui.aliases", "filter "+filters[0].name)) + "\n")
+	}
+
+	sb.WriteString("\n" + mutedStyle.Render(i18n.T("esc: close")))
+	return lipgloss.Place(m.width, m.height-1, lipgloss.Center, lipgloss.Center, boxStyle.Render(sb.String()))
+}
+
+// needsAlias reports whether filter is a valid filter with no key for it.
+func needsAlias(filter string) bool {
+	switch filter {
+	case "open", "closed", "ready":
+		return false
+	}
+	return validateListFilter(filter) == nil
+}
+
+// hasAliasFor reports whether an alias runs exactly line.
+func (m Model) hasAliasFor(line string) bool {
+	for _, body := range m.aliases {
+		if strings.TrimSpace(body) == line {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// typeCommand runs line at the ":" prompt.
+func typeCommand(m Model, line string) Model {
+	m = pressKey(m, runeKey(":"))
+	m = pressKey(m, runeKey(line))
+	return pressKey(m, tea.KeyMsg{Type: tea.KeyEnter})
+}
+
+func TestUsageStats_CountsAndSaves(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bv", "usage.json")
+	for session := 1; session <= 2; session++ {
+		m := commandTestModel(t)
+		if err := m.EnableUsageStats(path); err != nil {
+			t.Fatal(err)
+		}
+		m = typeCommand(m, "filter label:api")
+		m = typeCommand(m, "view board")
+		m = typeCommand(m, "A-2")
+		m = typeCommand(m, "bogus")
+		m = pressKey(m, runeKey("b")) // Observes the filter picked by "A-2"
+		if err := m.SaveUsageStats(); err != nil {
+			t.Fatal(err)
+		}
+
+		u, err := LoadUsageStats(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u.Sessions != session || u.Since.IsZero() {
+			t.Fatalf("session %d: got %d sessions since %v", session, u.Sessions, u.Since)
+		}
+		if u.Commands["filter"] != session || u.Commands["view"] != session || u.Commands["goto"] != session || u.Commands["bogus"] != 0 {
+			t.Errorf("session %d: unexpected command counts %v", session, u.Commands)
+		}
+		if u.Filters["label:api"] != session {
+			t.Errorf("session %d: unexpected filter counts %v", session, u.Filters)
+		}
+		if _, ok := u.Views[string(ContextBoard)]; !ok {
+			t.Errorf("session %d: expected time on the board, got %v", session, u.Views)
+		}
+	}
+}
+
+func TestUsageStats_View(t *testing.T) {
+	m := commandTestModel(t)
+	m = typeCommand(m, "stats")
+	if m.CurrentContext() != ContextUsage {
+		t.Fatalf("expected the stats view, got %s", m.CurrentContext())
+	}
+	if view := m.View(); !strings.Contains(view, "Usage stats are off") {
+		t.Errorf("expected the opt-in hint while off, got:\n%s", view)
+	}
+	if err := m.SaveUsageStats(); err != nil {
+		t.Fatalf("expected nothing saved while off, got %v", err)
+	}
+
+	if err := m.EnableUsageStats(filepath.Join(t.TempDir(), "usage.json")); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 5; i++ {
+		m = pressKey(m, tea.KeyMsg{Type: tea.KeyEsc})
+		m = typeCommand(m, "filter priority:0")
+		m = typeCommand(m, "filter all")
+		m = typeCommand(m, "stats")
+	}
+	view := m.View()
+	for _, want := range []string{"1 sessions", "filter", "priority:0", "5×", `Tip: add an alias for "filter priority:0"`} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected %q in the stats view:\n%s", want, view)
+		}
+	}
+
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.showUsage {
+		t.Error("expected esc to close the stats view")
+	}
+}
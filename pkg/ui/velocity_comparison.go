@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 
 	"github.com/charmbracelet/lipgloss"
@@ -191,7 +192,7 @@ func (m *VelocityComparisonModel) View() string {
 	titleStyle := t.Renderer.NewStyle().
 		Foreground(t.Primary).
 		Bold(true)
-	sb.WriteString(titleStyle.Render("Velocity Comparison"))
+	sb.WriteString(titleStyle.Render(i18n.T("Velocity Comparison")))
 	sb.WriteString("\n\n")
 
 	// Table header
@@ -236,7 +237,7 @@ func (m *VelocityComparisonModel) View() string {
 		dimStyle := t.Renderer.NewStyle().
 			Foreground(t.Secondary).
 			Italic(true)
-		sb.WriteString(dimStyle.Render("  No velocity data available"))
+		sb.WriteString(dimStyle.Render("  " + i18n.T("No velocity data available")))
 		sb.WriteString("\n")
 	} else {
 		visibleRows := m.visibleRowCount()
@@ -258,11 +259,8 @@ func (m *VelocityComparisonModel) View() string {
 					Background(lipgloss.Color("#333"))
 			}
 
-			// Truncate label if needed
-			displayLabel := row.Label
-			if len(displayLabel) > labelWidth {
-				displayLabel = displayLabel[:labelWidth-1] + "…"
-			}
+			// Truncate and pad the label to its column
+			displayLabel := padRight(truncate(row.Label, labelWidth), labelWidth)
 
 			// Format trend with color
 			trendStyle := t.Renderer.NewStyle()
@@ -288,8 +286,8 @@ func (m *VelocityComparisonModel) View() string {
 			sparkStyle := t.Renderer.NewStyle().Foreground(lipgloss.Color("#88aaff"))
 
 			// Build row string
-			rowText := fmt.Sprintf("%-*s %*d %*d %*d %*d %*.1f ",
-				labelWidth, displayLabel,
+			rowText := fmt.Sprintf("%s %*d %*d %*d %*d %*.1f ",
+				displayLabel,
 				weekWidth, row.Weeks[0],
 				weekWidth, row.Weeks[1],
 				weekWidth, row.Weeks[2],
@@ -325,7 +323,7 @@ func (m *VelocityComparisonModel) View() string {
 		Foreground(t.Secondary).
 		Italic(true)
 	sb.WriteString("\n")
-	sb.WriteString(footerStyle.Render("j/k: navigate | enter: filter by label | esc: back"))
+	sb.WriteString(footerStyle.Render(i18n.T("j/k: navigate | enter: filter by label | esc: back")))
 
 	return sb.String()
 }
@@ -0,0 +1,455 @@
+package ui
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/hooks"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// watchFeedMax bounds the watched-changes feed; older entries are dropped.
+const watchFeedMax = 200
+
+// watchMark marks watched issues in the list.
+const watchMark = "👁"
+
+// watchedFilterTerm is the list filter term that keeps the watched issues.
+const watchedFilterTerm = "watched"
+
+// WatchChange is one reload's worth of changes to a watched issue.
+type WatchChange struct {
+	ID      string
+	Title   string
+	Changes []string
+	At      time.Time
+}
+
+// WatchList holds the issues the user watches in one project and the feed of
+// changes seen to them during this session. Watches are personal, so they are
+// kept under the user config dir rather than in the project.
+type WatchList struct {
+	path string // Empty keeps the list in memory only
+	ids  map[string]bool
+	feed []WatchChange // Newest first
+}
+
+type watchListFile struct {
+	Issues []string `json:"issues"`
+}
+
+// NewWatchList returns an empty watch list that is not saved anywhere.
+func NewWatchList() *WatchList {
+	return &WatchList{ids: make(map[string]bool)}
+}
+
+// WatchListPath returns where the watch list of the project in projectDir is
+// stored: one file per project, named by a hash of its absolute path.
+func WatchListPath(projectDir string) (string, error) {
+	return userProjectFile("watches", projectDir)
+}
+
+// userProjectFile returns the file under the user config dir that keeps the
+// kind of personal state of the project in projectDir.
+func userProjectFile(kind, projectDir string) (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	abs, err := filepath.Abs(projectDir)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256([]byte(abs))
+	return filepath.Join(configDir, "bv", kind, hex.EncodeToString(hash[:8])+".json"), nil
+}
+
+// LoadWatchList reads the watch list at path; a missing file is an empty list.
+// Changes are saved back to path.
+func LoadWatchList(path string) (*WatchList, error) {
+	w := NewWatchList()
+	w.path = path
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return w, nil
+	}
+	if err != nil {
+		return w, err
+	}
+	var f watchListFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return w, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	for _, id := range f.Issues {
+		w.ids[id] = true
+	}
+	return w, nil
+}
+
+// Watching reports whether id is watched.
+func (w *WatchList) Watching(id string) bool {
+	return w != nil && w.ids[id]
+}
+
+// IDs returns the watched issue IDs, sorted.
+func (w *WatchList) IDs() []string {
+	if w == nil {
+		return nil
+	}
+	return sortedKeys(w.ids)
+}
+
+// Feed returns the changes seen this session, newest first.
+func (w *WatchList) Feed() []WatchChange {
+	if w == nil {
+		return nil
+	}
+	return w.feed
+}
+
+// Set watches or unwatches id and saves the list.
+func (w *WatchList) Set(id string, watch bool) error {
+	if w.ids[id] == watch {
+		return nil
+	}
+	if watch {
+		w.ids[id] = true
+	} else {
+		delete(w.ids, id)
+	}
+	return w.save()
+}
+
+func (w *WatchList) save() error {
+	if w.path == "" {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(w.path), 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(watchListFile{Issues: w.IDs()}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(w.path, data, 0o644)
+}
+
+// ObserveReload compares the watched issues before and after a reload, adds
+// what changed to the feed and returns the new entries.
+func (w *WatchList) ObserveReload(before map[string]*model.Issue, after []model.Issue, now time.Time) []WatchChange {
+	if w == nil || len(w.ids) == 0 || len(before) == 0 {
+		return nil
+	}
+	seen := make(map[string]bool, len(w.ids))
+	var changes []WatchChange
+	for i := range after {
+		cur := &after[i]
+		if !w.ids[cur.ID] {
+			continue
+		}
+		seen[cur.ID] = true
+		prev, ok := before[cur.ID]
+		if !ok || prev == nil {
+			continue
+		}
+		if diff := describeIssueChanges(prev, cur); len(diff) > 0 {
+			changes = append(changes, WatchChange{ID: cur.ID, Title: cur.Title, Changes: diff, At: now})
+		}
+	}
+	for _, id := range w.IDs() {
+		if prev, ok := before[id]; ok && prev != nil && !seen[id] {
+			changes = append(changes, WatchChange{ID: id, Title: prev.Title, Changes: []string{"deleted"}, At: now})
+		}
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	feed := make([]WatchChange, 0, len(changes)+len(w.feed))
+	for i := len(changes) - 1; i >= 0; i-- {
+		feed = append(feed, changes[i])
+	}
+	w.feed = append(feed, w.feed...)
+	if len(w.feed) > watchFeedMax {
+		w.feed = w.feed[:watchFeedMax]
+	}
+	return changes
+}
+
+// describeIssueChanges lists the user-visible differences between two
+// versions of an issue. An edit that only moved updated_at reads "updated".
+func describeIssueChanges(prev, cur *model.Issue) []string {
+	var out []string
+	if prev.Status != cur.Status {
+		out = append(out, fmt.Sprintf("status %s → %s", prev.Status, cur.Status))
+	}
+	if prev.Priority != cur.Priority {
+		out = append(out, fmt.Sprintf("priority P%d → P%d", prev.Priority, cur.Priority))
+	}
+	if prev.Assignee != cur.Assignee {
+		from, to := prev.Assignee, cur.Assignee
+		if from == "" {
+			from = "nobody"
+		}
+		if to == "" {
+			to = "nobody"
+		}
+		out = append(out, fmt.Sprintf("assignee %s → %s", from, to))
+	}
+	if prev.Title != cur.Title {
+		out = append(out, "title changed")
+	}
+	if prev.Description != cur.Description || prev.Design != cur.Design ||
+		prev.AcceptanceCriteria != cur.AcceptanceCriteria || prev.Notes != cur.Notes {
+		out = append(out, "text edited")
+	}
+	out = append(out, setChanges("label ", prev.Labels, cur.Labels)...)
+	out = append(out, setChanges("depends on ", dependencyIDs(prev), dependencyIDs(cur))...)
+	if n := len(cur.Comments) - len(prev.Comments); n == 1 {
+		out = append(out, "new comment")
+	} else if n > 1 {
+		out = append(out, fmt.Sprintf("%d new comments", n))
+	}
+	if len(out) == 0 && !prev.UpdatedAt.Equal(cur.UpdatedAt) {
+		out = append(out, "updated")
+	}
+	return out
+}
+
+// setChanges describes added ("+") and removed ("-") members, sorted.
+func setChanges(prefix string, before, after []string) []string {
+	old := make(map[string]bool, len(before))
+	for _, s := range before {
+		old[s] = true
+	}
+	cur := make(map[string]bool, len(after))
+	var out []string
+	for _, s := range after {
+		cur[s] = true
+		if !old[s] {
+			out = append(out, "+"+prefix+s)
+		}
+	}
+	for _, s := range before {
+		if !cur[s] {
+			out = append(out, "-"+prefix+s)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+func dependencyIDs(issue *model.Issue) []string {
+	ids := make([]string, 0, len(issue.Dependencies))
+	for _, dep := range issue.Dependencies {
+		if dep != nil {
+			ids = append(ids, dep.DependsOnID)
+		}
+	}
+	return ids
+}
+
+// sendOSNotification shows a desktop notification. It is a variable so tests
+// don't pop up real notifications.
+var sendOSNotification = func(title, body string) error {
+	if runtime.GOOS == "darwin" {
+		quote := func(s string) string {
+			return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+		}
+		return exec.Command("osascript", "-e", "display notification "+quote(body)+" with title "+quote(title)).Run()
+	}
+	if _, err := exec.LookPath("notify-send"); err != nil {
+		return err
+	}
+	return exec.Command("notify-send", "--app-name=bv", title, body).Run()
+}
+
+// EnableWatches loads the watch list stored at path (see WatchListPath) and
+// saves watch changes there. Without it watches last for the session only.
+func (m *Model) EnableWatches(path string) error {
+	w, err := LoadWatchList(path)
+	m.watches = w
+	m.updateListDelegate()
+	return err
+}
+
+// EnableWatchNotifications also reports watched changes as desktop
+// notifications.
+func (m *Model) EnableWatchNotifications(enabled bool) {
+	m.watchNotifications = enabled
+}
+
+// setWatched watches or unwatches id and reports it in the status bar.
+func (m *Model) setWatched(id string, watch bool) {
+	if err := m.watches.Set(id, watch); err != nil {
+		m.reportError(newError(errData, "save watch list", err))
+		return
+	}
+	if watch {
+		m.statusMsg = i18n.Tf("👁 Watching %s", id)
+	} else {
+		m.statusMsg = i18n.Tf("Stopped watching %s", id)
+	}
+	m.statusIsError = false
+}
+
+// noteWatchChanges announces changes to watched issues in the status bar
+// and, when enabled, as a desktop notification, and runs the issue-watched
+// hooks for each issue that still exists.
+func (m *Model) noteWatchChanges(changes []WatchChange) tea.Cmd {
+	if len(changes) == 0 {
+		return nil
+	}
+	hookCmd := m.runWatchHooks(changes)
+	title, body := i18n.Tf("%d watched issues changed", len(changes)), ""
+	if len(changes) == 1 {
+		c := changes[0]
+		title = c.ID + ": " + c.Title
+		body = strings.Join(c.Changes, ", ")
+		m.statusMsg = fmt.Sprintf("👁 %s: %s", c.ID, body)
+	} else {
+		ids := make([]string, len(changes))
+		for i, c := range changes {
+			ids[i] = c.ID
+		}
+		body = strings.Join(ids, ", ")
+		m.statusMsg = i18n.Tf("👁 %s (W to view)", title)
+	}
+	m.statusIsError = false
+	if !m.watchNotifications {
+		return hookCmd
+	}
+	return tea.Batch(hookCmd, func() tea.Msg {
+		_ = sendOSNotification(title, body) // Best effort: the feed has the details
+		return nil
+	})
+}
+
+// runWatchHooks runs the issue-watched hooks for the changed issues, except
+// in read-only sessions.
+func (m *Model) runWatchHooks(changes []WatchChange) tea.Cmd {
+	if m.readOnly {
+		return nil
+	}
+	watchHooks, err := m.projectHooks(hooks.IssueWatched)
+	if err != nil {
+		m.reportError(newError(errHook, "load hooks", err))
+		return nil
+	}
+	if len(watchHooks) == 0 {
+		return nil
+	}
+	var cmds []tea.Cmd
+	for _, c := range changes {
+		issue, ok := m.issueMap[c.ID]
+		if !ok {
+			continue
+		}
+		run := func(ctx context.Context, hook hooks.Hook, ic hooks.IssueContext) hooks.HookResult {
+			return hooks.RunIssueWatchedHook(ctx, hook, ic, c.Changes)
+		}
+		cmds = append(cmds, runIssueHooksCmd(m.life, hooks.IssueWatched, watchHooks, *issue, run))
+	}
+	return tea.Batch(cmds...)
+}
+
+func (m *Model) openWatchFeed() {
+	m.showWatchFeed = true
+	m.watchFeedCursor = 0
+}
+
+// handleWatchFeedKeys moves through the feed; enter jumps to the issue.
+func (m Model) handleWatchFeedKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	feed := m.watches.Feed()
+	switch msg.String() {
+	case "esc", "q", "W":
+		m.showWatchFeed = false
+	case "l":
+		m.showWatchFeed = false
+		m.setActiveRecipe(nil)
+		m.currentFilter = watchedFilterTerm
+		m.applyFilter()
+	case "j", "down":
+		if m.watchFeedCursor < len(feed)-1 {
+			m.watchFeedCursor++
+		}
+	case "k", "up":
+		if m.watchFeedCursor > 0 {
+			m.watchFeedCursor--
+		}
+	case "enter":
+		if m.watchFeedCursor < len(feed) {
+			id := feed[m.watchFeedCursor].ID
+			m.showWatchFeed = false
+			if _, ok := m.issueMap[id]; !ok {
+				m.statusMsg = i18n.Tf("Issue %s no longer exists", id)
+				m.statusIsError = true
+				return m, nil
+			}
+			return m.gotoIssue(id), nil
+		}
+	}
+	return m, nil
+}
+
+func (m Model) renderWatchFeed() string {
+	t := m.theme
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 3)
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	idStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Bold(true)
+	textStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+	selectedStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+
+	width := max(min(m.width-12, 90), 30)
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(i18n.T("Watched changes")) + "\n")
+	ids := m.watches.IDs()
+	if len(ids) == 0 {
+		sb.WriteString(mutedStyle.Render(i18n.T("Not watching any issues: use w in the action menu (.) or :watch")) + "\n")
+	} else {
+		sb.WriteString(mutedStyle.Render(truncateRunesHelper("Watching: "+strings.Join(ids, ", "), width, "…")) + "\n")
+	}
+	sb.WriteString("\n")
+
+	feed := m.watches.Feed()
+	if len(feed) == 0 {
+		sb.WriteString(mutedStyle.Render(i18n.T("No changes since bv started")) + "\n")
+	}
+	// Keep the cursor visible in a window of entries that fits the screen.
+	visible := max((m.height-12)/2, 1)
+	start := 0
+	if m.watchFeedCursor >= visible {
+		start = m.watchFeedCursor - visible + 1
+	}
+	for i := start; i < len(feed) && i < start+visible; i++ {
+		c := feed[i]
+		cursor := "  "
+		title := textStyle.Render(truncateRunesHelper(c.Title, width-22, "…"))
+		if i == m.watchFeedCursor {
+			cursor = selectedStyle.Render("▸ ")
+			title = selectedStyle.Render(truncateRunesHelper(c.Title, width-22, "…"))
+		}
+		sb.WriteString(cursor + mutedStyle.Render(c.At.Format("15:04")) + " " + idStyle.Render(c.ID) + " " + title + "\n")
+		sb.WriteString("        " + textStyle.Render(truncateRunesHelper(strings.Join(c.Changes, ", "), width-8, "…")) + "\n")
+	}
+	sb.WriteString("\n" + mutedStyle.Render(i18n.T("j/k: navigate • enter: go to issue • l: list watched • esc: close")))
+
+	return lipgloss.Place(m.width, m.height-1, lipgloss.Center, lipgloss.Center, boxStyle.Render(sb.String()))
+}
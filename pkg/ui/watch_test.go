@@ -0,0 +1,203 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestWatchList_Persists(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "watches", "p.json")
+	w, err := LoadWatchList(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Set("A-2", true); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Set("A-1", true); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Set("A-2", false); err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded, err := LoadWatchList(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := reloaded.IDs(); !reflect.DeepEqual(got, []string{"A-1"}) {
+		t.Fatalf("expected [A-1] after reload, got %v", got)
+	}
+}
+
+func TestWatchList_ObserveReload(t *testing.T) {
+	now := time.Now()
+	prev := []model.Issue{
+		{ID: "A-1", Title: "One", Status: model.StatusOpen, Priority: 2, Labels: []string{"api", "old"}},
+		{ID: "A-2", Title: "Two", Status: model.StatusOpen},
+		{ID: "A-3", Title: "Three", Status: model.StatusOpen, UpdatedAt: now},
+		{ID: "A-4", Title: "Four", Status: model.StatusOpen},
+	}
+	before := make(map[string]*model.Issue)
+	for i := range prev {
+		before[prev[i].ID] = &prev[i]
+	}
+	after := []model.Issue{
+		{ID: "A-1", Title: "One", Status: model.StatusClosed, Priority: 1, Labels: []string{"api", "new"},
+			Comments: []*model.Comment{{Text: "done"}}},
+		{ID: "A-2", Title: "Two", Status: model.StatusClosed}, // Not watched
+		{ID: "A-3", Title: "Three", Status: model.StatusOpen, UpdatedAt: now.Add(time.Minute)},
+	}
+
+	w := NewWatchList()
+	for _, id := range []string{"A-1", "A-3", "A-4"} {
+		_ = w.Set(id, true)
+	}
+	changes := w.ObserveReload(before, after, now)
+	got := make(map[string]string)
+	for _, c := range changes {
+		got[c.ID] = strings.Join(c.Changes, ", ")
+	}
+	want := map[string]string{
+		"A-1": "status open → closed, priority P2 → P1, +label new, -label old, new comment",
+		"A-3": "updated",
+		"A-4": "deleted",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v\nwant %v", got, want)
+	}
+	if feed := w.Feed(); len(feed) != 3 || feed[0].ID != "A-4" {
+		t.Fatalf("expected newest entries first in the feed, got %+v", feed)
+	}
+	if again := w.ObserveReload(before, prev, now); len(again) != 0 {
+		t.Fatalf("expected no changes for identical issues, got %+v", again)
+	}
+}
+
+func TestWatch_ReloadAnnouncesAndFeedJumps(t *testing.T) {
+	var notified []string
+	orig := sendOSNotification
+	sendOSNotification = func(title, body string) error {
+		notified = append(notified, title+"|"+body)
+		return nil
+	}
+	t.Cleanup(func() { sendOSNotification = orig })
+
+	m := commandTestModel(t)
+	m.EnableWatchNotifications(true)
+	m, _, err := m.ExecCommand("watch A-3")
+	if err != nil || !m.watches.Watching("A-3") {
+		t.Fatalf("watch A-3: %v", err)
+	}
+	if _, _, err := m.ExecCommand("watch nope"); err == nil {
+		t.Fatal("expected error for an unknown issue")
+	}
+
+	issues := append([]model.Issue(nil), m.issues...)
+	for i := range issues {
+		if issues[i].ID == "A-3" {
+			issues[i].Status = model.StatusOpen
+		}
+	}
+	newM, cmd := m.Update(SnapshotReadyMsg{Snapshot: NewSnapshotBuilder(issues).Build()})
+	m = newM.(Model)
+	if !strings.Contains(m.statusMsg, "A-3: status closed → open") {
+		t.Fatalf("expected the change in the status bar, got %q", m.statusMsg)
+	}
+	drainCmd(cmd)
+	if len(notified) != 1 || !strings.HasPrefix(notified[0], "A-3: Done|") {
+		t.Fatalf("expected one desktop notification, got %v", notified)
+	}
+
+	newM, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("W")})
+	m = newM.(Model)
+	if m.CurrentContext() != ContextWatchFeed || !strings.Contains(m.View(), "status closed → open") {
+		t.Fatalf("expected the watch feed, got %s", m.CurrentContext())
+	}
+	newM, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = newM.(Model)
+	if m.showWatchFeed {
+		t.Fatal("expected enter to close the feed")
+	}
+	if item, ok := m.list.SelectedItem().(IssueItem); !ok || item.Issue.ID != "A-3" {
+		t.Fatalf("expected A-3 selected, got %v", m.list.SelectedItem())
+	}
+
+	m, _, _ = m.ExecCommand("unwatch")
+	if m.watches.Watching("A-3") {
+		t.Fatal("expected unwatch to use the selection")
+	}
+}
+
+func TestWatch_MarkFilterAndHooks(t *testing.T) {
+	m := commandTestModel(t)
+	m.workDir = t.TempDir()
+	if err := os.MkdirAll(filepath.Join(m.workDir, ".bv"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	hooksYAML := "hooks:\n  issue-watched:\n    - name: ping\n      command: echo $BV_ISSUE_ID $BV_WATCH_CHANGES\n"
+	if err := os.WriteFile(filepath.Join(m.workDir, ".bv", "hooks.yaml"), []byte(hooksYAML), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	m, _, err := m.ExecCommand("watch A-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if view := ansi.Strip(m.View()); !strings.Contains(view, "A-1 "+watchMark) || strings.Contains(view, "A-2 "+watchMark) {
+		t.Errorf("expected only A-1 marked as watched, got:\n%s", view)
+	}
+
+	if m, _, err = m.ExecCommand("filter watched OR priority:0"); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(listIDs(m), ","); got != "A-2,A-1" {
+		t.Errorf("expected the watched issue and A-2, got %s", got)
+	}
+	m = pressKey(m, runeKey("W"))
+	m = pressKey(m, runeKey("l"))
+	if m.showWatchFeed || m.currentFilter != watchedFilterTerm || strings.Join(listIDs(m), ",") != "A-1" {
+		t.Errorf("expected l to list the watched issues, got %q %v", m.currentFilter, listIDs(m))
+	}
+
+	changes := []WatchChange{{ID: "A-1", Changes: []string{"priority P2 → P1", "new comment"}}, {ID: "GONE", Changes: []string{"deleted"}}}
+	cmd := m.noteWatchChanges(changes)
+	if cmd == nil {
+		t.Fatal("expected the issue-watched hook for A-1")
+	}
+	newM, _ := m.Update(cmd())
+	m = newM.(Model)
+	if !strings.Contains(m.statusMsg, "Hook ping finished for A-1: A-1 priority P2 → P1, new comment") {
+		t.Errorf("expected the hook result, got %q", m.statusMsg)
+	}
+	m.readOnly = true
+	if cmd := m.noteWatchChanges(changes); cmd != nil {
+		t.Error("expected read-only sessions not to run hooks")
+	}
+}
+
+// drainCmd runs cmd and any batched commands it returns, discarding messages
+// that would block (such as waits on a background worker).
+func drainCmd(cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	done := make(chan tea.Msg, 1)
+	go func() { done <- cmd() }()
+	select {
+	case msg := <-done:
+		if batch, ok := msg.(tea.BatchMsg); ok {
+			for _, c := range batch {
+				drainCmd(c)
+			}
+		}
+	case <-time.After(time.Second):
+	}
+}
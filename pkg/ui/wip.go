@@ -0,0 +1,119 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/i18n"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// WIP limits
+//
+// ui.wip_limits caps how many issues a status column of the board may hold.
+// A column past its limit is highlighted on the board. Status changes are
+// checked against the limits before bd runs: one that takes a column past
+// its limit is refused if the column blocks, and otherwise goes ahead with
+// a warning. The policy checks a batch of changes at once, so moving one
+// card and changing many issues together follow the same rules.
+
+// wipColumns names the board's status columns in ui.wip_limits.
+var wipColumns = [4]string{"open", "in_progress", "blocked", "closed"}
+
+// wipPolicy holds the limit of each status column; zero means none.
+type wipPolicy struct {
+	limits [4]int
+	block  [4]bool
+}
+
+// ValidateWIPLimits checks ui.wip_limits: every key must name a status
+// column and every limit must be positive.
+func ValidateWIPLimits(limits map[string]config.WIPLimit) error {
+	for name, limit := range limits {
+		if wipColumn(name) < 0 {
+			return fmt.Errorf("unknown board column %q (want one of %s)", name, strings.Join(wipColumns[:], ", "))
+		}
+		if limit.Limit <= 0 {
+			return fmt.Errorf("column %s: limit must be positive, got %d", name, limit.Limit)
+		}
+	}
+	return nil
+}
+
+// SetWIPLimits sets the WIP policy of the board's status columns. Check
+// the limits with ValidateWIPLimits first; unknown columns are ignored.
+func (m *Model) SetWIPLimits(limits map[string]config.WIPLimit) {
+	var p wipPolicy
+	for name, limit := range limits {
+		if col := wipColumn(name); col >= 0 && limit.Limit > 0 {
+			p.limits[col] = limit.Limit
+			p.block[col] = limit.Block
+		}
+	}
+	m.wip = p
+	m.board.wip = p
+}
+
+func wipColumn(name string) int {
+	for i, col := range wipColumns {
+		if col == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// over reports whether count issues take column col past its limit.
+func (p wipPolicy) over(col, count int) bool {
+	return p.limits[col] > 0 && count > p.limits[col]
+}
+
+// check applies moves, from issue ID to new status, to the column counts
+// of issues. It fails if a move fills a blocking column past its limit,
+// and otherwise returns a warning for the columns taken past theirs.
+func (p wipPolicy) check(issues []model.Issue, moves map[string]model.Status) (warning string, err error) {
+	var counts [4]int
+	var gained [4]bool
+	for i := range issues {
+		from := statusColumn(issues[i].Status)
+		to := from
+		if status, ok := moves[issues[i].ID]; ok {
+			to = statusColumn(status)
+		}
+		counts[to]++
+		if to != from {
+			gained[to] = true
+		}
+	}
+
+	var warnings []string
+	for col := range counts {
+		if !gained[col] || !p.over(col, counts[col]) {
+			continue
+		}
+		if p.block[col] {
+			return "", errors.New(i18n.Tf("%s is limited to %d issues", statusColumnTitles[col], p.limits[col]))
+		}
+		warnings = append(warnings, i18n.Tf("%s over its WIP limit: %d/%d", statusColumnTitles[col], counts[col], p.limits[col]))
+	}
+	return strings.Join(warnings, "; "), nil
+}
+
+// moveIssue changes the status of issue to status by running bd with args,
+// subject to the WIP limits.
+func (m Model) moveIssue(issue model.Issue, status model.Status, summary string, args ...string) (Model, tea.Cmd) {
+	warning, err := m.wip.check(m.issues, map[string]model.Status{issue.ID: status})
+	if err != nil {
+		m.statusMsg = i18n.Tf("Can't move %s: %v", issue.ID, err)
+		m.statusIsError = true
+		return m, nil
+	}
+	if warning != "" {
+		summary += " (" + warning + ")"
+	}
+	return m, m.runBDCmd(summary, args...)
+}
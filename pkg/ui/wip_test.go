@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestWIPPolicy_Check(t *testing.T) {
+	m := commandTestModel(t)
+	m.SetWIPLimits(map[string]config.WIPLimit{
+		"in_progress": {Limit: 1, Block: true},
+		"open":        {Limit: 2},
+	})
+
+	if warning, err := m.wip.check(m.issues, map[string]model.Status{"A-1": model.StatusInProgress}); err != nil || warning != "" {
+		t.Errorf("expected a move up to the limit to pass, got %q (%v)", warning, err)
+	}
+	moves := map[string]model.Status{"A-1": model.StatusInProgress, "A-2": model.StatusInProgress}
+	if _, err := m.wip.check(m.issues, moves); err == nil || err.Error() != "IN PROGRESS is limited to 1 issues" {
+		t.Errorf("expected the blocking column to refuse two moves, got %v", err)
+	}
+	warning, err := m.wip.check(m.issues, map[string]model.Status{"A-3": model.StatusOpen})
+	if err != nil || warning != "OPEN over its WIP limit: 3/2" {
+		t.Errorf("expected a warning for the open column, got %q (%v)", warning, err)
+	}
+	if warning, err := m.wip.check(m.issues, map[string]model.Status{"A-1": model.StatusOpen}); err != nil || warning != "" {
+		t.Errorf("expected a move within a column not to count, got %q (%v)", warning, err)
+	}
+
+	for _, limits := range []map[string]config.WIPLimit{
+		{"doing": {Limit: 3}},
+		{"blocked": {Limit: 0, Block: true}},
+	} {
+		if err := ValidateWIPLimits(limits); err == nil {
+			t.Errorf("expected %v to be rejected", limits)
+		}
+	}
+}
+
+func TestWIPLimits_BoardAndMoves(t *testing.T) {
+	withBD(t, true)
+	fakeBD(t, `echo "$@" > args`)
+	m := commandTestModel(t)
+	m.workDir = t.TempDir()
+	m.SetWIPLimits(map[string]config.WIPLimit{"open": {Limit: 1}, "closed": {Limit: 1, Block: true}})
+
+	m, _, err := m.ExecCommand("view board")
+	if err != nil {
+		t.Fatal(err)
+	}
+	view := m.View()
+	if !strings.Contains(view, "OPEN (2/1)") || !strings.Contains(view, "CLOSED (1/1)") {
+		t.Fatalf("expected the limits in the column headers:\n%s", view)
+	}
+
+	m = pressKey(m, runeKey("."))
+	if m.CurrentContext() != ContextActionMenu {
+		t.Fatalf("expected the action menu, got %s", m.CurrentContext())
+	}
+	m = pressKey(m, runeKey("c"))
+	if !m.statusIsError || m.statusMsg != "Can't move "+m.actionMenuIssue.ID+": CLOSED is limited to 1 issues" {
+		t.Fatalf("expected the close refused, got %q", m.statusMsg)
+	}
+
+	m.SetWIPLimits(map[string]config.WIPLimit{"closed": {Limit: 1}})
+	m = pressKey(m, runeKey("."))
+	newM, cmd := m.Update(runeKey("c"))
+	m = newM.(Model)
+	if cmd == nil {
+		t.Fatal("expected a warning-only limit to let the close through")
+	}
+	newM, _ = m.Update(cmd())
+	m = newM.(Model)
+	if want := "Closed " + m.actionMenuIssue.ID + " (CLOSED over its WIP limit: 2/1)"; !strings.Contains(m.statusMsg, want) {
+		t.Errorf("expected %q, got %q", want, m.statusMsg)
+	}
+}
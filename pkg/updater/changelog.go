@@ -0,0 +1,78 @@
+package updater
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxReleasePages bounds how far back GetReleasesBetween pages through the
+// release list, 100 releases a page.
+const maxReleasePages = 5
+
+// GetReleasesBetween returns the published releases after version from up
+// to and including version to, newest first, with their release notes.
+// Drafts and prereleases are left out.
+func GetReleasesBetween(ctx context.Context, from, to string) ([]Release, error) {
+	client := &http.Client{Timeout: 30 * time.Second}
+	return getReleasesBetween(ctx, client, baseURL+"/releases", from, to)
+}
+
+func getReleasesBetween(ctx context.Context, client *http.Client, url, from, to string) ([]Release, error) {
+	var between []Release
+	for page := 1; page <= maxReleasePages; page++ {
+		releases, err := fetchReleasePage(ctx, client, url, page)
+		if err != nil {
+			return nil, err
+		}
+
+		// Releases come newest first; a page reaching back to from is the
+		// last one needed.
+		reachedFrom := false
+		for _, rel := range releases {
+			if compareVersions(rel.TagName, from) <= 0 {
+				reachedFrom = true
+				continue
+			}
+			if rel.Draft || rel.Prerelease || compareVersions(rel.TagName, to) > 0 {
+				continue
+			}
+			between = append(between, rel)
+		}
+		if reachedFrom || len(releases) < 100 {
+			break
+		}
+	}
+
+	sort.SliceStable(between, func(i, j int) bool {
+		return compareVersions(between[i].TagName, between[j].TagName) > 0
+	})
+	return between, nil
+}
+
+// ReleaseNotes renders the notes of releases as one markdown document, a
+// section per release.
+func ReleaseNotes(releases []Release) string {
+	var b strings.Builder
+	for i, rel := range releases {
+		if i > 0 {
+			b.WriteString("\n\n")
+		}
+		b.WriteString("# " + rel.TagName)
+		if name := strings.TrimSpace(rel.Name); name != "" && name != rel.TagName {
+			b.WriteString(" — " + name)
+		}
+		if !rel.PublishedAt.IsZero() {
+			b.WriteString(" (" + rel.PublishedAt.Format("2006-01-02") + ")")
+		}
+		b.WriteString("\n\n")
+		if body := strings.TrimSpace(rel.Body); body != "" {
+			b.WriteString(body)
+		} else {
+			b.WriteString("_No release notes._")
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,63 @@
+package updater
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetReleasesBetween(t *testing.T) {
+	var pages []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pages = append(pages, r.URL.Query().Get("page"))
+		_, _ = w.Write([]byte(`[
+			{"tag_name": "v0.14.0-rc1", "prerelease": true, "body": "rc"},
+			{"tag_name": "v0.13.0", "body": "newest"},
+			{"tag_name": "v0.12.1", "draft": true, "body": "draft"},
+			{"tag_name": "v0.12.0", "body": "fixes"},
+			{"tag_name": "v0.11.0", "body": "installed"},
+			{"tag_name": "v0.10.0", "body": "older"}
+		]`))
+	}))
+	t.Cleanup(srv.Close)
+
+	releases, err := getReleasesBetween(context.Background(), srv.Client(), srv.URL, "v0.11.0", "v0.13.0")
+	if err != nil {
+		t.Fatalf("getReleasesBetween: %v", err)
+	}
+	var tags []string
+	for _, rel := range releases {
+		tags = append(tags, rel.TagName)
+	}
+	if got := strings.Join(tags, ","); got != "v0.13.0,v0.12.0" {
+		t.Errorf("expected the published releases after v0.11.0, newest first, got %s", got)
+	}
+	if len(pages) != 1 {
+		t.Errorf("expected one page once v0.11.0 was reached, fetched %v", pages)
+	}
+}
+
+func TestGetReleasesBetween_Error(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	t.Cleanup(srv.Close)
+
+	if _, err := getReleasesBetween(context.Background(), srv.Client(), srv.URL, "v0.11.0", "v0.13.0"); err == nil || !strings.Contains(err.Error(), "403") {
+		t.Fatalf("expected the status in the error, got %v", err)
+	}
+}
+
+func TestReleaseNotes(t *testing.T) {
+	notes := ReleaseNotes([]Release{
+		{TagName: "v0.13.0", Name: "Graphs", Body: "## Features\n\n- Critical path\n", PublishedAt: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{TagName: "v0.12.0", Name: "v0.12.0"},
+	})
+	want := "# v0.13.0 — Graphs (2026-10-01)\n\n## Features\n\n- Critical path\n\n# v0.12.0\n\n_No release notes._"
+	if notes != want {
+		t.Errorf("unexpected notes:\n%s\nwant:\n%s", notes, want)
+	}
+}
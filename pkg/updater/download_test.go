@@ -1,11 +1,14 @@
 package updater
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 )
 
 func TestDownloadFile_SizeVerified_Success(t *testing.T) {
@@ -18,7 +21,7 @@ func TestDownloadFile_SizeVerified_Success(t *testing.T) {
 	t.Cleanup(srv.Close)
 
 	dest := filepath.Join(t.TempDir(), "file.bin")
-	if err := downloadFile(srv.URL, dest, 5); err != nil {
+	if err := downloadFile(context.Background(), srv.URL, dest, 5); err != nil {
 		t.Fatalf("downloadFile: %v", err)
 	}
 	got, err := os.ReadFile(dest)
@@ -40,7 +43,7 @@ func TestDownloadFile_SizeMismatch_Header(t *testing.T) {
 	t.Cleanup(srv.Close)
 
 	dest := filepath.Join(t.TempDir(), "file.bin")
-	if err := downloadFile(srv.URL, dest, 5); err == nil {
+	if err := downloadFile(context.Background(), srv.URL, dest, 5); err == nil {
 		t.Fatalf("expected size mismatch error")
 	}
 }
@@ -58,7 +61,29 @@ func TestDownloadFile_SizeMismatch_WrittenBytes(t *testing.T) {
 	t.Cleanup(srv.Close)
 
 	dest := filepath.Join(t.TempDir(), "file.bin")
-	if err := downloadFile(srv.URL, dest, 5); err == nil {
+	if err := downloadFile(context.Background(), srv.URL, dest, 5); err == nil {
 		t.Fatalf("expected downloaded size mismatch error")
 	}
 }
+
+func TestDownloadFile_Canceled(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if f, ok := w.(http.Flusher); ok {
+			f.Flush()
+		}
+		<-r.Context().Done()
+	}))
+	t.Cleanup(srv.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	start := time.Now()
+	err := downloadFile(ctx, srv.URL, filepath.Join(t.TempDir(), "file.bin"), 0)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected a canceled download, got %v", err)
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Fatalf("download was not interrupted promptly")
+	}
+}
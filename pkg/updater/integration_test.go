@@ -4,6 +4,7 @@ import (
 	"archive/tar"
 	"bytes"
 	"compress/gzip"
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
@@ -89,7 +90,7 @@ func TestFullUpdateFlow_WithMockServer(t *testing.T) {
 
 	// Test that the mocked release can be parsed
 	client := server.Client()
-	tag, url, err := checkForUpdates(client, server.URL+"/releases/latest")
+	tag, url, err := checkForUpdates(context.Background(), client, server.URL+"/releases/latest", Preferences{})
 	if err != nil {
 		t.Fatalf("checkForUpdates failed: %v", err)
 	}
@@ -114,7 +115,7 @@ func TestCheckUpdateAvailable_NoUpdateNeeded(t *testing.T) {
 	defer server.Close()
 
 	client := server.Client()
-	tag, _, err := checkForUpdates(client, server.URL)
+	tag, _, err := checkForUpdates(context.Background(), client, server.URL, Preferences{})
 	if err != nil {
 		t.Fatalf("checkForUpdates failed: %v", err)
 	}
@@ -214,7 +215,7 @@ func TestDownloadFile_404_ReturnsError(t *testing.T) {
 	tmpDir := t.TempDir()
 	destPath := filepath.Join(tmpDir, "download.bin")
 
-	err := downloadFile(server.URL, destPath, 0)
+	err := downloadFile(context.Background(), server.URL, destPath, 0)
 	if err == nil {
 		t.Fatal("expected error for 404 response")
 	}
@@ -1,6 +1,7 @@
 package updater
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -73,7 +74,7 @@ func TestCheckForUpdates_Network(t *testing.T) {
 			client := server.Client()
 			client.Timeout = 1 * time.Second
 
-			tag, url, err := checkForUpdates(client, server.URL)
+			tag, url, err := checkForUpdates(context.Background(), client, server.URL, Preferences{})
 
 			if (err != nil) != tt.expectErr {
 				t.Errorf("checkForUpdates() error = %v, expectErr %v", err, tt.expectErr)
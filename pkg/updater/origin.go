@@ -0,0 +1,189 @@
+package updater
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// Installers that can own the bv binary.
+const (
+	InstallerNone     = ""           // A release binary or local build, which bv updates itself
+	InstallerHomebrew = "homebrew"   // Homebrew or Linuxbrew
+	InstallerScoop    = "scoop"      // Scoop on Windows
+	InstallerApt      = "apt"        // A Debian package
+	InstallerGo       = "go-install" // go install
+)
+
+// modulePath is the import path of bv for go install.
+const modulePath = "github.com/Dicklesworthstone/beads_viewer/cmd/bv"
+
+// dpkgInfoDir holds the file lists of installed Debian packages.
+var dpkgInfoDir = "/var/lib/dpkg/info"
+
+// InstallOrigin describes how the running bv was installed.
+type InstallOrigin struct {
+	Installer string `json:"installer,omitempty"` // One of the Installer constants
+	Package   string `json:"package,omitempty"`   // The package that owns the binary, for apt
+	Path      string `json:"path"`                // The binary, symlinks resolved
+}
+
+// Managed reports whether a package manager owns the binary. bv must not
+// replace a managed binary: the manager would not know of the new version
+// and could overwrite or fail to remove it later.
+func (o InstallOrigin) Managed() bool {
+	return o.Installer != InstallerNone
+}
+
+// Name returns the name of the installer for messages.
+func (o InstallOrigin) Name() string {
+	switch o.Installer {
+	case InstallerHomebrew:
+		return "Homebrew"
+	case InstallerScoop:
+		return "Scoop"
+	case InstallerApt:
+		return "apt"
+	case InstallerGo:
+		return "go install"
+	default:
+		return "a release download"
+	}
+}
+
+// UpgradeCommand returns the command that updates a managed install, or ""
+// when bv updates itself.
+func (o InstallOrigin) UpgradeCommand() string {
+	switch o.Installer {
+	case InstallerHomebrew:
+		return "brew upgrade bv"
+	case InstallerScoop:
+		return "scoop update bv"
+	case InstallerApt:
+		return "sudo apt-get update && sudo apt-get install --only-upgrade " + o.Package
+	case InstallerGo:
+		return "go install " + modulePath + "@latest"
+	default:
+		return ""
+	}
+}
+
+// ManagedInstallError reports an update refused because a package manager
+// owns the binary.
+type ManagedInstallError struct {
+	Origin InstallOrigin
+}
+
+func (e *ManagedInstallError) Error() string {
+	return fmt.Sprintf("bv was installed with %s; update it with: %s", e.Origin.Name(), e.Origin.UpgradeCommand())
+}
+
+// DetectInstallOrigin reports how the running bv was installed. When the
+// binary cannot be found it assumes a release download.
+func DetectInstallOrigin() InstallOrigin {
+	path, err := GetCurrentBinaryPath()
+	if err != nil {
+		return InstallOrigin{}
+	}
+	return detectInstallOrigin(path, os.Getenv)
+}
+
+// detectInstallOrigin reports how the binary at path, symlinks resolved,
+// was installed, reading the environment through getenv.
+func detectInstallOrigin(path string, getenv func(string) string) InstallOrigin {
+	origin := InstallOrigin{Path: path}
+	slashed := filepath.ToSlash(path)
+	if runtime.GOOS == "windows" {
+		slashed = strings.ToLower(slashed)
+	}
+
+	switch {
+	// Homebrew links bin/bv to the keg in Cellar/bv/<version>.
+	case strings.Contains(slashed, "/Cellar/"):
+		origin.Installer = InstallerHomebrew
+	case strings.Contains(slashed, "/scoop/apps/") || underDir(path, getenv("SCOOP")):
+		origin.Installer = InstallerScoop
+	case inGoBin(filepath.Dir(path), getenv):
+		origin.Installer = InstallerGo
+	default:
+		if pkg := dpkgOwner(path); pkg != "" {
+			origin.Installer = InstallerApt
+			origin.Package = pkg
+		}
+	}
+	return origin
+}
+
+// underDir reports whether path is inside dir, if dir is set.
+func underDir(path, dir string) bool {
+	if dir == "" {
+		return false
+	}
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// inGoBin reports whether dir is where go install puts binaries: $GOBIN,
+// or the bin directory of a $GOPATH entry, $HOME/go by default.
+func inGoBin(dir string, getenv func(string) string) bool {
+	var bins []string
+	if gobin := getenv("GOBIN"); gobin != "" {
+		bins = append(bins, gobin)
+	}
+	gopath := getenv("GOPATH")
+	if gopath == "" {
+		if home := getenv("HOME"); home != "" {
+			gopath = filepath.Join(home, "go")
+		} else if home := getenv("USERPROFILE"); home != "" {
+			gopath = filepath.Join(home, "go")
+		}
+	}
+	for _, entry := range filepath.SplitList(gopath) {
+		if entry != "" {
+			bins = append(bins, filepath.Join(entry, "bin"))
+		}
+	}
+	for _, bin := range bins {
+		if filepath.Clean(bin) == filepath.Clean(dir) {
+			return true
+		}
+	}
+	return false
+}
+
+// dpkgOwner returns the Debian package whose file list names path, or "".
+// Only system directories are searched; dpkg does not install elsewhere.
+func dpkgOwner(path string) string {
+	if runtime.GOOS != "linux" || !strings.HasPrefix(path, "/usr/") && !strings.HasPrefix(path, "/bin/") {
+		return ""
+	}
+	lists, _ := filepath.Glob(filepath.Join(dpkgInfoDir, "*.list"))
+	for _, list := range lists {
+		if listsFile(list, path) {
+			pkg := strings.TrimSuffix(filepath.Base(list), ".list")
+			// Multi-arch packages are listed as name:arch.
+			pkg, _, _ = strings.Cut(pkg, ":")
+			return pkg
+		}
+	}
+	return ""
+}
+
+// listsFile reports whether the dpkg file list at list has a line path.
+func listsFile(list, path string) bool {
+	f, err := os.Open(list)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if scanner.Text() == path {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+package updater
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func envOf(vars map[string]string) func(string) string {
+	return func(key string) string { return vars[key] }
+}
+
+func TestDetectInstallOrigin(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("paths below are Unix paths")
+	}
+	home := map[string]string{"HOME": "/home/ada"}
+	cases := []struct {
+		name string
+		path string
+		env  map[string]string
+		want string
+	}{
+		{"homebrew", "/opt/homebrew/Cellar/bv/0.11.0/bin/bv", home, InstallerHomebrew},
+		{"linuxbrew", "/home/linuxbrew/.linuxbrew/Cellar/bv/0.11.0/bin/bv", home, InstallerHomebrew},
+		{"scoop", "/home/ada/scoop/apps/bv/current/bv", home, InstallerScoop},
+		{"scoop elsewhere", "/tools/apps/bv/current/bv", map[string]string{"SCOOP": "/tools"}, InstallerScoop},
+		{"default gopath", "/home/ada/go/bin/bv", home, InstallerGo},
+		{"gobin", "/opt/gobin/bv", map[string]string{"GOBIN": "/opt/gobin", "HOME": "/home/ada"}, InstallerGo},
+		{"gopath list", "/src/second/bin/bv", map[string]string{"GOPATH": "/src/first" + string(os.PathListSeparator) + "/src/second"}, InstallerGo},
+		{"release download", "/home/ada/.local/bin/bv", home, InstallerNone},
+		{"gopath set", "/home/ada/go/bin/bv", map[string]string{"GOPATH": "/work", "HOME": "/home/ada"}, InstallerNone},
+	}
+	for _, tc := range cases {
+		if got := detectInstallOrigin(tc.path, envOf(tc.env)); got.Installer != tc.want || got.Path != tc.path {
+			t.Errorf("%s: expected %q for %s, got %+v", tc.name, tc.want, tc.path, got)
+		}
+	}
+}
+
+func TestDetectInstallOrigin_Dpkg(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("dpkg is Linux only")
+	}
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "coreutils.list"), []byte("/.\n/usr/bin/ls\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "beads-viewer:amd64.list"), []byte("/.\n/usr\n/usr/bin\n/usr/bin/bv\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	saved := dpkgInfoDir
+	dpkgInfoDir = dir
+	t.Cleanup(func() { dpkgInfoDir = saved })
+
+	origin := detectInstallOrigin("/usr/bin/bv", envOf(nil))
+	if origin.Installer != InstallerApt || origin.Package != "beads-viewer" {
+		t.Fatalf("expected the beads-viewer package, got %+v", origin)
+	}
+	if cmd := origin.UpgradeCommand(); !strings.HasSuffix(cmd, "--only-upgrade beads-viewer") {
+		t.Errorf("unexpected upgrade command %q", cmd)
+	}
+	if origin := detectInstallOrigin("/usr/local/bin/bv", envOf(nil)); origin.Managed() {
+		t.Errorf("expected a binary no package lists to be unmanaged, got %+v", origin)
+	}
+}
+
+func TestManagedInstallError(t *testing.T) {
+	err := &ManagedInstallError{Origin: InstallOrigin{Installer: InstallerHomebrew}}
+	if got := err.Error(); got != "bv was installed with Homebrew; update it with: brew upgrade bv" {
+		t.Errorf("unexpected message %q", got)
+	}
+	if (InstallOrigin{}).UpgradeCommand() != "" {
+		t.Error("expected no upgrade command for a release download")
+	}
+	if !strings.HasPrefix((InstallOrigin{Installer: InstallerGo}).UpgradeCommand(), "go install github.com/Dicklesworthstone/beads_viewer/cmd/bv@") {
+		t.Error("expected go install of the module")
+	}
+}
@@ -0,0 +1,200 @@
+package updater
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// Delta updates
+//
+// A release can publish bsdiff patches from the release before it, one per
+// platform, named bv_<from>_to_<to>_<os>_<arch>.bsdiff, with patches.txt
+// listing the SHA-256 of each patch and of the binary it produces, named
+// bv_<to>_<os>_<arch>. Updating from that release downloads the patch, a
+// fraction of the archive, and applies it to the running binary; anything
+// else downloads the archive. scripts/release_patches.sh publishes them.
+
+// patchChecksumsName is the checksums file of a release's patches.
+const patchChecksumsName = "patches.txt"
+
+// maxPatchedSize bounds the binary a patch may claim to produce.
+const maxPatchedSize = 1 << 30
+
+// errNoPatch reports that a release has no patch from the running version.
+var errNoPatch = errors.New("no patch from this version")
+
+// patchAssetName returns the name of the patch from version from to
+// version to for the current platform.
+func patchAssetName(from, to string) string {
+	return fmt.Sprintf("bv_%s_to_%s_%s_%s.bsdiff", strings.TrimPrefix(from, "v"), strings.TrimPrefix(to, "v"), runtime.GOOS, runtime.GOARCH)
+}
+
+// binaryChecksumName returns the name patches.txt gives the binary of
+// version for the current platform.
+func binaryChecksumName(version string) string {
+	return fmt.Sprintf("bv_%s_%s_%s", strings.TrimPrefix(version, "v"), runtime.GOOS, runtime.GOARCH)
+}
+
+// FindPatchAsset finds the patch from version from for the current
+// OS/arch, or nil.
+func (r *Release) FindPatchAsset(from string) *Asset {
+	return r.findAsset(patchAssetName(from, r.TagName))
+}
+
+// FindPatchChecksumAsset finds the checksums of the release's patches.
+func (r *Release) FindPatchChecksumAsset() *Asset {
+	return r.findAsset(patchChecksumsName)
+}
+
+// downloadPatched builds the binary of release at newBinaryPath by
+// patching the running binary at binaryPath, from version from. Both the
+// patch and the result are verified against patches.txt. It returns the
+// size of the patch, or errNoPatch when the release has none from this
+// version.
+func downloadPatched(ctx context.Context, release *Release, from, binaryPath, tmpDir, newBinaryPath string) (int64, error) {
+	patchAsset := release.FindPatchAsset(from)
+	checksumAsset := release.FindPatchChecksumAsset()
+	if patchAsset == nil || checksumAsset == nil {
+		return 0, errNoPatch
+	}
+
+	checksumPath := filepath.Join(tmpDir, patchChecksumsName)
+	if err := downloadFile(ctx, checksumAsset.BrowserDownloadURL, checksumPath, checksumAsset.Size); err != nil {
+		return 0, fmt.Errorf("patch checksum download failed: %w", err)
+	}
+	checksums, err := parseChecksums(checksumPath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse patch checksums: %w", err)
+	}
+	patchHash, ok := checksums[patchAsset.Name]
+	binaryHash, ok2 := checksums[binaryChecksumName(release.TagName)]
+	if !ok || !ok2 {
+		return 0, fmt.Errorf("no checksum found for %s", patchAsset.Name)
+	}
+
+	fmt.Printf("Downloading patch to %s (%s)...\n", release.TagName, formatSize(patchAsset.Size))
+	patchPath := filepath.Join(tmpDir, patchAsset.Name)
+	if err := downloadFile(ctx, patchAsset.BrowserDownloadURL, patchPath, patchAsset.Size); err != nil {
+		return 0, fmt.Errorf("patch download failed: %w", err)
+	}
+	if err := verifyChecksum(patchPath, patchHash); err != nil {
+		return 0, fmt.Errorf("patch verification failed: %w", err)
+	}
+
+	fmt.Println("Applying patch...")
+	if err := applyPatch(binaryPath, patchPath, newBinaryPath); err != nil {
+		return 0, err
+	}
+	// A binary other than the release the patch was made from, such as a
+	// local build, patches into garbage; the checksum catches it.
+	if err := verifyChecksum(newBinaryPath, binaryHash); err != nil {
+		return 0, fmt.Errorf("patched binary verification failed: %w", err)
+	}
+	return patchAsset.Size, nil
+}
+
+// formatSize renders a byte count for progress messages, e.g. "1.2 MB".
+func formatSize(n int64) string {
+	switch {
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(n)/(1<<10))
+	default:
+		return fmt.Sprintf("%d B", n)
+	}
+}
+
+// applyPatch writes the file that the bsdiff patch at patchPath makes of
+// the file at oldPath to newPath, executable.
+func applyPatch(oldPath, patchPath, newPath string) error {
+	old, err := os.ReadFile(oldPath)
+	if err != nil {
+		return fmt.Errorf("reading current binary: %w", err)
+	}
+	patch, err := os.ReadFile(patchPath)
+	if err != nil {
+		return err
+	}
+	patched, err := bspatch(old, patch)
+	if err != nil {
+		return fmt.Errorf("applying patch: %w", err)
+	}
+	if err := os.WriteFile(newPath, patched, 0o755); err != nil {
+		return fmt.Errorf("failed to write patched binary: %w", err)
+	}
+	return nil
+}
+
+// bspatch applies a patch in the BSDIFF40 format of bsdiff 4 to old: a
+// header with the lengths of the bzip2-compressed control and diff blocks
+// and the new size, then the control, diff and extra blocks. Each control
+// entry adds x diff bytes to the old bytes at the read position, copies y
+// extra bytes, and moves the read position by z.
+func bspatch(old, patch []byte) ([]byte, error) {
+	if len(patch) < 32 || string(patch[:8]) != "BSDIFF40" {
+		return nil, errors.New("not a bsdiff patch")
+	}
+	ctrlLen, diffLen, newSize := offtin(patch[8:]), offtin(patch[16:]), offtin(patch[24:])
+	if ctrlLen < 0 || diffLen < 0 || newSize < 0 || newSize > maxPatchedSize ||
+		ctrlLen > int64(len(patch)-32) || diffLen > int64(len(patch)-32)-ctrlLen {
+		return nil, errors.New("corrupt patch header")
+	}
+	body := patch[32:]
+	ctrl := bzip2.NewReader(bytes.NewReader(body[:ctrlLen]))
+	diff := bzip2.NewReader(bytes.NewReader(body[ctrlLen : ctrlLen+diffLen]))
+	extra := bzip2.NewReader(bytes.NewReader(body[ctrlLen+diffLen:]))
+
+	out := make([]byte, newSize)
+	var newPos, oldPos int64
+	var entry [24]byte
+	for newPos < newSize {
+		if _, err := io.ReadFull(ctrl, entry[:]); err != nil {
+			return nil, fmt.Errorf("corrupt patch control block: %w", err)
+		}
+		x, y, z := offtin(entry[0:]), offtin(entry[8:]), offtin(entry[16:])
+		if x < 0 || y < 0 || x > newSize-newPos {
+			return nil, errors.New("corrupt patch")
+		}
+		if _, err := io.ReadFull(diff, out[newPos:newPos+x]); err != nil {
+			return nil, fmt.Errorf("corrupt patch diff block: %w", err)
+		}
+		for i := int64(0); i < x; i++ {
+			if p := oldPos + i; p >= 0 && p < int64(len(old)) {
+				out[newPos+i] += old[p]
+			}
+		}
+		newPos += x
+		oldPos += x
+
+		if y > newSize-newPos {
+			return nil, errors.New("corrupt patch")
+		}
+		if _, err := io.ReadFull(extra, out[newPos:newPos+y]); err != nil {
+			return nil, fmt.Errorf("corrupt patch extra block: %w", err)
+		}
+		newPos += y
+		oldPos += z
+	}
+	return out, nil
+}
+
+// offtin decodes a bsdiff integer: little-endian magnitude, with the sign
+// in the top bit.
+func offtin(b []byte) int64 {
+	v := binary.LittleEndian.Uint64(b[:8])
+	n := int64(v &^ (1 << 63))
+	if v&(1<<63) != 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,160 @@
+package updater
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// testdata/bv.bsdiff is a BSDIFF40 patch from testdata/bv-old to
+// testdata/bv-new, with a diff and an extra block.
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	return data
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestBspatch_AppliesPatch(t *testing.T) {
+	got, err := bspatch(readFixture(t, "bv-old"), readFixture(t, "bv.bsdiff"))
+	if err != nil {
+		t.Fatalf("bspatch: %v", err)
+	}
+	if want := readFixture(t, "bv-new"); !bytes.Equal(got, want) {
+		t.Fatalf("patched output differs:\ngot  %q\nwant %q", got, want)
+	}
+}
+
+func TestBspatch_RejectsCorruptPatches(t *testing.T) {
+	old, patch := readFixture(t, "bv-old"), readFixture(t, "bv.bsdiff")
+	hugeSize := append([]byte{}, patch...)
+	copy(hugeSize[24:], []byte{0, 0, 0, 0, 0, 0, 0, 0x7f})
+	noExtra := patch[:32+offtin(patch[8:])+offtin(patch[16:])]
+
+	cases := map[string][]byte{
+		"not bsdiff":      []byte("PK\x03\x04 definitely a zip"),
+		"short header":    patch[:20],
+		"no extra block":  noExtra,
+		"oversized block": append(append([]byte{}, patch[:8]...), bytes.Repeat([]byte{0xff, 0xff, 0xff, 0x0f, 0, 0, 0, 0}, 3)...),
+		"huge output":     hugeSize,
+	}
+	for name, p := range cases {
+		if _, err := bspatch(old, p); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestRelease_FindPatchAsset(t *testing.T) {
+	platform := runtime.GOOS + "_" + runtime.GOARCH
+	rel := &Release{TagName: "v0.11.0", Assets: []Asset{
+		{Name: "bv_0.9.0_to_0.11.0_" + platform + ".bsdiff"},
+		{Name: "bv_0.10.0_to_0.11.0_" + platform + ".bsdiff"},
+		{Name: "patches.txt"},
+	}}
+	if asset := rel.FindPatchAsset("v0.10.0"); asset == nil || asset.Name != "bv_0.10.0_to_0.11.0_"+platform+".bsdiff" {
+		t.Errorf("expected the patch from 0.10.0, got %#v", asset)
+	}
+	if asset := rel.FindPatchAsset("0.8.0"); asset != nil {
+		t.Errorf("expected no patch from 0.8.0, got %#v", asset)
+	}
+	if asset := rel.FindPatchChecksumAsset(); asset == nil {
+		t.Error("expected patches.txt")
+	}
+}
+
+// patchRelease serves the fixture patch from 0.10.0 to 0.11.0 and a
+// patches.txt that lists the given hash for the patched binary.
+func patchRelease(t *testing.T, binaryHash string) *Release {
+	t.Helper()
+	patch := readFixture(t, "bv.bsdiff")
+	patchName := patchAssetName("0.10.0", "v0.11.0")
+	checksums := fmt.Sprintf("%s  %s\n%s  %s\n", sha256Hex(patch), patchName, binaryHash, binaryChecksumName("v0.11.0"))
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/" + patchName:
+			_, _ = w.Write(patch)
+		case "/patches.txt":
+			_, _ = w.Write([]byte(checksums))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return &Release{TagName: "v0.11.0", Assets: []Asset{
+		{Name: patchName, BrowserDownloadURL: srv.URL + "/" + patchName, Size: int64(len(patch))},
+		{Name: "patches.txt", BrowserDownloadURL: srv.URL + "/patches.txt", Size: int64(len(checksums))},
+	}}
+}
+
+func TestDownloadPatched(t *testing.T) {
+	dir := t.TempDir()
+	binaryPath := filepath.Join(dir, "bv")
+	if err := os.WriteFile(binaryPath, readFixture(t, "bv-old"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	want := readFixture(t, "bv-new")
+	newBinaryPath := filepath.Join(dir, "bv-new")
+
+	size, err := downloadPatched(context.Background(), patchRelease(t, sha256Hex(want)), "v0.10.0", binaryPath, dir, newBinaryPath)
+	if err != nil {
+		t.Fatalf("downloadPatched: %v", err)
+	}
+	if size != int64(len(readFixture(t, "bv.bsdiff"))) {
+		t.Errorf("expected the patch size, got %d", size)
+	}
+	if got, _ := os.ReadFile(newBinaryPath); !bytes.Equal(got, want) {
+		t.Error("expected the patched binary at newBinaryPath")
+	}
+	if runtime.GOOS != "windows" {
+		if info, err := os.Stat(newBinaryPath); err != nil || info.Mode().Perm()&0o111 == 0 {
+			t.Errorf("expected an executable binary, got %v (%v)", info.Mode(), err)
+		}
+	}
+}
+
+func TestDownloadPatched_RejectsWrongResult(t *testing.T) {
+	// A local build is not the binary the patch was made from.
+	dir := t.TempDir()
+	binaryPath := filepath.Join(dir, "bv")
+	if err := os.WriteFile(binaryPath, []byte("a local build"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	release := patchRelease(t, sha256Hex(readFixture(t, "bv-new")))
+	_, err := downloadPatched(context.Background(), release, "v0.10.0", binaryPath, dir, filepath.Join(dir, "bv-new"))
+	if err == nil || !strings.Contains(err.Error(), "patched binary verification failed") {
+		t.Fatalf("expected the result checksum to fail, got %v", err)
+	}
+}
+
+func TestDownloadPatched_NoPatch(t *testing.T) {
+	dir := t.TempDir()
+	release := patchRelease(t, "unused")
+	_, err := downloadPatched(context.Background(), release, "v0.9.0", filepath.Join(dir, "bv"), dir, filepath.Join(dir, "bv-new"))
+	if !errors.Is(err, errNoPatch) {
+		t.Fatalf("expected errNoPatch from a version without a patch, got %v", err)
+	}
+
+	release.Assets = release.Assets[:1] // The patch without patches.txt
+	_, err = downloadPatched(context.Background(), release, "v0.10.0", filepath.Join(dir, "bv"), dir, filepath.Join(dir, "bv-new"))
+	if !errors.Is(err, errNoPatch) {
+		t.Fatalf("expected errNoPatch without patches.txt, got %v", err)
+	}
+}
@@ -0,0 +1,116 @@
+package updater
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Preferences narrow the releases bv offers to update to. The zero value
+// offers the latest release.
+type Preferences struct {
+	// SkipRelease is a release not to remind about, e.g. "v1.2.3". A newer
+	// release is offered again.
+	SkipRelease string
+
+	// Pin keeps updates within one major version, e.g. "v1": the newest
+	// release of that major version is offered instead of the latest.
+	Pin string
+}
+
+// ValidatePin checks a major version pin: "", or a major version such as
+// "v1" or "1".
+func ValidatePin(pin string) error {
+	if pin == "" {
+		return nil
+	}
+	if _, ok := pinnedMajor(pin); !ok {
+		return fmt.Errorf("expected a major version such as v1, got %q", pin)
+	}
+	return nil
+}
+
+// pinnedMajor returns the major version of pin.
+func pinnedMajor(pin string) (int, bool) {
+	major, err := strconv.Atoi(strings.TrimPrefix(strings.TrimSpace(pin), "v"))
+	return major, err == nil && major >= 0
+}
+
+// majorVersion returns the major version of tag, or -1.
+func majorVersion(tag string) int {
+	head, _, _ := strings.Cut(strings.TrimPrefix(tag, "v"), ".")
+	major, err := strconv.Atoi(head)
+	if err != nil {
+		return -1
+	}
+	return major
+}
+
+// pinned reports whether tag is outside the pinned major version.
+func (p Preferences) pinned(tag string) bool {
+	major, ok := pinnedMajor(p.Pin)
+	return p.Pin != "" && ok && majorVersion(tag) != major
+}
+
+// skipped reports whether the user asked not to be reminded about tag.
+func (p Preferences) skipped(tag string) bool {
+	return p.SkipRelease != "" && compareVersions(tag, p.SkipRelease) == 0
+}
+
+// applyPin returns latest, or when it is outside the pinned major version
+// the newest published release of that version from the release list at
+// listURL, or nil if there is none.
+func (p Preferences) applyPin(ctx context.Context, client *http.Client, listURL string, latest *Release) (*Release, error) {
+	if !p.pinned(latest.TagName) {
+		return latest, nil
+	}
+	var newest *Release
+	for page := 1; page <= maxReleasePages; page++ {
+		releases, err := fetchReleasePage(ctx, client, listURL, page)
+		if err != nil {
+			return nil, err
+		}
+		for i := range releases {
+			rel := &releases[i]
+			if rel.Draft || rel.Prerelease || p.pinned(rel.TagName) {
+				continue
+			}
+			if newest == nil || compareVersions(rel.TagName, newest.TagName) > 0 {
+				newest = rel
+			}
+		}
+		// Releases come newest first, so the first page with a match has
+		// the newest one.
+		if newest != nil || len(releases) < 100 {
+			break
+		}
+	}
+	return newest, nil
+}
+
+// fetchReleasePage fetches one page of 100 releases, newest first, from
+// the release list at url.
+func fetchReleasePage(ctx context.Context, client *http.Client, url string, page int) ([]Release, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?per_page=100&page=%d", url, page), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "beads-viewer-updater")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch releases: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("github api returned status: %s", resp.Status)
+	}
+	var releases []Release
+	if err := json.NewDecoder(resp.Body).Decode(&releases); err != nil {
+		return nil, fmt.Errorf("failed to parse releases: %w", err)
+	}
+	return releases, nil
+}
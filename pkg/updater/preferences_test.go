@@ -0,0 +1,87 @@
+package updater
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// releaseServer serves v2.1.0 as the latest release and the list of
+// releases below it.
+func releaseServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/releases/latest":
+			_, _ = w.Write([]byte(`{"tag_name": "v2.1.0", "html_url": "http://example.com/v2.1.0"}`))
+		case "/releases":
+			_, _ = w.Write([]byte(`[
+				{"tag_name": "v2.1.0", "html_url": "http://example.com/v2.1.0"},
+				{"tag_name": "v2.0.0", "html_url": "http://example.com/v2.0.0"},
+				{"tag_name": "v1.9.0-rc1", "prerelease": true},
+				{"tag_name": "v1.8.0", "html_url": "http://example.com/v1.8.0"},
+				{"tag_name": "v1.7.0", "html_url": "http://example.com/v1.7.0"}
+			]`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestCheckForUpdates_Preferences(t *testing.T) {
+	srv := releaseServer(t)
+	cases := []struct {
+		name  string
+		prefs Preferences
+		want  string
+	}{
+		{"latest", Preferences{}, "v2.1.0"},
+		{"pinned", Preferences{Pin: "v1"}, "v1.8.0"},
+		{"pinned without v", Preferences{Pin: "1"}, "v1.8.0"},
+		{"pin on latest", Preferences{Pin: "v2"}, "v2.1.0"},
+		{"skipped latest", Preferences{SkipRelease: "v2.1.0"}, ""},
+		{"skipped older", Preferences{SkipRelease: "v2.0.0"}, "v2.1.0"},
+		{"skipped pinned", Preferences{Pin: "v1", SkipRelease: "1.8.0"}, ""},
+		{"pin with no release", Preferences{Pin: "v7"}, ""},
+	}
+	for _, tc := range cases {
+		tag, url, err := checkForUpdates(context.Background(), srv.Client(), srv.URL+"/releases/latest", tc.prefs)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if tag != tc.want || (tag != "" && url != "http://example.com/"+tag) {
+			t.Errorf("%s: expected %q, got %q (%s)", tc.name, tc.want, tag, url)
+		}
+	}
+}
+
+func TestGetLatestRelease_Pin(t *testing.T) {
+	srv := releaseServer(t)
+	rel, err := getLatestRelease(context.Background(), srv.Client(), srv.URL+"/releases", Preferences{Pin: "v1", SkipRelease: "v1.8.0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rel.TagName != "v1.8.0" {
+		t.Errorf("expected the newest v1 release, skipped or not, got %s", rel.TagName)
+	}
+	if _, err := getLatestRelease(context.Background(), srv.Client(), srv.URL+"/releases", Preferences{Pin: "v7"}); err == nil || !strings.Contains(err.Error(), "no release of major version v7") {
+		t.Errorf("expected an error for a pin with no release, got %v", err)
+	}
+}
+
+func TestValidatePin(t *testing.T) {
+	for _, pin := range []string{"", "v1", "1", "v0"} {
+		if err := ValidatePin(pin); err != nil {
+			t.Errorf("expected %q to be valid, got %v", pin, err)
+		}
+	}
+	for _, pin := range []string{"v1.2", "latest", "v-1"} {
+		if err := ValidatePin(pin); err == nil {
+			t.Errorf("expected %q to be rejected", pin)
+		}
+	}
+}
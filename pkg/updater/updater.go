@@ -3,9 +3,11 @@ package updater
 import (
 	"archive/tar"
 	"compress/gzip"
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -28,9 +30,14 @@ const (
 
 // Release represents a GitHub release
 type Release struct {
-	TagName string  `json:"tag_name"`
-	HTMLURL string  `json:"html_url"`
-	Assets  []Asset `json:"assets"`
+	TagName     string    `json:"tag_name"`
+	HTMLURL     string    `json:"html_url"`
+	Assets      []Asset   `json:"assets"`
+	Name        string    `json:"name,omitempty"`
+	Body        string    `json:"body,omitempty"` // Release notes, in markdown
+	Draft       bool      `json:"draft,omitempty"`
+	Prerelease  bool      `json:"prerelease,omitempty"`
+	PublishedAt time.Time `json:"published_at"`
 }
 
 // Asset represents a release asset (binary, checksum file, etc.)
@@ -48,20 +55,21 @@ type UpdateResult struct {
 	Success     bool   `json:"success"`
 	Message     string `json:"message"`
 	RequireRoot bool   `json:"require_root,omitempty"`
+	PatchSize   int64  `json:"patch_size,omitempty"` // Bytes downloaded, when a patch was used
 }
 
-// CheckForUpdates queries GitHub for the latest release.
+// CheckForUpdates queries GitHub for the latest release that prefs allow.
 // Returns the new version tag if an update is available, empty string otherwise.
-func CheckForUpdates() (string, string, error) {
+func CheckForUpdates(ctx context.Context, prefs Preferences) (string, string, error) {
 	// Set a short timeout to avoid blocking startup for too long
 	client := &http.Client{
 		Timeout: 2 * time.Second,
 	}
-	return checkForUpdates(client, "https://api.github.com/repos/Dicklesworthstone/beads_viewer/releases/latest")
+	return checkForUpdates(ctx, client, baseURL+"/releases/latest", prefs)
 }
 
-func checkForUpdates(client *http.Client, url string) (string, string, error) {
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+func checkForUpdates(ctx context.Context, client *http.Client, url string, prefs Preferences) (string, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return "", "", err
 	}
@@ -82,8 +90,12 @@ func checkForUpdates(client *http.Client, url string) (string, string, error) {
 		return "", "", fmt.Errorf("github api returned status: %s", resp.Status)
 	}
 
-	var rel Release
-	if err := json.NewDecoder(resp.Body).Decode(&rel); err != nil {
+	var latest Release
+	if err := json.NewDecoder(resp.Body).Decode(&latest); err != nil {
+		return "", "", err
+	}
+	rel, err := prefs.applyPin(ctx, client, strings.TrimSuffix(url, "/latest"), &latest)
+	if err != nil || rel == nil || prefs.skipped(rel.TagName) {
 		return "", "", err
 	}
 
@@ -309,10 +321,16 @@ func compareVersions(v1, v2 string) int {
 	return 0
 }
 
-// GetLatestRelease fetches full release info including assets
-func GetLatestRelease() (*Release, error) {
+// GetLatestRelease fetches full release info including assets, of the
+// latest release within prefs.Pin. The release to skip is not consulted:
+// asking for the update overrides it.
+func GetLatestRelease(ctx context.Context, prefs Preferences) (*Release, error) {
 	client := &http.Client{Timeout: 30 * time.Second}
-	req, err := http.NewRequest(http.MethodGet, baseURL+"/releases/latest", nil)
+	return getLatestRelease(ctx, client, baseURL+"/releases", prefs)
+}
+
+func getLatestRelease(ctx context.Context, client *http.Client, listURL string, prefs Preferences) (*Release, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, listURL+"/latest", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -333,7 +351,14 @@ func GetLatestRelease() (*Release, error) {
 		return nil, fmt.Errorf("failed to parse release info: %w", err)
 	}
 
-	return &rel, nil
+	pinned, err := prefs.applyPin(ctx, client, listURL, &rel)
+	if err != nil {
+		return nil, err
+	}
+	if pinned == nil {
+		return nil, fmt.Errorf("no release of major version %s", prefs.Pin)
+	}
+	return pinned, nil
 }
 
 // getAssetName returns the expected asset name for the current platform
@@ -346,19 +371,18 @@ func getAssetName(version string) string {
 
 // FindPlatformAsset finds the appropriate asset for the current OS/arch
 func (r *Release) FindPlatformAsset() *Asset {
-	targetName := getAssetName(r.TagName)
-	for i := range r.Assets {
-		if r.Assets[i].Name == targetName {
-			return &r.Assets[i]
-		}
-	}
-	return nil
+	return r.findAsset(getAssetName(r.TagName))
 }
 
 // FindChecksumAsset finds the checksums file
 func (r *Release) FindChecksumAsset() *Asset {
+	return r.findAsset("checksums.txt")
+}
+
+// findAsset finds the asset called name, or nil.
+func (r *Release) findAsset(name string) *Asset {
 	for i := range r.Assets {
-		if r.Assets[i].Name == "checksums.txt" {
+		if r.Assets[i].Name == name {
 			return &r.Assets[i]
 		}
 	}
@@ -369,9 +393,9 @@ func (r *Release) FindChecksumAsset() *Asset {
 //
 // If expectedSize is > 0, the download is size-verified against the HTTP Content-Length
 // (when present) and the number of bytes written.
-func downloadFile(url, destPath string, expectedSize int64) error {
+func downloadFile(ctx context.Context, url, destPath string, expectedSize int64) error {
 	client := &http.Client{Timeout: 5 * time.Minute}
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return err
 	}
@@ -523,7 +547,9 @@ func GetBackupPath(binaryPath string) string {
 
 // PerformUpdate downloads and installs a new version of bv
 // Returns an UpdateResult with details about the operation
-func PerformUpdate(release *Release, skipConfirm bool) (*UpdateResult, error) {
+// Canceling ctx aborts the download and verification; once installation
+// starts it runs to completion so the binary is never left half-replaced.
+func PerformUpdate(ctx context.Context, release *Release, skipConfirm bool) (*UpdateResult, error) {
 	result := &UpdateResult{
 		OldVersion: version.Version,
 		NewVersion: release.TagName,
@@ -536,6 +562,11 @@ func PerformUpdate(release *Release, skipConfirm bool) (*UpdateResult, error) {
 		return result, nil
 	}
 
+	// A package manager's binary is updated through the package manager
+	if origin := DetectInstallOrigin(); origin.Managed() {
+		return nil, &ManagedInstallError{Origin: origin}
+	}
+
 	// Find platform-specific asset
 	asset := release.FindPlatformAsset()
 	if asset == nil {
@@ -566,52 +597,37 @@ func PerformUpdate(release *Release, skipConfirm bool) (*UpdateResult, error) {
 	}
 	defer os.RemoveAll(tmpDir)
 
-	// Download archive
-	archivePath := filepath.Join(tmpDir, asset.Name)
-	fmt.Printf("Downloading %s...\n", release.TagName)
-	if err := downloadFile(asset.BrowserDownloadURL, archivePath, asset.Size); err != nil {
-		return nil, fmt.Errorf("download failed: %w", err)
+	newBinaryPath := filepath.Join(tmpDir, "bv-new")
+	if runtime.GOOS == "windows" {
+		newBinaryPath += ".exe"
 	}
 
-	// Download and verify checksum
-	checksumAsset := release.FindChecksumAsset()
-	if checksumAsset != nil {
-		checksumPath := filepath.Join(tmpDir, "checksums.txt")
-		if err := downloadFile(checksumAsset.BrowserDownloadURL, checksumPath, checksumAsset.Size); err != nil {
-			return nil, fmt.Errorf("checksum download failed: %w", err)
-		}
-
-		checksums, err := parseChecksums(checksumPath)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse checksums: %w", err)
+	// A patch from this version is much smaller than the archive; any
+	// trouble with it falls back to the archive.
+	patchSize, err := downloadPatched(ctx, release, version.Version, binaryPath, tmpDir, newBinaryPath)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, fmt.Errorf("update canceled: %w", ctxErr)
 		}
-
-		expectedHash, ok := checksums[asset.Name]
-		if !ok {
-			return nil, fmt.Errorf("no checksum found for %s", asset.Name)
+		if !errors.Is(err, errNoPatch) {
+			fmt.Printf("Patch update failed (%v); downloading the full release\n", err)
 		}
-
-		fmt.Println("Verifying checksum...")
-		if err := verifyChecksum(archivePath, expectedHash); err != nil {
-			return nil, fmt.Errorf("checksum verification failed: %w", err)
+		if err := downloadRelease(ctx, release, asset, tmpDir, newBinaryPath); err != nil {
+			return nil, err
 		}
-	}
-
-	// Extract binary to temp location
-	newBinaryPath := filepath.Join(tmpDir, "bv-new")
-	if runtime.GOOS == "windows" {
-		newBinaryPath += ".exe"
-	}
-	fmt.Println("Extracting...")
-	if err := extractBinary(archivePath, newBinaryPath); err != nil {
-		return nil, fmt.Errorf("extraction failed: %w", err)
+	} else {
+		result.PatchSize = patchSize
 	}
 
 	// Verify new binary works
 	fmt.Println("Verifying new binary...")
-	if err := runCommand(newBinaryPath, "--version"); err != nil {
+
+	if err := runCommand(ctx, newBinaryPath, "--version"); err != nil {
 		return nil, fmt.Errorf("new binary verification failed: %w", err)
 	}
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("update canceled: %w", err)
+	}
 
 	// Create backup of current binary
 	backupPath := GetBackupPath(binaryPath)
@@ -642,9 +658,54 @@ func PerformUpdate(release *Release, skipConfirm bool) (*UpdateResult, error) {
 
 	result.Success = true
 	result.Message = fmt.Sprintf("Successfully updated from %s to %s", version.Version, release.TagName)
+	if result.PatchSize > 0 {
+		result.Message += fmt.Sprintf(" (patch, %s)", formatSize(result.PatchSize))
+	}
 	return result, nil
 }
 
+// downloadRelease downloads the archive asset of release, verifies it
+// against checksums.txt when the release has one, and extracts the binary
+// to newBinaryPath.
+func downloadRelease(ctx context.Context, release *Release, asset *Asset, tmpDir, newBinaryPath string) error {
+	archivePath := filepath.Join(tmpDir, asset.Name)
+	fmt.Printf("Downloading %s...\n", release.TagName)
+	if err := downloadFile(ctx, asset.BrowserDownloadURL, archivePath, asset.Size); err != nil {
+		return fmt.Errorf("download failed: %w", err)
+	}
+
+	// Download and verify checksum
+	checksumAsset := release.FindChecksumAsset()
+	if checksumAsset != nil {
+		checksumPath := filepath.Join(tmpDir, "checksums.txt")
+		if err := downloadFile(ctx, checksumAsset.BrowserDownloadURL, checksumPath, checksumAsset.Size); err != nil {
+			return fmt.Errorf("checksum download failed: %w", err)
+		}
+
+		checksums, err := parseChecksums(checksumPath)
+		if err != nil {
+			return fmt.Errorf("failed to parse checksums: %w", err)
+		}
+
+		expectedHash, ok := checksums[asset.Name]
+		if !ok {
+			return fmt.Errorf("no checksum found for %s", asset.Name)
+		}
+
+		fmt.Println("Verifying checksum...")
+		if err := verifyChecksum(archivePath, expectedHash); err != nil {
+			return fmt.Errorf("checksum verification failed: %w", err)
+		}
+	}
+
+	// Extract binary to temp location
+	fmt.Println("Extracting...")
+	if err := extractBinary(archivePath, newBinaryPath); err != nil {
+		return fmt.Errorf("extraction failed: %w", err)
+	}
+	return nil
+}
+
 // copyFile copies a file from src to dst
 func copyFile(src, dst string) error {
 	sourceFile, err := os.Open(src)
@@ -669,8 +730,8 @@ func copyFile(src, dst string) error {
 }
 
 // runCommand executes a command and returns any error
-func runCommand(name string, args ...string) error {
-	cmd := osExec.Command(name, args...)
+func runCommand(ctx context.Context, name string, args ...string) error {
+	cmd := osExec.CommandContext(ctx, name, args...)
 	return cmd.Run()
 }
 
@@ -696,8 +757,8 @@ func Rollback() error {
 }
 
 // CheckUpdateAvailable is a convenience wrapper that checks and returns update info
-func CheckUpdateAvailable() (available bool, newVersion string, releaseURL string, err error) {
-	newVersion, releaseURL, err = CheckForUpdates()
+func CheckUpdateAvailable(ctx context.Context, prefs Preferences) (available bool, newVersion string, releaseURL string, err error) {
+	newVersion, releaseURL, err = CheckForUpdates(ctx, prefs)
 	if err != nil {
 		return false, "", "", err
 	}
@@ -3,6 +3,7 @@ package watcher
 import (
 	"context"
 	"errors"
+	"math/rand/v2"
 	"os"
 	"path/filepath"
 	"strings"
@@ -39,6 +40,14 @@ func WithPollInterval(d time.Duration) WatcherOption {
 	}
 }
 
+// WithPollJitter randomizes each polling wait by up to ±d, so that many
+// clients polling a shared filesystem spread out their stat calls.
+func WithPollJitter(d time.Duration) WatcherOption {
+	return func(w *Watcher) {
+		w.pollJitter = d
+	}
+}
+
 // WithOnChange sets the callback invoked when the file changes.
 func WithOnChange(fn func()) WatcherOption {
 	return func(w *Watcher) {
@@ -65,6 +74,7 @@ type Watcher struct {
 	path             string
 	debounceDuration time.Duration
 	pollInterval     time.Duration
+	pollJitter       time.Duration
 	onChange         func()
 	onError          func(error)
 	forcePoll        bool
@@ -77,6 +87,11 @@ type Watcher struct {
 	lastMtime   time.Time
 	lastSize    int64
 
+	// While paused, changes are remembered rather than reported, and
+	// polling stops checking the file.
+	paused        bool
+	missedChanges bool
+
 	ctx      context.Context
 	cancel   context.CancelFunc
 	started  bool
@@ -249,6 +264,54 @@ func (w *Watcher) PollInterval() time.Duration {
 	return w.pollInterval
 }
 
+// PollJitter returns the random variation applied to each polling wait.
+func (w *Watcher) PollJitter() time.Duration {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.pollJitter
+}
+
+// Pause stops reporting changes until Resume. Pausing survives Stop and
+// Start.
+func (w *Watcher) Pause() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.paused = true
+}
+
+// Resume reports changes again, starting with one for any change that
+// happened while paused.
+func (w *Watcher) Resume() {
+	w.mu.Lock()
+	missed := w.paused && w.missedChanges
+	w.paused = false
+	w.missedChanges = false
+	w.mu.Unlock()
+	if missed {
+		w.notifyChange()
+	}
+}
+
+// IsPaused returns true if the watcher is paused.
+func (w *Watcher) IsPaused() bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.paused
+}
+
+// nextPollDelay returns the polling interval with jitter applied, never
+// less than half the interval.
+func (w *Watcher) nextPollDelay() time.Duration {
+	w.mu.RLock()
+	interval, jitter := w.pollInterval, w.pollJitter
+	w.mu.RUnlock()
+	if jitter <= 0 {
+		return interval
+	}
+	d := interval + time.Duration(rand.Int64N(int64(2*jitter)+1)) - jitter
+	return max(d, interval/2)
+}
+
 func envBool(name string) bool {
 	v := strings.TrimSpace(os.Getenv(name))
 	if v == "" {
@@ -286,8 +349,15 @@ func (w *Watcher) watchFsnotify() {
 				return
 			}
 
-			// Only care about events for our specific file
+			// Only care about events for our specific file. A SQLite
+			// database takes its writes in the write-ahead log first.
 			eventFile := filepath.Base(event.Name)
+			if eventFile == targetFile+"-wal" {
+				if event.Op&(fsnotify.Write|fsnotify.Create) != 0 {
+					w.debouncer.Trigger(w.notifyChange)
+				}
+				continue
+			}
 			if eventFile != targetFile {
 				continue
 			}
@@ -311,15 +381,21 @@ func (w *Watcher) watchFsnotify() {
 
 // watchPolling monitors using periodic stat checks.
 func (w *Watcher) watchPolling() {
-	ticker := time.NewTicker(w.pollInterval)
-	defer ticker.Stop()
+	timer := time.NewTimer(w.nextPollDelay())
+	defer timer.Stop()
 
 	for {
 		select {
 		case <-w.ctx.Done():
 			return
 
-		case <-ticker.C:
+		case <-timer.C:
+			timer.Reset(w.nextPollDelay())
+			// A change while paused is picked up by the first check after
+			// Resume, since lastMtime and lastSize are not updated.
+			if w.IsPaused() {
+				continue
+			}
 			info, err := os.Stat(w.path)
 			if err != nil {
 				if os.IsNotExist(err) {
@@ -355,14 +431,18 @@ func (w *Watcher) watchPolling() {
 
 // notifyChange invokes the onChange callback and signals the change channel.
 func (w *Watcher) notifyChange() {
-	w.mu.RLock()
+	w.mu.Lock()
 	started := w.started
-	w.mu.RUnlock()
+	if w.paused {
+		w.missedChanges = true
+	}
+	paused := w.paused
+	w.mu.Unlock()
 
 	// Don't notify if watcher has been stopped - avoid calling callbacks
 	// after Stop() has been called. This is best-effort; there's a small
 	// race window, but callbacks are idempotent so it's harmless.
-	if !started {
+	if !started || paused {
 		return
 	}
 
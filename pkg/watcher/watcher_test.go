@@ -107,6 +107,40 @@ func TestWatcher_DetectsFileChange(t *testing.T) {
 	}
 }
 
+func TestWatcher_DetectsSQLiteWALWrite(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbFile := filepath.Join(tmpDir, "beads.db")
+	if err := os.WriteFile(dbFile, []byte("db"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var changes atomic.Int32
+	w, err := NewWatcher(dbFile,
+		WithDebounceDuration(50*time.Millisecond),
+		WithOnChange(func() { changes.Add(1) }),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer w.Stop()
+	if w.IsPolling() {
+		t.Skip("the write-ahead log is only watched through fsnotify")
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	if err := os.WriteFile(dbFile+"-wal", []byte("frame"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(300 * time.Millisecond)
+
+	if changes.Load() == 0 {
+		t.Error("expected a write to the write-ahead log to count as a change")
+	}
+}
+
 func TestWatcher_PollingFallback(t *testing.T) {
 	tmpDir := t.TempDir()
 	tmpFile := filepath.Join(tmpDir, "test.jsonl")
@@ -367,3 +401,75 @@ func TestWatcher_Path(t *testing.T) {
 		t.Errorf("expected path %s, got %s", absPath, w.Path())
 	}
 }
+
+func TestWatcher_PollJitter(t *testing.T) {
+	w, err := NewWatcher("test.jsonl",
+		WithPollInterval(100*time.Millisecond),
+		WithPollJitter(40*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seen := make(map[time.Duration]bool)
+	for i := 0; i < 200; i++ {
+		d := w.nextPollDelay()
+		if d < 60*time.Millisecond || d > 140*time.Millisecond {
+			t.Fatalf("delay %v outside 100ms±40ms", d)
+		}
+		seen[d] = true
+	}
+	if len(seen) < 2 {
+		t.Error("expected jitter to vary the delay")
+	}
+
+	// Jitter larger than the interval still waits at least half of it.
+	w, _ = NewWatcher("test.jsonl", WithPollInterval(100*time.Millisecond), WithPollJitter(time.Second))
+	for i := 0; i < 200; i++ {
+		if d := w.nextPollDelay(); d < 50*time.Millisecond {
+			t.Fatalf("delay %v below half the interval", d)
+		}
+	}
+}
+
+func TestWatcher_PauseResume(t *testing.T) {
+	for _, poll := range []bool{true, false} {
+		tmpDir := t.TempDir()
+		tmpFile := filepath.Join(tmpDir, "test.jsonl")
+		if err := os.WriteFile(tmpFile, []byte("initial"), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		w, err := NewWatcher(tmpFile,
+			WithDebounceDuration(10*time.Millisecond),
+			WithPollInterval(25*time.Millisecond),
+			WithForcePoll(poll),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Start(); err != nil {
+			t.Fatal(err)
+		}
+
+		w.Pause()
+		if !w.IsPaused() {
+			t.Fatal("expected watcher to be paused")
+		}
+		if err := os.WriteFile(tmpFile, []byte("changed while paused"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case <-w.Changed():
+			t.Fatalf("polling=%v: change reported while paused", poll)
+		case <-time.After(200 * time.Millisecond):
+		}
+
+		w.Resume()
+		select {
+		case <-w.Changed():
+		case <-time.After(time.Second):
+			t.Errorf("polling=%v: expected the change made while paused after Resume", poll)
+		}
+		w.Stop()
+	}
+}
@@ -0,0 +1,1016 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/beadsview"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// GraphQL
+//
+// /api/graphql answers GraphQL queries over the issues, so a dashboard can
+// fetch exactly the fields it shows and follow dependencies in one request
+// instead of walking /api/issues/{id}. bv only needs a small part of the
+// language, so it is implemented here rather than pulled in: one query
+// operation with fields, aliases, arguments and variables. Mutations,
+// subscriptions, fragments, directives and introspection are not
+// supported; GET /api/graphql/schema prints the schema instead. Nesting is
+// limited to graphQLMaxDepth levels, since dependencies and dependents can
+// be followed around a cycle forever, and aliases let a short query ask for
+// the same lists many times over at each level, so the fields a query
+// selects and the objects it resolves are capped as well.
+
+const (
+	// graphQLMaxDepth bounds how deeply selections may nest.
+	graphQLMaxDepth = 8
+	// graphQLMaxFields bounds the fields a query selects, each alias
+	// counted on its own.
+	graphQLMaxFields = 500
+	// graphQLMaxObjects bounds the issues and comments a query resolves.
+	graphQLMaxObjects = 10000
+)
+
+// gqlFieldDef is a field of a schema type.
+type gqlFieldDef struct {
+	name string
+	typ  string // A type of gqlTypes, or a scalar: String, Int, ID, Time
+	list bool
+	args []gqlArgDef
+	doc  string
+}
+
+type gqlArgDef struct {
+	name string
+	typ  string // String, Int or ID
+	doc  string
+}
+
+// gqlTypes is the schema, in the order the SDL lists it.
+var gqlTypes = []struct {
+	name   string
+	fields []gqlFieldDef
+}{
+	{"Query", []gqlFieldDef{
+		{name: "issues", typ: "Issue", list: true, doc: "Issues matching filter, in the order of sort, as in the bv list", args: []gqlArgDef{
+			{"filter", "String", `Filter terms, e.g. "open label:api"; default "all"`},
+			{"sort", "String", `Sort key, e.g. "priority"; default "default"`},
+			{"limit", "Int", "Return at most this many issues"},
+		}},
+		{name: "issue", typ: "Issue", doc: "The issue with this ID, or null", args: []gqlArgDef{{"id", "ID", ""}}},
+	}},
+	{"Issue", []gqlFieldDef{
+		{name: "id", typ: "ID"},
+		{name: "title", typ: "String"},
+		{name: "description", typ: "String"},
+		{name: "design", typ: "String"},
+		{name: "acceptanceCriteria", typ: "String"},
+		{name: "notes", typ: "String"},
+		{name: "status", typ: "String"},
+		{name: "priority", typ: "Int"},
+		{name: "issueType", typ: "String"},
+		{name: "assignee", typ: "String"},
+		{name: "labels", typ: "String", list: true},
+		{name: "estimatedMinutes", typ: "Int"},
+		{name: "createdAt", typ: "Time"},
+		{name: "updatedAt", typ: "Time"},
+		{name: "startDate", typ: "Time"},
+		{name: "dueDate", typ: "Time"},
+		{name: "closedAt", typ: "Time"},
+		{name: "externalRef", typ: "String"},
+		{name: "comments", typ: "Comment", list: true, args: []gqlArgDef{
+			{"limit", "Int", "Return at most this many comments"},
+		}},
+		{name: "dependencies", typ: "Issue", list: true, doc: "Issues this one depends on", args: []gqlArgDef{
+			{"type", "String", `Only dependencies of this type, e.g. "blocks"`},
+			{"limit", "Int", "Return at most this many issues"},
+		}},
+		{name: "dependents", typ: "Issue", list: true, doc: "Issues that depend on this one", args: []gqlArgDef{
+			{"type", "String", `Only dependencies of this type, e.g. "blocks"`},
+			{"limit", "Int", "Return at most this many issues"},
+		}},
+	}},
+	{"Comment", []gqlFieldDef{
+		{name: "author", typ: "String"},
+		{name: "text", typ: "String"},
+		{name: "createdAt", typ: "Time"},
+	}},
+}
+
+// gqlFields looks up the fields of each schema type by name.
+var gqlFields = func() map[string]map[string]*gqlFieldDef {
+	byType := make(map[string]map[string]*gqlFieldDef, len(gqlTypes))
+	for _, t := range gqlTypes {
+		fields := make(map[string]*gqlFieldDef, len(t.fields))
+		for i := range t.fields {
+			fields[t.fields[i].name] = &t.fields[i]
+		}
+		byType[t.name] = fields
+	}
+	return byType
+}()
+
+// GraphQLSchema returns the schema served at /api/graphql in SDL.
+func GraphQLSchema() string {
+	var sb strings.Builder
+	sb.WriteString("# Times are RFC 3339 strings.\nscalar Time\n")
+	for _, t := range gqlTypes {
+		fmt.Fprintf(&sb, "\ntype %s {\n", t.name)
+		for _, f := range t.fields {
+			if f.doc != "" {
+				fmt.Fprintf(&sb, "  # %s\n", f.doc)
+			}
+			sb.WriteString("  " + f.name)
+			if len(f.args) > 0 {
+				args := make([]string, len(f.args))
+				for i, a := range f.args {
+					args[i] = a.name + ": " + a.typ
+				}
+				sb.WriteString("(" + strings.Join(args, ", ") + ")")
+			}
+			typ := f.typ
+			if f.list {
+				typ = "[" + typ + "!]!"
+			}
+			sb.WriteString(": " + typ + "\n")
+		}
+		sb.WriteString("}\n")
+	}
+	return sb.String()
+}
+
+// GraphQLRequest is the body of POST /api/graphql.
+type GraphQLRequest struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// GraphQLResponse is the body of every /api/graphql answer. Data is absent
+// when the query was rejected before running.
+type GraphQLResponse struct {
+	Data   any            `json:"data,omitempty"`
+	Errors []GraphQLError `json:"errors,omitempty"`
+}
+
+// GraphQLError is one problem with a query.
+type GraphQLError struct {
+	Message string `json:"message"`
+}
+
+func (s *Server) handleGraphQL(w http.ResponseWriter, r *http.Request) {
+	var req GraphQLRequest
+	if r.Method == http.MethodPost {
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(&req); err != nil {
+			writeGraphQLError(w, fmt.Errorf("reading request: %w", err))
+			return
+		}
+	} else {
+		req.Query = r.URL.Query().Get("query")
+		if vars := r.URL.Query().Get("variables"); vars != "" {
+			if err := json.Unmarshal([]byte(vars), &req.Variables); err != nil {
+				writeGraphQLError(w, fmt.Errorf("parsing variables: %w", err))
+				return
+			}
+		}
+	}
+	snap, err := s.current()
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, GraphQLResponse{Errors: []GraphQLError{{Message: err.Error()}}})
+		return
+	}
+	data, err := executeGraphQL(snap, req)
+	if err != nil {
+		writeGraphQLError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, GraphQLResponse{Data: data})
+}
+
+func (s *Server) handleGraphQLSchema(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = w.Write([]byte(GraphQLSchema()))
+}
+
+func writeGraphQLError(w http.ResponseWriter, err error) {
+	writeJSON(w, http.StatusBadRequest, GraphQLResponse{Errors: []GraphQLError{{Message: err.Error()}}})
+}
+
+// executeGraphQL parses, checks and runs req against snap.
+func executeGraphQL(snap *snapshot, req GraphQLRequest) (gqlObject, error) {
+	op, err := parseGraphQL(req.Query)
+	if err != nil {
+		return nil, err
+	}
+	if req.OperationName != "" && op.name != req.OperationName {
+		return nil, fmt.Errorf("unknown operation %q", req.OperationName)
+	}
+	vars, err := op.bindVariables(req.Variables)
+	if err != nil {
+		return nil, err
+	}
+	selected := 0
+	if err := op.checkSelection("Query", op.selection, 1, &selected); err != nil {
+		return nil, err
+	}
+	return (&gqlExecutor{snap: snap, vars: vars}).query(op.selection)
+}
+
+type gqlOperation struct {
+	name      string
+	varDefs   []gqlVarDef
+	selection []gqlField
+}
+
+type gqlVarDef struct {
+	name     string
+	typ      string // Named type, without list or non-null markers
+	required bool
+	def      any // Default value; nil if none
+}
+
+type gqlField struct {
+	alias     string // Key in the result; the name unless aliased
+	name      string
+	args      map[string]gqlValue
+	selection []gqlField
+}
+
+// gqlValue is an argument value: a literal or a variable reference.
+type gqlValue struct {
+	variable string
+	literal  any // string, int, bool, nil or []any
+}
+
+type gqlTokenKind int
+
+const (
+	gqlEOF gqlTokenKind = iota
+	gqlPunct
+	gqlName
+	gqlInt
+	gqlString
+)
+
+type gqlToken struct {
+	kind gqlTokenKind
+	text string
+}
+
+type gqlParser struct {
+	src string
+	pos int
+	tok gqlToken
+}
+
+func parseGraphQL(src string) (*gqlOperation, error) {
+	if strings.TrimSpace(src) == "" {
+		return nil, errors.New("expected a query")
+	}
+	p := &gqlParser{src: src}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	op := &gqlOperation{}
+	if p.tok.kind == gqlName {
+		switch p.tok.text {
+		case "query":
+		case "mutation", "subscription":
+			return nil, fmt.Errorf("%ss are not supported: bv serve is read-only", p.tok.text)
+		case "fragment":
+			return nil, errors.New("fragments are not supported")
+		default:
+			return nil, fmt.Errorf("unexpected %q", p.tok.text)
+		}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		if p.tok.kind == gqlName {
+			op.name = p.tok.text
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+		}
+		if p.isPunct("(") {
+			defs, err := p.parseVarDefs()
+			if err != nil {
+				return nil, err
+			}
+			op.varDefs = defs
+		}
+	}
+	sel, err := p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+	op.selection = sel
+	if p.tok.kind != gqlEOF {
+		return nil, errors.New("only one operation per request is supported")
+	}
+	return op, nil
+}
+
+func (p *gqlParser) isPunct(s string) bool {
+	return p.tok.kind == gqlPunct && p.tok.text == s
+}
+
+func (p *gqlParser) expectPunct(s string) error {
+	if !p.isPunct(s) {
+		return p.unexpected("expected " + strconv.Quote(s))
+	}
+	return p.advance()
+}
+
+func (p *gqlParser) expectName() (string, error) {
+	if p.tok.kind != gqlName {
+		return "", p.unexpected("expected a name")
+	}
+	name := p.tok.text
+	return name, p.advance()
+}
+
+func (p *gqlParser) unexpected(want string) error {
+	if p.tok.kind == gqlEOF {
+		return fmt.Errorf("%s, got the end of the query", want)
+	}
+	return fmt.Errorf("%s, got %q", want, p.tok.text)
+}
+
+func (p *gqlParser) parseVarDefs() ([]gqlVarDef, error) {
+	if err := p.expectPunct("("); err != nil {
+		return nil, err
+	}
+	var defs []gqlVarDef
+	for !p.isPunct(")") {
+		if err := p.expectPunct("$"); err != nil {
+			return nil, err
+		}
+		var d gqlVarDef
+		var err error
+		if d.name, err = p.expectName(); err != nil {
+			return nil, err
+		}
+		if err := p.expectPunct(":"); err != nil {
+			return nil, err
+		}
+		if d.typ, d.required, err = p.parseType(); err != nil {
+			return nil, err
+		}
+		if p.isPunct("=") {
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			v, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			if v.variable != "" {
+				return nil, fmt.Errorf("default of $%s must be a constant", d.name)
+			}
+			d.def = v.literal
+		}
+		defs = append(defs, d)
+	}
+	return defs, p.advance()
+}
+
+// parseType reads a type reference such as String!, returning the named
+// type and whether the outer type is non-null.
+func (p *gqlParser) parseType() (string, bool, error) {
+	var name string
+	var err error
+	if p.isPunct("[") {
+		if err := p.advance(); err != nil {
+			return "", false, err
+		}
+		if name, _, err = p.parseType(); err != nil {
+			return "", false, err
+		}
+		if err := p.expectPunct("]"); err != nil {
+			return "", false, err
+		}
+	} else if name, err = p.expectName(); err != nil {
+		return "", false, err
+	}
+	if p.isPunct("!") {
+		return name, true, p.advance()
+	}
+	return name, false, nil
+}
+
+func (p *gqlParser) parseSelectionSet() ([]gqlField, error) {
+	if err := p.expectPunct("{"); err != nil {
+		return nil, err
+	}
+	var fields []gqlField
+	for !p.isPunct("}") {
+		if p.isPunct("...") {
+			return nil, errors.New("fragments are not supported")
+		}
+		if p.isPunct("@") {
+			return nil, errors.New("directives are not supported")
+		}
+		f, err := p.parseField()
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, f)
+	}
+	if len(fields) == 0 {
+		return nil, errors.New("empty selection")
+	}
+	return fields, p.advance()
+}
+
+func (p *gqlParser) parseField() (gqlField, error) {
+	name, err := p.expectName()
+	if err != nil {
+		return gqlField{}, err
+	}
+	f := gqlField{alias: name, name: name}
+	if p.isPunct(":") {
+		if err := p.advance(); err != nil {
+			return f, err
+		}
+		if f.name, err = p.expectName(); err != nil {
+			return f, err
+		}
+	}
+	if p.isPunct("(") {
+		if err := p.advance(); err != nil {
+			return f, err
+		}
+		f.args = make(map[string]gqlValue)
+		for !p.isPunct(")") {
+			arg, err := p.expectName()
+			if err != nil {
+				return f, err
+			}
+			if err := p.expectPunct(":"); err != nil {
+				return f, err
+			}
+			if f.args[arg], err = p.parseValue(); err != nil {
+				return f, err
+			}
+		}
+		if err := p.advance(); err != nil {
+			return f, err
+		}
+	}
+	if p.isPunct("{") {
+		if f.selection, err = p.parseSelectionSet(); err != nil {
+			return f, err
+		}
+	}
+	return f, nil
+}
+
+func (p *gqlParser) parseValue() (gqlValue, error) {
+	tok := p.tok
+	switch {
+	case tok.kind == gqlPunct && tok.text == "$":
+		if err := p.advance(); err != nil {
+			return gqlValue{}, err
+		}
+		name, err := p.expectName()
+		return gqlValue{variable: name}, err
+	case tok.kind == gqlInt:
+		n, err := strconv.Atoi(tok.text)
+		if err != nil {
+			return gqlValue{}, fmt.Errorf("integer %s out of range", tok.text)
+		}
+		return gqlValue{literal: n}, p.advance()
+	case tok.kind == gqlString:
+		return gqlValue{literal: tok.text}, p.advance()
+	case tok.kind == gqlName:
+		var v any
+		switch tok.text {
+		case "true":
+			v = true
+		case "false":
+			v = false
+		case "null":
+		default:
+			v = tok.text // Enum values read as strings
+		}
+		return gqlValue{literal: v}, p.advance()
+	case tok.kind == gqlPunct && tok.text == "[":
+		if err := p.advance(); err != nil {
+			return gqlValue{}, err
+		}
+		list := []any{}
+		for !p.isPunct("]") {
+			v, err := p.parseValue()
+			if err != nil {
+				return gqlValue{}, err
+			}
+			if v.variable != "" {
+				return gqlValue{}, errors.New("variables inside lists are not supported")
+			}
+			list = append(list, v.literal)
+		}
+		return gqlValue{literal: list}, p.advance()
+	}
+	return gqlValue{}, p.unexpected("expected a value")
+}
+
+// advance reads the next token. Commas, whitespace and comments are
+// ignored, as the language specifies.
+func (p *gqlParser) advance() error {
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		if c == '#' {
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' {
+				p.pos++
+			}
+			continue
+		}
+		if c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',' {
+			p.pos++
+			continue
+		}
+		break
+	}
+	if p.pos >= len(p.src) {
+		p.tok = gqlToken{kind: gqlEOF}
+		return nil
+	}
+	start := p.pos
+	c := p.src[p.pos]
+	switch {
+	case strings.HasPrefix(p.src[p.pos:], "..."):
+		p.pos += 3
+		p.tok = gqlToken{kind: gqlPunct, text: "..."}
+	case strings.ContainsRune("{}():$!=[]@", rune(c)):
+		p.pos++
+		p.tok = gqlToken{kind: gqlPunct, text: string(c)}
+	case c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z':
+		for p.pos < len(p.src) && isGraphQLNameChar(p.src[p.pos]) {
+			p.pos++
+		}
+		p.tok = gqlToken{kind: gqlName, text: p.src[start:p.pos]}
+	case c == '-' || '0' <= c && c <= '9':
+		p.pos++
+		for p.pos < len(p.src) && '0' <= p.src[p.pos] && p.src[p.pos] <= '9' {
+			p.pos++
+		}
+		if p.pos < len(p.src) && (p.src[p.pos] == '.' || p.src[p.pos] == 'e' || p.src[p.pos] == 'E') {
+			return errors.New("floats are not supported")
+		}
+		p.tok = gqlToken{kind: gqlInt, text: p.src[start:p.pos]}
+	case c == '"':
+		if strings.HasPrefix(p.src[p.pos:], `"""`) {
+			return errors.New("block strings are not supported")
+		}
+		p.pos++
+		for p.pos < len(p.src) && p.src[p.pos] != '"' && p.src[p.pos] != '\n' {
+			if p.src[p.pos] == '\\' {
+				p.pos++
+			}
+			p.pos++
+		}
+		if p.pos >= len(p.src) || p.src[p.pos] != '"' {
+			return errors.New("unterminated string")
+		}
+		p.pos++
+		// GraphQL string escapes are a subset of JSON's.
+		var s string
+		if err := json.Unmarshal([]byte(p.src[start:p.pos]), &s); err != nil {
+			return fmt.Errorf("bad string %s", p.src[start:p.pos])
+		}
+		p.tok = gqlToken{kind: gqlString, text: s}
+	default:
+		return fmt.Errorf("unexpected character %q", c)
+	}
+	return nil
+}
+
+func isGraphQLNameChar(c byte) bool {
+	return c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'
+}
+
+// bindVariables checks the request's variables against the operation's
+// definitions and fills in defaults.
+func (op *gqlOperation) bindVariables(given map[string]any) (map[string]any, error) {
+	vars := make(map[string]any, len(op.varDefs))
+	for _, d := range op.varDefs {
+		v, ok := given[d.name]
+		if !ok || v == nil {
+			v = d.def
+		}
+		if v == nil {
+			if d.required {
+				return nil, fmt.Errorf("variable $%s is required", d.name)
+			}
+			continue
+		}
+		coerced, err := coerceGraphQLInput(v, d.typ)
+		if err != nil {
+			return nil, fmt.Errorf("variable $%s: %w", d.name, err)
+		}
+		vars[d.name] = coerced
+	}
+	for name := range given {
+		if op.varDef(name) == nil {
+			return nil, fmt.Errorf("variable $%s is not defined by the operation", name)
+		}
+	}
+	return vars, nil
+}
+
+func (op *gqlOperation) varDef(name string) *gqlVarDef {
+	for i := range op.varDefs {
+		if op.varDefs[i].name == name {
+			return &op.varDefs[i]
+		}
+	}
+	return nil
+}
+
+// gqlInputKind groups the input types that accept the same values: an ID
+// is written as a string.
+func gqlInputKind(typ string) string {
+	if typ == "ID" {
+		return "String"
+	}
+	return typ
+}
+
+// coerceGraphQLInput converts a JSON or literal value to an input scalar.
+// JSON numbers arrive as float64.
+func coerceGraphQLInput(v any, typ string) (any, error) {
+	switch typ {
+	case "String", "ID":
+		if s, ok := v.(string); ok {
+			return s, nil
+		}
+		if n, ok := v.(int); ok && typ == "ID" {
+			return strconv.Itoa(n), nil
+		}
+	case "Int":
+		switch n := v.(type) {
+		case int:
+			return n, nil
+		case float64:
+			if n == math.Trunc(n) && math.Abs(n) <= math.MaxInt32 {
+				return int(n), nil
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown input type %s", typ)
+	}
+	return nil, fmt.Errorf("expected %s, got %v", typ, v)
+}
+
+// checkSelection validates fields against the schema type typ before
+// anything runs, so a bad field nested under an empty list still fails.
+// selected counts the fields checked so far.
+func (op *gqlOperation) checkSelection(typ string, fields []gqlField, depth int, selected *int) error {
+	if depth > graphQLMaxDepth {
+		return fmt.Errorf("query nests deeper than %d levels", graphQLMaxDepth)
+	}
+	for _, f := range fields {
+		if *selected++; *selected > graphQLMaxFields {
+			return fmt.Errorf("query selects more than %d fields", graphQLMaxFields)
+		}
+		if f.name == "__typename" {
+			if f.selection != nil || len(f.args) > 0 {
+				return errors.New("__typename takes no arguments or selection")
+			}
+			continue
+		}
+		def, ok := gqlFields[typ][f.name]
+		if !ok {
+			return fmt.Errorf("cannot query field %q on type %s", f.name, typ)
+		}
+		for name, v := range f.args {
+			arg := def.arg(name)
+			if arg == nil {
+				return fmt.Errorf("unknown argument %q on field %s.%s", name, typ, f.name)
+			}
+			if v.variable != "" {
+				d := op.varDef(v.variable)
+				if d == nil {
+					return fmt.Errorf("variable $%s is not defined", v.variable)
+				}
+				if gqlInputKind(d.typ) != gqlInputKind(arg.typ) {
+					return fmt.Errorf("variable $%s is a %s, but %s.%s(%s) takes a %s", v.variable, d.typ, typ, f.name, name, arg.typ)
+				}
+				continue
+			}
+			if v.literal != nil {
+				if _, err := coerceGraphQLInput(v.literal, arg.typ); err != nil {
+					return fmt.Errorf("argument %q of %s.%s: %w", name, typ, f.name, err)
+				}
+			}
+		}
+		_, object := gqlFields[def.typ]
+		switch {
+		case object && f.selection == nil:
+			return fmt.Errorf("field %s.%s needs a selection of %s fields", typ, f.name, def.typ)
+		case !object && f.selection != nil:
+			return fmt.Errorf("field %s.%s is a %s and has no fields", typ, f.name, def.typ)
+		case object:
+			if err := op.checkSelection(def.typ, f.selection, depth+1, selected); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (f *gqlFieldDef) arg(name string) *gqlArgDef {
+	for i := range f.args {
+		if f.args[i].name == name {
+			return &f.args[i]
+		}
+	}
+	return nil
+}
+
+// gqlObject is a result object. It keeps the fields in the order the
+// query asked for them, as GraphQL requires and maps would not.
+type gqlObject []gqlEntry
+
+type gqlEntry struct {
+	key   string
+	value any
+}
+
+// MarshalJSON writes the fields in order.
+func (o gqlObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, e := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(e.key)
+		buf.Write(key)
+		buf.WriteByte(':')
+		value, err := json.Marshal(e.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+type gqlExecutor struct {
+	snap    *snapshot
+	vars    map[string]any
+	objects int // Issues and comments resolved so far
+}
+
+// resolve counts one more object against graphQLMaxObjects.
+func (e *gqlExecutor) resolve() error {
+	if e.objects++; e.objects > graphQLMaxObjects {
+		return fmt.Errorf("query resolves more than %d objects; select fewer fields or pass a limit", graphQLMaxObjects)
+	}
+	return nil
+}
+
+// argument returns the value of a field argument, or nil when it is absent.
+func (e *gqlExecutor) argument(f gqlField, name string) any {
+	v, ok := f.args[name]
+	if !ok {
+		return nil
+	}
+	if v.variable != "" {
+		return e.vars[v.variable]
+	}
+	return v.literal
+}
+
+func (e *gqlExecutor) stringArg(f gqlField, name, def string) string {
+	if v, ok := e.argument(f, name).(string); ok {
+		return v
+	}
+	return def
+}
+
+// limitArg returns the limit argument of f, or n when there is none.
+func (e *gqlExecutor) limitArg(f gqlField, n int) (int, error) {
+	limit, ok := e.argument(f, "limit").(int)
+	if !ok {
+		return n, nil
+	}
+	if limit < 0 {
+		return 0, fmt.Errorf("limit must not be negative, got %d", limit)
+	}
+	return min(limit, n), nil
+}
+
+func (e *gqlExecutor) query(fields []gqlField) (gqlObject, error) {
+	out := make(gqlObject, 0, len(fields))
+	for _, f := range fields {
+		var value any
+		switch f.name {
+		case "__typename":
+			value = "Query"
+		case "issues":
+			filter := strings.TrimSpace(e.stringArg(f, "filter", "all"))
+			if err := beadsview.ValidateFilter(filter); err != nil {
+				return nil, err
+			}
+			mode, err := beadsview.ParseSortMode(e.stringArg(f, "sort", "default"))
+			if err != nil {
+				return nil, err
+			}
+			issues := beadsview.FilterIssues(e.snap.issues, filter, mode)
+			matched := make([]*model.Issue, len(issues))
+			for i := range issues {
+				matched[i] = e.snap.byID[issues[i].ID]
+			}
+			list, err := e.issueList(f, matched)
+			if err != nil {
+				return nil, err
+			}
+			value = list
+		case "issue":
+			if issue, ok := e.snap.byID[e.stringArg(f, "id", "")]; ok {
+				obj, err := e.issue(issue, f.selection)
+				if err != nil {
+					return nil, err
+				}
+				value = obj
+			}
+		}
+		out = append(out, gqlEntry{f.alias, value})
+	}
+	return out, nil
+}
+
+func (e *gqlExecutor) issue(issue *model.Issue, fields []gqlField) (gqlObject, error) {
+	if err := e.resolve(); err != nil {
+		return nil, err
+	}
+	out := make(gqlObject, 0, len(fields))
+	for _, f := range fields {
+		var value any
+		switch f.name {
+		case "__typename":
+			value = "Issue"
+		case "id":
+			value = issue.ID
+		case "title":
+			value = issue.Title
+		case "description":
+			value = issue.Description
+		case "design":
+			value = issue.Design
+		case "acceptanceCriteria":
+			value = issue.AcceptanceCriteria
+		case "notes":
+			value = issue.Notes
+		case "status":
+			value = string(issue.Status)
+		case "priority":
+			value = issue.Priority
+		case "issueType":
+			value = string(issue.IssueType)
+		case "assignee":
+			value = issue.Assignee
+		case "labels":
+			labels := issue.Labels
+			if labels == nil {
+				labels = []string{}
+			}
+			value = labels
+		case "estimatedMinutes":
+			if issue.EstimatedMinutes != nil {
+				value = *issue.EstimatedMinutes
+			}
+		case "createdAt":
+			value = gqlTime(&issue.CreatedAt)
+		case "updatedAt":
+			value = gqlTime(&issue.UpdatedAt)
+		case "startDate":
+			value = gqlTime(issue.StartDate)
+		case "dueDate":
+			value = gqlTime(issue.DueDate)
+		case "closedAt":
+			value = gqlTime(issue.ClosedAt)
+		case "externalRef":
+			if issue.ExternalRef != nil {
+				value = *issue.ExternalRef
+			}
+		case "comments":
+			var comments []*model.Comment
+			for _, c := range issue.Comments {
+				if c != nil {
+					comments = append(comments, c)
+				}
+			}
+			n, err := e.limitArg(f, len(comments))
+			if err != nil {
+				return nil, err
+			}
+			list := make([]any, n)
+			for i, c := range comments[:n] {
+				if list[i], err = e.comment(c, f.selection); err != nil {
+					return nil, err
+				}
+			}
+			value = list
+		case "dependencies":
+			depType := e.stringArg(f, "type", "")
+			var others []*model.Issue
+			for _, dep := range issue.Dependencies {
+				if dep == nil || depType != "" && string(dep.Type) != depType {
+					continue
+				}
+				if other, ok := e.snap.byID[dep.DependsOnID]; ok {
+					others = append(others, other)
+				}
+			}
+			list, err := e.issueList(f, others)
+			if err != nil {
+				return nil, err
+			}
+			value = list
+		case "dependents":
+			depType := e.stringArg(f, "type", "")
+			var others []*model.Issue
+			for _, id := range e.snap.dependents[issue.ID] {
+				other := e.snap.byID[id]
+				if depType == "" || dependsAs(other, issue.ID, depType) {
+					others = append(others, other)
+				}
+			}
+			list, err := e.issueList(f, others)
+			if err != nil {
+				return nil, err
+			}
+			value = list
+		}
+		out = append(out, gqlEntry{f.alias, value})
+	}
+	return out, nil
+}
+
+// issueList resolves the selection of f on issues, up to its limit.
+func (e *gqlExecutor) issueList(f gqlField, issues []*model.Issue) ([]any, error) {
+	n, err := e.limitArg(f, len(issues))
+	if err != nil {
+		return nil, err
+	}
+	list := make([]any, n)
+	for i, issue := range issues[:n] {
+		if list[i], err = e.issue(issue, f.selection); err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+func (e *gqlExecutor) comment(c *model.Comment, fields []gqlField) (gqlObject, error) {
+	if err := e.resolve(); err != nil {
+		return nil, err
+	}
+	out := make(gqlObject, 0, len(fields))
+	for _, f := range fields {
+		var value any
+		switch f.name {
+		case "__typename":
+			value = "Comment"
+		case "author":
+			value = c.Author
+		case "text":
+			value = c.Text
+		case "createdAt":
+			value = gqlTime(&c.CreatedAt)
+		}
+		out = append(out, gqlEntry{f.alias, value})
+	}
+	return out, nil
+}
+
+// dependsAs reports whether issue depends on id with a dependency of type
+// depType.
+func dependsAs(issue *model.Issue, id, depType string) bool {
+	for _, dep := range issue.Dependencies {
+		if dep != nil && dep.DependsOnID == id && string(dep.Type) == depType {
+			return true
+		}
+	}
+	return false
+}
+
+// gqlTime renders a time as RFC 3339, or null when it is unset.
+func gqlTime(t *time.Time) any {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	return t.UTC().Format(time.RFC3339)
+}
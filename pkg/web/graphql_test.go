@@ -0,0 +1,124 @@
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func postGraphQL(t *testing.T, url string, req GraphQLRequest) (int, string) {
+	t.Helper()
+	body, err := json.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.Post(url+"/api/graphql", "application/json", strings.NewReader(string(body)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	out, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, strings.TrimSpace(string(out))
+}
+
+func TestGraphQL_Queries(t *testing.T) {
+	ts := newTestServer(t, func() ([]model.Issue, error) { return testIssues(), nil })
+
+	code, body := postGraphQL(t, ts.URL, GraphQLRequest{Query: `
+		query Board($f: String = "open", $n: Int) {
+			top: issues(filter: $f, sort: "priority", limit: $n) { id priority labels }
+			issue(id: "W-1") {
+				id
+				dependents(type: "blocks") { id dependencies { id } }
+				dueDate
+			}
+			missing: issue(id: "nope") { id }
+		}`, Variables: map[string]any{"n": 1}})
+	want := `{"data":{"top":[{"id":"W-2","priority":0,"labels":[]}],` +
+		`"issue":{"id":"W-1","dependents":[{"id":"W-2","dependencies":[{"id":"W-1"}]}],"dueDate":null},` +
+		`"missing":null}}`
+	if code != http.StatusOK || body != want {
+		t.Fatalf("unexpected answer %d:\n%s\nwant:\n%s", code, body, want)
+	}
+
+	code, body = get(t, ts.URL+"/api/graphql?query="+url.QueryEscape(`{ issues(filter: "closed") { __typename id } }`))
+	if code != http.StatusOK || strings.TrimSpace(body) != `{"data":{"issues":[{"__typename":"Issue","id":"W-3"}]}}` {
+		t.Fatalf("unexpected GET answer %d: %s", code, body)
+	}
+}
+
+func TestGraphQL_RejectsBadQueries(t *testing.T) {
+	ts := newTestServer(t, func() ([]model.Issue, error) { return testIssues(), nil })
+
+	deep := "{ issue(id: \"W-2\") " + strings.Repeat("{ dependencies ", graphQLMaxDepth) + "{ id }" + strings.Repeat(" }", graphQLMaxDepth) + " }"
+	cases := map[string]string{
+		`{ issues { id owner } }`:                   `cannot query field \"owner\" on type Issue`,
+		`{ issues { dependencies } }`:               "needs a selection",
+		`{ issue(id: "W-1") { id { x } } }`:         "has no fields",
+		`{ issues(filter: "bogus") { id } }`:        "unknown filter",
+		`{ issues(limit: "3") { id } }`:             "expected Int",
+		`{ issues(filter: $f) { id } }`:             "variable $f is not defined",
+		`mutation { close(id: "W-1") }`:             "read-only",
+		`{ issues { ...F } }`:                       "fragments are not supported",
+		`query($id: ID!) { issue(id: $id) { id } }`: "variable $id is required",
+		`{ issues { id }`:                           "end of the query",
+		deep:                                        "deeper than 8 levels",
+	}
+	for query, want := range cases {
+		code, body := postGraphQL(t, ts.URL, GraphQLRequest{Query: query})
+		if code != http.StatusBadRequest || !strings.Contains(body, want) || strings.Contains(body, `"data"`) {
+			t.Errorf("%s: expected %q, got %d %s", query, want, code, body)
+		}
+	}
+
+	code, body := get(t, ts.URL+"/api/graphql/schema")
+	if code != http.StatusOK || !strings.Contains(body, "issues(filter: String, sort: String, limit: Int): [Issue!]!") ||
+		!strings.Contains(body, "dependents(type: String, limit: Int): [Issue!]!") {
+		t.Fatalf("unexpected schema %d:\n%s", code, body)
+	}
+}
+
+func TestGraphQL_CapsAliasedFanOut(t *testing.T) {
+	// Every issue depends on every other, so each dependents list holds
+	// all but one of them.
+	var issues []model.Issue
+	for i := 0; i < 20; i++ {
+		issue := model.Issue{ID: fmt.Sprintf("F-%d", i), Title: "fan", Status: model.StatusOpen}
+		for j := 0; j < 20; j++ {
+			if j != i {
+				issue.Dependencies = append(issue.Dependencies, &model.Dependency{IssueID: issue.ID, DependsOnID: fmt.Sprintf("F-%d", j), Type: model.DepBlocks})
+			}
+		}
+		issues = append(issues, issue)
+	}
+	ts := newTestServer(t, func() ([]model.Issue, error) { return issues, nil })
+
+	// Three aliases at each of six levels select 3^6 fields.
+	fanOut := "id"
+	for i := 0; i < 6; i++ {
+		fanOut = "a: dependents { " + fanOut + " } b: dependents { " + fanOut + " } c: dependents { " + fanOut + " }"
+	}
+	code, body := postGraphQL(t, ts.URL, GraphQLRequest{Query: `{ issue(id: "F-0") { ` + fanOut + ` } }`})
+	if code != http.StatusBadRequest || !strings.Contains(body, "more than 500 fields") {
+		t.Errorf("expected the aliased fan-out refused, got %d %.200s", code, body)
+	}
+
+	// A few fields can still resolve 20*19^3 issues.
+	deep := `{ issues { a: dependents { b: dependents { c: dependents { id } } } } }`
+	code, body = postGraphQL(t, ts.URL, GraphQLRequest{Query: deep})
+	if code != http.StatusBadRequest || !strings.Contains(body, "more than 10000 objects") || strings.Contains(body, `"data"`) {
+		t.Errorf("expected the resolved issues capped, got %d %.200s", code, body)
+	}
+
+	// Nested lists take a limit.
+	code, body = postGraphQL(t, ts.URL, GraphQLRequest{Query: `{ issue(id: "F-0") { dependents(limit: 1) { id comments(limit: 1) { text } } } }`})
+	if code != http.StatusOK || body != `{"data":{"issue":{"dependents":[{"id":"F-1","comments":[]}]}}}` {
+		t.Errorf("unexpected limited answer %d: %s", code, body)
+	}
+}
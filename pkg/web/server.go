@@ -0,0 +1,365 @@
+// Package web serves a read-only browser view of a beads project: the issue
+// list and issue details as HTML, the interactive dependency graph, and the
+// same data as a JSON API and a GraphQL endpoint. It is what "bv serve" runs.
+package web
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/beadsview"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/export"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+// DefaultReloadInterval is how long a loaded snapshot is served before the
+// issues are read again.
+const DefaultReloadInterval = 2 * time.Second
+
+// Options configures a Server.
+type Options struct {
+	// Title is shown in page headers; defaults to "Beads".
+	Title string
+	// ReloadInterval bounds how often Load is called; zero means
+	// DefaultReloadInterval.
+	ReloadInterval time.Duration
+}
+
+// Server renders issues returned by a load function. It never modifies them.
+type Server struct {
+	load func() ([]model.Issue, error)
+	opts Options
+
+	listTmpl  *template.Template
+	issueTmpl *template.Template
+
+	mu       sync.Mutex
+	snap     *snapshot
+	loadedAt time.Time
+}
+
+// snapshot is one load of the issues plus the lookups the pages need.
+type snapshot struct {
+	issues     []model.Issue
+	byID       map[string]*model.Issue
+	dependents map[string][]string
+	dataHash   string
+
+	statsOnce sync.Once
+	stats     analysis.GraphStats
+}
+
+// graphStats runs the graph analysis on first use; list and detail pages
+// don't need it.
+func (s *snapshot) graphStats() *analysis.GraphStats {
+	s.statsOnce.Do(func() {
+		s.stats = analysis.NewAnalyzer(s.issues).Analyze()
+	})
+	return &s.stats
+}
+
+// NewServer returns a server for the issues returned by load.
+func NewServer(load func() ([]model.Issue, error), opts Options) (*Server, error) {
+	if opts.Title == "" {
+		opts.Title = "Beads"
+	}
+	if opts.ReloadInterval <= 0 {
+		opts.ReloadInterval = DefaultReloadInterval
+	}
+	funcs := template.FuncMap{
+		"statusClass": func(s model.Status) string {
+			return strings.ReplaceAll(string(s), "_", "-")
+		},
+		"join": strings.Join,
+		"date": func(t time.Time) string {
+			if t.IsZero() {
+				return ""
+			}
+			return t.Local().Format("2006-01-02 15:04")
+		},
+	}
+	parse := func(page string) (*template.Template, error) {
+		return template.New("").Funcs(funcs).ParseFS(templateFS, "templates/layout.html", "templates/"+page)
+	}
+	listTmpl, err := parse("list.html")
+	if err != nil {
+		return nil, fmt.Errorf("parsing list template: %w", err)
+	}
+	issueTmpl, err := parse("issue.html")
+	if err != nil {
+		return nil, fmt.Errorf("parsing issue template: %w", err)
+	}
+	return &Server{load: load, opts: opts, listTmpl: listTmpl, issueTmpl: issueTmpl}, nil
+}
+
+// Handler returns the HTTP routes. Only GET (and HEAD) requests are served,
+// plus POST for GraphQL queries, which read like the rest.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", s.handleList)
+	mux.HandleFunc("GET /issues/{id}", s.handleIssue)
+	mux.HandleFunc("GET /graph", s.handleGraph)
+	mux.HandleFunc("GET /api/issues", s.handleAPIIssues)
+	mux.HandleFunc("GET /api/issues/{id}", s.handleAPIIssue)
+	mux.HandleFunc("GET /api/graph", s.handleAPIGraph)
+	mux.HandleFunc("GET /api/graphql", s.handleGraphQL)
+	mux.HandleFunc("POST /api/graphql", s.handleGraphQL)
+	mux.HandleFunc("GET /api/graphql/schema", s.handleGraphQLSchema)
+	return mux
+}
+
+// current returns the latest snapshot, reloading it when it is older than
+// the reload interval. A failed reload keeps serving the previous snapshot.
+func (s *Server) current() (*snapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.snap != nil && time.Since(s.loadedAt) < s.opts.ReloadInterval {
+		return s.snap, nil
+	}
+	issues, err := s.load()
+	if err != nil {
+		if s.snap != nil {
+			return s.snap, nil
+		}
+		return nil, err
+	}
+	s.snap = newSnapshot(issues)
+	s.loadedAt = time.Now()
+	return s.snap, nil
+}
+
+func newSnapshot(issues []model.Issue) *snapshot {
+	snap := &snapshot{
+		issues:     issues,
+		byID:       make(map[string]*model.Issue, len(issues)),
+		dependents: make(map[string][]string),
+		dataHash:   analysis.ComputeDataHash(issues),
+	}
+	for i := range issues {
+		snap.byID[issues[i].ID] = &issues[i]
+		for _, dep := range issues[i].Dependencies {
+			if dep != nil {
+				snap.dependents[dep.DependsOnID] = append(snap.dependents[dep.DependsOnID], issues[i].ID)
+			}
+		}
+	}
+	for _, ids := range snap.dependents {
+		sort.Strings(ids)
+	}
+	return snap
+}
+
+// listQuery reads the filter and sort parameters, which use the TUI's
+// filter terms and sort keys.
+func listQuery(r *http.Request) (filter, sortKey string, mode beadsview.SortMode, err error) {
+	filter = strings.TrimSpace(r.URL.Query().Get("filter"))
+	if filter == "" {
+		filter = "all"
+	}
+	sortKey = r.URL.Query().Get("sort")
+	if sortKey == "" {
+		sortKey = "default"
+	}
+	if err = beadsview.ValidateFilter(filter); err != nil {
+		return
+	}
+	mode, err = beadsview.ParseSortMode(sortKey)
+	return
+}
+
+type listPage struct {
+	Title   string
+	Filter  string
+	Sort    string
+	Error   string
+	Issues  []model.Issue
+	Total   int
+	SortKey []string
+}
+
+func (s *Server) handleList(w http.ResponseWriter, r *http.Request) {
+	snap, err := s.current()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	page := listPage{
+		Title:   s.opts.Title,
+		Total:   len(snap.issues),
+		SortKey: []string{"default", "priority", "created", "-created", "updated", "votes"},
+	}
+	filter, sortKey, mode, err := listQuery(r)
+	page.Filter, page.Sort = filter, sortKey
+	status := http.StatusOK
+	if err != nil {
+		page.Error = err.Error()
+		status = http.StatusBadRequest
+	} else {
+		page.Issues = beadsview.FilterIssues(snap.issues, filter, mode)
+	}
+	s.render(w, s.listTmpl, status, page)
+}
+
+type issuePage struct {
+	Title      string
+	Issue      *model.Issue
+	Blockers   []*model.Issue
+	Dependents []*model.Issue
+}
+
+func (s *Server) handleIssue(w http.ResponseWriter, r *http.Request) {
+	snap, err := s.current()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	issue, ok := snap.byID[r.PathValue("id")]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	page := issuePage{Title: s.opts.Title, Issue: issue}
+	for _, dep := range issue.Dependencies {
+		if dep == nil {
+			continue
+		}
+		if other, ok := snap.byID[dep.DependsOnID]; ok {
+			page.Blockers = append(page.Blockers, other)
+		}
+	}
+	for _, id := range snap.dependents[issue.ID] {
+		page.Dependents = append(page.Dependents, snap.byID[id])
+	}
+	s.render(w, s.issueTmpl, http.StatusOK, page)
+}
+
+func (s *Server) handleGraph(w http.ResponseWriter, r *http.Request) {
+	snap, err := s.current()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	html, err := export.RenderInteractiveGraphHTML(export.InteractiveGraphOptions{
+		Issues:      snap.issues,
+		Stats:       snap.graphStats(),
+		Title:       s.opts.Title + " dependency graph",
+		DataHash:    snap.dataHash,
+		ProjectName: s.opts.Title,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = w.Write([]byte(html))
+}
+
+// IssueList is the body of GET /api/issues. It matches "bv --json".
+type IssueList struct {
+	GeneratedAt string        `json:"generated_at"`
+	DataHash    string        `json:"data_hash"`
+	Filter      string        `json:"filter"`
+	Sort        string        `json:"sort"`
+	Count       int           `json:"count"`
+	Issues      []model.Issue `json:"issues"`
+}
+
+func (s *Server) handleAPIIssues(w http.ResponseWriter, r *http.Request) {
+	snap, err := s.current()
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+	filter, sortKey, mode, err := listQuery(r)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+	issues := beadsview.FilterIssues(snap.issues, filter, mode)
+	if issues == nil {
+		issues = []model.Issue{}
+	}
+	writeJSON(w, http.StatusOK, IssueList{
+		GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+		DataHash:    snap.dataHash,
+		Filter:      filter,
+		Sort:        sortKey,
+		Count:       len(issues),
+		Issues:      issues,
+	})
+}
+
+// IssueDetail is the body of GET /api/issues/{id}.
+type IssueDetail struct {
+	Issue      model.Issue `json:"issue"`
+	Dependents []string    `json:"dependents"`
+}
+
+func (s *Server) handleAPIIssue(w http.ResponseWriter, r *http.Request) {
+	snap, err := s.current()
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+	id := r.PathValue("id")
+	issue, ok := snap.byID[id]
+	if !ok {
+		writeJSONError(w, http.StatusNotFound, fmt.Errorf("issue %q not found", id))
+		return
+	}
+	dependents := snap.dependents[id]
+	if dependents == nil {
+		dependents = []string{}
+	}
+	writeJSON(w, http.StatusOK, IssueDetail{Issue: *issue, Dependents: dependents})
+}
+
+func (s *Server) handleAPIGraph(w http.ResponseWriter, r *http.Request) {
+	snap, err := s.current()
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+	result, err := export.ExportGraph(snap.issues, snap.graphStats(), export.GraphExportConfig{
+		Format:   export.GraphFormatJSON,
+		Label:    r.URL.Query().Get("label"),
+		DataHash: snap.dataHash,
+	})
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (s *Server) render(w http.ResponseWriter, tmpl *template.Template, status int, data any) {
+	var sb strings.Builder
+	if err := tmpl.ExecuteTemplate(&sb, "layout", data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(sb.String()))
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeJSONError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,138 @@
+package web
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func testIssues() []model.Issue {
+	now := time.Now()
+	return []model.Issue{
+		{ID: "W-1", Title: "Schema <design>", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeFeature, CreatedAt: now, Labels: []string{"api"}},
+		{ID: "W-2", Title: "Endpoint", Status: model.StatusBlocked, Priority: 0, IssueType: model.TypeTask, CreatedAt: now,
+			Dependencies: []*model.Dependency{{IssueID: "W-2", DependsOnID: "W-1", Type: model.DepBlocks}}},
+		{ID: "W-3", Title: "Old", Status: model.StatusClosed, Priority: 2, IssueType: model.TypeBug, CreatedAt: now},
+	}
+}
+
+func newTestServer(t *testing.T, load func() ([]model.Issue, error)) *httptest.Server {
+	t.Helper()
+	s, err := NewServer(load, Options{Title: "demo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := httptest.NewServer(s.Handler())
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func get(t *testing.T, url string) (int, string) {
+	t.Helper()
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, string(body)
+}
+
+func TestServer_ListAndDetailPages(t *testing.T) {
+	ts := newTestServer(t, func() ([]model.Issue, error) { return testIssues(), nil })
+
+	code, body := get(t, ts.URL+"/?filter=open&sort=priority")
+	if code != http.StatusOK {
+		t.Fatalf("list: %d", code)
+	}
+	if !strings.Contains(body, "2 of 3 issues") || strings.Index(body, "W-2") > strings.Index(body, "W-1") || strings.Contains(body, "/issues/W-3") {
+		t.Fatalf("expected open issues in priority order:\n%s", body)
+	}
+	if !strings.Contains(body, "Schema &lt;design&gt;") {
+		t.Fatal("expected titles to be escaped")
+	}
+
+	code, body = get(t, ts.URL+"/?filter=bogus")
+	if code != http.StatusBadRequest || !strings.Contains(body, "unknown filter") {
+		t.Fatalf("expected a bad filter to be reported, got %d", code)
+	}
+
+	code, body = get(t, ts.URL+"/issues/W-1")
+	if code != http.StatusOK || !strings.Contains(body, "Depended on by") || !strings.Contains(body, `href="/issues/W-2"`) {
+		t.Fatalf("expected dependents on the detail page, got %d", code)
+	}
+	if code, _ = get(t, ts.URL+"/issues/nope"); code != http.StatusNotFound {
+		t.Fatalf("expected 404 for a missing issue, got %d", code)
+	}
+}
+
+func TestServer_JSONAPI(t *testing.T) {
+	ts := newTestServer(t, func() ([]model.Issue, error) { return testIssues(), nil })
+
+	code, body := get(t, ts.URL+"/api/issues?filter=closed")
+	var list IssueList
+	if err := json.Unmarshal([]byte(body), &list); err != nil || code != http.StatusOK {
+		t.Fatalf("api/issues: %d %v", code, err)
+	}
+	if list.Count != 1 || list.Issues[0].ID != "W-3" || list.DataHash == "" {
+		t.Fatalf("unexpected list: %+v", list)
+	}
+
+	_, body = get(t, ts.URL+"/api/issues/W-1")
+	var detail IssueDetail
+	if err := json.Unmarshal([]byte(body), &detail); err != nil {
+		t.Fatal(err)
+	}
+	if detail.Issue.ID != "W-1" || len(detail.Dependents) != 1 || detail.Dependents[0] != "W-2" {
+		t.Fatalf("unexpected detail: %+v", detail)
+	}
+
+	code, body = get(t, ts.URL+"/api/graph")
+	if code != http.StatusOK || !strings.Contains(body, `"edges":1`) {
+		t.Fatalf("api/graph: %d %s", code, body)
+	}
+	if code, _ = get(t, ts.URL+"/graph"); code != http.StatusOK {
+		t.Fatalf("graph: %d", code)
+	}
+
+	resp, err := http.Post(ts.URL+"/api/issues", "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Fatalf("expected writes to be rejected, got %d", resp.StatusCode)
+	}
+}
+
+func TestServer_KeepsLastSnapshotOnLoadError(t *testing.T) {
+	calls := 0
+	s, err := NewServer(func() ([]model.Issue, error) {
+		calls++
+		if calls > 1 {
+			return nil, errors.New("file is being rewritten")
+		}
+		return testIssues(), nil
+	}, Options{ReloadInterval: time.Nanosecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := httptest.NewServer(s.Handler())
+	defer ts.Close()
+
+	for i := 0; i < 2; i++ {
+		if code, body := get(t, ts.URL+"/api/issues"); code != http.StatusOK || !strings.Contains(body, `"count":3`) {
+			t.Fatalf("request %d: %d %s", i, code, body)
+		}
+	}
+	if calls != 2 {
+		t.Fatalf("expected a reload attempt per request, got %d loads", calls)
+	}
+}
@@ -0,0 +1,123 @@
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// External dependencies
+//
+// An issue can depend on an issue of another repo in its workspace by
+// naming it with that repo's prefix, e.g. api-AUTH-12. Loaded on its own,
+// the repo doesn't have that issue and views ignore the dependency.
+// ResolveExternal finds such references, loads only the repos they name,
+// and returns the issues named, so an open one can block like a local one.
+
+// ResolveExternal returns the issues of other repos of config that issues
+// depend on, keyed by the ID they are referenced by, with SourceRepo set to
+// the repo's name and no dependencies of their own. root is the workspace
+// root and current the directory of the repo issues come from. References
+// to local issues, to no known repo, or to issues a repo doesn't have are
+// left out. A repo that fails to load is reported in the error, which
+// doesn't stop the others from resolving.
+func ResolveExternal(config *Config, root, current string, issues []model.Issue) (map[string]model.Issue, error) {
+	if config == nil {
+		return nil, fmt.Errorf("workspace config is nil")
+	}
+	currentAbs, err := filepath.Abs(current)
+	if err != nil {
+		return nil, fmt.Errorf("resolving %s: %w", current, err)
+	}
+
+	var prefixes []string
+	repos := make(map[string]RepoConfig)
+	for _, repo := range config.Repos {
+		if !repo.IsEnabled() || repoDir(root, repo) == currentAbs {
+			continue
+		}
+		prefixes = append(prefixes, repo.GetPrefix())
+		repos[repo.GetPrefix()] = repo
+	}
+
+	local := make(map[string]bool, len(issues))
+	for i := range issues {
+		local[issues[i].ID] = true
+	}
+	wanted := make(map[string]map[string]bool) // prefix -> referenced IDs
+	for i := range issues {
+		for _, dep := range issues[i].Dependencies {
+			if dep == nil || local[dep.DependsOnID] {
+				continue
+			}
+			ns := ParseNamespacedID(dep.DependsOnID, prefixes)
+			if ns.Namespace == "" {
+				continue
+			}
+			if wanted[ns.Namespace] == nil {
+				wanted[ns.Namespace] = make(map[string]bool)
+			}
+			wanted[ns.Namespace][dep.DependsOnID] = true
+		}
+	}
+
+	found := make(map[string]model.Issue)
+	var errs []error
+	for prefix, ids := range wanted {
+		repo := repos[prefix]
+		repoIssues, err := loader.LoadIssuesFromDir(filepath.Join(repoDir(root, repo), repo.GetBeadsPath()))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("loading %s: %w", repo.GetName(), err))
+			continue
+		}
+		for _, issue := range repoIssues {
+			id := QualifyID(issue.ID, prefix)
+			if !ids[id] {
+				continue
+			}
+			issue.ID = id
+			issue.SourceRepo = repo.GetName()
+			issue.Dependencies = nil
+			found[id] = issue
+		}
+	}
+	return found, errors.Join(errs...)
+}
+
+// FindExternal resolves the external dependencies of issues from the repo
+// at dir, in the workspace whose .bv/workspace.yaml is found from dir up.
+// Without a workspace it returns nothing.
+func FindExternal(dir string, issues []model.Issue) (map[string]model.Issue, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, fmt.Errorf("resolving %s: %w", dir, err)
+	}
+	path, err := FindWorkspaceConfig(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	config, err := LoadConfig(path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return ResolveExternal(config, filepath.Dir(filepath.Dir(path)), dir, issues)
+}
+
+// repoDir is the absolute directory of repo in the workspace at root.
+func repoDir(root string, repo RepoConfig) string {
+	dir := repo.Path
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(root, dir)
+	}
+	if abs, err := filepath.Abs(dir); err == nil {
+		return abs
+	}
+	return filepath.Clean(dir)
+}
@@ -0,0 +1,88 @@
+package workspace_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/workspace"
+)
+
+func TestResolveExternal(t *testing.T) {
+	root := t.TempDir()
+	now := time.Now()
+	createTestBeadsFile(t, filepath.Join(root, "services", "api"), []model.Issue{
+		{ID: "AUTH-1", Title: "Token refresh", Status: model.StatusOpen, Priority: 1, CreatedAt: now, UpdatedAt: now,
+			Dependencies: []*model.Dependency{{IssueID: "AUTH-1", DependsOnID: "AUTH-9", Type: model.DepBlocks}}},
+		{ID: "AUTH-2", Title: "Session store", Status: model.StatusClosed, CreatedAt: now, UpdatedAt: now},
+		{ID: "AUTH-3", Title: "Not referenced", CreatedAt: now, UpdatedAt: now},
+	})
+	web := filepath.Join(root, "apps", "web")
+	createTestBeadsFile(t, web, []model.Issue{{ID: "UI-1", Title: "Local", CreatedAt: now, UpdatedAt: now}})
+
+	config := &workspace.Config{Repos: []workspace.RepoConfig{
+		{Name: "api", Path: "services/api", Prefix: "api-"},
+		{Name: "web", Path: "apps/web", Prefix: "web-"},
+		{Name: "gone", Path: "libs/gone", Prefix: "gone-"},
+	}}
+	issues := []model.Issue{
+		{ID: "UI-1", Dependencies: []*model.Dependency{
+			{DependsOnID: "api-AUTH-1", Type: model.DepBlocks},
+			{DependsOnID: "api-AUTH-2", Type: model.DepRelated},
+			{DependsOnID: "api-AUTH-404", Type: model.DepBlocks},
+			{DependsOnID: "web-UI-1", Type: model.DepBlocks}, // The current repo
+			{DependsOnID: "UI-2", Type: model.DepBlocks},     // No prefix
+		}},
+		{ID: "UI-2", Dependencies: []*model.Dependency{{DependsOnID: "gone-X-1", Type: model.DepBlocks}}},
+	}
+
+	found, err := workspace.ResolveExternal(config, root, web, issues)
+	if err == nil {
+		t.Error("expected the missing repo reported")
+	}
+	if len(found) != 2 {
+		t.Fatalf("expected api-AUTH-1 and api-AUTH-2, got %v", found)
+	}
+	auth := found["api-AUTH-1"]
+	if auth.ID != "api-AUTH-1" || auth.Title != "Token refresh" || auth.Status != model.StatusOpen || auth.SourceRepo != "api" {
+		t.Errorf("unexpected external issue %+v", auth)
+	}
+	if len(auth.Dependencies) != 0 {
+		t.Error("expected external issues without their own dependencies")
+	}
+	if found["api-AUTH-2"].Status != model.StatusClosed {
+		t.Errorf("expected the closed status, got %q", found["api-AUTH-2"].Status)
+	}
+}
+
+func TestFindExternal(t *testing.T) {
+	root := t.TempDir()
+	issues := []model.Issue{{ID: "UI-1", Dependencies: []*model.Dependency{{DependsOnID: "api-AUTH-1", Type: model.DepBlocks}}}}
+
+	found, err := workspace.FindExternal(root, issues)
+	if err != nil || found != nil {
+		t.Fatalf("expected nothing outside a workspace, got %v, %v", found, err)
+	}
+
+	now := time.Now()
+	createTestBeadsFile(t, filepath.Join(root, "api"), []model.Issue{{ID: "AUTH-1", Title: "Token refresh", CreatedAt: now, UpdatedAt: now}})
+	web := filepath.Join(root, "web")
+	createTestBeadsFile(t, web, nil)
+	if err := os.MkdirAll(filepath.Join(root, ".bv"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	config := "repos:\n  - path: api\n  - path: web\n"
+	if err := os.WriteFile(filepath.Join(root, ".bv", "workspace.yaml"), []byte(config), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	found, err = workspace.FindExternal(web, issues)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found["api-AUTH-1"].Title != "Token refresh" {
+		t.Errorf("expected api-AUTH-1 found through the default prefix, got %v", found)
+	}
+}
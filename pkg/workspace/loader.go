@@ -155,11 +155,7 @@ func (l *AggregateLoader) loadSingleRepo(repo RepoConfig) ([]model.Issue, error)
 
 	// Load raw issues from the repo, respecting custom beads path if provided
 	beadsDir := filepath.Join(repoPath, repo.GetBeadsPath())
-	jsonlPath, err := loader.FindJSONLPath(beadsDir)
-	if err != nil {
-		return nil, fmt.Errorf("failed to load issues from %s: %w", repo.GetName(), err)
-	}
-	issues, err := loader.LoadIssuesFromFile(jsonlPath)
+	issues, err := loader.LoadIssuesFromDir(beadsDir)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load issues from %s: %w", repo.GetName(), err)
 	}
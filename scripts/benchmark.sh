@@ -58,6 +58,7 @@ run_quick() {
     echo "Running quick benchmarks (CI mode)..."
     go test -bench='Benchmark(FullAnalysis_(Sparse100|Dense100|ManyCycles20)|GraphModel_Rebuild_Layered1000|GraphSnapshot_BuildLayoutAndRenderSVG_Layered1000)' \
             -benchmem -count=1 "${BENCH_PACKAGES[@]}" 2>&1 | tee "$CURRENT_FILE"
+    go test -run='^$' -bench='BenchmarkRenderPath//issues=10000$' -benchmem -count=1 ./pkg/ui/ 2>&1 | tee -a "$CURRENT_FILE"
 }
 
 case "${1:-run}" in
@@ -0,0 +1,68 @@
+#!/bin/bash
+# Publishes bsdiff patches from the previous release to this one, which
+# `bv --update` downloads instead of the archive when it can.
+#
+# Run after GoReleaser, with the new archives in dist/. For each platform it
+# writes bv_<old>_to_<new>_<os>_<arch>.bsdiff and lists the SHA-256 of each
+# patch and of the binary it produces (bv_<new>_<os>_<arch>) in patches.txt,
+# then uploads them to the release.
+#
+# Usage:
+#   GH_TOKEN=... ./scripts/release_patches.sh v0.11.0
+#
+# Needs bsdiff and gh.
+
+set -euo pipefail
+
+tag="${1:?usage: $0 <tag>}"
+prev="$(git describe --tags --abbrev=0 "${tag}^" 2>/dev/null || true)"
+if [ -z "$prev" ]; then
+    echo "No release before ${tag}; nothing to patch from."
+    exit 0
+fi
+new="${tag#v}"
+old="${prev#v}"
+
+work="$(mktemp -d)"
+trap 'rm -rf "$work"' EXIT
+
+if ! gh release download "$prev" --pattern "bv_${old}_*.tar.gz" --dir "$work/old"; then
+    echo "Could not download the ${prev} archives; skipping patches."
+    exit 0
+fi
+
+# binary_from extracts the bv binary of archive into dir and prints its path.
+binary_from() {
+    local archive="$1" dir="$2"
+    mkdir -p "$dir"
+    tar -xzf "$archive" -C "$dir"
+    find "$dir" -type f \( -name bv -o -name bv.exe \) | head -n 1
+}
+
+patches=()
+: > "$work/patches.txt"
+for archive in dist/bv_"${new}"_*.tar.gz; do
+    platform="$(basename "$archive" .tar.gz)"
+    platform="${platform#bv_${new}_}"
+    old_archive="$work/old/bv_${old}_${platform}.tar.gz"
+    if [ ! -f "$old_archive" ]; then
+        echo "No ${prev} archive for ${platform}; skipping."
+        continue
+    fi
+
+    new_bin="$(binary_from "$archive" "$work/new/$platform")"
+    old_bin="$(binary_from "$old_archive" "$work/old/$platform")"
+    patch="$work/bv_${old}_to_${new}_${platform}.bsdiff"
+    bsdiff "$old_bin" "$new_bin" "$patch"
+
+    echo "$(sha256sum "$patch" | cut -d' ' -f1)  $(basename "$patch")" >> "$work/patches.txt"
+    echo "$(sha256sum "$new_bin" | cut -d' ' -f1)  bv_${new}_${platform}" >> "$work/patches.txt"
+    patches+=("$patch")
+    echo "${platform}: $(wc -c < "$patch") byte patch for a $(wc -c < "$archive") byte archive"
+done
+
+if [ "${#patches[@]}" -eq 0 ]; then
+    echo "No patches to publish."
+    exit 0
+fi
+gh release upload "$tag" "${patches[@]}" "$work/patches.txt" --clobber
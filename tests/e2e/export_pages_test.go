@@ -41,6 +41,7 @@ func TestExportPages_IncludesHistoryAndRunsHooks(t *testing.T) {
 		"--export-pages", exportDir,
 		"--pages-include-history",
 		"--pages-include-closed",
+		"--trust-hooks",
 	)
 	cmd.Dir = repoDir
 	out, err := cmd.CombinedOutput()
@@ -91,6 +92,23 @@ func TestExportPages_IncludesHistoryAndRunsHooks(t *testing.T) {
 	if len(history.Commits) == 0 || history.Commits[0].SHA == "" {
 		t.Fatalf("expected at least one commit in history.json, got %+v", history.Commits)
 	}
+
+	// Without --trust-hooks, hooks nobody has trusted are skipped.
+	untrustedDir := filepath.Join(repoDir, "bv-pages-untrusted")
+	cmd = exec.Command(bv, "--export-pages", untrustedDir)
+	cmd.Dir = repoDir
+	configHome := t.TempDir()
+	cmd.Env = append(os.Environ(), "HOME="+configHome, "XDG_CONFIG_HOME="+configHome)
+	out, err = cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("--export-pages failed: %v\n%s", err, out)
+	}
+	if !strings.Contains(string(out), "not trusted yet") {
+		t.Errorf("expected a warning about untrusted hooks:\n%s", out)
+	}
+	if _, err := os.Stat(filepath.Join(untrustedDir, "pre-hook.txt")); err == nil {
+		t.Error("expected the untrusted pre-export hook not to run")
+	}
 }
 
 func stageViewerAssets(t *testing.T, bvPath string) {